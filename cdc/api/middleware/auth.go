@@ -0,0 +1,556 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VividCortex/mysqlerr"
+	"github.com/gin-gonic/gin"
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/soheilhy/cmux"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// ForwardedUserHeader is set to the authenticated user name when a request
+	// is forwarded to the owner.
+	ForwardedUserHeader = "TiCDC-Forwarded-User"
+	// ForwardedSignatureHeader carries the signature of the forwarded user.
+	// The owner only trusts the forwarded user if the request comes from a
+	// capture, i.e. the client certificate has the same common name as its
+	// own, and the signature is made by the secret shared by captures.
+	ForwardedSignatureHeader = "TiCDC-Forwarded-Signature"
+)
+
+const (
+	ctxKeyUser = "ticdc-auth-user"
+	ctxKeyRole = "ticdc-auth-role"
+
+	tidbPasswordCacheTTL = time.Minute
+	tidbLoginTimeout     = 5 * time.Second
+
+	// forwardedSignatureTTL is how long a signature of a forwarded user is valid.
+	forwardedSignatureTTL = time.Minute
+)
+
+var (
+	// publicPaths can be accessed without authentication. They are used by
+	// monitoring systems and health checks.
+	publicPaths = []string{"/status", "/metrics", "/swagger/"}
+	// adminPaths can only be accessed by admins.
+	adminPaths = []string{"/api/v2/unsafe/", "/admin/", "/debug/"}
+)
+
+// Role is the role of a client. A role has all permissions of lower roles.
+type Role int
+
+// Roles of clients.
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleOperator
+	RoleAdmin
+)
+
+// ParseRole parses a role from its name, it returns RoleNone for unknown names.
+func ParseRole(name string) Role {
+	switch name {
+	case config.AuthRoleViewer:
+		return RoleViewer
+	case config.AuthRoleOperator:
+		return RoleOperator
+	case config.AuthRoleAdmin:
+		return RoleAdmin
+	default:
+		return RoleNone
+	}
+}
+
+// String implements fmt.Stringer interface.
+func (r Role) String() string {
+	switch r {
+	case RoleViewer:
+		return config.AuthRoleViewer
+	case RoleOperator:
+		return config.AuthRoleOperator
+	case RoleAdmin:
+		return config.AuthRoleAdmin
+	default:
+		return "none"
+	}
+}
+
+// Authenticator authenticates the client of a request.
+type Authenticator interface {
+	// Authenticate returns the user name of the client. ok is false if the
+	// request doesn't carry the credential checked by the authenticator.
+	Authenticate(req *http.Request) (user string, ok bool, err error)
+}
+
+// Auth authenticates clients and maps them to roles.
+type Auth struct {
+	enable         bool
+	authenticators []Authenticator
+	userRoles      map[string]Role
+	defaultRole    Role
+	basicAuth      bool
+	// forwardSecret signs the users of forwarded requests, nil means forwarded
+	// users are not trusted.
+	forwardSecret []byte
+}
+
+// NewAuth creates an Auth from the config. credential is the TLS credential
+// of the server, it's used to recognize requests forwarded by other captures.
+func NewAuth(cfg *config.AuthConfig, credential *security.Credential) (*Auth, error) {
+	if cfg == nil || !cfg.Enable {
+		return &Auth{}, nil
+	}
+	a := &Auth{
+		enable:      true,
+		userRoles:   make(map[string]Role, len(cfg.UserRoles)),
+		defaultRole: ParseRole(cfg.DefaultRole),
+	}
+	for user, role := range cfg.UserRoles {
+		a.userRoles[user] = ParseRole(role)
+	}
+
+	var checker passwordChecker
+	switch cfg.BasicAuthProvider {
+	case config.BasicAuthProviderHtpasswd:
+		htpasswd, err := loadHtpasswdFile(cfg.HtpasswdFile)
+		if err != nil {
+			return nil, err
+		}
+		checker = htpasswd
+	case config.BasicAuthProviderTiDB:
+		checker = newTiDBPasswordChecker(cfg.TiDBAddr)
+	}
+	if checker != nil {
+		a.basicAuth = true
+		a.authenticators = append(a.authenticators, &basicAuthenticator{checker: checker})
+	}
+
+	if cfg.ForwardSecretFile != "" {
+		secret, err := os.ReadFile(cfg.ForwardSecretFile)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrInvalidServerOption, err)
+		}
+		a.forwardSecret = []byte(strings.TrimSpace(string(secret)))
+		if len(a.forwardSecret) == 0 {
+			return nil, cerror.ErrInvalidServerOption.GenWithStack(
+				"forward secret file %s is empty", cfg.ForwardSecretFile)
+		}
+	}
+
+	if credential != nil && credential.IsTLSEnabled() {
+		selfCN, err := credential.SelfCommonName()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		a.authenticators = append(a.authenticators, &certAuthenticator{
+			identities:    cfg.CertIdentities,
+			selfCN:        selfCN,
+			forwardSecret: a.forwardSecret,
+		})
+	}
+	return a, nil
+}
+
+// roleOf returns the role of an authenticated user.
+func (a *Auth) roleOf(user string) Role {
+	if role, ok := a.userRoles[user]; ok {
+		return role
+	}
+	return a.defaultRole
+}
+
+// authenticate returns the user name of the client.
+func (a *Auth) authenticate(req *http.Request) (string, error) {
+	for _, authenticator := range a.authenticators {
+		user, ok, err := authenticator.Authenticate(req)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return user, nil
+		}
+	}
+	return "", cerror.ErrUnauthenticated.GenWithStackByArgs("no valid credential")
+}
+
+// AuthMiddleware authenticates the client of a request, and checks whether
+// its role is allowed to call the API. Reading APIs need the viewer role,
+// other APIs need the operator role, and the unsafe, admin and debug APIs
+// need the admin role. Every client is an admin if auth is disabled.
+func AuthMiddleware(auth *Auth) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if auth == nil || !auth.enable {
+			c.Set(ctxKeyRole, RoleAdmin)
+			c.Next()
+			return
+		}
+		path := c.Request.URL.Path
+		if hasPathPrefix(path, publicPaths) {
+			c.Next()
+			return
+		}
+
+		user, err := auth.authenticate(c.Request)
+		if err != nil {
+			if auth.basicAuth {
+				c.Header("WWW-Authenticate", `Basic realm="TiCDC"`)
+			}
+			code := http.StatusInternalServerError
+			if cerror.ErrUnauthenticated.Equal(err) {
+				code = http.StatusUnauthorized
+			}
+			abortWithError(c, code, err)
+			return
+		}
+		role := auth.roleOf(user)
+		c.Set(ctxKeyUser, user)
+		c.Set(ctxKeyRole, role)
+
+		required := RoleOperator
+		if hasPathPrefix(path, adminPaths) {
+			required = RoleAdmin
+		} else if !isMutatingMethod(c.Request.Method) {
+			required = RoleViewer
+		}
+		if role < required {
+			abortWithError(c, http.StatusForbidden,
+				cerror.ErrPermissionDenied.GenWithStackByArgs(fmt.Sprintf(
+					"user %s with role %s can not %s %s", user, role, c.Request.Method, path)))
+			return
+		}
+		// Let the owner know who the client is if the request is forwarded.
+		// The headers sent by the client are always overwritten or removed.
+		if auth.forwardSecret != nil {
+			c.Request.Header.Set(ForwardedUserHeader, user)
+			c.Request.Header.Set(ForwardedSignatureHeader, signForwardedUser(
+				auth.forwardSecret, c.Request, user, time.Now()))
+		} else {
+			c.Request.Header.Del(ForwardedUserHeader)
+			c.Request.Header.Del(ForwardedSignatureHeader)
+		}
+		c.Next()
+	}
+}
+
+// AuditLogMiddleware logs every mutating request and who sent it.
+func AuditLogMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !isMutatingMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+		c.Next()
+
+		var stdErr error
+		if err := c.Errors.Last(); err != nil {
+			stdErr = err.Err
+		}
+		role, _ := c.Get(ctxKeyRole)
+		roleName := RoleNone.String()
+		if r, ok := role.(Role); ok {
+			roleName = r.String()
+		}
+		log.Info("audit",
+			zap.String("user", c.GetString(ctxKeyUser)),
+			zap.String("role", roleName),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.String("ip", c.ClientIP()),
+			zap.Int("status", c.Writer.Status()),
+			zap.Error(stdErr),
+		)
+	}
+}
+
+// ConnContext stores the TLS connection state of a connection into the
+// context of its requests. It's needed because the HTTP server serves the
+// connections multiplexed by cmux, so http.Request.TLS is always nil.
+func ConnContext(ctx context.Context, conn net.Conn) context.Context {
+	if muxConn, ok := conn.(*cmux.MuxConn); ok {
+		conn = muxConn.Conn
+	}
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		state := tlsConn.ConnectionState()
+		return context.WithValue(ctx, tlsStateKey{}, &state)
+	}
+	return ctx
+}
+
+type tlsStateKey struct{}
+
+func tlsState(req *http.Request) *tls.ConnectionState {
+	if req.TLS != nil {
+		return req.TLS
+	}
+	state, _ := req.Context().Value(tlsStateKey{}).(*tls.ConnectionState)
+	return state
+}
+
+func abortWithError(c *gin.Context, code int, err error) {
+	_ = c.Error(err)
+	c.IndentedJSON(code, model.NewHTTPError(err))
+	c.Abort()
+}
+
+func hasPathPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if path == strings.TrimSuffix(prefix, "/") || strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// forwardedUserMAC returns the HMAC of a forwarded user. The method and path
+// of the request are covered, so a signature can't be used for other APIs.
+func forwardedUserMAC(secret []byte, req *http.Request, user string, ts int64) []byte {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%d", req.Method, req.URL.Path, user, ts)
+	return mac.Sum(nil)
+}
+
+// signForwardedUser returns the value of ForwardedSignatureHeader.
+func signForwardedUser(secret []byte, req *http.Request, user string, now time.Time) string {
+	ts := now.Unix()
+	return strconv.FormatInt(ts, 10) + ":" +
+		base64.StdEncoding.EncodeToString(forwardedUserMAC(secret, req, user, ts))
+}
+
+// verifyForwardedUser checks the signature of a forwarded user.
+func verifyForwardedUser(secret []byte, req *http.Request, user string, now time.Time) bool {
+	parts := strings.SplitN(req.Header.Get(ForwardedSignatureHeader), ":", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	ts, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > forwardedSignatureTTL || age < -forwardedSignatureTTL {
+		return false
+	}
+	sig, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(sig, forwardedUserMAC(secret, req, user, ts))
+}
+
+// certAuthenticator authenticates clients by their verified TLS certificates.
+type certAuthenticator struct {
+	identities    map[string]string
+	selfCN        string
+	forwardSecret []byte
+}
+
+// Authenticate implements Authenticator interface.
+func (a *certAuthenticator) Authenticate(req *http.Request) (string, bool, error) {
+	state := tlsState(req)
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false, nil
+	}
+	cn := state.VerifiedChains[0][0].Subject.CommonName
+	// Captures usually share a certificate, so the common name alone can't
+	// prove the request is forwarded by a capture, the signature is required.
+	if forwarded := req.Header.Get(ForwardedUserHeader); forwarded != "" &&
+		a.forwardSecret != nil && a.selfCN != "" && cn == a.selfCN {
+		if !verifyForwardedUser(a.forwardSecret, req, forwarded, time.Now()) {
+			return "", false, cerror.ErrUnauthenticated.GenWithStackByArgs(
+				"invalid signature of forwarded user")
+		}
+		return forwarded, true, nil
+	}
+	if user, ok := a.identities[cn]; ok {
+		return user, true, nil
+	}
+	return cn, true, nil
+}
+
+// passwordChecker checks the password of a user.
+type passwordChecker interface {
+	checkPassword(ctx context.Context, user, password string) error
+}
+
+// basicAuthenticator authenticates clients by HTTP basic auth.
+type basicAuthenticator struct {
+	checker passwordChecker
+}
+
+// Authenticate implements Authenticator interface.
+func (a *basicAuthenticator) Authenticate(req *http.Request) (string, bool, error) {
+	user, password, ok := req.BasicAuth()
+	if !ok {
+		return "", false, nil
+	}
+	if err := a.checker.checkPassword(req.Context(), user, password); err != nil {
+		return "", false, err
+	}
+	return user, true, nil
+}
+
+// htpasswd checks passwords against the entries of an htpasswd file.
+type htpasswd map[string]string
+
+func loadHtpasswdFile(path string) (htpasswd, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrInvalidServerOption, err)
+	}
+	defer file.Close()
+
+	entries := make(htpasswd)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, cerror.ErrInvalidServerOption.GenWithStack(
+				"invalid line in htpasswd file %s", path)
+		}
+		if !isBcryptHash(parts[1]) && !strings.HasPrefix(parts[1], "{SHA}") {
+			return nil, cerror.ErrInvalidServerOption.GenWithStack(
+				"unsupported password hash of user %s in htpasswd file %s, "+
+					"only bcrypt and SHA1 are supported", parts[0], path)
+		}
+		entries[parts[0]] = parts[1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrInvalidServerOption, err)
+	}
+	return entries, nil
+}
+
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") ||
+		strings.HasPrefix(hash, "$2b$") ||
+		strings.HasPrefix(hash, "$2y$")
+}
+
+func (h htpasswd) checkPassword(_ context.Context, user, password string) error {
+	hash, ok := h[user]
+	if !ok {
+		return cerror.ErrUnauthenticated.GenWithStackByArgs("invalid user name or password")
+	}
+	if isBcryptHash(hash) {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+			return cerror.ErrUnauthenticated.GenWithStackByArgs("invalid user name or password")
+		}
+		return nil
+	}
+	//nolint:gosec
+	sum := sha1.Sum([]byte(password))
+	expected := "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(expected)) != 1 {
+		return cerror.ErrUnauthenticated.GenWithStackByArgs("invalid user name or password")
+	}
+	return nil
+}
+
+// tidbPasswordChecker checks passwords by logging in to a TiDB server.
+// Successful logins are cached for a while to avoid connecting to TiDB for
+// every request.
+type tidbPasswordChecker struct {
+	addr string
+
+	mu    sync.Mutex
+	cache map[string]tidbLogin
+}
+
+type tidbLogin struct {
+	password [sha256.Size]byte
+	expire   time.Time
+}
+
+func newTiDBPasswordChecker(addr string) *tidbPasswordChecker {
+	return &tidbPasswordChecker{addr: addr, cache: make(map[string]tidbLogin)}
+}
+
+func (t *tidbPasswordChecker) checkPassword(ctx context.Context, user, password string) error {
+	sum := sha256.Sum256([]byte(password))
+	t.mu.Lock()
+	login, ok := t.cache[user]
+	t.mu.Unlock()
+	if ok && time.Now().Before(login.expire) &&
+		subtle.ConstantTimeCompare(login.password[:], sum[:]) == 1 {
+		return nil
+	}
+
+	dsnCfg := dmysql.NewConfig()
+	dsnCfg.User = user
+	dsnCfg.Passwd = password
+	dsnCfg.Net = "tcp"
+	dsnCfg.Addr = t.addr
+	dsnCfg.Timeout = tidbLoginTimeout
+	db, err := sql.Open("mysql", dsnCfg.FormatDSN())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(ctx, tidbLoginTimeout)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		if mysqlErr, ok := errors.Cause(err).(*dmysql.MySQLError); ok &&
+			mysqlErr.Number == mysqlerr.ER_ACCESS_DENIED_ERROR {
+			return cerror.ErrUnauthenticated.GenWithStackByArgs("invalid user name or password")
+		}
+		return errors.Annotate(err, "failed to log in to TiDB")
+	}
+
+	t.mu.Lock()
+	t.cache[user] = tidbLogin{password: sum, expire: time.Now().Add(tidbPasswordCacheTTL)}
+	t.mu.Unlock()
+	return nil
+}
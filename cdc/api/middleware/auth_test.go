@@ -0,0 +1,315 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newAuthTestRouter(auth *Auth) *gin.Engine {
+	router := gin.New()
+	router.Use(AuditLogMiddleware())
+	router.Use(AuthMiddleware(auth))
+	handler := func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(ctxKeyUser))
+	}
+	router.GET("/status", handler)
+	router.GET("/api/v2/changefeeds", handler)
+	router.POST("/api/v2/changefeeds", handler)
+	router.POST("/api/v2/unsafe/resolve_lock", handler)
+	return router
+}
+
+func doAuthTestRequest(
+	t *testing.T, router *gin.Engine, method, path, user, password string,
+) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, err := http.NewRequestWithContext(context.Background(), method, path, nil)
+	require.Nil(t, err)
+	if user != "" {
+		req.SetBasicAuth(user, password)
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthMiddlewareDisabled(t *testing.T) {
+	auth, err := NewAuth(&config.AuthConfig{}, nil)
+	require.Nil(t, err)
+	router := newAuthTestRouter(auth)
+	for _, path := range []string{"/api/v2/changefeeds", "/api/v2/unsafe/resolve_lock"} {
+		w := doAuthTestRequest(t, router, http.MethodPost, path, "", "")
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestAuthMiddlewareHtpasswd(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("admin-pass"), bcrypt.MinCost)
+	require.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "htpasswd")
+	content := "# comment\n" +
+		"alice:" + string(hash) + "\n" +
+		// htpasswd -nbs bob bob-pass
+		"bob:{SHA}eeQU2GomNu5hx6odcdNLXz4ZPOg=\n" +
+		"carol:{SHA}eeQU2GomNu5hx6odcdNLXz4ZPOg=\n"
+	require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+
+	cfg := &config.AuthConfig{
+		Enable:            true,
+		BasicAuthProvider: config.BasicAuthProviderHtpasswd,
+		HtpasswdFile:      path,
+		UserRoles: map[string]string{
+			"alice": config.AuthRoleAdmin,
+			"bob":   config.AuthRoleOperator,
+		},
+		DefaultRole: config.AuthRoleViewer,
+	}
+	auth, err := NewAuth(cfg, nil)
+	require.Nil(t, err)
+	router := newAuthTestRouter(auth)
+
+	// public paths
+	w := doAuthTestRequest(t, router, http.MethodGet, "/status", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// unauthenticated
+	w = doAuthTestRequest(t, router, http.MethodGet, "/api/v2/changefeeds", "", "")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Equal(t, `Basic realm="TiCDC"`, w.Header().Get("WWW-Authenticate"))
+	w = doAuthTestRequest(t, router, http.MethodGet, "/api/v2/changefeeds", "alice", "bob-pass")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	w = doAuthTestRequest(t, router, http.MethodGet, "/api/v2/changefeeds", "dave", "bob-pass")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+
+	testCases := []struct {
+		user     string
+		password string
+		method   string
+		path     string
+		code     int
+	}{
+		{"carol", "bob-pass", http.MethodGet, "/api/v2/changefeeds", http.StatusOK},
+		{"carol", "bob-pass", http.MethodPost, "/api/v2/changefeeds", http.StatusForbidden},
+		{"bob", "bob-pass", http.MethodPost, "/api/v2/changefeeds", http.StatusOK},
+		{"bob", "bob-pass", http.MethodPost, "/api/v2/unsafe/resolve_lock", http.StatusForbidden},
+		{"alice", "admin-pass", http.MethodPost, "/api/v2/unsafe/resolve_lock", http.StatusOK},
+	}
+	for _, tc := range testCases {
+		w := doAuthTestRequest(t, router, tc.method, tc.path, tc.user, tc.password)
+		require.Equal(t, tc.code, w.Code, "%v", tc)
+		if tc.code == http.StatusOK {
+			require.Equal(t, tc.user, w.Body.String())
+		}
+	}
+
+	// users are denied if there is no default role
+	cfg.DefaultRole = ""
+	auth, err = NewAuth(cfg, nil)
+	require.Nil(t, err)
+	router = newAuthTestRouter(auth)
+	w = doAuthTestRequest(t, router, http.MethodGet, "/api/v2/changefeeds", "carol", "bob-pass")
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestLoadHtpasswdFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "htpasswd")
+	require.Nil(t, os.WriteFile(path, []byte("alice:$apr1$xyz$abc\n"), 0o600))
+	_, err := loadHtpasswdFile(path)
+	require.Regexp(t, "unsupported password hash of user alice", err)
+
+	require.Nil(t, os.WriteFile(path, []byte("alice\n"), 0o600))
+	_, err = loadHtpasswdFile(path)
+	require.Regexp(t, "invalid line in htpasswd file", err)
+
+	_, err = loadHtpasswdFile(filepath.Join(t.TempDir(), "not-exist"))
+	require.Regexp(t, "ErrInvalidServerOption", err)
+}
+
+func TestCertAuthenticator(t *testing.T) {
+	authenticator := &certAuthenticator{
+		identities: map[string]string{"client-cn": "alice"},
+		selfCN:     "ticdc",
+	}
+	newRequest := func(cn string) *http.Request {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+		require.Nil(t, err)
+		if cn != "" {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+			req.TLS = &tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			}
+		}
+		return req
+	}
+
+	_, ok, err := authenticator.Authenticate(newRequest(""))
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	user, ok, err := authenticator.Authenticate(newRequest("client-cn"))
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, "alice", user)
+
+	user, ok, err = authenticator.Authenticate(newRequest("other-cn"))
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, "other-cn", user)
+
+	// The forwarded user is never trusted without a forward secret.
+	req := newRequest("ticdc")
+	req.Header.Set(ForwardedUserHeader, "bob")
+	user, _, _ = authenticator.Authenticate(req)
+	require.Equal(t, "ticdc", user)
+
+	// The forwarded user is only trusted if the request comes from a capture.
+	secret := []byte("secret")
+	authenticator.forwardSecret = secret
+	req = newRequest("other-cn")
+	req.Header.Set(ForwardedUserHeader, "bob")
+	req.Header.Set(ForwardedSignatureHeader, signForwardedUser(secret, req, "bob", time.Now()))
+	user, _, _ = authenticator.Authenticate(req)
+	require.Equal(t, "other-cn", user)
+	req = newRequest("ticdc")
+	req.Header.Set(ForwardedUserHeader, "bob")
+	req.Header.Set(ForwardedSignatureHeader, signForwardedUser(secret, req, "bob", time.Now()))
+	user, ok, err = authenticator.Authenticate(req)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, "bob", user)
+}
+
+func TestCertAuthenticatorForgedForwardedUser(t *testing.T) {
+	secret := []byte("secret")
+	authenticator := &certAuthenticator{selfCN: "ticdc", forwardSecret: secret}
+	// A client holding a certificate with the same common name as captures.
+	newRequest := func(path string) *http.Request {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, path, nil)
+		require.Nil(t, err)
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ticdc"}}
+		req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		req.Header.Set(ForwardedUserHeader, "admin")
+		return req
+	}
+	now := time.Now()
+	cases := []func(req *http.Request){
+		// no signature
+		func(req *http.Request) {},
+		// malformed signature
+		func(req *http.Request) { req.Header.Set(ForwardedSignatureHeader, "not-a-signature") },
+		// signed by another secret
+		func(req *http.Request) {
+			req.Header.Set(ForwardedSignatureHeader, signForwardedUser([]byte("guess"), req, "admin", now))
+		},
+		// signed for another user
+		func(req *http.Request) {
+			req.Header.Set(ForwardedSignatureHeader, signForwardedUser(secret, req, "viewer", now))
+		},
+		// signed for another API
+		func(req *http.Request) {
+			other := newRequest("/api/v2/changefeeds")
+			req.Header.Set(ForwardedSignatureHeader, signForwardedUser(secret, other, "admin", now))
+		},
+		// expired
+		func(req *http.Request) {
+			req.Header.Set(ForwardedSignatureHeader,
+				signForwardedUser(secret, req, "admin", now.Add(-2*forwardedSignatureTTL)))
+		},
+	}
+	for i, forge := range cases {
+		req := newRequest("/api/v2/unsafe/resolve_lock")
+		forge(req)
+		user, ok, err := authenticator.Authenticate(req)
+		require.True(t, cerror.ErrUnauthenticated.Equal(err), "case %d", i)
+		require.False(t, ok, "case %d", i)
+		require.Empty(t, user, "case %d", i)
+	}
+}
+
+func TestAuthMiddlewareForwardedUser(t *testing.T) {
+	dir := t.TempDir()
+	htpasswdPath := filepath.Join(dir, "htpasswd")
+	// htpasswd -nbs bob bob-pass
+	require.Nil(t, os.WriteFile(htpasswdPath, []byte("bob:{SHA}eeQU2GomNu5hx6odcdNLXz4ZPOg=\n"), 0o600))
+	secretPath := filepath.Join(dir, "secret")
+	require.Nil(t, os.WriteFile(secretPath, []byte("secret\n"), 0o600))
+
+	cfg := &config.AuthConfig{
+		Enable:            true,
+		BasicAuthProvider: config.BasicAuthProviderHtpasswd,
+		HtpasswdFile:      htpasswdPath,
+		DefaultRole:       config.AuthRoleOperator,
+		ForwardSecretFile: secretPath,
+	}
+	auth, err := NewAuth(cfg, nil)
+	require.Nil(t, err)
+	require.Equal(t, []byte("secret"), auth.forwardSecret)
+
+	var forwarded http.Header
+	router := gin.New()
+	router.Use(AuthMiddleware(auth))
+	router.POST("/api/v2/changefeeds", func(c *gin.Context) {
+		forwarded = c.Request.Header.Clone()
+	})
+	// The headers forged by the client are overwritten by the signed ones.
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/api/v2/changefeeds", nil)
+	require.Nil(t, err)
+	req.SetBasicAuth("bob", "bob-pass")
+	req.Header.Set(ForwardedUserHeader, "admin")
+	req.Header.Set(ForwardedSignatureHeader, "forged")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "bob", forwarded.Get(ForwardedUserHeader))
+	req.Header = forwarded
+	require.True(t, verifyForwardedUser([]byte("secret"), req, "bob", time.Now()))
+
+	// Without a forward secret, the headers are removed.
+	cfg.ForwardSecretFile = ""
+	auth, err = NewAuth(cfg, nil)
+	require.Nil(t, err)
+	router = gin.New()
+	router.Use(AuthMiddleware(auth))
+	router.POST("/api/v2/changefeeds", func(c *gin.Context) {
+		forwarded = c.Request.Header.Clone()
+	})
+	req.Header = http.Header{}
+	req.SetBasicAuth("bob", "bob-pass")
+	req.Header.Set(ForwardedUserHeader, "admin")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, forwarded.Get(ForwardedUserHeader))
+
+	// An empty secret file is rejected.
+	require.Nil(t, os.WriteFile(secretPath, []byte("\n"), 0o600))
+	cfg.ForwardSecretFile = secretPath
+	_, err = NewAuth(cfg, nil)
+	require.True(t, cerror.ErrInvalidServerOption.Equal(err))
+}
@@ -257,6 +257,7 @@ func (h *OpenAPI) GetChangefeed(c *gin.Context) {
 		ResolvedTs:     status.ResolvedTs,
 		Engine:         info.Engine,
 		FeedState:      info.State,
+		ErrorHis:       info.ErrorHis,
 		TaskStatus:     taskStatus,
 	}
 
@@ -155,6 +155,14 @@ func verifyCreateChangefeedConfig(
 	if err != nil {
 		return nil, err
 	}
+	err = sink.ValidateColumnSelectors(info.SinkURI, info.Config, tableInfos)
+	if err != nil {
+		return nil, err
+	}
+	err = sink.ValidateDispatchRules(info.SinkURI, info.Config, tableInfos)
+	if err != nil {
+		return nil, err
+	}
 	if !replicaConfig.ForceReplicate && !changefeedConfig.IgnoreIneligibleTable {
 		if len(ineligibleTables) != 0 {
 			return nil, cerror.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
@@ -43,13 +43,10 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	v2.Use(middleware.LogMiddleware())
 	v2.Use(middleware.ErrorHandleMiddleware())
 
-	// changefeed apis
-	changefeedGroup := v2.Group("/changefeeds")
-	changefeedGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
-	changefeedGroup.POST("", api.createChangefeed)
-	changefeedGroup.PUT("/:changefeed_id", api.updateChangefeed)
-	changefeedGroup.GET("/:changefeed_id/meta_info", api.getChangeFeedMetaInfo)
-	changefeedGroup.POST("/:changefeed_id/resume", api.resumeChangefeed)
+	registerChangefeedRoutes(v2, api)
+	// namespace-aware apis, the namespace in the path is used instead of
+	// the default namespace.
+	registerChangefeedRoutes(v2.Group("/namespaces/:namespace"), api)
 
 	verifyTableGroup := v2.Group("/verify_table")
 	verifyTableGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
@@ -62,6 +59,43 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	unsafeGroup.POST("/resolve_lock", api.ResolveLock)
 	unsafeGroup.DELETE("/service_gc_safepoint", api.DeleteServiceGcSafePoint)
 
+	// capture apis
+	captureGroup := v2.Group("/captures")
+	captureGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	captureGroup.GET("", api.listCaptures)
+	captureGroup.PUT("/drain", api.drainCapture)
+
+	// owner apis
+	ownerGroup := v2.Group("/owner")
+	ownerGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	ownerGroup.POST("/resign", api.resignOwner)
+
 	// common APIs
 	v2.POST("/tso", api.QueryTso)
 }
+
+// registerChangefeedRoutes registers changefeed and processor routes
+func registerChangefeedRoutes(group *gin.RouterGroup, api OpenAPIV2) {
+	// changefeed apis
+	changefeedGroup := group.Group("/changefeeds")
+	changefeedGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	changefeedGroup.GET("", api.listChangeFeeds)
+	changefeedGroup.POST("", api.createChangefeed)
+	changefeedGroup.GET("/:changefeed_id", api.getChangeFeed)
+	changefeedGroup.PUT("/:changefeed_id", api.updateChangefeed)
+	changefeedGroup.DELETE("/:changefeed_id", api.deleteChangefeed)
+	changefeedGroup.GET("/:changefeed_id/meta_info", api.getChangeFeedMetaInfo)
+	changefeedGroup.POST("/:changefeed_id/pause", api.pauseChangefeed)
+	changefeedGroup.POST("/:changefeed_id/resume", api.resumeChangefeed)
+	changefeedGroup.POST("/:changefeed_id/verify", api.verifyChangefeed)
+	changefeedGroup.GET("/:changefeed_id/verification", api.getChangefeedVerification)
+	changefeedGroup.POST("/:changefeed_id/ddl_error_handler", api.handleDDLError)
+	changefeedGroup.POST("/:changefeed_id/tables/rebalance_table", api.rebalanceTables)
+	changefeedGroup.POST("/:changefeed_id/tables/move_table", api.moveTable)
+
+	// processor apis
+	processorGroup := group.Group("/processors")
+	processorGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	processorGroup.GET("", api.listProcessors)
+	processorGroup.GET("/:changefeed_id/:capture_id", api.getProcessor)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/owner"
 	"github.com/pingcap/tiflow/cdc/sink"
+	"github.com/pingcap/tiflow/cdc/verification"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
@@ -100,6 +101,11 @@ type APIV2Helpers interface {
 		storage tidbkv.Storage, startTs uint64) (ineligibleTables,
 		eligibleTables []model.TableName, err error,
 	)
+
+	// verifyChangefeed wraps verification.Verify to increase testability
+	verifyChangefeed(ctx context.Context,
+		cfg *verification.Config,
+	) (*model.ChangefeedVerification, error)
 }
 
 // APIV2HelpersImpl is an implementation of AVIV2Helpers interface
@@ -138,8 +144,8 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 			"invalid namespace: %s", cfg.Namespace)
 	}
 
-	cfStatus, err := statusProvider.GetChangeFeedStatus(ctx,
-		model.DefaultChangeFeedID(cfg.ID))
+	changefeedID := model.ChangeFeedID{Namespace: cfg.Namespace, ID: cfg.ID}
+	cfStatus, err := statusProvider.GetChangeFeedStatus(ctx, changefeedID)
 	if err != nil && cerror.ErrChangeFeedNotExists.NotEqual(err) {
 		return nil, err
 	}
@@ -163,7 +169,7 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 		ctx,
 		pdClient,
 		ensureGCServiceID,
-		model.DefaultChangeFeedID(cfg.ID),
+		changefeedID,
 		ensureTTL, cfg.StartTs); err != nil {
 		if !cerror.ErrStartTsBeforeGC.Equal(err) {
 			return nil, cerror.ErrPDEtcdAPIError.Wrap(err)
@@ -227,6 +233,14 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 	if err != nil {
 		return nil, errors.Cause(err)
 	}
+	err = sink.ValidateColumnSelectors(cfg.SinkURI, replicaCfg, tableInfos)
+	if err != nil {
+		return nil, err
+	}
+	err = sink.ValidateDispatchRules(cfg.SinkURI, replicaCfg, tableInfos)
+	if err != nil {
+		return nil, err
+	}
 	if !replicaCfg.ForceReplicate && !cfg.ReplicaConfig.IgnoreIneligibleTable {
 		if err != nil {
 			return nil, err
@@ -335,6 +349,19 @@ func (APIV2HelpersImpl) verifyUpdateChangefeedConfig(
 			GenWithStackByArgs(errors.Cause(err).Error())
 	}
 
+	sinkURI := newInfo.SinkURI
+	if cfg.SinkURI != "" {
+		sinkURI = cfg.SinkURI
+	}
+	err = sink.ValidateColumnSelectors(sinkURI, newInfo.Config, tableInfos)
+	if err != nil {
+		return nil, nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
+	}
+	err = sink.ValidateDispatchRules(sinkURI, newInfo.Config, tableInfos)
+	if err != nil {
+		return nil, nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
+	}
+
 	// verify SinkURI
 	if cfg.SinkURI != "" {
 		newInfo.SinkURI = cfg.SinkURI
@@ -390,7 +417,7 @@ func (APIV2HelpersImpl) verifyResumeChangefeedConfig(ctx context.Context,
 		ctx,
 		pdClient,
 		gcServiceID,
-		model.DefaultChangeFeedID(changefeedID.ID),
+		changefeedID,
 		gcTTL, checkpointTs)
 	if err != nil {
 		if !cerror.ErrStartTsBeforeGC.Equal(err) {
@@ -452,3 +479,9 @@ func (h APIV2HelpersImpl) getVerfiedTables(replicaConfig *config.ReplicaConfig,
 		VerifyTables(f, storage, startTs)
 	return
 }
+
+func (h APIV2HelpersImpl) verifyChangefeed(ctx context.Context,
+	cfg *verification.Config,
+) (*model.ChangefeedVerification, error) {
+	return verification.Verify(ctx, cfg)
+}
@@ -12,6 +12,7 @@ import (
 	kv "github.com/pingcap/tidb/kv"
 	model "github.com/pingcap/tiflow/cdc/model"
 	owner "github.com/pingcap/tiflow/cdc/owner"
+	verification "github.com/pingcap/tiflow/cdc/verification"
 	config "github.com/pingcap/tiflow/pkg/config"
 	security "github.com/pingcap/tiflow/pkg/security"
 	client "github.com/tikv/pd/client"
@@ -86,6 +87,21 @@ func (mr *MockAPIV2HelpersMockRecorder) getVerfiedTables(replicaConfig, storage,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getVerfiedTables", reflect.TypeOf((*MockAPIV2Helpers)(nil).getVerfiedTables), replicaConfig, storage, startTs)
 }
 
+// verifyChangefeed mocks base method.
+func (m *MockAPIV2Helpers) verifyChangefeed(ctx context.Context, cfg *verification.Config) (*model.ChangefeedVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "verifyChangefeed", ctx, cfg)
+	ret0, _ := ret[0].(*model.ChangefeedVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// verifyChangefeed indicates an expected call of verifyChangefeed.
+func (mr *MockAPIV2HelpersMockRecorder) verifyChangefeed(ctx, cfg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "verifyChangefeed", reflect.TypeOf((*MockAPIV2Helpers)(nil).verifyChangefeed), ctx, cfg)
+}
+
 // verifyCreateChangefeedConfig mocks base method.
 func (m *MockAPIV2Helpers) verifyCreateChangefeedConfig(ctx context.Context, cfg *ChangefeedConfig, pdClient client.Client, statusProvider owner.StatusProvider, ensureGCServiceID string, kvStorage kv.Storage) (*model.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,105 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// listCaptures lists all captures
+func (h *OpenAPIV2) listCaptures(c *gin.Context) {
+	ctx := c.Request.Context()
+	captureInfos, err := h.capture.StatusProvider().GetCaptures(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// only owner handles api requests, so this must be the owner.
+	info, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ownerID := info.ID
+
+	captures := make([]Capture, 0, len(captureInfos))
+	for _, c := range captureInfos {
+		captures = append(captures, Capture{
+			ID:            c.ID,
+			IsOwner:       c.ID == ownerID,
+			AdvertiseAddr: c.AdvertiseAddr,
+		})
+	}
+	c.JSON(http.StatusOK, captures)
+}
+
+// drainCapture removes all tables at the given capture
+func (h *OpenAPIV2) drainCapture(c *gin.Context) {
+	req := &DrainCaptureRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	captures, err := h.capture.StatusProvider().GetCaptures(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// drain capture only work if there is at least two alive captures,
+	// it cannot work properly if it has only one capture.
+	if len(captures) <= 1 {
+		_ = c.Error(cerror.ErrSchedulerRequestFailed.
+			GenWithStackByArgs("only one capture alive"))
+		return
+	}
+
+	found := false
+	for _, capture := range captures {
+		if capture.ID == req.CaptureID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		_ = c.Error(cerror.ErrCaptureNotExist.GenWithStackByArgs(req.CaptureID))
+		return
+	}
+
+	// only owner handles api requests, so this must be the owner.
+	ownerInfo, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if ownerInfo.ID == req.CaptureID {
+		_ = c.Error(cerror.ErrSchedulerRequestFailed.
+			GenWithStackByArgs("cannot drain the owner"))
+		return
+	}
+
+	resp, err := api.HandleOwnerDrainCapture(ctx, h.capture, req.CaptureID)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusServiceUnavailable, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, &DrainCaptureResp{
+		CurrentTableCount: resp.CurrentTableCount,
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	"github.com/pingcap/tiflow/cdc/scheduler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCaptures(t *testing.T) {
+	t.Parallel()
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	router := newRouter(NewOpenAPIV2ForTest(cp, nil))
+
+	statusProvider := &mockStatusProvider{
+		captures: []*model.CaptureInfo{
+			{ID: "capture-1", AdvertiseAddr: "127.0.0.1:8300"},
+			{ID: "capture-2", AdvertiseAddr: "127.0.0.1:8301"},
+		},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), "GET", "/api/v2/captures", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp []Capture
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, []Capture{
+		{ID: "capture-1", IsOwner: true, AdvertiseAddr: "127.0.0.1:8300"},
+		{ID: "capture-2", IsOwner: false, AdvertiseAddr: "127.0.0.1:8301"},
+	}, resp)
+}
+
+func TestDrainCapture(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	mo := mock_owner.NewMockOwner(ctrl)
+	router := newRouter(NewOpenAPIV2ForTest(cp, nil))
+
+	statusProvider := &mockStatusProvider{
+		captures: []*model.CaptureInfo{{ID: "capture-1"}},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+	cp.EXPECT().GetOwner().Return(mo, nil).AnyTimes()
+
+	drain := func(captureID string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(&DrainCaptureRequest{CaptureID: captureID})
+		require.Nil(t, err)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), "PUT",
+			"/api/v2/captures/drain", bytes.NewReader(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// only one capture alive
+	w := drain("capture-1")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	statusProvider.captures = append(statusProvider.captures, &model.CaptureInfo{ID: "capture-2"})
+	// the capture does not exist
+	w = drain("capture-3")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrCaptureNotExist")
+
+	// cannot drain the owner
+	w = drain("capture-1")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	mo.EXPECT().DrainCapture(gomock.Any(), gomock.Any()).
+		Do(func(query *scheduler.Query, done chan<- error) {
+			require.Equal(t, "capture-2", query.CaptureID)
+			query.Resp = &model.DrainCaptureResp{CurrentTableCount: 3}
+			close(done)
+		})
+	w = drain("capture-2")
+	require.Equal(t, http.StatusAccepted, w.Code)
+	resp := &DrainCaptureResp{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, 3, resp.CurrentTableCount)
+}
@@ -15,7 +15,10 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -25,15 +28,56 @@ import (
 	"github.com/pingcap/tiflow/cdc/api"
 	"github.com/pingcap/tiflow/cdc/capture"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/verification"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 )
 
-const apiOpVarChangefeedID = "changefeed_id"
+const (
+	// apiOpVarChangefeedID is the key of changefeed ID in HTTP API
+	apiOpVarChangefeedID = "changefeed_id"
+	// apiOpVarNamespace is the key of namespace in HTTP API
+	apiOpVarNamespace = "namespace"
+	// apiOpVarChangefeedState is the key of changefeed state in HTTP API
+	apiOpVarChangefeedState = "state"
+	// apiOpVarCaptureID is the key of capture ID in HTTP API
+	apiOpVarCaptureID = "capture_id"
+)
+
+// getNamespace returns the namespace in the request path, it's empty if the
+// request is not namespace-aware.
+func getNamespace(c *gin.Context) string {
+	return c.Param(apiOpVarNamespace)
+}
+
+// getChangefeedID returns the changefeed ID in the request path,
+// the default namespace is used if the request is not namespace-aware.
+func getChangefeedID(c *gin.Context) (model.ChangeFeedID, error) {
+	namespace := getNamespace(c)
+	if namespace == "" {
+		namespace = model.DefaultNamespace
+	}
+	if err := model.ValidateNamespace(namespace); err != nil {
+		return model.ChangeFeedID{}, cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid namespace: %s", namespace)
+	}
+	changefeedID := model.ChangeFeedID{
+		Namespace: namespace,
+		ID:        c.Param(apiOpVarChangefeedID),
+	}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		return model.ChangeFeedID{}, cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid changefeed_id: %s", changefeedID.ID)
+	}
+	return changefeedID, nil
+}
 
 // createChangefeed handles create changefeed request,
 // it returns the changefeed's changefeedInfo that it just created
@@ -45,6 +89,15 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
 		return
 	}
+	if namespace := getNamespace(c); namespace != "" {
+		if cfg.Namespace != "" && cfg.Namespace != namespace {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+				"namespace %s in the body mismatches namespace %s in the path",
+				cfg.Namespace, namespace))
+			return
+		}
+		cfg.Namespace = namespace
+	}
 	if len(cfg.PDAddrs) == 0 {
 		up, err := getCaptureDefaultUpstream(h.capture)
 		if err != nil {
@@ -93,7 +146,7 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 			ctx,
 			pdClient,
 			h.capture.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceCreating),
-			model.DefaultChangeFeedID(cfg.ID),
+			model.ChangeFeedID{Namespace: info.Namespace, ID: info.ID},
 		)
 		if err != nil {
 			_ = c.Error(err)
@@ -118,7 +171,7 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 	err = h.capture.GetEtcdClient().CreateChangefeedInfo(ctx,
 		upstreamInfo,
 		info,
-		model.DefaultChangeFeedID(info.ID))
+		model.ChangeFeedID{Namespace: info.Namespace, ID: info.ID})
 	if err != nil {
 		needRemoveGCSafePoint = true
 		_ = c.Error(err)
@@ -184,13 +237,13 @@ func (h *OpenAPIV2) verifyTable(c *gin.Context) {
 // Can only update a changefeed's: TargetTs, SinkURI,
 // ReplicaConfig, PDAddrs, CAPath, CertPath, KeyPath,
 // SyncPointEnabled, SyncPointInterval
+// The throttle config can also be updated when the changefeed is running.
 func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
-	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
-		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
-			changefeedID.ID))
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 
@@ -200,8 +253,7 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 		return
 	}
 	if cfInfo.State != model.StateStopped {
-		_ = c.Error(cerror.ErrChangefeedUpdateRefused.
-			GenWithStackByArgs("can only update changefeed config when it is stopped"))
+		h.updateRunningChangefeed(c, cfInfo, changefeedID)
 		return
 	}
 	cfStatus, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
@@ -223,6 +275,7 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
 		return
 	}
+	restoreMaskedUpstreamURI(updateCfConfig.ReplicaConfig, cfInfo.Config)
 
 	if err = h.helpers.verifyUpstream(ctx, updateCfConfig, cfInfo); err != nil {
 		_ = c.Error(errors.Trace(err))
@@ -273,14 +326,137 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 	c.JSON(http.StatusOK, toAPIModel(newCfInfo, true))
 }
 
+// updateRunningChangefeed updates the throttle config of a running changefeed,
+// the processors apply it without restarting the changefeed. The throttle
+// config is updated on the latest changefeed info, so the changes made by the
+// owner concurrently, e.g. the state and the error, are not reverted.
+func (h *OpenAPIV2) updateRunningChangefeed(
+	c *gin.Context, cfInfo *model.ChangeFeedInfo, changefeedID model.ChangeFeedID,
+) {
+	ctx := c.Request.Context()
+	refused := cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+		"can only update changefeed config when it is stopped, except the throttle config")
+
+	updateCfConfig := &ChangefeedConfig{}
+	updateCfConfig.ReplicaConfig = ToAPIReplicaConfig(cfInfo.Config)
+	if err := c.BindJSON(updateCfConfig); err != nil {
+		_ = c.Error(refused)
+		return
+	}
+	restoreMaskedUpstreamURI(updateCfConfig.ReplicaConfig, cfInfo.Config)
+	newReplicaConfig := updateCfConfig.ReplicaConfig.ToInternalReplicaConfig()
+
+	newCfInfo, err := h.capture.GetEtcdClient().UpdateChangeFeedInfo(ctx, changefeedID,
+		func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, error) {
+			// Everything except the throttle config must be unchanged.
+			expected := ToAPIReplicaConfig(info.Config).ToInternalReplicaConfig()
+			expected.Throttle = newReplicaConfig.Throttle
+			if !reflect.DeepEqual(expected, newReplicaConfig) ||
+				(updateCfConfig.TargetTs != 0 && updateCfConfig.TargetTs != info.TargetTs) ||
+				(updateCfConfig.SinkURI != "" && updateCfConfig.SinkURI != info.SinkURI) ||
+				(updateCfConfig.Engine != "" && updateCfConfig.Engine != info.Engine) ||
+				len(updateCfConfig.PDAddrs) != 0 {
+				return nil, refused
+			}
+			newInfo, err := info.Clone()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			newInfo.Config.Throttle = newReplicaConfig.Throttle
+			if newInfo.Config.Throttle != nil &&
+				!config.GetGlobalServerConfig().Debug.EnableNewSink {
+				// The running processors would never apply it.
+				return nil, cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+					"throttle can not be used when the new sink is disabled")
+			}
+			if _, err := config.GetSinkURIAndAdjustConfigWithSinkURI(
+				newInfo.SinkURI, newInfo.Config); err != nil {
+				return nil, err
+			}
+			return newInfo, nil
+		})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("update the throttle config of a running changefeed",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Any("throttle", newCfInfo.Config.Throttle))
+	c.JSON(http.StatusOK, toAPIModel(newCfInfo, true))
+}
+
+// handleDDLError sets the handler of the DDLs with the given commit ts of a
+// changefeed, it returns all the DDL error handlers of the changefeed.
+// The handler takes effect when the changefeed is resumed.
+func (h *OpenAPIV2) handleDDLError(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	handler := new(DDLErrorHandler)
+	if err := c.BindJSON(handler); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	internalHandler := handler.ToInternalDDLErrorHandler()
+	if err := internalHandler.Validate(); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cfInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cfInfo.State != model.StateStopped && cfInfo.State != model.StateFailed {
+		_ = c.Error(cerror.ErrChangefeedUpdateRefused.
+			GenWithStackByArgs("can only handle ddl error when the changefeed is stopped or failed"))
+		return
+	}
+	if internalHandler.Op != model.DDLErrorHandleRevert {
+		cfStatus, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		if internalHandler.CommitTs < cfStatus.CheckpointTs {
+			_ = c.Error(cerror.ErrInvalidDDLErrorHandler.GenWithStackByArgs(
+				fmt.Sprintf("commit ts %d is less than the checkpoint ts %d",
+					internalHandler.CommitTs, cfStatus.CheckpointTs)))
+			return
+		}
+	}
+
+	cfInfo.SetDDLErrorHandler(internalHandler)
+	log.Info("set ddl error handler",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Any("handler", internalHandler))
+	err = h.capture.GetEtcdClient().SaveChangeFeedInfo(ctx, cfInfo, changefeedID)
+	if err != nil {
+		_ = c.Error(errors.Trace(err))
+		return
+	}
+	handlers := ToAPIDDLErrorHandlers(cfInfo.DDLErrorHandlers)
+	if handlers == nil {
+		handlers = []*DDLErrorHandler{}
+	}
+	c.JSON(http.StatusOK, handlers)
+}
+
 // getChangeFeedMetaInfo returns the metaInfo of a changefeed
 func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
-	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
-		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
-			changefeedID.ID))
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
 		return
 	}
 	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
@@ -294,11 +470,9 @@ func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 // resumeChangefeed handles update changefeed request.
 func (h *OpenAPIV2) resumeChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
-	err := model.ValidateChangefeedID(changefeedID.ID)
+	changefeedID, err := getChangefeedID(c)
 	if err != nil {
-		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
-			changefeedID.ID))
+		_ = c.Error(err)
 		return
 	}
 
@@ -375,16 +549,338 @@ func (h *OpenAPIV2) resumeChangefeed(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
-func toAPIModel(info *model.ChangeFeedInfo, maskSinkURI bool) *ChangeFeedInfo {
-	var runningError *RunningError
-	if info.Error != nil {
-		runningError = &RunningError{
-			Addr:    info.Error.Addr,
-			Code:    info.Error.Code,
-			Message: info.Error.Message,
+// verifyChangefeed compares the upstream and downstream data of a changefeed
+// at its latest syncpoint, the result is recorded in etcd and returned.
+func (h *OpenAPIV2) verifyChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cfInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cfg := new(VerifyChangefeedConfig)
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	replicaConfig := cfInfo.Config.Clone()
+	if cfg.UpstreamURI != "" || cfg.ChunkSize != 0 {
+		if replicaConfig.Verification == nil {
+			replicaConfig.Verification = &config.VerificationConfig{}
+		}
+		if cfg.UpstreamURI != "" {
+			replicaConfig.Verification.UpstreamURI = cfg.UpstreamURI
+		}
+		if cfg.ChunkSize != 0 {
+			replicaConfig.Verification.ChunkSize = cfg.ChunkSize
+		}
+	}
+
+	result, err := h.helpers.verifyChangefeed(ctx, &verification.Config{
+		ChangefeedID:  changefeedID,
+		SinkURI:       cfInfo.SinkURI,
+		ReplicaConfig: replicaConfig,
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	err = h.capture.GetEtcdClient().PutChangefeedVerification(ctx, changefeedID, result)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, ToAPIChangefeedVerification(result))
+}
+
+// getChangefeedVerification returns the latest verification result of a changefeed
+func (h *OpenAPIV2) getChangefeedVerification(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	_, err = h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	result, err := h.capture.GetEtcdClient().GetChangefeedVerification(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, ToAPIChangefeedVerification(result))
+}
+
+// listChangeFeeds lists all changefeeds, only changefeeds in the namespace
+// are listed if the request is namespace-aware.
+func (h *OpenAPIV2) listChangeFeeds(c *gin.Context) {
+	ctx := c.Request.Context()
+	state := c.Query(apiOpVarChangefeedState)
+	namespace := getNamespace(c)
+	statuses, err := h.capture.StatusProvider().GetAllChangeFeedStatuses(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	infos, err := h.capture.StatusProvider().GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	changefeeds := make([]model.ChangeFeedID, 0, len(statuses))
+	for cfID := range statuses {
+		if namespace != "" && cfID.Namespace != namespace {
+			continue
+		}
+		changefeeds = append(changefeeds, cfID)
+	}
+	sort.Slice(changefeeds, func(i, j int) bool {
+		if changefeeds[i].Namespace == changefeeds[j].Namespace {
+			return changefeeds[i].ID < changefeeds[j].ID
+		}
+		return changefeeds[i].Namespace < changefeeds[j].Namespace
+	})
+
+	resps := make([]ChangefeedCommonInfo, 0, len(changefeeds))
+	for _, cfID := range changefeeds {
+		cfInfo, exist := infos[cfID]
+		if !exist {
+			// If a changefeed info does not exists, skip it
+			continue
+		}
+		if !cfInfo.State.IsNeeded(state) {
+			continue
+		}
+		resp := ChangefeedCommonInfo{
+			UpstreamID: cfInfo.UpstreamID,
+			Namespace:  cfID.Namespace,
+			ID:         cfID.ID,
+			FeedState:  cfInfo.State,
 		}
+		if cfInfo.State != model.StateNormal {
+			resp.RunningError = toAPIRunningError(cfInfo.Error)
+		}
+		if cfStatus := statuses[cfID]; cfStatus != nil {
+			resp.CheckpointTSO = cfStatus.CheckpointTs
+			resp.CheckpointTime = model.JSONTime(oracle.GetTimeFromTS(cfStatus.CheckpointTs))
+		}
+		resps = append(resps, resp)
+	}
+	c.JSON(http.StatusOK, resps)
+}
+
+// getChangeFeed returns the detail information of a changefeed
+func (h *OpenAPIV2) getChangeFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	status, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	var taskStatus []CaptureTaskStatus
+	if info.State == model.StateNormal {
+		processorInfos, err := h.capture.StatusProvider().
+			GetAllTaskStatuses(ctx, changefeedID)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		for captureID, status := range processorInfos {
+			tables := make([]int64, 0, len(status.Tables))
+			for tableID := range status.Tables {
+				tables = append(tables, tableID)
+			}
+			sort.Slice(tables, func(i, j int) bool { return tables[i] < tables[j] })
+			taskStatus = append(taskStatus,
+				CaptureTaskStatus{CaptureID: captureID, Tables: tables})
+		}
+		sort.Slice(taskStatus, func(i, j int) bool {
+			return taskStatus[i].CaptureID < taskStatus[j].CaptureID
+		})
+	}
+	sinkURI, err := util.MaskSinkURI(info.SinkURI)
+	if err != nil {
+		log.Error("failed to mask sink URI", zap.Error(err))
+	}
+
+	detail := &ChangefeedDetail{
+		UpstreamID:     info.UpstreamID,
+		Namespace:      changefeedID.Namespace,
+		ID:             changefeedID.ID,
+		SinkURI:        sinkURI,
+		CreateTime:     model.JSONTime(info.CreateTime),
+		StartTs:        info.StartTs,
+		TargetTs:       info.TargetTs,
+		Engine:         info.Engine,
+		FeedState:      info.State,
+		RunningError:   toAPIRunningError(info.Error),
+		ErrorHis:       info.ErrorHis,
+		CreatorVersion: info.CreatorVersion,
+		TaskStatus:     taskStatus,
+	}
+	if status != nil {
+		detail.ResolvedTs = status.ResolvedTs
+		detail.CheckpointTSO = status.CheckpointTs
+		detail.CheckpointTime = model.JSONTime(oracle.GetTimeFromTS(status.CheckpointTs))
+	}
+	c.JSON(http.StatusOK, detail)
+}
+
+// pauseChangefeed handles pause changefeed request
+func (h *OpenAPIV2) pauseChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// check if the changefeed exists
+	_, err = h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	job := model.AdminJob{
+		CfID: changefeedID,
+		Type: model.AdminStop,
 	}
+	if err := api.HandleOwnerJob(ctx, h.capture, job); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
 
+// deleteChangefeed handles delete changefeed request,
+// it returns after the changefeed is removed by the owner.
+func (h *OpenAPIV2) deleteChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// check if the changefeed exists
+	_, err = h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	job := model.AdminJob{
+		CfID: changefeedID,
+		Type: model.AdminRemove,
+	}
+	if err := api.HandleOwnerJob(ctx, h.capture, job); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	// Owner needs at least two ticks to remove a changefeed,
+	// we need to wait for it.
+	err = retry.Do(ctx, func() error {
+		_, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+		if err != nil {
+			if cerror.ErrChangeFeedNotExists.Equal(err) {
+				return nil
+			}
+			return err
+		}
+		return cerror.ErrChangeFeedDeletionUnfinished.GenWithStackByArgs(changefeedID)
+	},
+		retry.WithMaxTries(100),         // max retry duration is 1 minute
+		retry.WithBackoffBaseDelay(600), // default owner tick interval is 200ms
+		retry.WithIsRetryableErr(cerror.IsRetryableError))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// rebalanceTables rebalances all tables of a changefeed among captures
+func (h *OpenAPIV2) rebalanceTables(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// check if the changefeed exists
+	_, err = h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if err := api.HandleOwnerBalance(ctx, h.capture, changefeedID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// moveTable moves a table of a changefeed to the target capture
+func (h *OpenAPIV2) moveTable(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// check if the changefeed exists
+	_, err = h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	req := &MoveTableReq{}
+	if err := c.BindJSON(req); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if err := model.ValidateChangefeedID(req.CaptureID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid capture_id: %s", req.CaptureID))
+		return
+	}
+
+	err = api.HandleOwnerScheduleTable(
+		ctx, h.capture, changefeedID, req.CaptureID, req.TableID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+func toAPIModel(info *model.ChangeFeedInfo, maskSinkURI bool) *ChangeFeedInfo {
 	sinkURI := info.SinkURI
 	var err error
 	if maskSinkURI {
@@ -406,12 +902,41 @@ func toAPIModel(info *model.ChangeFeedInfo, maskSinkURI bool) *ChangeFeedInfo {
 		Engine:         info.Engine,
 		Config:         ToAPIReplicaConfig(info.Config),
 		State:          info.State,
-		Error:          runningError,
+		Error:          toAPIRunningError(info.Error),
 		CreatorVersion: info.CreatorVersion,
+
+		DDLErrorHandlers: ToAPIDDLErrorHandlers(info.DDLErrorHandlers),
+	}
+	if apiInfoModel.Config.Verification != nil {
+		// The upstream uri may contain the password of the upstream TiDB, it's
+		// always masked, see restoreMaskedUpstreamURI for updating a changefeed.
+		upstreamURI, err := util.MaskSinkURI(apiInfoModel.Config.Verification.UpstreamURI)
+		if err != nil {
+			log.Error("failed to mask upstream URI", zap.Error(err))
+		}
+		apiInfoModel.Config.Verification.UpstreamURI = upstreamURI
 	}
 	return apiInfoModel
 }
 
+// restoreMaskedUpstreamURI restores the verification upstream uri of the
+// changefeed if it's not changed by an update request. The upstream uri
+// returned by the API is masked, so a client which reads and writes back
+// the config sends the masked one.
+func restoreMaskedUpstreamURI(cfg *ReplicaConfig, old *config.ReplicaConfig) {
+	if cfg == nil || cfg.Verification == nil ||
+		old.Verification == nil || old.Verification.UpstreamURI == "" {
+		return
+	}
+	masked, err := util.MaskSinkURI(old.Verification.UpstreamURI)
+	if err != nil {
+		return
+	}
+	if cfg.Verification.UpstreamURI == masked {
+		cfg.Verification.UpstreamURI = old.Verification.UpstreamURI
+	}
+}
+
 func getCaptureDefaultUpstream(cp capture.Capture) (*upstream.Upstream, error) {
 	upManager, err := cp.GetUpstreamManager()
 	if err != nil {
@@ -89,17 +89,24 @@ type ChangefeedConfig struct {
 
 // ReplicaConfig is a duplicate of  config.ReplicaConfig
 type ReplicaConfig struct {
-	CaseSensitive         bool              `json:"case_sensitive"`
-	EnableOldValue        bool              `json:"enable_old_value"`
-	ForceReplicate        bool              `json:"force_replicate"`
-	IgnoreIneligibleTable bool              `json:"ignore_ineligible_table"`
-	CheckGCSafePoint      bool              `json:"check_gc_safe_point"`
-	EnableSyncPoint       bool              `json:"enable_sync_point"`
-	SyncPointInterval     time.Duration     `json:"sync_point_interval"`
-	SyncPointRetention    time.Duration     `json:"sync_point_retention"`
-	Filter                *FilterConfig     `json:"filter"`
-	Sink                  *SinkConfig       `json:"sink"`
-	Consistent            *ConsistentConfig `json:"consistent"`
+	CaseSensitive         bool                       `json:"case_sensitive"`
+	EnableOldValue        bool                       `json:"enable_old_value"`
+	ForceReplicate        bool                       `json:"force_replicate"`
+	IgnoreIneligibleTable bool                       `json:"ignore_ineligible_table"`
+	CheckGCSafePoint      bool                       `json:"check_gc_safe_point"`
+	EnableSyncPoint       bool                       `json:"enable_sync_point"`
+	SyncPointInterval     time.Duration              `json:"sync_point_interval"`
+	SyncPointRetention    time.Duration              `json:"sync_point_retention"`
+	Filter                *FilterConfig              `json:"filter"`
+	Sink                  *SinkConfig                `json:"sink"`
+	Consistent            *ConsistentConfig          `json:"consistent"`
+	Verification          *VerificationConfig        `json:"verification"`
+	Scheduler             *ChangefeedSchedulerConfig `json:"scheduler"`
+	Throttle              *ThrottleConfig            `json:"throttle"`
+	Bidirectional         *BidirectionalConfig       `json:"bidirectional"`
+	// EmitVirtualGeneratedColumns is whether to emit the values of virtual
+	// generated columns
+	EmitVirtualGeneratedColumns bool `json:"emit_virtual_generated_columns"`
 }
 
 // ToInternalReplicaConfig coverts *v2.ReplicaConfig into *config.ReplicaConfig
@@ -108,6 +115,7 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 	res.CaseSensitive = c.CaseSensitive
 	res.EnableOldValue = c.EnableOldValue
 	res.ForceReplicate = c.ForceReplicate
+	res.EmitVirtualGeneratedColumns = c.EmitVirtualGeneratedColumns
 	res.CheckGCSafePoint = c.CheckGCSafePoint
 	res.EnableSyncPoint = c.EnableSyncPoint
 	res.SyncPointInterval = c.SyncPointInterval
@@ -158,6 +166,41 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 			MaxLogSize:        c.Consistent.MaxLogSize,
 			FlushIntervalInMs: c.Consistent.FlushIntervalInMs,
 			Storage:           c.Consistent.Storage,
+			Compression:       c.Consistent.Compression,
+			EncryptionKeyFile: c.Consistent.EncryptionKeyFile,
+		}
+	}
+	if c.Verification != nil {
+		res.Verification = &config.VerificationConfig{
+			UpstreamURI: c.Verification.UpstreamURI,
+			Interval:    c.Verification.Interval,
+			ChunkSize:   c.Verification.ChunkSize,
+		}
+	}
+	if c.Scheduler != nil {
+		res.Scheduler = &config.ChangefeedSchedulerConfig{
+			EnableTableAcrossNodes: c.Scheduler.EnableTableAcrossNodes,
+			RegionThreshold:        c.Scheduler.RegionThreshold,
+			WriteKeyThreshold:      c.Scheduler.WriteKeyThreshold,
+		}
+	}
+	if c.Throttle != nil {
+		res.Throttle = &config.ThrottleConfig{
+			ThrottleLimits: c.Throttle.ThrottleLimits.toInternal(),
+		}
+		for _, w := range c.Throttle.Schedule {
+			res.Throttle.Schedule = append(res.Throttle.Schedule, &config.ThrottleWindow{
+				Start:          w.Start,
+				End:            w.End,
+				ThrottleLimits: w.ThrottleLimits.toInternal(),
+			})
+		}
+	}
+	if c.Bidirectional != nil {
+		res.Bidirectional = &config.BidirectionalConfig{
+			ReplicationID:        c.Bidirectional.ReplicationID,
+			FilterReplicationIDs: c.Bidirectional.FilterReplicationIDs,
+			SyncDDL:              c.Bidirectional.SyncDDL,
 		}
 	}
 	if c.Sink != nil {
@@ -168,6 +211,7 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 				DispatcherRule: "",
 				PartitionRule:  rule.PartitionRule,
 				TopicRule:      rule.TopicRule,
+				Columns:        rule.Columns,
 			})
 		}
 		var columnSelectors []*config.ColumnSelector
@@ -177,12 +221,27 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 				Columns: selector.Columns,
 			})
 		}
+		var csvConfig *config.CSVConfig
+		if c.Sink.CSVConfig != nil {
+			csvConfig = &config.CSVConfig{
+				Delimiter:       c.Sink.CSVConfig.Delimiter,
+				Quote:           c.Sink.CSVConfig.Quote,
+				NullString:      c.Sink.CSVConfig.NullString,
+				IncludeCommitTs: c.Sink.CSVConfig.IncludeCommitTs,
+			}
+		}
 		res.Sink = &config.SinkConfig{
 			DispatchRules:   dispatchRules,
 			Protocol:        c.Sink.Protocol,
 			TxnAtomicity:    config.AtomicityLevel(c.Sink.TxnAtomicity),
 			ColumnSelectors: columnSelectors,
 			SchemaRegistry:  c.Sink.SchemaRegistry,
+			CSVConfig:       csvConfig,
+			DateSeparator:   c.Sink.DateSeparator,
+
+			ClaimCheckStorageURI: c.Sink.ClaimCheckStorageURI,
+			SchemaChangeTopic:    c.Sink.SchemaChangeTopic,
+			GlobalTxnMemoryQuota: c.Sink.GlobalTxnMemoryQuota,
 		}
 	}
 	return res
@@ -200,6 +259,8 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 		EnableSyncPoint:       cloned.EnableSyncPoint,
 		SyncPointInterval:     cloned.SyncPointInterval,
 		SyncPointRetention:    cloned.SyncPointRetention,
+
+		EmitVirtualGeneratedColumns: cloned.EmitVirtualGeneratedColumns,
 	}
 
 	if cloned.Filter != nil {
@@ -250,6 +311,7 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Matcher:       rule.Matcher,
 				PartitionRule: rule.PartitionRule,
 				TopicRule:     rule.TopicRule,
+				Columns:       rule.Columns,
 			})
 		}
 		var columnSelectors []*ColumnSelector
@@ -259,12 +321,27 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Columns: selector.Columns,
 			})
 		}
+		var csvConfig *CSVConfig
+		if cloned.Sink.CSVConfig != nil {
+			csvConfig = &CSVConfig{
+				Delimiter:       cloned.Sink.CSVConfig.Delimiter,
+				Quote:           cloned.Sink.CSVConfig.Quote,
+				NullString:      cloned.Sink.CSVConfig.NullString,
+				IncludeCommitTs: cloned.Sink.CSVConfig.IncludeCommitTs,
+			}
+		}
 		res.Sink = &SinkConfig{
 			Protocol:        cloned.Sink.Protocol,
 			SchemaRegistry:  cloned.Sink.SchemaRegistry,
 			DispatchRules:   dispatchRules,
 			ColumnSelectors: columnSelectors,
 			TxnAtomicity:    string(cloned.Sink.TxnAtomicity),
+			CSVConfig:       csvConfig,
+			DateSeparator:   cloned.Sink.DateSeparator,
+
+			ClaimCheckStorageURI: cloned.Sink.ClaimCheckStorageURI,
+			SchemaChangeTopic:    cloned.Sink.SchemaChangeTopic,
+			GlobalTxnMemoryQuota: cloned.Sink.GlobalTxnMemoryQuota,
 		}
 	}
 	if cloned.Consistent != nil {
@@ -273,6 +350,41 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			MaxLogSize:        cloned.Consistent.MaxLogSize,
 			FlushIntervalInMs: cloned.Consistent.FlushIntervalInMs,
 			Storage:           cloned.Consistent.Storage,
+			Compression:       cloned.Consistent.Compression,
+			EncryptionKeyFile: cloned.Consistent.EncryptionKeyFile,
+		}
+	}
+	if cloned.Verification != nil {
+		res.Verification = &VerificationConfig{
+			UpstreamURI: cloned.Verification.UpstreamURI,
+			Interval:    cloned.Verification.Interval,
+			ChunkSize:   cloned.Verification.ChunkSize,
+		}
+	}
+	if cloned.Scheduler != nil {
+		res.Scheduler = &ChangefeedSchedulerConfig{
+			EnableTableAcrossNodes: cloned.Scheduler.EnableTableAcrossNodes,
+			RegionThreshold:        cloned.Scheduler.RegionThreshold,
+			WriteKeyThreshold:      cloned.Scheduler.WriteKeyThreshold,
+		}
+	}
+	if cloned.Throttle != nil {
+		res.Throttle = &ThrottleConfig{
+			ThrottleLimits: toAPIThrottleLimits(cloned.Throttle.ThrottleLimits),
+		}
+		for _, w := range cloned.Throttle.Schedule {
+			res.Throttle.Schedule = append(res.Throttle.Schedule, &ThrottleWindow{
+				Start:          w.Start,
+				End:            w.End,
+				ThrottleLimits: toAPIThrottleLimits(w.ThrottleLimits),
+			})
+		}
+	}
+	if cloned.Bidirectional != nil {
+		res.Bidirectional = &BidirectionalConfig{
+			ReplicationID:        cloned.Bidirectional.ReplicationID,
+			FilterReplicationIDs: cloned.Bidirectional.FilterReplicationIDs,
+			SyncDDL:              cloned.Bidirectional.SyncDDL,
 		}
 	}
 	return res
@@ -395,6 +507,21 @@ type SinkConfig struct {
 	DispatchRules   []*DispatchRule   `json:"dispatchers,omitempty"`
 	ColumnSelectors []*ColumnSelector `json:"column_selectors"`
 	TxnAtomicity    string            `json:"transaction_atomicity"`
+	CSVConfig       *CSVConfig        `json:"csv,omitempty"`
+	DateSeparator   string            `json:"date_separator,omitempty"`
+
+	ClaimCheckStorageURI string `json:"claim_check_storage_uri,omitempty"`
+	SchemaChangeTopic    string `json:"schema_change_topic,omitempty"`
+	GlobalTxnMemoryQuota uint64 `json:"global_txn_memory_quota,omitempty"`
+}
+
+// CSVConfig denotes the csv config
+// This is the same as config.CSVConfig
+type CSVConfig struct {
+	Delimiter       string `json:"delimiter"`
+	Quote           string `json:"quote"`
+	NullString      string `json:"null"`
+	IncludeCommitTs bool   `json:"include_commit_ts"`
 }
 
 // DispatchRule represents partition rule for a table
@@ -403,6 +530,7 @@ type DispatchRule struct {
 	Matcher       []string `json:"matcher,omitempty"`
 	PartitionRule string   `json:"partition"`
 	TopicRule     string   `json:"topic"`
+	Columns       []string `json:"columns,omitempty"`
 }
 
 // ColumnSelector represents a column selector for a table.
@@ -419,6 +547,143 @@ type ConsistentConfig struct {
 	MaxLogSize        int64  `json:"max_log_size"`
 	FlushIntervalInMs int64  `json:"flush_interval"`
 	Storage           string `json:"storage"`
+	Compression       string `json:"compression"`
+	EncryptionKeyFile string `json:"encryption_key_file"`
+}
+
+// VerificationConfig represents the data verification config for a changefeed
+// This is a duplicate of config.VerificationConfig
+type VerificationConfig struct {
+	UpstreamURI string        `json:"upstream_uri"`
+	Interval    time.Duration `json:"interval"`
+	ChunkSize   int           `json:"chunk_size"`
+}
+
+// ChangefeedSchedulerConfig represents the scheduler config of a changefeed
+// This is a duplicate of config.ChangefeedSchedulerConfig
+type ChangefeedSchedulerConfig struct {
+	EnableTableAcrossNodes bool `json:"enable_table_across_nodes"`
+	RegionThreshold        int  `json:"region_threshold"`
+	WriteKeyThreshold      int  `json:"write_key_threshold"`
+}
+
+// ThrottleLimits are the throughput limits of a changefeed
+// This is a duplicate of config.ThrottleLimits
+type ThrottleLimits struct {
+	RowsPerSecond  int64 `json:"rows_per_second"`
+	BytesPerSecond int64 `json:"bytes_per_second"`
+	MaxConnections int   `json:"max_connections"`
+}
+
+func (l ThrottleLimits) toInternal() config.ThrottleLimits {
+	return config.ThrottleLimits{
+		RowsPerSecond:  l.RowsPerSecond,
+		BytesPerSecond: l.BytesPerSecond,
+		MaxConnections: l.MaxConnections,
+	}
+}
+
+func toAPIThrottleLimits(l config.ThrottleLimits) ThrottleLimits {
+	return ThrottleLimits{
+		RowsPerSecond:  l.RowsPerSecond,
+		BytesPerSecond: l.BytesPerSecond,
+		MaxConnections: l.MaxConnections,
+	}
+}
+
+// ThrottleWindow overrides the default throttle limits during a time of day window
+// This is a duplicate of config.ThrottleWindow
+type ThrottleWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	ThrottleLimits
+}
+
+// ThrottleConfig represents the throughput throttle config of a changefeed
+// This is a duplicate of config.ThrottleConfig
+type ThrottleConfig struct {
+	ThrottleLimits
+	Schedule []*ThrottleWindow `json:"schedule"`
+}
+
+// BidirectionalConfig represents the bidirectional replication config of a changefeed
+// This is a duplicate of config.BidirectionalConfig
+type BidirectionalConfig struct {
+	ReplicationID        uint64   `json:"replication_id"`
+	FilterReplicationIDs []uint64 `json:"filter_replication_ids"`
+	SyncDDL              bool     `json:"sync_ddl"`
+}
+
+// VerifyChangefeedConfig is used to verify a changefeed on demand, the non-empty
+// fields override the verification config of the changefeed.
+type VerifyChangefeedConfig struct {
+	UpstreamURI string `json:"upstream_uri"`
+	ChunkSize   int    `json:"chunk_size"`
+}
+
+// ChangefeedVerification is the result of a changefeed verification
+// This is a duplicate of model.ChangefeedVerification
+type ChangefeedVerification struct {
+	PrimaryTs   uint64          `json:"primary_ts"`
+	SecondaryTs uint64          `json:"secondary_ts"`
+	StartTime   time.Time       `json:"start_time"`
+	EndTime     time.Time       `json:"end_time"`
+	Consistent  bool            `json:"consistent"`
+	Mismatches  []TableMismatch `json:"mismatches,omitempty"`
+}
+
+// TableMismatch describes a table whose upstream and downstream data differ
+type TableMismatch struct {
+	Schema             string             `json:"database_name"`
+	Table              string             `json:"table_name"`
+	UpstreamChecksum   string             `json:"upstream_checksum"`
+	DownstreamChecksum string             `json:"downstream_checksum"`
+	MissingDownstream  bool               `json:"missing_downstream,omitempty"`
+	Ranges             []KeyRangeMismatch `json:"ranges,omitempty"`
+}
+
+// KeyRangeMismatch describes a key range whose upstream and downstream data differ
+type KeyRangeMismatch struct {
+	Columns            []string `json:"columns"`
+	LowerBound         []string `json:"lower_bound,omitempty"`
+	UpperBound         []string `json:"upper_bound,omitempty"`
+	UpstreamChecksum   string   `json:"upstream_checksum"`
+	DownstreamChecksum string   `json:"downstream_checksum"`
+	UpstreamRowCount   int64    `json:"upstream_row_count"`
+	DownstreamRowCount int64    `json:"downstream_row_count"`
+}
+
+// ToAPIChangefeedVerification converts *model.ChangefeedVerification into *v2.ChangefeedVerification
+func ToAPIChangefeedVerification(v *model.ChangefeedVerification) *ChangefeedVerification {
+	res := &ChangefeedVerification{
+		PrimaryTs:   v.PrimaryTs,
+		SecondaryTs: v.SecondaryTs,
+		StartTime:   v.StartTime,
+		EndTime:     v.EndTime,
+		Consistent:  v.Consistent,
+	}
+	for _, m := range v.Mismatches {
+		tm := TableMismatch{
+			Schema:             m.Schema,
+			Table:              m.Table,
+			UpstreamChecksum:   m.UpstreamChecksum,
+			DownstreamChecksum: m.DownstreamChecksum,
+			MissingDownstream:  m.MissingDownstream,
+		}
+		for _, r := range m.Ranges {
+			tm.Ranges = append(tm.Ranges, KeyRangeMismatch{
+				Columns:            r.Columns,
+				LowerBound:         r.LowerBound,
+				UpperBound:         r.UpperBound,
+				UpstreamChecksum:   r.UpstreamChecksum,
+				DownstreamChecksum: r.DownstreamChecksum,
+				UpstreamRowCount:   r.UpstreamRowCount,
+				DownstreamRowCount: r.DownstreamRowCount,
+			})
+		}
+		res.Mismatches = append(res.Mismatches, tm)
+	}
+	return res
 }
 
 // EtcdData contains key/value pair of etcd data
@@ -452,6 +717,42 @@ type ChangeFeedInfo struct {
 	State          model.FeedState    `json:"state,omitempty"`
 	Error          *RunningError      `json:"error,omitempty"`
 	CreatorVersion string             `json:"creator_version,omitempty"`
+	// DDLErrorHandlers decide how the DDLs failed to execute downstream are handled
+	DDLErrorHandlers []*DDLErrorHandler `json:"ddl_error_handlers,omitempty"`
+}
+
+// DDLErrorHandler handles the DDL events with the given commit ts, the
+// supported operations are skip, replace, inject and revert.
+// This is a duplicate of model.DDLErrorHandler
+type DDLErrorHandler struct {
+	CommitTs uint64   `json:"commit_ts"`
+	Op       string   `json:"op"`
+	SQLs     []string `json:"sqls,omitempty"`
+}
+
+// ToInternalDDLErrorHandler converts the api model to model.DDLErrorHandler
+func (h *DDLErrorHandler) ToInternalDDLErrorHandler() *model.DDLErrorHandler {
+	return &model.DDLErrorHandler{
+		CommitTs: h.CommitTs,
+		Op:       model.DDLErrorHandleOp(h.Op),
+		SQLs:     h.SQLs,
+	}
+}
+
+// ToAPIDDLErrorHandlers converts model.DDLErrorHandler slice to the api model
+func ToAPIDDLErrorHandlers(handlers []*model.DDLErrorHandler) []*DDLErrorHandler {
+	if len(handlers) == 0 {
+		return nil
+	}
+	res := make([]*DDLErrorHandler, 0, len(handlers))
+	for _, h := range handlers {
+		res = append(res, &DDLErrorHandler{
+			CommitTs: h.CommitTs,
+			Op:       string(h.Op),
+			SQLs:     h.SQLs,
+		})
+	}
+	return res
 }
 
 // RunningError represents some running error from cdc components, such as processor.
@@ -505,3 +806,96 @@ type UpstreamConfig struct {
 	ID uint64 `json:"id"`
 	PDConfig
 }
+
+// ChangefeedCommonInfo holds some common used information of a changefeed
+type ChangefeedCommonInfo struct {
+	UpstreamID     uint64          `json:"upstream_id"`
+	Namespace      string          `json:"namespace"`
+	ID             string          `json:"id"`
+	FeedState      model.FeedState `json:"state"`
+	CheckpointTSO  uint64          `json:"checkpoint_tso"`
+	CheckpointTime model.JSONTime  `json:"checkpoint_time"`
+	RunningError   *RunningError   `json:"error"`
+}
+
+// ChangefeedDetail holds the detail information of a changefeed
+type ChangefeedDetail struct {
+	UpstreamID     uint64              `json:"upstream_id"`
+	Namespace      string              `json:"namespace"`
+	ID             string              `json:"id"`
+	SinkURI        string              `json:"sink_uri"`
+	CreateTime     model.JSONTime      `json:"create_time"`
+	StartTs        uint64              `json:"start_ts"`
+	ResolvedTs     uint64              `json:"resolved_ts"`
+	TargetTs       uint64              `json:"target_ts"`
+	CheckpointTSO  uint64              `json:"checkpoint_tso"`
+	CheckpointTime model.JSONTime      `json:"checkpoint_time"`
+	Engine         string              `json:"sort_engine,omitempty"`
+	FeedState      model.FeedState     `json:"state"`
+	RunningError   *RunningError       `json:"error"`
+	ErrorHis       []int64             `json:"error_history"`
+	CreatorVersion string              `json:"creator_version"`
+	TaskStatus     []CaptureTaskStatus `json:"task_status,omitempty"`
+}
+
+// CaptureTaskStatus holds the tables replicated by a capture
+type CaptureTaskStatus struct {
+	CaptureID string  `json:"capture_id"`
+	Tables    []int64 `json:"table_ids"`
+}
+
+// MoveTableReq is used by move table api
+type MoveTableReq struct {
+	CaptureID string `json:"capture_id"`
+	TableID   int64  `json:"table_id"`
+}
+
+// ProcessorCommonInfo holds the common information of a processor
+type ProcessorCommonInfo struct {
+	Namespace    string `json:"namespace"`
+	ChangefeedID string `json:"changefeed_id"`
+	CaptureID    string `json:"capture_id"`
+}
+
+// ProcessorDetail holds the detail information of a processor
+type ProcessorDetail struct {
+	// The maximum event CommitTs that has been synchronized.
+	CheckpointTs uint64 `json:"checkpoint_ts"`
+	// The event that satisfies CommitTs <= ResolvedTs can be synchronized.
+	ResolvedTs uint64 `json:"resolved_ts"`
+	// All table ids that this processor are replicating.
+	Tables []int64 `json:"table_ids"`
+	// The count of events that have been replicated.
+	Count uint64 `json:"count"`
+	// Error when error happens
+	Error *RunningError `json:"error"`
+}
+
+// Capture holds the common information of a capture
+type Capture struct {
+	ID            string `json:"id"`
+	IsOwner       bool   `json:"is_owner"`
+	AdvertiseAddr string `json:"address"`
+}
+
+// DrainCaptureRequest is used by drain capture api
+type DrainCaptureRequest struct {
+	CaptureID string `json:"capture_id"`
+}
+
+// DrainCaptureResp is the response of drain capture api
+type DrainCaptureResp struct {
+	CurrentTableCount int `json:"current_table_count"`
+}
+
+// toAPIRunningError converts *model.RunningError into *v2.RunningError
+func toAPIRunningError(err *model.RunningError) *RunningError {
+	if err == nil {
+		return nil
+	}
+	return &RunningError{
+		Addr:    err.Addr,
+		Code:    err.Code,
+		Message: err.Message,
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	filter "github.com/pingcap/tidb/util/table-filter"
@@ -53,6 +54,25 @@ func TestToAPIReplicaConfig(t *testing.T) {
 		FlushIntervalInMs: 10,
 		Storage:           "s3",
 	}
+	cfg.Verification = &config.VerificationConfig{
+		UpstreamURI: "mysql://root@127.0.0.1:4000/",
+		Interval:    time.Hour,
+		ChunkSize:   100,
+	}
+	cfg.Throttle = &config.ThrottleConfig{
+		ThrottleLimits: config.ThrottleLimits{RowsPerSecond: 1000, MaxConnections: 4},
+		Schedule: []*config.ThrottleWindow{{
+			Start:          "09:00",
+			End:            "18:00",
+			ThrottleLimits: config.ThrottleLimits{BytesPerSecond: 1024},
+		}},
+	}
+	cfg.Bidirectional = &config.BidirectionalConfig{
+		ReplicationID:        1,
+		FilterReplicationIDs: []uint64{2},
+		SyncDDL:              true,
+	}
+	cfg.EmitVirtualGeneratedColumns = true
 	cfg.Filter = &config.FilterConfig{
 		Rules: []string{"a", "b", "c"},
 		MySQLReplicationRules: &filter.MySQLReplicationRules{
@@ -0,0 +1,122 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// listProcessors lists all processors, only processors of changefeeds in the
+// namespace are listed if the request is namespace-aware.
+func (h *OpenAPIV2) listProcessors(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespace(c)
+	infos, err := h.capture.StatusProvider().GetProcessors(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resps := make([]ProcessorCommonInfo, 0, len(infos))
+	for _, info := range infos {
+		if namespace != "" && info.CfID.Namespace != namespace {
+			continue
+		}
+		resps = append(resps, ProcessorCommonInfo{
+			Namespace:    info.CfID.Namespace,
+			ChangefeedID: info.CfID.ID,
+			CaptureID:    info.CaptureID,
+		})
+	}
+	c.JSON(http.StatusOK, resps)
+}
+
+// getProcessor returns the detail information of a processor
+func (h *OpenAPIV2) getProcessor(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID, err := getChangefeedID(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	captureID := c.Param(apiOpVarCaptureID)
+	if err := model.ValidateChangefeedID(captureID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid capture_id: %s", captureID))
+		return
+	}
+
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if info.State != model.StateNormal {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"changefeed in abnormal state: %s, "+
+				"can't get processors of an abnormal changefeed", info.State))
+		return
+	}
+	// check if the processor exists
+	procInfos, err := h.capture.StatusProvider().GetProcessors(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	found := false
+	for _, info := range procInfos {
+		if info.CfID == changefeedID && info.CaptureID == captureID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		_ = c.Error(cerror.ErrCaptureNotExist.GenWithStackByArgs(captureID))
+		return
+	}
+
+	statuses, err := h.capture.StatusProvider().GetAllTaskStatuses(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	status, statusExist := statuses[captureID]
+	positions, err := h.capture.StatusProvider().GetTaskPositions(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	position, positionExist := positions[captureID]
+
+	// Note: for the case that no tables are attached to a newly created
+	// changefeed, we just do not report an error.
+	detail := &ProcessorDetail{Tables: []int64{}}
+	if statusExist && positionExist {
+		detail.CheckpointTs = position.CheckPointTs
+		detail.ResolvedTs = position.ResolvedTs
+		detail.Count = position.Count
+		detail.Error = toAPIRunningError(position.Error)
+		for tableID := range status.Tables {
+			detail.Tables = append(detail.Tables, tableID)
+		}
+		sort.Slice(detail.Tables, func(i, j int) bool {
+			return detail.Tables[i] < detail.Tables[j]
+		})
+	}
+	c.JSON(http.StatusOK, detail)
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListProcessors(t *testing.T) {
+	t.Parallel()
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	router := newRouter(NewOpenAPIV2ForTest(cp, nil))
+
+	statusProvider := &mockStatusProvider{
+		processors: []*model.ProcInfoSnap{
+			{CfID: changeFeedID, CaptureID: "capture-1"},
+			{CfID: model.ChangeFeedID{Namespace: "ns", ID: "cf"}, CaptureID: "capture-2"},
+		},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	list := func(url string) []ProcessorCommonInfo {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), "GET", url, nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp []ProcessorCommonInfo
+		require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+		return resp
+	}
+	require.Len(t, list("/api/v2/processors"), 2)
+	require.Equal(t, []ProcessorCommonInfo{
+		{Namespace: "ns", ChangefeedID: "cf", CaptureID: "capture-2"},
+	}, list("/api/v2/namespaces/ns/processors"))
+}
+
+func TestGetProcessor(t *testing.T) {
+	t.Parallel()
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	router := newRouter(NewOpenAPIV2ForTest(cp, nil))
+
+	statusProvider := &mockStatusProvider{
+		changefeedInfo: &model.ChangeFeedInfo{State: model.StateNormal},
+		processors: []*model.ProcInfoSnap{
+			{CfID: changeFeedID, CaptureID: "capture-1"},
+		},
+		taskStatuses: map[model.CaptureID]*model.TaskStatus{
+			"capture-1": {Tables: map[model.TableID]*model.TableReplicaInfo{2: {}, 1: {}}},
+		},
+		taskPositions: map[model.CaptureID]*model.TaskPosition{
+			"capture-1": {CheckPointTs: 1, ResolvedTs: 2, Count: 3},
+		},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	get := func(url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), "GET", url, nil)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/api/v2/processors/test-changeFeed/capture-1")
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ProcessorDetail{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, &ProcessorDetail{
+		CheckpointTs: 1,
+		ResolvedTs:   2,
+		Tables:       []int64{1, 2},
+		Count:        3,
+	}, resp)
+
+	// the processor belongs to the changefeed in the default namespace
+	w = get("/api/v2/namespaces/ns/processors/test-changeFeed/capture-1")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrCaptureNotExist")
+
+	statusProvider.changefeedInfo.State = model.StateStopped
+	w = get("/api/v2/processors/test-changeFeed/capture-1")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -30,9 +30,12 @@ import (
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pfilter "github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
@@ -76,6 +79,9 @@ type mounterImpl struct {
 	metricMountDuration          prometheus.Observer
 	metricTotalRows              prometheus.Gauge
 	metricIgnoredDMLEventCounter prometheus.Counter
+
+	// virtualColumns is nil if virtual generated columns are not emitted.
+	virtualColumns *virtualColumnEvaluator
 }
 
 // NewMounter creates a mounter
@@ -84,12 +90,18 @@ func NewMounter(schemaStorage SchemaStorage,
 	tz *time.Location,
 	filter pfilter.Filter,
 	enableOldValue bool,
+	emitVirtualGeneratedColumns bool,
 ) Mounter {
+	var virtualColumns *virtualColumnEvaluator
+	if emitVirtualGeneratedColumns {
+		virtualColumns = newVirtualColumnEvaluator(util.GetTimeZoneName(tz))
+	}
 	return &mounterImpl{
 		schemaStorage:  schemaStorage,
 		changefeedID:   changefeedID,
 		enableOldValue: enableOldValue,
 		filter:         filter,
+		virtualColumns: virtualColumns,
 		metricMountDuration: mountDuration.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricTotalRows: totalRowsCountGauge.
@@ -179,6 +191,11 @@ func (m *mounterImpl) unmarshalAndMountRowChanged(ctx context.Context, raw *mode
 			if err != nil {
 				return nil, err
 			}
+			// The rows of the mark table are only pulled by the changefeeds of
+			// bidirectional replication, and they are filtered by the sink node.
+			if bidirectional.IsMarkTable(row.Table.Schema, row.Table.Table) {
+				return row, nil
+			}
 			// We need to filter a row here because we need its tableInfo.
 			ignore, err := m.filter.ShouldIgnoreDMLEvent(row, rawRow, tableInfo)
 			if err != nil {
@@ -362,6 +379,20 @@ func (m *mounterImpl) mountRowKVEntry(tableInfo *model.TableInfo, row *rowKVEntr
 		}
 	}
 
+	_, _, colInfos := tableInfo.GetRowColInfos()
+
+	// The pre columns are incomplete if the old value is disabled.
+	if m.virtualColumns != nil && row.PreRowExist && m.enableOldValue {
+		var infos []rowcodec.ColInfo
+		preCols, infos, err = m.virtualColumns.appendVirtualColumns(tableInfo, preCols, preRawCols)
+		if err != nil {
+			return nil, rawRow, errors.Trace(err)
+		}
+		if infos != nil {
+			colInfos = infos
+		}
+	}
+
 	var cols []*model.Column
 	var rawCols []types.Datum
 	if row.RowExist {
@@ -369,6 +400,16 @@ func (m *mounterImpl) mountRowKVEntry(tableInfo *model.TableInfo, row *rowKVEntr
 		if err != nil {
 			return nil, rawRow, errors.Trace(err)
 		}
+		if m.virtualColumns != nil {
+			var infos []rowcodec.ColInfo
+			cols, infos, err = m.virtualColumns.appendVirtualColumns(tableInfo, cols, rawCols)
+			if err != nil {
+				return nil, rawRow, errors.Trace(err)
+			}
+			if infos != nil {
+				colInfos = infos
+			}
+		}
 	}
 
 	schemaName := tableInfo.TableName.Schema
@@ -386,8 +427,6 @@ func (m *mounterImpl) mountRowKVEntry(tableInfo *model.TableInfo, row *rowKVEntr
 		tableInfoVersion = tableInfo.TableInfoVersion
 	}
 
-	_, _, colInfos := tableInfo.GetRowColInfos()
-
 	rawRow.PreRowDatums = preRawCols
 	rawRow.RowDatums = rawCols
 	return &model.RowChangedEvent{
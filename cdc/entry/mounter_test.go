@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	"github.com/pingcap/tiflow/pkg/config"
 	pfilter "github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/regionspan"
@@ -297,7 +298,7 @@ func testMounterDisableOldValue(t *testing.T, tc struct {
 	require.Nil(t, err)
 	mounter := NewMounter(scheamStorage,
 		model.DefaultChangeFeedID("c1"),
-		time.UTC, filter, false).(*mounterImpl)
+		time.UTC, filter, false, false).(*mounterImpl)
 	mounter.tz = time.Local
 	ctx := context.Background()
 
@@ -1004,7 +1005,7 @@ func TestDecodeEventIgnoreRow(t *testing.T) {
 
 	ts := schemaStorage.GetLastSnapshot().CurrentTs()
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
-	mounter := NewMounter(schemaStorage, cfID, time.Local, filter, true).(*mounterImpl)
+	mounter := NewMounter(schemaStorage, cfID, time.Local, filter, true, false).(*mounterImpl)
 
 	type testCase struct {
 		schema  string
@@ -1087,3 +1088,140 @@ func TestDecodeEventIgnoreRow(t *testing.T) {
 		decodeAndCheckRowInTable(tableInfo.ID, toRawKV)
 	}
 }
+
+func TestDecodeEventWithVirtualGeneratedColumns(t *testing.T) {
+	helper := NewSchemaTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test;")
+
+	cfID := model.DefaultChangeFeedID("changefeed-test-virtual-columns")
+	cfg := config.GetDefaultReplicaConfig()
+	filter, err := pfilter.NewFilter(cfg, "")
+	require.Nil(t, err)
+	ver, err := helper.Storage().CurrentVersion(oracle.GlobalTxnScope)
+	require.Nil(t, err)
+	schemaStorage, err := NewSchemaStorage(helper.GetCurrentMeta(),
+		ver.Ver, false, cfID)
+	require.Nil(t, err)
+	job := helper.DDL2Job("create table test.person(" +
+		"id int primary key, info json, " +
+		"name varchar(20) as (json_unquote(json_extract(info, '$.name'))) virtual, " +
+		"stored_id int as (id + 1) stored, " +
+		"label varchar(40) as (concat(name, '-', stored_id)) virtual)")
+	require.Nil(t, schemaStorage.HandleDDLJob(job))
+	ts := schemaStorage.GetLastSnapshot().CurrentTs()
+	schemaStorage.AdvanceResolvedTs(ver.Ver)
+
+	tableInfo, ok := schemaStorage.GetLastSnapshot().TableByName("test", "person")
+	require.True(t, ok)
+	helper.Tk().MustExec(`insert into test.person(id, info) values (1, '{"name": "alice"}')`)
+
+	ctx := context.Background()
+	toRawKV := func(key []byte, value []byte) *model.RawKVEntry {
+		return &model.RawKVEntry{
+			OpType:  model.OpTypePut,
+			Key:     key,
+			Value:   value,
+			StartTs: ts - 1,
+			CRTs:    ts + 1,
+		}
+	}
+
+	// Virtual generated columns are skipped by default.
+	mounter := NewMounter(schemaStorage, cfID, time.Local, filter, true, false).(*mounterImpl)
+	rows := 0
+	walkTableSpanInStore(t, helper.Storage(), tableInfo.ID, func(key []byte, value []byte) {
+		row, err := mounter.unmarshalAndMountRowChanged(ctx, toRawKV(key, value))
+		require.Nil(t, err)
+		rows++
+		require.Len(t, row.Columns, 3)
+		for _, col := range row.Columns {
+			require.NotEqual(t, "name", col.Name)
+			require.NotEqual(t, "label", col.Name)
+		}
+	})
+	require.Equal(t, 1, rows)
+
+	// Virtual generated columns are appended after the other columns.
+	mounter = NewMounter(schemaStorage, cfID, time.Local, filter, true, true).(*mounterImpl)
+	rows = 0
+	walkTableSpanInStore(t, helper.Storage(), tableInfo.ID, func(key []byte, value []byte) {
+		row, err := mounter.unmarshalAndMountRowChanged(ctx, toRawKV(key, value))
+		require.Nil(t, err)
+		rows++
+		require.Len(t, row.Columns, 5)
+		require.Len(t, row.ColInfos, 5)
+		names := make([]string, 0, len(row.Columns))
+		for i, col := range row.Columns {
+			names = append(names, col.Name)
+			require.Equal(t, col.Type, row.ColInfos[i].Ft.GetType())
+		}
+		require.Equal(t, []string{"id", "info", "stored_id", "name", "label"}, names)
+		require.Equal(t, []byte("alice"), row.Columns[3].Value)
+		require.True(t, row.Columns[3].Flag.IsGeneratedColumn())
+		require.Equal(t, []byte("alice-2"), row.Columns[4].Value)
+		require.True(t, row.Columns[4].Flag.IsGeneratedColumn())
+		// The index columns still refer to the right columns.
+		require.Equal(t, [][]int{{0}}, row.IndexColumns)
+	})
+	require.Equal(t, 1, rows)
+}
+
+func TestDecodeEventMarkTableCreatedAfterCheckpoint(t *testing.T) {
+	helper := NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	cfID := model.DefaultChangeFeedID("changefeed-test-mark-table")
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Filter.Rules = []string{"test.*"}
+	filter, err := pfilter.NewFilter(cfg, "")
+	require.Nil(t, err)
+	// The changefeed starts before the mark table is created by the
+	// changefeed of the reverse direction.
+	ver, err := helper.Storage().CurrentVersion(oracle.GlobalTxnScope)
+	require.Nil(t, err)
+	schemaStorage, err := NewSchemaStorage(helper.GetCurrentMeta(),
+		ver.Ver, false, cfID)
+	require.Nil(t, err)
+	_, ok := schemaStorage.GetLastSnapshot().TableIDByName(
+		bidirectional.SchemaName, bidirectional.MarkTableName)
+	require.False(t, ok)
+
+	// The DDLs of the mark table pass the filter of the DDL puller.
+	sqls := bidirectional.CreateMarkTableSQLs()
+	job := helper.DDL2Job(sqls[0])
+	require.False(t, filter.ShouldDiscardDDL(job.Type, bidirectional.SchemaName, ""))
+	require.Nil(t, schemaStorage.HandleDDLJob(job))
+	job = helper.DDL2Job(sqls[1])
+	require.False(t, filter.ShouldDiscardDDL(job.Type,
+		bidirectional.SchemaName, job.BinlogInfo.TableInfo.Name.O))
+	require.Nil(t, schemaStorage.HandleDDLJob(job))
+	ts := schemaStorage.GetLastSnapshot().CurrentTs()
+	schemaStorage.AdvanceResolvedTs(ver.Ver)
+
+	markTableID, ok := schemaStorage.GetLastSnapshot().TableIDByName(
+		bidirectional.SchemaName, bidirectional.MarkTableName)
+	require.True(t, ok)
+	query, args := bidirectional.UpdateMarkSQL("test", "t1", 0, 2)
+	helper.Tk().MustExec(query, args...)
+
+	ctx := context.Background()
+	mounter := NewMounter(schemaStorage, cfID, time.Local, filter, true, false).(*mounterImpl)
+	rows := 0
+	walkTableSpanInStore(t, helper.Storage(), markTableID, func(key []byte, value []byte) {
+		row, err := mounter.unmarshalAndMountRowChanged(ctx, &model.RawKVEntry{
+			OpType:  model.OpTypePut,
+			Key:     key,
+			Value:   value,
+			StartTs: ts - 1,
+			CRTs:    ts + 1,
+		})
+		require.Nil(t, err)
+		require.NotNil(t, row)
+		rows++
+		require.Equal(t, bidirectional.MarkTableName, row.Table.Table)
+		require.Equal(t, bidirectional.ReplicationIDColumn, row.Columns[1].Name)
+		require.Equal(t, uint64(2), row.Columns[1].Value)
+	})
+	require.Equal(t, 1, rows)
+}
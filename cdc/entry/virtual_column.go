@@ -0,0 +1,154 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// virtualColumnEvaluator evaluates the values of virtual generated columns,
+// which are not stored in TiKV, by their expressions.
+type virtualColumnEvaluator struct {
+	mu      sync.Mutex
+	sessCtx sessionctx.Context
+	// tables caches the expressions of virtual generated columns by table ID.
+	tables map[int64]*virtualColumnExprs
+}
+
+type virtualColumnExprs struct {
+	tableInfoVersion uint64
+	// offsets are the offsets of virtual generated columns in public columns.
+	offsets []int
+	exprs   []expression.Expression
+	// colInfos are the column infos in the order of emitted columns.
+	colInfos []rowcodec.ColInfo
+}
+
+func newVirtualColumnEvaluator(tz string) *virtualColumnEvaluator {
+	return &virtualColumnEvaluator{
+		sessCtx: utils.NewSessionCtx(map[string]string{
+			"time_zone": tz,
+			// some builtin functions like concat require max_allowed_packet.
+			"max_allowed_packet": strconv.FormatUint(variable.DefMaxAllowedPacket, 10),
+		}),
+		tables: make(map[int64]*virtualColumnExprs),
+	}
+}
+
+func (e *virtualColumnEvaluator) getExprs(ti *model.TableInfo) (*virtualColumnExprs, error) {
+	if exprs, ok := e.tables[ti.ID]; ok && exprs.tableInfoVersion == ti.TableInfoVersion {
+		return exprs, nil
+	}
+
+	exprs := &virtualColumnExprs{tableInfoVersion: ti.TableInfoVersion}
+	_, _, colInfos := ti.GetRowColInfos()
+	for i, col := range ti.Columns {
+		if model.IsColCDCVisible(col) {
+			exprs.colInfos = append(exprs.colInfos, colInfos[i])
+		}
+	}
+	for i, col := range ti.Cols() {
+		if !col.IsGenerated() || col.GeneratedStored {
+			continue
+		}
+		expr, err := expression.ParseSimpleExprCastWithTableInfo(
+			e.sessCtx, col.GeneratedExprString, ti.TableInfo, &col.FieldType)
+		if err != nil {
+			log.Error("failed to parse the expression of virtual generated column",
+				zap.String("table", ti.TableName.String()),
+				zap.String("column", col.Name.O),
+				zap.String("expression", col.GeneratedExprString),
+				zap.Error(err))
+			return nil, cerror.WrapError(cerror.ErrEvalVirtualColumnFailed, err,
+				col.Name.O, ti.TableName.String())
+		}
+		exprs.offsets = append(exprs.offsets, i)
+		exprs.exprs = append(exprs.exprs, expr)
+		exprs.colInfos = append(exprs.colInfos, colInfos[col.Offset])
+	}
+	e.tables[ti.ID] = exprs
+	return exprs, nil
+}
+
+// appendVirtualColumns evaluates the virtual generated columns of a row, and
+// appends them after the other columns, so that the offsets of the other
+// columns, which index columns refer to, are not changed. It also returns the
+// column infos in the order of the returned columns, or nil if the table has
+// no virtual generated columns.
+func (e *virtualColumnEvaluator) appendVirtualColumns(
+	ti *model.TableInfo, cols []*model.Column, rawCols []types.Datum,
+) ([]*model.Column, []rowcodec.ColInfo, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	exprs, err := e.getExprs(ti)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(exprs.exprs) == 0 {
+		return cols, nil, nil
+	}
+
+	// The expressions refer to the public columns by their offsets.
+	publicCols := ti.Cols()
+	datums := make([]types.Datum, len(publicCols))
+	for i, col := range publicCols {
+		if offset, ok := ti.RowColumnsOffset[col.ID]; ok {
+			datums[i] = rawCols[offset]
+		}
+	}
+	for i, offset := range exprs.offsets {
+		col := publicCols[offset]
+		d, err := exprs.exprs[i].Eval(chunk.MutRowFromDatums(datums).ToRow())
+		if err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrEvalVirtualColumnFailed, err,
+				col.Name.O, ti.TableName.String())
+		}
+		// A virtual generated column may refer to the previous ones.
+		datums[offset] = d
+
+		value, size, warn, err := formatColVal(d, col)
+		if err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrEvalVirtualColumnFailed, err,
+				col.Name.O, ti.TableName.String())
+		}
+		if warn != "" {
+			log.Warn(warn, zap.String("table", ti.TableName.String()),
+				zap.String("column", col.Name.String()))
+		}
+		cols = append(cols, &model.Column{
+			Name:             col.Name.O,
+			Type:             col.GetType(),
+			Charset:          col.GetCharset(),
+			Value:            value,
+			Default:          getDDLDefaultDefinition(col),
+			Flag:             ti.ColumnsFlag[col.ID],
+			ApproximateBytes: size + sizeOfEmptyColumn,
+		})
+	}
+	return cols, exprs.colInfos, nil
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
 	"github.com/pingcap/tiflow/cdc/api/owner"
 	"github.com/pingcap/tiflow/cdc/api/status"
 	v1 "github.com/pingcap/tiflow/cdc/api/v1"
@@ -39,7 +40,12 @@ func RegisterRoutes(
 	router *gin.Engine,
 	capture capture.Capture,
 	registry prometheus.Gatherer,
+	auth *middleware.Auth,
 ) {
+	// Authentication, authorization and audit log apply to all APIs.
+	router.Use(middleware.AuditLogMiddleware())
+	router.Use(middleware.AuthMiddleware(auth))
+
 	// online docs
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
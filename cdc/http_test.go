@@ -38,7 +38,7 @@ func (a *testCase) String() string {
 
 func TestPProfPath(t *testing.T) {
 	router := gin.New()
-	RegisterRoutes(router, capture.NewCapture4Test(nil), nil)
+	RegisterRoutes(router, capture.NewCapture4Test(nil), nil, nil)
 
 	apis := []*testCase{
 		{"/debug/pprof/", http.MethodGet},
@@ -63,7 +63,7 @@ func TestPProfPath(t *testing.T) {
 
 func TestHandleFailpoint(t *testing.T) {
 	router := gin.New()
-	RegisterRoutes(router, capture.NewCapture4Test(nil), nil)
+	RegisterRoutes(router, capture.NewCapture4Test(nil), nil, nil)
 	fp := "github.com/pingcap/tiflow/cdc/TestHandleFailpoint"
 	uri := fmt.Sprintf("/debug/fail/%s", fp)
 	body := bytes.NewReader([]byte("return(true)"))
@@ -15,9 +15,12 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"net/url"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -144,12 +147,22 @@ type ChangeFeedInfo struct {
 	Config *config.ReplicaConfig `json:"config"`
 	State  FeedState             `json:"state"`
 	Error  *RunningError         `json:"error"`
+	// ErrorHis records the times in milliseconds of the errors which occurred
+	// in the last ErrorHistoryWindow.
+	ErrorHis []int64 `json:"history,omitempty"`
 
 	CreatorVersion string `json:"creator-version"`
+
+	// DDLErrorHandlers decide how the DDLs failed to execute downstream are
+	// handled, they are set by the operator when the changefeed is stopped.
+	DDLErrorHandlers []*DDLErrorHandler `json:"ddl-error-handlers,omitempty"`
 }
 
 const changeFeedIDMaxLen = 128
 
+// ErrorHistoryWindow is the time window of the error history of a changefeed.
+const ErrorHistoryWindow = 10 * time.Minute
+
 var changeFeedIDRe = regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`)
 
 // ValidateChangefeedID returns true if the changefeed ID matches
@@ -194,6 +207,14 @@ func (info *ChangeFeedInfo) String() (str string) {
 	if err != nil {
 		log.Error("failed to marshal changefeed info", zap.Error(err))
 	}
+	if clone.Config != nil && clone.Config.Verification != nil {
+		// The upstream URI may contain the password of the upstream TiDB.
+		clone.Config.Verification.UpstreamURI, err = util.MaskSinkURI(
+			clone.Config.Verification.UpstreamURI)
+		if err != nil {
+			log.Error("failed to marshal changefeed info", zap.Error(err))
+		}
+	}
 
 	str, err = clone.Marshal()
 	if err != nil {
@@ -351,7 +372,8 @@ func (info *ChangeFeedInfo) fixMySQLSinkProtocol() {
 		return
 	}
 
-	if sink.IsMQScheme(uri.Scheme) {
+	// The protocol is required by the MQ and cloud storage sinks.
+	if sink.IsMQScheme(uri.Scheme) || sink.IsStorageScheme(uri.Scheme) {
 		return
 	}
 
@@ -429,3 +451,90 @@ func (info *ChangeFeedInfo) HasFastFailError() bool {
 	}
 	return cerror.IsChangefeedFastFailErrorCode(errors.RFCErrorCode(info.Error.Code))
 }
+
+// AddErrorHistory records an error occurred at the given time, and removes
+// the history out of the ErrorHistoryWindow.
+func (info *ChangeFeedInfo) AddErrorHistory(t time.Time) {
+	expired := t.Add(-ErrorHistoryWindow).UnixMilli()
+	his := make([]int64, 0, len(info.ErrorHis)+1)
+	for _, ts := range info.ErrorHis {
+		if ts >= expired {
+			his = append(his, ts)
+		}
+	}
+	info.ErrorHis = append(his, t.UnixMilli())
+}
+
+// DDLErrorHandleOp is the operation to handle a DDL failed to execute
+// downstream.
+type DDLErrorHandleOp string
+
+const (
+	// DDLErrorHandleSkip skips the DDL.
+	DDLErrorHandleSkip DDLErrorHandleOp = "skip"
+	// DDLErrorHandleReplace executes the SQLs instead of the DDL.
+	DDLErrorHandleReplace DDLErrorHandleOp = "replace"
+	// DDLErrorHandleInject executes the SQLs before the DDL.
+	DDLErrorHandleInject DDLErrorHandleOp = "inject"
+	// DDLErrorHandleRevert removes the handler of the DDL, it is never
+	// persisted.
+	DDLErrorHandleRevert DDLErrorHandleOp = "revert"
+)
+
+// DDLErrorHandler handles the DDL events with the given commit ts.
+// The owner removes it once the DDL job with the commit ts is done.
+type DDLErrorHandler struct {
+	CommitTs uint64           `json:"commit-ts"`
+	Op       DDLErrorHandleOp `json:"op"`
+	SQLs     []string         `json:"sqls,omitempty"`
+	// Handled is set once the SQLs have been executed, so that they are
+	// not executed again after the changefeed restarts.
+	Handled bool `json:"handled,omitempty"`
+}
+
+// Validate checks whether the handler is valid.
+func (h *DDLErrorHandler) Validate() error {
+	if h.CommitTs == 0 {
+		return cerror.ErrInvalidDDLErrorHandler.GenWithStackByArgs("commit ts is required")
+	}
+	switch h.Op {
+	case DDLErrorHandleSkip, DDLErrorHandleRevert:
+		if len(h.SQLs) != 0 {
+			return cerror.ErrInvalidDDLErrorHandler.GenWithStackByArgs(
+				fmt.Sprintf("no sql is allowed by the %s operation", h.Op))
+		}
+	case DDLErrorHandleReplace, DDLErrorHandleInject:
+		if len(h.SQLs) == 0 {
+			return cerror.ErrInvalidDDLErrorHandler.GenWithStackByArgs(
+				fmt.Sprintf("sqls are required by the %s operation", h.Op))
+		}
+		for _, sql := range h.SQLs {
+			if strings.TrimSpace(sql) == "" {
+				return cerror.ErrInvalidDDLErrorHandler.GenWithStackByArgs("empty sql")
+			}
+		}
+	default:
+		return cerror.ErrInvalidDDLErrorHandler.GenWithStackByArgs(
+			fmt.Sprintf("unknown operation %s", h.Op))
+	}
+	return nil
+}
+
+// SetDDLErrorHandler sets the handler of the DDLs with the commit ts of the
+// handler, it replaces the existing one, or removes it if the operation is
+// DDLErrorHandleRevert.
+func (info *ChangeFeedInfo) SetDDLErrorHandler(h *DDLErrorHandler) {
+	handlers := make([]*DDLErrorHandler, 0, len(info.DDLErrorHandlers)+1)
+	for _, handler := range info.DDLErrorHandlers {
+		if handler.CommitTs != h.CommitTs {
+			handlers = append(handlers, handler)
+		}
+	}
+	if h.Op != DDLErrorHandleRevert {
+		handlers = append(handlers, h)
+	}
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].CommitTs < handlers[j].CommitTs
+	})
+	info.DDLErrorHandlers = handlers
+}
@@ -278,6 +278,11 @@ func (r *RowChangedEvent) GetCommitTs() uint64 {
 	return r.CommitTs
 }
 
+// GetRowsCount returns the number of rows in this event, it is always 1.
+func (r *RowChangedEvent) GetRowsCount() int {
+	return 1
+}
+
 // IsDelete returns true if the row is a delete event
 func (r *RowChangedEvent) IsDelete() bool {
 	return len(r.PreColumns) != 0 && len(r.Columns) == 0
@@ -622,6 +627,20 @@ func (t *SingleTableTxn) GetCommitTs() uint64 {
 	return t.CommitTs
 }
 
+// GetRowsCount returns the number of rows in the transaction.
+func (t *SingleTableTxn) GetRowsCount() int {
+	return len(t.Rows)
+}
+
+// ApproximateBytes returns approximate bytes in memory consumed by the transaction.
+func (t *SingleTableTxn) ApproximateBytes() int {
+	size := 0
+	for _, row := range t.Rows {
+		size += row.ApproximateBytes()
+	}
+	return size
+}
+
 // Append adds a row changed event into SingleTableTxn
 func (t *SingleTableTxn) Append(row *RowChangedEvent) {
 	if row.StartTs != t.StartTs || row.CommitTs != t.CommitTs || row.Table.TableID != t.Table.TableID {
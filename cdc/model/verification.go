@@ -0,0 +1,76 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ChangefeedVerification is the result of comparing the upstream and the
+// downstream data of a changefeed at a syncpoint.
+type ChangefeedVerification struct {
+	// PrimaryTs is the ts at which the upstream data is read.
+	PrimaryTs uint64 `json:"primary-ts"`
+	// SecondaryTs is the ts at which the downstream data is read.
+	SecondaryTs uint64    `json:"secondary-ts"`
+	StartTime   time.Time `json:"start-time"`
+	EndTime     time.Time `json:"end-time"`
+	Consistent  bool      `json:"consistent"`
+	// Mismatches are the tables whose checksums are different.
+	Mismatches []*TableMismatch `json:"mismatches"`
+}
+
+// TableMismatch describes a table whose upstream and downstream data differ.
+type TableMismatch struct {
+	Schema             string `json:"schema"`
+	Table              string `json:"table"`
+	UpstreamChecksum   string `json:"upstream-checksum"`
+	DownstreamChecksum string `json:"downstream-checksum"`
+	// MissingDownstream is true if the table doesn't exist in the downstream,
+	// DownstreamChecksum and Ranges are empty in this case.
+	MissingDownstream bool `json:"missing-downstream"`
+	// Ranges are the key ranges whose checksums are different, it is empty if
+	// the table has no primary key so that it can not be split into chunks.
+	Ranges []*KeyRangeMismatch `json:"ranges"`
+}
+
+// KeyRangeMismatch describes a key range whose upstream and downstream data
+// differ. The range is (LowerBound, UpperBound] on the primary key columns,
+// an empty bound means the range is unbounded on that side.
+type KeyRangeMismatch struct {
+	Columns            []string `json:"columns"`
+	LowerBound         []string `json:"lower-bound"`
+	UpperBound         []string `json:"upper-bound"`
+	UpstreamChecksum   string   `json:"upstream-checksum"`
+	DownstreamChecksum string   `json:"downstream-checksum"`
+	UpstreamRowCount   int64    `json:"upstream-row-count"`
+	DownstreamRowCount int64    `json:"downstream-row-count"`
+}
+
+// Marshal returns the json marshal format of a ChangefeedVerification
+func (v *ChangefeedVerification) Marshal() (string, error) {
+	data, err := json.Marshal(v)
+	return string(data), cerror.WrapError(cerror.ErrMarshalFailed, err)
+}
+
+// Unmarshal unmarshals into *ChangefeedVerification from json marshal byte slice
+func (v *ChangefeedVerification) Unmarshal(data []byte) error {
+	err := json.Unmarshal(data, v)
+	return errors.Annotatef(
+		cerror.WrapError(cerror.ErrUnmarshalFailed, err), "Unmarshal data: %v", data)
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedVerificationMarshal(t *testing.T) {
+	t.Parallel()
+
+	v := &ChangefeedVerification{
+		PrimaryTs:   1,
+		SecondaryTs: 2,
+		StartTime:   time.Unix(100, 0).UTC(),
+		EndTime:     time.Unix(200, 0).UTC(),
+		Mismatches: []*TableMismatch{{
+			Schema:             "test",
+			Table:              "t",
+			UpstreamChecksum:   "1",
+			DownstreamChecksum: "2",
+			Ranges: []*KeyRangeMismatch{{
+				Columns:            []string{"id"},
+				LowerBound:         []string{"10"},
+				UpperBound:         []string{"20"},
+				UpstreamChecksum:   "3",
+				DownstreamChecksum: "4",
+				UpstreamRowCount:   10,
+				DownstreamRowCount: 9,
+			}},
+		}},
+	}
+	data, err := v.Marshal()
+	require.Nil(t, err)
+
+	v2 := new(ChangefeedVerification)
+	require.Nil(t, v2.Unmarshal([]byte(data)))
+	require.Equal(t, v, v2)
+
+	require.Regexp(t, ".*CDC:ErrUnmarshalFailed.*", v2.Unmarshal([]byte("{")))
+}
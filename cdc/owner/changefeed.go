@@ -30,6 +30,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/puller"
 	"github.com/pingcap/tiflow/cdc/redo"
 	"github.com/pingcap/tiflow/cdc/scheduler"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/txn"
+	"github.com/pingcap/tiflow/cdc/sinkv2/globaltxn"
+	"github.com/pingcap/tiflow/cdc/verification"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -44,7 +47,7 @@ import (
 // newSchedulerV2FromCtx creates a new schedulerV2 from context.
 // This function is factored out to facilitate unit testing.
 func newSchedulerV2FromCtx(
-	ctx cdcContext.Context, startTs uint64,
+	ctx cdcContext.Context, up *upstream.Upstream, startTs uint64,
 ) (ret scheduler.Scheduler, err error) {
 	changeFeedID := ctx.ChangefeedVars().ID
 	messageServer := ctx.GlobalVars().MessageServer
@@ -53,9 +56,15 @@ func newSchedulerV2FromCtx(
 	captureID := ctx.GlobalVars().CaptureInfo.ID
 	cfg := config.GetGlobalServerConfig().Debug
 	if cfg.EnableSchedulerV3 {
+		// Tables can only be split into spans with the new sink, as the old
+		// sink writes a table with a single goroutine.
+		changefeedCfg := ctx.ChangefeedVars().Info.Config.Scheduler
+		if !cfg.EnableNewSink {
+			changefeedCfg = nil
+		}
 		ret, err = scheduler.NewSchedulerV3(
-			ctx, captureID, changeFeedID, startTs,
-			messageServer, messageRouter, ownerRev, cfg.Scheduler)
+			ctx, up, captureID, changeFeedID, startTs,
+			messageServer, messageRouter, ownerRev, cfg.Scheduler, changefeedCfg)
 	} else {
 		ret, err = scheduler.NewScheduler(
 			ctx, captureID, changeFeedID, startTs,
@@ -64,8 +73,10 @@ func newSchedulerV2FromCtx(
 	return ret, errors.Trace(err)
 }
 
-func newScheduler(ctx cdcContext.Context, startTs uint64) (scheduler.Scheduler, error) {
-	return newSchedulerV2FromCtx(ctx, startTs)
+func newScheduler(
+	ctx cdcContext.Context, up *upstream.Upstream, startTs uint64,
+) (scheduler.Scheduler, error) {
+	return newSchedulerV2FromCtx(ctx, up, startTs)
 }
 
 type changefeed struct {
@@ -93,6 +104,10 @@ type changefeed struct {
 	// in every tick
 	isReleased bool
 
+	// globalTxnCoordinator is only created if transactions across tables
+	// should be written to the downstream atomically.
+	globalTxnCoordinator *globaltxn.Coordinator
+
 	// only used for asyncExecDDL function
 	// ddlEventCache is not nil when the changefeed is executing
 	// a DDL job asynchronously. After the DDL job has been executed,
@@ -111,6 +126,9 @@ type changefeed struct {
 	// The changefeed will start a backend goroutine in the function `initialize` for DDLPuller
 	// `ddlWg` is used to manage this backend goroutine.
 	ddlWg sync.WaitGroup
+	// verificationWg manages the backend goroutine which verifies the
+	// changefeed periodically if it is configured.
+	verificationWg sync.WaitGroup
 
 	metricsChangefeedCheckpointTsGauge     prometheus.Gauge
 	metricsChangefeedCheckpointTsLagGauge  prometheus.Gauge
@@ -131,7 +149,9 @@ type changefeed struct {
 	) (puller.DDLPuller, error)
 
 	newSink      func() DDLSink
-	newScheduler func(ctx cdcContext.Context, startTs uint64) (scheduler.Scheduler, error)
+	newScheduler func(
+		ctx cdcContext.Context, up *upstream.Upstream, startTs uint64,
+	) (scheduler.Scheduler, error)
 
 	lastDDLTs uint64 // Timestamp of the last executed DDL. Only used for tests.
 }
@@ -169,7 +189,9 @@ func newChangefeed4Test(
 		changefeed model.ChangeFeedID,
 	) (puller.DDLPuller, error),
 	newSink func() DDLSink,
-	newScheduler func(ctx cdcContext.Context, startTs uint64) (scheduler.Scheduler, error),
+	newScheduler func(
+		ctx cdcContext.Context, up *upstream.Upstream, startTs uint64,
+	) (scheduler.Scheduler, error),
 ) *changefeed {
 	c := newChangefeed(id, state, up)
 	c.newDDLPuller = newDDLPuller
@@ -285,6 +307,16 @@ func (c *changefeed) tick(ctx cdcContext.Context, captures map[model.CaptureID]*
 	if !c.sink.isInitialized() {
 		return nil
 	}
+	if c.globalTxnCoordinator != nil {
+		captureIDs := make([]model.CaptureID, 0, len(captures))
+		for captureID := range captures {
+			captureIDs = append(captureIDs, captureID)
+		}
+		if err := c.globalTxnCoordinator.Tick(
+			c.schema.AllPhysicalTables(), captureIDs); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	// This means that the cached DDL has been executed,
 	// and we need to use the latest table names.
 	if c.currentTableNames == nil {
@@ -503,6 +535,21 @@ LOOP:
 	}()
 
 	stdCtx := contextutil.PutChangefeedIDInCtx(cancelCtx, c.id)
+	if cfg := c.state.Info.Config.Verification; cfg != nil && cfg.Interval > 0 {
+		verificationCfg := &verification.Config{
+			ChangefeedID:  c.id,
+			SinkURI:       c.state.Info.SinkURI,
+			ReplicaConfig: c.state.Info.Config,
+		}
+		etcdClient := ctx.GlobalVars().EtcdClient
+		c.verificationWg.Add(1)
+		go func() {
+			defer c.verificationWg.Done()
+			// Run only returns when the changefeed is closed.
+			_ = verification.Run(stdCtx, verificationCfg, etcdClient)
+		}()
+	}
+
 	redoManagerOpts := redo.NewOwnerManagerOptions(c.errCh)
 	mgr, err := redo.NewManager(stdCtx, c.state.Info.Config.Consistent, redoManagerOpts)
 	c.redoManager = mgr
@@ -516,8 +563,19 @@ LOOP:
 		zap.String("namespace", c.id.Namespace),
 		zap.String("changefeed", c.id.ID))
 
+	if c.state.Info.Config.Sink.TxnAtomicity.IsGlobal() {
+		c.globalTxnCoordinator, err = newGlobalTxnCoordinator(
+			stdCtx, ctx, c.id, c.state.Info, checkpointTs)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		log.Info("owner creates global transaction coordinator",
+			zap.String("namespace", c.id.Namespace),
+			zap.String("changefeed", c.id.ID))
+	}
+
 	// create scheduler
-	c.scheduler, err = c.newScheduler(ctx, checkpointTs)
+	c.scheduler, err = c.newScheduler(ctx, c.upstream, checkpointTs)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -535,6 +593,36 @@ LOOP:
 	return nil
 }
 
+// newGlobalTxnCoordinator creates a global transaction coordinator which
+// writes the rows collected from all processors to a MySQL compatible sink.
+func newGlobalTxnCoordinator(
+	stdCtx context.Context,
+	ctx cdcContext.Context,
+	id model.ChangeFeedID,
+	info *model.ChangeFeedInfo,
+	checkpointTs model.Ts,
+) (*globaltxn.Coordinator, error) {
+	sinkURI, err := config.GetSinkURIAndAdjustConfigWithSinkURI(info.SinkURI, info.Config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sinkErrCh := make(chan error, defaultErrChSize)
+	backendSink, err := txn.NewMySQLSink(
+		stdCtx, sinkURI, info.Config, sinkErrCh, txn.DefaultConflictDetectorSlots)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	coordinator, err := globaltxn.NewCoordinator(
+		stdCtx, id, ctx.GlobalVars().CaptureInfo.ID, checkpointTs,
+		ctx.GlobalVars().MessageServer, ctx.GlobalVars().MessageRouter,
+		backendSink, sinkErrCh, info.Config.Sink.GetGlobalTxnMemoryQuota())
+	if err != nil {
+		_ = backendSink.Close()
+		return nil, errors.Trace(err)
+	}
+	return coordinator, nil
+}
+
 func (c *changefeed) initMetrics() {
 	c.metricsChangefeedCheckpointTsGauge = changefeedCheckpointTsGauge.
 		WithLabelValues(c.id.Namespace, c.id.ID)
@@ -573,6 +661,7 @@ func (c *changefeed) releaseResources(ctx cdcContext.Context) {
 		c.ddlPuller.Close()
 	}
 	c.ddlWg.Wait()
+	c.verificationWg.Wait()
 
 	if c.sink != nil {
 		canceledCtx, cancel := context.WithCancel(context.Background())
@@ -587,6 +676,11 @@ func (c *changefeed) releaseResources(ctx cdcContext.Context) {
 		}
 	}
 
+	if c.globalTxnCoordinator != nil {
+		c.globalTxnCoordinator.Close(ctx)
+		c.globalTxnCoordinator = nil
+	}
+
 	if c.scheduler != nil {
 		c.scheduler.Close(ctx)
 		c.scheduler = nil
@@ -823,6 +917,7 @@ func (c *changefeed) asyncExecDDLJob(ctx cdcContext.Context,
 		}
 		jobDone = jobDone && eventDone
 	}
+	c.updateDDLErrorHandler(job.BinlogInfo.FinishedTS, jobDone)
 
 	if jobDone {
 		c.ddlEventCache = nil
@@ -847,6 +942,13 @@ func (c *changefeed) asyncExecDDLEvent(ctx cdcContext.Context,
 			zap.String("changefeed", c.id.ID), zap.Any("event", ddlEvent))
 		return true, nil
 	}
+	// DDLs of a bidirectional replication are only replicated by one direction.
+	if cfg := c.state.Info.Config.Bidirectional; cfg != nil && !cfg.SyncDDL {
+		log.Info("ignore the DDL event since DDLs are not replicated by the changefeed",
+			zap.String("namespace", c.id.Namespace),
+			zap.String("changefeed", c.id.ID), zap.Any("event", ddlEvent))
+		return true, nil
+	}
 	done, err = c.sink.emitDDLEvent(ctx, ddlEvent)
 	if err != nil {
 		return false, err
@@ -855,6 +957,49 @@ func (c *changefeed) asyncExecDDLEvent(ctx cdcContext.Context,
 	return done, nil
 }
 
+// updateDDLErrorHandler persists the progress of the DDL error handler with
+// the commit ts. It's marked as handled once its SQLs are executed, so they
+// are not executed again after the changefeed restarts, and it's removed once
+// the DDL job is done.
+func (c *changefeed) updateDDLErrorHandler(commitTs model.Ts, jobDone bool) {
+	var handler *model.DDLErrorHandler
+	for _, h := range c.state.Info.DDLErrorHandlers {
+		if h.CommitTs == commitTs {
+			handler = h
+			break
+		}
+	}
+	if handler == nil {
+		return
+	}
+	if !jobDone && (handler.Handled || !c.sink.isDDLErrorHandled(commitTs)) {
+		return
+	}
+	log.Info("update ddl error handler",
+		zap.String("namespace", c.id.Namespace),
+		zap.String("changefeed", c.id.ID),
+		zap.Any("handler", handler),
+		zap.Bool("jobDone", jobDone))
+	c.state.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		if info == nil {
+			return nil, false, nil
+		}
+		for i, h := range info.DDLErrorHandlers {
+			if h.CommitTs != commitTs {
+				continue
+			}
+			if jobDone {
+				info.DDLErrorHandlers = append(info.DDLErrorHandlers[:i:i],
+					info.DDLErrorHandlers[i+1:]...)
+			} else {
+				h.Handled = true
+			}
+			return info, true, nil
+		}
+		return info, false, nil
+	})
+}
+
 func (c *changefeed) updateMetrics(currentTs int64, checkpointTs, resolvedTs model.Ts) {
 	phyCkpTs := oracle.ExtractPhysical(checkpointTs)
 	c.metricsChangefeedCheckpointTsGauge.Set(float64(phyCkpTs))
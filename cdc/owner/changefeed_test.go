@@ -87,6 +87,8 @@ type mockDDLSink struct {
 	}
 	syncPoint    model.Ts
 	syncPointHis []model.Ts
+	// ddlErrorHandled is returned by isDDLErrorHandled.
+	ddlErrorHandled bool
 
 	wg sync.WaitGroup
 }
@@ -123,6 +125,10 @@ func (m *mockDDLSink) emitSyncPoint(ctx cdcContext.Context, checkpointTs uint64)
 	return nil
 }
 
+func (m *mockDDLSink) isDDLErrorHandled(commitTs model.Ts) bool {
+	return m.ddlErrorHandled
+}
+
 func (m *mockDDLSink) emitCheckpointTs(ts uint64, tableNames []model.TableName) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -215,7 +221,7 @@ func createChangefeed4Test(ctx cdcContext.Context, t *testing.T,
 		},
 		// new scheduler
 		func(
-			ctx cdcContext.Context, startTs uint64,
+			ctx cdcContext.Context, up *upstream.Upstream, startTs uint64,
 		) (scheduler.Scheduler, error) {
 			return &mockScheduler{}, nil
 		})
@@ -362,6 +368,107 @@ func TestExecDDL(t *testing.T) {
 	require.Contains(t, cf.scheduler.(*mockScheduler).currentTables, job.TableID)
 }
 
+func TestExecDDLWithErrorHandler(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	helper.DDL2Job("create database test0")
+	job := helper.DDL2Job("create table test0.table0(id int primary key)")
+	startTs := job.BinlogInfo.FinishedTS + 1000
+	ddlTs := startTs + 1000
+
+	ctx := cdcContext.NewContext4Test(context.Background(), true)
+	ctx.ChangefeedVars().Info.StartTs = startTs
+	ctx.ChangefeedVars().Info.DDLErrorHandlers = []*model.DDLErrorHandler{
+		{CommitTs: ddlTs, Op: model.DDLErrorHandleInject, SQLs: []string{"select 1"}},
+		{CommitTs: ddlTs + 1000, Op: model.DDLErrorHandleSkip},
+	}
+
+	cf, captures, tester := createChangefeed4Test(ctx, t)
+	cf.upstream.KVStorage = helper.Storage()
+	defer cf.Close(ctx)
+	tickThreeTime := func() {
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+	}
+	// pre check and initialize
+	tickThreeTime()
+
+	mockDDLPuller := cf.ddlPuller.(*mockDDLPuller)
+	mockDDLSink := cf.sink.(*mockDDLSink)
+	mockDDLPuller.resolvedTs = startTs
+	tickThreeTime()
+
+	job = helper.DDL2Job("create table test0.table1(id int primary key)")
+	job.BinlogInfo.FinishedTS = ddlTs
+	mockDDLPuller.resolvedTs = ddlTs
+	mockDDLPuller.ddlQueue = append(mockDDLPuller.ddlQueue, job)
+	tickThreeTime()
+	require.NotNil(t, mockDDLSink.ddlExecuting)
+	require.False(t, cf.state.Info.DDLErrorHandlers[0].Handled)
+
+	// The handler is persisted as handled once its SQLs are executed.
+	mockDDLSink.ddlErrorHandled = true
+	tickThreeTime()
+	require.True(t, cf.state.Info.DDLErrorHandlers[0].Handled)
+	require.Len(t, cf.state.Info.DDLErrorHandlers, 2)
+
+	// The handler is removed once the DDL is applied.
+	mockDDLSink.ddlDone = true
+	mockDDLPuller.resolvedTs += 500
+	tickThreeTime()
+	require.Equal(t, mockDDLPuller.resolvedTs, cf.state.Status.CheckpointTs)
+	require.Equal(t, []*model.DDLErrorHandler{
+		{CommitTs: ddlTs + 1000, Op: model.DDLErrorHandleSkip},
+	}, cf.state.Info.DDLErrorHandlers)
+}
+
+func TestExecDDLWithoutSyncDDL(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	helper.DDL2Job("create database test0")
+	job := helper.DDL2Job("create table test0.table0(id int primary key)")
+	startTs := job.BinlogInfo.FinishedTS + 1000
+
+	ctx := cdcContext.NewContext4Test(context.Background(), true)
+	ctx.ChangefeedVars().Info.StartTs = startTs
+	ctx.ChangefeedVars().Info.Config.Bidirectional = &config.BidirectionalConfig{
+		ReplicationID: 1, FilterReplicationIDs: []uint64{2},
+	}
+
+	cf, captures, tester := createChangefeed4Test(ctx, t)
+	cf.upstream.KVStorage = helper.Storage()
+	defer cf.Close(ctx)
+	tickThreeTime := func() {
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+	}
+	// pre check and initialize
+	tickThreeTime()
+
+	mockDDLPuller := cf.ddlPuller.(*mockDDLPuller)
+	mockDDLSink := cf.sink.(*mockDDLSink)
+	mockDDLPuller.resolvedTs = startTs
+	tickThreeTime()
+
+	// The DDL is not sent to the sink, but the schema is still updated.
+	job = helper.DDL2Job("create table test0.table1(id int primary key)")
+	mockDDLPuller.resolvedTs += 1000
+	job.BinlogInfo.FinishedTS = mockDDLPuller.resolvedTs
+	mockDDLPuller.ddlQueue = append(mockDDLPuller.ddlQueue, job)
+	tickThreeTime()
+	require.Equal(t, cf.state.Status.CheckpointTs, mockDDLPuller.resolvedTs)
+	require.Nil(t, mockDDLSink.ddlExecuting)
+	require.Len(t, cf.schema.AllPhysicalTables(), 2)
+}
+
 func TestEmitCheckpointTs(t *testing.T) {
 	helper := entry.NewSchemaTestHelper(t)
 	defer helper.Close()
@@ -54,6 +54,9 @@ type DDLSink interface {
 	// the caller of this function can call again and again until a true returned
 	emitDDLEvent(ctx cdcContext.Context, ddl *model.DDLEvent) (bool, error)
 	emitSyncPoint(ctx cdcContext.Context, checkpointTs uint64) error
+	// isDDLErrorHandled returns true if the SQLs of the DDL error handler
+	// with the commit ts have been executed.
+	isDDLErrorHandled(commitTs model.Ts) bool
 	// close the sink, cancel running goroutine.
 	close(ctx context.Context) error
 	isInitialized() bool
@@ -68,6 +71,8 @@ type ddlSinkImpl struct {
 		sync.Mutex
 		checkpointTs      model.Ts
 		currentTableNames []model.TableName
+		// handledTs records the commit ts whose handler SQLs have been executed.
+		handledTs map[model.Ts]struct{}
 	}
 	// ddlSentTsMap is used to check whether a ddl event in a ddl job has been
 	// sent to `ddlCh` successfully.
@@ -98,6 +103,7 @@ func newDDLSink() DDLSink {
 		sinkInitHandler: ddlSinkInitializer,
 		cancel:          func() {},
 	}
+	res.mu.handledTs = make(map[model.Ts]struct{})
 	res.initialized.Store(false)
 	return res
 }
@@ -165,6 +171,11 @@ func (s *ddlSinkImpl) run(ctx cdcContext.Context, id model.ChangeFeedID, info *m
 			zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
 			zap.Duration("duration", time.Since(start)))
 
+		handlers := make(map[model.Ts]*model.DDLErrorHandler, len(info.DDLErrorHandlers))
+		for _, h := range info.DDLErrorHandlers {
+			handlers[h.CommitTs] = h
+		}
+
 		// TODO make the tick duration configurable
 		ticker := time.NewTicker(time.Second)
 		defer ticker.Stop()
@@ -216,12 +227,7 @@ func (s *ddlSinkImpl) run(ctx cdcContext.Context, id model.ChangeFeedID, info *m
 					zap.String("namespace", ctx.ChangefeedVars().ID.Namespace),
 					zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
 					zap.Any("DDL", ddl))
-				var err error
-				if s.sinkV1 != nil {
-					err = s.sinkV1.EmitDDLEvent(ctx, ddl)
-				} else {
-					err = s.sinkV2.WriteDDLEvent(ctx, ddl)
-				}
+				err := s.execDDL(ctx, ddl, handlers)
 				failpoint.Inject("InjectChangefeedDDLError", func() {
 					err = cerror.ErrExecDDLFailed.GenWithStackByArgs()
 				})
@@ -272,6 +278,60 @@ func (s *ddlSinkImpl) run(ctx cdcContext.Context, id model.ChangeFeedID, info *m
 	}()
 }
 
+// execDDL writes the DDL event to downstream, applying the DDL error handler
+// with the same commit ts if there is one. All the events in a job share the
+// commit ts, so the SQLs of a handler are executed only once for them, and
+// they are skipped if the handler has been persisted as handled.
+func (s *ddlSinkImpl) execDDL(
+	ctx cdcContext.Context, ddl *model.DDLEvent,
+	handlers map[model.Ts]*model.DDLErrorHandler,
+) error {
+	h, ok := handlers[ddl.CommitTs]
+	if !ok {
+		return s.writeDDLEvent(ctx, ddl)
+	}
+	log.Info("handle ddl event by ddl error handler",
+		zap.String("namespace", ctx.ChangefeedVars().ID.Namespace),
+		zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
+		zap.Any("handler", h),
+		zap.Any("DDL", ddl))
+	switch h.Op {
+	case model.DDLErrorHandleSkip:
+		return nil
+	case model.DDLErrorHandleReplace, model.DDLErrorHandleInject:
+		if !h.Handled && !s.isDDLErrorHandled(ddl.CommitTs) {
+			for _, sql := range h.SQLs {
+				event := *ddl
+				event.Query = sql
+				if err := s.writeDDLEvent(ctx, &event); err != nil {
+					return errors.Trace(err)
+				}
+			}
+			s.mu.Lock()
+			s.mu.handledTs[ddl.CommitTs] = struct{}{}
+			s.mu.Unlock()
+		}
+		if h.Op == model.DDLErrorHandleReplace {
+			return nil
+		}
+	}
+	return s.writeDDLEvent(ctx, ddl)
+}
+
+func (s *ddlSinkImpl) writeDDLEvent(ctx cdcContext.Context, ddl *model.DDLEvent) error {
+	if s.sinkV1 != nil {
+		return s.sinkV1.EmitDDLEvent(ctx, ddl)
+	}
+	return s.sinkV2.WriteDDLEvent(ctx, ddl)
+}
+
+func (s *ddlSinkImpl) isDDLErrorHandled(commitTs model.Ts) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.mu.handledTs[commitTs]
+	return ok
+}
+
 func (s *ddlSinkImpl) emitCheckpointTs(ts uint64, tableNames []model.TableName) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -167,3 +167,45 @@ func TestExecDDLError(t *testing.T) {
 	}
 	require.True(t, cerror.ErrExecDDLFailed.Equal(readResultErr()))
 }
+
+type recordDDLSink struct {
+	sink.Sink
+	queries []string
+}
+
+func (m *recordDDLSink) EmitDDLEvent(_ context.Context, ddl *model.DDLEvent) error {
+	m.queries = append(m.queries, ddl.Query)
+	return nil
+}
+
+func TestExecDDLWithErrorHandlers(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	mSink := &recordDDLSink{}
+	s := newDDLSink().(*ddlSinkImpl)
+	s.sinkV1 = mSink
+
+	handlers := map[model.Ts]*model.DDLErrorHandler{
+		2: {CommitTs: 2, Op: model.DDLErrorHandleSkip},
+		3: {CommitTs: 3, Op: model.DDLErrorHandleReplace, SQLs: []string{"r1", "r2"}},
+		4: {CommitTs: 4, Op: model.DDLErrorHandleInject, SQLs: []string{"i1"}},
+		// The SQLs have been executed before the changefeed restarts.
+		5: {CommitTs: 5, Op: model.DDLErrorHandleInject, SQLs: []string{"i2"}, Handled: true},
+	}
+	ddlEvents := []*model.DDLEvent{
+		{CommitTs: 1, Query: "q1"},
+		{CommitTs: 2, Query: "q2"},
+		// Events in a rename tables job share the same commit ts.
+		{CommitTs: 3, Query: "q3-1"},
+		{CommitTs: 3, Query: "q3-2"},
+		{CommitTs: 4, Query: "q4"},
+		{CommitTs: 5, Query: "q5"},
+	}
+	for _, event := range ddlEvents {
+		require.Nil(t, s.execDDL(ctx, event, handlers))
+	}
+	require.Equal(t, []string{"q1", "r1", "r2", "i1", "q4", "q5"}, mSink.queries)
+	require.False(t, s.isDDLErrorHandled(2))
+	require.True(t, s.isDDLErrorHandled(3))
+	require.True(t, s.isDDLErrorHandled(4))
+	require.False(t, s.isDDLErrorHandled(5))
+}
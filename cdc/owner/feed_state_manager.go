@@ -414,6 +414,7 @@ func (m *feedStateManager) errorsReportedByProcessors() []*model.RunningError {
 }
 
 func (m *feedStateManager) handleError(errs ...*model.RunningError) {
+	now := time.Now()
 	// if there are a fastFail error in errs, we can just fastFail the changefeed
 	// and no need to patch other error to the changefeed info
 	for _, err := range errs {
@@ -423,6 +424,7 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 					return nil, false, nil
 				}
 				info.Error = err
+				info.AddErrorHistory(now)
 				return info, true, nil
 			})
 			m.shouldBeRunning = false
@@ -441,6 +443,7 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 					return nil, false, nil
 				}
 				info.Error = err
+				info.AddErrorHistory(now)
 				return info, true, nil
 			})
 			m.shouldBeRunning = false
@@ -453,10 +456,14 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 		if info == nil {
 			return nil, false, nil
 		}
+		if len(errs) == 0 {
+			return info, false, nil
+		}
 		for _, err := range errs {
 			info.Error = err
 		}
-		return info, len(errs) > 0, nil
+		info.AddErrorHistory(now)
+		return info, true, nil
 	})
 
 	// If we enter into an abnormal state ('error', 'failed') for this changefeed now
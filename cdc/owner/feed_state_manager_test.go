@@ -166,6 +166,7 @@ func TestResumeChangefeedWithCheckpointTs(t *testing.T) {
 	require.Equal(t, state.Info.State, model.StateFailed)
 	require.Equal(t, state.Info.AdminJobType, model.AdminStop)
 	require.Equal(t, state.Status.AdminJobType, model.AdminStop)
+	require.Len(t, state.Info.ErrorHis, 1)
 
 	// resume the changefeed in failed state
 	manager.PushAdminJob(&model.AdminJob{
@@ -241,7 +241,7 @@ func (m *managerImpl) handleCommand() error {
 	case commandTpQueryTableCount:
 		count := 0
 		for _, p := range m.processors {
-			count += len(p.GetAllCurrentTables())
+			count += len(p.GetAllCurrentTableSpans())
 		}
 		select {
 		case cmd.payload.(chan int) <- count:
@@ -43,7 +43,7 @@ type managerTester struct {
 // NewManager4Test creates a new processor manager for test
 func NewManager4Test(
 	t *testing.T,
-	createTablePipeline func(ctx cdcContext.Context, tableID model.TableID, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error),
+	createTablePipeline func(ctx cdcContext.Context, span tablepb.Span, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error),
 	liveness *model.Liveness,
 ) *managerImpl {
 	captureInfo := &model.CaptureInfo{ID: "capture-test", AdvertiseAddr: "127.0.0.1:0000"}
@@ -61,10 +61,10 @@ func NewManager4Test(
 }
 
 func (s *managerTester) resetSuit(ctx cdcContext.Context, t *testing.T) {
-	s.manager = NewManager4Test(t, func(ctx cdcContext.Context, tableID model.TableID, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error) {
+	s.manager = NewManager4Test(t, func(ctx cdcContext.Context, span tablepb.Span, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error) {
 		return &mockTablePipeline{
-			tableID:      tableID,
-			name:         fmt.Sprintf("`test`.`table%d`", tableID),
+			tableID:      span.TableID,
+			name:         fmt.Sprintf("`test`.`table%d`", span.TableID),
 			state:        tablepb.TableStateReplicating,
 			resolvedTs:   replicaInfo.StartTs,
 			checkpointTs: replicaInfo.StartTs,
@@ -296,8 +296,11 @@ func TestQueryTableCount(t *testing.T) {
 	m := NewManager(&model.CaptureInfo{ID: "capture-test"}, nil, &liveness).(*managerImpl)
 	ctx := context.TODO()
 	// Add some tables to processor.
+	tables := tablepb.NewSpanMap[tablepb.TablePipeline]()
+	tables.ReplaceOrInsert(tablepb.TableSpan(1), nil)
+	tables.ReplaceOrInsert(tablepb.TableSpan(2), nil)
 	m.processors[model.ChangeFeedID{ID: "test"}] = &processor{
-		tables: map[model.TableID]tablepb.TablePipeline{1: nil, 2: nil},
+		tables: tables,
 	}
 
 	done := make(chan error, 1)
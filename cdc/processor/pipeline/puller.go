@@ -19,6 +19,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/puller"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/pipeline"
@@ -32,6 +33,8 @@ type pullerNode struct {
 	tableName string // quoted schema and table, used in metircs only
 
 	tableID    model.TableID
+	span       tablepb.Span
+	markSpan   *regionspan.Span
 	startTs    model.Ts
 	changefeed model.ChangeFeedID
 	cancel     context.CancelFunc
@@ -39,13 +42,16 @@ type pullerNode struct {
 }
 
 func newPullerNode(
-	tableID model.TableID,
+	span tablepb.Span,
+	markSpan *regionspan.Span,
 	startTs model.Ts,
 	tableName string,
 	changefeed model.ChangeFeedID,
 ) *pullerNode {
 	return &pullerNode{
-		tableID:    tableID,
+		tableID:    span.TableID,
+		span:       span,
+		markSpan:   markSpan,
 		startTs:    startTs,
 		tableName:  tableName,
 		changefeed: changefeed,
@@ -55,7 +61,10 @@ func newPullerNode(
 func (n *pullerNode) tableSpan() []regionspan.Span {
 	// start table puller
 	spans := make([]regionspan.Span, 0, 4)
-	spans = append(spans, regionspan.GetTableSpan(n.tableID))
+	spans = append(spans, n.span.ToRegionSpan())
+	if n.markSpan != nil {
+		spans = append(spans, *n.markSpan)
+	}
 	return spans
 }
 
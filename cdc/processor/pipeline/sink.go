@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/redo"
 	sinkv1 "github.com/pingcap/tiflow/cdc/sink"
 	sinkv2 "github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pmessage "github.com/pingcap/tiflow/pkg/pipeline/message"
 	"go.uber.org/zap"
@@ -58,6 +59,14 @@ type sinkNode struct {
 	logLimiter     *rate.Limiter
 	enableOldValue bool
 	splitTxn       bool
+
+	// markFilter drops the transactions written by the reverse direction of a
+	// bidirectional replication, it is nil if the mark table is not pulled.
+	markFilter *bidirectional.Filter
+	// pendingEvents are the events of the same commit ts. They are buffered
+	// if markFilter is set, since the mark of a transaction may be received
+	// after its rows.
+	pendingEvents []*model.PolymorphicEvent
 }
 
 func newSinkNode(
@@ -350,12 +359,22 @@ func (n *sinkNode) HandleMessage(ctx context.Context, msg pmessage.Message) (boo
 				resolved = *(event.Resolved)
 			}
 
+			if err := n.emitPendingEvents(ctx); err != nil {
+				return false, errors.Trace(err)
+			}
+
 			if err := n.flushSink(ctx, resolved); err != nil {
 				return false, errors.Trace(err)
 			}
 			n.resolvedTs.Store(resolved)
 			return true, nil
 		}
+		if n.markFilter != nil {
+			if err := n.bufferEvent(ctx, event); err != nil {
+				return false, errors.Trace(err)
+			}
+			return true, nil
+		}
 		if err := n.emitRowToSink(ctx, event); err != nil {
 			return false, errors.Trace(err)
 		}
@@ -377,6 +396,35 @@ func (n *sinkNode) HandleMessage(ctx context.Context, msg pmessage.Message) (boo
 	return true, nil
 }
 
+// bufferEvent buffers the event until all the events of its commit ts are
+// received, and emits the buffered events of the previous commit ts.
+func (n *sinkNode) bufferEvent(ctx context.Context, event *model.PolymorphicEvent) error {
+	if len(n.pendingEvents) > 0 && n.pendingEvents[0].CRTs != event.CRTs {
+		if err := n.emitPendingEvents(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	n.pendingEvents = append(n.pendingEvents, event)
+	return nil
+}
+
+// emitPendingEvents filters the buffered events by markFilter and emits them.
+func (n *sinkNode) emitPendingEvents(ctx context.Context) error {
+	if len(n.pendingEvents) == 0 {
+		return nil
+	}
+	for _, event := range n.markFilter.FilterEvents(n.pendingEvents) {
+		if err := n.emitRowToSink(ctx, event); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for i := range n.pendingEvents {
+		n.pendingEvents[i] = nil
+	}
+	n.pendingEvents = n.pendingEvents[:0]
+	return nil
+}
+
 func (n *sinkNode) updateBarrierTs(ctx context.Context, ts model.Ts) error {
 	atomic.StoreUint64(&n.barrierTs, ts)
 	if err := n.flushSink(ctx, n.getResolvedTs()); err != nil {
@@ -23,6 +23,8 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/redo"
 	mocksink "github.com/pingcap/tiflow/cdc/sink/mock"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	pmessage "github.com/pingcap/tiflow/pkg/pipeline/message"
 	"github.com/stretchr/testify/require"
@@ -403,6 +405,72 @@ func TestManyTs(t *testing.T) {
 	require.Equal(t, uint64(2), node.CheckpointTs())
 }
 
+func TestFilterMarkedTxn(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state := tablepb.TableStatePrepared
+	sink := mocksink.NewNormalMockSink()
+	node := newSinkNode(1, sink, nil, 0, 10, &mockFlowController{}, redo.NewDisabledManager(),
+		&state, model.DefaultChangeFeedID("changefeed-id-test"), true, false)
+	node.markFilter = bidirectional.NewFilter(&config.BidirectionalConfig{
+		ReplicationID:        1,
+		FilterReplicationIDs: []uint64{2},
+	})
+
+	table := &model.TableName{Schema: "test", Table: "t1"}
+	markTable := &model.TableName{Schema: bidirectional.SchemaName, Table: bidirectional.MarkTableName}
+	newRow := func(startTs, commitTs model.Ts) pmessage.Message {
+		return pmessage.PolymorphicEventMessage(&model.PolymorphicEvent{
+			StartTs: startTs, CRTs: commitTs,
+			RawKV: &model.RawKVEntry{OpType: model.OpTypePut},
+			Row: &model.RowChangedEvent{
+				StartTs: startTs, CommitTs: commitTs, Table: table,
+				Columns: []*model.Column{{Name: "id", Flag: model.HandleKeyFlag, Value: 1}},
+			},
+		})
+	}
+	newMark := func(startTs, commitTs model.Ts, replicationID uint64) pmessage.Message {
+		return pmessage.PolymorphicEventMessage(&model.PolymorphicEvent{
+			StartTs: startTs, CRTs: commitTs,
+			RawKV: &model.RawKVEntry{OpType: model.OpTypePut},
+			Row: &model.RowChangedEvent{
+				StartTs: startTs, CommitTs: commitTs, Table: markTable,
+				Columns: []*model.Column{
+					{Name: "id", Flag: model.HandleKeyFlag, Value: int64(1)},
+					{Name: bidirectional.ReplicationIDColumn, Value: replicationID},
+				},
+			},
+		})
+	}
+	receivedStartTs := func() []model.Ts {
+		var res []model.Ts
+		for _, data := range sink.Received {
+			if data.Row != nil {
+				res = append(res, data.Row.StartTs)
+			}
+		}
+		return res
+	}
+
+	// The mark of transaction 1 is received after its row.
+	for _, msg := range []pmessage.Message{
+		newRow(1, 5), newRow(2, 5), newMark(1, 5, 2), newRow(3, 6),
+	} {
+		ok, err := node.HandleMessage(ctx, msg)
+		require.Nil(t, err)
+		require.True(t, ok)
+	}
+	// The events of commit ts 6 are buffered until they are resolved.
+	require.Equal(t, []model.Ts{2}, receivedStartTs())
+
+	state = tablepb.TableStateReplicating
+	ok, err := node.HandleMessage(ctx, pmessage.PolymorphicEventMessage(
+		model.NewResolvedPolymorphicEvent(0, 6)))
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, []model.Ts{2, 3}, receivedStartTs())
+}
+
 func TestIgnoreEmptyRowChangeEvent(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
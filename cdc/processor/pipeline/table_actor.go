@@ -30,10 +30,12 @@ import (
 	sinkv2 "github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
 	"github.com/pingcap/tiflow/pkg/actor"
 	"github.com/pingcap/tiflow/pkg/actor/message"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	serverConfig "github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pmessage "github.com/pingcap/tiflow/pkg/pipeline/message"
+	"github.com/pingcap/tiflow/pkg/regionspan"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	uberatomic "go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -85,6 +87,8 @@ type tableActor struct {
 
 	// TODO: try to reduce these config fields below in the future
 	tableID        int64
+	span           tablepb.Span
+	markSpan       *regionspan.Span
 	targetTs       model.Ts
 	memoryQuota    uint64
 	replicaInfo    *model.TableReplicaInfo
@@ -110,7 +114,8 @@ func NewTableActor(
 	cdcCtx cdcContext.Context,
 	up *upstream.Upstream,
 	mounter entry.Mounter,
-	tableID model.TableID,
+	span tablepb.Span,
+	markSpan *regionspan.Span,
 	tableName string,
 	replicaInfo *model.TableReplicaInfo,
 	sinkV1 sinkv1.Sink,
@@ -137,7 +142,9 @@ func NewTableActor(
 		cancel:    cancel,
 
 		state:         tablepb.TableStatePreparing,
-		tableID:       tableID,
+		tableID:       span.TableID,
+		span:          span,
+		markSpan:      markSpan,
 		tableName:     tableName,
 		memoryQuota:   serverConfig.GetGlobalServerConfig().PerTableMemoryQuota,
 		upstream:      up,
@@ -171,7 +178,8 @@ func NewTableActor(
 	log.Info("table actor started",
 		zap.String("namespace", table.changefeedID.Namespace),
 		zap.String("changefeed", table.changefeedID.ID),
-		zap.Int64("tableID", tableID),
+		zap.Int64("tableID", span.TableID),
+		zap.String("span", tablepb.FormatSpan(span)),
 		zap.String("tableName", tableName),
 		zap.Uint64("checkpointTs", replicaInfo.StartTs),
 		zap.Uint64("quota", table.memoryQuota),
@@ -310,7 +318,7 @@ func (t *tableActor) start(sdtTableContext context.Context) error {
 		return err
 	}
 
-	pullerNode := newPullerNode(t.tableID, t.replicaInfo.StartTs, t.tableName, t.changefeedVars.ID)
+	pullerNode := newPullerNode(t.span, t.markSpan, t.replicaInfo.StartTs, t.tableName, t.changefeedVars.ID)
 	pullerActorNodeContext := newContext(sdtTableContext,
 		t.tableName,
 		t.globalVars.TableActorSystem.Router(),
@@ -334,6 +342,9 @@ func (t *tableActor) start(sdtTableContext context.Context) error {
 		t.replicaInfo.StartTs, t.targetTs, flowController, t.redoManager,
 		&t.state, t.changefeedID, t.replicaConfig.EnableOldValue, splitTxn,
 	)
+	if t.markSpan != nil {
+		actorSinkNode.markFilter = bidirectional.NewFilter(t.replicaConfig.Bidirectional)
+	}
 	t.sinkNode = actorSinkNode
 
 	// construct sink actor node, it gets message from sortNode
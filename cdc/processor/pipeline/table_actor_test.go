@@ -407,7 +407,7 @@ func TestNewTableActor(t *testing.T) {
 	startSorter = func(t *tableActor, ctx *actorNodeContext) error {
 		return nil
 	}
-	tbl, err := NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, 1, "t1",
+	tbl, err := NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, tablepb.TableSpan(1), nil, "t1",
 		&model.TableReplicaInfo{
 			StartTs: 0,
 		}, mocksink.NewNormalMockSink(), nil, redo.NewDisabledManager(), 10)
@@ -423,7 +423,7 @@ func TestNewTableActor(t *testing.T) {
 		return errors.New("failed to start puller")
 	}
 
-	tbl, err = NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, 1, "t1",
+	tbl, err = NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, tablepb.TableSpan(1), nil, "t1",
 		&model.TableReplicaInfo{
 			StartTs: 0,
 		}, mocksink.NewNormalMockSink(), nil, redo.NewDisabledManager(), 10)
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/kv"
@@ -37,22 +38,31 @@ import (
 	sinkv1 "github.com/pingcap/tiflow/cdc/sink"
 	sinkmetric "github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/factory"
+	"github.com/pingcap/tiflow/cdc/sinkv2/globaltxn"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"github.com/pingcap/tiflow/pkg/regionspan"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
 const (
 	backoffBaseDelayInMs = 5
 	maxTries             = 3
+
+	// defaultMarkTableWaitTimeout is how long the processor waits for the
+	// mark table of bidirectional replication before reporting an error.
+	defaultMarkTableWaitTimeout = time.Minute
 )
 
 type processor struct {
@@ -62,7 +72,7 @@ type processor struct {
 
 	upstream *upstream.Upstream
 
-	tables map[model.TableID]tablepb.TablePipeline
+	tables *tablepb.SpanMap[tablepb.TablePipeline]
 
 	schemaStorage entry.SchemaStorage
 	lastSchemaTs  model.Ts
@@ -71,7 +81,10 @@ type processor struct {
 	mounter       entry.Mounter
 	sinkV1        sinkv1.Sink
 	sinkV2Factory *factory.SinkFactory
-	redoManager   redo.LogManager
+	// globalTxnClient is used instead of sinks if transactions across
+	// tables should be written to the downstream atomically.
+	globalTxnClient *globaltxn.Client
+	redoManager     redo.LogManager
 
 	initialized bool
 	errCh       chan error
@@ -79,7 +92,8 @@ type processor struct {
 	wg          sync.WaitGroup
 
 	lazyInit            func(ctx cdcContext.Context) error
-	createTablePipeline func(ctx cdcContext.Context, tableID model.TableID, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error)
+	createTablePipeline func(ctx cdcContext.Context, span tablepb.Span, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error)
+	lookupMarkTable     func() (model.TableID, bool, error)
 	newAgent            func(cdcContext.Context, *model.Liveness) (scheduler.Agent, error)
 
 	liveness     *model.Liveness
@@ -87,6 +101,15 @@ type processor struct {
 	checkpointTs model.Ts
 	resolvedTs   model.Ts
 
+	// markTableID is the ID of the mark table of bidirectional replication,
+	// 0 if it's not resolved yet, see resolveMarkTable.
+	markTableID model.TableID
+	// markTableChanged is set by the DDL handler if a DDL may change the
+	// mark table, so that its ID is checked again, see checkMarkTable.
+	markTableChanged     atomic.Bool
+	markTableWaitStart   time.Time
+	markTableWaitTimeout time.Duration
+
 	metricResolvedTsGauge           prometheus.Gauge
 	metricResolvedTsLagGauge        prometheus.Gauge
 	metricMinResolvedTableIDGauge   prometheus.Gauge
@@ -109,13 +132,13 @@ func (p *processor) checkReadyForMessages() bool {
 	return p.changefeed != nil && p.changefeed.Status != nil
 }
 
-// AddTable implements TableExecutor interface.
-// AddTable may cause by the following scenario
+// AddTableSpan implements TableExecutor interface.
+// AddTableSpan may cause by the following scenario
 // 1. `Create Table`, a new table dispatched to the processor, `isPrepare` should be false
 // 2. Prepare phase for 2 phase scheduling, `isPrepare` should be true.
 // 3. Replicating phase for 2 phase scheduling, `isPrepare` should be false
-func (p *processor) AddTable(
-	ctx context.Context, tableID model.TableID, startTs model.Ts, isPrepare bool,
+func (p *processor) AddTableSpan(
+	ctx context.Context, span tablepb.Span, startTs model.Ts, isPrepare bool,
 ) (bool, error) {
 	if !p.checkReadyForMessages() {
 		return false, nil
@@ -126,12 +149,12 @@ func (p *processor) AddTable(
 			zap.String("captureID", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
-			zap.Int64("tableID", tableID),
+			zap.String("span", tablepb.FormatSpan(span)),
 			zap.Uint64("checkpointTs", startTs),
 			zap.Bool("isPrepare", isPrepare))
 	}
 
-	table, ok := p.tables[tableID]
+	table, ok := p.tables.Get(span)
 	if ok {
 		switch table.State() {
 		// table is still `preparing`, which means the table is `replicating` on other captures.
@@ -141,7 +164,7 @@ func (p *processor) AddTable(
 				zap.String("captureID", p.captureInfo.ID),
 				zap.String("namespace", p.changefeedID.Namespace),
 				zap.String("changefeed", p.changefeedID.ID),
-				zap.Int64("tableID", tableID),
+				zap.String("span", tablepb.FormatSpan(span)),
 				zap.Uint64("checkpointTs", startTs),
 				zap.Bool("isPrepare", isPrepare))
 			return true, nil
@@ -157,7 +180,7 @@ func (p *processor) AddTable(
 				zap.String("captureID", p.captureInfo.ID),
 				zap.String("namespace", p.changefeedID.Namespace),
 				zap.String("changefeed", p.changefeedID.ID),
-				zap.Int64("tableID", tableID),
+				zap.String("span", tablepb.FormatSpan(span)),
 				zap.Uint64("checkpointTs", startTs),
 				zap.Bool("isPrepare", isPrepare))
 			return true, nil
@@ -166,10 +189,10 @@ func (p *processor) AddTable(
 				zap.String("captureID", p.captureInfo.ID),
 				zap.String("namespace", p.changefeedID.Namespace),
 				zap.String("changefeed", p.changefeedID.ID),
-				zap.Int64("tableID", tableID),
+				zap.String("span", tablepb.FormatSpan(span)),
 				zap.Uint64("checkpointTs", startTs),
 				zap.Bool("isPrepare", isPrepare))
-			p.removeTable(table, tableID)
+			p.removeTable(table, span)
 		}
 	}
 
@@ -182,43 +205,47 @@ func (p *processor) AddTable(
 			zap.String("captureID", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
-			zap.Int64("tableID", tableID),
+			zap.String("span", tablepb.FormatSpan(span)),
 			zap.Uint64("checkpointTs", startTs),
 			zap.Bool("isPrepare", isPrepare))
 	}
 
+	if ready, err := p.resolveMarkTable(); err != nil || !ready {
+		return false, errors.Trace(err)
+	}
+
 	table, err := p.createTablePipeline(
-		ctx.(cdcContext.Context), tableID, &model.TableReplicaInfo{StartTs: startTs})
+		ctx.(cdcContext.Context), span, &model.TableReplicaInfo{StartTs: startTs})
 	if err != nil {
 		return false, errors.Trace(err)
 	}
-	p.tables[tableID] = table
+	p.tables.ReplaceOrInsert(span, table)
 	if !isPrepare {
 		table.Start(startTs)
 		log.Debug("start table",
 			zap.String("captureID", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
-			zap.Int64("tableID", tableID),
+			zap.String("span", tablepb.FormatSpan(span)),
 			zap.Uint64("startTs", startTs))
 	}
 
 	return true, nil
 }
 
-// RemoveTable implements TableExecutor interface.
-func (p *processor) RemoveTable(tableID model.TableID) bool {
+// RemoveTableSpan implements TableExecutor interface.
+func (p *processor) RemoveTableSpan(span tablepb.Span) bool {
 	if !p.checkReadyForMessages() {
 		return false
 	}
 
-	table, ok := p.tables[tableID]
+	table, ok := p.tables.Get(span)
 	if !ok {
 		log.Warn("table which will be deleted is not found",
 			zap.String("capture", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
-			zap.Int64("tableID", tableID))
+			zap.String("span", tablepb.FormatSpan(span)))
 		return true
 	}
 
@@ -230,25 +257,25 @@ func (p *processor) RemoveTable(tableID model.TableID) bool {
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
 			zap.Uint64("checkpointTs", table.CheckpointTs()),
-			zap.Int64("tableID", tableID))
+			zap.String("span", tablepb.FormatSpan(span)))
 		return false
 	}
 	return true
 }
 
-// IsAddTableFinished implements TableExecutor interface.
-func (p *processor) IsAddTableFinished(tableID model.TableID, isPrepare bool) bool {
+// IsAddTableSpanFinished implements TableExecutor interface.
+func (p *processor) IsAddTableSpanFinished(span tablepb.Span, isPrepare bool) bool {
 	if !p.checkReadyForMessages() {
 		return false
 	}
 
-	table, exist := p.tables[tableID]
+	table, exist := p.tables.Get(span)
 	if !exist {
 		log.Panic("table which was added is not found",
 			zap.String("captureID", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
-			zap.Int64("tableID", tableID),
+			zap.String("span", tablepb.FormatSpan(span)),
 			zap.Bool("isPrepare", isPrepare))
 	}
 
@@ -292,7 +319,7 @@ func (p *processor) IsAddTableFinished(tableID model.TableID, isPrepare bool) bo
 			zap.String("captureID", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
-			zap.Int64("tableID", tableID),
+			zap.String("span", tablepb.FormatSpan(span)),
 			zap.Uint64("tableResolvedTs", table.ResolvedTs()),
 			zap.Uint64("localResolvedTs", localResolvedTs),
 			zap.Uint64("globalResolvedTs", globalResolvedTs),
@@ -307,7 +334,7 @@ func (p *processor) IsAddTableFinished(tableID model.TableID, isPrepare bool) bo
 		zap.String("captureID", p.captureInfo.ID),
 		zap.String("namespace", p.changefeedID.Namespace),
 		zap.String("changefeed", p.changefeedID.ID),
-		zap.Int64("tableID", tableID),
+		zap.String("span", tablepb.FormatSpan(span)),
 		zap.Uint64("tableResolvedTs", table.ResolvedTs()),
 		zap.Uint64("localResolvedTs", localResolvedTs),
 		zap.Uint64("globalResolvedTs", globalResolvedTs),
@@ -318,19 +345,19 @@ func (p *processor) IsAddTableFinished(tableID model.TableID, isPrepare bool) bo
 	return true
 }
 
-// IsRemoveTableFinished implements TableExecutor interface.
-func (p *processor) IsRemoveTableFinished(tableID model.TableID) (model.Ts, bool) {
+// IsRemoveTableSpanFinished implements TableExecutor interface.
+func (p *processor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool) {
 	if !p.checkReadyForMessages() {
 		return 0, false
 	}
 
-	table, exist := p.tables[tableID]
+	table, exist := p.tables.Get(span)
 	if !exist {
 		log.Warn("table should be removing but not found",
 			zap.String("captureID", p.captureInfo.ID),
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
-			zap.Int64("tableID", tableID))
+			zap.String("span", tablepb.FormatSpan(span)))
 		return 0, true
 	}
 	status := table.State()
@@ -340,7 +367,7 @@ func (p *processor) IsRemoveTableFinished(tableID model.TableID) (model.Ts, bool
 			zap.String("namespace", p.changefeedID.Namespace),
 			zap.String("changefeed", p.changefeedID.ID),
 			zap.Uint64("checkpointTs", table.CheckpointTs()),
-			zap.Int64("tableID", tableID),
+			zap.String("span", tablepb.FormatSpan(span)),
 			zap.Any("tableStatus", status))
 		return 0, false
 	}
@@ -348,25 +375,21 @@ func (p *processor) IsRemoveTableFinished(tableID model.TableID) (model.Ts, bool
 	p.metricRemainKVEventGauge.Sub(float64(table.RemainEvents()))
 	table.Cancel()
 	table.Wait()
-	delete(p.tables, tableID)
+	p.tables.Delete(span)
 
 	checkpointTs := table.CheckpointTs()
 	log.Info("table removed",
 		zap.String("captureID", p.captureInfo.ID),
 		zap.String("namespace", p.changefeedID.Namespace),
 		zap.String("changefeed", p.changefeedID.ID),
-		zap.Int64("tableID", tableID),
+		zap.String("span", tablepb.FormatSpan(span)),
 		zap.Uint64("checkpointTs", checkpointTs))
 	return checkpointTs, true
 }
 
-// GetAllCurrentTables implements TableExecutor interface.
-func (p *processor) GetAllCurrentTables() []model.TableID {
-	ret := make([]model.TableID, 0, len(p.tables))
-	for tableID := range p.tables {
-		ret = append(ret, tableID)
-	}
-	return ret
+// GetAllCurrentTableSpans implements TableExecutor interface.
+func (p *processor) GetAllCurrentTableSpans() []tablepb.Span {
+	return p.tables.Spans()
 }
 
 // GetCheckpoint implements TableExecutor interface.
@@ -374,17 +397,19 @@ func (p *processor) GetCheckpoint() (checkpointTs, resolvedTs model.Ts) {
 	return p.checkpointTs, p.resolvedTs
 }
 
-// GetTableStatus implements TableExecutor interface
-func (p *processor) GetTableStatus(tableID model.TableID) tablepb.TableStatus {
-	table, ok := p.tables[tableID]
+// GetTableSpanStatus implements TableExecutor interface
+func (p *processor) GetTableSpanStatus(span tablepb.Span) tablepb.TableStatus {
+	table, ok := p.tables.Get(span)
 	if !ok {
 		return tablepb.TableStatus{
-			TableID: tableID,
+			TableID: span.TableID,
+			Span:    span,
 			State:   tablepb.TableStateAbsent,
 		}
 	}
 	return tablepb.TableStatus{
-		TableID: tableID,
+		TableID: span.TableID,
+		Span:    span,
 		Checkpoint: tablepb.Checkpoint{
 			CheckpointTs: table.CheckpointTs(),
 			ResolvedTs:   table.ResolvedTs(),
@@ -404,13 +429,15 @@ func newProcessor(
 	p := &processor{
 		changefeed:   state,
 		upstream:     up,
-		tables:       make(map[model.TableID]tablepb.TablePipeline),
+		tables:       tablepb.NewSpanMap[tablepb.TablePipeline](),
 		errCh:        make(chan error, 1),
 		changefeedID: changefeedID,
 		captureInfo:  captureInfo,
 		cancel:       func() {},
 		liveness:     liveness,
 
+		markTableWaitTimeout: defaultMarkTableWaitTimeout,
+
 		metricResolvedTsGauge: resolvedTsGauge.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricResolvedTsLagGauge: resolvedTsLagGauge.
@@ -442,6 +469,7 @@ func newProcessor(
 	}
 	p.createTablePipeline = p.createTablePipelineImpl
 	p.lazyInit = p.lazyInitImpl
+	p.lookupMarkTable = p.lookupMarkTableImpl
 	p.newAgent = p.newAgentImpl
 	return p
 }
@@ -568,9 +596,21 @@ func (p *processor) tick(ctx cdcContext.Context) error {
 	// local time when an error return, which is acceptable
 	pdTime, _ := p.upstream.PDClock.CurrentTime()
 
+	if err := p.checkMarkTable(); err != nil {
+		return errors.Trace(err)
+	}
+
 	p.handlePosition(oracle.GetPhysical(pdTime))
 	p.pushResolvedTs2Table()
 
+	if p.sinkV2Factory != nil {
+		// The throttle config can be updated when the changefeed is running,
+		// and the limits are split among the captures replicating it, every
+		// one of them has a task position of the changefeed.
+		p.sinkV2Factory.UpdateThrottle(p.changefeed.Info.Config.Throttle,
+			len(p.changefeed.TaskPositions))
+	}
+
 	p.doGCSchemaStorage()
 
 	if p.redoManager != nil && p.redoManager.Enabled() {
@@ -665,11 +705,31 @@ func (p *processor) lazyInitImpl(ctx cdcContext.Context) error {
 		contextutil.TimezoneFromCtx(ctx),
 		p.filter,
 		p.changefeed.Info.Config.EnableOldValue,
+		p.changefeed.Info.Config.EmitVirtualGeneratedColumns,
 	)
 
 	start := time.Now()
 	conf := config.GetGlobalServerConfig()
-	if !conf.Debug.EnableNewSink {
+	if p.changefeed.Info.Config.Sink.TxnAtomicity.IsGlobal() {
+		// Rows are forwarded to the global transaction coordinator which runs
+		// along with the owner, and it writes them to the downstream.
+		log.Info("Try to create global transaction client")
+		p.globalTxnClient, err = globaltxn.NewClient(stdCtx, p.changefeedID,
+			p.captureInfo.ID, ctx.GlobalVars().MessageServer, ctx.GlobalVars().MessageRouter)
+		if err != nil {
+			log.Info("processor creates global transaction client failed",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Error(err),
+				zap.Duration("duration", time.Since(start)))
+			return errors.Trace(err)
+		}
+	} else if !conf.Debug.EnableNewSink {
+		if p.changefeed.Info.Config.Throttle != nil {
+			// The throttle is enforced by the table sinks of sinkV2 only.
+			return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				"throttle can not be used when the new sink is disabled")
+		}
 		log.Info("Try to create sinkV1")
 		s, err := sinkv1.New(
 			stdCtx,
@@ -693,7 +753,10 @@ func (p *processor) lazyInitImpl(ctx cdcContext.Context) error {
 		}
 	} else {
 		log.Info("Try to create sinkV2")
-		sinkV2Factory, err := factory.New(stdCtx, p.changefeed.Info.SinkURI,
+		// The transactional Kafka producer derives its transactional ID
+		// from the capture address.
+		sinkCtx := contextutil.PutCaptureAddrInCtx(stdCtx, p.captureInfo.AdvertiseAddr)
+		sinkV2Factory, err := factory.New(sinkCtx, p.changefeed.Info.SinkURI,
 			p.changefeed.Info.Config,
 			errCh)
 		if err != nil {
@@ -813,6 +876,7 @@ func (p *processor) createAndDriveSchemaStorage(ctx cdcContext.Context) (entry.S
 		defer p.wg.Done()
 		p.sendError(ddlPuller.Run(stdCtx))
 	}()
+	isBidirectional := p.changefeed.Info.Config.Bidirectional != nil
 	p.wg.Add(1)
 	go func() {
 		defer p.wg.Done()
@@ -839,6 +903,9 @@ func (p *processor) createAndDriveSchemaStorage(ctx cdcContext.Context) (entry.S
 				p.sendError(errors.Trace(err))
 				return
 			}
+			if isBidirectional && mayChangeMarkTable(job) {
+				p.markTableChanged.Store(true)
+			}
 		}
 	}()
 	return schemaStorage, nil
@@ -864,23 +931,25 @@ func (p *processor) handlePosition(currentTs int64) {
 	if p.schemaStorage != nil {
 		minResolvedTs = p.schemaStorage.ResolvedTs()
 	}
-	for _, table := range p.tables {
+	p.tables.Range(func(_ tablepb.Span, table tablepb.TablePipeline) bool {
 		ts := table.ResolvedTs()
 		if ts < minResolvedTs {
 			minResolvedTs = ts
 			minResolvedTableID = table.ID()
 		}
-	}
+		return true
+	})
 
 	minCheckpointTs := minResolvedTs
 	minCheckpointTableID := int64(0)
-	for _, table := range p.tables {
+	p.tables.Range(func(_ tablepb.Span, table tablepb.TablePipeline) bool {
 		ts := table.CheckpointTs()
 		if ts < minCheckpointTs {
 			minCheckpointTs = ts
 			minCheckpointTableID = table.ID()
 		}
-	}
+		return true
+	})
 
 	resolvedPhyTs := oracle.ExtractPhysical(minResolvedTs)
 	p.metricResolvedTsLagGauge.Set(float64(currentTs-resolvedPhyTs) / 1e3)
@@ -907,9 +976,10 @@ func (p *processor) pushResolvedTs2Table() {
 		// may pile up in memory, as they have to wait DDL.
 		resolvedTs = schemaResolvedTs
 	}
-	for _, table := range p.tables {
+	p.tables.Range(func(_ tablepb.Span, table tablepb.TablePipeline) bool {
 		table.UpdateBarrierTs(resolvedTs)
-	}
+		return true
+	})
 }
 
 func (p *processor) getTableName(ctx context.Context, tableID model.TableID) string {
@@ -935,9 +1005,141 @@ func (p *processor) getTableName(ctx context.Context, tableID model.TableID) str
 	return tableName.QuoteString()
 }
 
+// resolveMarkTable resolves the ID of the mark table if the changefeed is a
+// part of a bidirectional replication. The mark table is created in the
+// upstream by the sink of the reverse changefeed, which may be started later
+// than this one, so it returns false to retry adding tables later until the
+// mark table is created. The wait is reported as an error of the changefeed
+// once it exceeds markTableWaitTimeout, and the owner restarts the changefeed
+// later to wait again.
+func (p *processor) resolveMarkTable() (bool, error) {
+	if p.changefeed.Info.Config.Bidirectional == nil || p.markTableID != 0 {
+		return true, nil
+	}
+	markTable := quotes.QuoteSchema(bidirectional.SchemaName, bidirectional.MarkTableName)
+	markTableID, ok, err := p.lookupMarkTable()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if !ok {
+		if p.markTableWaitStart.IsZero() {
+			p.markTableWaitStart = time.Now()
+			log.Warn("waiting for the mark table of bidirectional replication, "+
+				"which is created in the upstream by the changefeed of the reverse direction",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.String("markTable", markTable))
+		}
+		if time.Since(p.markTableWaitStart) > p.markTableWaitTimeout {
+			return false, cerror.ErrMarkTableNotFound.GenWithStackByArgs(markTable)
+		}
+		return false, nil
+	}
+	log.Info("mark table of bidirectional replication is resolved",
+		zap.String("namespace", p.changefeedID.Namespace),
+		zap.String("changefeed", p.changefeedID.ID),
+		zap.Int64("markTableID", markTableID))
+	p.markTableID = markTableID
+	p.markTableWaitStart = time.Time{}
+	return true, nil
+}
+
+// checkMarkTable invalidates the resolved mark table if it's dropped or
+// recreated by a DDL. The mark rows of the running tables are pulled by the
+// old ID, so an error is returned to restart them.
+func (p *processor) checkMarkTable() error {
+	if !p.markTableChanged.Swap(false) || p.markTableID == 0 {
+		return nil
+	}
+	markTableID, ok, err := p.lookupMarkTable()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ok && markTableID == p.markTableID {
+		return nil
+	}
+	log.Warn("mark table of bidirectional replication is changed",
+		zap.String("namespace", p.changefeedID.Namespace),
+		zap.String("changefeed", p.changefeedID.ID),
+		zap.Int64("oldMarkTableID", p.markTableID),
+		zap.Int64("newMarkTableID", markTableID))
+	oldMarkTableID := p.markTableID
+	p.markTableID = 0
+	if p.tables.Len() > 0 {
+		return cerror.ErrMarkTableChanged.GenWithStackByArgs(oldMarkTableID)
+	}
+	return nil
+}
+
+// mayChangeMarkTable returns true if the DDL job may change the ID of the
+// mark table. The old name of a renamed table is not in the job, so all the
+// renames are included.
+func mayChangeMarkTable(job *timodel.Job) bool {
+	switch job.Type {
+	case timodel.ActionRenameTable, timodel.ActionRenameTables:
+		return true
+	}
+	return bidirectional.IsMarkTableOrSchema(job.SchemaName, job.TableName)
+}
+
+// lookupMarkTableImpl looks up the mark table in the last schema snapshot, and
+// then in the latest schema of the upstream. The mark table may be created
+// after the snapshot, but the rows marking transactions are all written after
+// it's created, so it's safe to pull them by the ID in the latest schema. The
+// DDLs of the mark table are never discarded by the filter, so the rows can be
+// mounted once the schema storage catches up.
+func (p *processor) lookupMarkTableImpl() (model.TableID, bool, error) {
+	snap := p.schemaStorage.GetLastSnapshot()
+	if markTableID, ok := snap.TableIDByName(bidirectional.SchemaName, bidirectional.MarkTableName); ok {
+		return markTableID, true, nil
+	}
+	ver, err := p.upstream.KVStorage.CurrentVersion(oracle.GlobalTxnScope)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	meta, err := kv.GetSnapshotMeta(p.upstream.KVStorage, ver.Ver)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	dbs, err := meta.ListDatabases()
+	if err != nil {
+		return 0, false, cerror.WrapError(cerror.ErrMetaListDatabases, err)
+	}
+	for _, db := range dbs {
+		if db.Name.L != bidirectional.SchemaName {
+			continue
+		}
+		tables, err := meta.ListTables(db.ID)
+		if err != nil {
+			return 0, false, cerror.WrapError(cerror.ErrMetaListDatabases, err)
+		}
+		for _, table := range tables {
+			if table.Name.L == bidirectional.MarkTableName {
+				return table.ID, true, nil
+			}
+		}
+	}
+	return 0, false, nil
+}
+
+// getMarkSpan returns the span of the mark table rows of the table if the
+// changefeed is a part of a bidirectional replication, otherwise nil.
+func (p *processor) getMarkSpan(tableID model.TableID) (*regionspan.Span, error) {
+	if p.changefeed.Info.Config.Bidirectional == nil {
+		return nil, nil
+	}
+	snap := p.schemaStorage.GetLastSnapshot()
+	tableInfo, ok := snap.PhysicalTableByID(tableID)
+	if !ok {
+		return nil, cerror.ErrSnapshotTableNotFound.GenWithStackByArgs(tableID)
+	}
+	span := bidirectional.MarkSpan(p.markTableID, tableInfo.TableName.Schema, tableInfo.TableName.Table)
+	return &span, nil
+}
+
 func (p *processor) createTablePipelineImpl(
 	ctx cdcContext.Context,
-	tableID model.TableID,
+	span tablepb.Span,
 	replicaInfo *model.TableReplicaInfo,
 ) (table tablepb.TablePipeline, err error) {
 	ctx = cdcContext.WithErrorHandler(ctx, func(err error) error {
@@ -949,13 +1151,35 @@ func (p *processor) createTablePipelineImpl(
 		return nil
 	})
 
+	tableID := span.TableID
 	if p.redoManager.Enabled() {
 		p.redoManager.AddTable(tableID, replicaInfo.StartTs)
 	}
 
 	tableName := p.getTableName(ctx, tableID)
+	markSpan, err := p.getMarkSpan(tableID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 
-	if p.sinkV1 != nil {
+	if p.globalTxnClient != nil {
+		s := p.globalTxnClient.CreateTableSink(tableID, replicaInfo.StartTs, p.metricsTableSinkTotalRows)
+		table, err = pipeline.NewTableActor(
+			ctx,
+			p.upstream,
+			p.mounter,
+			span,
+			markSpan,
+			tableName,
+			replicaInfo,
+			nil,
+			s,
+			p.redoManager,
+			p.changefeed.Info.GetTargetTs())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else if p.sinkV1 != nil {
 		s, err := sinkv1.NewTableSink(p.sinkV1, tableID, p.metricsTableSinkTotalRows)
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -964,7 +1188,8 @@ func (p *processor) createTablePipelineImpl(
 			ctx,
 			p.upstream,
 			p.mounter,
-			tableID,
+			span,
+			markSpan,
 			tableName,
 			replicaInfo,
 			s,
@@ -981,7 +1206,8 @@ func (p *processor) createTablePipelineImpl(
 			ctx,
 			p.upstream,
 			p.mounter,
-			tableID,
+			span,
+			markSpan,
 			tableName,
 			replicaInfo,
 			nil,
@@ -996,12 +1222,12 @@ func (p *processor) createTablePipelineImpl(
 	return table, nil
 }
 
-func (p *processor) removeTable(table tablepb.TablePipeline, tableID model.TableID) {
+func (p *processor) removeTable(table tablepb.TablePipeline, span tablepb.Span) {
 	table.Cancel()
 	table.Wait()
-	delete(p.tables, tableID)
+	p.tables.Delete(span)
 	if p.redoManager.Enabled() {
-		p.redoManager.RemoveTable(tableID)
+		p.redoManager.RemoveTable(span.TableID)
 	}
 }
 
@@ -1036,7 +1262,7 @@ func (p *processor) doGCSchemaStorage() {
 func (p *processor) refreshMetrics() {
 	var totalConsumed uint64
 	var totalEvents int64
-	for _, table := range p.tables {
+	p.tables.Range(func(_ tablepb.Span, table tablepb.TablePipeline) bool {
 		consumed := table.MemoryConsumption()
 		p.metricsTableMemoryHistogram.Observe(float64(consumed))
 		totalConsumed += consumed
@@ -1044,9 +1270,10 @@ func (p *processor) refreshMetrics() {
 		if events > 0 {
 			totalEvents += events
 		}
-	}
+		return true
+	})
 	p.metricsProcessorMemoryGauge.Set(float64(totalConsumed))
-	p.metricSyncTableNumGauge.Set(float64(len(p.tables)))
+	p.metricSyncTableNumGauge.Set(float64(p.tables.Len()))
 	p.metricRemainKVEventGauge.Set(float64(totalEvents))
 }
 
@@ -1054,12 +1281,14 @@ func (p *processor) Close() error {
 	log.Info("processor closing ...",
 		zap.String("namespace", p.changefeedID.Namespace),
 		zap.String("changefeed", p.changefeedID.ID))
-	for _, tbl := range p.tables {
+	p.tables.Range(func(_ tablepb.Span, tbl tablepb.TablePipeline) bool {
 		tbl.Cancel()
-	}
-	for _, tbl := range p.tables {
+		return true
+	})
+	p.tables.Range(func(_ tablepb.Span, tbl tablepb.TablePipeline) bool {
 		tbl.Wait()
-	}
+		return true
+	})
 	p.cancel()
 	p.wg.Wait()
 
@@ -1071,7 +1300,16 @@ func (p *processor) Close() error {
 	}
 
 	// sink close might be time-consuming, do it the last.
-	if p.sinkV1 != nil {
+	if p.globalTxnClient != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := p.globalTxnClient.Close(ctx); err != nil {
+			log.Warn("processor close global transaction client failed",
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Error(err))
+		}
+	} else if p.sinkV1 != nil {
 		// pass a canceled context is ok here, since we don't need to wait Close
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -1136,8 +1374,9 @@ func (p *processor) cleanupMetrics() {
 // WriteDebugInfo write the debug info to Writer
 func (p *processor) WriteDebugInfo(w io.Writer) {
 	fmt.Fprintf(w, "%+v\n", *p.changefeed)
-	for tableID, tablePipeline := range p.tables {
-		fmt.Fprintf(w, "tableID: %d, tableName: %s, resolvedTs: %d, checkpointTs: %d, state: %s\n",
-			tableID, tablePipeline.Name(), tablePipeline.ResolvedTs(), tablePipeline.CheckpointTs(), tablePipeline.State())
-	}
+	p.tables.Ascend(func(span tablepb.Span, tablePipeline tablepb.TablePipeline) bool {
+		fmt.Fprintf(w, "span: %s, tableName: %s, resolvedTs: %d, checkpointTs: %d, state: %s\n",
+			tablepb.FormatSpan(span), tablePipeline.Name(), tablePipeline.ResolvedTs(), tablePipeline.CheckpointTs(), tablePipeline.State())
+		return true
+	})
 }
@@ -20,9 +20,11 @@ import (
 	"math"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -45,7 +47,7 @@ func newProcessor4Test(
 	t *testing.T,
 	state *orchestrator.ChangefeedReactorState,
 	captureInfo *model.CaptureInfo,
-	createTablePipeline func(ctx cdcContext.Context, tableID model.TableID, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error),
+	createTablePipeline func(ctx cdcContext.Context, span tablepb.Span, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error),
 	liveness *model.Liveness,
 ) *processor {
 	up := upstream.NewUpstream4Test(nil)
@@ -122,10 +124,10 @@ func initProcessor4Test(
 	})
 }
 
-func newMockTablePipeline(ctx cdcContext.Context, tableID model.TableID, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error) {
+func newMockTablePipeline(ctx cdcContext.Context, span tablepb.Span, replicaInfo *model.TableReplicaInfo) (tablepb.TablePipeline, error) {
 	return &mockTablePipeline{
-		tableID:      tableID,
-		name:         fmt.Sprintf("`test`.`table%d`", tableID),
+		tableID:      span.TableID,
+		name:         fmt.Sprintf("`test`.`table%d`", span.TableID),
 		state:        tablepb.TableStatePreparing,
 		resolvedTs:   replicaInfo.StartTs,
 		checkpointTs: replicaInfo.StartTs,
@@ -249,7 +251,7 @@ type mockAgent struct {
 }
 
 func (a *mockAgent) Tick(_ context.Context) error {
-	if len(a.executor.GetAllCurrentTables()) == 0 {
+	if len(a.executor.GetAllCurrentTableSpans()) == 0 {
 		return nil
 	}
 	a.lastCheckpointTs, _ = a.executor.GetCheckpoint()
@@ -292,21 +294,21 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	tester.MustApplyPatches()
 
 	// table-1: `preparing` -> `prepared` -> `replicating`
-	ok, err := p.AddTable(ctx, 1, 20, true)
+	ok, err := p.AddTableSpan(ctx, tablepb.TableSpan(1), 20, true)
 	require.NoError(t, err)
 	require.True(t, ok)
 
-	table1 := p.tables[1].(*mockTablePipeline)
+	table1 := p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline)
 	require.Equal(t, model.Ts(20), table1.resolvedTs)
 	require.Equal(t, model.Ts(20), table1.checkpointTs)
 	require.Equal(t, model.Ts(0), table1.sinkStartTs)
 
-	require.Len(t, p.tables, 1)
+	require.Equal(t, 1, p.tables.Len())
 
 	checkpointTs := p.agent.GetLastSentCheckpointTs()
 	require.Equal(t, checkpointTs, model.Ts(0))
 
-	done := p.IsAddTableFinished(1, true)
+	done := p.IsAddTableSpanFinished(tablepb.TableSpan(1), true)
 	require.False(t, done)
 	require.Equal(t, tablepb.TableStatePreparing, table1.State())
 
@@ -317,7 +319,7 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	require.Nil(t, err)
 	tester.MustApplyPatches()
 
-	done = p.IsAddTableFinished(1, true)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(1), true)
 	require.True(t, done)
 	require.Equal(t, tablepb.TableStatePrepared, table1.State())
 
@@ -325,12 +327,12 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	checkpointTs = p.agent.GetLastSentCheckpointTs()
 	require.Equal(t, checkpointTs, model.Ts(20))
 
-	ok, err = p.AddTable(ctx, 1, 30, true)
+	ok, err = p.AddTableSpan(ctx, tablepb.TableSpan(1), 30, true)
 	require.NoError(t, err)
 	require.True(t, ok)
 	require.Equal(t, model.Ts(0), table1.sinkStartTs)
 
-	ok, err = p.AddTable(ctx, 1, 30, false)
+	ok, err = p.AddTableSpan(ctx, tablepb.TableSpan(1), 30, false)
 	require.NoError(t, err)
 	require.True(t, ok)
 	require.Equal(t, model.Ts(30), table1.sinkStartTs)
@@ -341,7 +343,7 @@ func TestTableExecutorAddingTableIndirectly(t *testing.T) {
 	require.Nil(t, err)
 	tester.MustApplyPatches()
 
-	done = p.IsAddTableFinished(1, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(1), false)
 	require.True(t, done)
 	require.Equal(t, tablepb.TableStateReplicating, table1.State())
 
@@ -375,56 +377,56 @@ func TestTableExecutorAddingTableDirectly(t *testing.T) {
 	require.NoError(t, err)
 	tester.MustApplyPatches()
 
-	ok, err := p.AddTable(ctx, 1, 20, false)
+	ok, err := p.AddTableSpan(ctx, tablepb.TableSpan(1), 20, false)
 	require.NoError(t, err)
 	require.True(t, ok)
 
-	table1 := p.tables[1].(*mockTablePipeline)
+	table1 := p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline)
 	require.Equal(t, model.Ts(20), table1.sinkStartTs)
 	require.Equal(t, tablepb.TableStatePreparing, table1.state)
-	meta := p.GetTableStatus(model.TableID(1))
+	meta := p.GetTableSpanStatus(tablepb.TableSpan(1))
 	require.Equal(t, model.TableID(1), meta.TableID)
 	require.Equal(t, tablepb.TableStatePreparing, meta.State)
 
-	ok, err = p.AddTable(ctx, 2, 20, false)
+	ok, err = p.AddTableSpan(ctx, tablepb.TableSpan(2), 20, false)
 	require.NoError(t, err)
 	require.True(t, ok)
-	table2 := p.tables[2].(*mockTablePipeline)
+	table2 := p.tables.GetV(tablepb.TableSpan(2)).(*mockTablePipeline)
 	require.Equal(t, model.Ts(20), table2.sinkStartTs)
 	require.Equal(t, tablepb.TableStatePreparing, table2.state)
 
-	ok, err = p.AddTable(ctx, 3, 20, false)
+	ok, err = p.AddTableSpan(ctx, tablepb.TableSpan(3), 20, false)
 	require.NoError(t, err)
 	require.True(t, ok)
-	table3 := p.tables[3].(*mockTablePipeline)
+	table3 := p.tables.GetV(tablepb.TableSpan(3)).(*mockTablePipeline)
 	require.Equal(t, model.Ts(20), table3.sinkStartTs)
 	require.Equal(t, tablepb.TableStatePreparing, table3.state)
 
-	ok, err = p.AddTable(ctx, 4, 20, false)
+	ok, err = p.AddTableSpan(ctx, tablepb.TableSpan(4), 20, false)
 	require.NoError(t, err)
 	require.True(t, ok)
-	table4 := p.tables[4].(*mockTablePipeline)
+	table4 := p.tables.GetV(tablepb.TableSpan(4)).(*mockTablePipeline)
 	require.Equal(t, model.Ts(20), table4.sinkStartTs)
 	require.Equal(t, tablepb.TableStatePreparing, table4.state)
 
-	require.Len(t, p.tables, 4)
+	require.Equal(t, 4, p.tables.Len())
 
 	checkpointTs := p.agent.GetLastSentCheckpointTs()
 	require.Equal(t, checkpointTs, model.Ts(0))
 
-	done := p.IsAddTableFinished(1, false)
+	done := p.IsAddTableSpanFinished(tablepb.TableSpan(1), false)
 	require.False(t, done)
 	require.Equal(t, tablepb.TableStatePreparing, table1.State())
-	done = p.IsAddTableFinished(2, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(2), false)
 	require.False(t, done)
 	require.Equal(t, tablepb.TableStatePreparing, table2.State())
-	done = p.IsAddTableFinished(3, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(3), false)
 	require.False(t, done)
 	require.Equal(t, tablepb.TableStatePreparing, table3.State())
-	done = p.IsAddTableFinished(4, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(4), false)
 	require.False(t, done)
 	require.Equal(t, tablepb.TableStatePreparing, table4.State())
-	require.Len(t, p.tables, 4)
+	require.Equal(t, 4, p.tables.Len())
 
 	err = p.Tick(ctx)
 	require.NoError(t, err)
@@ -441,16 +443,16 @@ func TestTableExecutorAddingTableDirectly(t *testing.T) {
 	table3.checkpointTs = 30
 	table4.checkpointTs = 30
 
-	done = p.IsAddTableFinished(1, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(1), false)
 	require.True(t, done)
 	require.Equal(t, tablepb.TableStateReplicating, table1.State())
-	done = p.IsAddTableFinished(2, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(2), false)
 	require.True(t, done)
 	require.Equal(t, tablepb.TableStateReplicating, table2.State())
-	done = p.IsAddTableFinished(3, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(3), false)
 	require.True(t, done)
 	require.Equal(t, tablepb.TableStateReplicating, table3.State())
-	done = p.IsAddTableFinished(4, false)
+	done = p.IsAddTableSpanFinished(tablepb.TableSpan(4), false)
 	require.True(t, done)
 	require.Equal(t, tablepb.TableStateReplicating, table4.State())
 
@@ -476,7 +478,7 @@ func TestTableExecutorAddingTableDirectly(t *testing.T) {
 	require.NoError(t, err)
 	tester.MustApplyPatches()
 
-	ok = p.RemoveTable(3)
+	ok = p.RemoveTableSpan(tablepb.TableSpan(3))
 	require.True(t, ok)
 
 	err = p.Tick(ctx)
@@ -484,11 +486,11 @@ func TestTableExecutorAddingTableDirectly(t *testing.T) {
 
 	tester.MustApplyPatches()
 
-	require.Len(t, p.tables, 4)
+	require.Equal(t, 4, p.tables.Len())
 	require.False(t, table3.canceled)
 	require.Equal(t, model.Ts(60), table3.CheckpointTs())
 
-	checkpointTs, done = p.IsRemoveTableFinished(3)
+	checkpointTs, done = p.IsRemoveTableSpanFinished(tablepb.TableSpan(3))
 	require.False(t, done)
 	require.Equal(t, model.Ts(0), checkpointTs)
 
@@ -508,17 +510,17 @@ func TestTableExecutorAddingTableDirectly(t *testing.T) {
 
 	tester.MustApplyPatches()
 
-	require.Len(t, p.tables, 4)
+	require.Equal(t, 4, p.tables.Len())
 	require.False(t, table3.canceled)
 
-	checkpointTs, done = p.IsRemoveTableFinished(3)
+	checkpointTs, done = p.IsRemoveTableSpanFinished(tablepb.TableSpan(3))
 	require.True(t, done)
 	require.Equal(t, model.Ts(65), checkpointTs)
-	meta = p.GetTableStatus(model.TableID(3))
+	meta = p.GetTableSpanStatus(tablepb.TableSpan(3))
 	require.Equal(t, model.TableID(3), meta.TableID)
 	require.Equal(t, tablepb.TableStateAbsent, meta.State)
 
-	require.Len(t, p.tables, 3)
+	require.Equal(t, 3, p.tables.Len())
 	require.True(t, table3.canceled)
 
 	err = p.Tick(ctx)
@@ -607,10 +609,10 @@ func TestProcessorClose(t *testing.T) {
 	tester.MustApplyPatches()
 
 	// add tables
-	done, err := p.AddTable(ctx, model.TableID(1), 20, false)
+	done, err := p.AddTableSpan(ctx, tablepb.TableSpan(1), 20, false)
 	require.Nil(t, err)
 	require.True(t, done)
-	done, err = p.AddTable(ctx, model.TableID(2), 30, false)
+	done, err = p.AddTableSpan(ctx, tablepb.TableSpan(2), 30, false)
 	require.Nil(t, err)
 	require.True(t, done)
 
@@ -624,10 +626,10 @@ func TestProcessorClose(t *testing.T) {
 		return status, true, nil
 	})
 	tester.MustApplyPatches()
-	p.tables[1].(*mockTablePipeline).resolvedTs = 110
-	p.tables[2].(*mockTablePipeline).resolvedTs = 90
-	p.tables[1].(*mockTablePipeline).checkpointTs = 90
-	p.tables[2].(*mockTablePipeline).checkpointTs = 95
+	p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline).resolvedTs = 110
+	p.tables.GetV(tablepb.TableSpan(2)).(*mockTablePipeline).resolvedTs = 90
+	p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline).checkpointTs = 90
+	p.tables.GetV(tablepb.TableSpan(2)).(*mockTablePipeline).checkpointTs = 95
 	err = p.Tick(ctx)
 	require.Nil(t, err)
 	tester.MustApplyPatches()
@@ -637,8 +639,8 @@ func TestProcessorClose(t *testing.T) {
 
 	require.Nil(t, p.Close())
 	tester.MustApplyPatches()
-	require.True(t, p.tables[1].(*mockTablePipeline).canceled)
-	require.True(t, p.tables[2].(*mockTablePipeline).canceled)
+	require.True(t, p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline).canceled)
+	require.True(t, p.tables.GetV(tablepb.TableSpan(2)).(*mockTablePipeline).canceled)
 
 	p, tester = initProcessor4Test(ctx, t, &liveness)
 	// init tick
@@ -647,10 +649,10 @@ func TestProcessorClose(t *testing.T) {
 	tester.MustApplyPatches()
 
 	// add tables
-	done, err = p.AddTable(ctx, model.TableID(1), 20, false)
+	done, err = p.AddTableSpan(ctx, tablepb.TableSpan(1), 20, false)
 	require.Nil(t, err)
 	require.True(t, done)
-	done, err = p.AddTable(ctx, model.TableID(2), 30, false)
+	done, err = p.AddTableSpan(ctx, tablepb.TableSpan(2), 30, false)
 	require.Nil(t, err)
 	require.True(t, done)
 	err = p.Tick(ctx)
@@ -670,8 +672,8 @@ func TestProcessorClose(t *testing.T) {
 		Code:    "CDC:ErrSinkURIInvalid",
 		Message: "[CDC:ErrSinkURIInvalid]sink uri invalid '%s'",
 	})
-	require.True(t, p.tables[1].(*mockTablePipeline).canceled)
-	require.True(t, p.tables[2].(*mockTablePipeline).canceled)
+	require.True(t, p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline).canceled)
+	require.True(t, p.tables.GetV(tablepb.TableSpan(2)).(*mockTablePipeline).canceled)
 }
 
 func TestPositionDeleted(t *testing.T) {
@@ -680,10 +682,10 @@ func TestPositionDeleted(t *testing.T) {
 	p, tester := initProcessor4Test(ctx, t, &liveness)
 	var err error
 	// add table
-	done, err := p.AddTable(ctx, model.TableID(1), 30, false)
+	done, err := p.AddTableSpan(ctx, tablepb.TableSpan(1), 30, false)
 	require.Nil(t, err)
 	require.True(t, done)
-	done, err = p.AddTable(ctx, model.TableID(2), 40, false)
+	done, err = p.AddTableSpan(ctx, tablepb.TableSpan(2), 40, false)
 	require.Nil(t, err)
 	require.True(t, done)
 	// init tick
@@ -691,8 +693,8 @@ func TestPositionDeleted(t *testing.T) {
 	require.Nil(t, err)
 	tester.MustApplyPatches()
 
-	table1 := p.tables[1].(*mockTablePipeline)
-	table2 := p.tables[2].(*mockTablePipeline)
+	table1 := p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline)
+	table2 := p.tables.GetV(tablepb.TableSpan(2)).(*mockTablePipeline)
 
 	table1.resolvedTs += 1
 	table2.resolvedTs += 1
@@ -800,7 +802,7 @@ func TestUpdateBarrierTs(t *testing.T) {
 	})
 	p.schemaStorage.(*mockSchemaStorage).resolvedTs = 10
 
-	done, err := p.AddTable(ctx, model.TableID(1), 5, false)
+	done, err := p.AddTableSpan(ctx, tablepb.TableSpan(1), 5, false)
 	require.True(t, done)
 	require.Nil(t, err)
 	err = p.Tick(ctx)
@@ -815,7 +817,7 @@ func TestUpdateBarrierTs(t *testing.T) {
 	err = p.Tick(ctx)
 	require.Nil(t, err)
 	tester.MustApplyPatches()
-	tb := p.tables[model.TableID(1)].(*mockTablePipeline)
+	tb := p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline)
 	require.Equal(t, tb.barrierTs, uint64(10))
 
 	// Schema storage has advanced too.
@@ -823,10 +825,73 @@ func TestUpdateBarrierTs(t *testing.T) {
 	err = p.Tick(ctx)
 	require.Nil(t, err)
 	tester.MustApplyPatches()
-	tb = p.tables[model.TableID(1)].(*mockTablePipeline)
+	tb = p.tables.GetV(tablepb.TableSpan(1)).(*mockTablePipeline)
 	require.Equal(t, tb.barrierTs, uint64(15))
 }
 
+func TestAddTableWaitMarkTable(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+	p.changefeed.PatchInfo(func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, bool, error) {
+		info.Config.Bidirectional = &config.BidirectionalConfig{ReplicationID: 1}
+		return info, true, nil
+	})
+	tester.MustApplyPatches()
+
+	// The mark table is not created by the reverse changefeed yet.
+	markTableID := model.TableID(0)
+	p.lookupMarkTable = func() (model.TableID, bool, error) {
+		return markTableID, markTableID != 0, nil
+	}
+	done, err := p.AddTableSpan(ctx, tablepb.TableSpan(1), 5, false)
+	require.Nil(t, err)
+	require.False(t, done)
+	require.Equal(t, 0, p.tables.Len())
+
+	// The wait is reported as an error once it's too long.
+	p.markTableWaitStart = time.Now().Add(-2 * p.markTableWaitTimeout)
+	_, err = p.AddTableSpan(ctx, tablepb.TableSpan(1), 5, false)
+	require.True(t, cerror.ErrMarkTableNotFound.Equal(err))
+
+	markTableID = 100
+	done, err = p.AddTableSpan(ctx, tablepb.TableSpan(1), 5, false)
+	require.Nil(t, err)
+	require.True(t, done)
+	require.Equal(t, 1, p.tables.Len())
+	require.Equal(t, model.TableID(100), p.markTableID)
+	require.True(t, p.markTableWaitStart.IsZero())
+
+	// A DDL which doesn't change the ID of the mark table.
+	p.markTableChanged.Store(true)
+	require.Nil(t, p.checkMarkTable())
+	require.Equal(t, model.TableID(100), p.markTableID)
+
+	// The mark table is truncated, the running tables are restarted.
+	markTableID = 101
+	p.markTableChanged.Store(true)
+	require.True(t, cerror.ErrMarkTableChanged.Equal(p.checkMarkTable()))
+	require.Equal(t, model.TableID(0), p.markTableID)
+	require.False(t, p.markTableChanged.Load())
+}
+
+func TestMayChangeMarkTable(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, mayChangeMarkTable(&timodel.Job{
+		Type: timodel.ActionTruncateTable, SchemaName: "tidb_cdc", TableName: "repl_mark",
+	}))
+	require.True(t, mayChangeMarkTable(&timodel.Job{
+		Type: timodel.ActionDropSchema, SchemaName: "tidb_cdc",
+	}))
+	require.True(t, mayChangeMarkTable(&timodel.Job{
+		Type: timodel.ActionRenameTable, SchemaName: "test", TableName: "t1",
+	}))
+	require.False(t, mayChangeMarkTable(&timodel.Job{
+		Type: timodel.ActionTruncateTable, SchemaName: "test", TableName: "repl_mark",
+	}))
+}
+
 func TestProcessorLiveness(t *testing.T) {
 	ctx := cdcContext.NewBackendContext4Test(true)
 	liveness := model.LivenessCaptureAlive
@@ -0,0 +1,179 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablepb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+)
+
+// Key is a custom type for bytes in proto.
+type Key []byte
+
+// TableSpan returns the span that covers all records of the table.
+func TableSpan(tableID model.TableID) Span {
+	span := regionspan.GetTableSpan(tableID)
+	return Span{TableID: tableID, StartKey: span.Start, EndKey: span.End}
+}
+
+// FormatSpan returns a human readable string of the span.
+func FormatSpan(span Span) string {
+	return fmt.Sprintf("{table_id:%d,start_key:%s,end_key:%s}",
+		span.TableID, hex.EncodeToString(span.StartKey), hex.EncodeToString(span.EndKey))
+}
+
+// Less compares two spans. Spans are ordered by table ID, start key and end key.
+func (m *Span) Less(other *Span) bool {
+	if m.TableID != other.TableID {
+		return m.TableID < other.TableID
+	}
+	if c := bytes.Compare(m.StartKey, other.StartKey); c != 0 {
+		return c < 0
+	}
+	return bytes.Compare(m.EndKey, other.EndKey) < 0
+}
+
+// Eq returns whether two spans are the same.
+func (m *Span) Eq(other *Span) bool {
+	return m.TableID == other.TableID &&
+		bytes.Equal(m.StartKey, other.StartKey) &&
+		bytes.Equal(m.EndKey, other.EndKey)
+}
+
+// ToRegionSpan converts the span to a regionspan.Span.
+func (m *Span) ToRegionSpan() regionspan.Span {
+	return regionspan.Span{Start: m.StartKey, End: m.EndKey}
+}
+
+// SortSpans sorts spans in ascending order.
+func SortSpans(spans []Span) {
+	sort.Slice(spans, func(i, j int) bool { return spans[i].Less(&spans[j]) })
+}
+
+type spanKey struct {
+	tableID  model.TableID
+	startKey string
+	endKey   string
+}
+
+func toSpanKey(span Span) spanKey {
+	return spanKey{
+		tableID:  span.TableID,
+		startKey: string(span.StartKey),
+		endKey:   string(span.EndKey),
+	}
+}
+
+type spanEntry[T any] struct {
+	span  Span
+	value T
+}
+
+// SpanMap is a map whose keys are spans. It's not thread-safe.
+type SpanMap[T any] struct {
+	entries map[spanKey]spanEntry[T]
+}
+
+// NewSpanMap returns a new SpanMap.
+func NewSpanMap[T any]() *SpanMap[T] {
+	return &SpanMap[T]{entries: make(map[spanKey]spanEntry[T])}
+}
+
+// Len returns the number of spans in the map.
+func (m *SpanMap[T]) Len() int {
+	return len(m.entries)
+}
+
+// Has returns whether the span is in the map.
+func (m *SpanMap[T]) Has(span Span) bool {
+	_, ok := m.entries[toSpanKey(span)]
+	return ok
+}
+
+// Get returns the value of the span.
+func (m *SpanMap[T]) Get(span Span) (T, bool) {
+	entry, ok := m.entries[toSpanKey(span)]
+	return entry.value, ok
+}
+
+// GetV returns the value of the span, or the zero value if it's not found.
+func (m *SpanMap[T]) GetV(span Span) T {
+	return m.entries[toSpanKey(span)].value
+}
+
+// ReplaceOrInsert sets the value of the span.
+func (m *SpanMap[T]) ReplaceOrInsert(span Span, value T) {
+	m.entries[toSpanKey(span)] = spanEntry[T]{span: span, value: value}
+}
+
+// Delete removes the span from the map.
+func (m *SpanMap[T]) Delete(span Span) {
+	delete(m.entries, toSpanKey(span))
+}
+
+// Range calls fn for each span in the map in no particular order,
+// it stops if fn returns false. fn may delete the current span.
+func (m *SpanMap[T]) Range(fn func(span Span, value T) bool) {
+	for _, entry := range m.entries {
+		if !fn(entry.span, entry.value) {
+			return
+		}
+	}
+}
+
+// Ascend calls fn for each span in the map in ascending order,
+// it stops if fn returns false.
+func (m *SpanMap[T]) Ascend(fn func(span Span, value T) bool) {
+	entries := make([]spanEntry[T], 0, len(m.entries))
+	for _, entry := range m.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].span.Less(&entries[j].span)
+	})
+	for _, entry := range entries {
+		if !fn(entry.span, entry.value) {
+			return
+		}
+	}
+}
+
+// Spans returns all spans in the map in ascending order.
+func (m *SpanMap[T]) Spans() []Span {
+	spans := make([]Span, 0, len(m.entries))
+	for _, entry := range m.entries {
+		spans = append(spans, entry.span)
+	}
+	SortSpans(spans)
+	return spans
+}
+
+// IsEmpty returns whether the span has neither start key nor end key.
+func (m *Span) IsEmpty() bool {
+	return len(m.StartKey) == 0 && len(m.EndKey) == 0
+}
+
+// NormalizeSpan returns the span of the whole table if span is empty.
+// Messages sent by old versions carry table IDs only, their spans are empty.
+func NormalizeSpan(tableID model.TableID, span Span) Span {
+	if span.IsEmpty() {
+		return TableSpan(tableID)
+	}
+	return span
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablepb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpanMap(t *testing.T) {
+	t.Parallel()
+
+	m := NewSpanMap[int]()
+	span1 := Span{TableID: 1, StartKey: []byte("a"), EndKey: []byte("b")}
+	span2 := Span{TableID: 1, StartKey: []byte("b"), EndKey: []byte("c")}
+	span3 := Span{TableID: 2, StartKey: []byte("a"), EndKey: []byte("b")}
+	m.ReplaceOrInsert(span3, 3)
+	m.ReplaceOrInsert(span2, 2)
+	m.ReplaceOrInsert(span1, 1)
+	require.Equal(t, 3, m.Len())
+	require.True(t, m.Has(span1))
+	require.False(t, m.Has(Span{TableID: 1}))
+	v, ok := m.Get(span2)
+	require.True(t, ok)
+	require.Equal(t, 2, v)
+	require.Equal(t, 0, m.GetV(Span{TableID: 3}))
+	require.Equal(t, []Span{span1, span2, span3}, m.Spans())
+
+	var values []int
+	m.Ascend(func(span Span, value int) bool {
+		values = append(values, value)
+		return value < 2
+	})
+	require.Equal(t, []int{1, 2}, values)
+
+	// Delete spans while ranging.
+	m.Range(func(span Span, value int) bool {
+		if span.TableID == 1 {
+			m.Delete(span)
+		}
+		return true
+	})
+	require.Equal(t, []Span{span3}, m.Spans())
+}
+
+func TestNormalizeSpan(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, TableSpan(1), NormalizeSpan(1, Span{}))
+	require.Equal(t, TableSpan(1), NormalizeSpan(1, Span{TableID: 1}))
+	span := Span{TableID: 1, StartKey: []byte("a"), EndKey: []byte("b")}
+	require.Equal(t, span, NormalizeSpan(1, span))
+}
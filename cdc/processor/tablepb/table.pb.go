@@ -26,13 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // TableState is the state of table replication in processor.
 //
-//  ┌────────┐   ┌───────────┐   ┌──────────┐
-//  │ Absent ├─> │ Preparing ├─> │ Prepared │
-//  └────────┘   └───────────┘   └─────┬────┘
-//                                     v
-//  ┌─────────┐   ┌──────────┐   ┌─────────────┐
-//  │ Stopped │ <─┤ Stopping │ <─┤ Replicating │
-//  └─────────┘   └──────────┘   └─────────────┘
+//	┌────────┐   ┌───────────┐   ┌──────────┐
+//	│ Absent ├─> │ Preparing ├─> │ Prepared │
+//	└────────┘   └───────────┘   └─────┬────┘
+//	                                   v
+//	┌─────────┐   ┌──────────┐   ┌─────────────┐
+//	│ Stopped │ <─┤ Stopping │ <─┤ Replicating │
+//	└─────────┘   └──────────┘   └─────────────┘
 type TableState int32
 
 const (
@@ -130,6 +130,7 @@ type TableStatus struct {
 	TableID    github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_id,omitempty"`
 	State      TableState                                  `protobuf:"varint,2,opt,name=state,proto3,enum=pingcap.tiflow.cdc.processor.tablepb.TableState" json:"state,omitempty"`
 	Checkpoint Checkpoint                                  `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint"`
+	Span       Span                                        `protobuf:"bytes,4,opt,name=span,proto3" json:"span"`
 }
 
 func (m *TableStatus) Reset()         { *m = TableStatus{} }
@@ -186,46 +187,120 @@ func (m *TableStatus) GetCheckpoint() Checkpoint {
 	return Checkpoint{}
 }
 
+func (m *TableStatus) GetSpan() Span {
+	if m != nil {
+		return m.Span
+	}
+	return Span{}
+}
+
+// Span is a full extent of key space from an inclusive start_key to
+// an exclusive end_key.
+type Span struct {
+	TableID  github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_id,omitempty"`
+	StartKey Key                                         `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3,casttype=Key" json:"start_key,omitempty"`
+	EndKey   Key                                         `protobuf:"bytes,3,opt,name=end_key,json=endKey,proto3,casttype=Key" json:"end_key,omitempty"`
+}
+
+func (m *Span) Reset()         { *m = Span{} }
+func (m *Span) String() string { return proto.CompactTextString(m) }
+func (*Span) ProtoMessage()    {}
+func (*Span) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae83c9c6cf5ef75c, []int{2}
+}
+func (m *Span) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Span) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Span.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Span) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Span.Merge(m, src)
+}
+func (m *Span) XXX_Size() int {
+	return m.Size()
+}
+func (m *Span) XXX_DiscardUnknown() {
+	xxx_messageInfo_Span.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Span proto.InternalMessageInfo
+
+func (m *Span) GetTableID() github_com_pingcap_tiflow_cdc_model.TableID {
+	if m != nil {
+		return m.TableID
+	}
+	return 0
+}
+
+func (m *Span) GetStartKey() Key {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *Span) GetEndKey() Key {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pingcap.tiflow.cdc.processor.tablepb.TableState", TableState_name, TableState_value)
 	proto.RegisterType((*Checkpoint)(nil), "pingcap.tiflow.cdc.processor.tablepb.Checkpoint")
 	proto.RegisterType((*TableStatus)(nil), "pingcap.tiflow.cdc.processor.tablepb.TableStatus")
+	proto.RegisterType((*Span)(nil), "pingcap.tiflow.cdc.processor.tablepb.Span")
 }
 
 func init() { proto.RegisterFile("processor/tablepb/table.proto", fileDescriptor_ae83c9c6cf5ef75c) }
 
 var fileDescriptor_ae83c9c6cf5ef75c = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x93, 0xb6, 0x6b, 0xc7, 0x57, 0x40, 0xc1, 0x6c, 0x30, 0x22, 0x91, 0x46, 0xd5, 0x34,
-	0x4d, 0x45, 0x72, 0x10, 0xdc, 0xb8, 0x51, 0x10, 0xd2, 0x84, 0x10, 0x28, 0xeb, 0x38, 0x70, 0x99,
-	0x12, 0xdb, 0x64, 0xd1, 0xb2, 0xd8, 0x8a, 0x3d, 0xf6, 0x02, 0x9c, 0x72, 0xe2, 0xc4, 0x2d, 0x2f,
-	0xc0, 0x93, 0xec, 0xd8, 0x23, 0xa7, 0x0a, 0xda, 0xb7, 0xd8, 0x09, 0x25, 0xe9, 0xe2, 0xa9, 0x48,
-	0xa8, 0x9c, 0x62, 0xe7, 0xff, 0xff, 0xff, 0x3e, 0x7f, 0x5f, 0x62, 0x78, 0x2c, 0x32, 0x4e, 0x98,
-	0x94, 0x3c, 0xf3, 0x54, 0x10, 0x26, 0x4c, 0x84, 0xf5, 0x13, 0x8b, 0x8c, 0x2b, 0x8e, 0x76, 0x45,
-	0x9c, 0x46, 0x24, 0x10, 0x58, 0xc5, 0x9f, 0x13, 0x7e, 0x81, 0x09, 0x25, 0xb8, 0x49, 0xe0, 0x65,
-	0xc2, 0xde, 0x8a, 0x78, 0xc4, 0xab, 0x80, 0x57, 0xae, 0xea, 0xec, 0xf0, 0x87, 0x09, 0xf0, 0xea,
-	0x84, 0x91, 0x53, 0xc1, 0xe3, 0x54, 0xa1, 0xf7, 0x70, 0x87, 0x34, 0xbb, 0x63, 0x25, 0x77, 0x4c,
-	0xd7, 0xdc, 0xef, 0x8c, 0x47, 0x57, 0xb3, 0xc1, 0x5e, 0x14, 0xab, 0x93, 0xf3, 0x10, 0x13, 0x7e,
-	0xe6, 0x2d, 0x0b, 0x7a, 0x75, 0x41, 0x8f, 0x50, 0xe2, 0x9d, 0x71, 0xca, 0x12, 0x3c, 0x91, 0xfe,
-	0x6d, 0x0d, 0x98, 0x48, 0xf4, 0x16, 0xfa, 0x19, 0x93, 0x3c, 0xf9, 0xc2, 0x68, 0x89, 0x6b, 0xfd,
-	0x37, 0x0e, 0xae, 0xe3, 0x13, 0x39, 0xfc, 0xda, 0x82, 0xfe, 0xa4, 0x6c, 0xe7, 0x50, 0x05, 0xea,
-	0x5c, 0xa2, 0x23, 0xd8, 0xac, 0xba, 0x3b, 0x8e, 0x69, 0x75, 0xd0, 0xf6, 0xf8, 0xc5, 0x7c, 0x36,
-	0xe8, 0x55, 0x96, 0x83, 0xd7, 0x57, 0xb3, 0xc1, 0x93, 0xb5, 0x8a, 0xd4, 0x76, 0xbf, 0x57, 0xb1,
-	0x0e, 0x28, 0x7a, 0x03, 0x1b, 0x52, 0x05, 0x8a, 0x55, 0xa7, 0xbd, 0xfb, 0xec, 0x29, 0x5e, 0x67,
-	0xbe, 0xb8, 0x39, 0x18, 0xf3, 0xeb, 0x38, 0xfa, 0x08, 0xa0, 0x67, 0xb1, 0xd3, 0x76, 0xcd, 0xfd,
-	0xfe, 0xba, 0x30, 0xfd, 0x49, 0xc6, 0x9d, 0xcb, 0xd9, 0xc0, 0xf0, 0x6f, 0x90, 0x46, 0xdf, 0x5b,
-	0x00, 0xba, 0x1a, 0x1a, 0x42, 0xef, 0x28, 0x3d, 0x4d, 0xf9, 0x45, 0x6a, 0x19, 0xf6, 0x76, 0x5e,
-	0xb8, 0xf7, 0xb4, 0xb8, 0x14, 0x90, 0x0b, 0xdd, 0x97, 0xa1, 0x64, 0xa9, 0xb2, 0x4c, 0x7b, 0x2b,
-	0x2f, 0x5c, 0x4b, 0x5b, 0xea, 0xf7, 0x68, 0x0f, 0x6e, 0x7d, 0xc8, 0x98, 0x08, 0xb2, 0x38, 0x8d,
-	0xac, 0x96, 0xfd, 0x30, 0x2f, 0xdc, 0xfb, 0xda, 0xd4, 0x48, 0x68, 0x17, 0x36, 0xeb, 0x0d, 0xa3,
-	0x56, 0xdb, 0x7e, 0x90, 0x17, 0x2e, 0x5a, 0xb5, 0x31, 0x8a, 0x46, 0xd0, 0xf7, 0x99, 0x48, 0x62,
-	0x12, 0xa8, 0x92, 0xd7, 0xb1, 0x1f, 0xe5, 0x85, 0xbb, 0x7d, 0x63, 0x44, 0x5a, 0x2c, 0x89, 0x87,
-	0x8a, 0x8b, 0x72, 0x2e, 0xd6, 0xc6, 0x2a, 0xf1, 0x5a, 0x29, 0xbb, 0xac, 0xd6, 0x8c, 0x5a, 0xdd,
-	0xd5, 0x2e, 0x97, 0xc2, 0xf8, 0xdd, 0xf4, 0xb7, 0x63, 0x5c, 0xce, 0x1d, 0x73, 0x3a, 0x77, 0xcc,
-	0x5f, 0x73, 0xc7, 0xfc, 0xb6, 0x70, 0x8c, 0xe9, 0xc2, 0x31, 0x7e, 0x2e, 0x1c, 0xe3, 0x93, 0xf7,
-	0xef, 0x9f, 0xe1, 0xaf, 0x3b, 0x16, 0x76, 0xab, 0x2b, 0xf2, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x20, 0x55, 0xc1, 0xb8, 0x7f, 0x03, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x93, 0x36, 0x6b, 0xbb, 0xaf, 0x03, 0x05, 0xb3, 0x41, 0x89, 0x44, 0x1a, 0x55, 0xd5,
+	0x34, 0x15, 0x29, 0x41, 0x70, 0xe3, 0x46, 0x99, 0x90, 0xa6, 0x0a, 0x81, 0xd2, 0x8e, 0x03, 0x97,
+	0x2a, 0x8d, 0x4d, 0x17, 0xb5, 0xb3, 0xad, 0xd8, 0x63, 0xea, 0x5f, 0xe8, 0x89, 0x13, 0xb7, 0x1e,
+	0xb9, 0xf0, 0x4b, 0x26, 0x4e, 0x3d, 0x72, 0xaa, 0xa0, 0xfd, 0x17, 0x3d, 0xa1, 0x38, 0x5d, 0x33,
+	0x8a, 0x84, 0xca, 0x61, 0xa7, 0xd8, 0x7e, 0xdf, 0xf7, 0xb1, 0xbf, 0xcf, 0x91, 0xe1, 0x31, 0x8f,
+	0x59, 0x48, 0x84, 0x60, 0xb1, 0x27, 0x83, 0xde, 0x90, 0xf0, 0x5e, 0xfa, 0x75, 0x79, 0xcc, 0x24,
+	0x43, 0x75, 0x1e, 0xd1, 0x7e, 0x18, 0x70, 0x57, 0x46, 0x1f, 0x87, 0xec, 0xd2, 0x0d, 0x71, 0xe8,
+	0xae, 0x13, 0xee, 0x2a, 0x61, 0xed, 0xf7, 0x59, 0x9f, 0xa9, 0x80, 0x97, 0x8c, 0xd2, 0x6c, 0xed,
+	0x9b, 0x0e, 0xf0, 0xea, 0x8c, 0x84, 0x03, 0xce, 0x22, 0x2a, 0xd1, 0x5b, 0xb8, 0x13, 0xae, 0x67,
+	0x5d, 0x29, 0x2a, 0xba, 0xa3, 0x1f, 0x19, 0xcd, 0xc6, 0x72, 0x56, 0x3d, 0xec, 0x47, 0xf2, 0xec,
+	0xa2, 0xe7, 0x86, 0xec, 0xdc, 0x5b, 0x6d, 0xe8, 0xa5, 0x1b, 0x7a, 0x21, 0x0e, 0xbd, 0x73, 0x86,
+	0xc9, 0xd0, 0xed, 0x08, 0x7f, 0x2f, 0x03, 0x74, 0x04, 0x6a, 0x41, 0x39, 0x26, 0x82, 0x0d, 0x3f,
+	0x11, 0x9c, 0xe0, 0x72, 0xff, 0x8d, 0x83, 0xeb, 0x78, 0x47, 0xd4, 0xbe, 0xe7, 0xa0, 0xdc, 0x49,
+	0xca, 0x69, 0xcb, 0x40, 0x5e, 0x08, 0x74, 0x0a, 0x25, 0x55, 0x5d, 0x37, 0xc2, 0xea, 0xa0, 0xf9,
+	0xe6, 0x8b, 0xf9, 0xac, 0x5a, 0x54, 0x96, 0x93, 0xe3, 0xe5, 0xac, 0xfa, 0x64, 0xab, 0x4d, 0x52,
+	0xbb, 0x5f, 0x54, 0xac, 0x13, 0x8c, 0x5e, 0xc3, 0x8e, 0x90, 0x81, 0x24, 0xea, 0xb4, 0x77, 0x9f,
+	0x3d, 0x75, 0xb7, 0xe9, 0xaf, 0xbb, 0x3e, 0x18, 0xf1, 0xd3, 0x38, 0x7a, 0x0f, 0x90, 0xf5, 0xa2,
+	0x92, 0x77, 0xf4, 0xa3, 0xf2, 0xb6, 0xb0, 0xec, 0x4a, 0x9a, 0xc6, 0xd5, 0xac, 0xaa, 0xf9, 0x37,
+	0x48, 0xe8, 0x18, 0x0c, 0xc1, 0x03, 0x5a, 0x31, 0x14, 0xb1, 0xb1, 0x1d, 0xb1, 0xcd, 0x03, 0xba,
+	0x62, 0xa9, 0x74, 0xed, 0xab, 0x0e, 0x46, 0xb2, 0x78, 0x5b, 0x5d, 0xac, 0xc3, 0xae, 0x90, 0x41,
+	0x2c, 0xbb, 0x03, 0x32, 0x52, 0x9d, 0xdc, 0x6b, 0x16, 0x97, 0xb3, 0x6a, 0xbe, 0x45, 0x46, 0x7e,
+	0x49, 0x29, 0x2d, 0x32, 0x42, 0x0e, 0x14, 0x09, 0xc5, 0xca, 0x93, 0xff, 0xd3, 0x53, 0x20, 0x14,
+	0xb7, 0xc8, 0xa8, 0xf1, 0x25, 0x07, 0x90, 0xf5, 0x16, 0xd5, 0xa0, 0x78, 0x4a, 0x07, 0x94, 0x5d,
+	0x52, 0x53, 0xb3, 0x0e, 0xc6, 0x13, 0xe7, 0x5e, 0x26, 0xae, 0x04, 0xe4, 0x40, 0xe1, 0x65, 0x4f,
+	0x10, 0x2a, 0x4d, 0xdd, 0xda, 0x1f, 0x4f, 0x1c, 0x33, 0xb3, 0xa4, 0xeb, 0xe8, 0x10, 0x76, 0xdf,
+	0xc5, 0x84, 0x07, 0x71, 0x44, 0xfb, 0x66, 0xce, 0x7a, 0x38, 0x9e, 0x38, 0xf7, 0x33, 0xd3, 0x5a,
+	0x42, 0x75, 0x28, 0xa5, 0x13, 0x82, 0xcd, 0xbc, 0xf5, 0x60, 0x3c, 0x71, 0xd0, 0xa6, 0x8d, 0x60,
+	0xd4, 0x80, 0xb2, 0x4f, 0xf8, 0x30, 0x0a, 0x03, 0x99, 0xf0, 0x0c, 0xeb, 0xd1, 0x78, 0xe2, 0x1c,
+	0xdc, 0xf8, 0x21, 0x32, 0x31, 0x21, 0xb6, 0x25, 0xe3, 0x49, 0x1f, 0xcd, 0x9d, 0x4d, 0xe2, 0xb5,
+	0x92, 0x54, 0xa9, 0xc6, 0x04, 0x9b, 0x85, 0xcd, 0x2a, 0x57, 0x42, 0xf3, 0xcd, 0xf4, 0x97, 0xad,
+	0x5d, 0xcd, 0x6d, 0x7d, 0x3a, 0xb7, 0xf5, 0x9f, 0x73, 0x5b, 0xff, 0xbc, 0xb0, 0xb5, 0xe9, 0xc2,
+	0xd6, 0x7e, 0x2c, 0x6c, 0xed, 0x83, 0xf7, 0xef, 0x4b, 0xfb, 0xeb, 0x45, 0xe9, 0x15, 0xd4, 0x83,
+	0xf0, 0xfc, 0xf7, 0x00, 0x4b, 0x8c, 0xcd, 0x20, 0x6d, 0x04, 0x00, 0x00,
 }
 
 func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
@@ -281,6 +356,16 @@ func (m *TableStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTable(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -304,6 +389,48 @@ func (m *TableStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Span) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Span) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Span) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintTable(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintTable(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TableID != 0 {
+		i = encodeVarintTable(dAtA, i, uint64(m.TableID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTable(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTable(v)
 	base := offset
@@ -344,6 +471,28 @@ func (m *TableStatus) Size() (n int) {
 	}
 	l = m.Checkpoint.Size()
 	n += 1 + l + sovTable(uint64(l))
+	l = m.Span.Size()
+	n += 1 + l + sovTable(uint64(l))
+	return n
+}
+
+func (m *Span) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TableID != 0 {
+		n += 1 + sovTable(uint64(m.TableID))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovTable(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovTable(uint64(l))
+	}
 	return n
 }
 
@@ -541,6 +690,176 @@ func (m *TableStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTable(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTable
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Span) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTable
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Span: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Span: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+			}
+			m.TableID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableID |= github_com_pingcap_tiflow_cdc_model.TableID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTable
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTable
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTable
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTable(dAtA[iNdEx:])
@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/entry/schema"
 	"github.com/pingcap/tiflow/cdc/kv"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	"github.com/pingcap/tiflow/pkg/filter"
@@ -474,6 +475,20 @@ func TestHandleJob(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, skip)
 	}
+
+	// test the mark table of bidirectional replication, which is tracked
+	// even if it doesn't match the filter rules.
+	{
+		for _, query := range bidirectional.CreateMarkTableSQLs() {
+			job := helper.DDL2Job(query)
+			skip, err := ddlJobPullerImpl.handleJob(job)
+			require.NoError(t, err)
+			require.False(t, skip)
+		}
+		_, ok := ddlJobPullerImpl.schemaSnapshot.TableIDByName(
+			bidirectional.SchemaName, bidirectional.MarkTableName)
+		require.True(t, ok)
+	}
 }
 
 func waitResolvedTs(t *testing.T, p DDLJobPuller, targetTs model.Ts) {
@@ -0,0 +1,348 @@
+//  Copyright 2022 PingCAP, Inc.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// The layout of a redo log file in the versioned format:
+//
+//	| magic (8) | version (1) | compression (1) | flags (1) | reserved (5) |
+//	| frame | frame | ...
+//
+// Each frame is a length field followed by a block and the padding, which is
+// the same as the legacy format. A block is laid out as:
+//
+//	| crc32 of the payload (4) | payload |
+//
+// If the file is encrypted, payload is `nonce | AES-GCM sealed body`,
+// otherwise it's the body itself. The body is `codec (1) | data`, and data
+// is prefixed with the length of the raw data if it's compressed by lz4.
+//
+// The legacy format has no file header, and each frame holds a raw
+// msgp-encoded RedoLog. The most significant byte of the length field in a
+// legacy file is either 0 or 0x8X, so it never conflicts with the magic.
+const (
+	fileMagic = "TICDCRDO"
+	// FileHeaderSize is the size of the header of a versioned redo log file.
+	FileHeaderSize = 16
+	// FileFormatV1 is the first version of the versioned redo log file format.
+	FileFormatV1 uint8 = 1
+
+	blockChecksumSize = 4
+	flagEncrypted     = 0x1
+)
+
+// Compression algorithms supported by redo log files.
+const (
+	// CompressionNone means no compression.
+	CompressionNone = "none"
+	// CompressionLZ4 compresses blocks with lz4.
+	CompressionLZ4 = "lz4"
+	// CompressionZSTD compresses blocks with zstd.
+	CompressionZSTD = "zstd"
+)
+
+type compressionCodec uint8
+
+const (
+	codecNone compressionCodec = iota
+	codecLZ4
+	codecZSTD
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// IsValidCompression checks whether the given compression is supported.
+func IsValidCompression(compression string) bool {
+	switch compression {
+	case "", CompressionNone, CompressionLZ4, CompressionZSTD:
+		return true
+	default:
+		return false
+	}
+}
+
+func toCompressionCodec(compression string) (compressionCodec, error) {
+	switch compression {
+	case "", CompressionNone:
+		return codecNone, nil
+	case CompressionLZ4:
+		return codecLZ4, nil
+	case CompressionZSTD:
+		return codecZSTD, nil
+	default:
+		return codecNone, cerror.ErrRedoConfigInvalid.GenWithStack(
+			"unsupported redo log compression: %s", compression)
+	}
+}
+
+// FileHeader is the header of a versioned redo log file.
+type FileHeader struct {
+	Version     uint8
+	Compression string
+	Encrypted   bool
+}
+
+// Marshal encodes the file header.
+func (h *FileHeader) Marshal() []byte {
+	buf := make([]byte, FileHeaderSize)
+	copy(buf, fileMagic)
+	buf[len(fileMagic)] = h.Version
+	codec, _ := toCompressionCodec(h.Compression)
+	buf[len(fileMagic)+1] = byte(codec)
+	if h.Encrypted {
+		buf[len(fileMagic)+2] |= flagEncrypted
+	}
+	return buf
+}
+
+// IsVersionedFile returns whether the data is the beginning of a versioned
+// redo log file. At least len(fileMagic) bytes are needed.
+func IsVersionedFile(prefix []byte) bool {
+	return bytes.HasPrefix(prefix, []byte(fileMagic))
+}
+
+// UnmarshalFileHeader decodes the file header.
+func UnmarshalFileHeader(data []byte) (*FileHeader, error) {
+	if len(data) < FileHeaderSize || !IsVersionedFile(data) {
+		return nil, errors.New("invalid redo log file header")
+	}
+	h := &FileHeader{Version: data[len(fileMagic)]}
+	if h.Version != FileFormatV1 {
+		return nil, errors.Errorf("unsupported redo log file version %d", h.Version)
+	}
+	switch compressionCodec(data[len(fileMagic)+1]) {
+	case codecNone:
+		h.Compression = CompressionNone
+	case codecLZ4:
+		h.Compression = CompressionLZ4
+	case codecZSTD:
+		h.Compression = CompressionZSTD
+	default:
+		return nil, errors.Errorf("unsupported redo log compression codec %d",
+			data[len(fileMagic)+1])
+	}
+	h.Encrypted = data[len(fileMagic)+2]&flagEncrypted != 0
+	return h, nil
+}
+
+// LoadEncryptionKey loads an AES key from the key file. The file contains
+// a 16, 24 or 32 bytes key, either in raw bytes or hex encoded.
+func LoadEncryptionKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, cerror.ErrRedoEncryptionKeyInvalid.GenWithStackByArgs(err.Error())
+	}
+	if key, err := hex.DecodeString(string(bytes.TrimSpace(data))); err == nil && isValidKeySize(len(key)) {
+		return key, nil
+	}
+	if isValidKeySize(len(data)) {
+		return data, nil
+	}
+	return nil, cerror.ErrRedoEncryptionKeyInvalid.GenWithStackByArgs(
+		fmt.Sprintf("the key in %s must be 16, 24 or 32 bytes", path))
+}
+
+func isValidKeySize(n int) bool {
+	return n == 16 || n == 24 || n == 32
+}
+
+// BlockCodec encodes and decodes blocks of a versioned redo log file.
+// It's safe for concurrent use.
+type BlockCodec struct {
+	header FileHeader
+	codec  compressionCodec
+	aead   cipher.AEAD
+
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+}
+
+// NewBlockCodec creates a BlockCodec. Blocks are encrypted if key is not empty.
+func NewBlockCodec(compression string, key []byte) (*BlockCodec, error) {
+	codec, err := toCompressionCodec(compression)
+	if err != nil {
+		return nil, err
+	}
+	c := &BlockCodec{
+		header: FileHeader{
+			Version:     FileFormatV1,
+			Compression: compression,
+			Encrypted:   len(key) != 0,
+		},
+		codec: codec,
+	}
+	if len(key) != 0 {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, cerror.ErrRedoEncryptionKeyInvalid.GenWithStackByArgs(err.Error())
+		}
+		if c.aead, err = cipher.NewGCM(block); err != nil {
+			return nil, cerror.ErrRedoEncryptionKeyInvalid.GenWithStackByArgs(err.Error())
+		}
+	}
+	if codec == codecZSTD {
+		c.zstdEncoder, err = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		c.zstdDecoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return c, nil
+}
+
+// NewBlockCodecFromHeader creates a BlockCodec to decode blocks of a file
+// with the given header.
+func NewBlockCodecFromHeader(header *FileHeader, key []byte) (*BlockCodec, error) {
+	if header.Encrypted && len(key) == 0 {
+		return nil, cerror.ErrRedoEncryptionKeyInvalid.GenWithStackByArgs(
+			"the redo log file is encrypted but no key is provided")
+	}
+	if !header.Encrypted {
+		key = nil
+	}
+	return NewBlockCodec(header.Compression, key)
+}
+
+// Header returns the file header of files written by the codec.
+func (c *BlockCodec) Header() *FileHeader {
+	header := c.header
+	return &header
+}
+
+// Encode compresses, encrypts and checksums the data.
+func (c *BlockCodec) Encode(data []byte) ([]byte, error) {
+	body := make([]byte, 1, len(data)+binary.MaxVarintLen64+1)
+	switch c.codec {
+	case codecLZ4:
+		buf := make([]byte, lz4.CompressBlockBound(len(data)))
+		n, err := lz4.CompressBlock(data, buf, nil)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// n is zero if the data is incompressible.
+		if n > 0 && n < len(data) {
+			var lenBuf [binary.MaxVarintLen64]byte
+			body[0] = byte(codecLZ4)
+			body = append(body, lenBuf[:binary.PutUvarint(lenBuf[:], uint64(len(data)))]...)
+			body = append(body, buf[:n]...)
+		} else {
+			body = append(body, data...)
+		}
+	case codecZSTD:
+		body[0] = byte(codecZSTD)
+		body = c.zstdEncoder.EncodeAll(data, body)
+	default:
+		body = append(body, data...)
+	}
+
+	block := make([]byte, blockChecksumSize, blockChecksumSize+len(body)+64)
+	if c.aead != nil {
+		nonce := make([]byte, c.aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, errors.Trace(err)
+		}
+		block = append(block, nonce...)
+		block = c.aead.Seal(block, nonce, body, nil)
+	} else {
+		block = append(block, body...)
+	}
+	binary.LittleEndian.PutUint32(block, crc32.Checksum(block[blockChecksumSize:], crcTable))
+	return block, nil
+}
+
+// Decode verifies, decrypts and decompresses the block.
+func (c *BlockCodec) Decode(block []byte) ([]byte, error) {
+	if len(block) < blockChecksumSize {
+		return nil, errors.New("block is too short")
+	}
+	payload := block[blockChecksumSize:]
+	if binary.LittleEndian.Uint32(block) != crc32.Checksum(payload, crcTable) {
+		return nil, errors.New("checksum mismatch")
+	}
+
+	body := payload
+	if c.aead != nil {
+		nonceSize := c.aead.NonceSize()
+		if len(payload) < nonceSize {
+			return nil, errors.New("encrypted block is too short")
+		}
+		var err error
+		body, err = c.aead.Open(nil, payload[:nonceSize], payload[nonceSize:], nil)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to decrypt block")
+		}
+	}
+	if len(body) == 0 {
+		return nil, errors.New("block body is empty")
+	}
+
+	data := body[1:]
+	switch compressionCodec(body[0]) {
+	case codecNone:
+		return data, nil
+	case codecLZ4:
+		rawLen, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, errors.New("invalid lz4 block length")
+		}
+		raw := make([]byte, rawLen)
+		m, err := lz4.UncompressBlock(data[n:], raw)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if uint64(m) != rawLen {
+			return nil, errors.Errorf("lz4 block length mismatch, expect %d, got %d", rawLen, m)
+		}
+		return raw, nil
+	case codecZSTD:
+		if c.zstdDecoder == nil {
+			return nil, errors.New("unexpected zstd block")
+		}
+		raw, err := c.zstdDecoder.DecodeAll(data, nil)
+		return raw, errors.Trace(err)
+	default:
+		return nil, errors.Errorf("unknown block codec %d", body[0])
+	}
+}
+
+// Close releases resources of the codec.
+func (c *BlockCodec) Close() {
+	if c.zstdEncoder != nil {
+		_ = c.zstdEncoder.Close()
+	}
+	if c.zstdDecoder != nil {
+		c.zstdDecoder.Close()
+	}
+}
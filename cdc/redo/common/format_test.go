@@ -0,0 +1,127 @@
+//  Copyright 2022 PingCAP, Inc.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockCodec(t *testing.T) {
+	t.Parallel()
+
+	key := bytes.Repeat([]byte{0x1}, 32)
+	data := bytes.Repeat([]byte("redo log block"), 100)
+	for _, compression := range []string{"", CompressionNone, CompressionLZ4, CompressionZSTD} {
+		for _, key := range [][]byte{nil, key} {
+			codec, err := NewBlockCodec(compression, key)
+			require.NoError(t, err)
+
+			block, err := codec.Encode(data)
+			require.NoError(t, err)
+			if compression == CompressionLZ4 || compression == CompressionZSTD {
+				require.Less(t, len(block), len(data))
+			}
+			if key != nil {
+				require.False(t, bytes.Contains(block, []byte("redo log block")))
+			}
+
+			header, err := UnmarshalFileHeader(codec.Header().Marshal())
+			require.NoError(t, err)
+			require.Equal(t, FileFormatV1, header.Version)
+			require.Equal(t, key != nil, header.Encrypted)
+			decoder, err := NewBlockCodecFromHeader(header, key)
+			require.NoError(t, err)
+			decoded, err := decoder.Decode(block)
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+
+			// Any corruption is detected by the checksum.
+			block[len(block)/2] ^= 0xff
+			_, err = decoder.Decode(block)
+			require.Regexp(t, "checksum mismatch", err)
+
+			codec.Close()
+			decoder.Close()
+		}
+	}
+
+	// Incompressible data is stored as is.
+	codec, err := NewBlockCodec(CompressionLZ4, nil)
+	require.NoError(t, err)
+	defer codec.Close()
+	block, err := codec.Encode([]byte("a"))
+	require.NoError(t, err)
+	decoded, err := codec.Decode(block)
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), decoded)
+
+	_, err = NewBlockCodec("snappy", nil)
+	require.True(t, cerror.ErrRedoConfigInvalid.Equal(err))
+	_, err = NewBlockCodecFromHeader(&FileHeader{Version: FileFormatV1, Encrypted: true}, nil)
+	require.True(t, cerror.ErrRedoEncryptionKeyInvalid.Equal(err))
+}
+
+func TestFileHeader(t *testing.T) {
+	t.Parallel()
+
+	header := &FileHeader{Version: FileFormatV1, Compression: CompressionZSTD, Encrypted: true}
+	data := header.Marshal()
+	require.Len(t, data, FileHeaderSize)
+	require.True(t, IsVersionedFile(data))
+	decoded, err := UnmarshalFileHeader(data)
+	require.NoError(t, err)
+	require.Equal(t, header, decoded)
+
+	// The length field of a legacy file never matches the magic.
+	legacy := []byte{0x10, 0, 0, 0, 0, 0, 0, 0x86}
+	require.False(t, IsVersionedFile(legacy))
+
+	data[len(fileMagic)] = 2
+	_, err = UnmarshalFileHeader(data)
+	require.Regexp(t, "unsupported redo log file version 2", err)
+}
+
+func TestLoadEncryptionKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{0xab}, 16)
+
+	hexFile := filepath.Join(dir, "hex.key")
+	require.NoError(t, os.WriteFile(hexFile, []byte(hex.EncodeToString(key)+"\n"), 0o600))
+	loaded, err := LoadEncryptionKey(hexFile)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+
+	rawFile := filepath.Join(dir, "raw.key")
+	require.NoError(t, os.WriteFile(rawFile, key, 0o600))
+	loaded, err = LoadEncryptionKey(rawFile)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+
+	badFile := filepath.Join(dir, "bad.key")
+	require.NoError(t, os.WriteFile(badFile, []byte("short"), 0o600))
+	_, err = LoadEncryptionKey(badFile)
+	require.True(t, cerror.ErrRedoEncryptionKeyInvalid.Equal(err))
+
+	_, err = LoadEncryptionKey(filepath.Join(dir, "not-exist"))
+	require.True(t, cerror.ErrRedoEncryptionKeyInvalid.Equal(err))
+}
@@ -208,6 +208,10 @@ func NewManager(ctx context.Context, cfg *config.ConsistentConfig, opts *Manager
 	if err != nil {
 		return nil, err
 	}
+	if !common.IsValidCompression(cfg.Compression) {
+		return nil, cerror.ErrRedoConfigInvalid.GenWithStack(
+			"unsupported redo log compression: %s", cfg.Compression)
+	}
 
 	changeFeedID := contextutil.ChangefeedIDFromCtx(ctx)
 	m := &ManagerImpl{
@@ -245,6 +249,14 @@ func NewManager(ctx context.Context, cfg *config.ConsistentConfig, opts *Manager
 			redoDir = uri.Path
 		}
 
+		var encryptionKey []byte
+		if cfg.EncryptionKeyFile != "" {
+			encryptionKey, err = common.LoadEncryptionKey(cfg.EncryptionKeyFile)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		writerCfg := &writer.LogWriterConfig{
 			Dir:               redoDir,
 			CaptureID:         contextutil.CaptureAddrFromCtx(ctx),
@@ -253,6 +265,8 @@ func NewManager(ctx context.Context, cfg *config.ConsistentConfig, opts *Manager
 			MaxLogSize:        cfg.MaxLogSize,
 			FlushIntervalInMs: cfg.FlushIntervalInMs,
 			S3Storage:         m.storageType == consistentStorageS3,
+			Compression:       cfg.Compression,
+			EncryptionKey:     encryptionKey,
 
 			EmitMeta:      m.opts.EmitMeta,
 			EmitRowEvents: m.opts.EmitRowEvents,
@@ -19,6 +19,7 @@ import (
 	"container/heap"
 	"context"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"math"
 	"net/url"
@@ -63,6 +64,8 @@ type readerConfig struct {
 	s3Storage  bool
 	s3URI      url.URL
 	workerNums int
+
+	encryptionKey []byte
 }
 
 type reader struct {
@@ -73,6 +76,12 @@ type reader struct {
 	closer   io.Closer
 	// lastValidOff file offset following the last valid decoded record
 	lastValidOff int64
+
+	encryptionKey []byte
+	// headerRead is set once the file header is checked.
+	headerRead bool
+	// codec is nil if the file is in the legacy format.
+	codec *common.BlockCodec
 }
 
 func newReader(ctx context.Context, cfg *readerConfig) ([]fileReader, error) {
@@ -95,7 +104,8 @@ func newReader(ctx context.Context, cfg *readerConfig) ([]fileReader, error) {
 		cfg.workerNums = defaultWorkerNum
 	}
 
-	rr, err := openSelectedFiles(ctx, cfg.dir, cfg.fileType, cfg.startTs, cfg.workerNums)
+	rr, err := openSelectedFiles(ctx, cfg.dir, cfg.fileType, cfg.startTs,
+		cfg.workerNums, cfg.encryptionKey)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +118,8 @@ func newReader(ctx context.Context, cfg *readerConfig) ([]fileReader, error) {
 				br:       bufio.NewReader(rr[i]),
 				fileName: rr[i].(*os.File).Name(),
 				closer:   rr[i],
+
+				encryptionKey: cfg.encryptionKey,
 			})
 	}
 
@@ -163,7 +175,9 @@ func downLoadToLocal(ctx context.Context, dir string, s3storage storage.External
 	return eg.Wait()
 }
 
-func openSelectedFiles(ctx context.Context, dir, fixedType string, startTs uint64, workerNum int) ([]io.ReadCloser, error) {
+func openSelectedFiles(
+	ctx context.Context, dir, fixedType string, startTs uint64, workerNum int, encryptionKey []byte,
+) ([]io.ReadCloser, error) {
 	files, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrRedoFileOp, errors.Annotatef(err, "can't read log file directory: %s", dir))
@@ -211,7 +225,7 @@ func openSelectedFiles(ctx context.Context, dir, fixedType string, startTs uint6
 		}
 	}
 
-	sortFiles, err := createSortedFiles(ctx, dir, unSortedFile, workerNum)
+	sortFiles, err := createSortedFiles(ctx, dir, unSortedFile, workerNum, encryptionKey)
 	if err != nil {
 		return nil, err
 	}
@@ -223,11 +237,13 @@ func openReadFile(name string) (*os.File, error) {
 	return os.OpenFile(name, os.O_RDONLY, common.DefaultFileMode)
 }
 
-func readFile(file *os.File) (logHeap, error) {
+func readFile(file *os.File, encryptionKey []byte) (logHeap, error) {
 	r := &reader{
 		br:       bufio.NewReader(file),
 		fileName: file.Name(),
 		closer:   file,
+
+		encryptionKey: encryptionKey,
 	}
 	defer r.Close()
 
@@ -248,10 +264,12 @@ func readFile(file *os.File) (logHeap, error) {
 }
 
 // writFile if not safely closed, the sorted file will end up with .sort.tmp as the file name suffix
-func writFile(ctx context.Context, dir, name string, h logHeap) error {
+func writFile(ctx context.Context, dir, name string, h logHeap, encryptionKey []byte) error {
+	// The sorted file is encrypted as well if the original one is encrypted.
 	cfg := &writer.FileWriterConfig{
-		Dir:        dir,
-		MaxLogSize: math.MaxInt32,
+		Dir:           dir,
+		MaxLogSize:    math.MaxInt32,
+		EncryptionKey: encryptionKey,
 	}
 	w, err := writer.NewWriter(ctx, cfg, writer.WithLogFileName(func() string { return name }))
 	if err != nil {
@@ -273,7 +291,9 @@ func writFile(ctx context.Context, dir, name string, h logHeap) error {
 	return w.Close()
 }
 
-func createSortedFiles(ctx context.Context, dir string, names []string, workerNum int) ([]io.ReadCloser, error) {
+func createSortedFiles(
+	ctx context.Context, dir string, names []string, workerNum int, encryptionKey []byte,
+) ([]io.ReadCloser, error) {
 	logFiles := []io.ReadCloser{}
 	errCh := make(chan error)
 	retCh := make(chan io.ReadCloser)
@@ -292,7 +312,7 @@ func createSortedFiles(ctx context.Context, dir string, names []string, workerNu
 		}
 
 		for i := 0; i < len(nn); i++ {
-			go createSortedFile(ctx, dir, nn[i], errCh, retCh)
+			go createSortedFile(ctx, dir, nn[i], encryptionKey, errCh, retCh)
 		}
 		for i := 0; i < len(nn); i++ {
 			select {
@@ -312,7 +332,10 @@ func createSortedFiles(ctx context.Context, dir string, names []string, workerNu
 	return logFiles, nil
 }
 
-func createSortedFile(ctx context.Context, dir string, name string, errCh chan error, retCh chan io.ReadCloser) {
+func createSortedFile(
+	ctx context.Context, dir string, name string, encryptionKey []byte,
+	errCh chan error, retCh chan io.ReadCloser,
+) {
 	path := filepath.Join(dir, name)
 	file, err := openReadFile(path)
 	if err != nil {
@@ -320,7 +343,7 @@ func createSortedFile(ctx context.Context, dir string, name string, errCh chan e
 		return
 	}
 
-	h, err := readFile(file)
+	h, err := readFile(file, encryptionKey)
 	if err != nil {
 		errCh <- err
 		return
@@ -333,7 +356,7 @@ func createSortedFile(ctx context.Context, dir string, name string, errCh chan e
 	}
 
 	sortFileName := name + common.SortLogEXT
-	err = writFile(ctx, dir, sortFileName, h)
+	err = writFile(ctx, dir, sortFileName, h, encryptionKey)
 	if err != nil {
 		errCh <- err
 		return
@@ -371,6 +394,12 @@ func (r *reader) Read(redoLog *model.RedoLog) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if !r.headerRead {
+		if err := r.readHeader(); err != nil {
+			return err
+		}
+	}
+
 	lenField, err := readInt64(r.br)
 	if err != nil {
 		if err == io.EOF {
@@ -392,7 +421,19 @@ func (r *reader) Read(redoLog *model.RedoLog) error {
 		return cerror.WrapError(cerror.ErrRedoFileOp, err)
 	}
 
-	_, err = redoLog.UnmarshalMsg(data[:recBytes])
+	payload := data[:recBytes]
+	if r.codec != nil {
+		payload, err = r.codec.Decode(payload)
+		if err != nil {
+			if r.isTornEntry(data) {
+				// just return io.EOF, since if torn write it is the last redoLog entry
+				return io.EOF
+			}
+			return cerror.ErrRedoLogCorrupted.GenWithStackByArgs(r.fileName,
+				fmt.Sprintf("offset %d: %s", r.lastValidOff, err.Error()))
+		}
+	}
+	_, err = redoLog.UnmarshalMsg(payload)
 	if err != nil {
 		if r.isTornEntry(data) {
 			// just return io.EOF, since if torn write it is the last redoLog entry
@@ -406,6 +447,40 @@ func (r *reader) Read(redoLog *model.RedoLog) error {
 	return nil
 }
 
+// readHeader reads the file header if the file is in the versioned format.
+// Files in the legacy format have no header.
+func (r *reader) readHeader() error {
+	prefix, err := r.br.Peek(common.FileHeaderSize)
+	if !common.IsVersionedFile(prefix) {
+		// It's an empty or a legacy file, errors are left to the caller.
+		r.headerRead = true
+		return nil
+	}
+	if err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// The header is torn, treat it as an empty file.
+			return io.EOF
+		}
+		return cerror.WrapError(cerror.ErrRedoFileOp, err)
+	}
+	header, err := common.UnmarshalFileHeader(prefix)
+	if err != nil {
+		return cerror.ErrRedoLogCorrupted.GenWithStackByArgs(r.fileName, err.Error())
+	}
+	codec, err := common.NewBlockCodecFromHeader(header, r.encryptionKey)
+	if err != nil {
+		return err
+	}
+	if _, err := r.br.Discard(common.FileHeaderSize); err != nil {
+		codec.Close()
+		return cerror.WrapError(cerror.ErrRedoFileOp, err)
+	}
+	r.codec = codec
+	r.headerRead = true
+	r.lastValidOff += common.FileHeaderSize
+	return nil
+}
+
 func readInt64(r io.Reader) (int64, error) {
 	var n int64
 	err := binary.Read(r, binary.LittleEndian, &n)
@@ -465,6 +540,9 @@ func (r *reader) Close() error {
 	if r == nil || r.closer == nil {
 		return nil
 	}
+	if r.codec != nil {
+		r.codec.Close()
+	}
 
 	return cerror.WrapError(cerror.ErrRedoFileOp, r.closer.Close())
 }
@@ -15,6 +15,7 @@ package reader
 
 import (
 	"bufio"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/redo/common"
 	"github.com/pingcap/tiflow/cdc/redo/writer"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/uuid"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
@@ -210,7 +212,7 @@ func TestReaderOpenSelectedFiles(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		ret, err := openSelectedFiles(ctx, tt.args.dir, tt.args.fixedName, tt.args.startTs, 100)
+		ret, err := openSelectedFiles(ctx, tt.args.dir, tt.args.fixedName, tt.args.startTs, 100, nil)
 		if tt.wantErr == "" {
 			require.Nil(t, err, tt.name)
 			require.Equal(t, len(tt.wantRet), len(ret), tt.name)
@@ -251,3 +253,108 @@ func TestReaderOpenSelectedFiles(t *testing.T) {
 	}
 	time.Sleep(1001 * time.Millisecond)
 }
+
+func TestReaderReadVersionedFormat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := []byte("0123456789abcdef")
+	for _, compression := range []string{common.CompressionLZ4, common.CompressionZSTD} {
+		dir := t.TempDir()
+		cfg := &writer.FileWriterConfig{
+			MaxLogSize:    100000,
+			Dir:           dir,
+			ChangeFeedID:  model.DefaultChangeFeedID("test-cf"),
+			CaptureID:     "cp",
+			FileType:      common.DefaultRowLogFileType,
+			Compression:   compression,
+			EncryptionKey: key,
+		}
+		w, err := writer.NewWriter(ctx, cfg)
+		require.Nil(t, err)
+		for _, commitTs := range []uint64{1125, 1123, 1124} {
+			log := &model.RedoLog{
+				RedoRow: &model.RedoRowChangedEvent{Row: &model.RowChangedEvent{CommitTs: commitTs}},
+			}
+			data, err := log.MarshalMsg(nil)
+			require.Nil(t, err)
+			w.AdvanceTs(commitTs)
+			_, err = w.Write(data)
+			require.Nil(t, err)
+		}
+		require.Nil(t, w.Close())
+
+		// The key is required to read encrypted files.
+		_, err = newReader(ctx, &readerConfig{
+			dir:      dir,
+			startTs:  1,
+			endTs:    2000,
+			fileType: common.DefaultRowLogFileType,
+		})
+		require.True(t, cerror.ErrRedoEncryptionKeyInvalid.Equal(err), err)
+
+		r, err := newReader(ctx, &readerConfig{
+			dir:           dir,
+			startTs:       1,
+			endTs:         2000,
+			fileType:      common.DefaultRowLogFileType,
+			encryptionKey: key,
+		})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(r))
+		for _, commitTs := range []uint64{1123, 1124, 1125} {
+			log := &model.RedoLog{}
+			require.Nil(t, r[0].Read(log))
+			require.EqualValues(t, commitTs, log.RedoRow.Row.CommitTs)
+		}
+		require.Equal(t, io.EOF, r[0].Read(&model.RedoLog{}))
+		require.Nil(t, r[0].Close())
+	}
+}
+
+func TestReaderReadLegacyFormat(t *testing.T) {
+	dir := t.TempDir()
+
+	// Write a file in the legacy format, which has neither
+	// a header nor checksums.
+	var buf []byte
+	for _, commitTs := range []uint64{1123, 1124} {
+		log := &model.RedoLog{
+			RedoRow: &model.RedoRowChangedEvent{Row: &model.RowChangedEvent{CommitTs: commitTs}},
+		}
+		data, err := log.MarshalMsg(nil)
+		require.Nil(t, err)
+		padBytes := (8 - len(data)%8) % 8
+		lenField := uint64(len(data))
+		if padBytes != 0 {
+			lenField |= uint64(0x80|padBytes) << 56
+		}
+		lenBuf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(lenBuf, lenField)
+		buf = append(buf, lenBuf...)
+		buf = append(buf, data...)
+		buf = append(buf, make([]byte, padBytes)...)
+	}
+	fileName := fmt.Sprintf(common.RedoLogFileFormatV1, "cp", "test-cf",
+		common.DefaultRowLogFileType, 1124, "uuid", common.LogEXT)
+	file, err := os.Create(filepath.Join(dir, fileName))
+	require.Nil(t, err)
+	_, err = file.Write(buf)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	file, err = os.Open(filepath.Join(dir, fileName))
+	require.Nil(t, err)
+	r := &reader{
+		br:       bufio.NewReader(file),
+		fileName: fileName,
+		closer:   file,
+	}
+	defer r.Close() //nolint:errcheck
+	for _, commitTs := range []uint64{1123, 1124} {
+		log := &model.RedoLog{}
+		require.Nil(t, r.Read(log))
+		require.EqualValues(t, commitTs, log.RedoRow.Row.CommitTs)
+	}
+	require.Equal(t, io.EOF, r.Read(&model.RedoLog{}))
+}
@@ -63,8 +63,10 @@ type LogReaderConfig struct {
 	// will load the file to memory first then write the sorted file to disk
 	// the memory used is WorkerNums * defaultMaxLogSize (64 * megabyte) total
 	WorkerNums int
-	startTs    uint64
-	endTs      uint64
+	// EncryptionKey is the AES key used to decrypt encrypted redo log files.
+	EncryptionKey []byte
+	startTs       uint64
+	endTs         uint64
 }
 
 // LogReader implement RedoLogReader interface
@@ -161,6 +163,8 @@ func (l *LogReader) setUpRowReader(ctx context.Context, startTs, endTs uint64) e
 		s3Storage:  l.cfg.S3Storage,
 		s3URI:      l.cfg.S3URI,
 		workerNums: l.cfg.WorkerNums,
+
+		encryptionKey: l.cfg.EncryptionKey,
 	}
 	l.rowReader, err = newReader(ctx, rowCfg)
 	if err != nil {
@@ -190,6 +194,8 @@ func (l *LogReader) setUpDDLReader(ctx context.Context, startTs, endTs uint64) e
 		s3Storage:  l.cfg.S3Storage,
 		s3URI:      l.cfg.S3URI,
 		workerNums: l.cfg.WorkerNums,
+
+		encryptionKey: l.cfg.EncryptionKey,
 	}
 	l.ddlReader, err = newReader(ctx, ddlCfg)
 	if err != nil {
@@ -83,6 +83,10 @@ type FileWriterConfig struct {
 	MaxLogSize int64
 	S3Storage  bool
 	S3URI      url.URL
+	// Compression is the compression algorithm of the log file, see common.CompressionLZ4.
+	Compression string
+	// EncryptionKey is the AES key used to encrypt the log file if it's not empty.
+	EncryptionKey []byte
 }
 
 // Option define the writerOptions
@@ -129,6 +133,7 @@ type Writer struct {
 	ongoingFilePath string
 	bw              *pioutil.PageWriter
 	uint64buf       []byte
+	codec           *common.BlockCodec
 	storage         storage.ExternalStorage
 	sync.RWMutex
 	uuidGenerator uuid.Generator
@@ -158,6 +163,11 @@ func NewWriter(ctx context.Context, cfg *FileWriterConfig, opts ...Option) (*Wri
 		}
 	}
 
+	codec, err := common.NewBlockCodec(cfg.Compression, cfg.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
 	op := &writerOptions{}
 	for _, opt := range opts {
 		opt(op)
@@ -167,6 +177,7 @@ func NewWriter(ctx context.Context, cfg *FileWriterConfig, opts ...Option) (*Wri
 		cfg:       cfg,
 		op:        op,
 		uint64buf: make([]byte, 8),
+		codec:     codec,
 		storage:   s3storage,
 
 		metricFsyncDuration: common.RedoFsyncDurationHistogram.
@@ -186,7 +197,7 @@ func NewWriter(ctx context.Context, cfg *FileWriterConfig, opts ...Option) (*Wri
 		return nil, cerror.WrapError(cerror.ErrRedoFileOp, errors.New("invalid redo dir path"))
 	}
 
-	err := os.MkdirAll(cfg.Dir, common.DefaultDirMode)
+	err = os.MkdirAll(cfg.Dir, common.DefaultDirMode)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrRedoFileOp,
 			errors.Annotatef(err, "can't make dir: %s for redo writing", cfg.Dir))
@@ -209,6 +220,10 @@ func (w *Writer) Write(rawData []byte) (int, error) {
 	w.Lock()
 	defer w.Unlock()
 
+	rawData, err := w.codec.Encode(rawData)
+	if err != nil {
+		return 0, cerror.WrapError(cerror.ErrRedoFileOp, err)
+	}
 	writeLen := int64(len(rawData))
 	if writeLen > w.cfg.MaxLogSize {
 		return 0, cerror.ErrFileSizeExceed.GenWithStackByArgs(writeLen, w.cfg.MaxLogSize)
@@ -229,6 +244,16 @@ func (w *Writer) Write(rawData []byte) (int, error) {
 	if w.maxCommitTS.Load() < w.eventCommitTS.Load() {
 		w.maxCommitTS.Store(w.eventCommitTS.Load())
 	}
+	// The header is written along with the first record, so a file
+	// without any record is still empty.
+	if w.size == 0 {
+		n, err := w.bw.Write(w.codec.Header().Marshal())
+		if err != nil {
+			return 0, err
+		}
+		w.metricWriteBytes.Add(float64(n))
+		w.size += int64(n)
+	}
 	// ref: https://github.com/etcd-io/etcd/pull/5250
 	lenField, padBytes := encodeFrameSize(len(rawData))
 	if err := w.writeUint64(lenField, w.uint64buf); err != nil {
@@ -289,6 +314,7 @@ func (w *Writer) Close() error {
 	if !w.IsRunning() {
 		return nil
 	}
+	defer w.codec.Close()
 
 	common.RedoFlushAllDurationHistogram.
 		DeleteLabelValues(w.cfg.ChangeFeedID.Namespace, w.cfg.ChangeFeedID.ID)
@@ -35,6 +35,12 @@ import (
 	"github.com/pingcap/tiflow/pkg/uuid"
 )
 
+func newTestBlockCodec(t *testing.T) *common.BlockCodec {
+	codec, err := common.NewBlockCodec(common.CompressionNone, nil)
+	require.NoError(t, err)
+	return codec
+}
+
 func TestWriterWrite(t *testing.T) {
 	dir := t.TempDir()
 
@@ -58,7 +64,7 @@ func TestWriterWrite(t *testing.T) {
 		uuidGen := uuid.NewConstGenerator("const-uuid")
 		w := &Writer{
 			cfg: &FileWriterConfig{
-				MaxLogSize:   10,
+				MaxLogSize:   32,
 				Dir:          dir,
 				ChangeFeedID: cf,
 				CaptureID:    "cp",
@@ -66,6 +72,7 @@ func TestWriterWrite(t *testing.T) {
 				CreateTime:   time.Date(2000, 1, 1, 1, 1, 1, 1, &time.Location{}),
 			},
 			uint64buf: make([]byte, 8),
+			codec:     newTestBlockCodec(t),
 			running:   *atomic.NewBool(true),
 			metricWriteBytes: common.RedoWriteBytesGauge.
 				WithLabelValues("default", "test-cf"),
@@ -150,7 +157,7 @@ func TestWriterWrite(t *testing.T) {
 
 		w1 := &Writer{
 			cfg: &FileWriterConfig{
-				MaxLogSize:   10,
+				MaxLogSize:   32,
 				Dir:          dir,
 				ChangeFeedID: cf11s[idx],
 				CaptureID:    "cp",
@@ -158,6 +165,7 @@ func TestWriterWrite(t *testing.T) {
 				CreateTime:   time.Date(2000, 1, 1, 1, 1, 1, 1, &time.Location{}),
 			},
 			uint64buf: make([]byte, 8),
+			codec:     newTestBlockCodec(t),
 			running:   *atomic.NewBool(true),
 			metricWriteBytes: common.RedoWriteBytesGauge.
 				WithLabelValues("default", "test-cf11"),
@@ -226,6 +234,7 @@ func TestWriterGC(t *testing.T) {
 	w := &Writer{
 		cfg:       cfg,
 		uint64buf: make([]byte, 8),
+		codec:     newTestBlockCodec(t),
 		storage:   mockStorage,
 		metricWriteBytes: common.RedoWriteBytesGauge.
 			WithLabelValues(cfg.ChangeFeedID.Namespace, cfg.ChangeFeedID.ID),
@@ -269,6 +278,7 @@ func TestWriterGC(t *testing.T) {
 	w1 := &Writer{
 		cfg:       cfg,
 		uint64buf: make([]byte, 8),
+		codec:     newTestBlockCodec(t),
 		storage:   mockStorage,
 	}
 	w1.cfg.Dir += "not-exist"
@@ -331,6 +341,7 @@ func TestNewWriter(t *testing.T) {
 			MaxLogSize:   defaultMaxLogSize,
 		},
 		uint64buf: make([]byte, 8),
+		codec:     newTestBlockCodec(t),
 		storage:   mockStorage,
 		metricWriteBytes: common.RedoWriteBytesGauge.
 			WithLabelValues("default", "test"),
@@ -387,6 +398,7 @@ func TestRotateFileWithFileAllocator(t *testing.T) {
 			MaxLogSize:   defaultMaxLogSize,
 		},
 		uint64buf: make([]byte, 8),
+		codec:     newTestBlockCodec(t),
 		metricWriteBytes: common.RedoWriteBytesGauge.
 			WithLabelValues("default", "test"),
 		metricFsyncDuration: common.RedoFsyncDurationHistogram.
@@ -452,6 +464,7 @@ func TestRotateFileWithoutFileAllocator(t *testing.T) {
 			MaxLogSize:   defaultMaxLogSize,
 		},
 		uint64buf: make([]byte, 8),
+		codec:     newTestBlockCodec(t),
 		metricWriteBytes: common.RedoWriteBytesGauge.
 			WithLabelValues("default", "test"),
 		metricFsyncDuration: common.RedoFsyncDurationHistogram.
@@ -82,6 +82,10 @@ type LogWriterConfig struct {
 	S3Storage         bool
 	// S3URI should be like S3URI="s3://logbucket/test-changefeed?endpoint=http://$S3_ENDPOINT/"
 	S3URI url.URL
+	// Compression is the compression algorithm of log files.
+	Compression string
+	// EncryptionKey is the AES key used to encrypt log files if it's not empty.
+	EncryptionKey []byte
 
 	EmitMeta      bool
 	EmitRowEvents bool
@@ -120,6 +124,9 @@ func NewLogWriter(
 			MaxLogSize:   cfg.MaxLogSize,
 			S3Storage:    cfg.S3Storage,
 			S3URI:        cfg.S3URI,
+
+			Compression:   cfg.Compression,
+			EncryptionKey: cfg.EncryptionKey,
 		}
 		if logWriter.rowWriter, err = NewWriter(ctx, writerCfg, opts...); err != nil {
 			return
@@ -136,6 +143,9 @@ func NewLogWriter(
 			MaxLogSize:   cfg.MaxLogSize,
 			S3Storage:    cfg.S3Storage,
 			S3URI:        cfg.S3URI,
+
+			Compression:   cfg.Compression,
+			EncryptionKey: cfg.EncryptionKey,
 		}
 		if logWriter.ddlWriter, err = NewWriter(ctx, writerCfg, opts...); err != nil {
 			return
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v2/protocol"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v2/util"
@@ -236,7 +237,8 @@ func (a *Agent) sendSync(ctx context.Context) (bool, error) {
 			removing = append(removing, op.TableID)
 		}
 	}
-	for _, tableID := range a.executor.GetAllCurrentTables() {
+	for _, span := range a.executor.GetAllCurrentTableSpans() {
+		tableID := span.TableID
 		if _, ok := a.tableOperations[tableID]; ok {
 			// Tables with a pending operation is not in the Running state.
 			continue
@@ -265,7 +267,7 @@ func (a *Agent) processOperations(ctx context.Context) error {
 			a.logger.Info("Agent start processing operation", zap.Any("op", op))
 			if !op.IsDelete {
 				// add table
-				done, err := a.executor.AddTable(ctx, op.TableID, op.StartTs, false)
+				done, err := a.executor.AddTableSpan(ctx, tablepb.TableSpan(op.TableID), op.StartTs, false)
 				if err != nil {
 					return errors.Trace(err)
 				}
@@ -274,7 +276,7 @@ func (a *Agent) processOperations(ctx context.Context) error {
 				}
 			} else {
 				// delete table
-				done := a.executor.RemoveTable(op.TableID)
+				done := a.executor.RemoveTableSpan(tablepb.TableSpan(op.TableID))
 				if !done {
 					break
 				}
@@ -284,9 +286,9 @@ func (a *Agent) processOperations(ctx context.Context) error {
 		case operationProcessed:
 			var done bool
 			if !op.IsDelete {
-				done = a.executor.IsAddTableFinished(op.TableID, false)
+				done = a.executor.IsAddTableSpanFinished(tablepb.TableSpan(op.TableID), false)
 			} else {
-				_, done = a.executor.IsRemoveTableFinished(op.TableID)
+				_, done = a.executor.IsRemoveTableSpanFinished(tablepb.TableSpan(op.TableID))
 			}
 			if !done {
 				break
@@ -310,7 +312,7 @@ func (a *Agent) processOperations(ctx context.Context) error {
 func (a *Agent) sendCheckpoint(ctx context.Context) error {
 	checkpointProvider := func() (checkpointTs, resolvedTs model.Ts, ok bool) {
 		// We cannot have a meaningful checkpoint for a processor running NO table.
-		if len(a.executor.GetAllCurrentTables()) == 0 {
+		if len(a.executor.GetAllCurrentTableSpans()) == 0 {
 			a.logger.Debug("no table is running, skip sending checkpoint")
 			return 0, 0, false // false indicates no available checkpoint
 		}
@@ -119,15 +119,16 @@ func NewMockTableExecutor(t *testing.T) *MockTableExecutor {
 	}
 }
 
-// AddTable adds a table to the executor.
-func (e *MockTableExecutor) AddTable(
-	ctx context.Context, tableID model.TableID, startTs model.Ts, isPrepare bool,
+// AddTableSpan adds a table span to the executor.
+func (e *MockTableExecutor) AddTableSpan(
+	ctx context.Context, span tablepb.Span, startTs model.Ts, isPrepare bool,
 ) (bool, error) {
-	log.Info("AddTable", zap.Int64("tableID", tableID))
+	tableID := span.TableID
+	log.Info("AddTableSpan", zap.Int64("tableID", tableID))
 	require.NotContains(e.t, e.Adding, tableID)
 	require.NotContains(e.t, e.Running, tableID)
 	require.NotContains(e.t, e.Removing, tableID)
-	args := e.Called(ctx, tableID, startTs)
+	args := e.MethodCalled("AddTable", ctx, tableID, startTs)
 	if args.Bool(0) {
 		// If the mock return value indicates a success, then we record the added table.
 		e.Adding[tableID] = struct{}{}
@@ -135,10 +136,11 @@ func (e *MockTableExecutor) AddTable(
 	return args.Bool(0), args.Error(1)
 }
 
-// RemoveTable removes a table from the executor.
-func (e *MockTableExecutor) RemoveTable(tableID model.TableID) bool {
-	log.Info("RemoveTable", zap.Int64("tableID", tableID))
-	args := e.Called(tableID)
+// RemoveTableSpan removes a table span from the executor.
+func (e *MockTableExecutor) RemoveTableSpan(span tablepb.Span) bool {
+	tableID := span.TableID
+	log.Info("RemoveTableSpan", zap.Int64("tableID", tableID))
+	args := e.MethodCalled("RemoveTable", tableID)
 	require.Contains(e.t, e.Running, tableID)
 	require.NotContains(e.t, e.Removing, tableID)
 	delete(e.Running, tableID)
@@ -146,29 +148,30 @@ func (e *MockTableExecutor) RemoveTable(tableID model.TableID) bool {
 	return args.Bool(0)
 }
 
-// IsAddTableFinished determines if the table has been added.
-func (e *MockTableExecutor) IsAddTableFinished(tableID model.TableID, isPrepare bool) bool {
-	_, ok := e.Running[tableID]
+// IsAddTableSpanFinished determines if the table span has been added.
+func (e *MockTableExecutor) IsAddTableSpanFinished(span tablepb.Span, isPrepare bool) bool {
+	_, ok := e.Running[span.TableID]
 	return ok
 }
 
-// IsRemoveTableFinished determines if the table has been removed.
-func (e *MockTableExecutor) IsRemoveTableFinished(tableID model.TableID) (model.Ts, bool) {
-	_, ok := e.Removing[tableID]
+// IsRemoveTableSpanFinished determines if the table span has been removed.
+func (e *MockTableExecutor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool) {
+	_, ok := e.Removing[span.TableID]
 	return 0, !ok
 }
 
-// GetAllCurrentTables returns all tables that are currently being adding, running, or removing.
-func (e *MockTableExecutor) GetAllCurrentTables() []model.TableID {
-	var ret []model.TableID
+// GetAllCurrentTableSpans returns all table spans that are currently being
+// adding, running, or removing.
+func (e *MockTableExecutor) GetAllCurrentTableSpans() []tablepb.Span {
+	var ret []tablepb.Span
 	for tableID := range e.Adding {
-		ret = append(ret, tableID)
+		ret = append(ret, tablepb.TableSpan(tableID))
 	}
 	for tableID := range e.Running {
-		ret = append(ret, tableID)
+		ret = append(ret, tablepb.TableSpan(tableID))
 	}
 	for tableID := range e.Removing {
-		ret = append(ret, tableID)
+		ret = append(ret, tablepb.TableSpan(tableID))
 	}
 
 	return ret
@@ -180,7 +183,7 @@ func (e *MockTableExecutor) GetCheckpoint() (checkpointTs, resolvedTs model.Ts)
 	return args.Get(0).(model.Ts), args.Get(1).(model.Ts)
 }
 
-// GetTableStatus implements TableExecutor interface
-func (e *MockTableExecutor) GetTableStatus(tableID model.TableID) tablepb.TableStatus {
+// GetTableSpanStatus implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanStatus(span tablepb.Span) tablepb.TableStatus {
 	return tablepb.TableStatus{}
 }
@@ -235,19 +235,27 @@ func (a *agent) handleMessage(msg []*schedulepb.Message) []*schedulepb.Message {
 
 func (a *agent) handleMessageHeartbeat(request *schedulepb.Heartbeat) *schedulepb.Message {
 	allTables := a.tableM.getAllTables()
-	result := make([]tablepb.TableStatus, 0, len(allTables))
-	for _, table := range allTables {
+	result := make([]tablepb.TableStatus, 0, allTables.Len())
+	allTables.Ascend(func(_ tablepb.Span, table *table) bool {
 		status := table.getTableStatus()
 		if table.task != nil && table.task.IsRemove {
 			status.State = tablepb.TableStateStopping
 		}
 		result = append(result, status)
+		return true
+	})
+	reported := tablepb.NewSpanMap[struct{}]()
+	reportAbsent := func(span tablepb.Span) {
+		if !allTables.Has(span) && !reported.Has(span) {
+			reported.ReplaceOrInsert(span, struct{}{})
+			result = append(result, a.tableM.getTableStatus(span))
+		}
 	}
 	for _, tableID := range request.GetTableIDs() {
-		if _, ok := allTables[tableID]; !ok {
-			status := a.tableM.getTableStatus(tableID)
-			result = append(result, status)
-		}
+		reportAbsent(tablepb.TableSpan(tableID))
+	}
+	for _, span := range request.GetSpans() {
+		reportAbsent(span)
 	}
 
 	if request.IsStopping {
@@ -281,6 +289,7 @@ const (
 
 type dispatchTableTask struct {
 	TableID   model.TableID
+	Span      tablepb.Span
 	StartTs   model.Ts
 	IsRemove  bool
 	IsPrepare bool
@@ -312,30 +321,34 @@ func (a *agent) handleMessageDispatchTableRequest(
 	switch req := request.Request.(type) {
 	case *schedulepb.DispatchTableRequest_AddTable:
 		tableID := req.AddTable.GetTableID()
+		span := tablepb.NormalizeSpan(tableID, req.AddTable.GetSpan())
 		task = &dispatchTableTask{
 			TableID:   tableID,
+			Span:      span,
 			StartTs:   req.AddTable.GetCheckpoint().CheckpointTs,
 			IsRemove:  false,
 			IsPrepare: req.AddTable.GetIsSecondary(),
 			Epoch:     epoch,
 			status:    dispatchTableTaskReceived,
 		}
-		table = a.tableM.addTable(tableID)
+		table = a.tableM.addTable(span)
 	case *schedulepb.DispatchTableRequest_RemoveTable:
 		tableID := req.RemoveTable.GetTableID()
-		table, ok = a.tableM.getTable(tableID)
+		span := tablepb.NormalizeSpan(tableID, req.RemoveTable.GetSpan())
+		table, ok = a.tableM.getTable(span)
 		if !ok {
 			log.Warn("schedulerv3: agent ignore remove table request, "+
 				"since the table not found",
 				zap.String("capture", a.CaptureID),
 				zap.String("namespace", a.ChangeFeedID.Namespace),
 				zap.String("changefeed", a.ChangeFeedID.ID),
-				zap.Any("tableID", tableID),
+				zap.String("span", tablepb.FormatSpan(span)),
 				zap.Any("request", request))
 			return
 		}
 		task = &dispatchTableTask{
 			TableID:  tableID,
+			Span:     span,
 			IsRemove: true,
 			Epoch:    epoch,
 			status:   dispatchTableTaskReceived,
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
 )
 
@@ -30,7 +31,7 @@ func benchmarkHeartbeatResponse(b *testing.B, bench func(b *testing.B, a *agent)
 		}
 
 		for j := 0; j < size; j++ {
-			_ = a.tableM.addTable(model.TableID(10000 + j))
+			_ = a.tableM.addTable(tablepb.TableSpan(model.TableID(10000 + j)))
 		}
 
 		b.ResetTimer()
@@ -41,7 +42,7 @@ func benchmarkHeartbeatResponse(b *testing.B, bench func(b *testing.B, a *agent)
 
 func BenchmarkRefreshAllTables(b *testing.B) {
 	benchmarkHeartbeatResponse(b, func(b *testing.B, a *agent) {
-		total := len(a.tableM.tables)
+		total := a.tableM.tables.Len()
 		b.Run(fmt.Sprintf("%d tables", total), func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
 				a.handleMessageHeartbeat(&schedulepb.Heartbeat{})
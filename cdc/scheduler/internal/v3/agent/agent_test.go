@@ -159,7 +159,7 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	// addTableRequest should be not ignored even if it's stopping.
 	a.handleLivenessUpdate(model.LivenessCaptureStopping)
 	require.Equal(t, model.LivenessCaptureStopping, a.liveness.Load())
-	mockTableExecutor.On("AddTable", mock.Anything, mock.Anything,
+	mockTableExecutor.On("AddTableSpan", mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything).Return(false, nil)
 	a.handleMessageDispatchTableRequest(addTableRequest, processorEpoch)
 	responses, err = a.tableM.poll(ctx)
@@ -171,22 +171,22 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, model.TableID(1), addTableResponse.AddTable.Status.TableID)
 	require.Equal(t, tablepb.TableStateAbsent, addTableResponse.AddTable.Status.State)
-	require.NotContains(t, a.tableM.tables, model.TableID(1))
+	require.False(t, a.tableM.tables.Has(tablepb.TableSpan(1)))
 
 	// Force set liveness to alive.
 	*a.liveness = model.LivenessCaptureAlive
 	require.Equal(t, model.LivenessCaptureAlive, a.liveness.Load())
 	mockTableExecutor.ExpectedCalls = nil
-	mockTableExecutor.On("AddTable", mock.Anything, mock.Anything,
+	mockTableExecutor.On("AddTableSpan", mock.Anything, mock.Anything,
 		mock.Anything, mock.Anything).Return(true, nil)
-	mockTableExecutor.On("IsAddTableFinished", mock.Anything,
+	mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything,
 		mock.Anything, mock.Anything).Return(false, nil)
 	a.handleMessageDispatchTableRequest(addTableRequest, processorEpoch)
 	_, err = a.tableM.poll(ctx)
 	require.NoError(t, err)
 
 	mockTableExecutor.ExpectedCalls = mockTableExecutor.ExpectedCalls[:1]
-	mockTableExecutor.On("IsAddTableFinished", mock.Anything,
+	mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything,
 		mock.Anything, mock.Anything).Return(true, nil)
 	a.handleMessageDispatchTableRequest(addTableRequest, processorEpoch)
 	responses, err = a.tableM.poll(ctx)
@@ -198,7 +198,7 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, model.TableID(1), addTableResponse.AddTable.Status.TableID)
 	require.Equal(t, tablepb.TableStatePrepared, addTableResponse.AddTable.Status.State)
-	require.Contains(t, a.tableM.tables, model.TableID(1))
+	require.True(t, a.tableM.tables.Has(tablepb.TableSpan(1)))
 
 	// let the prepared table become replicating, by set `IsSecondary` to false.
 	addTableRequest.Request.(*schedulepb.DispatchTableRequest_AddTable).
@@ -206,7 +206,7 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 
 	// only mock `IsAddTableFinished`, since `AddTable` by start a prepared table always success.
 	mockTableExecutor.ExpectedCalls = nil
-	mockTableExecutor.On("IsAddTableFinished", mock.Anything,
+	mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything,
 		mock.Anything, mock.Anything).Return(false, nil)
 
 	a.handleMessageDispatchTableRequest(addTableRequest, processorEpoch)
@@ -219,10 +219,10 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, model.TableID(1), addTableResponse.AddTable.Status.TableID)
 	require.Equal(t, tablepb.TableStatePrepared, addTableResponse.AddTable.Status.State)
-	require.Contains(t, a.tableM.tables, model.TableID(1))
+	require.True(t, a.tableM.tables.Has(tablepb.TableSpan(1)))
 
 	mockTableExecutor.ExpectedCalls = nil
-	mockTableExecutor.On("IsAddTableFinished", mock.Anything,
+	mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything,
 		mock.Anything, mock.Anything).Return(true, nil)
 	a.handleMessageDispatchTableRequest(addTableRequest, processorEpoch)
 	responses, err = a.tableM.poll(ctx)
@@ -234,9 +234,9 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, model.TableID(1), addTableResponse.AddTable.Status.TableID)
 	require.Equal(t, tablepb.TableStateReplicating, addTableResponse.AddTable.Status.State)
-	require.Contains(t, a.tableM.tables, model.TableID(1))
+	require.True(t, a.tableM.tables.Has(tablepb.TableSpan(1)))
 
-	mockTableExecutor.On("RemoveTable", mock.Anything, mock.Anything).
+	mockTableExecutor.On("RemoveTableSpan", mock.Anything, mock.Anything).
 		Return(false)
 	// remove table in the replicating state failed, should still in replicating.
 	a.handleMessageDispatchTableRequest(removeTableRequest, processorEpoch)
@@ -248,12 +248,12 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, model.TableID(1), removeTableResponse.RemoveTable.Status.TableID)
 	require.Equal(t, tablepb.TableStateStopping, removeTableResponse.RemoveTable.Status.State)
-	require.Contains(t, a.tableM.tables, model.TableID(1))
+	require.True(t, a.tableM.tables.Has(tablepb.TableSpan(1)))
 
 	mockTableExecutor.ExpectedCalls = nil
-	mockTableExecutor.On("RemoveTable", mock.Anything, mock.Anything).
+	mockTableExecutor.On("RemoveTableSpan", mock.Anything, mock.Anything).
 		Return(true)
-	mockTableExecutor.On("IsRemoveTableFinished", mock.Anything, mock.Anything).
+	mockTableExecutor.On("IsRemoveTableSpanFinished", mock.Anything, mock.Anything).
 		Return(3, false)
 	// remove table in the replicating state failed, should still in replicating.
 	a.handleMessageDispatchTableRequest(removeTableRequest, processorEpoch)
@@ -267,7 +267,7 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.Equal(t, tablepb.TableStateStopping, removeTableResponse.RemoveTable.Status.State)
 
 	mockTableExecutor.ExpectedCalls = mockTableExecutor.ExpectedCalls[:1]
-	mockTableExecutor.On("IsRemoveTableFinished", mock.Anything, mock.Anything).
+	mockTableExecutor.On("IsRemoveTableSpanFinished", mock.Anything, mock.Anything).
 		Return(3, true)
 	// remove table in the replicating state success, should in stopped
 	a.handleMessageDispatchTableRequest(removeTableRequest, processorEpoch)
@@ -280,7 +280,7 @@ func TestAgentHandleMessageDispatchTable(t *testing.T) {
 	require.Equal(t, model.TableID(1), removeTableResponse.RemoveTable.Status.TableID)
 	require.Equal(t, tablepb.TableStateStopped, removeTableResponse.RemoveTable.Status.State)
 	require.Equal(t, model.Ts(3), removeTableResponse.RemoveTable.Checkpoint.CheckpointTs)
-	require.NotContains(t, a.tableM.tables, model.TableID(1))
+	require.False(t, a.tableM.tables.Has(tablepb.TableSpan(1)))
 }
 
 func TestAgentHandleMessageHeartbeat(t *testing.T) {
@@ -291,14 +291,14 @@ func TestAgentHandleMessageHeartbeat(t *testing.T) {
 	a.tableM = newTableManager(model.ChangeFeedID{}, mockTableExecutor)
 
 	for i := 0; i < 5; i++ {
-		a.tableM.addTable(model.TableID(i))
+		a.tableM.addTable(tablepb.TableSpan(model.TableID(i)))
 	}
 
-	a.tableM.tables[model.TableID(0)].state = tablepb.TableStatePreparing
-	a.tableM.tables[model.TableID(1)].state = tablepb.TableStatePrepared
-	a.tableM.tables[model.TableID(2)].state = tablepb.TableStateReplicating
-	a.tableM.tables[model.TableID(3)].state = tablepb.TableStateStopping
-	a.tableM.tables[model.TableID(4)].state = tablepb.TableStateStopped
+	a.tableM.tables.GetV(tablepb.TableSpan(0)).state = tablepb.TableStatePreparing
+	a.tableM.tables.GetV(tablepb.TableSpan(1)).state = tablepb.TableStatePrepared
+	a.tableM.tables.GetV(tablepb.TableSpan(2)).state = tablepb.TableStateReplicating
+	a.tableM.tables.GetV(tablepb.TableSpan(3)).state = tablepb.TableStateStopping
+	a.tableM.tables.GetV(tablepb.TableSpan(4)).state = tablepb.TableStateStopped
 
 	mockTableExecutor.tables[model.TableID(0)] = tablepb.TableStatePreparing
 	mockTableExecutor.tables[model.TableID(1)] = tablepb.TableStatePrepared
@@ -337,7 +337,7 @@ func TestAgentHandleMessageHeartbeat(t *testing.T) {
 		require.Equal(t, tablepb.TableStateAbsent, result[i].State)
 	}
 
-	a.tableM.tables[model.TableID(1)].task = &dispatchTableTask{IsRemove: true}
+	a.tableM.tables.GetV(tablepb.TableSpan(1)).task = &dispatchTableTask{IsRemove: true}
 	response = a.handleMessage([]*schedulepb.Message{heartbeat})
 	result = response[0].GetHeartbeatResponse().Tables
 	sort.Slice(result, func(i, j int) bool {
@@ -465,10 +465,10 @@ func TestAgentPermuteMessages(t *testing.T) {
 				switch message.DispatchTableRequest.Request.(type) {
 				case *schedulepb.DispatchTableRequest_AddTable:
 					for _, ok := range []bool{false, true} {
-						mockTableExecutor.On("AddTable", mock.Anything, mock.Anything,
+						mockTableExecutor.On("AddTableSpan", mock.Anything, mock.Anything,
 							mock.Anything, mock.Anything).Return(ok, nil)
 						for _, ok1 := range []bool{false, true} {
-							mockTableExecutor.On("IsAddTableFinished", mock.Anything,
+							mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything,
 								mock.Anything, mock.Anything).Return(ok1, nil)
 
 							trans.RecvBuffer = append(trans.RecvBuffer, message)
@@ -482,11 +482,11 @@ func TestAgentPermuteMessages(t *testing.T) {
 					}
 				case *schedulepb.DispatchTableRequest_RemoveTable:
 					for _, ok := range []bool{false, true} {
-						mockTableExecutor.On("RemoveTable", mock.Anything,
+						mockTableExecutor.On("RemoveTableSpan", mock.Anything,
 							mock.Anything).Return(ok)
 						for _, ok1 := range []bool{false, true} {
 							trans.RecvBuffer = append(trans.RecvBuffer, message)
-							mockTableExecutor.On("IsRemoveTableFinished",
+							mockTableExecutor.On("IsRemoveTableSpanFinished",
 								mock.Anything, mock.Anything).Return(0, ok1)
 							err := a.Tick(ctx)
 							require.NoError(t, err)
@@ -558,13 +558,13 @@ func TestAgentHandleMessage(t *testing.T) {
 	}
 	// wrong epoch, ignored
 	responses := a.handleMessage([]*schedulepb.Message{addTableRequest})
-	require.NotContains(t, tableM.tables, model.TableID(1))
+	require.False(t, tableM.tables.Has(tablepb.TableSpan(1)))
 	require.Len(t, responses, 0)
 
 	// correct epoch, processing.
 	addTableRequest.Header.ProcessorEpoch = a.Epoch
 	_ = a.handleMessage([]*schedulepb.Message{addTableRequest})
-	require.Contains(t, tableM.tables, model.TableID(1))
+	require.True(t, tableM.tables.Has(tablepb.TableSpan(1)))
 
 	heartbeat.Header.OwnerRevision.Revision = 2
 	response = a.handleMessage([]*schedulepb.Message{heartbeat})
@@ -680,9 +680,9 @@ func TestAgentTick(t *testing.T) {
 	messages = append(messages, removeTableRequest)
 	trans.RecvBuffer = append(trans.RecvBuffer, messages...)
 
-	mockTableExecutor.On("AddTable", mock.Anything,
+	mockTableExecutor.On("AddTableSpan", mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
-	mockTableExecutor.On("IsAddTableFinished", mock.Anything,
+	mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything,
 		mock.Anything, mock.Anything).Return(false, nil)
 	require.NoError(t, a.Tick(ctx))
 	trans.SendBuffer = trans.SendBuffer[:0]
@@ -690,7 +690,7 @@ func TestAgentTick(t *testing.T) {
 	trans.RecvBuffer = append(trans.RecvBuffer, addTableRequest)
 
 	mockTableExecutor.ExpectedCalls = mockTableExecutor.ExpectedCalls[:1]
-	mockTableExecutor.On("IsAddTableFinished", mock.Anything,
+	mockTableExecutor.On("IsAddTableSpanFinished", mock.Anything,
 		mock.Anything, mock.Anything).Return(true, nil)
 	require.NoError(t, a.Tick(ctx))
 	responses := trans.SendBuffer[:len(trans.SendBuffer)]
@@ -763,20 +763,20 @@ func TestAgentCommitAddTableDuringStopping(t *testing.T) {
 
 	// Prepare add table is still in-progress.
 	mockTableExecutor.
-		On("AddTable", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("AddTableSpan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(true, nil).Once()
 	mockTableExecutor.
-		On("IsAddTableFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("IsAddTableSpanFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(false, nil).Once()
 	err := a.Tick(context.Background())
 	require.Nil(t, err)
 	require.Len(t, trans.SendBuffer, 0)
 
 	mockTableExecutor.
-		On("AddTable", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("AddTableSpan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(true, nil).Once()
 	mockTableExecutor.
-		On("IsAddTableFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("IsAddTableSpanFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(true, nil).Once()
 	err = a.Tick(context.Background())
 	require.Nil(t, err)
@@ -805,10 +805,10 @@ func TestAgentCommitAddTableDuringStopping(t *testing.T) {
 	trans.RecvBuffer = []*schedulepb.Message{commitTableMsg}
 	trans.SendBuffer = []*schedulepb.Message{}
 	mockTableExecutor.
-		On("AddTable", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("AddTableSpan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(true, nil).Once()
 	mockTableExecutor.
-		On("IsAddTableFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("IsAddTableSpanFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(false, nil).Once()
 	// Set liveness to stopping.
 	a.liveness.Store(model.LivenessCaptureStopping)
@@ -819,7 +819,7 @@ func TestAgentCommitAddTableDuringStopping(t *testing.T) {
 	trans.RecvBuffer = []*schedulepb.Message{}
 	trans.SendBuffer = []*schedulepb.Message{}
 	mockTableExecutor.
-		On("IsAddTableFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		On("IsAddTableSpanFinished", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(true, nil).Once()
 	err = a.Tick(context.Background())
 	require.Nil(t, err)
@@ -845,11 +845,12 @@ func newMockTableExecutor() *MockTableExecutor {
 	}
 }
 
-// AddTable adds a table to the executor.
-func (e *MockTableExecutor) AddTable(
-	ctx context.Context, tableID model.TableID, startTs model.Ts, isPrepare bool,
+// AddTableSpan adds a table span to the executor.
+func (e *MockTableExecutor) AddTableSpan(
+	ctx context.Context, span tablepb.Span, startTs model.Ts, isPrepare bool,
 ) (bool, error) {
-	log.Info("AddTable",
+	tableID := span.TableID
+	log.Info("AddTableSpan",
 		zap.Int64("tableID", tableID),
 		zap.Any("startTs", startTs),
 		zap.Bool("isPrepare", isPrepare))
@@ -870,15 +871,16 @@ func (e *MockTableExecutor) AddTable(
 			delete(e.tables, tableID)
 		}
 	}
-	args := e.Called(ctx, tableID, startTs, isPrepare)
+	args := e.Called(ctx, span, startTs, isPrepare)
 	if args.Bool(0) {
 		e.tables[tableID] = tablepb.TableStatePreparing
 	}
 	return args.Bool(0), args.Error(1)
 }
 
-// IsAddTableFinished determines if the table has been added.
-func (e *MockTableExecutor) IsAddTableFinished(tableID model.TableID, isPrepare bool) bool {
+// IsAddTableSpanFinished determines if the table span has been added.
+func (e *MockTableExecutor) IsAddTableSpanFinished(span tablepb.Span, isPrepare bool) bool {
+	tableID := span.TableID
 	_, ok := e.tables[tableID]
 	if !ok {
 		log.Panic("table which was added is not found",
@@ -886,7 +888,7 @@ func (e *MockTableExecutor) IsAddTableFinished(tableID model.TableID, isPrepare
 			zap.Bool("isPrepare", isPrepare))
 	}
 
-	args := e.Called(tableID, isPrepare)
+	args := e.Called(span, isPrepare)
 	if args.Bool(0) {
 		e.tables[tableID] = tablepb.TableStatePrepared
 		if !isPrepare {
@@ -903,8 +905,9 @@ func (e *MockTableExecutor) IsAddTableFinished(tableID model.TableID, isPrepare
 	return false
 }
 
-// RemoveTable removes a table from the executor.
-func (e *MockTableExecutor) RemoveTable(tableID model.TableID) bool {
+// RemoveTableSpan removes a table span from the executor.
+func (e *MockTableExecutor) RemoveTableSpan(span tablepb.Span) bool {
+	tableID := span.TableID
 	state, ok := e.tables[tableID]
 	if !ok {
 		log.Warn("table to be remove is not found", zap.Int64("tableID", tableID))
@@ -917,17 +920,18 @@ func (e *MockTableExecutor) RemoveTable(tableID model.TableID) bool {
 	default:
 	}
 	// the current `processor implementation, does not consider table's state
-	log.Info("RemoveTable", zap.Int64("tableID", tableID), zap.Any("state", state))
+	log.Info("RemoveTableSpan", zap.Int64("tableID", tableID), zap.Any("state", state))
 
-	args := e.Called(tableID)
+	args := e.Called(span)
 	if args.Bool(0) {
 		e.tables[tableID] = tablepb.TableStateStopped
 	}
 	return args.Bool(0)
 }
 
-// IsRemoveTableFinished determines if the table has been removed.
-func (e *MockTableExecutor) IsRemoveTableFinished(tableID model.TableID) (model.Ts, bool) {
+// IsRemoveTableSpanFinished determines if the table span has been removed.
+func (e *MockTableExecutor) IsRemoveTableSpanFinished(span tablepb.Span) (model.Ts, bool) {
+	tableID := span.TableID
 	state, ok := e.tables[tableID]
 	if !ok {
 		// the real `table executor` processor, would panic in such case.
@@ -935,7 +939,7 @@ func (e *MockTableExecutor) IsRemoveTableFinished(tableID model.TableID) (model.
 			zap.Int64("tableID", tableID))
 		return 0, true
 	}
-	args := e.Called(tableID)
+	args := e.Called(span)
 	if args.Bool(1) {
 		log.Info("remove table finished, remove it from the executor",
 			zap.Int64("tableID", tableID), zap.Any("state", state))
@@ -949,11 +953,12 @@ func (e *MockTableExecutor) IsRemoveTableFinished(tableID model.TableID) (model.
 	return model.Ts(args.Int(0)), args.Bool(1)
 }
 
-// GetAllCurrentTables returns all tables that are currently being adding, running, or removing.
-func (e *MockTableExecutor) GetAllCurrentTables() []model.TableID {
-	var result []model.TableID
+// GetAllCurrentTableSpans returns all table spans that are currently being
+// adding, running, or removing.
+func (e *MockTableExecutor) GetAllCurrentTableSpans() []tablepb.Span {
+	var result []tablepb.Span
 	for tableID := range e.tables {
-		result = append(result, tableID)
+		result = append(result, tablepb.TableSpan(tableID))
 	}
 	return result
 }
@@ -964,14 +969,16 @@ func (e *MockTableExecutor) GetCheckpoint() (checkpointTs, resolvedTs model.Ts)
 	return args.Get(0).(model.Ts), args.Get(1).(model.Ts)
 }
 
-// GetTableStatus implements TableExecutor interface
-func (e *MockTableExecutor) GetTableStatus(tableID model.TableID) tablepb.TableStatus {
+// GetTableSpanStatus implements TableExecutor interface
+func (e *MockTableExecutor) GetTableSpanStatus(span tablepb.Span) tablepb.TableStatus {
+	tableID := span.TableID
 	state, ok := e.tables[tableID]
 	if !ok {
 		state = tablepb.TableStateAbsent
 	}
 	return tablepb.TableStatus{
 		TableID: tableID,
+		Span:    span,
 		State:   state,
 	}
 }
@@ -30,6 +30,7 @@ import (
 type table struct {
 	changefeedID model.ChangeFeedID
 	id           model.TableID
+	span         tablepb.Span
 
 	state    tablepb.TableState
 	executor internal.TableExecutor
@@ -38,11 +39,12 @@ type table struct {
 }
 
 func newTable(
-	changefeed model.ChangeFeedID, tableID model.TableID, executor internal.TableExecutor,
+	changefeed model.ChangeFeedID, span tablepb.Span, executor internal.TableExecutor,
 ) *table {
 	return &table{
 		changefeedID: changefeed,
-		id:           tableID,
+		id:           span.TableID,
+		span:         span,
 		state:        tablepb.TableStateAbsent, // use `absent` as the default state.
 		executor:     executor,
 		task:         nil,
@@ -53,14 +55,14 @@ func newTable(
 func (t *table) getAndUpdateTableState() (tablepb.TableState, bool) {
 	oldState := t.state
 
-	meta := t.executor.GetTableStatus(t.id)
+	meta := t.executor.GetTableSpanStatus(t.span)
 	t.state = meta.State
 
 	if oldState != t.state {
 		log.Debug("schedulerv3: table state changed",
 			zap.String("namespace", t.changefeedID.Namespace),
 			zap.String("changefeed", t.changefeedID.ID),
-			zap.Int64("tableID", t.id),
+			zap.String("span", tablepb.FormatSpan(t.span)),
 			zap.Stringer("oldState", oldState),
 			zap.Stringer("state", t.state))
 		return t.state, true
@@ -69,7 +71,7 @@ func (t *table) getAndUpdateTableState() (tablepb.TableState, bool) {
 }
 
 func (t *table) getTableStatus() tablepb.TableStatus {
-	return t.executor.GetTableStatus(t.id)
+	return t.executor.GetTableSpanStatus(t.span)
 }
 
 func newAddTableResponseMessage(status tablepb.TableStatus) *schedulepb.Message {
@@ -111,14 +113,14 @@ func (t *table) handleRemoveTableTask() *schedulepb.Message {
 			log.Warn("schedulerv3: remove table, but table is absent",
 				zap.String("namespace", t.changefeedID.Namespace),
 				zap.String("changefeed", t.changefeedID.ID),
-				zap.Int64("tableID", t.id))
+				zap.String("span", tablepb.FormatSpan(t.span)))
 			t.task = nil
 			return newRemoveTableResponseMessage(t.getTableStatus())
 		case tablepb.TableStateStopping, // stopping now is useless
 			tablepb.TableStateStopped:
 			// release table resource, and get the latest checkpoint
 			// this will let the table become `absent`
-			checkpointTs, done := t.executor.IsRemoveTableFinished(t.id)
+			checkpointTs, done := t.executor.IsRemoveTableSpanFinished(t.span)
 			if !done {
 				// actually, this should never be hit, since we know that table is stopped.
 				status := t.getTableStatus()
@@ -133,7 +135,7 @@ func (t *table) handleRemoveTableTask() *schedulepb.Message {
 		case tablepb.TableStatePreparing,
 			tablepb.TableStatePrepared,
 			tablepb.TableStateReplicating:
-			done := t.executor.RemoveTable(t.task.TableID)
+			done := t.executor.RemoveTableSpan(t.task.Span)
 			if !done {
 				status := t.getTableStatus()
 				status.State = tablepb.TableStateStopping
@@ -144,7 +146,7 @@ func (t *table) handleRemoveTableTask() *schedulepb.Message {
 			log.Panic("schedulerv3: unknown table state",
 				zap.String("namespace", t.changefeedID.Namespace),
 				zap.String("changefeed", t.changefeedID.ID),
-				zap.Int64("tableID", t.id), zap.Stringer("state", state))
+				zap.String("span", tablepb.FormatSpan(t.span)), zap.Stringer("state", state))
 		}
 	}
 	return nil
@@ -156,12 +158,12 @@ func (t *table) handleAddTableTask(ctx context.Context) (result *schedulepb.Mess
 	for changed {
 		switch state {
 		case tablepb.TableStateAbsent:
-			done, err := t.executor.AddTable(ctx, t.task.TableID, t.task.StartTs, t.task.IsPrepare)
+			done, err := t.executor.AddTableSpan(ctx, t.task.Span, t.task.StartTs, t.task.IsPrepare)
 			if err != nil || !done {
 				log.Warn("schedulerv3: agent add table failed",
 					zap.String("namespace", t.changefeedID.Namespace),
 					zap.String("changefeed", t.changefeedID.ID),
-					zap.Int64("tableID", t.id), zap.Any("task", t.task),
+					zap.String("span", tablepb.FormatSpan(t.span)), zap.Any("task", t.task),
 					zap.Error(err))
 				status := t.getTableStatus()
 				return newAddTableResponseMessage(status), errors.Trace(err)
@@ -171,7 +173,7 @@ func (t *table) handleAddTableTask(ctx context.Context) (result *schedulepb.Mess
 			log.Info("schedulerv3: table is replicating",
 				zap.String("namespace", t.changefeedID.Namespace),
 				zap.String("changefeed", t.changefeedID.ID),
-				zap.Int64("tableID", t.id), zap.Stringer("state", state))
+				zap.String("span", tablepb.FormatSpan(t.span)), zap.Stringer("state", state))
 			t.task = nil
 			status := t.getTableStatus()
 			return newAddTableResponseMessage(status), nil
@@ -181,18 +183,18 @@ func (t *table) handleAddTableTask(ctx context.Context) (result *schedulepb.Mess
 				log.Info("schedulerv3: table is prepared",
 					zap.String("namespace", t.changefeedID.Namespace),
 					zap.String("changefeed", t.changefeedID.ID),
-					zap.Int64("tableID", t.id), zap.Stringer("state", state))
+					zap.String("span", tablepb.FormatSpan(t.span)), zap.Stringer("state", state))
 				t.task = nil
 				return newAddTableResponseMessage(t.getTableStatus()), nil
 			}
 
 			if t.task.status == dispatchTableTaskReceived {
-				done, err := t.executor.AddTable(ctx, t.task.TableID, t.task.StartTs, false)
+				done, err := t.executor.AddTableSpan(ctx, t.task.Span, t.task.StartTs, false)
 				if err != nil || !done {
 					log.Warn("schedulerv3: agent add table failed",
 						zap.String("namespace", t.changefeedID.Namespace),
 						zap.String("changefeed", t.changefeedID.ID),
-						zap.Int64("tableID", t.id), zap.Stringer("state", state),
+						zap.String("span", tablepb.FormatSpan(t.span)), zap.Stringer("state", state),
 						zap.Error(err))
 					status := t.getTableStatus()
 					return newAddTableResponseMessage(status), errors.Trace(err)
@@ -200,7 +202,7 @@ func (t *table) handleAddTableTask(ctx context.Context) (result *schedulepb.Mess
 				t.task.status = dispatchTableTaskProcessed
 			}
 
-			done := t.executor.IsAddTableFinished(t.task.TableID, false)
+			done := t.executor.IsAddTableSpanFinished(t.task.Span, false)
 			if !done {
 				return newAddTableResponseMessage(t.getTableStatus()), nil
 			}
@@ -208,7 +210,7 @@ func (t *table) handleAddTableTask(ctx context.Context) (result *schedulepb.Mess
 		case tablepb.TableStatePreparing:
 			// `preparing` is not stable state and would last a long time,
 			// it's no need to return such a state, to make the coordinator become burdensome.
-			done := t.executor.IsAddTableFinished(t.task.TableID, t.task.IsPrepare)
+			done := t.executor.IsAddTableSpanFinished(t.task.Span, t.task.IsPrepare)
 			if !done {
 				return nil, nil
 			}
@@ -216,20 +218,20 @@ func (t *table) handleAddTableTask(ctx context.Context) (result *schedulepb.Mess
 			log.Info("schedulerv3: add table finished",
 				zap.String("namespace", t.changefeedID.Namespace),
 				zap.String("changefeed", t.changefeedID.ID),
-				zap.Int64("tableID", t.id), zap.Stringer("state", state))
+				zap.String("span", tablepb.FormatSpan(t.span)), zap.Stringer("state", state))
 		case tablepb.TableStateStopping,
 			tablepb.TableStateStopped:
 			log.Warn("schedulerv3: ignore add table",
 				zap.String("namespace", t.changefeedID.Namespace),
 				zap.String("changefeed", t.changefeedID.ID),
-				zap.Int64("tableID", t.id))
+				zap.String("span", tablepb.FormatSpan(t.span)))
 			t.task = nil
 			return newAddTableResponseMessage(t.getTableStatus()), nil
 		default:
 			log.Panic("schedulerv3: unknown table state",
 				zap.String("namespace", t.changefeedID.Namespace),
 				zap.String("changefeed", t.changefeedID.ID),
-				zap.Int64("tableID", t.id))
+				zap.String("span", tablepb.FormatSpan(t.span)))
 		}
 	}
 
@@ -237,18 +239,18 @@ func (t *table) handleAddTableTask(ctx context.Context) (result *schedulepb.Mess
 }
 
 func (t *table) injectDispatchTableTask(task *dispatchTableTask) {
-	if t.id != task.TableID {
-		log.Panic("schedulerv3: tableID not match",
+	if !t.span.Eq(&task.Span) {
+		log.Panic("schedulerv3: span not match",
 			zap.String("namespace", t.changefeedID.Namespace),
 			zap.String("changefeed", t.changefeedID.ID),
-			zap.Int64("tableID", t.id),
-			zap.Int64("task.TableID", task.TableID))
+			zap.String("span", tablepb.FormatSpan(t.span)),
+			zap.String("task.Span", tablepb.FormatSpan(task.Span)))
 	}
 	if t.task == nil {
 		log.Info("schedulerv3: table found new task",
 			zap.String("namespace", t.changefeedID.Namespace),
 			zap.String("changefeed", t.changefeedID.ID),
-			zap.Int64("tableID", t.id),
+			zap.String("span", tablepb.FormatSpan(t.span)),
 			zap.Any("task", task))
 		t.task = task
 		return
@@ -257,7 +259,7 @@ func (t *table) injectDispatchTableTask(task *dispatchTableTask) {
 		"since there is one not finished yet",
 		zap.String("namespace", t.changefeedID.Namespace),
 		zap.String("changefeed", t.changefeedID.ID),
-		zap.Int64("tableID", t.id),
+		zap.String("span", tablepb.FormatSpan(t.span)),
 		zap.Any("nowTask", t.task),
 		zap.Any("ignoredTask", task))
 }
@@ -273,7 +275,7 @@ func (t *table) poll(ctx context.Context) (*schedulepb.Message, error) {
 }
 
 type tableManager struct {
-	tables   map[model.TableID]*table
+	tables   *tablepb.SpanMap[*table]
 	executor internal.TableExecutor
 
 	changefeedID model.ChangeFeedID
@@ -283,7 +285,7 @@ func newTableManager(
 	changefeed model.ChangeFeedID, executor internal.TableExecutor,
 ) *tableManager {
 	return &tableManager{
-		tables:       make(map[model.TableID]*table),
+		tables:       tablepb.NewSpanMap[*table](),
 		executor:     executor,
 		changefeedID: changefeed,
 	}
@@ -291,54 +293,57 @@ func newTableManager(
 
 func (tm *tableManager) poll(ctx context.Context) ([]*schedulepb.Message, error) {
 	result := make([]*schedulepb.Message, 0)
-	for tableID, table := range tm.tables {
-		message, err := table.poll(ctx)
+	var err error
+	tm.tables.Range(func(span tablepb.Span, table *table) bool {
+		var message *schedulepb.Message
+		message, err = table.poll(ctx)
 		if err != nil {
-			return result, errors.Trace(err)
+			err = errors.Trace(err)
+			return false
 		}
 
 		state, _ := table.getAndUpdateTableState()
 		if state == tablepb.TableStateAbsent {
-			tm.dropTable(tableID)
+			tm.dropTable(span)
 		}
 
-		if message == nil {
-			continue
+		if message != nil {
+			result = append(result, message)
 		}
-		result = append(result, message)
-	}
-	return result, nil
+		return true
+	})
+	return result, err
 }
 
-func (tm *tableManager) getAllTables() map[model.TableID]*table {
+func (tm *tableManager) getAllTables() *tablepb.SpanMap[*table] {
 	return tm.tables
 }
 
-// addTable add the target table, and return it.
-func (tm *tableManager) addTable(tableID model.TableID) *table {
-	table, ok := tm.tables[tableID]
+// addTable add the target table span, and return it.
+func (tm *tableManager) addTable(span tablepb.Span) *table {
+	table, ok := tm.tables.Get(span)
 	if !ok {
-		table = newTable(tm.changefeedID, tableID, tm.executor)
-		tm.tables[tableID] = table
+		table = newTable(tm.changefeedID, span, tm.executor)
+		tm.tables.ReplaceOrInsert(span, table)
 	}
 	return table
 }
 
-func (tm *tableManager) getTable(tableID model.TableID) (*table, bool) {
-	table, ok := tm.tables[tableID]
+func (tm *tableManager) getTable(span tablepb.Span) (*table, bool) {
+	table, ok := tm.tables.Get(span)
 	if ok {
 		return table, true
 	}
 	return nil, false
 }
 
-func (tm *tableManager) dropTable(tableID model.TableID) {
-	table, ok := tm.tables[tableID]
+func (tm *tableManager) dropTable(span tablepb.Span) {
+	table, ok := tm.tables.Get(span)
 	if !ok {
 		log.Warn("schedulerv3: tableManager drop table not found",
 			zap.String("namespace", tm.changefeedID.Namespace),
 			zap.String("changefeed", tm.changefeedID.ID),
-			zap.Int64("tableID", tableID))
+			zap.String("span", tablepb.FormatSpan(span)))
 		return
 	}
 	state, _ := table.getAndUpdateTableState()
@@ -346,25 +351,26 @@ func (tm *tableManager) dropTable(tableID model.TableID) {
 		log.Panic("schedulerv3: tableManager drop table undesired",
 			zap.String("namespace", tm.changefeedID.Namespace),
 			zap.String("changefeed", tm.changefeedID.ID),
-			zap.Int64("tableID", tableID),
+			zap.String("span", tablepb.FormatSpan(span)),
 			zap.Stringer("state", table.state))
 	}
 
 	log.Debug("schedulerv3: tableManager drop table",
 		zap.String("namespace", tm.changefeedID.Namespace),
 		zap.String("changefeed", tm.changefeedID.ID),
-		zap.Int64("tableID", tableID))
-	delete(tm.tables, tableID)
+		zap.String("span", tablepb.FormatSpan(span)))
+	tm.tables.Delete(span)
 }
 
-func (tm *tableManager) getTableStatus(tableID model.TableID) tablepb.TableStatus {
-	table, ok := tm.getTable(tableID)
+func (tm *tableManager) getTableStatus(span tablepb.Span) tablepb.TableStatus {
+	table, ok := tm.getTable(span)
 	if ok {
 		return table.getTableStatus()
 	}
 
 	return tablepb.TableStatus{
-		TableID: tableID,
+		TableID: span.TableID,
+		Span:    span,
 		State:   tablepb.TableStateAbsent,
 	}
 }
@@ -29,9 +29,9 @@ func TestTableManager(t *testing.T) {
 
 	tableM := newTableManager(model.ChangeFeedID{}, mockTableExecutor)
 
-	tableM.addTable(model.TableID(1))
-	require.Equal(t, tablepb.TableStateAbsent, tableM.tables[model.TableID(1)].state)
+	tableM.addTable(tablepb.TableSpan(1))
+	require.Equal(t, tablepb.TableStateAbsent, tableM.tables.GetV(tablepb.TableSpan(1)).state)
 
-	tableM.dropTable(model.TableID(1))
-	require.NotContains(t, tableM.tables, model.TableID(1))
+	tableM.dropTable(tablepb.TableSpan(1))
+	require.False(t, tableM.tables.Has(tablepb.TableSpan(1)))
 }
@@ -21,7 +21,9 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/keyspan"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/scheduler"
@@ -30,6 +32,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/version"
 	"go.uber.org/zap"
 )
@@ -50,6 +53,7 @@ type coordinator struct {
 	revision     schedulepb.OwnerRevision
 	captureID    model.CaptureID
 	trans        transport.Transport
+	reconciler   *keyspan.Reconciler
 	replicationM *replication.Manager
 	captureM     *member.CaptureManager
 	schedulerM   *scheduler.Manager
@@ -61,6 +65,7 @@ type coordinator struct {
 // NewCoordinator returns a two phase scheduler.
 func NewCoordinator(
 	ctx context.Context,
+	up *upstream.Upstream,
 	captureID model.CaptureID,
 	changefeedID model.ChangeFeedID,
 	checkpointTs model.Ts,
@@ -68,14 +73,21 @@ func NewCoordinator(
 	messageRouter p2p.MessageRouter,
 	ownerRevision int64,
 	cfg *config.SchedulerConfig,
+	changefeedCfg *config.ChangefeedSchedulerConfig,
 ) (internal.Scheduler, error) {
 	trans, err := transport.NewTransport(
 		ctx, changefeedID, transport.SchedulerRole, messageServer, messageRouter)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	reconciler, err := keyspan.NewReconciler(changefeedID, up, changefeedCfg)
+	if err != nil {
+		_ = trans.Close()
+		return nil, errors.Trace(err)
+	}
 	coord := newCoordinator(captureID, changefeedID, ownerRevision, cfg)
 	coord.trans = trans
+	coord.reconciler = reconciler
 	return coord, nil
 }
 
@@ -86,11 +98,15 @@ func newCoordinator(
 	cfg *config.SchedulerConfig,
 ) *coordinator {
 	revision := schedulepb.OwnerRevision{Revision: ownerRevision}
+	// Tables are never split by default, NewCoordinator replaces it with
+	// a reconciler that follows the changefeed config.
+	reconciler, _ := keyspan.NewReconciler(changefeedID, nil, nil)
 
 	return &coordinator{
-		version:   version.ReleaseSemver(),
-		revision:  revision,
-		captureID: captureID,
+		version:    version.ReleaseSemver(),
+		revision:   revision,
+		captureID:  captureID,
+		reconciler: reconciler,
 		replicationM: replication.NewReplicationManager(
 			cfg.MaxTaskConcurrency, changefeedID),
 		captureM: member.NewCaptureManager(
@@ -168,11 +184,13 @@ func (c *coordinator) DrainCapture(target model.CaptureID) (int, error) {
 	}
 
 	var count int
-	for _, rep := range c.replicationM.ReplicationSets() {
-		if rep.Primary == target {
-			count++
-		}
-	}
+	c.replicationM.ReplicationSets().Range(
+		func(_ tablepb.Span, rep *replication.ReplicationSet) bool {
+			if rep.Primary == target {
+				count++
+			}
+			return true
+		})
 
 	if count == 0 {
 		log.Info("schedulerv3: drain capture request ignored, "+
@@ -223,6 +241,7 @@ func (c *coordinator) Close(ctx context.Context) {
 	defer c.mu.Unlock()
 
 	_ = c.trans.Close()
+	c.reconciler.Close()
 	c.captureM.CleanMetrics()
 	c.replicationM.CleanMetrics()
 	c.schedulerM.CleanMetrics()
@@ -263,7 +282,8 @@ func (c *coordinator) poll(
 	if !c.captureM.CheckAllCaptureInitialized() {
 		// Skip generating schedule tasks for replication manager,
 		// as not all capture are initialized.
-		newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(currentTables)
+		currentSpans := c.reconciler.Spans(currentTables)
+		newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(currentSpans)
 		return newCheckpointTs, newResolvedTs, c.sendMsgs(ctx, msgBuf)
 	}
 
@@ -280,8 +300,10 @@ func (c *coordinator) poll(
 	// Generate schedule tasks based on the current status.
 	replications := c.replicationM.ReplicationSets()
 	runningTasks := c.replicationM.RunningTasks()
+	currentSpans := c.reconciler.Reconcile(
+		ctx, currentTables, replications, len(c.captureM.Captures))
 	allTasks := c.schedulerM.Schedule(
-		checkpointTs, currentTables, c.captureM.Captures, replications, runningTasks)
+		checkpointTs, currentSpans, c.captureM.Captures, replications, runningTasks)
 
 	// Handle generated schedule tasks.
 	msgs, err = c.replicationM.HandleTasks(allTasks)
@@ -297,7 +319,7 @@ func (c *coordinator) poll(
 	}
 
 	// Checkpoint calculation
-	newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(currentTables)
+	newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(currentSpans)
 	return newCheckpointTs, newResolvedTs, nil
 }
 
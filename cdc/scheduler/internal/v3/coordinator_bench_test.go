@@ -141,7 +141,7 @@ func BenchmarkCoordinatorHeartbeatResponse(b *testing.B) {
 			currentTables = append(currentTables, tableID)
 			captureID := fmt.Sprint(i % captureCount)
 			rep, err := replication.NewReplicationSet(
-				tableID, 0, map[string]*tablepb.TableStatus{
+				tablepb.TableSpan(tableID), 0, map[string]*tablepb.TableStatus{
 					captureID: {
 						TableID: tableID,
 						State:   tablepb.TableStateReplicating,
@@ -171,7 +171,7 @@ func TestCoordinatorHeartbeat(t *testing.T) {
 	require.Len(t, msgs, 1)
 	// Basic scheduler, make sure all tables get replicated.
 	require.EqualValues(t, 3, msgs[0].DispatchTableRequest.GetAddTable().TableID)
-	require.Len(t, coord.replicationM.GetReplicationSetForTests(), 3)
+	require.Equal(t, 3, coord.replicationM.GetReplicationSetForTests().Len())
 }
 
 func TestCoordinatorAddCapture(t *testing.T) {
@@ -199,7 +199,7 @@ func TestCoordinatorAddCapture(t *testing.T) {
 	msgs, err := coord.replicationM.HandleCaptureChanges(init, nil, 0)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Len(t, coord.replicationM.GetReplicationSetForTests(), 3)
+	require.Equal(t, 3, coord.replicationM.GetReplicationSetForTests().Len())
 
 	// Capture "b" is online, heartbeat, and then move one table to capture "b".
 	ctx := context.Background()
@@ -256,7 +256,7 @@ func TestCoordinatorRemoveCapture(t *testing.T) {
 	msgs, err := coord.replicationM.HandleCaptureChanges(init, nil, 0)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Len(t, coord.replicationM.GetReplicationSetForTests(), 3)
+	require.Equal(t, 3, coord.replicationM.GetReplicationSetForTests().Len())
 
 	// Capture "c" is removed, add table 3 to another capture.
 	ctx := context.Background()
@@ -294,6 +294,7 @@ func TestCoordinatorDrainCapture(t *testing.T) {
 
 	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
 		TableID: 1,
+		Span:    tablepb.TableSpan(1),
 		State:   replication.ReplicationSetStateReplicating,
 		Primary: "a",
 	})
@@ -305,6 +306,7 @@ func TestCoordinatorDrainCapture(t *testing.T) {
 	coord.captureM.Captures["b"] = &member.CaptureStatus{State: member.CaptureStateInitialized}
 	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
 		TableID: 2,
+		Span:    tablepb.TableSpan(2),
 		State:   replication.ReplicationSetStateReplicating,
 		Primary: "b",
 	})
@@ -40,6 +40,12 @@ func (c *coordinator) GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus,
 			Tables: make(map[model.TableID]*model.TableReplicaInfo),
 		}
 		for _, s := range status.Tables {
+			// A table may be split into several spans on the same capture,
+			// report the slowest one.
+			if info, ok := taskStatus.Tables[s.TableID]; ok &&
+				info.StartTs <= s.Checkpoint.CheckpointTs {
+				continue
+			}
 			taskStatus.Tables[s.TableID] = &model.TableReplicaInfo{
 				StartTs: s.Checkpoint.CheckpointTs,
 			}
@@ -0,0 +1,301 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyspan
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultSplitCheckInterval is the interval to check whether the spans
+	// of a table should be changed, as its write rate or regions change.
+	defaultSplitCheckInterval = 5 * time.Minute
+	// maxSplitChecksPerTick limits the number of tables checked in a
+	// Reconcile call, since checking a table may scan its regions in PD.
+	maxSplitChecksPerTick = 16
+)
+
+type splitter interface {
+	// split returns spans that cover the given span, it returns the span
+	// itself if the span does not need to be split.
+	split(
+		ctx context.Context, span tablepb.Span, totalCaptures int,
+		config *config.ChangefeedSchedulerConfig,
+	) []tablepb.Span
+}
+
+// Reconciler reconciles the mapping between tables and spans, it makes sure
+// that spans cover all tables that should be replicated.
+type Reconciler struct {
+	tableSpans map[model.TableID][]tablepb.Span
+	// splitCheckedAt records when the spans of each table are decided or
+	// checked last time.
+	splitCheckedAt     map[model.TableID]time.Time
+	splitCheckInterval time.Duration
+	// now is used to mock the current time in tests.
+	now func() time.Time
+
+	changefeedID model.ChangeFeedID
+	config       *config.ChangefeedSchedulerConfig
+
+	splitter []splitter
+	closer   func()
+}
+
+// NewReconciler returns a Reconciler. Tables are never split if splitting
+// is not enabled in the config or the upstream is nil.
+func NewReconciler(
+	changefeedID model.ChangeFeedID,
+	up *upstream.Upstream,
+	config *config.ChangefeedSchedulerConfig,
+) (*Reconciler, error) {
+	r := &Reconciler{
+		tableSpans:         make(map[model.TableID][]tablepb.Span),
+		splitCheckedAt:     make(map[model.TableID]time.Time),
+		splitCheckInterval: defaultSplitCheckInterval,
+		now:                time.Now,
+		changefeedID:       changefeedID,
+		config:             config,
+	}
+	if up == nil || config == nil || !config.EnableTableAcrossNodes {
+		return r, nil
+	}
+	if config.RegionThreshold > 0 {
+		r.splitter = append(r.splitter,
+			newRegionCountSplitter(changefeedID, up.RegionCache))
+	}
+	if config.WriteKeyThreshold > 0 {
+		pc, err := pdutil.NewPDAPIClient(up.PDClient, up.SecurityConfig)
+		if err != nil {
+			return nil, err
+		}
+		r.splitter = append(r.splitter, newWriteSplitter(changefeedID, pc))
+		r.closer = pc.Close
+	}
+	return r, nil
+}
+
+// Close releases resources held by the Reconciler.
+func (m *Reconciler) Close() {
+	if m.closer != nil {
+		m.closer()
+	}
+}
+
+// Spans returns spans of the given tables without updating the reconciler.
+// Tables that have not been reconciled yet are returned as whole table spans.
+func (m *Reconciler) Spans(currentTables []model.TableID) []tablepb.Span {
+	spans := make([]tablepb.Span, 0, len(currentTables))
+	for _, tableID := range currentTables {
+		if tableSpans, ok := m.tableSpans[tableID]; ok {
+			spans = append(spans, tableSpans...)
+			continue
+		}
+		spans = append(spans, tablepb.TableSpan(tableID))
+	}
+	return spans
+}
+
+// Reconcile returns spans that should be replicated for the given tables.
+//
+// Spans of a table are decided and cached. For a table that is not cached,
+// spans in replications are reused if they cover the whole table, e.g.,
+// after the owner is restarted, otherwise the table is split if necessary.
+// The cached spans are checked periodically, and they are replaced once the
+// table should be split into a different number of spans.
+func (m *Reconciler) Reconcile(
+	ctx context.Context,
+	currentTables []model.TableID,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
+	aliveCaptureCount int,
+) []tablepb.Span {
+	var existing map[model.TableID][]tablepb.Span
+	now := m.now()
+	checks := 0
+	spans := make([]tablepb.Span, 0, len(currentTables))
+	tables := make(map[model.TableID]struct{}, len(currentTables))
+	for _, tableID := range currentTables {
+		tables[tableID] = struct{}{}
+		if tableSpans, ok := m.tableSpans[tableID]; ok {
+			if checks < maxSplitChecksPerTick &&
+				m.shouldCheckSplit(tableID, tableSpans, replications, now) {
+				checks++
+				tableSpans = m.checkSplit(ctx, tableID, tableSpans, aliveCaptureCount)
+				m.splitCheckedAt[tableID] = now
+			}
+			spans = append(spans, tableSpans...)
+			continue
+		}
+
+		tableSpan := tablepb.TableSpan(tableID)
+		tableSpans := []tablepb.Span{tableSpan}
+		if !replications.Has(tableSpan) {
+			if existing == nil {
+				existing = groupSpans(replications)
+			}
+			if covered := existing[tableID]; coverSpan(tableSpan, covered) {
+				tableSpans = covered
+			} else {
+				tableSpans = m.split(ctx, tableSpan, aliveCaptureCount)
+			}
+		}
+		m.tableSpans[tableID] = tableSpans
+		m.splitCheckedAt[tableID] = now
+		spans = append(spans, tableSpans...)
+	}
+
+	// Clean up tables that no longer need to be replicated.
+	if len(m.tableSpans) != len(tables) {
+		for tableID := range m.tableSpans {
+			if _, ok := tables[tableID]; !ok {
+				delete(m.tableSpans, tableID)
+				delete(m.splitCheckedAt, tableID)
+			}
+		}
+	}
+	return spans
+}
+
+// shouldCheckSplit returns true if the cached spans of a table are due to be
+// checked. They are not checked until all of them are replicating, so that
+// the spans are not changed again while the last change is in progress.
+func (m *Reconciler) shouldCheckSplit(
+	tableID model.TableID,
+	tableSpans []tablepb.Span,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
+	now time.Time,
+) bool {
+	if len(m.splitter) == 0 ||
+		now.Sub(m.splitCheckedAt[tableID]) < m.splitCheckInterval {
+		return false
+	}
+	for _, span := range tableSpans {
+		rep, ok := replications.Get(span)
+		if !ok || rep.State != replication.ReplicationSetStateReplicating {
+			return false
+		}
+	}
+	return true
+}
+
+// checkSplit splits the table again and returns the new spans if the number
+// of spans changes, which means the write rate or the regions of the table
+// have changed beyond the thresholds. Otherwise the cached spans are kept to
+// avoid moving the table for a minor change.
+func (m *Reconciler) checkSplit(
+	ctx context.Context, tableID model.TableID,
+	tableSpans []tablepb.Span, totalCaptures int,
+) []tablepb.Span {
+	newSpans := m.split(ctx, tablepb.TableSpan(tableID), totalCaptures)
+	if len(newSpans) == len(tableSpans) {
+		return tableSpans
+	}
+	log.Info("schedulerv3: spans of table changed",
+		zap.String("namespace", m.changefeedID.Namespace),
+		zap.String("changefeed", m.changefeedID.ID),
+		zap.Int64("tableID", tableID),
+		zap.Int("oldSpans", len(tableSpans)),
+		zap.Int("newSpans", len(newSpans)))
+	// The scheduler adds the new spans and then removes the old ones.
+	m.tableSpans[tableID] = newSpans
+	return newSpans
+}
+
+func (m *Reconciler) split(
+	ctx context.Context, span tablepb.Span, totalCaptures int,
+) []tablepb.Span {
+	for _, s := range m.splitter {
+		spans := s.split(ctx, span, totalCaptures, m.config)
+		if len(spans) > 1 {
+			log.Info("schedulerv3: split table into spans",
+				zap.String("namespace", m.changefeedID.Namespace),
+				zap.String("changefeed", m.changefeedID.ID),
+				zap.Int64("tableID", span.TableID),
+				zap.Int("spans", len(spans)))
+			return spans
+		}
+	}
+	return []tablepb.Span{span}
+}
+
+// groupSpans groups spans in replications by table ID, spans of each table
+// are sorted.
+func groupSpans(
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
+) map[model.TableID][]tablepb.Span {
+	groups := make(map[model.TableID][]tablepb.Span)
+	replications.Ascend(func(span tablepb.Span, _ *replication.ReplicationSet) bool {
+		groups[span.TableID] = append(groups[span.TableID], span)
+		return true
+	})
+	return groups
+}
+
+// coverSpan returns true if sorted spans are continuous and cover exactly
+// the given span.
+func coverSpan(span tablepb.Span, spans []tablepb.Span) bool {
+	if len(spans) == 0 {
+		return false
+	}
+	if !bytes.Equal(spans[0].StartKey, span.StartKey) ||
+		!bytes.Equal(spans[len(spans)-1].EndKey, span.EndKey) {
+		return false
+	}
+	for i := 1; i < len(spans); i++ {
+		if !bytes.Equal(spans[i-1].EndKey, spans[i].StartKey) {
+			return false
+		}
+	}
+	return true
+}
+
+// cutSpan cuts the span at the given memcomparable keys. Keys that can not
+// be decoded or are out of the span are ignored.
+func cutSpan(span tablepb.Span, comparableKeys [][]byte) []tablepb.Span {
+	spans := make([]tablepb.Span, 0, len(comparableKeys)+1)
+	startKey := span.StartKey
+	for _, comparableKey := range comparableKeys {
+		_, key, err := codec.DecodeBytes(comparableKey, nil)
+		if err != nil {
+			continue
+		}
+		if bytes.Compare(key, startKey) <= 0 || bytes.Compare(key, span.EndKey) >= 0 {
+			continue
+		}
+		spans = append(spans, tablepb.Span{
+			TableID:  span.TableID,
+			StartKey: startKey,
+			EndKey:   key,
+		})
+		startKey = key
+	}
+	spans = append(spans, tablepb.Span{
+		TableID:  span.TableID,
+		StartKey: startKey,
+		EndKey:   span.EndKey,
+	})
+	return spans
+}
@@ -0,0 +1,188 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyspan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSplitter struct {
+	spans map[model.TableID][]tablepb.Span
+}
+
+func (m *mockSplitter) split(
+	ctx context.Context, span tablepb.Span, totalCaptures int,
+	config *config.ChangefeedSchedulerConfig,
+) []tablepb.Span {
+	if spans, ok := m.spans[span.TableID]; ok {
+		return spans
+	}
+	return []tablepb.Span{span}
+}
+
+func splitSpan(tableID model.TableID, keys ...string) []tablepb.Span {
+	span := tablepb.TableSpan(tableID)
+	comparableKeys := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		rawKey := append(span.StartKey[:len(span.StartKey):len(span.StartKey)], key...)
+		comparableKeys = append(comparableKeys, regionspan.ToComparableKey(rawKey))
+	}
+	return cutSpan(span, comparableKeys)
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reps := tablepb.NewSpanMap[*replication.ReplicationSet]()
+	r, err := NewReconciler(model.ChangeFeedID{}, nil, nil)
+	require.NoError(t, err)
+	r.splitter = []splitter{&mockSplitter{spans: map[model.TableID][]tablepb.Span{
+		1: splitSpan(1, "a"),
+	}}}
+
+	// Tables are split by splitters.
+	spans := r.Reconcile(ctx, []model.TableID{1, 2}, reps, 2)
+	require.Equal(t, append(splitSpan(1, "a"), tablepb.TableSpan(2)), spans)
+
+	// Spans are cached.
+	r.splitter = nil
+	spans = r.Reconcile(ctx, []model.TableID{1, 2}, reps, 2)
+	require.Equal(t, append(splitSpan(1, "a"), tablepb.TableSpan(2)), spans)
+	require.Equal(t, spans, r.Spans([]model.TableID{1, 2}))
+
+	// Dropped tables are removed from the cache.
+	spans = r.Reconcile(ctx, []model.TableID{2}, reps, 2)
+	require.Equal(t, []tablepb.Span{tablepb.TableSpan(2)}, spans)
+	require.Len(t, r.tableSpans, 1)
+	require.Equal(t, []tablepb.Span{tablepb.TableSpan(1)}, r.Spans([]model.TableID{1}))
+}
+
+func TestReconcileExistingSpans(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reps := tablepb.NewSpanMap[*replication.ReplicationSet]()
+	for _, span := range splitSpan(1, "a", "b") {
+		reps.ReplaceOrInsert(span, &replication.ReplicationSet{})
+	}
+	// Spans that do not cover the table.
+	reps.ReplaceOrInsert(splitSpan(2, "a")[0], &replication.ReplicationSet{})
+	reps.ReplaceOrInsert(tablepb.TableSpan(3), &replication.ReplicationSet{})
+
+	r, err := NewReconciler(model.ChangeFeedID{}, nil, nil)
+	require.NoError(t, err)
+	r.splitter = []splitter{&mockSplitter{spans: map[model.TableID][]tablepb.Span{
+		1: splitSpan(1, "c"),
+		3: splitSpan(3, "c"),
+	}}}
+	spans := r.Reconcile(ctx, []model.TableID{1, 2, 3}, reps, 3)
+	expected := splitSpan(1, "a", "b")
+	expected = append(expected, tablepb.TableSpan(2), tablepb.TableSpan(3))
+	require.Equal(t, expected, spans)
+}
+
+func TestCoverSpan(t *testing.T) {
+	t.Parallel()
+
+	span := tablepb.TableSpan(1)
+	require.True(t, coverSpan(span, []tablepb.Span{span}))
+	require.True(t, coverSpan(span, splitSpan(1, "a", "b")))
+	require.False(t, coverSpan(span, nil))
+	require.False(t, coverSpan(span, splitSpan(1, "a", "b")[1:]))
+	spans := splitSpan(1, "a", "b")
+	require.False(t, coverSpan(span, []tablepb.Span{spans[0], spans[2]}))
+}
+
+func TestCutSpan(t *testing.T) {
+	t.Parallel()
+
+	span := tablepb.TableSpan(1)
+	spans := splitSpan(1, "a", "b")
+	require.Len(t, spans, 3)
+	require.Equal(t, span.StartKey, spans[0].StartKey)
+	require.Equal(t, spans[0].EndKey, spans[1].StartKey)
+	require.Equal(t, spans[1].EndKey, spans[2].StartKey)
+	require.Equal(t, span.EndKey, spans[2].EndKey)
+
+	// Invalid, duplicated and out of range keys are ignored.
+	spans = cutSpan(span, [][]byte{
+		[]byte("invalid"),
+		regionspan.ToComparableKey(span.StartKey),
+		regionspan.ToComparableKey(append(span.StartKey[:len(span.StartKey):len(span.StartKey)], 'a')),
+		regionspan.ToComparableKey(append(span.StartKey[:len(span.StartKey):len(span.StartKey)], 'a')),
+		regionspan.ToComparableKey(span.EndKey),
+	})
+	require.Equal(t, splitSpan(1, "a"), spans)
+}
+
+func TestReconcileCheckSplit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	reps := tablepb.NewSpanMap[*replication.ReplicationSet]()
+	r, err := NewReconciler(model.ChangeFeedID{}, nil, nil)
+	require.NoError(t, err)
+	r.now = func() time.Time { return now }
+	ms := &mockSplitter{spans: map[model.TableID][]tablepb.Span{}}
+	r.splitter = []splitter{ms}
+
+	spans := r.Reconcile(ctx, []model.TableID{1}, reps, 2)
+	require.Equal(t, []tablepb.Span{tablepb.TableSpan(1)}, spans)
+
+	// The table becomes hot, but it's not checked until the interval passes.
+	ms.spans[1] = splitSpan(1, "a")
+	reps.ReplaceOrInsert(tablepb.TableSpan(1), &replication.ReplicationSet{
+		State: replication.ReplicationSetStateReplicating,
+	})
+	spans = r.Reconcile(ctx, []model.TableID{1}, reps, 2)
+	require.Equal(t, []tablepb.Span{tablepb.TableSpan(1)}, spans)
+
+	now = now.Add(defaultSplitCheckInterval)
+	spans = r.Reconcile(ctx, []model.TableID{1}, reps, 2)
+	require.Equal(t, splitSpan(1, "a"), spans)
+
+	// The spans are not checked until all of them are replicating.
+	ms.spans[1] = splitSpan(1, "a", "b")
+	now = now.Add(defaultSplitCheckInterval)
+	spans = r.Reconcile(ctx, []model.TableID{1}, reps, 2)
+	require.Equal(t, splitSpan(1, "a"), spans)
+
+	// The spans are kept if the number of spans does not change.
+	reps = tablepb.NewSpanMap[*replication.ReplicationSet]()
+	for _, span := range splitSpan(1, "a") {
+		reps.ReplaceOrInsert(span, &replication.ReplicationSet{
+			State: replication.ReplicationSetStateReplicating,
+		})
+	}
+	ms.spans[1] = splitSpan(1, "b")
+	spans = r.Reconcile(ctx, []model.TableID{1}, reps, 2)
+	require.Equal(t, splitSpan(1, "a"), spans)
+
+	// The table becomes cold again.
+	delete(ms.spans, 1)
+	now = now.Add(defaultSplitCheckInterval)
+	spans = r.Reconcile(ctx, []model.TableID{1}, reps, 2)
+	require.Equal(t, []tablepb.Span{tablepb.TableSpan(1)}, spans)
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyspan
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"github.com/tikv/client-go/v2/tikv"
+	"go.uber.org/zap"
+)
+
+const regionCacheMaxBackoff = 500
+
+// RegionCache is a simplified interface of tikv.RegionCache.
+type RegionCache interface {
+	// ListRegionIDsInKeyRange lists ids of regions in [startKey, endKey].
+	ListRegionIDsInKeyRange(
+		bo *tikv.Backoffer, startKey, endKey []byte,
+	) (regionIDs []uint64, err error)
+	// LocateRegionByID searches for the region with ID.
+	LocateRegionByID(bo *tikv.Backoffer, regionID uint64) (*tikv.KeyLocation, error)
+}
+
+// regionCountSplitter splits a table into spans that have roughly the same
+// number of regions.
+type regionCountSplitter struct {
+	changefeedID model.ChangeFeedID
+	regionCache  RegionCache
+}
+
+func newRegionCountSplitter(
+	changefeedID model.ChangeFeedID, regionCache RegionCache,
+) *regionCountSplitter {
+	return &regionCountSplitter{
+		changefeedID: changefeedID,
+		regionCache:  regionCache,
+	}
+}
+
+func (m *regionCountSplitter) split(
+	ctx context.Context, span tablepb.Span, totalCaptures int,
+	config *config.ChangefeedSchedulerConfig,
+) []tablepb.Span {
+	bo := tikv.NewBackoffer(ctx, regionCacheMaxBackoff)
+	comparableSpan := regionspan.ToComparableSpan(span.ToRegionSpan())
+	regions, err := m.regionCache.ListRegionIDsInKeyRange(
+		bo, comparableSpan.Start, comparableSpan.End)
+	if err != nil {
+		log.Warn("schedulerv3: list regions failed, skip split span",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.String("span", tablepb.FormatSpan(span)),
+			zap.Error(err))
+		return []tablepb.Span{span}
+	}
+	if len(regions) <= config.RegionThreshold {
+		return []tablepb.Span{span}
+	}
+
+	spansNum := (len(regions) + config.RegionThreshold - 1) / config.RegionThreshold
+	if spansNum > totalCaptures {
+		spansNum = totalCaptures
+	}
+	if spansNum <= 1 {
+		return []tablepb.Span{span}
+	}
+	keys := make([][]byte, 0, spansNum-1)
+	for i := 1; i < spansNum; i++ {
+		regionID := regions[i*len(regions)/spansNum]
+		loc, err := m.regionCache.LocateRegionByID(bo, regionID)
+		if err != nil {
+			log.Warn("schedulerv3: locate region failed, skip split span",
+				zap.String("namespace", m.changefeedID.Namespace),
+				zap.String("changefeed", m.changefeedID.ID),
+				zap.String("span", tablepb.FormatSpan(span)),
+				zap.Uint64("regionID", regionID),
+				zap.Error(err))
+			return []tablepb.Span{span}
+		}
+		keys = append(keys, loc.StartKey)
+	}
+	return cutSpan(span, keys)
+}
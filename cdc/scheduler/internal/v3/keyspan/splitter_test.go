@@ -0,0 +1,149 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyspan
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/tikv"
+)
+
+type mockRegionCache struct {
+	// startKeys are comparable start keys of regions, region ID is the index.
+	startKeys [][]byte
+	err       error
+}
+
+func (m *mockRegionCache) ListRegionIDsInKeyRange(
+	bo *tikv.Backoffer, startKey, endKey []byte,
+) ([]uint64, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	ids := make([]uint64, 0, len(m.startKeys))
+	for i := range m.startKeys {
+		ids = append(ids, uint64(i))
+	}
+	return ids, nil
+}
+
+func (m *mockRegionCache) LocateRegionByID(
+	bo *tikv.Backoffer, regionID uint64,
+) (*tikv.KeyLocation, error) {
+	return &tikv.KeyLocation{StartKey: m.startKeys[regionID]}, nil
+}
+
+func regionStartKeys(tableID model.TableID, keys ...string) [][]byte {
+	span := tablepb.TableSpan(tableID)
+	startKeys := [][]byte{regionspan.ToComparableKey(span.StartKey)}
+	for _, key := range keys {
+		rawKey := append(span.StartKey[:len(span.StartKey):len(span.StartKey)], key...)
+		startKeys = append(startKeys, regionspan.ToComparableKey(rawKey))
+	}
+	return startKeys
+}
+
+func TestRegionCountSplitSpan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	span := tablepb.TableSpan(1)
+	cache := &mockRegionCache{startKeys: regionStartKeys(1, "a", "b", "c", "d", "e")}
+	splitter := newRegionCountSplitter(model.ChangeFeedID{}, cache)
+	cfg := &config.ChangefeedSchedulerConfig{EnableTableAcrossNodes: true}
+
+	for _, cs := range []struct {
+		threshold     int
+		totalCaptures int
+		expected      []tablepb.Span
+	}{
+		// Less regions than the threshold.
+		{threshold: 6, totalCaptures: 3, expected: []tablepb.Span{span}},
+		{threshold: 3, totalCaptures: 3, expected: splitSpan(1, "c")},
+		{threshold: 2, totalCaptures: 3, expected: splitSpan(1, "b", "d")},
+		// Limited by the number of captures.
+		{threshold: 1, totalCaptures: 2, expected: splitSpan(1, "c")},
+		{threshold: 1, totalCaptures: 1, expected: []tablepb.Span{span}},
+	} {
+		cfg.RegionThreshold = cs.threshold
+		require.Equal(t, cs.expected, splitter.split(ctx, span, cs.totalCaptures, cfg), cs)
+	}
+
+	// Do not split span on error.
+	cache.err = errors.New("test")
+	cfg.RegionThreshold = 1
+	require.Equal(t, []tablepb.Span{span}, splitter.split(ctx, span, 3, cfg))
+}
+
+type mockPDAPIClient struct {
+	regions []pdutil.RegionInfo
+	err     error
+}
+
+func (m *mockPDAPIClient) ScanRegions(
+	ctx context.Context, startKey, endKey []byte,
+) ([]pdutil.RegionInfo, error) {
+	return m.regions, m.err
+}
+
+func TestWriteSplitSpan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	span := tablepb.TableSpan(1)
+	startKeys := regionStartKeys(1, "a", "b", "c")
+	writes := []uint64{10, 10, 30, 10}
+	regions := make([]pdutil.RegionInfo, 0, len(startKeys))
+	for i := range startKeys {
+		regions = append(regions, pdutil.RegionInfo{
+			ID:          uint64(i),
+			StartKey:    hex.EncodeToString(startKeys[i]),
+			WrittenKeys: writes[i],
+		})
+	}
+	client := &mockPDAPIClient{regions: regions}
+	splitter := newWriteSplitter(model.ChangeFeedID{}, client)
+	cfg := &config.ChangefeedSchedulerConfig{EnableTableAcrossNodes: true}
+
+	for _, cs := range []struct {
+		threshold     int
+		totalCaptures int
+		expected      []tablepb.Span
+	}{
+		// Less writes than the threshold.
+		{threshold: 100, totalCaptures: 3, expected: []tablepb.Span{span}},
+		{threshold: 30, totalCaptures: 3, expected: splitSpan(1, "c")},
+		{threshold: 20, totalCaptures: 3, expected: splitSpan(1, "b", "c")},
+		// Regions are never split, so a hot region makes a span on its own.
+		{threshold: 1, totalCaptures: 10, expected: splitSpan(1, "b", "c")},
+		{threshold: 1, totalCaptures: 1, expected: []tablepb.Span{span}},
+	} {
+		cfg.WriteKeyThreshold = cs.threshold
+		require.Equal(t, cs.expected, splitter.split(ctx, span, cs.totalCaptures, cfg), cs)
+	}
+
+	// Do not split span on error.
+	client.err = errors.New("test")
+	cfg.WriteKeyThreshold = 1
+	require.Equal(t, []tablepb.Span{span}, splitter.split(ctx, span, 3, cfg))
+}
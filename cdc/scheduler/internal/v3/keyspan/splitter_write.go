@@ -0,0 +1,103 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyspan
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"go.uber.org/zap"
+)
+
+// PDAPIClient is a simplified interface of the PD HTTP API client.
+type PDAPIClient interface {
+	// ScanRegions scans regions in the key range [startKey, endKey).
+	ScanRegions(ctx context.Context, startKey, endKey []byte) ([]pdutil.RegionInfo, error)
+}
+
+// writeSplitter splits a table into spans that have roughly the same
+// write rate, according to written keys of regions reported by PD.
+type writeSplitter struct {
+	changefeedID model.ChangeFeedID
+	pdAPIClient  PDAPIClient
+}
+
+func newWriteSplitter(
+	changefeedID model.ChangeFeedID, pdAPIClient PDAPIClient,
+) *writeSplitter {
+	return &writeSplitter{
+		changefeedID: changefeedID,
+		pdAPIClient:  pdAPIClient,
+	}
+}
+
+func (m *writeSplitter) split(
+	ctx context.Context, span tablepb.Span, totalCaptures int,
+	config *config.ChangefeedSchedulerConfig,
+) []tablepb.Span {
+	comparableSpan := regionspan.ToComparableSpan(span.ToRegionSpan())
+	regions, err := m.pdAPIClient.ScanRegions(
+		ctx, comparableSpan.Start, comparableSpan.End)
+	if err != nil {
+		log.Warn("schedulerv3: scan regions failed, skip split span",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.String("span", tablepb.FormatSpan(span)),
+			zap.Error(err))
+		return []tablepb.Span{span}
+	}
+
+	var totalWrite uint64
+	for i := range regions {
+		totalWrite += regions[i].WrittenKeys
+	}
+	threshold := uint64(config.WriteKeyThreshold)
+	if totalWrite < threshold {
+		return []tablepb.Span{span}
+	}
+
+	spansNum := int((totalWrite + threshold - 1) / threshold)
+	if spansNum > totalCaptures {
+		spansNum = totalCaptures
+	}
+	if spansNum > len(regions) {
+		spansNum = len(regions)
+	}
+	if spansNum <= 1 {
+		return []tablepb.Span{span}
+	}
+
+	// Cut the span once the accumulated written keys reach the expected
+	// written keys of each span.
+	keys := make([][]byte, 0, spansNum-1)
+	var accWrite uint64
+	for i := 0; i < len(regions)-1 && len(keys) < spansNum-1; i++ {
+		accWrite += regions[i].WrittenKeys
+		if accWrite*uint64(spansNum) < totalWrite*uint64(len(keys)+1) {
+			continue
+		}
+		key, err := hex.DecodeString(regions[i+1].StartKey)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return cutSpan(span, keys)
+}
@@ -166,19 +166,28 @@ func (c *CaptureManager) checkAllCaptureInitialized() bool {
 // Tick advances the logical lock of capture manager and produce heartbeat when
 // necessary.
 func (c *CaptureManager) Tick(
-	reps map[model.TableID]*replication.ReplicationSet, drainingCapture model.CaptureID,
+	reps *tablepb.SpanMap[*replication.ReplicationSet], drainingCapture model.CaptureID,
 ) []*schedulepb.Message {
 	c.tickCounter++
 	if c.tickCounter < c.heartbeatTick {
 		return nil
 	}
 	c.tickCounter = 0
+	// Whole table spans are sent as table IDs, so that captures running an
+	// old version can still understand the heartbeat.
 	tables := make(map[model.CaptureID][]model.TableID)
-	for tableID, rep := range reps {
+	spans := make(map[model.CaptureID][]tablepb.Span)
+	reps.Ascend(func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+		wholeTable := tablepb.TableSpan(span.TableID)
 		for captureID := range rep.Captures {
-			tables[captureID] = append(tables[captureID], tableID)
+			if span.Eq(&wholeTable) {
+				tables[captureID] = append(tables[captureID], span.TableID)
+			} else {
+				spans[captureID] = append(spans[captureID], span)
+			}
 		}
-	}
+		return true
+	})
 	msgs := make([]*schedulepb.Message, 0, len(c.Captures))
 	for to := range c.Captures {
 		msgs = append(msgs, &schedulepb.Message{
@@ -186,6 +195,7 @@ func (c *CaptureManager) Tick(
 			MsgType: schedulepb.MsgHeartbeat,
 			Heartbeat: &schedulepb.Heartbeat{
 				TableIDs: tables[to],
+				Spans:    spans[to],
 				// IsStopping let the receiver capture know that it should be stopping now.
 				// At the moment, this is triggered by `DrainCapture` scheduler.
 				IsStopping: drainingCapture == to,
@@ -181,9 +181,10 @@ func TestCaptureManagerTick(t *testing.T) {
 	cm := NewCaptureManager("", model.ChangeFeedID{}, rev, 2)
 
 	// No heartbeat if there is no capture.
-	msgs := cm.Tick(nil, captureIDNotDraining)
+	emptyTables := tablepb.NewSpanMap[*replication.ReplicationSet]()
+	msgs := cm.Tick(emptyTables, captureIDNotDraining)
 	require.Empty(t, msgs)
-	msgs = cm.Tick(nil, captureIDNotDraining)
+	msgs = cm.Tick(emptyTables, captureIDNotDraining)
 	require.Empty(t, msgs)
 
 	ms := map[model.CaptureID]*model.CaptureInfo{
@@ -193,9 +194,9 @@ func TestCaptureManagerTick(t *testing.T) {
 	cm.HandleAliveCaptureUpdate(ms)
 
 	// Heartbeat even if capture is uninitialized.
-	msgs = cm.Tick(nil, captureIDNotDraining)
+	msgs = cm.Tick(emptyTables, captureIDNotDraining)
 	require.Empty(t, msgs)
-	msgs = cm.Tick(nil, captureIDNotDraining)
+	msgs = cm.Tick(emptyTables, captureIDNotDraining)
 	require.ElementsMatch(t, []*schedulepb.Message{
 		{To: "1", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
 		{To: "2", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
@@ -205,9 +206,9 @@ func TestCaptureManagerTick(t *testing.T) {
 	for _, s := range []CaptureState{CaptureStateInitialized, CaptureStateStopping} {
 		cm.Captures["1"].State = s
 		cm.Captures["2"].State = s
-		msgs = cm.Tick(nil, captureIDNotDraining)
+		msgs = cm.Tick(emptyTables, captureIDNotDraining)
 		require.Empty(t, msgs)
-		msgs = cm.Tick(nil, captureIDNotDraining)
+		msgs = cm.Tick(emptyTables, captureIDNotDraining)
 		require.ElementsMatch(t, []*schedulepb.Message{
 			{To: "1", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
 			{To: "2", MsgType: schedulepb.MsgHeartbeat, Heartbeat: &schedulepb.Heartbeat{}},
@@ -215,27 +216,40 @@ func TestCaptureManagerTick(t *testing.T) {
 	}
 
 	// TableID in heartbeat.
-	msgs = cm.Tick(nil, captureIDNotDraining)
+	msgs = cm.Tick(emptyTables, captureIDNotDraining)
 	require.Empty(t, msgs)
-	tables := map[model.TableID]*replication.ReplicationSet{
-		1: {Captures: map[model.CaptureID]replication.Role{
+	tables := tablepb.NewSpanMap[*replication.ReplicationSet]()
+	tables.ReplaceOrInsert(tablepb.TableSpan(1), &replication.ReplicationSet{
+		Captures: map[model.CaptureID]replication.Role{
 			"1": replication.RolePrimary,
-		}},
-		2: {Captures: map[model.CaptureID]replication.Role{
+		},
+	})
+	tables.ReplaceOrInsert(tablepb.TableSpan(2), &replication.ReplicationSet{
+		Captures: map[model.CaptureID]replication.Role{
 			"1": replication.RolePrimary, "2": replication.RoleSecondary,
-		}},
-		3: {Captures: map[model.CaptureID]replication.Role{
+		},
+	})
+	tables.ReplaceOrInsert(tablepb.TableSpan(3), &replication.ReplicationSet{
+		Captures: map[model.CaptureID]replication.Role{
 			"2": replication.RoleSecondary,
-		}},
-		4: {},
-	}
+		},
+	})
+	tables.ReplaceOrInsert(tablepb.TableSpan(4), &replication.ReplicationSet{})
+	// Spans of a split table are sent as spans.
+	span5 := tablepb.TableSpan(5)
+	span5.EndKey = append(span5.StartKey[:len(span5.StartKey):len(span5.StartKey)], 'a')
+	tables.ReplaceOrInsert(span5, &replication.ReplicationSet{
+		Captures: map[model.CaptureID]replication.Role{
+			"2": replication.RolePrimary,
+		},
+	})
 	msgs = cm.Tick(tables, captureIDNotDraining)
 	require.Len(t, msgs, 2)
-	if msgs[0].To == "1" {
-		require.ElementsMatch(t, []model.TableID{1, 2}, msgs[0].Heartbeat.TableIDs)
-		require.ElementsMatch(t, []model.TableID{2, 3}, msgs[1].Heartbeat.TableIDs)
-	} else {
-		require.ElementsMatch(t, []model.TableID{2, 3}, msgs[0].Heartbeat.TableIDs)
-		require.ElementsMatch(t, []model.TableID{1, 2}, msgs[1].Heartbeat.TableIDs)
+	if msgs[0].To != "1" {
+		msgs[0], msgs[1] = msgs[1], msgs[0]
 	}
+	require.ElementsMatch(t, []model.TableID{1, 2}, msgs[0].Heartbeat.TableIDs)
+	require.Empty(t, msgs[0].Heartbeat.Spans)
+	require.ElementsMatch(t, []model.TableID{2, 3}, msgs[1].Heartbeat.TableIDs)
+	require.Equal(t, []tablepb.Span{span5}, msgs[1].Heartbeat.Spans)
 }
@@ -41,22 +41,22 @@ type BurstBalance struct {
 	MoveTables   []MoveTable
 }
 
-// MoveTable is a schedule task for moving a table.
+// MoveTable is a schedule task for moving a table span.
 type MoveTable struct {
-	TableID     model.TableID
+	Span        tablepb.Span
 	DestCapture model.CaptureID
 }
 
-// AddTable is a schedule task for adding a table.
+// AddTable is a schedule task for adding a table span.
 type AddTable struct {
-	TableID      model.TableID
+	Span         tablepb.Span
 	CaptureID    model.CaptureID
 	CheckpointTs model.Ts
 }
 
-// RemoveTable is a schedule task for removing a table.
+// RemoveTable is a schedule task for removing a table span.
 type RemoveTable struct {
-	TableID   model.TableID
+	Span      tablepb.Span
 	CaptureID model.CaptureID
 }
 
@@ -86,14 +86,14 @@ func (s *ScheduleTask) Name() string {
 
 // Manager manages replications and running scheduling tasks.
 type Manager struct { //nolint:revive
-	tables map[model.TableID]*ReplicationSet
+	tables *tablepb.SpanMap[*ReplicationSet]
 
-	runningTasks       map[model.TableID]*ScheduleTask
+	runningTasks       *tablepb.SpanMap[*ScheduleTask]
 	maxTaskConcurrency int
 
 	changefeedID           model.ChangeFeedID
 	acceptScheduleTask     int
-	slowestTableID         model.TableID
+	slowestSpan            tablepb.Span
 	acceptAddTableTask     int
 	acceptRemoveTableTask  int
 	acceptMoveTableTask    int
@@ -105,8 +105,8 @@ func NewReplicationManager(
 	maxTaskConcurrency int, changefeedID model.ChangeFeedID,
 ) *Manager {
 	return &Manager{
-		tables:             make(map[int64]*ReplicationSet),
-		runningTasks:       make(map[int64]*ScheduleTask),
+		tables:             tablepb.NewSpanMap[*ReplicationSet](),
+		runningTasks:       tablepb.NewSpanMap[*ScheduleTask](),
 		maxTaskConcurrency: maxTaskConcurrency,
 		changefeedID:       changefeedID,
 	}
@@ -119,45 +119,64 @@ func (r *Manager) HandleCaptureChanges(
 	checkpointTs model.Ts,
 ) ([]*schedulepb.Message, error) {
 	if init != nil {
-		if len(r.tables) != 0 {
+		if r.tables.Len() != 0 {
 			log.Panic("schedulerv3: init again",
 				zap.String("namespace", r.changefeedID.Namespace),
 				zap.String("changefeed", r.changefeedID.ID),
 				zap.Any("init", init), zap.Any("tables", r.tables))
 		}
-		tableStatus := map[model.TableID]map[model.CaptureID]*tablepb.TableStatus{}
+		spanStatus := tablepb.NewSpanMap[map[model.CaptureID]*tablepb.TableStatus]()
 		for captureID, tables := range init {
 			for i := range tables {
 				table := tables[i]
-				if _, ok := tableStatus[table.TableID]; !ok {
-					tableStatus[table.TableID] = map[model.CaptureID]*tablepb.TableStatus{}
+				table.Span = tablepb.NormalizeSpan(table.TableID, table.Span)
+				status, ok := spanStatus.Get(table.Span)
+				if !ok {
+					status = map[model.CaptureID]*tablepb.TableStatus{}
+					spanStatus.ReplaceOrInsert(table.Span, status)
 				}
-				tableStatus[table.TableID][captureID] = &table
+				status[captureID] = &table
 			}
 		}
-		for tableID, status := range tableStatus {
-			table, err := NewReplicationSet(
-				tableID, checkpointTs, status, r.changefeedID)
+		var err error
+		spanStatus.Ascend(func(span tablepb.Span, status map[model.CaptureID]*tablepb.TableStatus) bool {
+			var table *ReplicationSet
+			table, err = NewReplicationSet(span, checkpointTs, status, r.changefeedID)
 			if err != nil {
-				return nil, errors.Trace(err)
+				err = errors.Trace(err)
+				return false
 			}
-			r.tables[tableID] = table
+			r.tables.ReplaceOrInsert(span, table)
+			return true
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 	sentMsgs := make([]*schedulepb.Message, 0)
 	if removed != nil {
-		for _, table := range r.tables {
+		var err error
+		r.tables.Ascend(func(span tablepb.Span, table *ReplicationSet) bool {
 			for captureID := range removed {
-				msgs, affected, err := table.handleCaptureShutdown(captureID)
+				var (
+					msgs     []*schedulepb.Message
+					affected bool
+				)
+				msgs, affected, err = table.handleCaptureShutdown(captureID)
 				if err != nil {
-					return nil, errors.Trace(err)
+					err = errors.Trace(err)
+					return false
 				}
 				sentMsgs = append(sentMsgs, msgs...)
 				if affected {
 					// Cleanup its running task.
-					delete(r.runningTasks, table.TableID)
+					r.runningTasks.Delete(span)
 				}
 			}
+			return true
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 	return sentMsgs, nil
@@ -198,7 +217,8 @@ func (r *Manager) handleMessageHeartbeatResponse(
 ) ([]*schedulepb.Message, error) {
 	sentMsgs := make([]*schedulepb.Message, 0)
 	for _, status := range msg.Tables {
-		table, ok := r.tables[status.TableID]
+		status.Span = tablepb.NormalizeSpan(status.TableID, status.Span)
+		table, ok := r.tables.Get(status.Span)
 		if !ok {
 			log.Info("schedulerv3: ignore table status no table found",
 				zap.String("namespace", r.changefeedID.Namespace),
@@ -214,8 +234,8 @@ func (r *Manager) handleMessageHeartbeatResponse(
 			log.Info("schedulerv3: table has removed",
 				zap.String("namespace", r.changefeedID.Namespace),
 				zap.String("changefeed", r.changefeedID.ID),
-				zap.Int64("tableID", status.TableID))
-			delete(r.tables, status.TableID)
+				zap.String("span", tablepb.FormatSpan(status.Span)))
+			r.tables.Delete(status.Span)
 		}
 		sentMsgs = append(sentMsgs, msgs...)
 	}
@@ -239,7 +259,8 @@ func (r *Manager) handleMessageDispatchTableResponse(
 		return nil, nil
 	}
 
-	table, ok := r.tables[status.TableID]
+	status.Span = tablepb.NormalizeSpan(status.TableID, status.Span)
+	table, ok := r.tables.Get(status.Span)
 	if !ok {
 		log.Info("schedulerv3: ignore table status no table found",
 			zap.String("namespace", r.changefeedID.Namespace),
@@ -255,8 +276,8 @@ func (r *Manager) handleMessageDispatchTableResponse(
 		log.Info("schedulerv3: table has removed",
 			zap.String("namespace", r.changefeedID.Namespace),
 			zap.String("changefeed", r.changefeedID.ID),
-			zap.Int64("tableID", status.TableID))
-		delete(r.tables, status.TableID)
+			zap.String("span", tablepb.FormatSpan(status.Span)))
+		r.tables.Delete(status.Span)
 	}
 	return msgs, nil
 }
@@ -266,18 +287,19 @@ func (r *Manager) HandleTasks(
 	tasks []*ScheduleTask,
 ) ([]*schedulepb.Message, error) {
 	// Check if a running task is finished.
-	for tableID := range r.runningTasks {
-		if table, ok := r.tables[tableID]; ok {
+	r.runningTasks.Range(func(span tablepb.Span, _ *ScheduleTask) bool {
+		if table, ok := r.tables.Get(span); ok {
 			// If table is back to Replicating or Removed,
 			// the running task is finished.
 			if table.State == ReplicationSetStateReplicating || table.hasRemoved() {
-				delete(r.runningTasks, tableID)
+				r.runningTasks.Delete(span)
 			}
 		} else {
 			// No table found, remove the task
-			delete(r.runningTasks, tableID)
+			r.runningTasks.Delete(span)
 		}
-	}
+		return true
+	})
 
 	sentMsgs := make([]*schedulepb.Message, 0)
 	for _, task := range tasks {
@@ -295,7 +317,7 @@ func (r *Manager) HandleTasks(
 		}
 
 		// Check if accepting one more task exceeds maxTaskConcurrency.
-		if len(r.runningTasks) == r.maxTaskConcurrency {
+		if r.runningTasks.Len() == r.maxTaskConcurrency {
 			log.Debug("schedulerv3: too many running task",
 				zap.String("namespace", r.changefeedID.Namespace),
 				zap.String("changefeed", r.changefeedID.ID))
@@ -304,25 +326,25 @@ func (r *Manager) HandleTasks(
 			continue
 		}
 
-		var tableID model.TableID
+		var span tablepb.Span
 		if task.AddTable != nil {
-			tableID = task.AddTable.TableID
+			span = task.AddTable.Span
 		} else if task.RemoveTable != nil {
-			tableID = task.RemoveTable.TableID
+			span = task.RemoveTable.Span
 		} else if task.MoveTable != nil {
-			tableID = task.MoveTable.TableID
+			span = task.MoveTable.Span
 		}
 
 		// Skip task if the table is already running a task,
 		// or the table has removed.
-		if _, ok := r.runningTasks[tableID]; ok {
+		if r.runningTasks.Has(span) {
 			log.Info("schedulerv3: ignore task, already exists",
 				zap.String("namespace", r.changefeedID.Namespace),
 				zap.String("changefeed", r.changefeedID.ID),
 				zap.Any("task", task))
 			continue
 		}
-		if _, ok := r.tables[tableID]; !ok && task.AddTable == nil {
+		if !r.tables.Has(span) && task.AddTable == nil {
 			log.Info("schedulerv3: ignore task, table not found",
 				zap.String("namespace", r.changefeedID.Namespace),
 				zap.String("changefeed", r.changefeedID.ID),
@@ -343,7 +365,7 @@ func (r *Manager) HandleTasks(
 			return nil, errors.Trace(err)
 		}
 		sentMsgs = append(sentMsgs, msgs...)
-		r.runningTasks[tableID] = task
+		r.runningTasks.ReplaceOrInsert(span, task)
 		if task.Accept != nil {
 			task.Accept()
 		}
@@ -356,14 +378,14 @@ func (r *Manager) handleAddTableTask(
 ) ([]*schedulepb.Message, error) {
 	r.acceptAddTableTask++
 	var err error
-	table := r.tables[task.TableID]
-	if table == nil {
+	table, ok := r.tables.Get(task.Span)
+	if !ok {
 		table, err = NewReplicationSet(
-			task.TableID, task.CheckpointTs, nil, r.changefeedID)
+			task.Span, task.CheckpointTs, nil, r.changefeedID)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-		r.tables[task.TableID] = table
+		r.tables.ReplaceOrInsert(task.Span, table)
 	}
 	return table.handleAddTable(task.CaptureID)
 }
@@ -372,13 +394,13 @@ func (r *Manager) handleRemoveTableTask(
 	task *RemoveTable,
 ) ([]*schedulepb.Message, error) {
 	r.acceptRemoveTableTask++
-	table := r.tables[task.TableID]
+	table := r.tables.GetV(task.Span)
 	if table.hasRemoved() {
 		log.Info("schedulerv3: table has removed",
 			zap.String("namespace", r.changefeedID.Namespace),
 			zap.String("changefeed", r.changefeedID.ID),
-			zap.Int64("tableID", task.TableID))
-		delete(r.tables, task.TableID)
+			zap.String("span", tablepb.FormatSpan(task.Span)))
+		r.tables.Delete(task.Span)
 		return nil, nil
 	}
 	return table.handleRemoveTable()
@@ -388,7 +410,7 @@ func (r *Manager) handleMoveTableTask(
 	task *MoveTable,
 ) ([]*schedulepb.Message, error) {
 	r.acceptMoveTableTask++
-	table := r.tables[task.TableID]
+	table := r.tables.GetV(task.Span)
 	return table.handleMoveTable(task.DestCapture)
 }
 
@@ -417,7 +439,7 @@ func (r *Manager) handleBurstBalanceTasks(
 	sentMsgs := make([]*schedulepb.Message, 0, len(task.AddTables))
 	for i := range task.AddTables {
 		addTable := task.AddTables[i]
-		if r.runningTasks[addTable.TableID] != nil {
+		if r.runningTasks.Has(addTable.Span) {
 			// Skip add table if the table is already running a task.
 			continue
 		}
@@ -427,11 +449,11 @@ func (r *Manager) handleBurstBalanceTasks(
 		}
 		sentMsgs = append(sentMsgs, msgs...)
 		// Just for place holding.
-		r.runningTasks[addTable.TableID] = &ScheduleTask{}
+		r.runningTasks.ReplaceOrInsert(addTable.Span, &ScheduleTask{})
 	}
 	for i := range task.RemoveTables {
 		removeTable := task.RemoveTables[i]
-		if r.runningTasks[removeTable.TableID] != nil {
+		if r.runningTasks.Has(removeTable.Span) {
 			// Skip add table if the table is already running a task.
 			continue
 		}
@@ -441,11 +463,11 @@ func (r *Manager) handleBurstBalanceTasks(
 		}
 		sentMsgs = append(sentMsgs, msgs...)
 		// Just for place holding.
-		r.runningTasks[removeTable.TableID] = &ScheduleTask{}
+		r.runningTasks.ReplaceOrInsert(removeTable.Span, &ScheduleTask{})
 	}
 	for i := range task.MoveTables {
 		moveTable := task.MoveTables[i]
-		if r.runningTasks[moveTable.TableID] != nil {
+		if r.runningTasks.Has(moveTable.Span) {
 			// Skip add table if the table is already running a task.
 			continue
 		}
@@ -455,50 +477,51 @@ func (r *Manager) handleBurstBalanceTasks(
 		}
 		sentMsgs = append(sentMsgs, msgs...)
 		// Just for place holding.
-		r.runningTasks[moveTable.TableID] = &ScheduleTask{}
+		r.runningTasks.ReplaceOrInsert(moveTable.Span, &ScheduleTask{})
 	}
 	return sentMsgs, nil
 }
 
 // ReplicationSets return all tracking replication set
 // Caller must not modify the returned map.
-func (r *Manager) ReplicationSets() map[model.TableID]*ReplicationSet {
+func (r *Manager) ReplicationSets() *tablepb.SpanMap[*ReplicationSet] {
 	return r.tables
 }
 
 // RunningTasks return running tasks.
 // Caller must not modify the returned map.
-func (r *Manager) RunningTasks() map[model.TableID]*ScheduleTask {
+func (r *Manager) RunningTasks() *tablepb.SpanMap[*ScheduleTask] {
 	return r.runningTasks
 }
 
 // AdvanceCheckpoint tries to advance checkpoint and returns current checkpoint.
+// The checkpoint of a table is the minimum checkpoint of all its spans.
 func (r *Manager) AdvanceCheckpoint(
-	currentTables []model.TableID,
+	currentSpans []tablepb.Span,
 ) (newCheckpointTs, newResolvedTs model.Ts) {
 	newCheckpointTs, newResolvedTs = math.MaxUint64, math.MaxUint64
-	slowestTableID := int64(0)
-	for _, tableID := range currentTables {
-		table, ok := r.tables[tableID]
+	var slowestSpan tablepb.Span
+	for _, span := range currentSpans {
+		table, ok := r.tables.Get(span)
 		if !ok {
-			// Can not advance checkpoint there is a table missing.
-			log.Warn("schedulerv3: cannot advance checkpoint since missing table",
+			// Can not advance checkpoint there is a span missing.
+			log.Warn("schedulerv3: cannot advance checkpoint since missing span",
 				zap.String("namespace", r.changefeedID.Namespace),
 				zap.String("changefeed", r.changefeedID.ID),
-				zap.Int64("tableID", tableID))
+				zap.String("span", tablepb.FormatSpan(span)))
 			return checkpointCannotProceed, checkpointCannotProceed
 		}
 		// Find the minimum checkpoint ts and resolved ts.
 		if newCheckpointTs > table.Checkpoint.CheckpointTs {
 			newCheckpointTs = table.Checkpoint.CheckpointTs
-			slowestTableID = tableID
+			slowestSpan = span
 		}
 		if newResolvedTs > table.Checkpoint.ResolvedTs {
 			newResolvedTs = table.Checkpoint.ResolvedTs
 		}
 	}
-	if slowestTableID != 0 {
-		r.slowestTableID = slowestTableID
+	if slowestSpan.TableID != 0 {
+		r.slowestSpan = slowestSpan
 	}
 	return newCheckpointTs, newResolvedTs
 }
@@ -507,10 +530,10 @@ func (r *Manager) AdvanceCheckpoint(
 func (r *Manager) CollectMetrics() {
 	cf := r.changefeedID
 	tableGauge.
-		WithLabelValues(cf.Namespace, cf.ID).Set(float64(len(r.tables)))
-	if table, ok := r.tables[r.slowestTableID]; ok {
+		WithLabelValues(cf.Namespace, cf.ID).Set(float64(r.tables.Len()))
+	if table, ok := r.tables.Get(r.slowestSpan); ok {
 		slowestTableIDGauge.
-			WithLabelValues(cf.Namespace, cf.ID).Set(float64(r.slowestTableID))
+			WithLabelValues(cf.Namespace, cf.ID).Set(float64(r.slowestSpan.TableID))
 		slowestTableStateGauge.
 			WithLabelValues(cf.Namespace, cf.ID).Set(float64(table.State))
 		phyCkpTs := oracle.ExtractPhysical(table.Checkpoint.CheckpointTs)
@@ -532,9 +555,9 @@ func (r *Manager) CollectMetrics() {
 	metricAcceptScheduleTask.WithLabelValues("burstBalance").Add(float64(r.acceptBurstBalanceTask))
 	r.acceptBurstBalanceTask = 0
 	runningScheduleTaskGauge.
-		WithLabelValues(cf.Namespace, cf.ID).Set(float64(len(r.runningTasks)))
+		WithLabelValues(cf.Namespace, cf.ID).Set(float64(r.runningTasks.Len()))
 	var stateCounters [6]int
-	for _, table := range r.tables {
+	r.tables.Range(func(_ tablepb.Span, table *ReplicationSet) bool {
 		switch table.State {
 		case ReplicationSetStateUnknown:
 			stateCounters[ReplicationSetStateUnknown]++
@@ -549,7 +572,8 @@ func (r *Manager) CollectMetrics() {
 		case ReplicationSetStateRemoving:
 			stateCounters[ReplicationSetStateRemoving]++
 		}
-	}
+		return true
+	})
 	for s, counter := range stateCounters {
 		tableStateGauge.
 			WithLabelValues(cf.Namespace, cf.ID, ReplicationSetState(s).String()).
@@ -574,7 +598,7 @@ func (r *Manager) CleanMetrics() {
 	metricAcceptScheduleTask.DeleteLabelValues("moveTable")
 	metricAcceptScheduleTask.DeleteLabelValues("burstBalance")
 	var stateCounters [6]int
-	for _, table := range r.tables {
+	r.tables.Range(func(_ tablepb.Span, table *ReplicationSet) bool {
 		switch table.State {
 		case ReplicationSetStateUnknown:
 			stateCounters[ReplicationSetStateUnknown]++
@@ -589,7 +613,8 @@ func (r *Manager) CleanMetrics() {
 		case ReplicationSetStateRemoving:
 			stateCounters[ReplicationSetStateRemoving]++
 		}
-	}
+		return true
+	})
 	for s := range stateCounters {
 		tableStateGauge.
 			DeleteLabelValues(cf.Namespace, cf.ID, ReplicationSetState(s).String())
@@ -598,10 +623,10 @@ func (r *Manager) CleanMetrics() {
 
 // SetReplicationSetForTests is only used in tests.
 func (r *Manager) SetReplicationSetForTests(rs *ReplicationSet) {
-	r.tables[rs.TableID] = rs
+	r.tables.ReplaceOrInsert(rs.Span, rs)
 }
 
 // GetReplicationSetForTests is only used in tests.
-func (r *Manager) GetReplicationSetForTests() map[model.TableID]*ReplicationSet {
+func (r *Manager) GetReplicationSetForTests() *tablepb.SpanMap[*ReplicationSet] {
 	return r.tables
 }
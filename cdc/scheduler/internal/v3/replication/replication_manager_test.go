@@ -29,7 +29,7 @@ func TestReplicationManagerHandleAddTableTask(t *testing.T) {
 	addTableCh := make(chan int, 1)
 	// Absent -> Prepare
 	msgs, err := r.HandleTasks([]*ScheduleTask{{
-		AddTable: &AddTable{TableID: 1, CaptureID: "1", CheckpointTs: 1},
+		AddTable: &AddTable{Span: tablepb.TableSpan(1), CaptureID: "1", CheckpointTs: 1},
 		Accept: func() {
 			addTableCh <- 1
 			close(addTableCh)
@@ -44,6 +44,7 @@ func TestReplicationManagerHandleAddTableTask(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     1,
+					Span:        tablepb.TableSpan(1),
 					IsSecondary: true,
 					Checkpoint: tablepb.Checkpoint{
 						CheckpointTs: 1,
@@ -53,12 +54,12 @@ func TestReplicationManagerHandleAddTableTask(t *testing.T) {
 			},
 		},
 	}, msgs[0])
-	require.NotNil(t, r.runningTasks[1])
+	require.True(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 	require.Equal(t, 1, <-addTableCh)
 
 	// Ignore if add the table again.
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
-		AddTable: &AddTable{TableID: 1, CaptureID: "1"},
+		AddTable: &AddTable{Span: tablepb.TableSpan(1), CaptureID: "1"},
 		Accept:   func() { t.Fatalf("must not accept") },
 	}})
 	require.Nil(t, err)
@@ -88,6 +89,7 @@ func TestReplicationManagerHandleAddTableTask(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     1,
+					Span:        tablepb.TableSpan(1),
 					IsSecondary: false,
 					Checkpoint: tablepb.Checkpoint{
 						CheckpointTs: 1,
@@ -97,9 +99,9 @@ func TestReplicationManagerHandleAddTableTask(t *testing.T) {
 			},
 		},
 	}, msgs[0])
-	require.Equal(t, ReplicationSetStateCommit, r.tables[1].State)
-	require.Equal(t, "1", r.tables[1].Primary)
-	require.False(t, r.tables[1].hasRole(RoleSecondary))
+	require.Equal(t, ReplicationSetStateCommit, r.tables.GetV(tablepb.TableSpan(1)).State)
+	require.Equal(t, "1", r.tables.GetV(tablepb.TableSpan(1)).Primary)
+	require.False(t, r.tables.GetV(tablepb.TableSpan(1)).hasRole(RoleSecondary))
 
 	// Commit -> Replicating through heartbeat response.
 	msgs, err = r.HandleMessage([]*schedulepb.Message{{
@@ -114,15 +116,15 @@ func TestReplicationManagerHandleAddTableTask(t *testing.T) {
 	}})
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Equal(t, ReplicationSetStateReplicating, r.tables[1].State)
-	require.Equal(t, "1", r.tables[1].Primary)
-	require.False(t, r.tables[1].hasRole(RoleSecondary))
+	require.Equal(t, ReplicationSetStateReplicating, r.tables.GetV(tablepb.TableSpan(1)).State)
+	require.Equal(t, "1", r.tables.GetV(tablepb.TableSpan(1)).Primary)
+	require.False(t, r.tables.GetV(tablepb.TableSpan(1)).hasRole(RoleSecondary))
 
 	// Handle task again to clear runningTasks
 	msgs, err = r.HandleTasks(nil)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Nil(t, r.runningTasks[1])
+	require.False(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 }
 
 func TestReplicationManagerRemoveTable(t *testing.T) {
@@ -133,23 +135,23 @@ func TestReplicationManagerRemoveTable(t *testing.T) {
 
 	// Ignore remove table if there is no such table.
 	msgs, err := r.HandleTasks([]*ScheduleTask{{
-		RemoveTable: &RemoveTable{TableID: 1, CaptureID: "1"},
+		RemoveTable: &RemoveTable{Span: tablepb.TableSpan(1), CaptureID: "1"},
 		Accept:      func() { t.Fatal("must not accept") },
 	}})
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
 
 	// Add the table.
-	tbl, err := NewReplicationSet(1, 0, map[string]*tablepb.TableStatus{
+	tbl, err := NewReplicationSet(tablepb.TableSpan(1), 0, map[string]*tablepb.TableStatus{
 		"1": {TableID: 1, State: tablepb.TableStateReplicating},
 	}, model.ChangeFeedID{})
 	require.Nil(t, err)
 	require.Equal(t, ReplicationSetStateReplicating, tbl.State)
-	r.tables[1] = tbl
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(1), tbl)
 
 	// Remove the table.
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
-		RemoveTable: &RemoveTable{TableID: 1, CaptureID: "1"},
+		RemoveTable: &RemoveTable{Span: tablepb.TableSpan(1), CaptureID: "1"},
 		Accept: func() {
 			removeTableCh <- 1
 			close(removeTableCh)
@@ -162,16 +164,16 @@ func TestReplicationManagerRemoveTable(t *testing.T) {
 		MsgType: schedulepb.MsgDispatchTableRequest,
 		DispatchTableRequest: &schedulepb.DispatchTableRequest{
 			Request: &schedulepb.DispatchTableRequest_RemoveTable{
-				RemoveTable: &schedulepb.RemoveTableRequest{TableID: 1},
+				RemoveTable: &schedulepb.RemoveTableRequest{TableID: 1, Span: tablepb.TableSpan(1)},
 			},
 		},
 	}, msgs[0])
-	require.NotNil(t, r.runningTasks[1])
+	require.True(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 	require.Equal(t, 1, <-removeTableCh)
 
 	// Ignore if remove table again.
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
-		RemoveTable: &RemoveTable{TableID: 1, CaptureID: "1"},
+		RemoveTable: &RemoveTable{Span: tablepb.TableSpan(1), CaptureID: "1"},
 		Accept:      func() { t.Fatalf("must not accept") },
 	}})
 	require.Nil(t, err)
@@ -208,13 +210,13 @@ func TestReplicationManagerRemoveTable(t *testing.T) {
 	}})
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Nil(t, r.tables[1])
+	require.False(t, r.tables.Has(tablepb.TableSpan(1)))
 
 	// Handle task again to clear runningTasks
 	msgs, err = r.HandleTasks(nil)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Nil(t, r.runningTasks[1])
+	require.False(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 }
 
 func TestReplicationManagerMoveTable(t *testing.T) {
@@ -228,23 +230,23 @@ func TestReplicationManagerMoveTable(t *testing.T) {
 
 	// Ignore move table if it's not exist.
 	msgs, err := r.HandleTasks([]*ScheduleTask{{
-		MoveTable: &MoveTable{TableID: 1, DestCapture: dest},
+		MoveTable: &MoveTable{Span: tablepb.TableSpan(1), DestCapture: dest},
 		Accept:    func() { t.Fatal("must not accept") },
 	}})
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
 
 	// Add the table.
-	tbl, err := NewReplicationSet(1, 0, map[string]*tablepb.TableStatus{
+	tbl, err := NewReplicationSet(tablepb.TableSpan(1), 0, map[string]*tablepb.TableStatus{
 		source: {TableID: 1, State: tablepb.TableStateReplicating},
 	}, model.ChangeFeedID{})
 	require.Nil(t, err)
 	require.Equal(t, ReplicationSetStateReplicating, tbl.State)
-	r.tables[1] = tbl
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(1), tbl)
 
 	// Replicating -> Prepare
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
-		MoveTable: &MoveTable{TableID: 1, DestCapture: dest},
+		MoveTable: &MoveTable{Span: tablepb.TableSpan(1), DestCapture: dest},
 		Accept: func() {
 			moveTableCh <- 1
 			close(moveTableCh)
@@ -259,17 +261,18 @@ func TestReplicationManagerMoveTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     1,
+					Span:        tablepb.TableSpan(1),
 					IsSecondary: true,
 				},
 			},
 		},
 	}, msgs[0])
-	require.NotNil(t, r.runningTasks[1])
+	require.True(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 	require.Equal(t, 1, <-moveTableCh)
 
 	// Ignore if move table again.
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
-		MoveTable: &MoveTable{TableID: 1, DestCapture: dest},
+		MoveTable: &MoveTable{Span: tablepb.TableSpan(1), DestCapture: dest},
 		Accept: func() {
 			moveTableCh <- 1
 			close(moveTableCh)
@@ -300,7 +303,7 @@ func TestReplicationManagerMoveTable(t *testing.T) {
 		MsgType: schedulepb.MsgDispatchTableRequest,
 		DispatchTableRequest: &schedulepb.DispatchTableRequest{
 			Request: &schedulepb.DispatchTableRequest_RemoveTable{
-				RemoveTable: &schedulepb.RemoveTableRequest{TableID: 1},
+				RemoveTable: &schedulepb.RemoveTableRequest{TableID: 1, Span: tablepb.TableSpan(1)},
 			},
 		},
 	}, msgs[0])
@@ -326,6 +329,7 @@ func TestReplicationManagerMoveTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     1,
+					Span:        tablepb.TableSpan(1),
 					IsSecondary: false,
 				},
 			},
@@ -349,14 +353,14 @@ func TestReplicationManagerMoveTable(t *testing.T) {
 	}})
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Equal(t, ReplicationSetStateReplicating, r.tables[1].State)
-	require.Equal(t, dest, r.tables[1].Primary)
+	require.Equal(t, ReplicationSetStateReplicating, r.tables.GetV(tablepb.TableSpan(1)).State)
+	require.Equal(t, dest, r.tables.GetV(tablepb.TableSpan(1)).Primary)
 
 	// Handle task again to clear runningTasks
 	msgs, err = r.HandleTasks(nil)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Nil(t, r.runningTasks[1])
+	require.False(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 }
 
 func TestReplicationManagerBurstBalance(t *testing.T) {
@@ -367,15 +371,15 @@ func TestReplicationManagerBurstBalance(t *testing.T) {
 
 	// Burst balance is not limited by maxTaskConcurrency.
 	msgs, err := r.HandleTasks([]*ScheduleTask{{
-		AddTable: &AddTable{TableID: 1, CaptureID: "0", CheckpointTs: 1},
+		AddTable: &AddTable{Span: tablepb.TableSpan(1), CaptureID: "0", CheckpointTs: 1},
 	}, {
 		BurstBalance: &BurstBalance{
 			AddTables: []AddTable{{
-				TableID: 1, CaptureID: "1", CheckpointTs: 1,
+				Span: tablepb.TableSpan(1), CaptureID: "1", CheckpointTs: 1,
 			}, {
-				TableID: 2, CaptureID: "2", CheckpointTs: 1,
+				Span: tablepb.TableSpan(2), CaptureID: "2", CheckpointTs: 1,
 			}, {
-				TableID: 3, CaptureID: "3", CheckpointTs: 1,
+				Span: tablepb.TableSpan(3), CaptureID: "3", CheckpointTs: 1,
 			}},
 		},
 		Accept: func() {
@@ -395,6 +399,7 @@ func TestReplicationManagerBurstBalance(t *testing.T) {
 				Request: &schedulepb.DispatchTableRequest_AddTable{
 					AddTable: &schedulepb.AddTableRequest{
 						TableID:     tableID,
+						Span:        tablepb.TableSpan(tableID),
 						IsSecondary: true,
 						Checkpoint: tablepb.Checkpoint{
 							CheckpointTs: 1,
@@ -404,28 +409,29 @@ func TestReplicationManagerBurstBalance(t *testing.T) {
 				},
 			},
 		}, msgs)
-		require.Contains(t, r.tables, tableID)
-		require.Contains(t, r.runningTasks, tableID)
+		require.True(t, r.tables.Has(tablepb.TableSpan(tableID)))
+		require.True(t, r.runningTasks.Has(tablepb.TableSpan(tableID)))
 	}
 
 	// Add a new table.
-	r.tables[5], err = NewReplicationSet(5, 0, map[string]*tablepb.TableStatus{
+	rs, err := NewReplicationSet(tablepb.TableSpan(5), 0, map[string]*tablepb.TableStatus{
 		"5": {TableID: 5, State: tablepb.TableStateReplicating},
 	}, model.ChangeFeedID{})
 	require.Nil(t, err)
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(5), rs)
 
 	// More burst balance is still allowed.
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
 		BurstBalance: &BurstBalance{
 			AddTables: []AddTable{{
-				TableID: 4, CaptureID: "4", CheckpointTs: 2,
+				Span: tablepb.TableSpan(4), CaptureID: "4", CheckpointTs: 2,
 			}, {
-				TableID: 1, CaptureID: "0", CheckpointTs: 2,
+				Span: tablepb.TableSpan(1), CaptureID: "0", CheckpointTs: 2,
 			}},
 			RemoveTables: []RemoveTable{{
-				TableID: 5, CaptureID: "5",
+				Span: tablepb.TableSpan(5), CaptureID: "5",
 			}, {
-				TableID: 1, CaptureID: "0",
+				Span: tablepb.TableSpan(1), CaptureID: "0",
 			}},
 		},
 		Accept: func() {
@@ -442,6 +448,7 @@ func TestReplicationManagerBurstBalance(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     4,
+					Span:        tablepb.TableSpan(4),
 					IsSecondary: true,
 					Checkpoint: tablepb.Checkpoint{
 						CheckpointTs: 2,
@@ -458,6 +465,7 @@ func TestReplicationManagerBurstBalance(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_RemoveTable{
 				RemoveTable: &schedulepb.RemoveTableRequest{
 					TableID: 5,
+					Span:    tablepb.TableSpan(5),
 				},
 			},
 		},
@@ -472,22 +480,24 @@ func TestReplicationManagerBurstBalanceMoveTables(t *testing.T) {
 
 	var err error
 	// Two tables in "1".
-	r.tables[1], err = NewReplicationSet(1, 0, map[string]*tablepb.TableStatus{
+	rs1, err := NewReplicationSet(tablepb.TableSpan(1), 0, map[string]*tablepb.TableStatus{
 		"1": {TableID: 1, State: tablepb.TableStateReplicating},
 	}, model.ChangeFeedID{})
 	require.Nil(t, err)
-	r.tables[2], err = NewReplicationSet(2, 0, map[string]*tablepb.TableStatus{
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(1), rs1)
+	rs2, err := NewReplicationSet(tablepb.TableSpan(2), 0, map[string]*tablepb.TableStatus{
 		"1": {
 			TableID: 2, State: tablepb.TableStateReplicating,
 			Checkpoint: tablepb.Checkpoint{CheckpointTs: 1},
 		},
 	}, model.ChangeFeedID{})
 	require.Nil(t, err)
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(2), rs2)
 
 	msgs, err := r.HandleTasks([]*ScheduleTask{{
 		BurstBalance: &BurstBalance{
 			MoveTables: []MoveTable{{
-				TableID: 2, DestCapture: "2",
+				Span: tablepb.TableSpan(2), DestCapture: "2",
 			}},
 		},
 		Accept: func() {
@@ -504,14 +514,15 @@ func TestReplicationManagerBurstBalanceMoveTables(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     2,
+					Span:        tablepb.TableSpan(2),
 					IsSecondary: true,
 					Checkpoint:  tablepb.Checkpoint{CheckpointTs: 1},
 				},
 			},
 		},
 	}, msgs)
-	require.Contains(t, r.tables, model.TableID(2))
-	require.Contains(t, r.runningTasks, model.TableID(2))
+	require.True(t, r.tables.Has(tablepb.TableSpan(2)))
+	require.True(t, r.runningTasks.Has(tablepb.TableSpan(2)))
 }
 
 func TestReplicationManagerMaxTaskConcurrency(t *testing.T) {
@@ -521,7 +532,7 @@ func TestReplicationManagerMaxTaskConcurrency(t *testing.T) {
 	addTableCh := make(chan int, 1)
 
 	msgs, err := r.HandleTasks([]*ScheduleTask{{
-		AddTable: &AddTable{TableID: 1, CaptureID: "1"},
+		AddTable: &AddTable{Span: tablepb.TableSpan(1), CaptureID: "1"},
 		Accept: func() {
 			addTableCh <- 1
 			close(addTableCh)
@@ -536,17 +547,18 @@ func TestReplicationManagerMaxTaskConcurrency(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     1,
+					Span:        tablepb.TableSpan(1),
 					IsSecondary: true,
 				},
 			},
 		},
 	}, msgs[0])
-	require.NotNil(t, r.runningTasks[1])
+	require.True(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 	require.Equal(t, 1, <-addTableCh)
 
 	// No more tasks allowed.
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
-		AddTable: &AddTable{TableID: 2, CaptureID: "1"},
+		AddTable: &AddTable{Span: tablepb.TableSpan(2), CaptureID: "1"},
 		Accept: func() {
 			t.Fatal("must not accept")
 		},
@@ -559,7 +571,7 @@ func TestReplicationManagerAdvanceCheckpoint(t *testing.T) {
 	t.Parallel()
 
 	r := NewReplicationManager(1, model.ChangeFeedID{})
-	rs, err := NewReplicationSet(model.TableID(1), model.Ts(10),
+	rs, err := NewReplicationSet(tablepb.TableSpan(1), model.Ts(10),
 		map[model.CaptureID]*tablepb.TableStatus{
 			"1": {
 				TableID: model.TableID(1),
@@ -571,9 +583,9 @@ func TestReplicationManagerAdvanceCheckpoint(t *testing.T) {
 			},
 		}, model.ChangeFeedID{})
 	require.NoError(t, err)
-	r.tables[model.TableID(1)] = rs
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(1), rs)
 
-	rs, err = NewReplicationSet(model.TableID(2), model.Ts(15),
+	rs, err = NewReplicationSet(tablepb.TableSpan(2), model.Ts(15),
 		map[model.CaptureID]*tablepb.TableStatus{
 			"2": {
 				TableID: model.TableID(2),
@@ -585,21 +597,21 @@ func TestReplicationManagerAdvanceCheckpoint(t *testing.T) {
 			},
 		}, model.ChangeFeedID{})
 	require.NoError(t, err)
-	r.tables[model.TableID(2)] = rs
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(2), rs)
 
 	// all table is replicating
-	currentTables := []model.TableID{1, 2}
+	currentTables := []tablepb.Span{tablepb.TableSpan(1), tablepb.TableSpan(2)}
 	checkpoint, resolved := r.AdvanceCheckpoint(currentTables)
 	require.Equal(t, model.Ts(10), checkpoint)
 	require.Equal(t, model.Ts(20), resolved)
 
 	// some table not exist yet.
-	currentTables = append(currentTables, 3)
+	currentTables = append(currentTables, tablepb.TableSpan(3))
 	checkpoint, resolved = r.AdvanceCheckpoint(currentTables)
 	require.Equal(t, checkpointCannotProceed, checkpoint)
 	require.Equal(t, checkpointCannotProceed, resolved)
 
-	rs, err = NewReplicationSet(model.TableID(3), model.Ts(5),
+	rs, err = NewReplicationSet(tablepb.TableSpan(3), model.Ts(5),
 		map[model.CaptureID]*tablepb.TableStatus{
 			"1": {
 				TableID: model.TableID(3),
@@ -619,13 +631,13 @@ func TestReplicationManagerAdvanceCheckpoint(t *testing.T) {
 			},
 		}, model.ChangeFeedID{})
 	require.NoError(t, err)
-	r.tables[model.TableID(3)] = rs
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(3), rs)
 	checkpoint, resolved = r.AdvanceCheckpoint(currentTables)
 	require.Equal(t, model.Ts(5), checkpoint)
 	require.Equal(t, model.Ts(20), resolved)
 
-	currentTables = append(currentTables, 4)
-	rs, err = NewReplicationSet(model.TableID(4), model.Ts(3),
+	currentTables = append(currentTables, tablepb.TableSpan(4))
+	rs, err = NewReplicationSet(tablepb.TableSpan(4), model.Ts(3),
 		map[model.CaptureID]*tablepb.TableStatus{
 			"1": {
 				TableID: model.TableID(4),
@@ -637,7 +649,7 @@ func TestReplicationManagerAdvanceCheckpoint(t *testing.T) {
 			},
 		}, model.ChangeFeedID{})
 	require.NoError(t, err)
-	r.tables[model.TableID(4)] = rs
+	r.tables.ReplaceOrInsert(tablepb.TableSpan(4), rs)
 	checkpoint, resolved = r.AdvanceCheckpoint(currentTables)
 	require.Equal(t, model.Ts(3), checkpoint)
 	require.Equal(t, model.Ts(10), resolved)
@@ -660,12 +672,12 @@ func TestReplicationManagerHandleCaptureChanges(t *testing.T) {
 	msgs, err := r.HandleCaptureChanges(init, nil, 0)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Len(t, r.tables, 5)
-	require.Equal(t, ReplicationSetStateReplicating, r.tables[1].State)
-	require.Equal(t, ReplicationSetStatePrepare, r.tables[2].State)
-	require.Equal(t, ReplicationSetStateReplicating, r.tables[3].State)
-	require.Equal(t, ReplicationSetStateRemoving, r.tables[4].State)
-	require.Equal(t, ReplicationSetStateAbsent, r.tables[5].State)
+	require.Equal(t, 5, r.tables.Len())
+	require.Equal(t, ReplicationSetStateReplicating, r.tables.GetV(tablepb.TableSpan(1)).State)
+	require.Equal(t, ReplicationSetStatePrepare, r.tables.GetV(tablepb.TableSpan(2)).State)
+	require.Equal(t, ReplicationSetStateReplicating, r.tables.GetV(tablepb.TableSpan(3)).State)
+	require.Equal(t, ReplicationSetStateRemoving, r.tables.GetV(tablepb.TableSpan(4)).State)
+	require.Equal(t, ReplicationSetStateAbsent, r.tables.GetV(tablepb.TableSpan(5)).State)
 
 	removed := map[string][]tablepb.TableStatus{
 		"1": {{TableID: 1, State: tablepb.TableStateReplicating}},
@@ -673,12 +685,12 @@ func TestReplicationManagerHandleCaptureChanges(t *testing.T) {
 	msgs, err = r.HandleCaptureChanges(nil, removed, 0)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Len(t, r.tables, 5)
-	require.Equal(t, ReplicationSetStateAbsent, r.tables[1].State)
-	require.Equal(t, ReplicationSetStatePrepare, r.tables[2].State)
-	require.Equal(t, ReplicationSetStateReplicating, r.tables[3].State)
-	require.Equal(t, ReplicationSetStateRemoving, r.tables[4].State)
-	require.Equal(t, ReplicationSetStateAbsent, r.tables[5].State)
+	require.Equal(t, 5, r.tables.Len())
+	require.Equal(t, ReplicationSetStateAbsent, r.tables.GetV(tablepb.TableSpan(1)).State)
+	require.Equal(t, ReplicationSetStatePrepare, r.tables.GetV(tablepb.TableSpan(2)).State)
+	require.Equal(t, ReplicationSetStateReplicating, r.tables.GetV(tablepb.TableSpan(3)).State)
+	require.Equal(t, ReplicationSetStateRemoving, r.tables.GetV(tablepb.TableSpan(4)).State)
+	require.Equal(t, ReplicationSetStateAbsent, r.tables.GetV(tablepb.TableSpan(5)).State)
 }
 
 func TestReplicationManagerHandleCaptureChangesDuringAddTable(t *testing.T) {
@@ -688,14 +700,14 @@ func TestReplicationManagerHandleCaptureChangesDuringAddTable(t *testing.T) {
 	addTableCh := make(chan int, 1)
 
 	msgs, err := r.HandleTasks([]*ScheduleTask{{
-		AddTable: &AddTable{TableID: 1, CaptureID: "1"},
+		AddTable: &AddTable{Span: tablepb.TableSpan(1), CaptureID: "1"},
 		Accept: func() {
 			addTableCh <- 1
 		},
 	}})
 	require.Nil(t, err)
 	require.Len(t, msgs, 1)
-	require.NotNil(t, r.runningTasks[1])
+	require.True(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 	require.Equal(t, 1, <-addTableCh)
 
 	removed := map[string][]tablepb.TableStatus{
@@ -704,19 +716,19 @@ func TestReplicationManagerHandleCaptureChangesDuringAddTable(t *testing.T) {
 	msgs, err = r.HandleCaptureChanges(nil, removed, 0)
 	require.Nil(t, err)
 	require.Len(t, msgs, 0)
-	require.Len(t, r.tables, 1)
-	require.Equal(t, ReplicationSetStateAbsent, r.tables[1].State)
-	require.Nil(t, r.runningTasks[1])
+	require.Equal(t, 1, r.tables.Len())
+	require.Equal(t, ReplicationSetStateAbsent, r.tables.GetV(tablepb.TableSpan(1)).State)
+	require.False(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 
 	// New task must be accepted.
 	msgs, err = r.HandleTasks([]*ScheduleTask{{
-		AddTable: &AddTable{TableID: 1, CaptureID: "1"},
+		AddTable: &AddTable{Span: tablepb.TableSpan(1), CaptureID: "1"},
 		Accept: func() {
 			addTableCh <- 1
 		},
 	}})
 	require.Nil(t, err)
 	require.Len(t, msgs, 1)
-	require.NotNil(t, r.runningTasks[1])
+	require.True(t, r.runningTasks.Has(tablepb.TableSpan(1)))
 	require.Equal(t, 1, <-addTableCh)
 }
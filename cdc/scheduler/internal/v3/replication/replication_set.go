@@ -128,6 +128,7 @@ func (r Role) MarshalJSON() ([]byte, error) {
 type ReplicationSet struct { //nolint:revive
 	Changefeed model.ChangeFeedID
 	TableID    model.TableID
+	Span       tablepb.Span
 	State      ReplicationSetState
 	// Primary is the capture ID that is currently replicating the table.
 	Primary model.CaptureID
@@ -140,14 +141,15 @@ type ReplicationSet struct { //nolint:revive
 
 // NewReplicationSet returns a new replication set.
 func NewReplicationSet(
-	tableID model.TableID,
+	span tablepb.Span,
 	checkpoint model.Ts,
 	tableStatus map[model.CaptureID]*tablepb.TableStatus,
 	changefeed model.ChangeFeedID,
 ) (*ReplicationSet, error) {
 	r := &ReplicationSet{
 		Changefeed: changefeed,
-		TableID:    tableID,
+		TableID:    span.TableID,
+		Span:       span,
 		Captures:   make(map[string]Role),
 		Checkpoint: tablepb.Checkpoint{
 			CheckpointTs: checkpoint,
@@ -158,9 +160,9 @@ func NewReplicationSet(
 	stoppingCount := 0
 	committed := false
 	for captureID, table := range tableStatus {
-		if r.TableID != table.TableID {
+		if !r.isSameSpan(table) {
 			return nil, r.inconsistentError(table, captureID,
-				"schedulerv3: table id inconsistent")
+				"schedulerv3: table span inconsistent")
 		}
 		r.updateCheckpoint(table.Checkpoint)
 
@@ -243,6 +245,16 @@ func NewReplicationSet(
 	return r, nil
 }
 
+// isSameSpan returns whether the table status belongs to the replication set.
+// Statuses reported by old versions have no span, they always cover the
+// whole table.
+func (r *ReplicationSet) isSameSpan(status *tablepb.TableStatus) bool {
+	if r.TableID != status.TableID {
+		return false
+	}
+	return status.Span.IsEmpty() || r.Span.Eq(&status.Span)
+}
+
 func (r *ReplicationSet) hasRole(role Role) bool {
 	_, has := r.getRole(role)
 	return has
@@ -322,7 +334,7 @@ func (r *ReplicationSet) inconsistentError(
 	}...)
 	log.L().WithOptions(zap.AddCallerSkip(1)).Error(msg, fields...)
 	return cerror.ErrReplicationSetInconsistent.GenWithStackByArgs(
-		fmt.Sprintf("tableID %d, %s", r.TableID, msg))
+		fmt.Sprintf("span %s, %s", tablepb.FormatSpan(r.Span), msg))
 }
 
 func (r *ReplicationSet) multiplePrimaryError(
@@ -335,16 +347,16 @@ func (r *ReplicationSet) multiplePrimaryError(
 	}...)
 	log.L().WithOptions(zap.AddCallerSkip(1)).Error(msg, fields...)
 	return cerror.ErrReplicationSetMultiplePrimaryError.GenWithStackByArgs(
-		fmt.Sprintf("tableID %d, %s", r.TableID, msg))
+		fmt.Sprintf("span %s, %s", tablepb.FormatSpan(r.Span), msg))
 }
 
 // checkInvariant ensures ReplicationSet invariant is hold.
 func (r *ReplicationSet) checkInvariant(
 	input *tablepb.TableStatus, captureID model.CaptureID,
 ) error {
-	if r.TableID != input.TableID {
+	if !r.isSameSpan(input) {
 		return r.inconsistentError(input, captureID,
-			"schedulerv3: tableID must be the same")
+			"schedulerv3: table span must be the same")
 	}
 	if len(r.Captures) == 0 {
 		if r.State == ReplicationSetStatePrepare ||
@@ -458,6 +470,7 @@ func (r *ReplicationSet) pollOnPrepare(
 					Request: &schedulepb.DispatchTableRequest_AddTable{
 						AddTable: &schedulepb.AddTableRequest{
 							TableID:     r.TableID,
+							Span:        r.Span,
 							IsSecondary: true,
 							Checkpoint:  r.Checkpoint,
 						},
@@ -534,7 +547,7 @@ func (r *ReplicationSet) pollOnCommit(
 					MsgType: schedulepb.MsgDispatchTableRequest,
 					DispatchTableRequest: &schedulepb.DispatchTableRequest{
 						Request: &schedulepb.DispatchTableRequest_RemoveTable{
-							RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID},
+							RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID, Span: r.Span},
 						},
 					},
 				}, false, nil
@@ -570,6 +583,7 @@ func (r *ReplicationSet) pollOnCommit(
 					Request: &schedulepb.DispatchTableRequest_AddTable{
 						AddTable: &schedulepb.AddTableRequest{
 							TableID:     r.TableID,
+							Span:        r.Span,
 							IsSecondary: false,
 							Checkpoint:  r.Checkpoint,
 						},
@@ -610,6 +624,7 @@ func (r *ReplicationSet) pollOnCommit(
 					Request: &schedulepb.DispatchTableRequest_AddTable{
 						AddTable: &schedulepb.AddTableRequest{
 							TableID:     r.TableID,
+							Span:        r.Span,
 							IsSecondary: false,
 							Checkpoint:  r.Checkpoint,
 						},
@@ -652,7 +667,7 @@ func (r *ReplicationSet) pollOnCommit(
 					MsgType: schedulepb.MsgDispatchTableRequest,
 					DispatchTableRequest: &schedulepb.DispatchTableRequest{
 						Request: &schedulepb.DispatchTableRequest_RemoveTable{
-							RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID},
+							RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID, Span: r.Span},
 						},
 					},
 				}, false, nil
@@ -746,7 +761,7 @@ func (r *ReplicationSet) pollOnRemoving(
 			MsgType: schedulepb.MsgDispatchTableRequest,
 			DispatchTableRequest: &schedulepb.DispatchTableRequest{
 				Request: &schedulepb.DispatchTableRequest_RemoveTable{
-					RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID},
+					RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID, Span: r.Span},
 				},
 			},
 		}, false, nil
@@ -789,7 +804,7 @@ func (r *ReplicationSet) handleAddTable(
 	// Ignore add table if it's not in Absent state.
 	if r.State != ReplicationSetStateAbsent {
 		log.Warn("schedulerv3: add table is ignored",
-			zap.Any("replicationSet", r), zap.Int64("tableID", r.TableID))
+			zap.Any("replicationSet", r), zap.String("span", tablepb.FormatSpan(r.Span)))
 		return nil, nil
 	}
 	oldState := r.State
@@ -803,6 +818,7 @@ func (r *ReplicationSet) handleAddTable(
 		zap.Stringer("old", oldState), zap.Stringer("new", r.State))
 	status := tablepb.TableStatus{
 		TableID:    r.TableID,
+		Span:       r.Span,
 		State:      tablepb.TableStateAbsent,
 		Checkpoint: tablepb.Checkpoint{},
 	}
@@ -815,7 +831,7 @@ func (r *ReplicationSet) handleMoveTable(
 	// Ignore move table if it has been removed already.
 	if r.hasRemoved() {
 		log.Warn("schedulerv3: move table is ignored",
-			zap.Any("replicationSet", r), zap.Int64("tableID", r.TableID))
+			zap.Any("replicationSet", r), zap.String("span", tablepb.FormatSpan(r.Span)))
 		return nil, nil
 	}
 	// Ignore move table if
@@ -823,7 +839,7 @@ func (r *ReplicationSet) handleMoveTable(
 	// 2) the dest capture is the primary.
 	if r.State != ReplicationSetStateReplicating || r.Primary == dest {
 		log.Warn("schedulerv3: move table is ignored",
-			zap.Any("replicationSet", r), zap.Int64("tableID", r.TableID))
+			zap.Any("replicationSet", r), zap.String("span", tablepb.FormatSpan(r.Span)))
 		return nil, nil
 	}
 	oldState := r.State
@@ -837,6 +853,7 @@ func (r *ReplicationSet) handleMoveTable(
 		zap.Stringer("old", oldState), zap.Stringer("new", r.State))
 	status := tablepb.TableStatus{
 		TableID:    r.TableID,
+		Span:       r.Span,
 		State:      tablepb.TableStateAbsent,
 		Checkpoint: tablepb.Checkpoint{},
 	}
@@ -847,13 +864,13 @@ func (r *ReplicationSet) handleRemoveTable() ([]*schedulepb.Message, error) {
 	// Ignore remove table if it has been removed already.
 	if r.hasRemoved() {
 		log.Warn("schedulerv3: remove table is ignored",
-			zap.Any("replicationSet", r), zap.Int64("tableID", r.TableID))
+			zap.Any("replicationSet", r), zap.String("span", tablepb.FormatSpan(r.Span)))
 		return nil, nil
 	}
 	// Ignore remove table if it's not in Replicating state.
 	if r.State != ReplicationSetStateReplicating {
 		log.Warn("schedulerv3: remove table is ignored",
-			zap.Any("replicationSet", r), zap.Int64("tableID", r.TableID))
+			zap.Any("replicationSet", r), zap.String("span", tablepb.FormatSpan(r.Span)))
 		return nil, nil
 	}
 	oldState := r.State
@@ -863,6 +880,7 @@ func (r *ReplicationSet) handleRemoveTable() ([]*schedulepb.Message, error) {
 		zap.Stringer("old", oldState), zap.Stringer("new", r.State))
 	status := tablepb.TableStatus{
 		TableID: r.TableID,
+		Span:    r.Span,
 		State:   tablepb.TableStateReplicating,
 		Checkpoint: tablepb.Checkpoint{
 			CheckpointTs: r.Checkpoint.CheckpointTs,
@@ -892,6 +910,7 @@ func (r *ReplicationSet) handleCaptureShutdown(
 	// The capture has shutdown, the table has stopped.
 	status := tablepb.TableStatus{
 		TableID: r.TableID,
+		Span:    r.Span,
 		State:   tablepb.TableStateStopped,
 	}
 	msgs, err := r.poll(&status, captureID)
@@ -58,6 +58,7 @@ func TestNewReplicationSet(t *testing.T) {
 	}{
 		{
 			set: &ReplicationSet{
+				Span:     tablepb.TableSpan(0),
 				State:    ReplicationSetStateAbsent,
 				Captures: map[string]Role{},
 			},
@@ -65,6 +66,7 @@ func TestNewReplicationSet(t *testing.T) {
 		},
 		{
 			set: &ReplicationSet{
+				Span:     tablepb.TableSpan(0),
 				Primary:  "1",
 				State:    ReplicationSetStateReplicating,
 				Captures: map[string]Role{"1": RolePrimary},
@@ -85,6 +87,7 @@ func TestNewReplicationSet(t *testing.T) {
 		{
 			// Rebuild add table state.
 			set: &ReplicationSet{
+				Span:     tablepb.TableSpan(0),
 				State:    ReplicationSetStatePrepare,
 				Captures: map[string]Role{"1": RoleSecondary},
 			},
@@ -98,6 +101,7 @@ func TestNewReplicationSet(t *testing.T) {
 		{
 			// Rebuild move table state, Prepare.
 			set: &ReplicationSet{
+				Span:    tablepb.TableSpan(0),
 				State:   ReplicationSetStatePrepare,
 				Primary: "2",
 				Captures: map[string]Role{
@@ -119,6 +123,7 @@ func TestNewReplicationSet(t *testing.T) {
 		{
 			// Rebuild move table state, Commit.
 			set: &ReplicationSet{
+				Span:    tablepb.TableSpan(0),
 				State:   ReplicationSetStateCommit,
 				Primary: "2",
 				Captures: map[string]Role{
@@ -139,6 +144,7 @@ func TestNewReplicationSet(t *testing.T) {
 		{
 			// Rebuild move table state, Commit, original primary stopping.
 			set: &ReplicationSet{
+				Span:  tablepb.TableSpan(0),
 				State: ReplicationSetStateCommit,
 				Captures: map[string]Role{
 					"1": RoleSecondary, "2": RoleUndetermined,
@@ -158,6 +164,7 @@ func TestNewReplicationSet(t *testing.T) {
 		{
 			// Rebuild move table state, Commit, original primary stopped.
 			set: &ReplicationSet{
+				Span:     tablepb.TableSpan(0),
 				State:    ReplicationSetStateCommit,
 				Captures: map[string]Role{"1": RoleSecondary},
 			},
@@ -175,6 +182,7 @@ func TestNewReplicationSet(t *testing.T) {
 		{
 			// Rebuild remove table state, Removing.
 			set: &ReplicationSet{
+				Span:  tablepb.TableSpan(0),
 				State: ReplicationSetStateRemoving,
 				Captures: map[string]Role{
 					"1": RoleUndetermined, "2": RoleUndetermined,
@@ -211,7 +219,7 @@ func TestNewReplicationSet(t *testing.T) {
 		status := tc.tableStatus
 		checkpoint := tc.checkpoint
 
-		output, err := NewReplicationSet(0, checkpoint, status, model.ChangeFeedID{})
+		output, err := NewReplicationSet(tablepb.TableSpan(0), checkpoint, status, model.ChangeFeedID{})
 		if set == nil {
 			require.Errorf(t, err, "%d", id)
 		} else {
@@ -255,7 +263,7 @@ func TestReplicationSetPoll(t *testing.T) {
 				Checkpoint: tablepb.Checkpoint{},
 			}
 		}
-		r, _ := NewReplicationSet(1, 0, status, model.ChangeFeedID{})
+		r, _ := NewReplicationSet(tablepb.TableSpan(1), 0, status, model.ChangeFeedID{})
 		var tableStates []int
 		for state := range tablepb.TableState_name {
 			tableStates = append(tableStates, int(state))
@@ -286,7 +294,7 @@ func TestReplicationSetPollUnknownCapture(t *testing.T) {
 	t.Parallel()
 
 	tableID := model.TableID(1)
-	r, err := NewReplicationSet(tableID, 0, map[model.CaptureID]*tablepb.TableStatus{
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, map[model.CaptureID]*tablepb.TableStatus{
 		"1": {
 			TableID:    tableID,
 			State:      tablepb.TableStateReplicating,
@@ -322,7 +330,7 @@ func TestReplicationSetAddTable(t *testing.T) {
 
 	from := "1"
 	tableID := model.TableID(1)
-	r, err := NewReplicationSet(tableID, 0, nil, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, nil, model.ChangeFeedID{})
 	require.Nil(t, err)
 
 	// Absent -> Prepare
@@ -336,6 +344,7 @@ func TestReplicationSetAddTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: true,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -364,6 +373,7 @@ func TestReplicationSetAddTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: true,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -397,6 +407,7 @@ func TestReplicationSetAddTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: false,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -420,6 +431,7 @@ func TestReplicationSetAddTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: false,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -466,7 +478,7 @@ func TestReplicationSetRemoveTable(t *testing.T) {
 
 	from := "1"
 	tableID := model.TableID(1)
-	r, err := NewReplicationSet(tableID, 0, nil, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, nil, model.ChangeFeedID{})
 	require.Nil(t, err)
 
 	// Ignore removing table if it's not in replicating.
@@ -487,7 +499,7 @@ func TestReplicationSetRemoveTable(t *testing.T) {
 		MsgType: schedulepb.MsgDispatchTableRequest,
 		DispatchTableRequest: &schedulepb.DispatchTableRequest{
 			Request: &schedulepb.DispatchTableRequest_RemoveTable{
-				RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID},
+				RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID, Span: r.Span},
 			},
 		},
 	}, msgs[0])
@@ -544,7 +556,7 @@ func TestReplicationSetMoveTable(t *testing.T) {
 	t.Parallel()
 
 	tableID := model.TableID(1)
-	r, err := NewReplicationSet(tableID, 0, nil, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, nil, model.ChangeFeedID{})
 	require.Nil(t, err)
 
 	source := "1"
@@ -571,6 +583,7 @@ func TestReplicationSetMoveTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: true,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -616,6 +629,7 @@ func TestReplicationSetMoveTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: true,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -637,7 +651,7 @@ func TestReplicationSetMoveTable(t *testing.T) {
 		MsgType: schedulepb.MsgDispatchTableRequest,
 		DispatchTableRequest: &schedulepb.DispatchTableRequest{
 			Request: &schedulepb.DispatchTableRequest_RemoveTable{
-				RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID},
+				RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID, Span: r.Span},
 			},
 		},
 	}, msgs[0])
@@ -661,7 +675,7 @@ func TestReplicationSetMoveTable(t *testing.T) {
 		MsgType: schedulepb.MsgDispatchTableRequest,
 		DispatchTableRequest: &schedulepb.DispatchTableRequest{
 			Request: &schedulepb.DispatchTableRequest_RemoveTable{
-				RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID},
+				RemoveTable: &schedulepb.RemoveTableRequest{TableID: r.TableID, Span: r.Span},
 			},
 		},
 	}, msgs[0])
@@ -711,6 +725,7 @@ func TestReplicationSetMoveTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: false,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -740,6 +755,7 @@ func TestReplicationSetMoveTable(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: false,
 					Checkpoint: tablepb.Checkpoint{
 						CheckpointTs: 3,
@@ -774,7 +790,7 @@ func TestReplicationSetCaptureShutdown(t *testing.T) {
 
 	from := "1"
 	tableID := model.TableID(1)
-	r, err := NewReplicationSet(tableID, 0, nil, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, nil, model.ChangeFeedID{})
 	require.Nil(t, err)
 
 	// Add table, Absent -> Prepare
@@ -788,6 +804,7 @@ func TestReplicationSetCaptureShutdown(t *testing.T) {
 			Request: &schedulepb.DispatchTableRequest_AddTable{
 				AddTable: &schedulepb.AddTableRequest{
 					TableID:     r.TableID,
+					Span:        r.Span,
 					IsSecondary: true,
 					Checkpoint:  r.Checkpoint,
 				},
@@ -930,6 +947,7 @@ func TestReplicationSetCaptureShutdown(t *testing.T) {
 				Request: &schedulepb.DispatchTableRequest_AddTable{
 					AddTable: &schedulepb.AddTableRequest{
 						TableID:     r.TableID,
+						Span:        r.Span,
 						IsSecondary: false,
 						Checkpoint:  r.Checkpoint,
 					},
@@ -1013,6 +1031,7 @@ func TestReplicationSetCaptureShutdown(t *testing.T) {
 					Request: &schedulepb.DispatchTableRequest_AddTable{
 						AddTable: &schedulepb.AddTableRequest{
 							TableID:     r.TableID,
+							Span:        r.Span,
 							IsSecondary: true,
 							Checkpoint:  r.Checkpoint,
 						},
@@ -1079,7 +1098,7 @@ func TestReplicationSetCaptureShutdownAfterReconstructCommitState(t *testing.T)
 	tableStatus := map[model.CaptureID]*tablepb.TableStatus{
 		from: {TableID: tableID, State: tablepb.TableStatePrepared},
 	}
-	r, err := NewReplicationSet(tableID, 0, tableStatus, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, tableStatus, model.ChangeFeedID{})
 	require.Nil(t, err)
 	require.Equal(t, ReplicationSetStateCommit, r.State)
 	require.Equal(t, "", r.Primary)
@@ -1099,7 +1118,7 @@ func TestReplicationSetMoveTableWithHeartbeatResponse(t *testing.T) {
 	t.Parallel()
 
 	tableID := model.TableID(1)
-	r, err := NewReplicationSet(tableID, 0, nil, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, nil, model.ChangeFeedID{})
 	require.Nil(t, err)
 
 	source := "1"
@@ -1186,7 +1205,7 @@ func TestReplicationSetMoveTableSameDestCapture(t *testing.T) {
 	t.Parallel()
 
 	tableID := model.TableID(1)
-	r, err := NewReplicationSet(tableID, 0, nil, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(tableID), 0, nil, model.ChangeFeedID{})
 	require.Nil(t, err)
 
 	source := "1"
@@ -1218,7 +1237,7 @@ func TestReplicationSetCommitRestart(t *testing.T) {
 			Checkpoint: tablepb.Checkpoint{},
 		},
 	}
-	r, err := NewReplicationSet(0, 0, tableStatus, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(0), 0, tableStatus, model.ChangeFeedID{})
 	require.Nil(t, err)
 	require.Equal(t, ReplicationSetStateCommit, r.State)
 	require.EqualValues(t, RoleSecondary, r.Captures["1"])
@@ -1300,7 +1319,7 @@ func TestReplicationSetRemoveRestart(t *testing.T) {
 			Checkpoint: tablepb.Checkpoint{},
 		},
 	}
-	r, err := NewReplicationSet(0, 0, tableStatus, model.ChangeFeedID{})
+	r, err := NewReplicationSet(tablepb.TableSpan(0), 0, tableStatus, model.ChangeFeedID{})
 	require.Nil(t, err)
 	require.Equal(t, ReplicationSetStateRemoving, r.State)
 	require.False(t, r.hasRole(RoleSecondary))
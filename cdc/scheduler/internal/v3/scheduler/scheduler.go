@@ -15,6 +15,7 @@ package scheduler
 
 import (
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 )
@@ -23,9 +24,9 @@ type scheduler interface {
 	Name() string
 	Schedule(
 		checkpointTs model.Ts,
-		currentTables []model.TableID,
+		currentSpans []tablepb.Span,
 		aliveCaptures map[model.CaptureID]*member.CaptureStatus,
-		replications map[model.TableID]*replication.ReplicationSet) []*replication.ScheduleTask
+		replications *tablepb.SpanMap[*replication.ReplicationSet]) []*replication.ScheduleTask
 }
 
 // schedulerPriority is the priority of each scheduler.
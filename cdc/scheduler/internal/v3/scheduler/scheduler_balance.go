@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 )
@@ -55,9 +56,9 @@ func (b *balanceScheduler) Name() string {
 
 func (b *balanceScheduler) Schedule(
 	_ model.Ts,
-	currentTables []model.TableID,
+	currentSpans []tablepb.Span,
 	captures map[model.CaptureID]*member.CaptureStatus,
-	replications map[model.TableID]*replication.ReplicationSet,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
 ) []*replication.ScheduleTask {
 	if !b.forceBalance {
 		now := time.Now()
@@ -76,26 +77,26 @@ func (b *balanceScheduler) Schedule(
 	}
 
 	tasks := buildBalanceMoveTables(
-		b.random, currentTables, captures, replications, b.maxTaskConcurrency)
+		b.random, currentSpans, captures, replications, b.maxTaskConcurrency)
 	b.forceBalance = len(tasks) != 0
 	return tasks
 }
 
 func buildBalanceMoveTables(
 	random *rand.Rand,
-	currentTables []model.TableID,
+	currentSpans []tablepb.Span,
 	captures map[model.CaptureID]*member.CaptureStatus,
-	replications map[model.TableID]*replication.ReplicationSet,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
 	maxTaskConcurrency int,
 ) []*replication.ScheduleTask {
-	captureTables := make(map[model.CaptureID][]model.TableID)
-	for _, tableID := range currentTables {
-		rep, ok := replications[tableID]
+	captureTables := make(map[model.CaptureID][]tablepb.Span)
+	for _, span := range currentSpans {
+		rep, ok := replications.Get(span)
 		if !ok {
 			continue
 		}
 		for captureID := range rep.Captures {
-			captureTables[captureID] = append(captureTables[captureID], tableID)
+			captureTables[captureID] = append(captureTables[captureID], span)
 		}
 	}
 
@@ -36,14 +36,14 @@ func TestSchedulerBalanceCaptureOnline(t *testing.T) {
 		1: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 		2: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 	}
-	tasks := sched.Schedule(0, currentTables, captures, replications)
+	tasks := sched.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
 	require.NotNil(t, tasks[0].MoveTable)
-	require.Equal(t, tasks[0].MoveTable.TableID, model.TableID(1))
+	require.Equal(t, tasks[0].MoveTable.Span.TableID, model.TableID(1))
 
 	// New capture "b" online, but this time has capture is stopping
 	captures["a"].State = member.CaptureStateStopping
-	tasks = sched.Schedule(0, currentTables, captures, replications)
+	tasks = sched.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// New capture "b" online, it keeps balancing, even though it has not pass
@@ -55,7 +55,7 @@ func TestSchedulerBalanceCaptureOnline(t *testing.T) {
 		1: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 		2: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 	}
-	tasks = sched.Schedule(0, currentTables, captures, replications)
+	tasks = sched.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
 
 	// New capture "b" online, but this time it not pass check balance interval.
@@ -67,7 +67,7 @@ func TestSchedulerBalanceCaptureOnline(t *testing.T) {
 		1: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 		2: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 	}
-	tasks = sched.Schedule(0, currentTables, captures, replications)
+	tasks = sched.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 }
 
@@ -86,10 +86,10 @@ func TestSchedulerBalanceTaskLimit(t *testing.T) {
 		3: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 		4: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 	}
-	tasks := sched.Schedule(0, currentTables, captures, replications)
+	tasks := sched.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 2)
 
 	sched = newBalanceScheduler(time.Duration(0), 1)
-	tasks = sched.Schedule(0, currentTables, captures, replications)
+	tasks = sched.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
 }
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"go.uber.org/zap"
@@ -55,28 +56,28 @@ func (b *basicScheduler) Name() string {
 
 func (b *basicScheduler) Schedule(
 	checkpointTs model.Ts,
-	currentTables []model.TableID,
+	currentSpans []tablepb.Span,
 	captures map[model.CaptureID]*member.CaptureStatus,
-	replications map[model.TableID]*replication.ReplicationSet,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
 ) []*replication.ScheduleTask {
 	tasks := make([]*replication.ScheduleTask, 0)
-	tablesLenEqual := len(currentTables) == len(replications)
+	tablesLenEqual := len(currentSpans) == replications.Len()
 	tablesAllFind := true
-	newTables := make([]model.TableID, 0)
-	for _, tableID := range currentTables {
+	newTables := make([]tablepb.Span, 0)
+	for _, span := range currentSpans {
 		if len(newTables) >= b.batchSize {
 			break
 		}
-		rep, ok := replications[tableID]
+		rep, ok := replications.Get(span)
 		if !ok {
-			newTables = append(newTables, tableID)
+			newTables = append(newTables, span)
 			// The table ID is not in the replication means the two sets are
 			// not identical.
 			tablesAllFind = false
 			continue
 		}
 		if rep.State == replication.ReplicationSetStateAbsent {
-			newTables = append(newTables, tableID)
+			newTables = append(newTables, span)
 		}
 	}
 
@@ -110,7 +111,7 @@ func (b *basicScheduler) Schedule(
 			zap.String("namespace", b.changefeedID.Namespace),
 			zap.String("changefeed", b.changefeedID.ID),
 			zap.Strings("captureIDs", captureIDs),
-			zap.Int64s("tableIDs", newTables))
+			zap.Int("spanCount", len(newTables)))
 		tasks = append(
 			tasks, newBurstAddTables(checkpointTs, newTables, captureIDs))
 	}
@@ -123,21 +124,20 @@ func (b *basicScheduler) Schedule(
 	// and for all tables in currentTables have a record in replications.
 	if !tablesLenEqual || !tablesAllFind {
 		// The two sets are not identical. We need to find removed tables.
-		intersectionTable := make(map[model.TableID]struct{}, len(currentTables))
-		for _, tableID := range currentTables {
-			_, ok := replications[tableID]
-			if !ok {
+		intersectionTable := tablepb.NewSpanMap[struct{}]()
+		for _, span := range currentSpans {
+			if !replications.Has(span) {
 				continue
 			}
-			intersectionTable[tableID] = struct{}{}
+			intersectionTable.ReplaceOrInsert(span, struct{}{})
 		}
-		rmTables := make([]model.TableID, 0)
-		for tableID := range replications {
-			_, ok := intersectionTable[tableID]
-			if !ok {
-				rmTables = append(rmTables, tableID)
+		rmTables := make([]tablepb.Span, 0)
+		replications.Ascend(func(span tablepb.Span, _ *replication.ReplicationSet) bool {
+			if !intersectionTable.Has(span) {
+				rmTables = append(rmTables, span)
 			}
-		}
+			return true
+		})
 		if len(rmTables) > 0 {
 			tasks = append(tasks,
 				newBurstRemoveTables(rmTables, replications, b.changefeedID))
@@ -146,15 +146,17 @@ func (b *basicScheduler) Schedule(
 	return tasks
 }
 
-// newBurstAddTables add each new table to captures in a round-robin way.
+// newBurstAddTables add each new table span to captures in a round-robin way.
+// Spans of a table are adjacent in newTables, so they are placed on different
+// captures as long as the table has no more spans than captures.
 func newBurstAddTables(
-	checkpointTs model.Ts, newTables []model.TableID, captureIDs []model.CaptureID,
+	checkpointTs model.Ts, newTables []tablepb.Span, captureIDs []model.CaptureID,
 ) *replication.ScheduleTask {
 	idx := 0
 	tables := make([]replication.AddTable, 0, len(newTables))
-	for _, tableID := range newTables {
+	for _, span := range newTables {
 		tables = append(tables, replication.AddTable{
-			TableID:      tableID,
+			Span:         span,
 			CaptureID:    captureIDs[idx],
 			CheckpointTs: checkpointTs,
 		})
@@ -169,12 +171,12 @@ func newBurstAddTables(
 }
 
 func newBurstRemoveTables(
-	rmTables []model.TableID, replications map[model.TableID]*replication.ReplicationSet,
+	rmTables []tablepb.Span, replications *tablepb.SpanMap[*replication.ReplicationSet],
 	changefeedID model.ChangeFeedID,
 ) *replication.ScheduleTask {
 	tables := make([]replication.RemoveTable, 0, len(rmTables))
-	for _, tableID := range rmTables {
-		rep := replications[tableID]
+	for _, span := range rmTables {
+		rep := replications.GetV(span)
 		var captureID model.CaptureID
 		for id := range rep.Captures {
 			captureID = id
@@ -188,7 +190,7 @@ func newBurstRemoveTables(
 			continue
 		}
 		tables = append(tables, replication.RemoveTable{
-			TableID:   tableID,
+			Span:      span,
 			CaptureID: captureID,
 		})
 	}
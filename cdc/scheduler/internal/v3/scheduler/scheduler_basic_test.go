@@ -18,11 +18,28 @@ import (
 	"testing"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/stretchr/testify/require"
 )
 
+func toSpans(tables []model.TableID) []tablepb.Span {
+	spans := make([]tablepb.Span, 0, len(tables))
+	for _, tableID := range tables {
+		spans = append(spans, tablepb.TableSpan(tableID))
+	}
+	return spans
+}
+
+func mapToSpanMap[T any](in map[model.TableID]T) *tablepb.SpanMap[T] {
+	out := tablepb.NewSpanMap[T]()
+	for tableID, v := range in {
+		out.ReplaceOrInsert(tablepb.TableSpan(tableID), v)
+	}
+	return out
+}
+
 func TestSchedulerBasic(t *testing.T) {
 	t.Parallel()
 
@@ -36,7 +53,7 @@ func TestSchedulerBasic(t *testing.T) {
 
 	// one capture stopping, another one is initialized
 	captures["a"].State = member.CaptureStateStopping
-	tasks := b.Schedule(0, currentTables, captures, replications)
+	tasks := b.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
 	require.Len(t, tasks[0].BurstBalance.AddTables, 2)
 	require.Equal(t, tasks[0].BurstBalance.AddTables[0].CaptureID, "b")
@@ -44,16 +61,16 @@ func TestSchedulerBasic(t *testing.T) {
 
 	// all capture's stopping, cannot add table
 	captures["b"].State = member.CaptureStateStopping
-	tasks = b.Schedule(0, currentTables, captures, replications)
+	tasks = b.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	captures["a"].State = member.CaptureStateInitialized
 	captures["b"].State = member.CaptureStateInitialized
-	tasks = b.Schedule(0, currentTables, captures, replications)
+	tasks = b.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
 	require.Len(t, tasks[0].BurstBalance.AddTables, 2)
-	require.Equal(t, tasks[0].BurstBalance.AddTables[0].TableID, model.TableID(1))
-	require.Equal(t, tasks[0].BurstBalance.AddTables[1].TableID, model.TableID(2))
+	require.Equal(t, tasks[0].BurstBalance.AddTables[0].Span.TableID, model.TableID(1))
+	require.Equal(t, tasks[0].BurstBalance.AddTables[1].Span.TableID, model.TableID(2))
 
 	// Capture offline, causes replication.ReplicationSetStateAbsent.
 	// AddTable only.
@@ -78,9 +95,9 @@ func TestSchedulerBasic(t *testing.T) {
 		},
 		4: {State: replication.ReplicationSetStateAbsent},
 	}
-	tasks = b.Schedule(1, currentTables, captures, replications)
+	tasks = b.Schedule(1, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
-	require.Equal(t, tasks[0].BurstBalance.AddTables[0].TableID, model.TableID(4))
+	require.Equal(t, tasks[0].BurstBalance.AddTables[0].Span.TableID, model.TableID(4))
 	require.Equal(t, tasks[0].BurstBalance.AddTables[0].CheckpointTs, model.Ts(1))
 
 	// DDL CREATE/DROP/TRUNCATE TABLE.
@@ -111,16 +128,16 @@ func TestSchedulerBasic(t *testing.T) {
 			},
 		},
 	}
-	tasks = b.Schedule(2, currentTables, captures, replications)
+	tasks = b.Schedule(2, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 2)
 	if tasks[0].BurstBalance.AddTables != nil {
-		require.Equal(t, tasks[0].BurstBalance.AddTables[0].TableID, model.TableID(4))
+		require.Equal(t, tasks[0].BurstBalance.AddTables[0].Span.TableID, model.TableID(4))
 		require.Equal(t, tasks[0].BurstBalance.AddTables[0].CheckpointTs, model.Ts(2))
-		require.Equal(t, tasks[1].BurstBalance.RemoveTables[0].TableID, model.TableID(5))
+		require.Equal(t, tasks[1].BurstBalance.RemoveTables[0].Span.TableID, model.TableID(5))
 	} else {
-		require.Equal(t, tasks[1].BurstBalance.AddTables[0].TableID, model.TableID(4))
+		require.Equal(t, tasks[1].BurstBalance.AddTables[0].Span.TableID, model.TableID(4))
 		require.Equal(t, tasks[0].BurstBalance.AddTables[0].CheckpointTs, model.Ts(2))
-		require.Equal(t, tasks[0].BurstBalance.RemoveTables[0].TableID, model.TableID(5))
+		require.Equal(t, tasks[0].BurstBalance.RemoveTables[0].Span.TableID, model.TableID(5))
 	}
 
 	// RemoveTable only.
@@ -156,9 +173,51 @@ func TestSchedulerBasic(t *testing.T) {
 			},
 		},
 	}
-	tasks = b.Schedule(3, currentTables, captures, replications)
+	tasks = b.Schedule(3, toSpans(currentTables), captures, mapToSpanMap(replications))
+	require.Len(t, tasks, 1)
+	require.Equal(t, tasks[0].BurstBalance.RemoveTables[0].Span.TableID, model.TableID(5))
+}
+
+func TestSchedulerBasicSplitSpans(t *testing.T) {
+	t.Parallel()
+
+	captures := map[model.CaptureID]*member.CaptureStatus{
+		"a": {State: member.CaptureStateInitialized},
+		"b": {State: member.CaptureStateInitialized},
+	}
+	span := tablepb.TableSpan(1)
+	mid := append(span.StartKey[:len(span.StartKey):len(span.StartKey)], 'a')
+	currentSpans := []tablepb.Span{
+		{TableID: 1, StartKey: span.StartKey, EndKey: mid},
+		{TableID: 1, StartKey: mid, EndKey: span.EndKey},
+	}
+	replications := tablepb.NewSpanMap[*replication.ReplicationSet]()
+	b := newBasicScheduler(2, model.ChangeFeedID{})
+
+	// Spans of a table are placed on different captures.
+	tasks := b.Schedule(0, currentSpans, captures, replications)
+	require.Len(t, tasks, 1)
+	addTables := tasks[0].BurstBalance.AddTables
+	require.Len(t, addTables, 2)
+	require.Equal(t, currentSpans[0], addTables[0].Span)
+	require.Equal(t, currentSpans[1], addTables[1].Span)
+	require.NotEqual(t, addTables[0].CaptureID, addTables[1].CaptureID)
+
+	// The whole table span is removed once the table is split.
+	replications.ReplaceOrInsert(span, &replication.ReplicationSet{
+		State: replication.ReplicationSetStateReplicating, Primary: "a",
+		Captures: map[string]replication.Role{"a": replication.RolePrimary},
+	})
+	for _, s := range currentSpans {
+		replications.ReplaceOrInsert(s, &replication.ReplicationSet{
+			State: replication.ReplicationSetStateReplicating, Primary: "b",
+			Captures: map[string]replication.Role{"b": replication.RolePrimary},
+		})
+	}
+	tasks = b.Schedule(0, currentSpans, captures, replications)
 	require.Len(t, tasks, 1)
-	require.Equal(t, tasks[0].BurstBalance.RemoveTables[0].TableID, model.TableID(5))
+	require.Equal(t, []replication.RemoveTable{{Span: span, CaptureID: "a"}},
+		tasks[0].BurstBalance.RemoveTables)
 }
 
 func TestSchedulerPriority(t *testing.T) {
@@ -188,7 +247,7 @@ func benchmarkSchedulerBalance(
 		b.ResetTimer()
 		b.Run(name, func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
-				sched.Schedule(0, currentTables, captures, replications)
+				sched.Schedule(0, toSpans(currentTables), captures, mapToSpanMap(replications))
 			}
 		})
 		b.StopTimer()
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"go.uber.org/zap"
@@ -70,9 +71,9 @@ func (d *drainCaptureScheduler) setTarget(target model.CaptureID) bool {
 
 func (d *drainCaptureScheduler) Schedule(
 	_ model.Ts,
-	_ []model.TableID,
+	_ []tablepb.Span,
 	captures map[model.CaptureID]*member.CaptureStatus,
-	replications map[model.TableID]*replication.ReplicationSet,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
 ) []*replication.ScheduleTask {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -124,8 +125,11 @@ func (d *drainCaptureScheduler) Schedule(
 
 	maxTaskConcurrency := d.maxTaskConcurrency
 	// victimTables record tables should be moved out from the target capture
-	victimTables := make([]model.TableID, 0, maxTaskConcurrency)
-	for tableID, rep := range replications {
+	victimTables := make([]tablepb.Span, 0, maxTaskConcurrency)
+	// captureTables records tables which have spans in each capture.
+	captureTables := make(map[model.CaptureID]map[model.TableID]struct{})
+	skip := false
+	replications.Ascend(func(span tablepb.Span, rep *replication.ReplicationSet) bool {
 		if rep.State != replication.ReplicationSetStateReplicating {
 			// only drain the target capture if all tables is replicating,
 			log.Debug("schedulerv3: drain capture scheduler skip this tick,"+
@@ -134,19 +138,28 @@ func (d *drainCaptureScheduler) Schedule(
 				zap.String("changefeed", d.changefeedID.ID),
 				zap.String("target", d.target),
 				zap.Any("replication", rep))
-			return nil
+			skip = true
+			return false
 		}
 
 		if rep.Primary == d.target {
 			if len(victimTables) < maxTaskConcurrency {
-				victimTables = append(victimTables, tableID)
+				victimTables = append(victimTables, span)
 			}
 		}
 
 		// only calculate workload of other captures not the drain target.
 		if rep.Primary != d.target {
 			captureWorkload[rep.Primary]++
+			if captureTables[rep.Primary] == nil {
+				captureTables[rep.Primary] = make(map[model.TableID]struct{})
+			}
+			captureTables[rep.Primary][span.TableID] = struct{}{}
 		}
+		return true
+	})
+	if skip {
+		return nil
 	}
 
 	// this always indicate that the whole draining process finished, and can be triggered by:
@@ -164,13 +177,23 @@ func (d *drainCaptureScheduler) Schedule(
 
 	// For each victim table, find the target for it
 	result := make([]*replication.ScheduleTask, 0, maxTaskConcurrency)
-	for _, tableID := range victimTables {
+	for _, span := range victimTables {
 		target := ""
 		minWorkload := math.MaxInt64
-		for captureID, workload := range captureWorkload {
-			if workload < minWorkload {
-				minWorkload = workload
-				target = captureID
+		// Spans of the same table are preferred to be placed on
+		// different captures.
+		for _, avoidSibling := range []bool{true, false} {
+			for captureID, workload := range captureWorkload {
+				if _, ok := captureTables[captureID][span.TableID]; ok && avoidSibling {
+					continue
+				}
+				if workload < minWorkload {
+					minWorkload = workload
+					target = captureID
+				}
+			}
+			if target != "" {
+				break
 			}
 		}
 
@@ -183,7 +206,7 @@ func (d *drainCaptureScheduler) Schedule(
 
 		result = append(result, &replication.ScheduleTask{
 			MoveTable: &replication.MoveTable{
-				TableID:     tableID,
+				Span:        span,
 				DestCapture: target,
 			},
 			Accept: (replication.Callback)(nil), // No need for accept callback here.
@@ -191,6 +214,10 @@ func (d *drainCaptureScheduler) Schedule(
 
 		// Increase target workload to make sure tables are evenly distributed.
 		captureWorkload[target]++
+		if captureTables[target] == nil {
+			captureTables[target] = make(map[model.TableID]struct{})
+		}
+		captureTables[target][span.TableID] = struct{}{}
 	}
 
 	return result
@@ -33,13 +33,15 @@ func TestDrainCapture(t *testing.T) {
 	currentTables := make([]model.TableID, 0)
 	replications := make(map[model.TableID]*replication.ReplicationSet)
 
-	tasks := scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks := scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	ok := scheduler.setTarget("a")
 	require.True(t, ok)
 
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 	// the target capture has no table at the beginning, so reset the target
 	require.Equal(t, captureIDNotDraining, scheduler.target)
@@ -48,7 +50,8 @@ func TestDrainCapture(t *testing.T) {
 	ok = scheduler.setTarget("b")
 	require.True(t, ok)
 
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 	// the target capture cannot be found in the latest captures
 	require.Equal(t, captureIDNotDraining, scheduler.target)
@@ -103,7 +106,8 @@ func TestDrainCapture(t *testing.T) {
 	ok = scheduler.setTarget("a")
 	require.True(t, ok)
 	// not all table is replicating, skip this tick.
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Equal(t, "a", scheduler.target)
 	require.Len(t, tasks, 0)
 
@@ -116,13 +120,15 @@ func TestDrainCapture(t *testing.T) {
 		7: {State: replication.ReplicationSetStateReplicating, Primary: "b"},
 	}
 
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Equal(t, "a", scheduler.target)
 	require.Len(t, tasks, 3)
 
 	scheduler = newDrainCaptureScheduler(1, model.ChangeFeedID{})
 	require.True(t, scheduler.setTarget("a"))
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Equal(t, "a", scheduler.target)
 	require.Len(t, tasks, 1)
 }
@@ -136,7 +142,8 @@ func TestDrainStoppingCapture(t *testing.T) {
 	replications := make(map[model.TableID]*replication.ReplicationSet)
 	scheduler := newDrainCaptureScheduler(10, model.ChangeFeedID{})
 
-	tasks := scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks := scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Empty(t, tasks)
 
 	captures["a"] = &member.CaptureStatus{}
@@ -145,9 +152,10 @@ func TestDrainStoppingCapture(t *testing.T) {
 		1: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 		2: {State: replication.ReplicationSetStateReplicating, Primary: "b"},
 	}
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
-	require.EqualValues(t, 2, tasks[0].MoveTable.TableID)
+	require.EqualValues(t, 2, tasks[0].MoveTable.Span.TableID)
 	require.EqualValues(t, "a", tasks[0].MoveTable.DestCapture)
 	require.EqualValues(t, "b", scheduler.getTarget())
 }
@@ -166,7 +174,8 @@ func TestDrainSkipOwner(t *testing.T) {
 		2: {State: replication.ReplicationSetStateReplicating, Primary: "b"},
 	}
 	scheduler := newDrainCaptureScheduler(10, model.ChangeFeedID{})
-	tasks := scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks := scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 	require.EqualValues(t, captureIDNotDraining, scheduler.getTarget())
 }
@@ -186,7 +195,8 @@ func TestDrainImbalanceCluster(t *testing.T) {
 	}
 	scheduler := newDrainCaptureScheduler(10, model.ChangeFeedID{})
 	scheduler.setTarget("a")
-	tasks := scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks := scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 2)
 	require.EqualValues(t, "a", scheduler.getTarget())
 }
@@ -209,7 +219,8 @@ func TestDrainEvenlyDistributedTables(t *testing.T) {
 	}
 	scheduler := newDrainCaptureScheduler(10, model.ChangeFeedID{})
 	scheduler.setTarget("a")
-	tasks := scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks := scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 3)
 	taskMap := make(map[model.CaptureID]int)
 	for _, t := range tasks {
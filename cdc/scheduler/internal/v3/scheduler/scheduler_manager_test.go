@@ -52,14 +52,16 @@ func TestSchedulerManagerScheduler(t *testing.T) {
 	// schedulerPriorityBasic bypasses task check.
 	replications := map[model.TableID]*replication.ReplicationSet{}
 	runningTasks := map[model.TableID]*replication.ScheduleTask{1: {}}
-	tasks := m.Schedule(0, currentTables, captures, replications, runningTasks)
+	tasks := m.Schedule(
+		0, toSpans(currentTables), captures, mapToSpanMap(replications), mapToSpanMap(runningTasks))
 	require.Len(t, tasks, 1)
 
 	// No more task.
 	replications = map[model.TableID]*replication.ReplicationSet{
 		1: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 	}
-	tasks = m.Schedule(0, currentTables, captures, replications, runningTasks)
+	tasks = m.Schedule(
+		0, toSpans(currentTables), captures, mapToSpanMap(replications), mapToSpanMap(runningTasks))
 	require.Len(t, tasks, 0)
 
 	// Move table is drop because of running tasks.
@@ -67,7 +69,8 @@ func TestSchedulerManagerScheduler(t *testing.T) {
 	replications = map[model.TableID]*replication.ReplicationSet{
 		1: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 	}
-	tasks = m.Schedule(0, currentTables, captures, replications, runningTasks)
+	tasks = m.Schedule(
+		0, toSpans(currentTables), captures, mapToSpanMap(replications), mapToSpanMap(runningTasks))
 	require.Len(t, tasks, 0)
 
 	// Move table can proceed after clean up tasks.
@@ -76,6 +79,7 @@ func TestSchedulerManagerScheduler(t *testing.T) {
 		1: {State: replication.ReplicationSetStateReplicating, Primary: "a"},
 	}
 	runningTasks = map[model.TableID]*replication.ScheduleTask{}
-	tasks = m.Schedule(0, currentTables, captures, replications, runningTasks)
+	tasks = m.Schedule(
+		0, toSpans(currentTables), captures, mapToSpanMap(replications), mapToSpanMap(runningTasks))
 	require.Len(t, tasks, 1)
 }
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"go.uber.org/zap"
@@ -52,7 +53,7 @@ func (m *moveTableScheduler) addTask(tableID model.TableID, target model.Capture
 	}
 	m.tasks[tableID] = &replication.ScheduleTask{
 		MoveTable: &replication.MoveTable{
-			TableID:     tableID,
+			Span:        tablepb.TableSpan(tableID),
 			DestCapture: target,
 		},
 		Accept: func() {
@@ -66,9 +67,9 @@ func (m *moveTableScheduler) addTask(tableID model.TableID, target model.Capture
 
 func (m *moveTableScheduler) Schedule(
 	_ model.Ts,
-	currentTables []model.TableID,
+	currentSpans []tablepb.Span,
 	captures map[model.CaptureID]*member.CaptureStatus,
-	replications map[model.TableID]*replication.ReplicationSet,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
 ) []*replication.ScheduleTask {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -83,32 +84,33 @@ func (m *moveTableScheduler) Schedule(
 		return result
 	}
 
-	allTables := newTableSet()
-	for _, tableID := range currentTables {
-		allTables.add(tableID)
+	tableSpans := make(map[model.TableID][]tablepb.Span)
+	for _, span := range currentSpans {
+		tableSpans[span.TableID] = append(tableSpans[span.TableID], span)
 	}
 
 	for tableID, task := range m.tasks {
 		// table may not in the all current tables
 		// if it was removed after manual move table triggered.
-		if !allTables.contain(tableID) {
+		spans, ok := tableSpans[tableID]
+		if !ok {
 			log.Warn("schedulerv3: move table ignored, since the table cannot found",
 				zap.String("namespace", m.changefeedID.Namespace),
 				zap.String("changefeed", m.changefeedID.ID),
 				zap.Int64("tableID", tableID),
-				zap.String("captureID", task.MoveTable.DestCapture))
+				zap.String("captureID", destCapture(task)))
 			delete(m.tasks, tableID)
 			continue
 		}
 
 		// the target capture may offline after manual move table triggered.
-		status, ok := captures[task.MoveTable.DestCapture]
+		status, ok := captures[destCapture(task)]
 		if !ok {
 			log.Info("schedulerv3: move table ignored, since the target capture cannot found",
 				zap.String("namespace", m.changefeedID.Namespace),
 				zap.String("changefeed", m.changefeedID.ID),
 				zap.Int64("tableID", tableID),
-				zap.String("captureID", task.MoveTable.DestCapture))
+				zap.String("captureID", destCapture(task)))
 			delete(m.tasks, tableID)
 			continue
 		}
@@ -117,21 +119,59 @@ func (m *moveTableScheduler) Schedule(
 				zap.String("namespace", m.changefeedID.Namespace),
 				zap.String("changefeed", m.changefeedID.ID),
 				zap.Int64("tableID", tableID),
-				zap.String("captureID", task.MoveTable.DestCapture),
+				zap.String("captureID", destCapture(task)),
 				zap.Any("state", status.State))
 			delete(m.tasks, tableID)
 			continue
 		}
 
-		rep, ok := replications[tableID]
+		if !m.checkSpansReplicating(tableID, task, spans, replications) {
+			delete(m.tasks, tableID)
+			continue
+		}
+		// All spans of the table are moved together.
+		if task.MoveTable == nil {
+			// The task has been expanded to spans already.
+			continue
+		}
+		if len(spans) == 1 {
+			task.MoveTable.Span = spans[0]
+		} else {
+			moves := make([]replication.MoveTable, 0, len(spans))
+			for _, span := range spans {
+				moves = append(moves, replication.MoveTable{
+					Span:        span,
+					DestCapture: destCapture(task),
+				})
+			}
+			task.BurstBalance = &replication.BurstBalance{MoveTables: moves}
+			task.MoveTable = nil
+		}
+	}
+
+	for _, task := range m.tasks {
+		result = append(result, task)
+	}
+
+	return result
+}
+
+func (m *moveTableScheduler) checkSpansReplicating(
+	tableID model.TableID,
+	task *replication.ScheduleTask,
+	spans []tablepb.Span,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
+) bool {
+	for _, span := range spans {
+		rep, ok := replications.Get(span)
 		if !ok {
 			log.Warn("schedulerv3: move table ignored, table not found in the replication set",
 				zap.String("namespace", m.changefeedID.Namespace),
 				zap.String("changefeed", m.changefeedID.ID),
 				zap.Int64("tableID", tableID),
-				zap.String("captureID", task.MoveTable.DestCapture))
-			delete(m.tasks, tableID)
-			continue
+				zap.String("span", tablepb.FormatSpan(span)),
+				zap.String("captureID", destCapture(task)))
+			return false
 		}
 		// only move replicating table.
 		if rep.State != replication.ReplicationSetStateReplicating {
@@ -139,15 +179,19 @@ func (m *moveTableScheduler) Schedule(
 				zap.String("namespace", m.changefeedID.Namespace),
 				zap.String("changefeed", m.changefeedID.ID),
 				zap.Int64("tableID", tableID),
-				zap.String("captureID", task.MoveTable.DestCapture),
+				zap.String("span", tablepb.FormatSpan(span)),
+				zap.String("captureID", destCapture(task)),
 				zap.Any("replicationState", rep.State))
-			delete(m.tasks, tableID)
+			return false
 		}
 	}
+	return true
+}
 
-	for _, task := range m.tasks {
-		result = append(result, task)
+// destCapture returns the target capture of a manual move table task.
+func destCapture(task *replication.ScheduleTask) model.CaptureID {
+	if task.MoveTable != nil {
+		return task.MoveTable.DestCapture
 	}
-
-	return result
+	return task.BurstBalance.MoveTables[0].DestCapture
 }
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/stretchr/testify/require"
@@ -41,47 +42,55 @@ func TestSchedulerMoveTable(t *testing.T) {
 	require.Equal(t, "move-table-scheduler", scheduler.Name())
 
 	tasks := scheduler.Schedule(
-		checkpointTs, currentTables, map[model.CaptureID]*member.CaptureStatus{}, replications)
+		checkpointTs, toSpans(currentTables),
+		map[model.CaptureID]*member.CaptureStatus{}, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	scheduler.addTask(model.TableID(0), "a")
 	tasks = scheduler.Schedule(
-		checkpointTs, currentTables, map[model.CaptureID]*member.CaptureStatus{}, replications)
+		checkpointTs, toSpans(currentTables),
+		map[model.CaptureID]*member.CaptureStatus{}, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// move a not exist table
 	scheduler.addTask(model.TableID(0), "a")
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// move table to a not exist capture
 	scheduler.addTask(model.TableID(1), "c")
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// move table not replicating
 	scheduler.addTask(model.TableID(1), "b")
 	tasks = scheduler.Schedule(
-		checkpointTs, currentTables, captures, map[model.TableID]*replication.ReplicationSet{})
+		checkpointTs, toSpans(currentTables), captures,
+		tablepb.NewSpanMap[*replication.ReplicationSet]())
 	require.Len(t, tasks, 0)
 
 	scheduler.addTask(model.TableID(1), "b")
 	replications[model.TableID(1)].State = replication.ReplicationSetStatePrepare
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	scheduler.addTask(model.TableID(1), "b")
 	replications[model.TableID(1)].State = replication.ReplicationSetStateReplicating
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
-	require.Equal(t, model.TableID(1), tasks[0].MoveTable.TableID)
+	require.Equal(t, model.TableID(1), tasks[0].MoveTable.Span.TableID)
 	require.Equal(t, "b", tasks[0].MoveTable.DestCapture)
 	require.Equal(t, scheduler.tasks[model.TableID(1)], tasks[0])
 
 	// the target capture is stopping
 	scheduler.addTask(model.TableID(1), "b")
 	captures["b"].State = member.CaptureStateStopping
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 	require.NotContains(t, scheduler.tasks, model.TableID(1))
 }
@@ -16,12 +16,12 @@ package scheduler
 import (
 	"math"
 	"math/rand"
-	"sort"
 	"sync/atomic"
 	"time"
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"go.uber.org/zap"
@@ -50,9 +50,9 @@ func (r *rebalanceScheduler) Name() string {
 
 func (r *rebalanceScheduler) Schedule(
 	_ model.Ts,
-	currentTables []model.TableID,
+	currentSpans []tablepb.Span,
 	captures map[model.CaptureID]*member.CaptureStatus,
-	replications map[model.TableID]*replication.ReplicationSet,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
 ) []*replication.ScheduleTask {
 	// rebalance is not triggered, or there is still some pending task,
 	// do not generate new tasks.
@@ -75,8 +75,8 @@ func (r *rebalanceScheduler) Schedule(
 	}
 
 	// only rebalance when all tables are replicating
-	for _, tableID := range currentTables {
-		rep, ok := replications[tableID]
+	for _, span := range currentSpans {
+		rep, ok := replications.Get(span)
 		if !ok {
 			return nil
 		}
@@ -108,7 +108,7 @@ func (r *rebalanceScheduler) Schedule(
 func newBalanceMoveTables(
 	random *rand.Rand,
 	captures map[model.CaptureID]*member.CaptureStatus,
-	replications map[model.TableID]*replication.ReplicationSet,
+	replications *tablepb.SpanMap[*replication.ReplicationSet],
 	maxTaskLimit int,
 	changefeedID model.ChangeFeedID,
 ) []replication.MoveTable {
@@ -117,17 +117,18 @@ func newBalanceMoveTables(
 		tablesPerCapture[captureID] = newTableSet()
 	}
 
-	for tableID, rep := range replications {
+	replications.Ascend(func(span tablepb.Span, rep *replication.ReplicationSet) bool {
 		if rep.State != replication.ReplicationSetStateReplicating {
-			continue
+			return true
 		}
-		tablesPerCapture[rep.Primary].add(tableID)
-	}
+		tablesPerCapture[rep.Primary].add(span)
+		return true
+	})
 
 	// findVictim return tables which need to be moved
-	upperLimitPerCapture := int(math.Ceil(float64(len(replications)) / float64(len(captures))))
+	upperLimitPerCapture := int(math.Ceil(float64(replications.Len()) / float64(len(captures))))
 
-	victims := make([]model.TableID, 0)
+	victims := make([]tablepb.Span, 0)
 	for _, ts := range tablesPerCapture {
 		tables := ts.keys()
 		if random != nil {
@@ -140,12 +141,6 @@ func newBalanceMoveTables(
 			random.Shuffle(len(tables), func(i, j int) {
 				tables[i], tables[j] = tables[j], tables[i]
 			})
-		} else {
-			// sort the tableIDs here so that the result is deterministic,
-			// which would aid testing and debugging.
-			sort.Slice(tables, func(i, j int) bool {
-				return tables[i] < tables[j]
-			})
 		}
 
 		tableNum2Remove := len(tables) - upperLimitPerCapture
@@ -172,14 +167,23 @@ func newBalanceMoveTables(
 	}
 	// for each victim table, find the target for it
 	moveTables := make([]replication.MoveTable, 0, len(victims))
-	for idx, tableID := range victims {
+	for idx, span := range victims {
 		target := ""
 		minWorkload := math.MaxInt64
-
-		for captureID, workload := range captureWorkload {
-			if workload < minWorkload {
-				minWorkload = workload
-				target = captureID
+		// Spans of the same table are preferred to be placed on
+		// different captures.
+		for _, avoidSibling := range []bool{true, false} {
+			for captureID, workload := range captureWorkload {
+				if avoidSibling && tablesPerCapture[captureID].containTable(span.TableID) {
+					continue
+				}
+				if workload < minWorkload {
+					minWorkload = workload
+					target = captureID
+				}
+			}
+			if target != "" {
+				break
 			}
 		}
 
@@ -195,10 +199,10 @@ func newBalanceMoveTables(
 		}
 
 		moveTables = append(moveTables, replication.MoveTable{
-			TableID:     tableID,
+			Span:        span,
 			DestCapture: target,
 		})
-		tablesPerCapture[target].add(tableID)
+		tablesPerCapture[target].add(span)
 		captureWorkload[target] = randomizeWorkload(random, tablesPerCapture[target].size())
 	}
 
@@ -227,36 +231,49 @@ func randomizeWorkload(random *rand.Rand, input int) int {
 }
 
 type tableSet struct {
-	memo map[model.TableID]struct{}
+	memo *tablepb.SpanMap[struct{}]
+	// spans counts spans of each table in the set.
+	spans map[model.TableID]int
 }
 
 func newTableSet() *tableSet {
 	return &tableSet{
-		memo: make(map[model.TableID]struct{}),
+		memo:  tablepb.NewSpanMap[struct{}](),
+		spans: make(map[model.TableID]int),
 	}
 }
 
-func (s *tableSet) add(tableID model.TableID) {
-	s.memo[tableID] = struct{}{}
+func (s *tableSet) add(span tablepb.Span) {
+	if !s.memo.Has(span) {
+		s.memo.ReplaceOrInsert(span, struct{}{})
+		s.spans[span.TableID]++
+	}
+}
+
+func (s *tableSet) remove(span tablepb.Span) {
+	if s.memo.Has(span) {
+		s.memo.Delete(span)
+		s.spans[span.TableID]--
+		if s.spans[span.TableID] == 0 {
+			delete(s.spans, span.TableID)
+		}
+	}
 }
 
-func (s *tableSet) remove(tableID model.TableID) {
-	delete(s.memo, tableID)
+// keys returns spans in the set in ascending order.
+func (s *tableSet) keys() []tablepb.Span {
+	return s.memo.Spans()
 }
 
-func (s *tableSet) keys() []model.TableID {
-	result := make([]model.TableID, 0, len(s.memo))
-	for k := range s.memo {
-		result = append(result, k)
-	}
-	return result
+func (s *tableSet) contain(span tablepb.Span) bool {
+	return s.memo.Has(span)
 }
 
-func (s *tableSet) contain(tableID model.TableID) bool {
-	_, ok := s.memo[tableID]
-	return ok
+// containTable returns whether the set has any span of the table.
+func (s *tableSet) containTable(tableID model.TableID) bool {
+	return s.spans[tableID] > 0
 }
 
 func (s *tableSet) size() int {
-	return len(s.memo)
+	return s.memo.Len()
 }
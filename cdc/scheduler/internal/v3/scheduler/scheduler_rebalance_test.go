@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/stretchr/testify/require"
@@ -55,21 +56,25 @@ func TestSchedulerRebalance(t *testing.T) {
 	scheduler := newRebalanceScheduler(model.ChangeFeedID{})
 	require.Equal(t, "rebalance-scheduler", scheduler.Name())
 	// rebalance is not triggered
-	tasks := scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks := scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	atomic.StoreInt32(&scheduler.rebalance, 1)
 	// no captures
 	tasks = scheduler.Schedule(
-		checkpointTs, currentTables, map[model.CaptureID]*member.CaptureStatus{}, replications)
+		checkpointTs, toSpans(currentTables),
+		map[model.CaptureID]*member.CaptureStatus{}, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// table not in the replication set,
-	tasks = scheduler.Schedule(checkpointTs, []model.TableID{0}, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans([]model.TableID{0}), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// not all tables are replicating,
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// table distribution is balanced, should have no task.
@@ -79,7 +84,8 @@ func TestSchedulerRebalance(t *testing.T) {
 		3: {State: replication.ReplicationSetStateReplicating, Primary: "b"},
 		4: {State: replication.ReplicationSetStateReplicating, Primary: "b"},
 	}
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 
 	// Imbalance.
@@ -94,17 +100,19 @@ func TestSchedulerRebalance(t *testing.T) {
 
 	// capture is stopping, ignore the request
 	captures["a"].State = member.CaptureStateStopping
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 	require.Equal(t, atomic.LoadInt32(&scheduler.rebalance), int32(0))
 
 	captures["a"].State = member.CaptureStateInitialized
 	atomic.StoreInt32(&scheduler.rebalance, 1)
 	scheduler.random = nil // disable random to make test easier.
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 1)
 	require.Contains(t, tasks[0].BurstBalance.MoveTables, replication.MoveTable{
-		TableID: 1, DestCapture: "b",
+		Span: tablepb.TableSpan(1), DestCapture: "b",
 	})
 	require.EqualValues(t, 1, atomic.LoadInt32(&scheduler.rebalance))
 
@@ -112,6 +120,7 @@ func TestSchedulerRebalance(t *testing.T) {
 	require.EqualValues(t, 0, atomic.LoadInt32(&scheduler.rebalance))
 
 	// pending task is not consumed yet, this turn should have no tasks.
-	tasks = scheduler.Schedule(checkpointTs, currentTables, captures, replications)
+	tasks = scheduler.Schedule(
+		checkpointTs, toSpans(currentTables), captures, mapToSpanMap(replications))
 	require.Len(t, tasks, 0)
 }
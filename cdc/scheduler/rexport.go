@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/etcd"
 	"github.com/pingcap/tiflow/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -109,6 +110,7 @@ func NewAgentV3(
 // NewSchedulerV3 returns two-phase scheduler.
 func NewSchedulerV3(
 	ctx context.Context,
+	up *upstream.Upstream,
 	captureID model.CaptureID,
 	changeFeedID model.ChangeFeedID,
 	checkpointTs model.Ts,
@@ -116,10 +118,11 @@ func NewSchedulerV3(
 	messageRouter p2p.MessageRouter,
 	ownerRevision int64,
 	cfg *config.SchedulerConfig,
+	changefeedCfg *config.ChangefeedSchedulerConfig,
 ) (Scheduler, error) {
 	return v3.NewCoordinator(
-		ctx, captureID, changeFeedID, checkpointTs,
-		messageServer, messageRouter, ownerRevision, cfg)
+		ctx, up, captureID, changeFeedID, checkpointTs,
+		messageServer, messageRouter, ownerRevision, cfg, changefeedCfg)
 }
 
 // InitMetrics registers all metrics used in scheduler
@@ -63,6 +63,7 @@ type AddTableRequest struct {
 	TableID     github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_id,omitempty"`
 	IsSecondary bool                                        `protobuf:"varint,2,opt,name=is_secondary,json=isSecondary,proto3" json:"is_secondary,omitempty"`
 	Checkpoint  tablepb.Checkpoint                          `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint"`
+	Span        tablepb.Span                                `protobuf:"bytes,4,opt,name=span,proto3" json:"span"`
 }
 
 func (m *AddTableRequest) Reset()         { *m = AddTableRequest{} }
@@ -119,8 +120,16 @@ func (m *AddTableRequest) GetCheckpoint() tablepb.Checkpoint {
 	return tablepb.Checkpoint{}
 }
 
+func (m *AddTableRequest) GetSpan() tablepb.Span {
+	if m != nil {
+		return m.Span
+	}
+	return tablepb.Span{}
+}
+
 type RemoveTableRequest struct {
 	TableID github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_id,omitempty"`
+	Span    tablepb.Span                                `protobuf:"bytes,2,opt,name=span,proto3" json:"span"`
 }
 
 func (m *RemoveTableRequest) Reset()         { *m = RemoveTableRequest{} }
@@ -163,6 +172,13 @@ func (m *RemoveTableRequest) GetTableID() github_com_pingcap_tiflow_cdc_model.Ta
 	return 0
 }
 
+func (m *RemoveTableRequest) GetSpan() tablepb.Span {
+	if m != nil {
+		return m.Span
+	}
+	return tablepb.Span{}
+}
+
 type DispatchTableRequest struct {
 	// Types that are valid to be assigned to Request:
 	//	*DispatchTableRequest_AddTable
@@ -440,6 +456,7 @@ func (*DispatchTableResponse) XXX_OneofWrappers() []interface{} {
 type Heartbeat struct {
 	TableIDs   []github_com_pingcap_tiflow_cdc_model.TableID `protobuf:"varint,1,rep,packed,name=table_ids,json=tableIds,proto3,casttype=github.com/pingcap/tiflow/cdc/model.TableID" json:"table_ids,omitempty"`
 	IsStopping bool                                          `protobuf:"varint,2,opt,name=is_stopping,json=isStopping,proto3" json:"is_stopping,omitempty"`
+	Spans      []tablepb.Span                                `protobuf:"bytes,3,rep,name=spans,proto3" json:"spans"`
 }
 
 func (m *Heartbeat) Reset()         { *m = Heartbeat{} }
@@ -489,6 +506,13 @@ func (m *Heartbeat) GetIsStopping() bool {
 	return false
 }
 
+func (m *Heartbeat) GetSpans() []tablepb.Span {
+	if m != nil {
+		return m.Spans
+	}
+	return nil
+}
+
 type HeartbeatResponse struct {
 	Tables   []tablepb.TableStatus                        `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables"`
 	Liveness github_com_pingcap_tiflow_cdc_model.Liveness `protobuf:"varint,2,opt,name=liveness,proto3,casttype=github.com/pingcap/tiflow/cdc/model.Liveness" json:"liveness,omitempty"`
@@ -811,68 +835,71 @@ func init() {
 }
 
 var fileDescriptor_86eeacbf6ca5b996 = []byte{
-	// 972 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x8e, 0xdb, 0x44,
-	0x18, 0x8f, 0x93, 0x6d, 0xfe, 0x7c, 0xd9, 0xa6, 0xe9, 0x90, 0x52, 0xcb, 0x40, 0x12, 0x7c, 0x80,
-	0x85, 0x82, 0xd3, 0x06, 0x04, 0x65, 0x0b, 0x48, 0x4d, 0x5b, 0x69, 0x57, 0xea, 0x6a, 0x57, 0xde,
-	0x16, 0x10, 0x97, 0xe0, 0xd8, 0xb3, 0x8e, 0xb5, 0x89, 0xc7, 0x78, 0x9c, 0xac, 0xf6, 0x05, 0x38,
-	0xe4, 0xc4, 0x0b, 0xe4, 0x25, 0x90, 0x90, 0x78, 0x84, 0x1e, 0x97, 0x1b, 0x07, 0x14, 0x95, 0xec,
-	0x03, 0x70, 0x5f, 0x2e, 0xc8, 0x33, 0x63, 0x67, 0xbd, 0x9b, 0x22, 0x6f, 0x84, 0x90, 0xb8, 0xcd,
-	0x7c, 0x33, 0xdf, 0xef, 0xfb, 0x33, 0xbf, 0xdf, 0x67, 0xc3, 0x7b, 0xd4, 0xec, 0x63, 0x6b, 0x34,
-	0xc0, 0x7e, 0x2b, 0x5a, 0x79, 0xbd, 0x56, 0x60, 0xf4, 0x06, 0xb8, 0x1b, 0x19, 0x34, 0xcf, 0x27,
-	0x01, 0x41, 0xef, 0x7a, 0x8e, 0x6b, 0x9b, 0x86, 0xa7, 0x05, 0xce, 0xc1, 0x80, 0x1c, 0x69, 0xa6,
-	0x65, 0x6a, 0xb1, 0xb7, 0xb6, 0xf0, 0x56, 0x6a, 0x36, 0xb1, 0x09, 0xf3, 0x69, 0x85, 0x2b, 0xee,
-	0xae, 0xbc, 0xe5, 0xf9, 0xc4, 0xc4, 0x94, 0x12, 0x9f, 0xc3, 0x47, 0x61, 0xf8, 0xb1, 0x7a, 0x2a,
-	0xc1, 0x8d, 0x87, 0x96, 0xf5, 0x2c, 0x34, 0xe9, 0xf8, 0xfb, 0x11, 0xa6, 0x01, 0x7a, 0x0e, 0x45,
-	0x9e, 0x89, 0x63, 0xc9, 0x52, 0x53, 0xda, 0xc8, 0x75, 0x36, 0xe7, 0xb3, 0x46, 0x81, 0xdd, 0xd9,
-	0x7e, 0x7c, 0x36, 0x6b, 0xdc, 0xb1, 0x9d, 0xa0, 0x3f, 0xea, 0x69, 0x26, 0x19, 0xb6, 0x44, 0x76,
-	0x2d, 0x9e, 0x5d, 0xcb, 0xb4, 0xcc, 0xd6, 0x90, 0x58, 0x78, 0xa0, 0x89, 0xeb, 0x7a, 0x81, 0x61,
-	0x6d, 0x5b, 0xe8, 0x6d, 0x58, 0x77, 0x68, 0x97, 0x62, 0x93, 0xb8, 0x96, 0xe1, 0x1f, 0xcb, 0xd9,
-	0xa6, 0xb4, 0x51, 0xd4, 0xcb, 0x0e, 0xdd, 0x8f, 0x4c, 0xe8, 0x2b, 0x00, 0xb3, 0x8f, 0xcd, 0x43,
-	0x8f, 0x38, 0x6e, 0x20, 0xe7, 0x9a, 0xd2, 0x46, 0xb9, 0x7d, 0x57, 0x5b, 0xd2, 0x80, 0xb8, 0x28,
-	0x4d, 0x14, 0xa5, 0x3d, 0x8a, 0xfd, 0x3a, 0x6b, 0x2f, 0x66, 0x8d, 0x8c, 0x7e, 0x0e, 0x49, 0x3d,
-	0x04, 0xa4, 0xe3, 0x21, 0x19, 0xe3, 0xff, 0xa0, 0x4e, 0xf5, 0x77, 0x09, 0x6a, 0x8f, 0x1d, 0xea,
-	0x19, 0x81, 0xd9, 0x4f, 0xc4, 0xfb, 0x1a, 0x4a, 0x86, 0x65, 0x75, 0xd9, 0x3d, 0x16, 0xb0, 0xdc,
-	0xbe, 0xaf, 0xa5, 0x7c, 0x5d, 0xed, 0xc2, 0x23, 0x6d, 0x65, 0xf4, 0xa2, 0x21, 0x4c, 0xe8, 0x3b,
-	0x58, 0xf7, 0x59, 0x79, 0x02, 0x3b, 0xcb, 0xb0, 0x1f, 0xa4, 0xc6, 0xbe, 0xdc, 0x9b, 0xad, 0x8c,
-	0x5e, 0xf6, 0x17, 0xd6, 0x4e, 0x09, 0x0a, 0x3e, 0x3f, 0x51, 0x7f, 0x96, 0xa0, 0xba, 0x48, 0x86,
-	0x7a, 0xc4, 0xa5, 0x18, 0x6d, 0x43, 0x9e, 0x06, 0x46, 0x30, 0xa2, 0xa2, 0xae, 0x7b, 0xe9, 0x1e,
-	0x8d, 0x81, 0xec, 0x33, 0x47, 0x5d, 0x00, 0x5c, 0xe0, 0x40, 0xf6, 0x5f, 0xe3, 0xc0, 0x2f, 0x12,
-	0xbc, 0x96, 0x28, 0xf4, 0xff, 0x93, 0xfa, 0x4b, 0x09, 0x6e, 0x5d, 0x60, 0x94, 0x48, 0xfe, 0x9b,
-	0xcb, 0x94, 0xfa, 0x6c, 0x05, 0x4a, 0x71, 0xb4, 0x04, 0xa7, 0x8c, 0xa5, 0x9c, 0xfa, 0x7c, 0x35,
-	0x4e, 0xc5, 0xf8, 0x09, 0x52, 0x01, 0x14, 0x7d, 0x71, 0xa4, 0xfe, 0x20, 0x41, 0x69, 0x0b, 0x1b,
-	0x7e, 0xd0, 0xc3, 0x46, 0x10, 0x96, 0x15, 0x29, 0x33, 0x7c, 0x96, 0xdc, 0x46, 0xae, 0xf3, 0x60,
-	0x3e, 0x6b, 0x14, 0x85, 0xd6, 0xe8, 0x55, 0xb5, 0x59, 0x14, 0xda, 0xa4, 0xa8, 0x01, 0xe5, 0x70,
-	0x08, 0x05, 0xc4, 0x0b, 0x9d, 0xc4, 0x0c, 0x02, 0x87, 0xee, 0x0b, 0x8b, 0xfa, 0x93, 0x04, 0x37,
-	0xe3, 0x44, 0xe2, 0x3e, 0xef, 0x42, 0x9e, 0x41, 0xf0, 0x6c, 0x56, 0x21, 0x89, 0x78, 0x56, 0x01,
-	0x83, 0x9e, 0x42, 0x71, 0xe0, 0x8c, 0xb1, 0x8b, 0x29, 0x65, 0x49, 0x5c, 0xeb, 0xdc, 0x3d, 0x9b,
-	0x35, 0x3e, 0x48, 0x53, 0xd4, 0x53, 0xe1, 0xa7, 0xc7, 0x08, 0xea, 0x1d, 0xb8, 0xbe, 0x7b, 0xe4,
-	0x62, 0x5f, 0xc7, 0x63, 0x87, 0x3a, 0xc4, 0x45, 0x4a, 0xd8, 0x5a, 0xbe, 0xe6, 0xa3, 0x4d, 0x8f,
-	0xf7, 0xea, 0x3b, 0x50, 0xd9, 0x8b, 0x32, 0x7d, 0xe2, 0x11, 0xb3, 0x8f, 0x6a, 0x70, 0x0d, 0x87,
-	0x0b, 0x76, 0xb5, 0xa4, 0xf3, 0x8d, 0xfa, 0x6b, 0x01, 0x0a, 0x3b, 0x98, 0x52, 0xc3, 0x66, 0xf5,
-	0xf7, 0xb1, 0x61, 0x61, 0x5f, 0x90, 0xec, 0xd3, 0xd4, 0x3c, 0x10, 0x08, 0xda, 0x16, 0x73, 0xd7,
-	0x05, 0x0c, 0xda, 0x85, 0xe2, 0x90, 0xda, 0xdd, 0xe0, 0xd8, 0xe3, 0xd4, 0xaa, 0xb4, 0x3f, 0xbe,
-	0x2a, 0xe4, 0xb3, 0x63, 0x0f, 0xeb, 0x85, 0x21, 0xb5, 0xc3, 0x05, 0x7a, 0x02, 0x6b, 0x07, 0x3e,
-	0x19, 0xb2, 0x8f, 0x46, 0xa9, 0x73, 0xef, 0x6c, 0xd6, 0xf8, 0x30, 0x4d, 0x33, 0x1f, 0x19, 0x5e,
-	0x30, 0xf2, 0x43, 0x8e, 0x30, 0x77, 0xf4, 0x10, 0xb2, 0x01, 0x91, 0xd7, 0x56, 0x05, 0xc9, 0x06,
-	0x04, 0x51, 0x78, 0xdd, 0x12, 0x62, 0xe5, 0xda, 0xe9, 0x8a, 0xd1, 0x29, 0x5f, 0x63, 0xbd, 0xfb,
-	0x22, 0x75, 0xa1, 0xcb, 0xbe, 0x22, 0x7a, 0xcd, 0x5a, 0xf6, 0x6d, 0x19, 0xc3, 0xed, 0x4b, 0x41,
-	0x39, 0x77, 0xe5, 0x3c, 0x8b, 0xfa, 0xe5, 0xaa, 0x51, 0x39, 0x8a, 0x7e, 0xcb, 0x5a, 0x3a, 0x80,
-	0xf6, 0xa0, 0xd4, 0x8f, 0xd4, 0x22, 0x17, 0x58, 0xa4, 0x76, 0xea, 0x48, 0x0b, 0x9d, 0x2d, 0x40,
-	0x90, 0x03, 0x28, 0xde, 0x2c, 0x8a, 0x28, 0x32, 0xe8, 0xcd, 0x15, 0xa0, 0xa3, 0x02, 0x6e, 0xf6,
-	0x2f, 0x9a, 0x94, 0x3f, 0x25, 0xc8, 0x73, 0x5e, 0x22, 0x19, 0x0a, 0x63, 0xec, 0xc7, 0x7a, 0x29,
-	0xe9, 0xd1, 0x16, 0x99, 0x50, 0x21, 0xa1, 0xb6, 0xba, 0xb1, 0xa0, 0xf8, 0x28, 0xfc, 0x24, 0x75,
-	0x2e, 0x09, 0x69, 0x8a, 0x39, 0x70, 0x9d, 0x24, 0xf4, 0x7a, 0x00, 0x37, 0xe2, 0xe9, 0xd1, 0xe5,
-	0x5a, 0xcc, 0x5d, 0x51, 0x68, 0x49, 0x4d, 0x8b, 0x30, 0x15, 0x2f, 0x61, 0x7d, 0xff, 0x2f, 0x09,
-	0xca, 0xe7, 0xe4, 0x83, 0xea, 0x00, 0x3b, 0xd4, 0x7e, 0xee, 0x1e, 0xba, 0xe4, 0xc8, 0xad, 0x66,
-	0x94, 0xca, 0x64, 0xda, 0x3c, 0x67, 0x41, 0xf7, 0xe1, 0xf6, 0x0e, 0xb5, 0x97, 0xf1, 0xb0, 0x2a,
-	0x29, 0x6f, 0x4c, 0xa6, 0xcd, 0x57, 0x1d, 0xa3, 0x4d, 0x90, 0x2f, 0x1f, 0xf1, 0xbe, 0x57, 0xb3,
-	0xca, 0x9b, 0x93, 0x69, 0xf3, 0x95, 0xe7, 0x48, 0x85, 0xf5, 0x1d, 0x6a, 0xc7, 0x4f, 0x58, 0xcd,
-	0x29, 0xd5, 0xc9, 0xb4, 0x99, 0xb0, 0xa1, 0x36, 0xd4, 0xce, 0xef, 0x63, 0xec, 0x35, 0x45, 0x9e,
-	0x4c, 0x9b, 0x4b, 0xcf, 0x3a, 0x7b, 0x27, 0x7f, 0xd4, 0x33, 0x2f, 0xe6, 0x75, 0xe9, 0x64, 0x5e,
-	0x97, 0x5e, 0xce, 0xeb, 0xd2, 0x8f, 0xa7, 0xf5, 0xcc, 0xc9, 0x69, 0x3d, 0xf3, 0xdb, 0x69, 0x3d,
-	0xf3, 0x6d, 0xfb, 0x9f, 0xa5, 0xbe, 0xec, 0x8f, 0xbd, 0x97, 0x67, 0x7f, 0xd1, 0x1f, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0xe5, 0xd5, 0x79, 0xcd, 0xd0, 0x0b, 0x00, 0x00,
+	// 1010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0x8f, 0x93, 0xb4, 0x49, 0x4e, 0xba, 0x2c, 0xbb, 0x64, 0xcc, 0x0a, 0x90, 0x04, 0x3f, 0x40,
+	0xd9, 0xc0, 0xd9, 0x02, 0x82, 0xd1, 0x01, 0xd2, 0xb2, 0x0e, 0xb5, 0xd2, 0xaa, 0x56, 0xee, 0x06,
+	0x88, 0x97, 0xe0, 0xd8, 0xb7, 0x8e, 0xb5, 0xc4, 0xd7, 0xf8, 0x3a, 0xa9, 0xfa, 0x15, 0xf2, 0xc4,
+	0x17, 0xc8, 0x07, 0xe0, 0x11, 0x24, 0x24, 0x3e, 0xc2, 0x9e, 0x50, 0x79, 0xe3, 0x01, 0x45, 0x23,
+	0xfd, 0x00, 0xbc, 0x97, 0x17, 0xe4, 0x7b, 0xaf, 0x9d, 0xba, 0xcd, 0x90, 0x1b, 0x10, 0xd2, 0xde,
+	0xee, 0x3d, 0xc7, 0xe7, 0x77, 0xfe, 0xdc, 0xdf, 0xef, 0x44, 0x81, 0x77, 0xa8, 0xd1, 0xc3, 0xe6,
+	0xb0, 0x8f, 0xbd, 0x66, 0x78, 0x72, 0xbb, 0x4d, 0x5f, 0xef, 0xf6, 0x71, 0x27, 0x34, 0xa8, 0xae,
+	0x47, 0x7c, 0x82, 0xde, 0x76, 0x6d, 0xc7, 0x32, 0x74, 0x57, 0xf5, 0xed, 0x83, 0x3e, 0x39, 0x54,
+	0x0d, 0xd3, 0x50, 0xa3, 0x68, 0x75, 0x1e, 0x5d, 0xad, 0x58, 0xc4, 0x22, 0x2c, 0xa6, 0x19, 0x9c,
+	0x78, 0x78, 0xf5, 0x0d, 0xd7, 0x23, 0x06, 0xa6, 0x94, 0x78, 0x1c, 0x3e, 0x4c, 0xc3, 0xdd, 0xca,
+	0xf7, 0x69, 0xb8, 0x7a, 0xdf, 0x34, 0x1f, 0x07, 0x26, 0x0d, 0x7f, 0x3b, 0xc4, 0xd4, 0x47, 0x4f,
+	0x20, 0xcf, 0x2b, 0xb1, 0x4d, 0x59, 0x6a, 0x48, 0xeb, 0x99, 0xf6, 0xc6, 0x6c, 0x5a, 0xcf, 0xb1,
+	0x6f, 0xb6, 0x37, 0x4f, 0xa7, 0xf5, 0x5b, 0x96, 0xed, 0xf7, 0x86, 0x5d, 0xd5, 0x20, 0x83, 0xa6,
+	0xa8, 0xae, 0xc9, 0xab, 0x6b, 0x1a, 0xa6, 0xd1, 0x1c, 0x10, 0x13, 0xf7, 0x55, 0xf1, 0xb9, 0x96,
+	0x63, 0x58, 0xdb, 0x26, 0x7a, 0x13, 0xd6, 0x6c, 0xda, 0xa1, 0xd8, 0x20, 0x8e, 0xa9, 0x7b, 0x47,
+	0x72, 0xba, 0x21, 0xad, 0xe7, 0xb5, 0xa2, 0x4d, 0xf7, 0x43, 0x13, 0xfa, 0x02, 0xc0, 0xe8, 0x61,
+	0xe3, 0xa9, 0x4b, 0x6c, 0xc7, 0x97, 0x33, 0x0d, 0x69, 0xbd, 0xd8, 0xba, 0xad, 0x2e, 0x18, 0x40,
+	0xd4, 0x94, 0x2a, 0x9a, 0x52, 0x1f, 0x44, 0x71, 0xed, 0xec, 0xb3, 0x69, 0x3d, 0xa5, 0x9d, 0x41,
+	0x42, 0x9b, 0x90, 0xa5, 0xae, 0xee, 0xc8, 0x59, 0x86, 0x78, 0x33, 0x19, 0xe2, 0xbe, 0xab, 0x3b,
+	0x02, 0x8b, 0x45, 0x2b, 0x3f, 0x48, 0x80, 0x34, 0x3c, 0x20, 0x23, 0xfc, 0x7f, 0x8c, 0x2b, 0xac,
+	0x39, 0xfd, 0xaf, 0x6a, 0xfe, 0x5d, 0x82, 0xca, 0xa6, 0x4d, 0x5d, 0xdd, 0x37, 0x7a, 0xb1, 0xaa,
+	0xbf, 0x84, 0x82, 0x6e, 0x9a, 0x1d, 0x16, 0xc8, 0xca, 0x2e, 0xb6, 0xee, 0xaa, 0x09, 0xa9, 0xa6,
+	0x9e, 0x63, 0xcc, 0x56, 0x4a, 0xcb, 0xeb, 0xc2, 0x84, 0xbe, 0x81, 0x35, 0x8f, 0x0d, 0x49, 0x60,
+	0xf3, 0xfa, 0xef, 0x25, 0xc6, 0xbe, 0x38, 0xe1, 0xad, 0x94, 0x56, 0xf4, 0xe6, 0xd6, 0x76, 0x01,
+	0x72, 0x1e, 0xf7, 0x28, 0x3f, 0x49, 0x50, 0x9e, 0x17, 0x43, 0x5d, 0xe2, 0x50, 0x8c, 0xb6, 0x61,
+	0x95, 0xfa, 0xba, 0x3f, 0xa4, 0xa2, 0xaf, 0x3b, 0xc9, 0x66, 0xc7, 0x40, 0xf6, 0x59, 0xa0, 0x26,
+	0x00, 0xce, 0x11, 0x32, 0xfd, 0x5f, 0x11, 0x52, 0xf9, 0x59, 0x82, 0x57, 0x62, 0x8d, 0xbe, 0x3c,
+	0xa5, 0x3f, 0x97, 0xe0, 0xfa, 0x39, 0x46, 0x89, 0xe2, 0xbf, 0xba, 0x48, 0xa9, 0x8f, 0x97, 0xa0,
+	0x14, 0x47, 0x8b, 0x71, 0x4a, 0x5f, 0xc8, 0xa9, 0x4f, 0x96, 0xe3, 0x54, 0x84, 0x1f, 0x23, 0x15,
+	0x40, 0xde, 0x13, 0x2e, 0xe5, 0x17, 0x09, 0x0a, 0x5b, 0x58, 0xf7, 0xfc, 0x2e, 0xd6, 0xfd, 0xa0,
+	0xad, 0x50, 0xdf, 0xc1, 0xb3, 0x64, 0xd6, 0x33, 0xed, 0x7b, 0xb3, 0x69, 0x3d, 0x2f, 0x14, 0x4b,
+	0x2f, 0xab, 0xf0, 0xbc, 0x50, 0x38, 0x45, 0x75, 0x28, 0x06, 0x1b, 0xd1, 0x27, 0x6e, 0x10, 0x24,
+	0x16, 0x22, 0xd8, 0x74, 0x5f, 0x58, 0xd0, 0xe7, 0xb0, 0x12, 0xa8, 0x98, 0xca, 0x99, 0x46, 0x66,
+	0xa9, 0x25, 0xc0, 0xc3, 0x95, 0x1f, 0x25, 0xb8, 0x16, 0x35, 0x14, 0xbd, 0xd7, 0x2e, 0xac, 0xb2,
+	0x10, 0xde, 0xd5, 0x32, 0x64, 0x13, 0x59, 0x04, 0x0c, 0x7a, 0x04, 0xf9, 0xbe, 0x3d, 0xc2, 0x0e,
+	0xa6, 0x94, 0x35, 0xb3, 0xd2, 0xbe, 0x7d, 0x3a, 0xad, 0xbf, 0x9b, 0x64, 0x38, 0x8f, 0x44, 0x9c,
+	0x16, 0x21, 0x28, 0xb7, 0xe0, 0xca, 0xee, 0xa1, 0x83, 0x3d, 0x0d, 0x8f, 0x6c, 0x6a, 0x13, 0x07,
+	0x55, 0x83, 0x27, 0xe2, 0x67, 0xbe, 0x68, 0xb5, 0xe8, 0xae, 0xbc, 0x05, 0xa5, 0xbd, 0xb0, 0xd2,
+	0x87, 0x2e, 0x31, 0x7a, 0xa8, 0x02, 0x2b, 0x38, 0x38, 0xb0, 0x4f, 0x0b, 0x1a, 0xbf, 0x28, 0xbf,
+	0xe6, 0x20, 0xb7, 0x83, 0x29, 0xd5, 0x2d, 0xd6, 0x7f, 0x0f, 0xeb, 0x26, 0xf6, 0x04, 0x59, 0x3f,
+	0x4a, 0xcc, 0x27, 0x81, 0xa0, 0x6e, 0xb1, 0x70, 0x4d, 0xc0, 0xa0, 0x5d, 0xc8, 0x0f, 0xa8, 0xd5,
+	0xf1, 0x8f, 0x5c, 0x4e, 0xd1, 0x52, 0xeb, 0x83, 0xcb, 0x42, 0x3e, 0x3e, 0x72, 0xb1, 0x96, 0x1b,
+	0x50, 0x2b, 0x38, 0xa0, 0x87, 0x90, 0x3d, 0xf0, 0xc8, 0x80, 0xfd, 0x12, 0x16, 0xda, 0x77, 0x4e,
+	0xa7, 0xf5, 0xf7, 0x92, 0x0c, 0xf3, 0x81, 0xee, 0xfa, 0x43, 0x2f, 0xe0, 0x1a, 0x0b, 0x47, 0xf7,
+	0x21, 0xed, 0x13, 0x39, 0xbb, 0x2c, 0x48, 0xda, 0x27, 0x88, 0xc2, 0xab, 0xa6, 0x10, 0x3d, 0xd7,
+	0x60, 0x47, 0xac, 0x60, 0x79, 0x85, 0xcd, 0xee, 0xd3, 0xc4, 0x8d, 0x2e, 0xfa, 0x35, 0xd2, 0x2a,
+	0xe6, 0x02, 0x2b, 0x1a, 0xc1, 0x8d, 0x0b, 0x49, 0x39, 0x77, 0xe5, 0x55, 0x96, 0xf5, 0xb3, 0x65,
+	0xb3, 0x72, 0x14, 0xed, 0xba, 0xb9, 0xc8, 0x8c, 0xf6, 0xa0, 0xd0, 0x0b, 0xd5, 0x22, 0xe7, 0x58,
+	0xa6, 0x56, 0xe2, 0x4c, 0x73, 0x9d, 0xcd, 0x41, 0x90, 0x0d, 0x28, 0xba, 0xcc, 0x9b, 0xc8, 0x33,
+	0xe8, 0x8d, 0x25, 0xa0, 0xc3, 0x06, 0xae, 0xf5, 0xce, 0x9b, 0xaa, 0x7f, 0x4a, 0xb0, 0xca, 0x79,
+	0x89, 0x64, 0xc8, 0x8d, 0xb0, 0x17, 0xe9, 0xa5, 0xa0, 0x85, 0x57, 0x64, 0x40, 0x89, 0x04, 0xda,
+	0xea, 0x44, 0x82, 0xe2, 0x2b, 0xf5, 0xc3, 0xc4, 0xb5, 0xc4, 0xa4, 0x29, 0xf6, 0xc0, 0x15, 0x12,
+	0xd3, 0xeb, 0x01, 0x5c, 0x8d, 0xb6, 0x47, 0x87, 0x6b, 0x31, 0x73, 0x49, 0xa1, 0xc5, 0x35, 0x2d,
+	0xd2, 0x94, 0xdc, 0x98, 0xf5, 0xe6, 0x5f, 0x12, 0x14, 0xcf, 0xc8, 0x07, 0xd5, 0x00, 0x76, 0xa8,
+	0xf5, 0xc4, 0x79, 0xea, 0x90, 0x43, 0xa7, 0x9c, 0xaa, 0x96, 0xc6, 0x93, 0xc6, 0x19, 0x0b, 0xba,
+	0x0b, 0x37, 0x76, 0xa8, 0xb5, 0x88, 0x87, 0x65, 0xa9, 0xfa, 0xda, 0x78, 0xd2, 0x78, 0x91, 0x1b,
+	0x6d, 0x80, 0x7c, 0xd1, 0xc5, 0xe7, 0x5e, 0x4e, 0x57, 0x5f, 0x1f, 0x4f, 0x1a, 0x2f, 0xf4, 0x23,
+	0x05, 0xd6, 0x76, 0xa8, 0x15, 0x3d, 0x61, 0x39, 0x53, 0x2d, 0x8f, 0x27, 0x8d, 0x98, 0x0d, 0xb5,
+	0xa0, 0x72, 0xf6, 0x1e, 0x61, 0x67, 0xab, 0xf2, 0x78, 0xd2, 0x58, 0xe8, 0x6b, 0xef, 0x1d, 0xff,
+	0x51, 0x4b, 0x3d, 0x9b, 0xd5, 0xa4, 0xe3, 0x59, 0x4d, 0x7a, 0x3e, 0xab, 0x49, 0xdf, 0x9d, 0xd4,
+	0x52, 0xc7, 0x27, 0xb5, 0xd4, 0x6f, 0x27, 0xb5, 0xd4, 0xd7, 0xad, 0x7f, 0x96, 0xfa, 0xa2, 0xbf,
+	0x21, 0xdd, 0x55, 0xf6, 0xd7, 0xe0, 0xfd, 0xbf, 0x07, 0x00, 0x8f, 0x27, 0x6e, 0x23, 0xa5, 0x0c,
+	0x00, 0x00,
 }
 
 func (m *AddTableRequest) Marshal() (dAtA []byte, err error) {
@@ -895,6 +922,16 @@ func (m *AddTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTableSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -943,6 +980,16 @@ func (m *RemoveTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTableSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.TableID != 0 {
 		i = encodeVarintTableSchedule(dAtA, i, uint64(m.TableID))
 		i--
@@ -1209,6 +1256,20 @@ func (m *Heartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Spans) > 0 {
+		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTableSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.IsStopping {
 		i--
 		if m.IsStopping {
@@ -1220,21 +1281,21 @@ func (m *Heartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.TableIDs) > 0 {
-		dAtA11 := make([]byte, len(m.TableIDs)*10)
-		var j10 int
+		dAtA13 := make([]byte, len(m.TableIDs)*10)
+		var j12 int
 		for _, num1 := range m.TableIDs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j10++
+				j12++
 			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintTableSchedule(dAtA, i, uint64(j10))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTableSchedule(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1518,6 +1579,8 @@ func (m *AddTableRequest) Size() (n int) {
 	}
 	l = m.Checkpoint.Size()
 	n += 1 + l + sovTableSchedule(uint64(l))
+	l = m.Span.Size()
+	n += 1 + l + sovTableSchedule(uint64(l))
 	return n
 }
 
@@ -1530,6 +1593,8 @@ func (m *RemoveTableRequest) Size() (n int) {
 	if m.TableID != 0 {
 		n += 1 + sovTableSchedule(uint64(m.TableID))
 	}
+	l = m.Span.Size()
+	n += 1 + l + sovTableSchedule(uint64(l))
 	return n
 }
 
@@ -1651,6 +1716,12 @@ func (m *Heartbeat) Size() (n int) {
 	if m.IsStopping {
 		n += 2
 	}
+	if len(m.Spans) > 0 {
+		for _, e := range m.Spans {
+			l = e.Size()
+			n += 1 + l + sovTableSchedule(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1861,6 +1932,39 @@ func (m *AddTableRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -1930,6 +2034,39 @@ func (m *RemoveTableRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -2554,6 +2691,40 @@ func (m *Heartbeat) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsStopping = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTableSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTableSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spans = append(m.Spans, tablepb.Span{})
+			if err := m.Spans[len(m.Spans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTableSchedule(dAtA[iNdEx:])
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
 	"github.com/pingcap/tiflow/cdc/processor/pipeline/system"
 	ssystem "github.com/pingcap/tiflow/cdc/sorter/db/system"
 	"github.com/pingcap/tiflow/pkg/pdutil"
@@ -248,9 +249,12 @@ func (s *server) startStatusHTTP(lis net.Listener) error {
 	router := gin.New()
 	// add gin.Recovery() to handle unexpected panic
 	router.Use(gin.Recovery())
-	// router.
+	auth, err := middleware.NewAuth(conf.Auth, conf.Security)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	// Register APIs.
-	cdc.RegisterRoutes(router, s.capture, registry)
+	cdc.RegisterRoutes(router, s.capture, registry, auth)
 
 	// No need to configure TLS because it is already handled by `s.tcpServer`.
 	// Add ReadTimeout and WriteTimeout to avoid some abnormal connections never close.
@@ -258,6 +262,7 @@ func (s *server) startStatusHTTP(lis net.Listener) error {
 		Handler:      router,
 		ReadTimeout:  httpConnectionTimeout,
 		WriteTimeout: httpConnectionTimeout,
+		ConnContext:  middleware.ConnContext,
 	}
 
 	go func() {
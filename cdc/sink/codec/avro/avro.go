@@ -25,6 +25,7 @@ import (
 	"github.com/linkedin/goavro/v2"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/rowcodec"
@@ -41,12 +42,14 @@ import (
 // BatchEncoder converts the events to binary Avro data
 type BatchEncoder struct {
 	namespace          string
-	keySchemaManager   *schemaManager
-	valueSchemaManager *schemaManager
+	keySchemaManager   *SchemaManager
+	valueSchemaManager *SchemaManager
 	resultBuf          []*common.Message
 	maxMessageBytes    int
+	claimCheck         bool
 
 	enableTiDBExtension        bool
+	enableWatermark            bool
 	decimalHandlingMode        string
 	bigintUnsignedHandlingMode string
 }
@@ -77,7 +80,10 @@ func (a *BatchEncoder) AppendRowChangedEvent(
 	message.Callback = callback
 	topic = sanitizeTopic(topic)
 
-	if !e.IsDelete() {
+	// The delete event is sent as a tombstone, but it's impossible to
+	// replay it without the commit ts, so keep its value if watermark
+	// is enabled.
+	if !e.IsDelete() || a.enableWatermark {
 		res, err := a.avroEncode(ctx, e, topic, false)
 		if err != nil {
 			log.Error("AppendRowChangedEvent: avro encoding failed", zap.Error(err))
@@ -113,7 +119,7 @@ func (a *BatchEncoder) AppendRowChangedEvent(
 	}
 	message.IncRowsCount()
 
-	if message.Length() > a.maxMessageBytes {
+	if message.Length() > a.maxMessageBytes && !a.claimCheck {
 		log.Error(
 			"Single message too large",
 			zap.Int(
@@ -131,14 +137,48 @@ func (a *BatchEncoder) AppendRowChangedEvent(
 	return nil
 }
 
-// EncodeCheckpointEvent is no-op for now
+// EncodeCheckpointEvent is no-op unless the watermark is enabled.
 func (a *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
-	return nil, nil
+	if !a.enableWatermark {
+		return nil, nil
+	}
+	buf := new(bytes.Buffer)
+	data := []interface{}{checkpointByte, ts}
+	for _, v := range data {
+		err := binary.Write(buf, binary.BigEndian, v)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrAvroToEnvelopeError, err)
+		}
+	}
+	return common.NewResolvedMsg(config.ProtocolAvro, nil, buf.Bytes(), ts), nil
 }
 
-// EncodeDDLEvent is no-op now
+// ddlEvent is the DDL event sent with the watermark enabled.
+type ddlEvent struct {
+	Query    string             `json:"query"`
+	Type     timodel.ActionType `json:"type"`
+	Schema   string             `json:"schema"`
+	Table    string             `json:"table"`
+	CommitTs uint64             `json:"commitTs"`
+}
+
+// EncodeDDLEvent is no-op unless the watermark is enabled.
 func (a *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
-	return nil, nil
+	if !a.enableWatermark {
+		return nil, nil
+	}
+	data, err := json.Marshal(&ddlEvent{
+		Query:    e.Query,
+		Type:     e.Type,
+		Schema:   e.TableInfo.Schema,
+		Table:    e.TableInfo.Table,
+		CommitTs: e.CommitTs,
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrAvroMarshalFailed, err)
+	}
+	value := append([]byte{ddlByte}, data...)
+	return common.NewDDLMsg(config.ProtocolAvro, nil, value, e), nil
 }
 
 // Build Messages
@@ -151,6 +191,7 @@ func (a *BatchEncoder) Build() (messages []*common.Message) {
 const (
 	insertOperation = "c"
 	updateOperation = "u"
+	deleteOperation = "d"
 )
 
 func (a *BatchEncoder) avroEncode(
@@ -163,7 +204,7 @@ func (a *BatchEncoder) avroEncode(
 		cols                []*model.Column
 		colInfos            []rowcodec.ColInfo
 		enableTiDBExtension bool
-		schemaManager       *schemaManager
+		schemaManager       *SchemaManager
 		operation           string
 	)
 	if isKey {
@@ -179,6 +220,9 @@ func (a *BatchEncoder) avroEncode(
 			operation = insertOperation
 		} else if e.IsUpdate() {
 			operation = updateOperation
+		} else if e.IsDelete() {
+			cols = e.PreColumns
+			operation = deleteOperation
 		} else {
 			log.Error("unknown operation", zap.Any("rowChangedEvent", e))
 			return nil, cerror.ErrAvroEncodeFailed.GenWithStack("unknown operation")
@@ -779,7 +823,14 @@ func columnToAvroData(
 	}
 }
 
-const magicByte = uint8(0)
+const (
+	// magicByte is the first byte of the confluent avro wire format.
+	magicByte = uint8(0)
+	// ddlByte and checkpointByte are the first byte of the DDL and checkpoint
+	// events, which are only sent with the watermark enabled.
+	ddlByte        = uint8(1)
+	checkpointByte = uint8(3)
+)
 
 // confluent avro wire format, confluent avro is not same as apache avro
 // https://rmoff.net/2020/07/03/why-json-isnt-the-same-as-json-schema-in-kafka-connect-converters \
@@ -799,8 +850,8 @@ func (r *avroEncodeResult) toEnvelope() ([]byte, error) {
 type batchEncoderBuilder struct {
 	namespace          string
 	config             *common.Config
-	keySchemaManager   *schemaManager
-	valueSchemaManager *schemaManager
+	keySchemaManager   *SchemaManager
+	valueSchemaManager *SchemaManager
 }
 
 const (
@@ -846,7 +897,9 @@ func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
 	encoder.valueSchemaManager = b.valueSchemaManager
 	encoder.resultBuf = make([]*common.Message, 0, 4096)
 	encoder.maxMessageBytes = b.config.MaxMessageBytes
+	encoder.claimCheck = b.config.ClaimCheckStorageURI != ""
 	encoder.enableTiDBExtension = b.config.EnableTiDBExtension
+	encoder.enableWatermark = b.config.AvroEnableWatermark
 	encoder.decimalHandlingMode = b.config.AvroDecimalHandlingMode
 	encoder.bigintUnsignedHandlingMode = b.config.AvroBigintUnsignedHandlingMode
 
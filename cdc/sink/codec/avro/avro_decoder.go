@@ -0,0 +1,370 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// decoder decodes the messages sent by the avro BatchEncoder, the schemas
+// are fetched from the schema registry by the ID in the envelope.
+// The commit ts of the row changed events is only available if the TiDB
+// extension is enabled, and the checkpoint and DDL events are only sent if
+// the watermark is enabled.
+type decoder struct {
+	ctx           context.Context
+	schemaManager *SchemaManager
+
+	key   []byte
+	value []byte
+}
+
+// NewDecoder creates a new avro decoder for a message.
+func NewDecoder(
+	ctx context.Context,
+	schemaManager *SchemaManager,
+	key []byte,
+	value []byte,
+) codec.EventBatchDecoder {
+	return &decoder{
+		ctx:           ctx,
+		schemaManager: schemaManager,
+		key:           key,
+		value:         value,
+	}
+}
+
+// HasNext implements the EventBatchDecoder interface
+func (d *decoder) HasNext() (model.MessageType, bool, error) {
+	if d.key == nil && d.value == nil {
+		return model.MessageTypeUnknown, false, nil
+	}
+	// the value of the delete event is nil unless the watermark is enabled.
+	if len(d.value) == 0 {
+		return model.MessageTypeRow, true, nil
+	}
+	switch d.value[0] {
+	case magicByte:
+		return model.MessageTypeRow, true, nil
+	case ddlByte:
+		return model.MessageTypeDDL, true, nil
+	case checkpointByte:
+		return model.MessageTypeResolved, true, nil
+	default:
+		return model.MessageTypeUnknown, false, cerror.ErrAvroDecodeFailed.GenWithStack(
+			"unknown message type %d", d.value[0])
+	}
+}
+
+// NextResolvedEvent implements the EventBatchDecoder interface
+func (d *decoder) NextResolvedEvent() (uint64, error) {
+	ty, hasNext, err := d.HasNext()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeResolved {
+		return 0, cerror.ErrAvroDecodeFailed.GenWithStack("not found resolved event message")
+	}
+	if len(d.value) != 9 {
+		return 0, cerror.ErrAvroDecodeFailed.GenWithStack(
+			"invalid checkpoint event length %d", len(d.value))
+	}
+	ts := binary.BigEndian.Uint64(d.value[1:])
+	d.key, d.value = nil, nil
+	return ts, nil
+}
+
+// NextDDLEvent implements the EventBatchDecoder interface
+func (d *decoder) NextDDLEvent() (*model.DDLEvent, error) {
+	ty, hasNext, err := d.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeDDL {
+		return nil, cerror.ErrAvroDecodeFailed.GenWithStack("not found ddl event message")
+	}
+	e := &ddlEvent{}
+	if err := json.Unmarshal(d.value[1:], e); err != nil {
+		return nil, cerror.WrapError(cerror.ErrAvroDecodeFailed, err)
+	}
+	d.key, d.value = nil, nil
+	return &model.DDLEvent{
+		CommitTs: e.CommitTs,
+		Query:    e.Query,
+		Type:     e.Type,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: e.Schema,
+			Table:  e.Table,
+		},
+	}, nil
+}
+
+// NextRowChangedEvent implements the EventBatchDecoder interface
+func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	ty, hasNext, err := d.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeRow {
+		return nil, cerror.ErrAvroDecodeFailed.GenWithStack("not found row changed event message")
+	}
+
+	handleKeys := make(map[string]struct{})
+	var keyRecord *avroRecord
+	if len(d.key) != 0 {
+		keyRecord, err = d.decodeEnvelope(d.key)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, f := range keyRecord.fields {
+			handleKeys[f.name] = struct{}{}
+		}
+	}
+
+	e := &model.RowChangedEvent{}
+	if len(d.value) == 0 {
+		// a tombstone, only the handle key columns are available.
+		if keyRecord == nil {
+			return nil, cerror.ErrAvroDecodeFailed.GenWithStack("both key and value are empty")
+		}
+		e.Table = keyRecord.tableName()
+		e.PreColumns, err = keyRecord.columns(handleKeys)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		d.key, d.value = nil, nil
+		return e, nil
+	}
+
+	valueRecord, err := d.decodeEnvelope(d.value)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	e.Table = valueRecord.tableName()
+	cols, err := valueRecord.columns(handleKeys)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if commitTs, ok := valueRecord.native[tidbCommitTs].(int64); ok {
+		e.CommitTs = uint64(commitTs)
+	}
+	if op, ok := valueRecord.native[tidbOp].(string); ok && op == deleteOperation {
+		e.PreColumns = cols
+	} else {
+		e.Columns = cols
+	}
+	d.key, d.value = nil, nil
+	return e, nil
+}
+
+// avroRecord is a decoded avro record with its schema.
+type avroRecord struct {
+	namespace string
+	name      string
+	fields    []*avroField
+	native    map[string]interface{}
+}
+
+type avroField struct {
+	name     string
+	tidbType string
+	nullable bool
+	// scale is only used by the decimal in precise mode.
+	scale int
+}
+
+// decodeEnvelope decodes the data in confluent avro wire format.
+func (d *decoder) decodeEnvelope(data []byte) (*avroRecord, error) {
+	if len(data) < 5 || data[0] != magicByte {
+		return nil, cerror.ErrAvroDecodeFailed.GenWithStack("invalid avro envelope")
+	}
+	registryID := int(binary.BigEndian.Uint32(data[1:5]))
+	avroCodec, err := d.schemaManager.LookupByID(d.ctx, registryID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	native, _, err := avroCodec.NativeFromBinary(data[5:])
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrAvroDecodeFailed, err)
+	}
+	nativeMap, ok := native.(map[string]interface{})
+	if !ok {
+		return nil, cerror.ErrAvroDecodeFailed.GenWithStack("avro data is not a record")
+	}
+	record, err := parseAvroSchema(avroCodec)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	record.native = nativeMap
+	return record, nil
+}
+
+// parseAvroSchema parses the schema generated by rowToAvroSchema.
+func parseAvroSchema(avroCodec *goavro.Codec) (*avroRecord, error) {
+	var top avroSchemaTop
+	if err := json.Unmarshal([]byte(avroCodec.Schema()), &top); err != nil {
+		return nil, cerror.WrapError(cerror.ErrAvroDecodeFailed, err)
+	}
+	record := &avroRecord{namespace: top.Namespace, name: top.Name}
+	for _, f := range top.Fields {
+		name, _ := f["name"].(string)
+		if name == tidbOp || name == tidbCommitTs || name == tidbPhysicalTime {
+			continue
+		}
+		field := &avroField{name: name}
+		tp := f["type"]
+		if union, ok := tp.([]interface{}); ok {
+			field.nullable = true
+			for _, t := range union {
+				if t != "null" {
+					tp = t
+				}
+			}
+		}
+		schema, ok := tp.(map[string]interface{})
+		if !ok {
+			return nil, cerror.ErrAvroDecodeFailed.GenWithStack(
+				"unexpected type of field %s", name)
+		}
+		if params, ok := schema["connect.parameters"].(map[string]interface{}); ok {
+			field.tidbType, _ = params[tidbType].(string)
+		}
+		if scale, ok := schema["scale"].(float64); ok {
+			field.scale = int(scale)
+		}
+		record.fields = append(record.fields, field)
+	}
+	return record, nil
+}
+
+// tableName returns the table name of the record, the names may be
+// different from the upstream ones if they are sanitized.
+func (r *avroRecord) tableName() *model.TableName {
+	schema := r.namespace
+	if i := strings.Index(schema, "."); i >= 0 {
+		schema = schema[i+1:]
+	}
+	return &model.TableName{Schema: schema, Table: r.name}
+}
+
+func (r *avroRecord) columns(handleKeys map[string]struct{}) ([]*model.Column, error) {
+	cols := make([]*model.Column, 0, len(r.fields))
+	for _, f := range r.fields {
+		value := r.native[f.name]
+		// https://pkg.go.dev/github.com/linkedin/goavro/v2#Union
+		if union, ok := value.(map[string]interface{}); ok {
+			value = nil
+			for _, v := range union {
+				value = v
+			}
+		}
+		col, err := avroDataToColumn(f, value)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if _, ok := handleKeys[f.name]; ok {
+			col.Flag.SetIsHandleKey()
+			col.Flag.SetIsPrimaryKey()
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+var tidbType2Type = map[string]byte{
+	"INT":       mysql.TypeLong,
+	"BIGINT":    mysql.TypeLonglong,
+	"FLOAT":     mysql.TypeFloat,
+	"DOUBLE":    mysql.TypeDouble,
+	"BIT":       mysql.TypeBit,
+	"DECIMAL":   mysql.TypeNewDecimal,
+	"TEXT":      mysql.TypeVarchar,
+	"BLOB":      mysql.TypeLongBlob,
+	"ENUM":      mysql.TypeEnum,
+	"SET":       mysql.TypeSet,
+	"JSON":      mysql.TypeJSON,
+	"DATE":      mysql.TypeDate,
+	"DATETIME":  mysql.TypeDatetime,
+	"TIMESTAMP": mysql.TypeTimestamp,
+	"TIME":      mysql.TypeDuration,
+	"YEAR":      mysql.TypeYear,
+}
+
+// avroDataToColumn is the reverse of columnToAvroData.
+func avroDataToColumn(f *avroField, value interface{}) (*model.Column, error) {
+	col := &model.Column{Name: f.name}
+	tt := f.tidbType
+	if strings.HasSuffix(tt, " UNSIGNED") {
+		tt = strings.TrimSuffix(tt, " UNSIGNED")
+		col.Flag.SetIsUnsigned()
+	}
+	tp, ok := tidbType2Type[tt]
+	if !ok {
+		return nil, cerror.ErrAvroDecodeFailed.GenWithStack(
+			"unknown tidb type %s of field %s", f.tidbType, f.name)
+	}
+	col.Type = tp
+	if f.nullable {
+		col.Flag.SetIsNullable()
+	}
+	if tp == mysql.TypeLongBlob {
+		col.Flag.SetIsBinary()
+	}
+	if value == nil {
+		return col, nil
+	}
+
+	switch v := value.(type) {
+	case int32:
+		if col.Flag.IsUnsigned() {
+			col.Value = uint64(v)
+		} else {
+			col.Value = int64(v)
+		}
+	case int64:
+		if col.Flag.IsUnsigned() {
+			col.Value = uint64(v)
+		} else {
+			col.Value = v
+		}
+	case *big.Rat:
+		col.Value = v.FloatString(f.scale)
+	case []byte:
+		if tp == mysql.TypeBit {
+			n, err := types.BinaryLiteral(v).ToInt(nil)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrAvroDecodeFailed, err)
+			}
+			col.Value = n
+		} else {
+			col.Value = v
+		}
+	default:
+		// string and float64
+		col.Value = v
+	}
+	return col, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"context"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newDecimalFieldType(flen, decimal int) *types.FieldType {
+	ft := types.NewFieldType(mysql.TypeNewDecimal)
+	ft.SetFlen(flen)
+	ft.SetDecimal(decimal)
+	return ft
+}
+
+func TestAvroDecoder(t *testing.T) {
+	encoder, err := setupEncoderAndSchemaRegistry(true, "precise", "long")
+	require.NoError(t, err)
+	defer teardownEncoderAndSchemaRegistry()
+	encoder.enableWatermark = true
+
+	ctx := context.Background()
+	schemaManager, err := NewAvroSchemaManager(ctx, nil, "http://127.0.0.1:8081", "")
+	require.NoError(t, err)
+
+	cols := []*model.Column{
+		{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: int64(1)},
+		{Name: "uint", Type: mysql.TypeLong, Flag: model.UnsignedFlag, Value: uint64(2)},
+		{Name: "bigint", Type: mysql.TypeLonglong, Value: int64(3)},
+		{Name: "decimal", Type: mysql.TypeNewDecimal, Value: "3.140"},
+		{Name: "varchar", Type: mysql.TypeVarchar, Value: []byte("hello")},
+		{Name: "blob", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte("world")},
+		{Name: "bit", Type: mysql.TypeBit, Value: uint64(683)},
+		{Name: "nullable", Type: mysql.TypeLong, Flag: model.NullableFlag, Value: nil},
+	}
+	colInfos := []rowcodec.ColInfo{
+		{ID: 1, IsPKHandle: true, Ft: types.NewFieldType(mysql.TypeLong)},
+		{ID: 2, Ft: types.NewFieldType(mysql.TypeLong)},
+		{ID: 3, Ft: types.NewFieldType(mysql.TypeLonglong)},
+		{ID: 4, Ft: newDecimalFieldType(10, 3)},
+		{ID: 5, Ft: types.NewFieldType(mysql.TypeVarchar)},
+		{ID: 6, Ft: types.NewFieldType(mysql.TypeBlob)},
+		{ID: 7, Ft: types.NewFieldType(mysql.TypeBit)},
+		{ID: 8, Ft: types.NewFieldType(mysql.TypeLong)},
+	}
+	expected := []interface{}{
+		int64(1), uint64(2), int64(3), "3.140", "hello", []byte("world"), uint64(683), nil,
+	}
+	table := &model.TableName{Schema: "testdb", Table: "avrodecode"}
+
+	insert := &model.RowChangedEvent{
+		CommitTs: 417318403368288260,
+		Table:    table,
+		Columns:  cols,
+		ColInfos: colInfos,
+	}
+	del := &model.RowChangedEvent{
+		CommitTs:   417318403368288261,
+		Table:      table,
+		PreColumns: cols,
+		ColInfos:   colInfos,
+	}
+	for _, e := range []*model.RowChangedEvent{insert, del} {
+		err = encoder.AppendRowChangedEvent(ctx, "testdb.avrodecode", e, nil)
+		require.NoError(t, err)
+	}
+	messages := encoder.Build()
+	require.Len(t, messages, 2)
+
+	for i, msg := range messages {
+		decoder := NewDecoder(ctx, schemaManager, msg.Key, msg.Value)
+		tp, hasNext, err := decoder.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+		row, err := decoder.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Equal(t, table, row.Table)
+		decoded := row.Columns
+		if i == 0 {
+			require.Equal(t, insert.CommitTs, row.CommitTs)
+			require.True(t, row.IsInsert())
+		} else {
+			require.Equal(t, del.CommitTs, row.CommitTs)
+			require.True(t, row.IsDelete())
+			decoded = row.PreColumns
+		}
+		require.Len(t, decoded, len(cols))
+		for j, col := range decoded {
+			require.Equal(t, cols[j].Name, col.Name)
+			require.Equal(t, expected[j], col.Value, col.Name)
+			require.Equal(t, j == 0, col.Flag.IsHandleKey())
+		}
+		require.True(t, decoded[1].Flag.IsUnsigned())
+		require.True(t, decoded[5].Flag.IsBinary())
+		require.True(t, decoded[7].Flag.IsNullable())
+
+		_, hasNext, err = decoder.HasNext()
+		require.NoError(t, err)
+		require.False(t, hasNext)
+	}
+
+	// the delete event without watermark is a tombstone.
+	encoder.enableWatermark = false
+	err = encoder.AppendRowChangedEvent(ctx, "testdb.avrodecode", del, nil)
+	require.NoError(t, err)
+	messages = encoder.Build()
+	require.Len(t, messages, 1)
+	require.Nil(t, messages[0].Value)
+	decoder := NewDecoder(ctx, schemaManager, messages[0].Key, messages[0].Value)
+	row, err := decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+	require.True(t, row.IsDelete())
+	require.Len(t, row.PreColumns, 1)
+	require.Equal(t, int64(1), row.PreColumns[0].Value)
+	encoder.enableWatermark = true
+
+	// checkpoint event
+	msg, err := encoder.EncodeCheckpointEvent(417318403368288262)
+	require.NoError(t, err)
+	decoder = NewDecoder(ctx, schemaManager, msg.Key, msg.Value)
+	tp, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeResolved, tp)
+	ts, err := decoder.NextResolvedEvent()
+	require.NoError(t, err)
+	require.Equal(t, uint64(417318403368288262), ts)
+
+	// ddl event
+	msg, err = encoder.EncodeDDLEvent(&model.DDLEvent{
+		CommitTs:  417318403368288263,
+		TableInfo: &model.SimpleTableInfo{Schema: "testdb", Table: "avrodecode"},
+		Query:     "create table avrodecode(id int primary key)",
+		Type:      timodel.ActionCreateTable,
+	})
+	require.NoError(t, err)
+	decoder = NewDecoder(ctx, schemaManager, msg.Key, msg.Value)
+	tp, hasNext, err = decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeDDL, tp)
+	_, err = decoder.NextRowChangedEvent()
+	require.Error(t, err)
+	ddl, err := decoder.NextDDLEvent()
+	require.NoError(t, err)
+	require.Equal(t, uint64(417318403368288263), ddl.CommitTs)
+	require.Equal(t, timodel.ActionCreateTable, ddl.Type)
+	require.Equal(t, "testdb", ddl.TableInfo.Schema)
+	require.Equal(t, "avrodecode", ddl.TableInfo.Table)
+}
+
+func TestAvroWatermarkDisabled(t *testing.T) {
+	encoder, err := setupEncoderAndSchemaRegistry(true, "precise", "long")
+	require.NoError(t, err)
+	defer teardownEncoderAndSchemaRegistry()
+
+	msg, err := encoder.EncodeCheckpointEvent(1)
+	require.NoError(t, err)
+	require.Nil(t, msg)
+	msg, err = encoder.EncodeDDLEvent(&model.DDLEvent{})
+	require.NoError(t, err)
+	require.Nil(t, msg)
+}
@@ -20,6 +20,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -34,10 +35,10 @@ import (
 	"go.uber.org/zap"
 )
 
-// schemaManager is used to register Avro Schemas to the Registry server,
+// SchemaManager is used to register Avro Schemas to the Registry server,
 // look up local cache according to the table's name, and fetch from the Registry
 // in cache the local cache entry is missing.
-type schemaManager struct {
+type SchemaManager struct {
 	registryURL   string
 	subjectSuffix string
 
@@ -45,6 +46,9 @@ type schemaManager struct {
 
 	cacheRWLock sync.RWMutex
 	cache       map[string]*schemaCacheEntry
+	// idCache caches the schemas fetched by the registry designated ID,
+	// which is used by the decoder.
+	idCache map[int]*goavro.Codec
 }
 
 type schemaCacheEntry struct {
@@ -69,10 +73,10 @@ type lookupResponse struct {
 	Schema     string `json:"schema"`
 }
 
-// NewAvroSchemaManager creates a new schemaManager and test connectivity to the schema registry
+// NewAvroSchemaManager creates a new SchemaManager and test connectivity to the schema registry
 func NewAvroSchemaManager(
 	ctx context.Context, credential *security.Credential, registryURL string, subjectSuffix string,
-) (*schemaManager, error) {
+) (*SchemaManager, error) {
 	registryURL = strings.TrimRight(registryURL, "/")
 	httpCli, err := httputil.NewClient(credential)
 	if err != nil {
@@ -102,15 +106,16 @@ func NewAvroSchemaManager(
 		zap.String("registryURL", registryURL),
 	)
 
-	return &schemaManager{
+	return &SchemaManager{
 		registryURL:   registryURL,
 		cache:         make(map[string]*schemaCacheEntry, 1),
+		idCache:       make(map[int]*goavro.Codec),
 		subjectSuffix: subjectSuffix,
 	}, nil
 }
 
 // Register a schema in schema registry, no cache
-func (m *schemaManager) Register(
+func (m *SchemaManager) Register(
 	ctx context.Context,
 	topicName string,
 	codec *goavro.Codec,
@@ -201,7 +206,7 @@ func (m *schemaManager) Register(
 // RESTful request to the Registry.
 // Returns (codec, registry schema ID, error)
 // NOT USED for now, reserved for future use.
-func (m *schemaManager) Lookup(
+func (m *SchemaManager) Lookup(
 	ctx context.Context,
 	topicName string,
 	tiSchemaID uint64,
@@ -295,6 +300,80 @@ func (m *schemaManager) Lookup(
 	return cacheEntry.codec, cacheEntry.registryID, nil
 }
 
+// LookupByID fetches the schema with the Registry designated ID.
+// A registered schema is immutable, so the result is cached forever.
+func (m *SchemaManager) LookupByID(
+	ctx context.Context,
+	registryID int,
+) (*goavro.Codec, error) {
+	m.cacheRWLock.RLock()
+	if codec, exists := m.idCache[registryID]; exists {
+		m.cacheRWLock.RUnlock()
+		return codec, nil
+	}
+	m.cacheRWLock.RUnlock()
+
+	uri := m.registryURL + "/schemas/ids/" + strconv.Itoa(registryID)
+	log.Debug("Querying for schema by id", zap.String("uri", uri))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
+	if err != nil {
+		log.Error("Error constructing request for Registry lookup", zap.Error(err))
+		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	req.Header.Add(
+		"Accept",
+		"application/vnd.schemaregistry.v1+json, application/vnd.schemaregistry+json, "+
+			"application/json",
+	)
+
+	resp, err := httpRetry(ctx, m.credential, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Error("Failed to parse result from Registry", zap.Error(err))
+		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+
+	if resp.StatusCode != 200 {
+		log.Error("Failed to query schema from the Registry, HTTP error",
+			zap.Int("status", resp.StatusCode),
+			zap.String("uri", uri),
+			zap.ByteString("responseBody", body))
+		return nil, cerror.ErrAvroSchemaAPIError.GenWithStack(
+			"Failed to query schema %d from the Registry, HTTP error %d",
+			registryID, resp.StatusCode,
+		)
+	}
+
+	var jsonResp lookupResponse
+	err = json.Unmarshal(body, &jsonResp)
+	if err != nil {
+		log.Error("Failed to parse result from Registry", zap.Error(err))
+		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+
+	codec, err := goavro.NewCodec(jsonResp.Schema)
+	if err != nil {
+		log.Error("Creating Avro codec failed", zap.Error(err))
+		return nil, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+
+	m.cacheRWLock.Lock()
+	m.idCache[registryID] = codec
+	m.cacheRWLock.Unlock()
+
+	log.Info("Avro schema lookup by id successful",
+		zap.Int("registryID", registryID),
+		zap.String("schema", codec.Schema()))
+
+	return codec, nil
+}
+
 // SchemaGenerator represents a function that returns an Avro schema in JSON.
 // Used for lazy evaluation
 type SchemaGenerator func() (string, error)
@@ -303,7 +382,7 @@ type SchemaGenerator func() (string, error)
 // If not, a new schema is generated, registered and cached.
 // Re-registering an existing schema shall return the same id(and version), so even if the
 // cache is out-of-sync with schema registry, we could reload it.
-func (m *schemaManager) GetCachedOrRegister(
+func (m *SchemaManager) GetCachedOrRegister(
 	ctx context.Context,
 	topicName string,
 	tiSchemaID uint64,
@@ -362,7 +441,7 @@ func (m *schemaManager) GetCachedOrRegister(
 // ClearRegistry clears the Registry subject for the given table. Should be idempotent.
 // Exported for testing.
 // NOT USED for now, reserved for future use.
-func (m *schemaManager) ClearRegistry(ctx context.Context, topicName string) error {
+func (m *SchemaManager) ClearRegistry(ctx context.Context, topicName string) error {
 	uri := m.registryURL + "/subjects/" + url.QueryEscape(
 		m.topicNameToSchemaSubject(topicName),
 	)
@@ -460,6 +539,6 @@ func httpRetry(
 }
 
 // TopicNameStrategy, ksqlDB only supports this
-func (m *schemaManager) topicNameToSchemaSubject(topicName string) string {
+func (m *SchemaManager) topicNameToSchemaSubject(topicName string) string {
 	return topicName + m.subjectSuffix
 }
@@ -118,6 +118,23 @@ func startHTTPInterceptForTestingRegistry() {
 			return httpmock.NewJsonResponse(200, &respData)
 		})
 
+	httpmock.RegisterResponder("GET", `=~^http://127.0.0.1:8081/schemas/ids/(\d+)`,
+		func(req *http.Request) (*http.Response, error) {
+			id, err := httpmock.GetSubmatchAsInt(req, 1)
+			if err != nil {
+				return httpmock.NewStringResponse(500, "Internal Server Error"), err
+			}
+
+			registry.mu.Lock()
+			defer registry.mu.Unlock()
+			for _, item := range registry.subjects {
+				if item.ID == int(id) {
+					return httpmock.NewJsonResponse(200, &lookupResponse{Schema: item.content})
+				}
+			}
+			return httpmock.NewStringResponse(404, ""), nil
+		})
+
 	httpmock.RegisterResponder("DELETE", `=~^http://127.0.0.1:8081/subjects/(.+)`,
 		func(req *http.Request) (*http.Response, error) {
 			subject, err := httpmock.GetSubmatch(req, 1)
@@ -20,8 +20,11 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/codec/canal"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/cdc/sink/codec/craft"
+	"github.com/pingcap/tiflow/cdc/sink/codec/csv"
+	"github.com/pingcap/tiflow/cdc/sink/codec/debezium"
 	"github.com/pingcap/tiflow/cdc/sink/codec/maxwell"
 	"github.com/pingcap/tiflow/cdc/sink/codec/open"
+	"github.com/pingcap/tiflow/cdc/sink/codec/protobuf"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 )
@@ -36,11 +39,17 @@ func NewEventBatchEncoderBuilder(ctx context.Context, c *common.Config) (codec.E
 	case config.ProtocolAvro:
 		return avro.NewBatchEncoderBuilder(ctx, c)
 	case config.ProtocolMaxwell:
-		return maxwell.NewBatchEncoderBuilder(), nil
+		return maxwell.NewBatchEncoderBuilder(c), nil
 	case config.ProtocolCanalJSON:
 		return canal.NewJSONBatchEncoderBuilder(c), nil
 	case config.ProtocolCraft:
 		return craft.NewBatchEncoderBuilder(c), nil
+	case config.ProtocolCSV:
+		return csv.NewBatchEncoderBuilder(c), nil
+	case config.ProtocolDebezium:
+		return debezium.NewBatchEncoderBuilder(ctx, c), nil
+	case config.ProtocolProtobuf:
+		return protobuf.NewBatchEncoderBuilder(c), nil
 	default:
 		return nil, cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(c.Protocol)
 	}
@@ -0,0 +1,218 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimcheck
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// The payload stored in the external storage is the original message:
+//
+//	| key length (8, big endian) | key | value |
+const keyLenSize = 8
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Reference is the value of a claim-check reference message,
+// which locates the original message in the external storage.
+type Reference struct {
+	// Storage is the URI of the external storage without any credentials
+	// and options, the consumer can override it if they are required.
+	Storage string `json:"storage"`
+	// File is the name of the payload file relative to Storage.
+	File string `json:"file"`
+	// Size is the size of the payload file in bytes.
+	Size int `json:"size"`
+	// Checksum is the CRC32 (Castagnoli) checksum of the payload file.
+	Checksum uint32 `json:"checksum"`
+}
+
+// ClaimCheck offloads the messages larger than max-message-bytes to
+// the external storage, and replaces them with small reference messages.
+type ClaimCheck struct {
+	changefeedID    model.ChangeFeedID
+	storage         storage.ExternalStorage
+	storageURI      string
+	maxMessageBytes int
+}
+
+// New creates a ClaimCheck that offloads messages to the storage of storageURI.
+func New(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	storageURI string,
+	maxMessageBytes int,
+) (*ClaimCheck, error) {
+	s, err := util.GetExternalStorageFromURI(ctx, storageURI)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	log.Info("claim-check enabled",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("storage", redactStorageURI(storageURI)),
+		zap.Int("maxMessageBytes", maxMessageBytes))
+	return &ClaimCheck{
+		changefeedID:    changefeedID,
+		storage:         s,
+		storageURI:      redactStorageURI(storageURI),
+		maxMessageBytes: maxMessageBytes,
+	}, nil
+}
+
+// Handle offloads the message to the external storage if it is larger than
+// max-message-bytes, and turns the message into a reference in place.
+// Messages within the limit are left untouched.
+func (c *ClaimCheck) Handle(ctx context.Context, message *common.Message) error {
+	if message.Length() <= c.maxMessageBytes {
+		return nil
+	}
+
+	payload := make([]byte, keyLenSize, keyLenSize+len(message.Key)+len(message.Value))
+	binary.BigEndian.PutUint64(payload, uint64(len(message.Key)))
+	payload = append(payload, message.Key...)
+	payload = append(payload, message.Value...)
+
+	ref := Reference{
+		Storage:  c.storageURI,
+		File:     c.fileName(message.Ts),
+		Size:     len(payload),
+		Checksum: crc32.Checksum(payload, crcTable),
+	}
+	if err := c.storage.WriteFile(ctx, ref.File, payload); err != nil {
+		return cerror.WrapError(cerror.ErrClaimCheckStorageAPI, err)
+	}
+	value, err := json.Marshal(ref)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrClaimCheckInvalidReference, err)
+	}
+	log.Debug("claim-check message offloaded",
+		zap.String("namespace", c.changefeedID.Namespace),
+		zap.String("changefeed", c.changefeedID.ID),
+		zap.Int("length", message.Length()),
+		zap.String("file", ref.File))
+
+	message.Value = value
+	message.ClaimCheck = true
+	return nil
+}
+
+func (c *ClaimCheck) fileName(ts uint64) string {
+	return fmt.Sprintf("%s/%s/%d-%s.bin",
+		c.changefeedID.Namespace, c.changefeedID.ID, ts, uuid.New().String())
+}
+
+// Fetcher fetches the original messages of the claim-check references.
+// It is used by the consumers.
+type Fetcher struct {
+	// storageURI overrides the storage of the references if it is not empty.
+	storageURI string
+
+	mu       sync.Mutex
+	storages map[string]storage.ExternalStorage
+}
+
+// NewFetcher creates a Fetcher. If storageURI is not empty, it is used
+// instead of the storage in the references, e.g. to provide credentials.
+func NewFetcher(storageURI string) *Fetcher {
+	return &Fetcher{
+		storageURI: storageURI,
+		storages:   make(map[string]storage.ExternalStorage),
+	}
+}
+
+// Fetch reads the original key and value of the reference message value
+// from the external storage, and verifies its checksum.
+func (f *Fetcher) Fetch(ctx context.Context, value []byte) ([]byte, []byte, error) {
+	ref := &Reference{}
+	if err := json.Unmarshal(value, ref); err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrClaimCheckInvalidReference, err)
+	}
+	if ref.File == "" {
+		return nil, nil, cerror.ErrClaimCheckInvalidReference.GenWithStackByArgs(string(value))
+	}
+
+	s, err := f.getStorage(ctx, ref)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	payload, err := s.ReadFile(ctx, ref.File)
+	if err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrClaimCheckStorageAPI, err)
+	}
+	if checksum := crc32.Checksum(payload, crcTable); checksum != ref.Checksum {
+		return nil, nil, cerror.ErrClaimCheckChecksumMismatch.GenWithStackByArgs(
+			ref.File, ref.Checksum, checksum)
+	}
+	if len(payload) < keyLenSize {
+		return nil, nil, cerror.ErrClaimCheckInvalidReference.GenWithStackByArgs(ref.File)
+	}
+	keyLen := binary.BigEndian.Uint64(payload)
+	if keyLen > uint64(len(payload)-keyLenSize) {
+		return nil, nil, cerror.ErrClaimCheckInvalidReference.GenWithStackByArgs(ref.File)
+	}
+	payload = payload[keyLenSize:]
+	return payload[:keyLen], payload[keyLen:], nil
+}
+
+func (f *Fetcher) getStorage(
+	ctx context.Context, ref *Reference,
+) (storage.ExternalStorage, error) {
+	uri := f.storageURI
+	if uri == "" {
+		uri = ref.Storage
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if s, ok := f.storages[uri]; ok {
+		return s, nil
+	}
+	s, err := util.GetExternalStorageFromURI(ctx, uri)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	f.storages[uri] = s
+	return s, nil
+}
+
+// redactStorageURI removes the user info and query parameters, which may
+// contain credentials, from the storage URI.
+func redactStorageURI(storageURI string) string {
+	u, err := url.Parse(storageURI)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	return strings.TrimSuffix(u.String(), "/")
+}
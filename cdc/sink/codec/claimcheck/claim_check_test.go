@@ -0,0 +1,94 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimcheck
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	changefeedID := model.DefaultChangeFeedID("test")
+	c, err := New(ctx, changefeedID, "file://"+dir, 1024)
+	require.NoError(t, err)
+
+	// Messages within the limit are not offloaded.
+	small := common.NewMsg(config.ProtocolOpen, []byte("key"), []byte("value"),
+		1, model.MessageTypeRow, nil, nil)
+	require.NoError(t, c.Handle(ctx, small))
+	require.False(t, small.ClaimCheck)
+	require.Equal(t, []byte("value"), small.Value)
+
+	key := []byte("large-key")
+	value := []byte(strings.Repeat("v", 2048))
+	large := common.NewMsg(config.ProtocolOpen, key, value,
+		2, model.MessageTypeRow, nil, nil)
+	require.NoError(t, c.Handle(ctx, large))
+	require.True(t, large.ClaimCheck)
+	require.Equal(t, key, large.Key)
+	require.Less(t, large.Length(), 1024)
+
+	ref := &Reference{}
+	require.NoError(t, json.Unmarshal(large.Value, ref))
+	require.Equal(t, "file://"+dir, ref.Storage)
+	require.True(t, strings.HasPrefix(ref.File, "default/test/2-"))
+	require.Equal(t, 8+len(key)+len(value), ref.Size)
+
+	// Fetch with the storage in the reference.
+	fetchedKey, fetchedValue, err := NewFetcher("").Fetch(ctx, large.Value)
+	require.NoError(t, err)
+	require.Equal(t, key, fetchedKey)
+	require.Equal(t, value, fetchedValue)
+
+	// The payload is corrupted.
+	path := filepath.Join(dir, ref.File)
+	payload, err := os.ReadFile(path)
+	require.NoError(t, err)
+	payload[len(payload)-1] = 'x'
+	require.NoError(t, os.WriteFile(path, payload, 0o644))
+	_, _, err = NewFetcher("").Fetch(ctx, large.Value)
+	require.True(t, cerror.ErrClaimCheckChecksumMismatch.Equal(err))
+
+	// The storage in the reference is overridden.
+	_, _, err = NewFetcher("file://"+t.TempDir()).Fetch(ctx, large.Value)
+	require.Regexp(t, ".*ErrClaimCheckStorageAPI.*", err)
+
+	_, _, err = NewFetcher("").Fetch(ctx, []byte("invalid"))
+	require.Regexp(t, ".*ErrClaimCheckInvalidReference.*", err)
+}
+
+func TestRedactStorageURI(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "s3://bucket/prefix", redactStorageURI(
+		"s3://bucket/prefix/?access-key=ak&secret-access-key=sk"))
+	require.Equal(t, "s3://bucket/prefix", redactStorageURI(
+		"s3://user:password@bucket/prefix"))
+	require.Equal(t, "file:///tmp/claim-check", redactStorageURI(
+		"file:///tmp/claim-check"))
+}
@@ -29,17 +29,32 @@ const defaultMaxBatchSize int = 16
 type Config struct {
 	Protocol config.Protocol
 
-	// control batch behavior, only for `open-protocol` and `craft` at the moment.
+	// control batch behavior, only for `open-protocol`, `craft` and `protobuf`
+	// at the moment.
 	MaxMessageBytes int
 	MaxBatchSize    int
 
-	// canal-json only
+	// canal-json, avro and maxwell only
 	EnableTiDBExtension bool
 
 	// avro only
 	AvroSchemaRegistry             string
 	AvroDecimalHandlingMode        string
 	AvroBigintUnsignedHandlingMode string
+	// AvroEnableWatermark makes the avro encoder emit checkpoint and DDL events,
+	// which are not part of the Confluent wire format. It is only used to
+	// replay the changes by the kafka consumer, e.g. in integration tests.
+	AvroEnableWatermark bool
+
+	// ClaimCheckStorageURI is the external storage to offload the messages
+	// larger than MaxMessageBytes to, the encoders allow such messages then.
+	ClaimCheckStorageURI string
+
+	// for sinking to cloud storage
+	Delimiter       string
+	Quote           string
+	NullString      string
+	IncludeCommitTs bool
 }
 
 // NewConfig return a Config for codec
@@ -64,6 +79,7 @@ const (
 	codecOPTAvroDecimalHandlingMode        = "avro-decimal-handling-mode"
 	codecOPTAvroBigintUnsignedHandlingMode = "avro-bigint-unsigned-handling-mode"
 	codecOPTAvroSchemaRegistry             = "schema-registry"
+	codecOPTAvroEnableWatermark            = "avro-enable-watermark"
 )
 
 const (
@@ -112,10 +128,29 @@ func (c *Config) Apply(sinkURI *url.URL, config *config.ReplicaConfig) error {
 		c.AvroBigintUnsignedHandlingMode = s
 	}
 
+	if s := params.Get(codecOPTAvroEnableWatermark); s != "" {
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		c.AvroEnableWatermark = b
+	}
+
 	if config.Sink != nil && config.Sink.SchemaRegistry != "" {
 		c.AvroSchemaRegistry = config.Sink.SchemaRegistry
 	}
 
+	if config.Sink != nil && config.Sink.ClaimCheckStorageURI != "" {
+		c.ClaimCheckStorageURI = config.Sink.ClaimCheckStorageURI
+	}
+
+	if config.Sink != nil && config.Sink.CSVConfig != nil {
+		c.Delimiter = config.Sink.CSVConfig.Delimiter
+		c.Quote = config.Sink.CSVConfig.Quote
+		c.NullString = config.Sink.CSVConfig.NullString
+		c.IncludeCommitTs = config.Sink.CSVConfig.IncludeCommitTs
+	}
+
 	return nil
 }
 
@@ -128,9 +163,21 @@ func (c *Config) WithMaxMessageBytes(bytes int) *Config {
 // Validate the Config
 func (c *Config) Validate() error {
 	if c.EnableTiDBExtension &&
-		!(c.Protocol == config.ProtocolCanalJSON || c.Protocol == config.ProtocolAvro) {
+		!(c.Protocol == config.ProtocolCanalJSON ||
+			c.Protocol == config.ProtocolAvro ||
+			c.Protocol == config.ProtocolMaxwell ||
+			c.Protocol == config.ProtocolDebezium) {
+		return cerror.ErrCodecInvalidConfig.GenWithStack(
+			`enable-tidb-extension only supports canal-json/avro/maxwell/debezium protocol`,
+		)
+	}
+
+	if c.AvroEnableWatermark &&
+		!(c.Protocol == config.ProtocolAvro && c.EnableTiDBExtension) {
 		return cerror.ErrCodecInvalidConfig.GenWithStack(
-			`enable-tidb-extension only supports canal-json/avro protocol`,
+			`%s only supports avro protocol with %s enabled`,
+			codecOPTAvroEnableWatermark,
+			codecOPTEnableTiDBExtension,
 		)
 	}
 
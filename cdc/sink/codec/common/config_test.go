@@ -81,7 +81,7 @@ func TestConfigApplyValidate(t *testing.T) {
 	require.True(t, c.EnableTiDBExtension)
 
 	err = c.Validate()
-	require.ErrorContains(t, err, "enable-tidb-extension only supports canal-json/avro protocol")
+	require.ErrorContains(t, err, "enable-tidb-extension only supports canal-json/avro/maxwell/debezium protocol")
 
 	// avro
 	uri = "kafka://127.0.0.1:9092/abc?protocol=avro"
@@ -169,6 +169,27 @@ func TestConfigApplyValidate(t *testing.T) {
 		`bigint-unsigned-handling-mode value could only be "long" or "string"`,
 	)
 
+	// avro-enable-watermark
+	uri = "kafka://127.0.0.1:9092/abc?protocol=avro&avro-enable-watermark=true"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+
+	c = NewConfig(config.ProtocolAvro)
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	require.True(t, c.AvroEnableWatermark)
+
+	err = c.Validate()
+	require.ErrorContains(t, err, "avro-enable-watermark only supports avro protocol")
+
+	uri = "kafka://127.0.0.1:9092/abc?protocol=avro&avro-enable-watermark=true&enable-tidb-extension=true"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	err = c.Validate()
+	require.NoError(t, err)
+
 	// Illegal max-message-bytes.
 	uri = "kafka://127.0.0.1:9092/abc?kafka-version=2.6.0&max-message-bytes=a"
 	sinkURI, err = url.Parse(uri)
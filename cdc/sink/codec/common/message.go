@@ -29,6 +29,14 @@ import (
 // which will be treated as `version = 2` by sarama producer.
 const MaxRecordOverhead = 5*binary.MaxVarintLen32 + binary.MaxVarintLen64 + 1
 
+// ClaimCheckHeaderKey is the key of the record header that marks a message
+// as a claim-check reference, whose value locates the original message
+// offloaded to the external storage.
+const ClaimCheckHeaderKey = "ticdc-claim-check"
+
+// claimCheckHeaderOverhead is the size of the claim-check record header.
+const claimCheckHeaderOverhead = len(ClaimCheckHeaderKey) + 2*binary.MaxVarintLen32
+
 // Message represents an message to the sink
 type Message struct {
 	Key       []byte
@@ -40,13 +48,20 @@ type Message struct {
 	Protocol  config.Protocol   // protocol
 	rowsCount int               // rows in one Message
 	Callback  func()            // Callback function will be called when the message is sent to the sink.
+	// ClaimCheck indicates the message is a claim-check reference,
+	// it is sent with the `ClaimCheckHeaderKey` record header.
+	ClaimCheck bool
 }
 
 // Length returns the expected size of the Kafka message
-// We didn't append any `Headers` when send the message, so ignore the calculations related to it.
-// If `ProducerMessage` Headers fields used, this method should also adjust.
+// The only `Headers` appended when send the message is the claim-check header,
+// if more `ProducerMessage` Headers fields used, this method should also adjust.
 func (m *Message) Length() int {
-	return len(m.Key) + len(m.Value) + MaxRecordOverhead
+	length := len(m.Key) + len(m.Value) + MaxRecordOverhead
+	if m.ClaimCheck {
+		length += claimCheckHeaderOverhead
+	}
+	return length
 }
 
 // PhysicalTime returns physical time part of Ts in time.Time
@@ -116,8 +116,8 @@ func (b *batchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
 	return event, nil
 }
 
-// newBatchDecoder creates a new batchDecoder.
-func newBatchDecoder(bits []byte) (codec.EventBatchDecoder, error) {
+// NewBatchDecoder creates a new batchDecoder.
+func NewBatchDecoder(bits []byte) (codec.EventBatchDecoder, error) {
 	return NewBatchDecoderWithAllocator(bits, NewSliceAllocator(64))
 }
 
@@ -76,7 +76,7 @@ func TestCraftMaxBatchSize(t *testing.T) {
 	messages := encoder.Build()
 	sum := 0
 	for _, msg := range messages {
-		decoder, err := newBatchDecoder(msg.Value)
+		decoder, err := NewBatchDecoder(msg.Value)
 		require.Nil(t, err)
 		count := 0
 		for {
@@ -207,7 +207,7 @@ func testBatchCodec(
 func TestDefaultCraftBatchCodec(t *testing.T) {
 	cfg := common.NewConfig(config.ProtocolCraft).WithMaxMessageBytes(8192)
 	cfg.MaxBatchSize = 64
-	testBatchCodec(t, NewBatchEncoderBuilder(cfg), newBatchDecoder)
+	testBatchCodec(t, NewBatchEncoderBuilder(cfg), NewBatchDecoder)
 }
 
 func TestCraftAppendRowChangedEventWithCallback(t *testing.T) {
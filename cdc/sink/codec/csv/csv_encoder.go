@@ -0,0 +1,106 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+)
+
+// BatchEncoder encodes the events into the byte of a batch into.
+// Each row is encoded into one message, the DDL and checkpoint events
+// are not supported by csv protocol, they are handled by the sink itself.
+type BatchEncoder struct {
+	csvConfig   *common.Config
+	messageBuf  []*common.Message
+	callbackBuf []func()
+}
+
+// EncodeCheckpointEvent implements the EventBatchEncoder interface
+func (b *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	// Unsupported
+	return nil, nil
+}
+
+// AppendRowChangedEvent implements the EventBatchEncoder interface
+func (b *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context,
+	_ string,
+	e *model.RowChangedEvent,
+	callback func(),
+) error {
+	row, err := rowChangedEvent2CSVMsg(b.csvConfig, e)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	msg := common.NewMsg(config.ProtocolCSV, nil, row.encode(), e.CommitTs,
+		model.MessageTypeRow, &e.Table.Schema, &e.Table.Table)
+	msg.IncRowsCount()
+	b.messageBuf = append(b.messageBuf, msg)
+	if callback != nil {
+		b.callbackBuf = append(b.callbackBuf, callback)
+	}
+	return nil
+}
+
+// EncodeDDLEvent implements the EventBatchEncoder interface
+func (b *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
+	// Unsupported
+	return nil, nil
+}
+
+// Build implements the EventBatchEncoder interface
+func (b *BatchEncoder) Build() []*common.Message {
+	if len(b.messageBuf) == 0 {
+		return nil
+	}
+
+	ret := b.messageBuf
+	if len(b.callbackBuf) != 0 && len(b.callbackBuf) == len(ret) {
+		for i, cb := range b.callbackBuf {
+			ret[i].Callback = cb
+		}
+	}
+	b.messageBuf = make([]*common.Message, 0)
+	b.callbackBuf = make([]func(), 0)
+	return ret
+}
+
+// newBatchEncoder creates a new csv BatchEncoder.
+func newBatchEncoder(config *common.Config) codec.EventBatchEncoder {
+	return &BatchEncoder{
+		csvConfig:   config,
+		messageBuf:  make([]*common.Message, 0),
+		callbackBuf: make([]func(), 0),
+	}
+}
+
+type batchEncoderBuilder struct {
+	config *common.Config
+}
+
+// NewBatchEncoderBuilder creates a csv batchEncoderBuilder.
+func NewBatchEncoderBuilder(config *common.Config) codec.EncoderBuilder {
+	return &batchEncoderBuilder{config: config}
+}
+
+// Build a csv BatchEncoder
+func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
+	return newBatchEncoder(b.config)
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVBatchEncoder(t *testing.T) {
+	t.Parallel()
+
+	encoder := NewBatchEncoderBuilder(newTestConfig("\"", false)).Build()
+
+	msg, err := encoder.EncodeCheckpointEvent(1)
+	require.Nil(t, err)
+	require.Nil(t, msg)
+	msg, err = encoder.EncodeDDLEvent(&model.DDLEvent{})
+	require.Nil(t, err)
+	require.Nil(t, msg)
+	require.Nil(t, encoder.Build())
+
+	called := 0
+	for i := 0; i < 3; i++ {
+		err := encoder.AppendRowChangedEvent(context.Background(), "", &model.RowChangedEvent{
+			CommitTs: uint64(i),
+			Table:    &model.TableName{Schema: "test", Table: "t"},
+			Columns: []*model.Column{
+				{Name: "id", Type: mysql.TypeLong, Value: int64(i)},
+			},
+		}, func() { called++ })
+		require.Nil(t, err)
+	}
+
+	messages := encoder.Build()
+	require.Len(t, messages, 3)
+	for _, m := range messages {
+		require.Equal(t, 1, m.GetRowsCount())
+		m.Callback()
+	}
+	require.Equal(t, 3, called)
+	require.Equal(t, `"I","t","test",2`, string(messages[2].Value))
+	require.Nil(t, encoder.Build())
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// operation specifies the operation type
+type operation int
+
+// enum types of operation
+const (
+	operationInsert operation = iota
+	operationDelete
+	operationUpdate
+)
+
+func (o operation) String() string {
+	switch o {
+	case operationInsert:
+		return "I"
+	case operationDelete:
+		return "D"
+	case operationUpdate:
+		return "U"
+	default:
+		return "unknown"
+	}
+}
+
+// csvMessage is a row of the csv file, its layout is:
+// operation-type, table-name, schema-name, [commit-ts,] column values...
+type csvMessage struct {
+	// csvConfig holds the csv configuration items.
+	csvConfig *common.Config
+	// opType denotes the specific operation type.
+	opType     operation
+	tableName  string
+	schemaName string
+	commitTs   uint64
+	columns    []any
+}
+
+func newCSVMessage(config *common.Config) *csvMessage {
+	return &csvMessage{
+		csvConfig: config,
+	}
+}
+
+// encode returns the encoded csv row without the line terminator.
+func (c *csvMessage) encode() []byte {
+	strBuilder := new(strings.Builder)
+	c.formatValue(c.opType.String(), strBuilder)
+	c.formatValue(c.tableName, strBuilder)
+	c.formatValue(c.schemaName, strBuilder)
+	if c.csvConfig.IncludeCommitTs {
+		c.formatValue(c.commitTs, strBuilder)
+	}
+	for _, col := range c.columns {
+		c.formatValue(col, strBuilder)
+	}
+	return []byte(strBuilder.String())
+}
+
+// formatWithQuotes appends the quoted value, the quote characters
+// in the value are escaped by doubling them.
+func (c *csvMessage) formatWithQuotes(value string, strBuilder *strings.Builder) {
+	quote := c.csvConfig.Quote
+
+	strBuilder.WriteString(quote)
+	// replace any quote in csv column with two quotes.
+	strBuilder.WriteString(strings.ReplaceAll(value, quote, quote+quote))
+	strBuilder.WriteString(quote)
+}
+
+// formatWithEscapes appends the value, escaping the delimiter and
+// line break characters with a backslash, which is used when
+// no quote character is configured.
+func (c *csvMessage) formatWithEscapes(value string, strBuilder *strings.Builder) {
+	delimiter := c.csvConfig.Delimiter
+	for _, ch := range value {
+		switch {
+		case ch == '\r':
+			strBuilder.WriteString("\\r")
+		case ch == '\n':
+			strBuilder.WriteString("\\n")
+		case ch == '\\':
+			strBuilder.WriteString("\\\\")
+		case string(ch) == delimiter:
+			strBuilder.WriteString("\\" + delimiter)
+		default:
+			strBuilder.WriteRune(ch)
+		}
+	}
+}
+
+// formatValue appends the value and a delimiter in front of it
+// if it is not the first field of the row.
+func (c *csvMessage) formatValue(value any, strBuilder *strings.Builder) {
+	if strBuilder.Len() != 0 {
+		strBuilder.WriteString(c.csvConfig.Delimiter)
+	}
+
+	if value == nil {
+		strBuilder.WriteString(c.csvConfig.NullString)
+		return
+	}
+
+	switch v := value.(type) {
+	case string:
+		// if quote is configured, format the csv column with quotes,
+		// otherwise escape this csv column.
+		if len(c.csvConfig.Quote) != 0 {
+			c.formatWithQuotes(v, strBuilder)
+		} else {
+			c.formatWithEscapes(v, strBuilder)
+		}
+	default:
+		strBuilder.WriteString(model.ColumnValueString(v))
+	}
+}
+
+// fromColValToCsvVal converts a column value to the value written in csv.
+// String values are returned as string, so they can be quoted or escaped,
+// binary values are encoded in base64.
+func fromColValToCsvVal(col *model.Column) (any, error) {
+	if col.Value == nil {
+		return nil, nil
+	}
+
+	switch col.Type {
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		v, ok := col.Value.([]byte)
+		if !ok {
+			return nil, cerror.ErrCSVEncodeFailed.GenWithStack(
+				"unexpected value type %T for column %s", col.Value, col.Name)
+		}
+		if col.Flag.IsBinary() {
+			return base64.StdEncoding.EncodeToString(v), nil
+		}
+		return string(v), nil
+	case mysql.TypeFloat:
+		if v, ok := col.Value.(float64); ok {
+			return strconv.FormatFloat(v, 'f', -1, 32), nil
+		}
+		return col.Value, nil
+	default:
+		if v, ok := col.Value.(string); ok {
+			return v, nil
+		}
+		return col.Value, nil
+	}
+}
+
+// rowChangedEvent2CSVMsg converts a RowChangedEvent to the csv message.
+func rowChangedEvent2CSVMsg(csvConfig *common.Config, e *model.RowChangedEvent) (*csvMessage, error) {
+	csvMsg := newCSVMessage(csvConfig)
+	csvMsg.tableName = e.Table.Table
+	csvMsg.schemaName = e.Table.Schema
+	csvMsg.commitTs = e.CommitTs
+
+	columns := e.Columns
+	switch {
+	case e.IsDelete():
+		csvMsg.opType = operationDelete
+		columns = e.PreColumns
+	case e.IsUpdate():
+		csvMsg.opType = operationUpdate
+	default:
+		csvMsg.opType = operationInsert
+	}
+
+	csvMsg.columns = make([]any, 0, len(columns))
+	for _, col := range columns {
+		// skip the columns removed by the column selectors or not visible.
+		if col == nil {
+			continue
+		}
+		val, err := fromColValToCsvVal(col)
+		if err != nil {
+			return nil, err
+		}
+		csvMsg.columns = append(csvMsg.columns, val)
+	}
+	return csvMsg, nil
+}
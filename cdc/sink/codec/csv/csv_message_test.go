@@ -0,0 +1,111 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig(quote string, includeCommitTs bool) *common.Config {
+	c := common.NewConfig(config.ProtocolCSV)
+	c.Delimiter = ","
+	c.Quote = quote
+	c.NullString = "\\N"
+	c.IncludeCommitTs = includeCommitTs
+	return c
+}
+
+func TestCSVMessageEncode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		msg      *csvMessage
+		expected string
+	}{
+		{
+			name: "quoted",
+			msg: &csvMessage{
+				csvConfig:  newTestConfig("\"", true),
+				opType:     operationInsert,
+				tableName:  "table1",
+				schemaName: "test",
+				commitTs:   435661838416609281,
+				columns:    []any{int64(1), "a\"b,c", nil, 1.5},
+			},
+			expected: `"I","table1","test",435661838416609281,1,"a""b,c",\N,1.5`,
+		},
+		{
+			name: "escaped",
+			msg: &csvMessage{
+				csvConfig:  newTestConfig("", false),
+				opType:     operationDelete,
+				tableName:  "table1",
+				schemaName: "test",
+				columns:    []any{"a,b\nc\\d"},
+			},
+			expected: `D,table1,test,a\,b\nc\\d`,
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, string(tc.msg.encode()), tc.name)
+	}
+}
+
+func TestRowChangedEvent2CSVMsg(t *testing.T) {
+	t.Parallel()
+
+	cfg := newTestConfig("\"", false)
+	row := &model.RowChangedEvent{
+		CommitTs: 100,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("old")},
+		},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: int64(1)},
+			nil,
+			{
+				Name: "bin", Type: mysql.TypeBlob,
+				Flag: model.BinaryFlag, Value: []byte{0x1, 0x2},
+			},
+		},
+	}
+
+	msg, err := rowChangedEvent2CSVMsg(cfg, row)
+	require.Nil(t, err)
+	require.Equal(t, operationUpdate, msg.opType)
+	// the nil column is skipped.
+	require.Equal(t, []any{int64(1), "AQI="}, msg.columns)
+
+	row.Columns = nil
+	msg, err = rowChangedEvent2CSVMsg(cfg, row)
+	require.Nil(t, err)
+	require.Equal(t, operationDelete, msg.opType)
+	require.Equal(t, `"D","t","test",1,"old"`, string(msg.encode()))
+
+	row.PreColumns = []*model.Column{
+		{Name: "name", Type: mysql.TypeVarchar, Value: "not bytes"},
+	}
+	_, err = rowChangedEvent2CSVMsg(cfg, row)
+	require.Regexp(t, ".*CDC:ErrCSVEncodeFailed.*", err)
+}
@@ -0,0 +1,269 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// batchDecoder decodes a Debezium message into the original event. Every
+// message carries exactly one event, and a tombstone carries none.
+type batchDecoder struct {
+	key   []byte
+	value []byte
+	tz    *time.Location
+
+	msgType model.MessageType
+	schema  *field
+	payload json.RawMessage
+}
+
+// NewBatchDecoder creates a new batchDecoder, the TIMESTAMP values are
+// converted into the given timezone.
+func NewBatchDecoder(key, value []byte, tz *time.Location) codec.EventBatchDecoder {
+	if tz == nil {
+		tz = time.UTC
+	}
+	return &batchDecoder{key: key, value: value, tz: tz}
+}
+
+// HasNext implements the EventBatchDecoder interface
+func (b *batchDecoder) HasNext() (model.MessageType, bool, error) {
+	if b.payload != nil {
+		return b.msgType, true, nil
+	}
+	if len(b.value) == 0 {
+		return model.MessageTypeUnknown, false, nil
+	}
+	var msg struct {
+		Schema  *field          `json:"schema"`
+		Payload json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(b.value, &msg); err != nil {
+		return model.MessageTypeUnknown, false,
+			cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	var header struct {
+		Op  string `json:"op"`
+		DDL string `json:"ddl"`
+	}
+	if err := json.Unmarshal(msg.Payload, &header); err != nil {
+		return model.MessageTypeUnknown, false,
+			cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	switch header.Op {
+	case opCreate, opUpdate, opDelete:
+		b.msgType = model.MessageTypeRow
+	case opWatermark:
+		b.msgType = model.MessageTypeResolved
+	case "":
+		if header.DDL == "" {
+			return model.MessageTypeUnknown, false,
+				cerror.ErrDebeziumInvalidData.GenWithStack("unknown message %s", b.value)
+		}
+		b.msgType = model.MessageTypeDDL
+	default:
+		return model.MessageTypeUnknown, false,
+			cerror.ErrDebeziumInvalidData.GenWithStack("unknown operation %s", header.Op)
+	}
+	b.schema = msg.Schema
+	b.payload = msg.Payload
+	return b.msgType, true, nil
+}
+
+// NextResolvedEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextResolvedEvent() (uint64, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeResolved {
+		return 0, cerror.ErrDebeziumInvalidData.GenWithStack("not found resolved event message")
+	}
+	payload := &rowPayload{}
+	if err := b.unmarshal(payload); err != nil {
+		return 0, errors.Trace(err)
+	}
+	if payload.Source == nil {
+		return 0, cerror.ErrDebeziumInvalidData.GenWithStack("source not found")
+	}
+	return payload.Source.CommitTs, nil
+}
+
+// NextRowChangedEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeRow {
+		return nil, cerror.ErrDebeziumInvalidData.GenWithStack("not found row changed event message")
+	}
+	payload := &rowPayload{}
+	if err := b.unmarshal(payload); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if payload.Source == nil || payload.Source.Table == nil {
+		return nil, cerror.ErrDebeziumInvalidData.GenWithStack("source not found")
+	}
+	fields, err := b.rowFields()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	handleKeys, err := b.handleKeys()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	event := &model.RowChangedEvent{
+		CommitTs: payload.Source.CommitTs,
+		Table: &model.TableName{
+			Schema: payload.Source.DB,
+			Table:  *payload.Source.Table,
+		},
+	}
+	if event.PreColumns, err = b.decodeColumns(payload.Before, fields, handleKeys); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if event.Columns, err = b.decodeColumns(payload.After, fields, handleKeys); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return event, nil
+}
+
+// NextDDLEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeDDL {
+		return nil, cerror.ErrDebeziumInvalidData.GenWithStack("not found ddl event message")
+	}
+	payload := &ddlPayload{}
+	if err := b.unmarshal(payload); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if payload.Source == nil {
+		return nil, cerror.ErrDebeziumInvalidData.GenWithStack("source not found")
+	}
+
+	event := &model.DDLEvent{
+		StartTs:  payload.Source.CommitTs,
+		CommitTs: payload.Source.CommitTs,
+		Query:    payload.DDL,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: payload.DatabaseName,
+		},
+		Type: timodel.ActionNone,
+	}
+	if payload.Source.Table != nil {
+		event.TableInfo.Table = *payload.Source.Table
+	}
+	for _, change := range payload.TableChanges {
+		switch change.Type {
+		case tableChangeCreate:
+			event.Type = timodel.ActionCreateTable
+		case tableChangeDrop:
+			event.Type = timodel.ActionDropTable
+		}
+	}
+	return event, nil
+}
+
+func (b *batchDecoder) unmarshal(v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(b.payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	// Every message carries only one event.
+	b.payload = nil
+	b.value = nil
+	return nil
+}
+
+// rowFields returns the schema of the columns from the envelope schema.
+func (b *batchDecoder) rowFields() ([]*field, error) {
+	if b.schema == nil {
+		return nil, cerror.ErrDebeziumInvalidData.GenWithStack("schema not found")
+	}
+	for _, f := range b.schema.Fields {
+		if f.Field == "after" || f.Field == "before" {
+			return f.Fields, nil
+		}
+	}
+	return nil, cerror.ErrDebeziumInvalidData.GenWithStack("row schema not found")
+}
+
+// handleKeys returns the names of the columns in the key message.
+func (b *batchDecoder) handleKeys() (map[string]struct{}, error) {
+	keys := make(map[string]struct{})
+	if len(b.key) == 0 {
+		return keys, nil
+	}
+	var key struct {
+		Schema *field `json:"schema"`
+	}
+	if err := json.Unmarshal(b.key, &key); err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	if key.Schema != nil {
+		for _, f := range key.Schema.Fields {
+			keys[f.Field] = struct{}{}
+		}
+	}
+	return keys, nil
+}
+
+func (b *batchDecoder) decodeColumns(
+	values map[string]interface{}, fields []*field, handleKeys map[string]struct{},
+) ([]*model.Column, error) {
+	if values == nil {
+		return nil, nil
+	}
+	cols := make([]*model.Column, 0, len(fields))
+	for _, f := range fields {
+		value, ok := values[f.Field]
+		if !ok {
+			continue
+		}
+		tp, flag := parseSourceColumnType(f.Parameters[paramColumnType])
+		if f.Optional {
+			flag.SetIsNullable()
+		}
+		if _, ok := handleKeys[f.Field]; ok {
+			flag.SetIsHandleKey()
+		}
+		v, err := decodeColumnValue(value, f, flag, b.tz)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		cols = append(cols, &model.Column{
+			Name:  f.Field,
+			Type:  tp,
+			Flag:  flag,
+			Value: v,
+		})
+	}
+	return cols, nil
+}
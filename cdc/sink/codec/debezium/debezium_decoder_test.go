@@ -0,0 +1,143 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRowChangedEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := newTestEncoder(false)
+	id := &model.Column{
+		Name:  "id",
+		Type:  mysql.TypeLonglong,
+		Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag | model.UnsignedFlag,
+		Value: uint64(18446744073709551615),
+	}
+	insert := &model.RowChangedEvent{
+		CommitTs: 417318403368288260,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			id, {Name: "name", Type: mysql.TypeVarchar, Flag: model.NullableFlag, Value: []byte("aa")},
+		},
+	}
+	update := &model.RowChangedEvent{
+		CommitTs:   417318403368288261,
+		Table:      &model.TableName{Schema: "a", Table: "b"},
+		PreColumns: insert.Columns,
+		Columns: []*model.Column{
+			id, {Name: "name", Type: mysql.TypeVarchar, Flag: model.NullableFlag, Value: nil},
+		},
+	}
+	del := &model.RowChangedEvent{
+		CommitTs:   417318403368288262,
+		Table:      &model.TableName{Schema: "a", Table: "b"},
+		PreColumns: update.Columns,
+	}
+	for _, row := range []*model.RowChangedEvent{insert, update, del} {
+		require.Nil(t, encoder.AppendRowChangedEvent(context.Background(), "", row, nil))
+	}
+	messages := encoder.Build()
+	require.Len(t, messages, 4)
+
+	expected := []*model.RowChangedEvent{insert, update, del, nil}
+	for i, m := range messages {
+		decoder := NewBatchDecoder(m.Key, m.Value, time.UTC)
+		tp, hasNext, err := decoder.HasNext()
+		require.Nil(t, err)
+		if expected[i] == nil {
+			// The tombstone carries no event.
+			require.False(t, hasNext)
+			continue
+		}
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+		row, err := decoder.NextRowChangedEvent()
+		require.Nil(t, err)
+		require.Equal(t, expected[i].CommitTs, row.CommitTs)
+		require.Equal(t, expected[i].Table, row.Table)
+		require.Len(t, row.PreColumns, len(expected[i].PreColumns))
+		require.Len(t, row.Columns, len(expected[i].Columns))
+		for _, cols := range [][]*model.Column{row.PreColumns, row.Columns} {
+			if len(cols) == 0 {
+				continue
+			}
+			require.Equal(t, "id", cols[0].Name)
+			require.Equal(t, mysql.TypeLonglong, cols[0].Type)
+			require.True(t, cols[0].Flag.IsHandleKey())
+			require.True(t, cols[0].Flag.IsUnsigned())
+			require.Equal(t, uint64(18446744073709551615), cols[0].Value)
+			require.Equal(t, "name", cols[1].Name)
+			require.True(t, cols[1].Flag.IsNullable())
+		}
+		_, hasNext, err = decoder.HasNext()
+		require.Nil(t, err)
+		require.False(t, hasNext)
+	}
+}
+
+func TestDecodeDDLAndCheckpointEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := newTestEncoder(true)
+	ddl := &model.DDLEvent{
+		CommitTs: 417318403368288260,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "a",
+			Table:  "b",
+			ColumnInfo: []*model.ColumnInfo{
+				{Name: "id", Type: mysql.TypeLong},
+			},
+		},
+		Query: "create table a.b(id int primary key)",
+		Type:  timodel.ActionCreateTable,
+	}
+	m, err := encoder.EncodeDDLEvent(ddl)
+	require.Nil(t, err)
+	decoder := NewBatchDecoder(m.Key, m.Value, time.UTC)
+	tp, hasNext, err := decoder.HasNext()
+	require.Nil(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeDDL, tp)
+	decoded, err := decoder.NextDDLEvent()
+	require.Nil(t, err)
+	require.Equal(t, ddl.CommitTs, decoded.CommitTs)
+	require.Equal(t, ddl.Query, decoded.Query)
+	require.Equal(t, ddl.Type, decoded.Type)
+	require.Equal(t, "a", decoded.TableInfo.Schema)
+	require.Equal(t, "b", decoded.TableInfo.Table)
+
+	m, err = encoder.EncodeCheckpointEvent(417318403368288261)
+	require.Nil(t, err)
+	decoder = NewBatchDecoder(m.Key, m.Value, time.UTC)
+	tp, hasNext, err = decoder.HasNext()
+	require.Nil(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeResolved, tp)
+	ts, err := decoder.NextResolvedEvent()
+	require.Nil(t, err)
+	require.Equal(t, uint64(417318403368288261), ts)
+
+	_, err = decoder.NextResolvedEvent()
+	require.Regexp(t, ".*ErrDebeziumInvalidData.*", err)
+}
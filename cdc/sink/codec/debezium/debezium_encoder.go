@@ -0,0 +1,363 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	parser_types "github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/version"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// BatchEncoder encodes the events into the JSON format of the Debezium
+// MySQL connector. Every message carries its schema as the JsonConverter
+// of Kafka Connect does with `schemas.enable=true`, and a delete is followed
+// by a tombstone so that the topic can be compacted.
+type BatchEncoder struct {
+	// serverName is the logical name of the source server, it is the
+	// prefix of the schema names.
+	serverName string
+	tz         *time.Location
+
+	messages []*common.Message
+
+	enableTiDBExtension bool
+}
+
+// EncodeCheckpointEvent implements the EventBatchEncoder interface
+func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	// There is no such a corresponding type to ResolvedEvent in Debezium,
+	// the event is ignored unless the TiDB extension is enabled.
+	if !d.enableTiDBExtension {
+		return nil, nil
+	}
+	value, err := json.Marshal(&message{
+		Payload: &rowPayload{
+			Source: d.newSource("", "", ts),
+			Op:     opWatermark,
+			TsMs:   time.Now().UnixMilli(),
+		},
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+	return common.NewResolvedMsg(config.ProtocolDebezium, nil, value, ts), nil
+}
+
+// AppendRowChangedEvent implements the EventBatchEncoder interface
+func (d *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context,
+	_ string,
+	e *model.RowChangedEvent,
+	callback func(),
+) error {
+	key, err := d.encodeKey(e)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	value, err := d.encodeValue(e)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	m := common.NewMsg(config.ProtocolDebezium, key, value, e.CommitTs,
+		model.MessageTypeRow, &e.Table.Schema, &e.Table.Table)
+	m.IncRowsCount()
+	d.messages = append(d.messages, m)
+	if e.IsDelete() && key != nil {
+		// The tombstone tells the log compaction to remove all the messages
+		// of the key.
+		d.messages = append(d.messages, common.NewMsg(config.ProtocolDebezium,
+			key, nil, e.CommitTs, model.MessageTypeRow, &e.Table.Schema, &e.Table.Table))
+	}
+	// The callback is attached to the last message of the event.
+	d.messages[len(d.messages)-1].Callback = callback
+	return nil
+}
+
+// EncodeDDLEvent implements the EventBatchEncoder interface
+func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
+	key, err := json.Marshal(&message{
+		Schema: &field{
+			Type: "struct",
+			Name: schemaChangeKeyName,
+			Fields: []*field{
+				{Type: "string", Field: "databaseName"},
+			},
+		},
+		Payload: map[string]string{"databaseName": e.TableInfo.Schema},
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+
+	payload := &ddlPayload{
+		Source:       d.newSource(e.TableInfo.Schema, e.TableInfo.Table, e.CommitTs),
+		TsMs:         time.Now().UnixMilli(),
+		DatabaseName: e.TableInfo.Schema,
+		DDL:          e.Query,
+		TableChanges: []*tableChange{},
+	}
+	if change := newTableChange(e); change != nil {
+		payload.TableChanges = append(payload.TableChanges, change)
+	}
+	value, err := json.Marshal(&message{Schema: ddlSchema, Payload: payload})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+	return common.NewDDLMsg(config.ProtocolDebezium, key, value, e), nil
+}
+
+// Build implements the EventBatchEncoder interface
+func (d *BatchEncoder) Build() []*common.Message {
+	if len(d.messages) == 0 {
+		return nil
+	}
+	ret := d.messages
+	d.messages = nil
+	return ret
+}
+
+func (d *BatchEncoder) schemaPrefix(schema, table string) string {
+	return fmt.Sprintf("%s.%s.%s", d.serverName, schema, table)
+}
+
+func (d *BatchEncoder) newSource(schema, table string, commitTs uint64) *source {
+	s := &source{
+		Version:   version.ReleaseVersion,
+		Connector: connectorName,
+		Name:      d.serverName,
+		TsMs:      oracle.ExtractPhysical(commitTs),
+		Snapshot:  "false",
+		DB:        schema,
+		CommitTs:  commitTs,
+	}
+	if table != "" {
+		s.Table = &table
+	}
+	return s
+}
+
+// encodeKey returns the key message which consists of the handle key
+// columns, or nil if the table has no handle key.
+func (d *BatchEncoder) encodeKey(e *model.RowChangedEvent) ([]byte, error) {
+	cols := e.Columns
+	if e.IsDelete() {
+		cols = e.PreColumns
+	}
+	fts := fieldTypes(e, cols)
+	schema := &field{
+		Type: "struct",
+		Name: d.schemaPrefix(e.Table.Schema, e.Table.Table) + ".Key",
+	}
+	payload := make(map[string]interface{})
+	for i, col := range cols {
+		if col == nil || !col.Flag.IsHandleKey() {
+			continue
+		}
+		ft := fts[i]
+		f := columnSchema(col, ft)
+		value, err := columnValue(col, f, ft, d.tz)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		schema.Fields = append(schema.Fields, f)
+		payload[col.Name] = value
+	}
+	if len(schema.Fields) == 0 {
+		return nil, nil
+	}
+	key, err := json.Marshal(&message{Schema: schema, Payload: payload})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+	return key, nil
+}
+
+func (d *BatchEncoder) encodeValue(e *model.RowChangedEvent) ([]byte, error) {
+	prefix := d.schemaPrefix(e.Table.Schema, e.Table.Table)
+	rowSchema := &field{Type: "struct", Name: prefix + ".Value", Optional: true}
+	payload := &rowPayload{
+		Source: d.newSource(e.Table.Schema, e.Table.Table, e.CommitTs),
+		TsMs:   time.Now().UnixMilli(),
+	}
+	switch {
+	case e.IsDelete():
+		payload.Op = opDelete
+	case len(e.PreColumns) != 0:
+		payload.Op = opUpdate
+	default:
+		payload.Op = opCreate
+	}
+
+	schemaCols := e.Columns
+	if e.IsDelete() {
+		schemaCols = e.PreColumns
+	}
+	fts := fieldTypes(e, schemaCols)
+	fields := make([]*field, len(schemaCols))
+	for i, col := range schemaCols {
+		if col == nil {
+			continue
+		}
+		fields[i] = columnSchema(col, fts[i])
+		rowSchema.Fields = append(rowSchema.Fields, fields[i])
+	}
+
+	convert := func(cols []*model.Column) (map[string]interface{}, error) {
+		if len(cols) == 0 {
+			return nil, nil
+		}
+		values := make(map[string]interface{}, len(cols))
+		for i, col := range cols {
+			if col == nil || i >= len(fields) || fields[i] == nil {
+				continue
+			}
+			value, err := columnValue(col, fields[i], fts[i], d.tz)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			values[col.Name] = value
+		}
+		return values, nil
+	}
+	var err error
+	if payload.Before, err = convert(e.PreColumns); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if payload.After, err = convert(e.Columns); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	before := *rowSchema
+	before.Field = "before"
+	after := *rowSchema
+	after.Field = "after"
+	schema := &field{
+		Type:    "struct",
+		Name:    prefix + ".Envelope",
+		Version: 1,
+		Fields: []*field{
+			&before,
+			&after,
+			sourceSchema,
+			{Type: "string", Field: "op"},
+			{Type: "int64", Field: "ts_ms", Optional: true},
+			transactionSchema,
+		},
+	}
+	value, err := json.Marshal(&message{Schema: schema, Payload: payload})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+	return value, nil
+}
+
+// fieldTypes returns the field types of the columns, which are nil if the
+// column infos are unavailable.
+func fieldTypes(e *model.RowChangedEvent, cols []*model.Column) []*types.FieldType {
+	fts := make([]*types.FieldType, len(cols))
+	if len(e.ColInfos) == len(cols) {
+		for i := range e.ColInfos {
+			fts[i] = e.ColInfos[i].Ft
+		}
+	}
+	return fts
+}
+
+var ddlSchema = &field{
+	Type: "struct",
+	Name: schemaChangeValueName,
+	Fields: []*field{
+		sourceSchema,
+		{Type: "int64", Field: "ts_ms", Optional: true},
+		{Type: "string", Field: "databaseName", Optional: true},
+		{Type: "string", Field: "schemaName", Optional: true},
+		{Type: "string", Field: "ddl", Optional: true},
+		{
+			Type:  "array",
+			Field: "tableChanges",
+		},
+	},
+}
+
+// newTableChange describes the table affected by the DDL, it returns nil
+// if the DDL changes no table.
+func newTableChange(e *model.DDLEvent) *tableChange {
+	if e.TableInfo.Table == "" {
+		return nil
+	}
+	change := &tableChange{
+		Type: tableChangeAlter,
+		ID:   fmt.Sprintf("%q.%q", e.TableInfo.Schema, e.TableInfo.Table),
+	}
+	switch e.Type {
+	case timodel.ActionCreateTable:
+		change.Type = tableChangeCreate
+	case timodel.ActionDropTable:
+		change.Type = tableChangeDrop
+		return change
+	}
+	change.Table = &tableInfo{Columns: make([]*tableColumn, 0, len(e.TableInfo.ColumnInfo))}
+	for i, col := range e.TableInfo.ColumnInfo {
+		change.Table.Columns = append(change.Table.Columns, &tableColumn{
+			Name:     col.Name,
+			TypeName: strings.ToUpper(parser_types.TypeToStr(col.Type, "")),
+			Position: i + 1,
+		})
+	}
+	return change
+}
+
+type batchEncoderBuilder struct {
+	serverName string
+	tz         *time.Location
+	config     *common.Config
+}
+
+// NewBatchEncoderBuilder creates a debezium batchEncoderBuilder.
+func NewBatchEncoderBuilder(ctx context.Context, config *common.Config) codec.EncoderBuilder {
+	tz := contextutil.TimezoneFromCtx(ctx)
+	if tz == nil {
+		tz = time.UTC
+	}
+	return &batchEncoderBuilder{
+		serverName: contextutil.ChangefeedIDFromCtx(ctx).ID,
+		tz:         tz,
+		config:     config,
+	}
+}
+
+// Build a `BatchEncoder`
+func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
+	return &BatchEncoder{
+		serverName:          b.serverName,
+		tz:                  b.tz,
+		enableTiDBExtension: b.config.EnableTiDBExtension,
+	}
+}
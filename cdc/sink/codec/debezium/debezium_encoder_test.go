@@ -0,0 +1,143 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEncoder(enableTiDBExtension bool) *BatchEncoder {
+	ctx := contextutil.PutChangefeedIDInCtx(context.Background(),
+		model.DefaultChangeFeedID("test-cf"))
+	ctx = contextutil.PutTimezoneInCtx(ctx, time.UTC)
+	cfg := common.NewConfig(config.ProtocolDebezium)
+	cfg.EnableTiDBExtension = enableTiDBExtension
+	return NewBatchEncoderBuilder(ctx, cfg).Build().(*BatchEncoder)
+}
+
+func TestEncodeRowChangedEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := newTestEncoder(false)
+	id := &model.Column{
+		Name:  "id",
+		Type:  mysql.TypeLong,
+		Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+		Value: int64(1),
+	}
+	insert := &model.RowChangedEvent{
+		CommitTs: 417318403368288260,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			id, {Name: "name", Type: mysql.TypeVarchar, Flag: model.NullableFlag, Value: []byte("aa")},
+		},
+	}
+	del := &model.RowChangedEvent{
+		CommitTs:   417318403368288261,
+		Table:      &model.TableName{Schema: "a", Table: "b"},
+		PreColumns: insert.Columns,
+	}
+	callbacks := 0
+	callback := func() { callbacks++ }
+	require.Nil(t, encoder.AppendRowChangedEvent(context.Background(), "", insert, callback))
+	require.Nil(t, encoder.AppendRowChangedEvent(context.Background(), "", del, callback))
+
+	messages := encoder.Build()
+	require.Len(t, messages, 3)
+	require.Nil(t, encoder.Build())
+	for _, m := range messages {
+		require.Equal(t, config.ProtocolDebezium, m.Protocol)
+		require.Equal(t, messages[0].Key, m.Key)
+		if m.Callback != nil {
+			m.Callback()
+		}
+	}
+	// The delete is followed by a tombstone of the same key, which carries
+	// the callback of the delete.
+	require.Equal(t, 2, callbacks)
+	require.Nil(t, messages[2].Value)
+	require.Nil(t, messages[1].Callback)
+	require.NotNil(t, messages[2].Callback)
+
+	var key struct {
+		Schema  *field           `json:"schema"`
+		Payload map[string]int64 `json:"payload"`
+	}
+	require.Nil(t, json.Unmarshal(messages[0].Key, &key))
+	require.Equal(t, "test-cf.a.b.Key", key.Schema.Name)
+	require.Len(t, key.Schema.Fields, 1)
+	require.Equal(t, map[string]int64{"id": 1}, key.Payload)
+
+	var value struct {
+		Schema  *field `json:"schema"`
+		Payload struct {
+			Before map[string]interface{} `json:"before"`
+			After  map[string]interface{} `json:"after"`
+			Source *source                `json:"source"`
+			Op     string                 `json:"op"`
+		} `json:"payload"`
+	}
+	require.Nil(t, json.Unmarshal(messages[0].Value, &value))
+	require.Equal(t, "test-cf.a.b.Envelope", value.Schema.Name)
+	require.Equal(t, "before", value.Schema.Fields[0].Field)
+	require.Equal(t, "test-cf.a.b.Value", value.Schema.Fields[0].Name)
+	require.Len(t, value.Schema.Fields[0].Fields, 2)
+	require.False(t, value.Schema.Fields[0].Fields[0].Optional)
+	require.True(t, value.Schema.Fields[0].Fields[1].Optional)
+	require.Equal(t, opCreate, value.Payload.Op)
+	require.Nil(t, value.Payload.Before)
+	require.Equal(t, map[string]interface{}{"id": float64(1), "name": "aa"}, value.Payload.After)
+	require.Equal(t, "a", value.Payload.Source.DB)
+	require.Equal(t, "b", *value.Payload.Source.Table)
+	require.Equal(t, insert.CommitTs, value.Payload.Source.CommitTs)
+	require.Equal(t, connectorName, value.Payload.Source.Connector)
+
+	require.Nil(t, json.Unmarshal(messages[1].Value, &value))
+	require.Equal(t, opDelete, value.Payload.Op)
+	require.Nil(t, value.Payload.After)
+
+	// No key and no tombstone for the table without handle key.
+	del.PreColumns = []*model.Column{{Name: "name", Type: mysql.TypeVarchar, Value: []byte("aa")}}
+	require.Nil(t, encoder.AppendRowChangedEvent(context.Background(), "", del, nil))
+	messages = encoder.Build()
+	require.Len(t, messages, 1)
+	require.Nil(t, messages[0].Key)
+	require.NotNil(t, messages[0].Value)
+}
+
+func TestEncodeCheckpointEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := newTestEncoder(false)
+	m, err := encoder.EncodeCheckpointEvent(417318403368288260)
+	require.Nil(t, err)
+	require.Nil(t, m)
+
+	encoder = newTestEncoder(true)
+	m, err = encoder.EncodeCheckpointEvent(417318403368288260)
+	require.Nil(t, err)
+	require.NotNil(t, m)
+	require.Equal(t, model.MessageTypeResolved, m.Type)
+	require.Equal(t, uint64(417318403368288260), m.Ts)
+}
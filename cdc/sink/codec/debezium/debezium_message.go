@@ -0,0 +1,713 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/mysql"
+	parser_types "github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	connectorName = "TiCDC"
+
+	sourceSchemaName       = "io.debezium.connector.mysql.Source"
+	schemaChangeKeyName    = "io.debezium.connector.mysql.SchemaChangeKey"
+	schemaChangeValueName  = "io.debezium.connector.mysql.SchemaChangeValue"
+	transactionSchemaName  = "event.block"
+	decimalSchemaName      = "org.apache.kafka.connect.data.Decimal"
+	bitsSchemaName         = "io.debezium.data.Bits"
+	enumSchemaName         = "io.debezium.data.Enum"
+	enumSetSchemaName      = "io.debezium.data.EnumSet"
+	jsonSchemaName         = "io.debezium.data.Json"
+	dateSchemaName         = "io.debezium.time.Date"
+	timestampSchemaName    = "io.debezium.time.Timestamp"
+	microTimestampName     = "io.debezium.time.MicroTimestamp"
+	zonedTimestampName     = "io.debezium.time.ZonedTimestamp"
+	microTimeSchemaName    = "io.debezium.time.MicroTime"
+	yearSchemaName         = "io.debezium.time.Year"
+	paramColumnType        = "__debezium.source.column.type"
+	paramColumnLength      = "__debezium.source.column.length"
+	paramColumnScale       = "__debezium.source.column.scale"
+	paramAllowed           = "allowed"
+	paramLength            = "length"
+	paramScale             = "scale"
+	paramDecimalPrecision  = "connect.decimal.precision"
+	sourceColumnTypeSuffix = " UNSIGNED"
+
+	opCreate = "c"
+	opUpdate = "u"
+	opDelete = "d"
+	// opWatermark is not a part of Debezium, the watermark message is only
+	// sent with the TiDB extension enabled.
+	opWatermark = "tidb-watermark"
+
+	tableChangeCreate = "CREATE"
+	tableChangeAlter  = "ALTER"
+	tableChangeDrop   = "DROP"
+
+	dateLayout     = "2006-01-02"
+	datetimeLayout = "2006-01-02 15:04:05"
+	// datetimeOutputLayout trims the trailing zeros of the fractional part.
+	datetimeOutputLayout = "2006-01-02 15:04:05.999999"
+)
+
+// field is the Kafka Connect schema of a struct field, it is embedded into
+// every message so that the message can be decoded without a registry.
+type field struct {
+	Type       string            `json:"type"`
+	Optional   bool              `json:"optional"`
+	Name       string            `json:"name,omitempty"`
+	Version    int               `json:"version,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+	Fields     []*field          `json:"fields,omitempty"`
+	Field      string            `json:"field,omitempty"`
+}
+
+// message is the Kafka Connect JSON envelope of the key and the value.
+type message struct {
+	Schema  *field      `json:"schema"`
+	Payload interface{} `json:"payload"`
+}
+
+// source describes where the change comes from, the fields follow the
+// Debezium MySQL connector, and `commit_ts` is the commit TSO in TiDB.
+type source struct {
+	Version   string  `json:"version"`
+	Connector string  `json:"connector"`
+	Name      string  `json:"name"`
+	TsMs      int64   `json:"ts_ms"`
+	Snapshot  string  `json:"snapshot"`
+	DB        string  `json:"db"`
+	Table     *string `json:"table"`
+	ServerID  int64   `json:"server_id"`
+	GTID      *string `json:"gtid"`
+	File      string  `json:"file"`
+	Pos       int64   `json:"pos"`
+	Row       int32   `json:"row"`
+	Thread    *int64  `json:"thread"`
+	Query     *string `json:"query"`
+	CommitTs  uint64  `json:"commit_ts"`
+}
+
+// rowPayload is the payload of a row change or a watermark.
+type rowPayload struct {
+	Before      map[string]interface{} `json:"before"`
+	After       map[string]interface{} `json:"after"`
+	Source      *source                `json:"source"`
+	Op          string                 `json:"op"`
+	TsMs        int64                  `json:"ts_ms"`
+	Transaction interface{}            `json:"transaction"`
+}
+
+// ddlPayload is the payload of a schema change.
+type ddlPayload struct {
+	Source       *source        `json:"source"`
+	TsMs         int64          `json:"ts_ms"`
+	DatabaseName string         `json:"databaseName"`
+	SchemaName   *string        `json:"schemaName"`
+	DDL          string         `json:"ddl"`
+	TableChanges []*tableChange `json:"tableChanges"`
+}
+
+type tableChange struct {
+	Type  string     `json:"type"`
+	ID    string     `json:"id"`
+	Table *tableInfo `json:"table"`
+}
+
+type tableInfo struct {
+	Columns []*tableColumn `json:"columns"`
+}
+
+type tableColumn struct {
+	Name     string `json:"name"`
+	TypeName string `json:"typeName"`
+	Position int    `json:"position"`
+}
+
+var sourceSchema = &field{
+	Type:     "struct",
+	Name:     sourceSchemaName,
+	Field:    "source",
+	Optional: false,
+	Fields: []*field{
+		{Type: "string", Field: "version"},
+		{Type: "string", Field: "connector"},
+		{Type: "string", Field: "name"},
+		{Type: "int64", Field: "ts_ms"},
+		{Type: "string", Field: "snapshot", Optional: true},
+		{Type: "string", Field: "db"},
+		{Type: "string", Field: "table", Optional: true},
+		{Type: "int64", Field: "server_id"},
+		{Type: "string", Field: "gtid", Optional: true},
+		{Type: "string", Field: "file"},
+		{Type: "int64", Field: "pos"},
+		{Type: "int32", Field: "row"},
+		{Type: "int64", Field: "thread", Optional: true},
+		{Type: "string", Field: "query", Optional: true},
+		{Type: "int64", Field: "commit_ts"},
+	},
+}
+
+var transactionSchema = &field{
+	Type:     "struct",
+	Name:     transactionSchemaName,
+	Field:    "transaction",
+	Optional: true,
+	Version:  1,
+	Fields: []*field{
+		{Type: "string", Field: "id"},
+		{Type: "int64", Field: "total_order"},
+		{Type: "int64", Field: "data_collection_order"},
+	},
+}
+
+// sourceColumnType returns the MySQL type of the column, such as
+// `INT UNSIGNED` or `VARBINARY`, it is recorded in the field parameters so
+// that the decoder is able to restore the column type.
+func sourceColumnType(col *model.Column) string {
+	cs := ""
+	if col.Flag.IsBinary() {
+		cs = charset.CharsetBin
+	}
+	tp := strings.ToUpper(parser_types.TypeToStr(col.Type, cs))
+	if col.Flag.IsUnsigned() {
+		tp += sourceColumnTypeSuffix
+	}
+	return tp
+}
+
+// parseSourceColumnType is the reverse of sourceColumnType.
+func parseSourceColumnType(tp string) (byte, model.ColumnFlagType) {
+	var flag model.ColumnFlagType
+	if strings.HasSuffix(tp, sourceColumnTypeSuffix) {
+		flag.SetIsUnsigned()
+		tp = strings.TrimSuffix(tp, sourceColumnTypeSuffix)
+	}
+	tp = strings.ToLower(tp)
+	if strings.Contains(tp, "binary") || strings.Contains(tp, "blob") {
+		flag.SetIsBinary()
+	}
+	return parser_types.StrToType(tp), flag
+}
+
+// columnSchema returns the schema of the column following the default
+// mapping of the Debezium MySQL connector. The field type could be nil if
+// the column info is unavailable, the length and the scale of the column
+// are derived from the value then.
+func columnSchema(col *model.Column, ft *types.FieldType) *field {
+	f := &field{
+		Field:    col.Name,
+		Optional: col.Flag.IsNullable(),
+		Parameters: map[string]string{
+			paramColumnType: sourceColumnType(col),
+		},
+	}
+	if ft != nil {
+		if ft.GetFlen() != types.UnspecifiedLength {
+			f.Parameters[paramColumnLength] = strconv.Itoa(ft.GetFlen())
+		}
+		if ft.GetDecimal() != types.UnspecifiedLength {
+			f.Parameters[paramColumnScale] = strconv.Itoa(ft.GetDecimal())
+		}
+	}
+
+	switch col.Type {
+	case mysql.TypeTiny:
+		f.Type = "int16"
+	case mysql.TypeShort:
+		f.Type = "int16"
+		if col.Flag.IsUnsigned() {
+			f.Type = "int32"
+		}
+	case mysql.TypeInt24:
+		f.Type = "int32"
+	case mysql.TypeLong:
+		f.Type = "int32"
+		if col.Flag.IsUnsigned() {
+			f.Type = "int64"
+		}
+	case mysql.TypeLonglong:
+		f.Type = "int64"
+		if col.Flag.IsUnsigned() {
+			// BIGINT UNSIGNED overflows int64, it's encoded as a decimal as
+			// Debezium does with `bigint.unsigned.handling.mode=precise`.
+			f.Type = "bytes"
+			f.Name = decimalSchemaName
+			f.Version = 1
+			f.Parameters[paramScale] = "0"
+			f.Parameters[paramDecimalPrecision] = "20"
+		}
+	case mysql.TypeFloat:
+		f.Type = "float"
+	case mysql.TypeDouble:
+		f.Type = "double"
+	case mysql.TypeBit:
+		length := 64
+		if ft != nil && ft.GetFlen() > 0 {
+			length = ft.GetFlen()
+		}
+		if length == 1 {
+			f.Type = "boolean"
+		} else {
+			f.Type = "bytes"
+			f.Name = bitsSchemaName
+			f.Version = 1
+			f.Parameters[paramLength] = strconv.Itoa(length)
+		}
+	case mysql.TypeNewDecimal:
+		f.Type = "bytes"
+		f.Name = decimalSchemaName
+		f.Version = 1
+		scale := 0
+		if ft != nil && ft.GetDecimal() != types.UnspecifiedLength {
+			scale = ft.GetDecimal()
+			f.Parameters[paramDecimalPrecision] = strconv.Itoa(ft.GetFlen())
+		} else if v, ok := col.Value.(string); ok {
+			if i := strings.IndexByte(v, '.'); i >= 0 {
+				scale = len(v) - i - 1
+			}
+		}
+		f.Parameters[paramScale] = strconv.Itoa(scale)
+	case mysql.TypeVarchar,
+		mysql.TypeString,
+		mysql.TypeVarString,
+		mysql.TypeTinyBlob,
+		mysql.TypeBlob,
+		mysql.TypeMediumBlob,
+		mysql.TypeLongBlob:
+		f.Type = "string"
+		if col.Flag.IsBinary() {
+			f.Type = "bytes"
+		}
+	case mysql.TypeEnum:
+		f.Type = "string"
+		f.Name = enumSchemaName
+		f.Version = 1
+		if ft != nil {
+			f.Parameters[paramAllowed] = strings.Join(ft.GetElems(), ",")
+		}
+	case mysql.TypeSet:
+		f.Type = "string"
+		f.Name = enumSetSchemaName
+		f.Version = 1
+		if ft != nil {
+			f.Parameters[paramAllowed] = strings.Join(ft.GetElems(), ",")
+		}
+	case mysql.TypeJSON:
+		f.Type = "string"
+		f.Name = jsonSchemaName
+		f.Version = 1
+	case mysql.TypeDate:
+		f.Type = "int32"
+		f.Name = dateSchemaName
+		f.Version = 1
+	case mysql.TypeDatetime:
+		f.Type = "int64"
+		f.Name = microTimestampName
+		if ft != nil && ft.GetDecimal() >= 0 && ft.GetDecimal() <= 3 {
+			f.Name = timestampSchemaName
+		}
+		f.Version = 1
+	case mysql.TypeTimestamp:
+		f.Type = "string"
+		f.Name = zonedTimestampName
+		f.Version = 1
+	case mysql.TypeDuration:
+		f.Type = "int64"
+		f.Name = microTimeSchemaName
+		f.Version = 1
+	case mysql.TypeYear:
+		f.Type = "int32"
+		f.Name = yearSchemaName
+		f.Version = 1
+	default:
+		f.Type = "string"
+	}
+	return f
+}
+
+// columnValue converts the column value into the representation of the
+// given schema. The value is the one produced by the mounter, or a string
+// if the event is decoded from another protocol.
+func columnValue(col *model.Column, f *field, ft *types.FieldType, tz *time.Location) (interface{}, error) {
+	if col.Value == nil {
+		return nil, nil
+	}
+	switch f.Name {
+	case decimalSchemaName:
+		scale, _ := strconv.Atoi(f.Parameters[paramScale])
+		return decimalToBytes(toString(col.Value), scale)
+	case bitsSchemaName:
+		n, err := toUint64(col.Value)
+		if err != nil {
+			return nil, err
+		}
+		length, _ := strconv.Atoi(f.Parameters[paramLength])
+		buf := make([]byte, (length+7)/8)
+		for i := range buf {
+			buf[i] = byte(n >> (8 * i))
+		}
+		return buf, nil
+	case enumSchemaName:
+		if v, ok := col.Value.(uint64); ok && ft != nil {
+			enum, err := types.ParseEnumValue(ft.GetElems(), v)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+			}
+			return enum.Name, nil
+		}
+		return toString(col.Value), nil
+	case enumSetSchemaName:
+		if v, ok := col.Value.(uint64); ok && ft != nil {
+			set, err := types.ParseSetValue(ft.GetElems(), v)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+			}
+			return set.Name, nil
+		}
+		return toString(col.Value), nil
+	case dateSchemaName:
+		t, err := time.ParseInLocation(dateLayout, toString(col.Value), time.UTC)
+		if err != nil {
+			// Zero dates can not be represented in Debezium.
+			return nil, nil
+		}
+		return t.Unix() / (24 * 60 * 60), nil
+	case timestampSchemaName, microTimestampName:
+		t, err := time.ParseInLocation(datetimeLayout, toString(col.Value), time.UTC)
+		if err != nil {
+			return nil, nil
+		}
+		if f.Name == timestampSchemaName {
+			return t.UnixMilli(), nil
+		}
+		return t.UnixMicro(), nil
+	case zonedTimestampName:
+		t, err := time.ParseInLocation(datetimeLayout, toString(col.Value), tz)
+		if err != nil {
+			return nil, nil
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	case microTimeSchemaName:
+		return parseDuration(toString(col.Value))
+	}
+
+	switch f.Type {
+	case "boolean":
+		n, err := toUint64(col.Value)
+		if err != nil {
+			return nil, err
+		}
+		return n != 0, nil
+	case "int16", "int32", "int64":
+		switch v := col.Value.(type) {
+		case int64:
+			return v, nil
+		case uint64:
+			// Only BIGINT UNSIGNED may overflow, which is encoded as a decimal.
+			return int64(v), nil
+		default:
+			s := toString(v)
+			if col.Flag.IsUnsigned() {
+				n, err := strconv.ParseUint(s, 10, 64)
+				if err != nil {
+					return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+				}
+				return int64(n), nil
+			}
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+			}
+			return n, nil
+		}
+	case "float", "double":
+		switch v := col.Value.(type) {
+		case float32:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		default:
+			n, err := strconv.ParseFloat(toString(v), 64)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+			}
+			return n, nil
+		}
+	case "bytes":
+		if v, ok := col.Value.([]byte); ok {
+			return v, nil
+		}
+		return []byte(toString(col.Value)), nil
+	default:
+		return toString(col.Value), nil
+	}
+}
+
+// decodeColumnValue is the reverse of columnValue, it returns the value in
+// the form that the MySQL sink accepts.
+func decodeColumnValue(
+	value interface{}, f *field, flag model.ColumnFlagType, tz *time.Location,
+) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch f.Name {
+	case decimalSchemaName:
+		buf, err := decodeBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		scale, _ := strconv.Atoi(f.Parameters[paramScale])
+		s := bytesToDecimal(buf, scale)
+		if tp, _ := parseSourceColumnType(f.Parameters[paramColumnType]); tp == mysql.TypeLonglong {
+			// It's a BIGINT UNSIGNED.
+			n, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+			}
+			return n, nil
+		}
+		return s, nil
+	case bitsSchemaName:
+		buf, err := decodeBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		var n uint64
+		for i, b := range buf {
+			n |= uint64(b) << (8 * i)
+		}
+		return n, nil
+	case dateSchemaName:
+		days, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(days*24*60*60, 0).UTC().Format(dateLayout), nil
+	case timestampSchemaName:
+		ms, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		return time.UnixMilli(ms).UTC().Format(datetimeOutputLayout), nil
+	case microTimestampName:
+		us, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		return time.UnixMicro(us).UTC().Format(datetimeOutputLayout), nil
+	case zonedTimestampName:
+		t, err := time.Parse(time.RFC3339Nano, toString(value))
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+		}
+		return t.In(tz).Format(datetimeOutputLayout), nil
+	case microTimeSchemaName:
+		us, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		return formatDuration(us), nil
+	}
+
+	switch f.Type {
+	case "boolean":
+		if v, ok := value.(bool); ok && v {
+			return uint64(1), nil
+		}
+		return uint64(0), nil
+	case "int16", "int32", "int64":
+		n, err := toInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		if flag.IsUnsigned() {
+			return uint64(n), nil
+		}
+		return n, nil
+	case "float", "double":
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, cerror.ErrDebeziumInvalidData.GenWithStack(
+				"invalid float value %v", value)
+		}
+		v, err := n.Float64()
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+		}
+		return v, nil
+	case "bytes":
+		return decodeBytes(value)
+	default:
+		return toString(value), nil
+	}
+}
+
+func toString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func toUint64(v interface{}) (uint64, error) {
+	switch v := v.(type) {
+	case uint64:
+		return v, nil
+	case int64:
+		return uint64(v), nil
+	default:
+		n, err := strconv.ParseUint(toString(v), 10, 64)
+		if err != nil {
+			return 0, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+		}
+		return n, nil
+	}
+}
+
+func toInt64(v interface{}) (int64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, cerror.ErrDebeziumInvalidData.GenWithStack("invalid integer value %v", v)
+	}
+	i, err := n.Int64()
+	if err != nil {
+		return 0, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	return i, nil
+}
+
+func decodeBytes(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, cerror.ErrDebeziumInvalidData.GenWithStack("invalid bytes value %v", v)
+	}
+	buf, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumDecodeFailed, err)
+	}
+	return buf, nil
+}
+
+// decimalToBytes returns the unscaled value of the decimal in big-endian
+// two's complement, which is the representation of the Kafka Connect Decimal.
+func decimalToBytes(s string, scale int) ([]byte, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, cerror.ErrDebeziumEncodeFailed.GenWithStack("invalid decimal value %s", s)
+	}
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)))
+	if !r.IsInt() {
+		return nil, cerror.ErrDebeziumEncodeFailed.GenWithStack(
+			"decimal value %s exceeds scale %d", s, scale)
+	}
+	n := r.Num()
+	if n.Sign() >= 0 {
+		buf := n.Bytes()
+		if len(buf) == 0 || buf[0]&0x80 != 0 {
+			buf = append([]byte{0}, buf...)
+		}
+		return buf, nil
+	}
+	// The length of the two's complement of n is the bit length of -n-1
+	// plus a sign bit.
+	length := new(big.Int).Sub(new(big.Int).Neg(n), big.NewInt(1)).BitLen()/8 + 1
+	complement := new(big.Int).Lsh(big.NewInt(1), uint(length*8))
+	buf := complement.Add(complement, n).Bytes()
+	for len(buf) < length {
+		buf = append([]byte{0xff}, buf...)
+	}
+	return buf, nil
+}
+
+// bytesToDecimal is the reverse of decimalToBytes.
+func bytesToDecimal(buf []byte, scale int) string {
+	n := new(big.Int).SetBytes(buf)
+	if len(buf) > 0 && buf[0]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(buf)*8)))
+	}
+	digits := new(big.Int).Abs(n).String()
+	sign := ""
+	if n.Sign() < 0 {
+		sign = "-"
+	}
+	if scale <= 0 {
+		return sign + digits
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// parseDuration returns the microseconds of a TIME value such as
+// `-838:59:59.000000`.
+func parseDuration(s string) (interface{}, error) {
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return nil, cerror.ErrDebeziumEncodeFailed.GenWithStack("invalid time value %s", s)
+	}
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+	seconds, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+	}
+	us := (hours*60+minutes)*60*1000000 + int64(seconds*1000000+0.5)
+	if negative {
+		us = -us
+	}
+	return us, nil
+}
+
+// formatDuration is the reverse of parseDuration.
+func formatDuration(us int64) string {
+	sign := ""
+	if us < 0 {
+		sign = "-"
+		us = -us
+	}
+	seconds := us / 1000000
+	s := fmt.Sprintf("%s%02d:%02d:%02d", sign, seconds/3600, seconds/60%60, seconds%60)
+	if frac := us % 1000000; frac != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%06d", frac), "0")
+	}
+	return s
+}
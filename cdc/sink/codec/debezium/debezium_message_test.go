@@ -0,0 +1,287 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value    string
+		scale    int
+		expected []byte
+	}{
+		{value: "0", scale: 0, expected: []byte{0x00}},
+		{value: "1.00", scale: 2, expected: []byte{0x64}},
+		{value: "1.28", scale: 2, expected: []byte{0x00, 0x80}},
+		{value: "-1.28", scale: 2, expected: []byte{0x80}},
+		{value: "-0.01", scale: 2, expected: []byte{0xff}},
+		{value: "-1.29", scale: 2, expected: []byte{0xff, 0x7f}},
+	}
+	for _, tc := range testCases {
+		buf, err := decimalToBytes(tc.value, tc.scale)
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, buf, tc.value)
+		require.Equal(t, tc.value, bytesToDecimal(buf, tc.scale))
+	}
+
+	_, err := decimalToBytes("1.234", 2)
+	require.Regexp(t, ".*ErrDebeziumEncodeFailed.*", err)
+}
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value    string
+		expected int64
+	}{
+		{value: "00:00:00", expected: 0},
+		{value: "12:34:56.5", expected: 45296500000},
+		{value: "-838:59:59", expected: -3020399000000},
+	}
+	for _, tc := range testCases {
+		us, err := parseDuration(tc.value)
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, us)
+		require.Equal(t, tc.value, formatDuration(tc.expected))
+	}
+}
+
+func TestColumnSchemaAndValue(t *testing.T) {
+	t.Parallel()
+
+	newFieldType := func(tp byte, flen, decimal int, elems ...string) *types.FieldType {
+		ft := types.NewFieldType(tp)
+		ft.SetFlen(flen)
+		ft.SetDecimal(decimal)
+		ft.SetElems(elems)
+		return ft
+	}
+	tz, err := time.LoadLocation("Asia/Shanghai")
+	require.Nil(t, err)
+
+	testCases := []struct {
+		col        *model.Column
+		ft         *types.FieldType
+		tp         string
+		name       string
+		sourceType string
+		value      interface{}
+		decoded    interface{}
+	}{
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeTiny, Value: int64(-1)},
+			tp:         "int16",
+			sourceType: "TINYINT",
+			value:      int64(-1),
+			decoded:    int64(-1),
+		},
+		{
+			col: &model.Column{
+				Name: "a", Type: mysql.TypeLong,
+				Flag: model.UnsignedFlag, Value: uint64(4294967295),
+			},
+			tp:         "int64",
+			sourceType: "INT UNSIGNED",
+			value:      int64(4294967295),
+			decoded:    uint64(4294967295),
+		},
+		{
+			col: &model.Column{
+				Name: "a", Type: mysql.TypeLonglong,
+				Flag: model.UnsignedFlag, Value: uint64(18446744073709551615),
+			},
+			tp:         "bytes",
+			name:       decimalSchemaName,
+			sourceType: "BIGINT UNSIGNED",
+			value:      []byte{0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			decoded:    uint64(18446744073709551615),
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeDouble, Value: float64(1.5)},
+			tp:         "double",
+			sourceType: "DOUBLE",
+			value:      float64(1.5),
+			decoded:    float64(1.5),
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeNewDecimal, Value: "-12.30"},
+			ft:         newFieldType(mysql.TypeNewDecimal, 10, 2),
+			tp:         "bytes",
+			name:       decimalSchemaName,
+			sourceType: "DECIMAL",
+			value:      []byte{0xfb, 0x32},
+			decoded:    "-12.30",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeBit, Value: uint64(1)},
+			ft:         newFieldType(mysql.TypeBit, 1, 0),
+			tp:         "boolean",
+			sourceType: "BIT",
+			value:      true,
+			decoded:    uint64(1),
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeBit, Value: uint64(0x0102)},
+			ft:         newFieldType(mysql.TypeBit, 10, 0),
+			tp:         "bytes",
+			name:       bitsSchemaName,
+			sourceType: "BIT",
+			value:      []byte{0x02, 0x01},
+			decoded:    uint64(0x0102),
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeVarchar, Value: []byte("abc")},
+			tp:         "string",
+			sourceType: "VARCHAR",
+			value:      "abc",
+			decoded:    "abc",
+		},
+		{
+			col: &model.Column{
+				Name: "a", Type: mysql.TypeBlob,
+				Flag: model.BinaryFlag, Value: []byte{0x00, 0x01},
+			},
+			tp:         "bytes",
+			sourceType: "BLOB",
+			value:      []byte{0x00, 0x01},
+			decoded:    []byte{0x00, 0x01},
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeEnum, Value: uint64(2)},
+			ft:         newFieldType(mysql.TypeEnum, 0, 0, "x", "y"),
+			tp:         "string",
+			name:       enumSchemaName,
+			sourceType: "ENUM",
+			value:      "y",
+			decoded:    "y",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeSet, Value: uint64(3)},
+			ft:         newFieldType(mysql.TypeSet, 0, 0, "x", "y"),
+			tp:         "string",
+			name:       enumSetSchemaName,
+			sourceType: "SET",
+			value:      "x,y",
+			decoded:    "x,y",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeDate, Value: "2022-01-02"},
+			tp:         "int32",
+			name:       dateSchemaName,
+			sourceType: "DATE",
+			value:      int64(18994),
+			decoded:    "2022-01-02",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeDate, Value: "0000-00-00"},
+			tp:         "int32",
+			name:       dateSchemaName,
+			sourceType: "DATE",
+			value:      nil,
+			decoded:    nil,
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeDatetime, Value: "2022-01-02 03:04:05.123"},
+			ft:         newFieldType(mysql.TypeDatetime, 0, 3),
+			tp:         "int64",
+			name:       timestampSchemaName,
+			sourceType: "DATETIME",
+			value:      int64(1641092645123),
+			decoded:    "2022-01-02 03:04:05.123",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeDatetime, Value: "2022-01-02 03:04:05.123456"},
+			ft:         newFieldType(mysql.TypeDatetime, 0, 6),
+			tp:         "int64",
+			name:       microTimestampName,
+			sourceType: "DATETIME",
+			value:      int64(1641092645123456),
+			decoded:    "2022-01-02 03:04:05.123456",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeTimestamp, Value: "2022-01-02 11:04:05"},
+			tp:         "string",
+			name:       zonedTimestampName,
+			sourceType: "TIMESTAMP",
+			value:      "2022-01-02T03:04:05Z",
+			decoded:    "2022-01-02 11:04:05",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeDuration, Value: "-01:02:03"},
+			tp:         "int64",
+			name:       microTimeSchemaName,
+			sourceType: "TIME",
+			value:      int64(-3723000000),
+			decoded:    "-01:02:03",
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeYear, Value: int64(2022)},
+			tp:         "int32",
+			name:       yearSchemaName,
+			sourceType: "YEAR",
+			value:      int64(2022),
+			decoded:    int64(2022),
+		},
+		{
+			col:        &model.Column{Name: "a", Type: mysql.TypeJSON, Value: `{"a":1}`},
+			tp:         "string",
+			name:       jsonSchemaName,
+			sourceType: "JSON",
+			value:      `{"a":1}`,
+			decoded:    `{"a":1}`,
+		},
+	}
+	for _, tc := range testCases {
+		f := columnSchema(tc.col, tc.ft)
+		require.Equal(t, tc.tp, f.Type, tc.col.Value)
+		require.Equal(t, tc.name, f.Name, tc.col.Value)
+		require.Equal(t, tc.sourceType, f.Parameters[paramColumnType], tc.col.Value)
+		value, err := columnValue(tc.col, f, tc.ft, tz)
+		require.Nil(t, err)
+		require.Equal(t, tc.value, value, tc.col.Value)
+
+		// Decode the value as it is read from a JSON message.
+		var jsonValue interface{}
+		if buf, ok := value.([]byte); ok {
+			jsonValue = base64.StdEncoding.EncodeToString(buf)
+		} else if value != nil {
+			data, err := json.Marshal(value)
+			require.Nil(t, err)
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			decoder.UseNumber()
+			require.Nil(t, decoder.Decode(&jsonValue))
+		}
+		tp, flag := parseSourceColumnType(f.Parameters[paramColumnType])
+		require.Equal(t, tc.col.Type, tp, tc.col.Value)
+		require.Equal(t, tc.col.Flag.IsUnsigned(), flag.IsUnsigned())
+		require.Equal(t, tc.col.Flag.IsBinary(), flag.IsBinary())
+		decoded, err := decodeColumnValue(jsonValue, f, flag, tz)
+		require.Nil(t, err)
+		require.Equal(t, tc.decoded, decoded, tc.col.Value)
+	}
+}
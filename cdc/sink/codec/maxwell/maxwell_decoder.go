@@ -0,0 +1,223 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxwell
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// batchDecoder decodes the byte of a batch into the original messages.
+// Maxwell messages carry neither the column types nor the TiDB timestamps,
+// so the events can only be replayed faithfully if they are encoded with
+// the TiDB extension enabled.
+type batchDecoder struct {
+	decoder *json.Decoder
+
+	msgType model.MessageType
+	raw     json.RawMessage
+}
+
+// NewBatchDecoder creates a new batchDecoder.
+func NewBatchDecoder(value []byte) codec.EventBatchDecoder {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	return &batchDecoder{decoder: decoder}
+}
+
+// HasNext implements the EventBatchDecoder interface
+func (b *batchDecoder) HasNext() (model.MessageType, bool, error) {
+	if b.raw != nil {
+		return b.msgType, true, nil
+	}
+	if !b.decoder.More() {
+		return model.MessageTypeUnknown, false, nil
+	}
+	var raw json.RawMessage
+	if err := b.decoder.Decode(&raw); err != nil {
+		return model.MessageTypeUnknown, false,
+			cerror.WrapError(cerror.ErrMaxwellDecodeFailed, err)
+	}
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return model.MessageTypeUnknown, false,
+			cerror.WrapError(cerror.ErrMaxwellDecodeFailed, err)
+	}
+	switch header.Type {
+	case "insert", "update", "delete":
+		b.msgType = model.MessageTypeRow
+	case tidbWaterMarkType:
+		b.msgType = model.MessageTypeResolved
+	default:
+		b.msgType = model.MessageTypeDDL
+	}
+	b.raw = raw
+	return b.msgType, true, nil
+}
+
+// NextResolvedEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextResolvedEvent() (uint64, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeResolved {
+		return 0, cerror.ErrMaxwellInvalidData.GenWithStack("not found resolved event message")
+	}
+	msg := &maxwellMessage{}
+	if err := b.unmarshal(msg); err != nil {
+		return 0, errors.Trace(err)
+	}
+	if msg.Extensions == nil {
+		return 0, cerror.ErrMaxwellInvalidData.GenWithStack("tidb extension not found")
+	}
+	return msg.Extensions.WatermarkTs, nil
+}
+
+// NextRowChangedEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeRow {
+		return nil, cerror.ErrMaxwellInvalidData.GenWithStack("not found row changed event message")
+	}
+	msg := &maxwellMessage{}
+	if err := b.unmarshal(msg); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return maxwellMsgToRowChange(msg), nil
+}
+
+// NextDDLEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
+	ty, hasNext, err := b.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || ty != model.MessageTypeDDL {
+		return nil, cerror.ErrMaxwellInvalidData.GenWithStack("not found ddl event message")
+	}
+	msg := &ddlMaxwellMessage{}
+	if err := b.unmarshal(msg); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &model.DDLEvent{
+		CommitTs: msg.Ts,
+		Query:    msg.SQL,
+		Type:     maxwellTypeToDDL(msg.Type),
+		TableInfo: &model.SimpleTableInfo{
+			Schema: msg.Database,
+			Table:  msg.Table,
+		},
+	}, nil
+}
+
+// unmarshal decodes the pending message into v and consumes it.
+func (b *batchDecoder) unmarshal(v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(b.raw))
+	decoder.UseNumber()
+	b.raw = nil
+	return cerror.WrapError(cerror.ErrMaxwellDecodeFailed, decoder.Decode(v))
+}
+
+func maxwellMsgToRowChange(msg *maxwellMessage) *model.RowChangedEvent {
+	e := &model.RowChangedEvent{
+		Table: &model.TableName{
+			Schema: msg.Database,
+			Table:  msg.Table,
+		},
+	}
+	if msg.Extensions != nil {
+		e.CommitTs = msg.Extensions.CommitTs
+	} else {
+		// the maxwell ts is in seconds, it's the best we can do.
+		e.CommitTs = oracle.ComposeTS(msg.Ts*1000, 0)
+	}
+	handleKeys := make(map[string]struct{}, len(msg.PrimaryKeyColumns))
+	for _, name := range msg.PrimaryKeyColumns {
+		handleKeys[name] = struct{}{}
+	}
+	switch msg.Type {
+	case "delete":
+		e.PreColumns = maxwellDataToColumns(msg.Old, handleKeys)
+	case "update":
+		e.Columns = maxwellDataToColumns(msg.Data, handleKeys)
+		// the old data only contains the changed columns.
+		old := make(map[string]interface{}, len(msg.Data))
+		for name, value := range msg.Data {
+			old[name] = value
+		}
+		for name, value := range msg.Old {
+			old[name] = value
+		}
+		e.PreColumns = maxwellDataToColumns(old, handleKeys)
+	default:
+		e.Columns = maxwellDataToColumns(msg.Data, handleKeys)
+	}
+	return e
+}
+
+// maxwellDataToColumns converts the data of a maxwell message to columns,
+// the columns are sorted by name since the order is lost in the message.
+func maxwellDataToColumns(
+	data map[string]interface{}, handleKeys map[string]struct{},
+) []*model.Column {
+	cols := make([]*model.Column, 0, len(data))
+	for name, value := range data {
+		col := &model.Column{Name: name, Value: value}
+		if number, ok := value.(json.Number); ok {
+			col.Value = number.String()
+		}
+		if _, ok := handleKeys[name]; ok {
+			col.Flag.SetIsHandleKey()
+			col.Flag.SetIsPrimaryKey()
+		}
+		cols = append(cols, col)
+	}
+	sort.Slice(cols, func(i, j int) bool {
+		return cols[i].Name < cols[j].Name
+	})
+	return cols
+}
+
+// maxwellTypeToDDL is the reverse of ddlToMaxwellType, the `table-alter`
+// type is not reversible, but it does not matter to replay the DDL.
+func maxwellTypeToDDL(tp string) timodel.ActionType {
+	switch tp {
+	case "table-create":
+		return timodel.ActionCreateTable
+	case "table-drop":
+		return timodel.ActionDropTable
+	case "database-create":
+		return timodel.ActionCreateSchema
+	case "database-drop":
+		return timodel.ActionDropSchema
+	case "database-alter":
+		return timodel.ActionModifySchemaCharsetAndCollate
+	default:
+		return timodel.ActionNone
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxwell
+
+import (
+	"context"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxwellBatchDecoder(t *testing.T) {
+	t.Parallel()
+
+	encoder := newBatchEncoder(true)
+	id := &model.Column{
+		Name:  "id",
+		Type:  mysql.TypeLong,
+		Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag,
+		Value: 1,
+	}
+	insert := &model.RowChangedEvent{
+		CommitTs: 417318403368288260,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			id, {Name: "name", Type: mysql.TypeVarchar, Value: []byte("aa")},
+		},
+	}
+	update := &model.RowChangedEvent{
+		CommitTs: 417318403368288261,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		PreColumns: []*model.Column{
+			id, {Name: "name", Type: mysql.TypeVarchar, Value: []byte("aa")},
+		},
+		Columns: []*model.Column{
+			id, {Name: "name", Type: mysql.TypeVarchar, Value: []byte("bb")},
+		},
+	}
+	del := &model.RowChangedEvent{
+		CommitTs: 417318403368288262,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		PreColumns: []*model.Column{
+			id, {Name: "name", Type: mysql.TypeVarchar, Value: []byte("bb")},
+		},
+	}
+	for _, row := range []*model.RowChangedEvent{insert, update, del} {
+		err := encoder.AppendRowChangedEvent(context.Background(), "", row, nil)
+		require.Nil(t, err)
+	}
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	decoder := NewBatchDecoder(messages[0].Value)
+	expected := []struct {
+		commitTs uint64
+		pre      []interface{}
+		cur      []interface{}
+	}{
+		{commitTs: insert.CommitTs, cur: []interface{}{"1", "aa"}},
+		{commitTs: update.CommitTs, pre: []interface{}{"1", "aa"}, cur: []interface{}{"1", "bb"}},
+		{commitTs: del.CommitTs, pre: []interface{}{"1", "bb"}},
+	}
+	for _, e := range expected {
+		tp, hasNext, err := decoder.HasNext()
+		require.Nil(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+		row, err := decoder.NextRowChangedEvent()
+		require.Nil(t, err)
+		require.Equal(t, e.commitTs, row.CommitTs)
+		require.Equal(t, "a", row.Table.Schema)
+		require.Equal(t, "b", row.Table.Table)
+		require.Len(t, row.PreColumns, len(e.pre))
+		for i, v := range e.pre {
+			require.Equal(t, v, row.PreColumns[i].Value)
+		}
+		require.Len(t, row.Columns, len(e.cur))
+		for i, v := range e.cur {
+			require.Equal(t, v, row.Columns[i].Value)
+		}
+		cols := row.Columns
+		if row.IsDelete() {
+			cols = row.PreColumns
+		}
+		require.Equal(t, "id", cols[0].Name)
+		require.True(t, cols[0].Flag.IsHandleKey())
+		require.False(t, cols[1].Flag.IsHandleKey())
+	}
+	_, hasNext, err := decoder.HasNext()
+	require.Nil(t, err)
+	require.False(t, hasNext)
+
+	// resolved event
+	msg, err := encoder.EncodeCheckpointEvent(417318403368288263)
+	require.Nil(t, err)
+	decoder = NewBatchDecoder(msg.Value)
+	tp, hasNext, err := decoder.HasNext()
+	require.Nil(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeResolved, tp)
+	ts, err := decoder.NextResolvedEvent()
+	require.Nil(t, err)
+	require.Equal(t, uint64(417318403368288263), ts)
+	_, err = decoder.NextRowChangedEvent()
+	require.NotNil(t, err)
+
+	// ddl event
+	msg, err = encoder.EncodeDDLEvent(&model.DDLEvent{
+		CommitTs: 417318403368288264,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "a",
+		},
+		Query: "create database a",
+		Type:  timodel.ActionCreateSchema,
+	})
+	require.Nil(t, err)
+	decoder = NewBatchDecoder(msg.Value)
+	tp, hasNext, err = decoder.HasNext()
+	require.Nil(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeDDL, tp)
+	ddl, err := decoder.NextDDLEvent()
+	require.Nil(t, err)
+	require.Equal(t, uint64(417318403368288264), ddl.CommitTs)
+	require.Equal(t, "create database a", ddl.Query)
+	require.Equal(t, timodel.ActionCreateSchema, ddl.Type)
+	require.Equal(t, "a", ddl.TableInfo.Schema)
+}
+
+func TestMaxwellCheckpointWithoutExtension(t *testing.T) {
+	t.Parallel()
+
+	encoder := newBatchEncoder(false)
+	msg, err := encoder.EncodeCheckpointEvent(1)
+	require.Nil(t, err)
+	require.Nil(t, msg)
+}
@@ -31,13 +31,22 @@ type BatchEncoder struct {
 	valueBuf    *bytes.Buffer
 	callbackBuf []func()
 	batchSize   int
+
+	enableTiDBExtension bool
 }
 
 // EncodeCheckpointEvent implements the EventBatchEncoder interface
 func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
 	// For maxwell now, there is no such a corresponding type to ResolvedEvent so far.
-	// Therefore the event is ignored.
-	return nil, nil
+	// Therefore the event is ignored unless the TiDB extension is enabled.
+	if !d.enableTiDBExtension {
+		return nil, nil
+	}
+	value, err := checkpointToMaxwellMsg(ts).encode()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.NewResolvedMsg(config.ProtocolMaxwell, nil, value, ts), nil
 }
 
 // AppendRowChangedEvent implements the EventBatchEncoder interface
@@ -47,7 +56,7 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	e *model.RowChangedEvent,
 	callback func(),
 ) error {
-	_, valueMsg := rowChangeToMaxwellMsg(e)
+	_, valueMsg := rowChangeToMaxwellMsg(e, d.enableTiDBExtension)
 	value, err := valueMsg.encode()
 	if err != nil {
 		return errors.Trace(err)
@@ -109,24 +118,27 @@ func (d *BatchEncoder) reset() {
 }
 
 // newBatchEncoder creates a new maxwell BatchEncoder.
-func newBatchEncoder() codec.EventBatchEncoder {
+func newBatchEncoder(enableTiDBExtension bool) codec.EventBatchEncoder {
 	batch := &BatchEncoder{
-		keyBuf:      &bytes.Buffer{},
-		valueBuf:    &bytes.Buffer{},
-		callbackBuf: make([]func(), 0),
+		keyBuf:              &bytes.Buffer{},
+		valueBuf:            &bytes.Buffer{},
+		callbackBuf:         make([]func(), 0),
+		enableTiDBExtension: enableTiDBExtension,
 	}
 	batch.reset()
 	return batch
 }
 
-type batchEncoderBuilder struct{}
+type batchEncoderBuilder struct {
+	config *common.Config
+}
 
 // NewBatchEncoderBuilder creates a maxwell batchEncoderBuilder.
-func NewBatchEncoderBuilder() codec.EncoderBuilder {
-	return &batchEncoderBuilder{}
+func NewBatchEncoderBuilder(config *common.Config) codec.EncoderBuilder {
+	return &batchEncoderBuilder{config: config}
 }
 
 // Build a `maxwellBatchEncoder`
 func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
-	return newBatchEncoder()
+	return newBatchEncoder(b.config.EnableTiDBExtension)
 }
@@ -19,12 +19,15 @@ import (
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/stretchr/testify/require"
 )
 
 func TestMaxwellBatchCodec(t *testing.T) {
 	t.Parallel()
-	newEncoder := newBatchEncoder
+	newEncoder := func() codec.EventBatchEncoder {
+		return newBatchEncoder(false)
+	}
 
 	rowCases := [][]*model.RowChangedEvent{{{
 		CommitTs: 1,
@@ -65,7 +68,7 @@ func TestMaxwellBatchCodec(t *testing.T) {
 }
 
 func TestMaxwellAppendRowChangedEventWithCallback(t *testing.T) {
-	encoder := newBatchEncoder()
+	encoder := newBatchEncoder(false)
 	require.NotNil(t, encoder)
 
 	count := 0
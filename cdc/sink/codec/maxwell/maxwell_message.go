@@ -24,6 +24,10 @@ import (
 	"github.com/tikv/pd/pkg/tsoutil"
 )
 
+// tidbWaterMarkType is the type of the checkpoint event, only sent when the
+// TiDB extension is enabled.
+const tidbWaterMarkType = "tidb-watermark"
+
 type maxwellMessage struct {
 	Database string                 `json:"database"`
 	Table    string                 `json:"table"`
@@ -35,6 +39,16 @@ type maxwellMessage struct {
 	Gtid     string                 `json:"gtid,omitempty"`
 	Data     map[string]interface{} `json:"data,omitempty"`
 	Old      map[string]interface{} `json:"old,omitempty"`
+	// PrimaryKeyColumns and Extensions are only set if the TiDB extension is
+	// enabled, they make the message can be replayed to the downstream.
+	PrimaryKeyColumns []string       `json:"primary_key_columns,omitempty"`
+	Extensions        *tidbExtension `json:"_tidb,omitempty"`
+}
+
+// tidbExtension is a TiCDC custom field that different from official Maxwell format.
+type tidbExtension struct {
+	CommitTs    uint64 `json:"commitTs,omitempty"`
+	WatermarkTs uint64 `json:"watermarkTs,omitempty"`
 }
 
 // Encode encodes the message to bytes
@@ -43,7 +57,9 @@ func (m *maxwellMessage) encode() ([]byte, error) {
 	return data, cerror.WrapError(cerror.ErrMaxwellEncodeFailed, err)
 }
 
-func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *maxwellMessage) {
+func rowChangeToMaxwellMsg(
+	e *model.RowChangedEvent, enableTiDBExtension bool,
+) (*internal.MessageKey, *maxwellMessage) {
 	var partition *int64
 	if e.Table.IsPartition {
 		partition = &e.Table.TableID
@@ -65,9 +81,24 @@ func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *max
 
 	physicalTime, _ := tsoutil.ParseTS(e.CommitTs)
 	value.Ts = physicalTime.Unix()
+	if enableTiDBExtension {
+		cols := e.Columns
+		if e.IsDelete() {
+			cols = e.PreColumns
+		}
+		for _, col := range cols {
+			if col != nil && col.Flag.IsHandleKey() {
+				value.PrimaryKeyColumns = append(value.PrimaryKeyColumns, col.Name)
+			}
+		}
+		value.Extensions = &tidbExtension{CommitTs: e.CommitTs}
+	}
 	if e.IsDelete() {
 		value.Type = "delete"
 		for _, v := range e.PreColumns {
+			if v == nil {
+				continue
+			}
 			switch v.Type {
 			case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 				if v.Value == nil {
@@ -83,6 +114,9 @@ func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *max
 		}
 	} else {
 		for _, v := range e.Columns {
+			if v == nil {
+				continue
+			}
 			switch v.Type {
 			case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 				if v.Value == nil {
@@ -101,6 +135,9 @@ func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *max
 		} else {
 			value.Type = "update"
 			for _, v := range e.PreColumns {
+				if v == nil {
+					continue
+				}
 				switch v.Type {
 				case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 					if v.Value == nil {
@@ -128,6 +165,15 @@ func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *max
 	return key, value
 }
 
+func checkpointToMaxwellMsg(ts uint64) *maxwellMessage {
+	physicalTime, _ := tsoutil.ParseTS(ts)
+	return &maxwellMessage{
+		Type:       tidbWaterMarkType,
+		Ts:         physicalTime.Unix(),
+		Extensions: &tidbExtension{WatermarkTs: ts},
+	}
+}
+
 // maxwellColumn represents a column in maxwell
 type maxwellColumn struct {
 	Type string `json:"type"`
@@ -38,6 +38,9 @@ type BatchEncoder struct {
 	// configs
 	MaxMessageBytes int
 	MaxBatchSize    int
+	// ClaimCheck allows the single message larger than MaxMessageBytes,
+	// which is offloaded to the external storage by the sink.
+	ClaimCheck bool
 }
 
 // AppendRowChangedEvent implements the EventBatchEncoder interface
@@ -62,10 +65,11 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	var valueLenByte [8]byte
 	binary.BigEndian.PutUint64(valueLenByte[:], uint64(len(value)))
 
-	// for single message that longer than max-message-size, do not send it.
+	// for single message that longer than max-message-size, do not send it,
+	// unless it can be offloaded by claim-check.
 	// 16 is the length of `keyLenByte` and `valueLenByte`, 8 is the length of `versionHead`
 	length := len(key) + len(value) + common.MaxRecordOverhead + 16 + 8
-	if length > d.MaxMessageBytes {
+	if length > d.MaxMessageBytes && !d.ClaimCheck {
 		log.Warn("Single message too large",
 			zap.Int("max-message-size", d.MaxMessageBytes), zap.Int("length", length), zap.Any("table", e.Table))
 		return cerror.ErrOpenProtocolCodecRowTooLarge.GenWithStackByArgs()
@@ -191,6 +195,7 @@ func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
 	encoder := NewBatchEncoder()
 	encoder.(*BatchEncoder).MaxMessageBytes = b.config.MaxMessageBytes
 	encoder.(*BatchEncoder).MaxBatchSize = b.config.MaxBatchSize
+	encoder.(*BatchEncoder).ClaimCheck = b.config.ClaimCheckStorageURI != ""
 
 	return encoder
 }
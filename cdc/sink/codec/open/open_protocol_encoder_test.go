@@ -63,6 +63,19 @@ func TestMaxMessageBytes(t *testing.T) {
 	err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 	require.NotNil(t, err)
 
+	// a single message larger than max-message-bytes is allowed by claim-check,
+	// and it is not batched with other messages.
+	config.ClaimCheckStorageURI = "file:///tmp/claim-check"
+	encoder = NewBatchEncoderBuilder(config).Build()
+	for i := 0; i < 2; i++ {
+		err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
+		require.Nil(t, err)
+	}
+	messages := encoder.Build()
+	require.Len(t, messages, 2)
+	require.Equal(t, 1, messages[0].GetRowsCount())
+	config.ClaimCheckStorageURI = ""
+
 	// make sure each batch's `Length` not greater than `max-message-bytes`
 	config = config.WithMaxMessageBytes(256)
 	encoder = NewBatchEncoderBuilder(config).Build()
@@ -71,7 +84,7 @@ func TestMaxMessageBytes(t *testing.T) {
 		require.Nil(t, err)
 	}
 
-	messages := encoder.Build()
+	messages = encoder.Build()
 	for _, msg := range messages {
 		require.LessOrEqual(t, msg.Length(), 256)
 	}
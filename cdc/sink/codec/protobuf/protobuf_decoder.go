@@ -0,0 +1,99 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/proto/ticdc"
+)
+
+// batchDecoder decodes the events of a protobuf message.
+type batchDecoder struct {
+	events []*ticdc.Event
+}
+
+// NewBatchDecoder creates a new batchDecoder.
+func NewBatchDecoder(value []byte) (codec.EventBatchDecoder, error) {
+	msg := &ticdc.Message{}
+	if err := msg.Unmarshal(value); err != nil {
+		return nil, cerror.WrapError(cerror.ErrProtobufCodecInvalidData, err)
+	}
+	if msg.Version != protocolVersion {
+		return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack(
+			"unexpected protocol version %d", msg.Version)
+	}
+	return &batchDecoder{events: msg.Events}, nil
+}
+
+// HasNext implements the EventBatchDecoder interface
+func (b *batchDecoder) HasNext() (model.MessageType, bool, error) {
+	if len(b.events) == 0 {
+		return model.MessageTypeUnknown, false, nil
+	}
+	switch b.events[0].Type {
+	case ticdc.EventType_EVENT_TYPE_ROW:
+		return model.MessageTypeRow, true, nil
+	case ticdc.EventType_EVENT_TYPE_DDL:
+		return model.MessageTypeDDL, true, nil
+	case ticdc.EventType_EVENT_TYPE_RESOLVED:
+		return model.MessageTypeResolved, true, nil
+	default:
+		return model.MessageTypeUnknown, false, cerror.ErrProtobufCodecInvalidData.
+			GenWithStack("unknown event type %s", b.events[0].Type)
+	}
+}
+
+// NextResolvedEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextResolvedEvent() (uint64, error) {
+	ev, err := b.next(model.MessageTypeResolved)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return ev.CommitTs, nil
+}
+
+// NextRowChangedEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
+	ev, err := b.next(model.MessageTypeRow)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return rowFromProto(ev)
+}
+
+// NextDDLEvent implements the EventBatchDecoder interface
+func (b *batchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
+	ev, err := b.next(model.MessageTypeDDL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return ddlFromProto(ev)
+}
+
+func (b *batchDecoder) next(expected model.MessageType) (*ticdc.Event, error) {
+	tp, hasNext, err := b.HasNext()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !hasNext || tp != expected {
+		return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack(
+			"not found event of type %d", expected)
+	}
+	ev := b.events[0]
+	b.events = b.events[1:]
+	return ev, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/proto/ticdc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtobufRowChangedEvent(t *testing.T) {
+	t.Parallel()
+
+	decimal := types.NewFieldType(mysql.TypeNewDecimal)
+	decimal.SetFlen(10)
+	decimal.SetDecimal(2)
+	varchar := types.NewFieldType(mysql.TypeVarchar)
+	varchar.SetFlen(32)
+	varchar.SetCharset("utf8mb4")
+	varchar.SetCollate("utf8mb4_bin")
+	row := &model.RowChangedEvent{
+		StartTs:  1,
+		CommitTs: 2,
+		Table:    &model.TableName{Schema: "a", Table: "b", TableID: 100, IsPartition: true},
+		PreColumns: []*model.Column{
+			{
+				Name:  "id",
+				Type:  mysql.TypeLonglong,
+				Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag | model.UnsignedFlag,
+				Value: uint64(18446744073709551615),
+			},
+			{Name: "price", Type: mysql.TypeNewDecimal, Flag: model.NullableFlag, Value: "12.30"},
+			{Name: "name", Type: mysql.TypeVarchar, Flag: model.NullableFlag, Value: []byte("aa")},
+		},
+		Columns: []*model.Column{
+			{
+				Name:  "id",
+				Type:  mysql.TypeLonglong,
+				Flag:  model.HandleKeyFlag | model.PrimaryKeyFlag | model.UnsignedFlag,
+				Value: uint64(18446744073709551615),
+			},
+			{Name: "price", Type: mysql.TypeNewDecimal, Flag: model.NullableFlag, Value: nil},
+			{Name: "name", Type: mysql.TypeVarchar, Flag: model.NullableFlag, Value: []byte("bb")},
+		},
+		ColInfos: []rowcodec.ColInfo{
+			{Ft: types.NewFieldType(mysql.TypeLonglong)},
+			{Ft: decimal},
+			{Ft: varchar},
+		},
+	}
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolProtobuf)).Build()
+	require.Nil(t, encoder.AppendRowChangedEvent(context.Background(), "", row, nil))
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	// The full type info is available to the clients.
+	msg := &ticdc.Message{}
+	require.Nil(t, msg.Unmarshal(messages[0].Value))
+	require.Equal(t, uint32(protocolVersion), msg.Version)
+	price := msg.Events[0].Row.PreColumns[1].Type
+	require.Equal(t, "decimal", price.Name)
+	require.Equal(t, int32(10), price.Length)
+	require.Equal(t, int32(2), price.Decimal)
+	name := msg.Events[0].Row.Columns[2].Type
+	require.Equal(t, "utf8mb4", name.Charset)
+	require.Equal(t, "utf8mb4_bin", name.Collation)
+
+	decoder, err := NewBatchDecoder(messages[0].Value)
+	require.Nil(t, err)
+	tp, hasNext, err := decoder.HasNext()
+	require.Nil(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeRow, tp)
+	decoded, err := decoder.NextRowChangedEvent()
+	require.Nil(t, err)
+	require.Equal(t, row.StartTs, decoded.StartTs)
+	require.Equal(t, row.CommitTs, decoded.CommitTs)
+	require.Equal(t, row.Table, decoded.Table)
+	for i, cols := range [][]*model.Column{row.PreColumns, row.Columns} {
+		decodedCols := decoded.PreColumns
+		if i == 1 {
+			decodedCols = decoded.Columns
+		}
+		require.Len(t, decodedCols, len(cols))
+		for j, col := range cols {
+			require.Equal(t, col.Name, decodedCols[j].Name)
+			require.Equal(t, col.Type, decodedCols[j].Type)
+			require.Equal(t, col.Flag, decodedCols[j].Flag)
+		}
+		require.Equal(t, cols[0].Value, decodedCols[0].Value)
+		require.Equal(t, cols[1].Value, decodedCols[1].Value)
+		require.Equal(t, string(cols[2].Value.([]byte)), decodedCols[2].Value)
+	}
+	require.Equal(t, "utf8mb4", decoded.Columns[2].Charset)
+
+	_, hasNext, err = decoder.HasNext()
+	require.Nil(t, err)
+	require.False(t, hasNext)
+}
+
+func TestProtobufDDLAndResolvedEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolProtobuf)).Build()
+	ddl := &model.DDLEvent{
+		StartTs:  1,
+		CommitTs: 2,
+		TableInfo: &model.SimpleTableInfo{
+			Schema:     "a",
+			Table:      "c",
+			TableID:    101,
+			ColumnInfo: []*model.ColumnInfo{{Name: "id", Type: mysql.TypeLong}},
+		},
+		PreTableInfo: &model.SimpleTableInfo{Schema: "a", Table: "b", TableID: 101},
+		Query:        "rename table a.b to a.c",
+		Type:         timodel.ActionRenameTable,
+	}
+	m, err := encoder.EncodeDDLEvent(ddl)
+	require.Nil(t, err)
+	decoder, err := NewBatchDecoder(m.Value)
+	require.Nil(t, err)
+	tp, hasNext, err := decoder.HasNext()
+	require.Nil(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeDDL, tp)
+	decoded, err := decoder.NextDDLEvent()
+	require.Nil(t, err)
+	require.Equal(t, ddl, decoded)
+
+	m, err = encoder.EncodeCheckpointEvent(3)
+	require.Nil(t, err)
+	decoder, err = NewBatchDecoder(m.Value)
+	require.Nil(t, err)
+	ts, err := decoder.NextResolvedEvent()
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), ts)
+	_, err = decoder.NextResolvedEvent()
+	require.Regexp(t, ".*ErrProtobufCodecInvalidData.*", err)
+
+	_, err = NewBatchDecoder([]byte("invalid"))
+	require.Regexp(t, ".*ErrProtobufCodecInvalidData.*", err)
+}
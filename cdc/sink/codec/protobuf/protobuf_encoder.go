@@ -0,0 +1,169 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/proto/ticdc"
+	"go.uber.org/zap"
+)
+
+// messageOverhead is the size of the fields of a message other than the
+// events, which is the version now.
+var messageOverhead = (&ticdc.Message{Version: protocolVersion}).Size()
+
+// BatchEncoder encodes the events into the protobuf messages described by
+// `proto/TiCDCEvent.proto`. The row changed events are batched into one
+// message until either MaxBatchSize or MaxMessageBytes is reached.
+type BatchEncoder struct {
+	rows        []*ticdc.Event
+	rowsSize    int
+	callbackBuf []func()
+	messageBuf  []*common.Message
+
+	// configs
+	MaxMessageBytes int
+	MaxBatchSize    int
+}
+
+// EncodeCheckpointEvent implements the EventBatchEncoder interface
+func (e *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	value, err := encode([]*ticdc.Event{{
+		Type:     ticdc.EventType_EVENT_TYPE_RESOLVED,
+		CommitTs: ts,
+	}})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.NewResolvedMsg(config.ProtocolProtobuf, nil, value, ts), nil
+}
+
+// AppendRowChangedEvent implements the EventBatchEncoder interface
+func (e *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context,
+	_ string,
+	ev *model.RowChangedEvent,
+	callback func(),
+) error {
+	row, err := rowToProto(ev)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The size of the row in the message, including its tag and length.
+	size := (&ticdc.Message{Events: []*ticdc.Event{row}}).Size()
+	if len(e.rows) > 0 && messageOverhead+e.rowsSize+size > e.MaxMessageBytes {
+		if err := e.flush(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	e.rows = append(e.rows, row)
+	e.rowsSize += size
+	if callback != nil {
+		e.callbackBuf = append(e.callbackBuf, callback)
+	}
+	if len(e.rows) >= e.MaxBatchSize {
+		return e.flush()
+	}
+	return nil
+}
+
+// EncodeDDLEvent implements the EventBatchEncoder interface
+func (e *BatchEncoder) EncodeDDLEvent(ev *model.DDLEvent) (*common.Message, error) {
+	value, err := encode([]*ticdc.Event{ddlToProto(ev)})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.NewDDLMsg(config.ProtocolProtobuf, nil, value, ev), nil
+}
+
+// Build implements the EventBatchEncoder interface
+func (e *BatchEncoder) Build() []*common.Message {
+	if len(e.rows) > 0 {
+		// flush buffered rows to message buffer
+		if err := e.flush(); err != nil {
+			log.Panic("protobuf encoder failed to build message", zap.Error(err))
+		}
+	}
+	ret := e.messageBuf
+	e.messageBuf = make([]*common.Message, 0, 2)
+	return ret
+}
+
+func (e *BatchEncoder) flush() error {
+	value, err := encode(e.rows)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	first := e.rows[0]
+	message := common.NewMsg(config.ProtocolProtobuf, nil, value, first.CommitTs,
+		model.MessageTypeRow, &first.Row.Table.Schema, &first.Row.Table.Table)
+	message.SetRowsCount(len(e.rows))
+	if len(e.callbackBuf) != 0 && len(e.callbackBuf) == len(e.rows) {
+		callbacks := e.callbackBuf
+		message.Callback = func() {
+			for _, cb := range callbacks {
+				cb()
+			}
+		}
+		e.callbackBuf = make([]func(), 0)
+	}
+	e.messageBuf = append(e.messageBuf, message)
+	e.rows = nil
+	e.rowsSize = 0
+	return nil
+}
+
+func encode(events []*ticdc.Event) ([]byte, error) {
+	value, err := (&ticdc.Message{
+		Version: protocolVersion,
+		Events:  events,
+	}).Marshal()
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrProtobufEncodeFailed, err)
+	}
+	return value, nil
+}
+
+// NewBatchEncoder creates a new BatchEncoder.
+func NewBatchEncoder() codec.EventBatchEncoder {
+	return &BatchEncoder{
+		messageBuf:  make([]*common.Message, 0, 2),
+		callbackBuf: make([]func(), 0),
+	}
+}
+
+type batchEncoderBuilder struct {
+	config *common.Config
+}
+
+// Build a BatchEncoder
+func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
+	encoder := NewBatchEncoder()
+	encoder.(*BatchEncoder).MaxMessageBytes = b.config.MaxMessageBytes
+	encoder.(*BatchEncoder).MaxBatchSize = b.config.MaxBatchSize
+	return encoder
+}
+
+// NewBatchEncoderBuilder creates a protobuf batchEncoderBuilder.
+func NewBatchEncoderBuilder(config *common.Config) codec.EncoderBuilder {
+	return &batchEncoderBuilder{config: config}
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtobufMaxMessageBytes(t *testing.T) {
+	t.Parallel()
+	cfg := common.NewConfig(config.ProtocolProtobuf).WithMaxMessageBytes(256)
+	encoder := NewBatchEncoderBuilder(cfg).Build()
+
+	testEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{{
+			Name:  "col1",
+			Type:  mysql.TypeVarchar,
+			Value: []byte("aa"),
+		}},
+	}
+
+	for i := 0; i < 10000; i++ {
+		err := encoder.AppendRowChangedEvent(context.Background(), "", testEvent, nil)
+		require.Nil(t, err)
+	}
+
+	messages := encoder.Build()
+	rows := 0
+	for _, msg := range messages {
+		require.LessOrEqual(t, msg.Length(), 256)
+		rows += msg.GetRowsCount()
+	}
+	require.Equal(t, 10000, rows)
+}
+
+func TestProtobufMaxBatchSize(t *testing.T) {
+	t.Parallel()
+	cfg := common.NewConfig(config.ProtocolProtobuf).WithMaxMessageBytes(10485760)
+	cfg.MaxBatchSize = 64
+	encoder := NewBatchEncoderBuilder(cfg).Build()
+
+	testEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: mysql.TypeVarchar, Value: []byte("aa")}},
+	}
+
+	callbacks := 0
+	for i := 0; i < 10000; i++ {
+		err := encoder.AppendRowChangedEvent(context.Background(), "", testEvent, func() {
+			callbacks++
+		})
+		require.Nil(t, err)
+	}
+
+	messages := encoder.Build()
+	sum := 0
+	for _, msg := range messages {
+		decoder, err := NewBatchDecoder(msg.Value)
+		require.Nil(t, err)
+		count := 0
+		for {
+			tp, hasNext, err := decoder.HasNext()
+			require.Nil(t, err)
+			if !hasNext {
+				break
+			}
+			require.Equal(t, model.MessageTypeRow, tp)
+			_, err = decoder.NextRowChangedEvent()
+			require.Nil(t, err)
+			count++
+		}
+		require.LessOrEqual(t, count, 64)
+		require.Equal(t, count, msg.GetRowsCount())
+		sum += count
+		msg.Callback()
+	}
+	require.Equal(t, 10000, sum)
+	require.Equal(t, 10000, callbacks)
+}
+
+func TestProtobufUnsupportedValue(t *testing.T) {
+	t.Parallel()
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolProtobuf)).Build()
+	err := encoder.AppendRowChangedEvent(context.Background(), "", &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: mysql.TypeLong, Value: int32(1)}},
+	}, nil)
+	require.Regexp(t, ".*ErrProtobufEncodeFailed.*", err)
+}
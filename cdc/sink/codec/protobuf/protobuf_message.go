@@ -0,0 +1,311 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"github.com/pingcap/tidb/parser/charset"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	parser_types "github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/proto/ticdc"
+)
+
+// protocolVersion is the version of the protocol, which is the version of
+// the package of `proto/TiCDCEvent.proto`.
+const protocolVersion = 1
+
+func tableNameToProto(t *model.TableName) *ticdc.TableName {
+	return &ticdc.TableName{
+		Schema:      t.Schema,
+		Table:       t.Table,
+		TableId:     t.TableID,
+		IsPartition: t.IsPartition,
+	}
+}
+
+func tableNameFromProto(t *ticdc.TableName) *model.TableName {
+	return &model.TableName{
+		Schema:      t.GetSchema(),
+		Table:       t.GetTable(),
+		TableID:     t.GetTableId(),
+		IsPartition: t.GetIsPartition(),
+	}
+}
+
+func rowToProto(e *model.RowChangedEvent) (*ticdc.Event, error) {
+	row := &ticdc.RowChanged{
+		Table:   tableNameToProto(e.Table),
+		StartTs: e.StartTs,
+	}
+	var err error
+	if row.Columns, err = columnsToProto(e.Columns, e); err != nil {
+		return nil, err
+	}
+	if row.PreColumns, err = columnsToProto(e.PreColumns, e); err != nil {
+		return nil, err
+	}
+	return &ticdc.Event{
+		Type:     ticdc.EventType_EVENT_TYPE_ROW,
+		CommitTs: e.CommitTs,
+		Row:      row,
+	}, nil
+}
+
+func columnsToProto(cols []*model.Column, e *model.RowChangedEvent) ([]*ticdc.Column, error) {
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	// The column infos are aligned with the columns if they are available.
+	withColInfos := len(e.ColInfos) == len(cols)
+	ret := make([]*ticdc.Column, 0, len(cols))
+	for i, col := range cols {
+		if col == nil {
+			continue
+		}
+		var ft *types.FieldType
+		if withColInfos {
+			ft = e.ColInfos[i].Ft
+		}
+		c, err := columnToProto(col, ft)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, c)
+	}
+	return ret, nil
+}
+
+// columnToProto converts the column, the field type could be nil if the
+// column info is unavailable.
+func columnToProto(col *model.Column, ft *types.FieldType) (*ticdc.Column, error) {
+	tp := &ticdc.ColumnType{
+		Type:     uint32(col.Type),
+		Name:     typeName(col.Type, col.Flag.IsBinary()),
+		Unsigned: col.Flag.IsUnsigned(),
+		Binary:   col.Flag.IsBinary(),
+		Length:   types.UnspecifiedLength,
+		Decimal:  types.UnspecifiedLength,
+		Charset:  col.Charset,
+	}
+	if ft != nil {
+		tp.Length = int32(ft.GetFlen())
+		tp.Decimal = int32(ft.GetDecimal())
+		tp.Charset = ft.GetCharset()
+		tp.Collation = ft.GetCollate()
+		tp.Elems = ft.GetElems()
+	}
+	value, err := valueToProto(col)
+	if err != nil {
+		return nil, err
+	}
+	return &ticdc.Column{
+		Name:        col.Name,
+		Type:        tp,
+		Nullable:    col.Flag.IsNullable(),
+		PrimaryKey:  col.Flag.IsPrimaryKey(),
+		HandleKey:   col.Flag.IsHandleKey(),
+		UniqueKey:   col.Flag.IsUniqueKey(),
+		MultipleKey: col.Flag.IsMultipleKey(),
+		Generated:   col.Flag.IsGeneratedColumn(),
+		Value:       value,
+	}, nil
+}
+
+func typeName(tp byte, binary bool) string {
+	cs := ""
+	if binary {
+		cs = charset.CharsetBin
+	}
+	return parser_types.TypeToStr(tp, cs)
+}
+
+func isStringType(tp byte) bool {
+	switch tp {
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob:
+		return true
+	}
+	return false
+}
+
+func valueToProto(col *model.Column) (*ticdc.Value, error) {
+	if col.Value == nil {
+		return nil, nil
+	}
+	// The strings are sent as bytes only if they are binary.
+	if isStringType(col.Type) {
+		switch v := col.Value.(type) {
+		case []byte:
+			if col.Flag.IsBinary() {
+				return &ticdc.Value{Value: &ticdc.Value_BytesValue{BytesValue: v}}, nil
+			}
+			return &ticdc.Value{Value: &ticdc.Value_StringValue{StringValue: string(v)}}, nil
+		case string:
+			if col.Flag.IsBinary() {
+				return &ticdc.Value{Value: &ticdc.Value_BytesValue{BytesValue: []byte(v)}}, nil
+			}
+			return &ticdc.Value{Value: &ticdc.Value_StringValue{StringValue: v}}, nil
+		}
+	}
+	switch v := col.Value.(type) {
+	case int64:
+		return &ticdc.Value{Value: &ticdc.Value_Int64Value{Int64Value: v}}, nil
+	case int:
+		return &ticdc.Value{Value: &ticdc.Value_Int64Value{Int64Value: int64(v)}}, nil
+	case uint64:
+		return &ticdc.Value{Value: &ticdc.Value_Uint64Value{Uint64Value: v}}, nil
+	case float32:
+		return &ticdc.Value{Value: &ticdc.Value_DoubleValue{DoubleValue: float64(v)}}, nil
+	case float64:
+		return &ticdc.Value{Value: &ticdc.Value_DoubleValue{DoubleValue: v}}, nil
+	case []byte:
+		return &ticdc.Value{Value: &ticdc.Value_BytesValue{BytesValue: v}}, nil
+	case string:
+		return &ticdc.Value{Value: &ticdc.Value_StringValue{StringValue: v}}, nil
+	default:
+		return nil, cerror.ErrProtobufEncodeFailed.GenWithStack(
+			"unsupported value type %T of column %s", col.Value, col.Name)
+	}
+}
+
+func rowFromProto(ev *ticdc.Event) (*model.RowChangedEvent, error) {
+	row := ev.GetRow()
+	if row == nil || row.GetTable() == nil {
+		return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack("row not found")
+	}
+	return &model.RowChangedEvent{
+		StartTs:    row.GetStartTs(),
+		CommitTs:   ev.GetCommitTs(),
+		Table:      tableNameFromProto(row.GetTable()),
+		Columns:    columnsFromProto(row.GetColumns()),
+		PreColumns: columnsFromProto(row.GetPreColumns()),
+	}, nil
+}
+
+func columnsFromProto(cols []*ticdc.Column) []*model.Column {
+	if len(cols) == 0 {
+		return nil
+	}
+	ret := make([]*model.Column, 0, len(cols))
+	for _, c := range cols {
+		col := &model.Column{
+			Name:    c.GetName(),
+			Type:    byte(c.GetType().GetType()),
+			Charset: c.GetType().GetCharset(),
+		}
+		flags := []struct {
+			isSet bool
+			set   func()
+		}{
+			{c.GetType().GetUnsigned(), col.Flag.SetIsUnsigned},
+			{c.GetType().GetBinary(), col.Flag.SetIsBinary},
+			{c.GetNullable(), col.Flag.SetIsNullable},
+			{c.GetPrimaryKey(), col.Flag.SetIsPrimaryKey},
+			{c.GetHandleKey(), col.Flag.SetIsHandleKey},
+			{c.GetUniqueKey(), col.Flag.SetIsUniqueKey},
+			{c.GetMultipleKey(), col.Flag.SetIsMultipleKey},
+			{c.GetGenerated(), col.Flag.SetIsGeneratedColumn},
+		}
+		for _, f := range flags {
+			if f.isSet {
+				f.set()
+			}
+		}
+		switch v := c.GetValue().GetValue().(type) {
+		case *ticdc.Value_Int64Value:
+			col.Value = v.Int64Value
+		case *ticdc.Value_Uint64Value:
+			col.Value = v.Uint64Value
+		case *ticdc.Value_DoubleValue:
+			col.Value = v.DoubleValue
+		case *ticdc.Value_BytesValue:
+			col.Value = v.BytesValue
+		case *ticdc.Value_StringValue:
+			col.Value = v.StringValue
+		}
+		ret = append(ret, col)
+	}
+	return ret
+}
+
+func ddlToProto(e *model.DDLEvent) *ticdc.Event {
+	ddl := &ticdc.DDL{
+		StartTs: e.StartTs,
+		Query:   e.Query,
+		Type:    uint32(e.Type),
+	}
+	if e.TableInfo != nil {
+		ddl.Table = &ticdc.TableName{
+			Schema:  e.TableInfo.Schema,
+			Table:   e.TableInfo.Table,
+			TableId: e.TableInfo.TableID,
+		}
+		for _, col := range e.TableInfo.ColumnInfo {
+			ddl.Columns = append(ddl.Columns, &ticdc.Column{
+				Name: col.Name,
+				Type: &ticdc.ColumnType{
+					Type: uint32(col.Type),
+					Name: typeName(col.Type, false),
+				},
+			})
+		}
+	}
+	if e.PreTableInfo != nil {
+		ddl.PreTable = &ticdc.TableName{
+			Schema:  e.PreTableInfo.Schema,
+			Table:   e.PreTableInfo.Table,
+			TableId: e.PreTableInfo.TableID,
+		}
+	}
+	return &ticdc.Event{
+		Type:     ticdc.EventType_EVENT_TYPE_DDL,
+		CommitTs: e.CommitTs,
+		Ddl:      ddl,
+	}
+}
+
+func ddlFromProto(ev *ticdc.Event) (*model.DDLEvent, error) {
+	ddl := ev.GetDdl()
+	if ddl == nil {
+		return nil, cerror.ErrProtobufCodecInvalidData.GenWithStack("ddl not found")
+	}
+	e := &model.DDLEvent{
+		StartTs:  ddl.GetStartTs(),
+		CommitTs: ev.GetCommitTs(),
+		Query:    ddl.GetQuery(),
+		Type:     timodel.ActionType(ddl.GetType()),
+		TableInfo: &model.SimpleTableInfo{
+			Schema:  ddl.GetTable().GetSchema(),
+			Table:   ddl.GetTable().GetTable(),
+			TableID: ddl.GetTable().GetTableId(),
+		},
+	}
+	for _, col := range ddl.GetColumns() {
+		e.TableInfo.ColumnInfo = append(e.TableInfo.ColumnInfo, &model.ColumnInfo{
+			Name: col.GetName(),
+			Type: byte(col.GetType().GetType()),
+		})
+	}
+	if ddl.GetPreTable() != nil {
+		e.PreTableInfo = &model.SimpleTableInfo{
+			Schema:  ddl.GetPreTable().GetSchema(),
+			Table:   ddl.GetPreTable().GetTable(),
+			TableID: ddl.GetPreTable().GetTableId(),
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnselector
+
+import (
+	"fmt"
+
+	filter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
+	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher/partition"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+type selector struct {
+	tableF  filter.Filter
+	columnM filter.ColumnFilter
+}
+
+func newSelector(rule *config.ColumnSelector, caseSensitive bool) (*selector, error) {
+	tableM, err := filter.Parse(rule.Matcher)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, rule.Matcher)
+	}
+	if !caseSensitive {
+		tableM = filter.CaseInsensitive(tableM)
+	}
+	columnM, err := filter.ParseColumnFilter(rule.Columns)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, rule.Columns)
+	}
+
+	return &selector{
+		tableF:  tableM,
+		columnM: columnM,
+	}, nil
+}
+
+// match returns true if the table is matched by the selector.
+func (s *selector) match(schema, table string) bool {
+	return s.tableF.MatchTable(schema, table)
+}
+
+// apply returns a copy of the row changed event with the unselected columns removed.
+// The removed columns are set to nil instead of being deleted from the slice,
+// so the column offsets, such as `ColInfos` and `IndexColumns`, are still valid.
+func (s *selector) apply(event *model.RowChangedEvent) *model.RowChangedEvent {
+	// The event may be shared with other components, such as the redo log manager,
+	// so we must not modify it in place.
+	selected := *event
+	selected.Columns = s.selectColumns(event.Columns)
+	selected.PreColumns = s.selectColumns(event.PreColumns)
+	return &selected
+}
+
+func (s *selector) selectColumns(columns []*model.Column) []*model.Column {
+	if len(columns) == 0 {
+		return columns
+	}
+	result := make([]*model.Column, len(columns))
+	for i, column := range columns {
+		if column != nil && s.columnM.MatchColumn(column.Name) {
+			result[i] = column
+		}
+	}
+	return result
+}
+
+// ColumnSelector manages an array of selectors, the first selector matches
+// the given event is used to select out columns.
+type ColumnSelector struct {
+	selectors []*selector
+}
+
+// New returns a new ColumnSelector.
+func New(cfg *config.ReplicaConfig) (*ColumnSelector, error) {
+	selectors := make([]*selector, 0, len(cfg.Sink.ColumnSelectors))
+	for _, r := range cfg.Sink.ColumnSelectors {
+		s, err := newSelector(r, cfg.CaseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, s)
+	}
+
+	return &ColumnSelector{
+		selectors: selectors,
+	}, nil
+}
+
+// Apply the column selector to the given event. It returns the event itself
+// if no selector matches, otherwise a copy without the unselected columns.
+func (c *ColumnSelector) Apply(event *model.RowChangedEvent) *model.RowChangedEvent {
+	for _, s := range c.selectors {
+		if s.match(event.Table.Schema, event.Table.Table) {
+			return s.apply(event)
+		}
+	}
+	return event
+}
+
+// VerifyTables returns an error if the column selectors remove any column
+// that is required by the partition dispatcher of the given tables.
+func (c *ColumnSelector) VerifyTables(
+	infos []*model.TableInfo, eventRouter *dispatcher.EventRouter,
+) error {
+	if len(c.selectors) == 0 {
+		return nil
+	}
+
+	for _, table := range infos {
+		d := eventRouter.GetPartitionDispatcher(table.TableName.Schema, table.TableName.Table)
+		// Only the index-value and columns dispatchers rely on specific columns.
+		var dispatchColumns []string
+		switch d := d.(type) {
+		case *partition.IndexValueDispatcher:
+		case *partition.ColumnsDispatcher:
+			dispatchColumns = d.Columns()
+		default:
+			continue
+		}
+		for _, s := range c.selectors {
+			if !s.match(table.TableName.Schema, table.TableName.Table) {
+				continue
+			}
+			for _, name := range dispatchColumns {
+				if !s.columnM.MatchColumn(name) {
+					return cerror.ErrColumnSelectorFailed.GenWithStackByArgs(
+						fmt.Sprintf("column %s of table %s is required by "+
+							"the columns partition dispatcher but not selected",
+							name, table.TableName.String()))
+				}
+			}
+			if dispatchColumns != nil {
+				break
+			}
+			for _, col := range table.Columns {
+				flag := table.ColumnsFlag[col.ID]
+				if !flag.IsHandleKey() {
+					continue
+				}
+				if !s.columnM.MatchColumn(col.Name.O) {
+					return cerror.ErrColumnSelectorFailed.GenWithStackByArgs(
+						fmt.Sprintf("handle key column %s of table %s is required by "+
+							"the index-value partition dispatcher but not selected",
+							col.Name.O, table.TableName.String()))
+				}
+			}
+			// Only the first matched selector takes effect.
+			break
+		}
+	}
+	return nil
+}
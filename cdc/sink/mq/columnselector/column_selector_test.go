@@ -0,0 +1,232 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnselector
+
+import (
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewColumnSelector(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	selectors, err := New(replicaConfig)
+	require.NoError(t, err)
+	require.Len(t, selectors.selectors, 0)
+
+	replicaConfig.Sink.ColumnSelectors = []*config.ColumnSelector{
+		{
+			Matcher: []string{"test.*"},
+			Columns: []string{"a", "b"},
+		},
+		{
+			Matcher: []string{"test1.*"},
+			Columns: []string{"*", "!a"},
+		},
+	}
+	selectors, err = New(replicaConfig)
+	require.NoError(t, err)
+	require.Len(t, selectors.selectors, 2)
+
+	replicaConfig.Sink.ColumnSelectors = []*config.ColumnSelector{
+		{
+			Matcher: []string{"test.*", "rtest1"},
+			Columns: []string{"a"},
+		},
+	}
+	_, err = New(replicaConfig)
+	require.Regexp(t, ".*CDC:ErrFilterRuleInvalid.*", err)
+}
+
+func TestColumnSelectorApply(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.ColumnSelectors = []*config.ColumnSelector{
+		{
+			Matcher: []string{"test.*"},
+			Columns: []string{"a", "b"},
+		},
+		{
+			Matcher: []string{"test1.*"},
+			Columns: []string{"*", "!a"},
+		},
+		{
+			Matcher: []string{"test2.*"},
+			Columns: []string{"co*", "!col2"},
+		},
+	}
+	selectors, err := New(replicaConfig)
+	require.NoError(t, err)
+
+	// Tables not matched by any selector are not changed.
+	event := &model.RowChangedEvent{
+		Table: &model.TableName{Schema: "test_default", Table: "t1"},
+		Columns: []*model.Column{
+			{Name: "a", Value: 1},
+			{Name: "b", Value: 2},
+			{Name: "c", Value: 3},
+		},
+	}
+	require.Same(t, event, selectors.Apply(event))
+
+	event = &model.RowChangedEvent{
+		Table: &model.TableName{Schema: "test", Table: "t1"},
+		Columns: []*model.Column{
+			{Name: "a", Value: 1},
+			{Name: "b", Value: 2},
+			{Name: "c", Value: 3},
+		},
+		PreColumns: []*model.Column{
+			{Name: "a", Value: 4},
+			{Name: "b", Value: 5},
+			{Name: "c", Value: 6},
+		},
+	}
+	selected := selectors.Apply(event)
+	require.Equal(t, []*model.Column{
+		{Name: "a", Value: 1},
+		{Name: "b", Value: 2},
+		nil,
+	}, selected.Columns)
+	require.Equal(t, []*model.Column{
+		{Name: "a", Value: 4},
+		{Name: "b", Value: 5},
+		nil,
+	}, selected.PreColumns)
+	// The original event must not be modified.
+	require.NotNil(t, event.Columns[2])
+	require.NotNil(t, event.PreColumns[2])
+
+	event = &model.RowChangedEvent{
+		Table: &model.TableName{Schema: "test1", Table: "t1"},
+		PreColumns: []*model.Column{
+			{Name: "a", Value: 1},
+			{Name: "b", Value: 2},
+			nil,
+		},
+	}
+	selected = selectors.Apply(event)
+	require.Len(t, selected.Columns, 0)
+	require.Equal(t, []*model.Column{
+		nil,
+		{Name: "b", Value: 2},
+		nil,
+	}, selected.PreColumns)
+
+	event = &model.RowChangedEvent{
+		Table: &model.TableName{Schema: "test2", Table: "t1"},
+		Columns: []*model.Column{
+			{Name: "a", Value: 1},
+			{Name: "col2", Value: 2},
+			{Name: "col3", Value: 3},
+		},
+	}
+	selected = selectors.Apply(event)
+	require.Equal(t, []*model.Column{
+		nil,
+		nil,
+		{Name: "col3", Value: 3},
+	}, selected.Columns)
+}
+
+func newTableInfo(schema, table string) *model.TableInfo {
+	pkType := types.NewFieldType(mysql.TypeLong)
+	pkType.AddFlag(mysql.PriKeyFlag | mysql.NotNullFlag)
+	tableInfo := &timodel.TableInfo{
+		ID:         1,
+		Name:       timodel.NewCIStr(table),
+		PKIsHandle: true,
+		Columns: []*timodel.ColumnInfo{
+			{
+				ID:        1,
+				Name:      timodel.NewCIStr("id"),
+				FieldType: *pkType,
+				State:     timodel.StatePublic,
+			},
+			{
+				ID:        2,
+				Name:      timodel.NewCIStr("name"),
+				FieldType: *types.NewFieldType(mysql.TypeVarchar),
+				State:     timodel.StatePublic,
+			},
+		},
+	}
+	return model.WrapTableInfo(1, schema, 0, tableInfo)
+}
+
+func TestVerifyTables(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{
+			Matcher:       []string{"test.*"},
+			PartitionRule: "index-value",
+		},
+	}
+	replicaConfig.Sink.ColumnSelectors = []*config.ColumnSelector{
+		{
+			Matcher: []string{"test.t1", "test1.*"},
+			Columns: []string{"name"},
+		},
+		{
+			Matcher: []string{"test.*"},
+			Columns: []string{"id"},
+		},
+	}
+	eventRouter, err := dispatcher.NewEventRouter(replicaConfig, "")
+	require.NoError(t, err)
+	selectors, err := New(replicaConfig)
+	require.NoError(t, err)
+
+	// The handle key is selected.
+	err = selectors.VerifyTables([]*model.TableInfo{newTableInfo("test", "t2")}, eventRouter)
+	require.NoError(t, err)
+	// The handle key is removed, but the table uses the default partition dispatcher.
+	err = selectors.VerifyTables([]*model.TableInfo{newTableInfo("test1", "t1")}, eventRouter)
+	require.NoError(t, err)
+	// The handle key is removed and the table uses the index-value partition dispatcher.
+	err = selectors.VerifyTables([]*model.TableInfo{
+		newTableInfo("test", "t2"),
+		newTableInfo("test", "t1"),
+	}, eventRouter)
+	require.True(t, cerror.ErrColumnSelectorFailed.Equal(err))
+	require.Regexp(t, "handle key column id of table test.t1", err)
+
+	// The columns partition dispatcher requires the selected columns.
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{
+			Matcher:       []string{"test.*"},
+			PartitionRule: "columns",
+			Columns:       []string{"name"},
+		},
+	}
+	eventRouter, err = dispatcher.NewEventRouter(replicaConfig, "")
+	require.NoError(t, err)
+	err = selectors.VerifyTables([]*model.TableInfo{newTableInfo("test", "t1")}, eventRouter)
+	require.NoError(t, err)
+	err = selectors.VerifyTables([]*model.TableInfo{newTableInfo("test", "t2")}, eventRouter)
+	require.True(t, cerror.ErrColumnSelectorFailed.Equal(err))
+	require.Regexp(t, "column name of table test.t2 is required by the columns", err)
+}
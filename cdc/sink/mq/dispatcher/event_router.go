@@ -14,6 +14,7 @@
 package dispatcher
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pingcap/log"
@@ -44,6 +45,7 @@ const (
 	partitionDispatchRuleTS
 	partitionDispatchRuleTable
 	partitionDispatchRuleIndexValue
+	partitionDispatchRuleColumns
 )
 
 func (r *partitionDispatchRule) fromString(rule string) {
@@ -59,9 +61,11 @@ func (r *partitionDispatchRule) fromString(rule string) {
 		log.Warn("rowid is deprecated, please use index-value instead.")
 	case "index-value":
 		*r = partitionDispatchRuleIndexValue
+	case "columns":
+		*r = partitionDispatchRuleColumns
 	default:
 		*r = partitionDispatchRuleDefault
-		log.Warn("the partition dispatch rule is not default/ts/table/index-value," +
+		log.Warn("the partition dispatch rule is not default/ts/table/index-value/columns," +
 			" use the default rule instead.")
 	}
 }
@@ -70,7 +74,9 @@ func (r *partitionDispatchRule) fromString(rule string) {
 // an event should be dispatched to.
 type EventRouter struct {
 	defaultTopic string
-	rules        []struct {
+	// schemaChangeTopic is the topic of all the DDL events if it's not empty.
+	schemaChangeTopic string
+	rules             []struct {
 		partitionDispatcher partition.Dispatcher
 		topicDispatcher     topic.Dispatcher
 		filter.Filter
@@ -102,7 +108,10 @@ func NewEventRouter(cfg *config.ReplicaConfig, defaultTopic string) (*EventRoute
 			f = filter.CaseInsensitive(f)
 		}
 
-		d := getPartitionDispatcher(ruleConfig, cfg.EnableOldValue)
+		d, err := getPartitionDispatcher(ruleConfig, cfg.EnableOldValue)
+		if err != nil {
+			return nil, err
+		}
 		t, err := getTopicDispatcher(ruleConfig, defaultTopic, cfg.Sink.Protocol)
 		if err != nil {
 			return nil, err
@@ -115,8 +124,9 @@ func NewEventRouter(cfg *config.ReplicaConfig, defaultTopic string) (*EventRoute
 	}
 
 	return &EventRouter{
-		defaultTopic: defaultTopic,
-		rules:        rules,
+		defaultTopic:      defaultTopic,
+		schemaChangeTopic: cfg.Sink.SchemaChangeTopic,
+		rules:             rules,
 	}, nil
 }
 
@@ -128,6 +138,9 @@ func (s *EventRouter) GetTopicForRowChange(row *model.RowChangedEvent) string {
 
 // GetTopicForDDL returns the target topic for DDL.
 func (s *EventRouter) GetTopicForDDL(ddl *model.DDLEvent) string {
+	if s.schemaChangeTopic != "" {
+		return s.schemaChangeTopic
+	}
 	var schema, table string
 	if ddl.PreTableInfo != nil {
 		if ddl.PreTableInfo.Table == "" {
@@ -161,12 +174,19 @@ func (s *EventRouter) GetPartitionForRowChange(
 	)
 }
 
+// GetPartitionDispatcher returns the partition dispatcher for a specific table.
+func (s *EventRouter) GetPartitionDispatcher(schema, table string) partition.Dispatcher {
+	_, partitionDispatcher := s.matchDispatcher(schema, table)
+	return partitionDispatcher
+}
+
 // GetDLLDispatchRuleByProtocol returns the DDL
 // distribution rule according to the protocol.
 func (s *EventRouter) GetDLLDispatchRuleByProtocol(
 	protocol config.Protocol,
 ) DDLDispatchRule {
-	if protocol == config.ProtocolCanal || protocol == config.ProtocolCanalJSON {
+	if protocol == config.ProtocolCanal || protocol == config.ProtocolCanalJSON ||
+		protocol == config.ProtocolDebezium {
 		return PartitionZero
 	}
 	return PartitionAll
@@ -221,10 +241,42 @@ func (s *EventRouter) matchDispatcher(
 	return nil, nil
 }
 
+// VerifyTables returns an error if a columns partition dispatcher
+// refers to a column which does not exist in the given tables.
+func (s *EventRouter) VerifyTables(infos []*model.TableInfo) error {
+	for _, table := range infos {
+		d := s.GetPartitionDispatcher(table.TableName.Schema, table.TableName.Table)
+		columnsDispatcher, ok := d.(*partition.ColumnsDispatcher)
+		if !ok {
+			continue
+		}
+		for _, name := range columnsDispatcher.Columns() {
+			if !hasColumn(table, name) {
+				return cerror.ErrDispatchRuleInvalid.GenWithStackByArgs(
+					fmt.Sprintf("column %s required by the columns partition "+
+						"dispatcher is not found in table %s",
+						name, table.TableName.String()))
+			}
+		}
+	}
+	return nil
+}
+
+// hasColumn returns true if the table has a column with the given name.
+// Column names are case-insensitive.
+func hasColumn(table *model.TableInfo, name string) bool {
+	for _, col := range table.Columns {
+		if col.Name.L == strings.ToLower(name) {
+			return true
+		}
+	}
+	return false
+}
+
 // getPartitionDispatcher returns the partition dispatcher for a specific partition rule.
 func getPartitionDispatcher(
 	ruleConfig *config.DispatchRule, enableOldValue bool,
-) partition.Dispatcher {
+) (partition.Dispatcher, error) {
 	var (
 		d    partition.Dispatcher
 		rule partitionDispatchRule
@@ -238,6 +290,13 @@ func getPartitionDispatcher(
 				"switching on the old value, so please use caution!")
 		}
 		d = partition.NewIndexValueDispatcher()
+	case partitionDispatchRuleColumns:
+		if len(ruleConfig.Columns) == 0 {
+			return nil, cerror.ErrDispatchRuleInvalid.GenWithStackByArgs(
+				fmt.Sprintf("columns must be specified for the columns partition "+
+					"dispatcher, matcher: %v", ruleConfig.Matcher))
+		}
+		d = partition.NewColumnsDispatcher(ruleConfig.Columns)
 	case partitionDispatchRuleTS:
 		d = partition.NewTsDispatcher()
 	case partitionDispatchRuleTable:
@@ -246,7 +305,7 @@ func getPartitionDispatcher(
 		d = partition.NewDefaultDispatcher(enableOldValue)
 	}
 
-	return d
+	return d, nil
 }
 
 // getTopicDispatcher returns the topic dispatcher for a specific topic rule (aka topic expression).
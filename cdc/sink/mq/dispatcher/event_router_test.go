@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher/partition"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher/topic"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -300,6 +301,58 @@ func TestGetPartitionForRowChange(t *testing.T) {
 	require.Equal(t, int32(1), p)
 }
 
+func TestColumnsDispatchRule(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEventRouter(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			DispatchRules: []*config.DispatchRule{
+				{
+					Matcher:       []string{"test.*"},
+					PartitionRule: "columns",
+				},
+			},
+		},
+	}, "test")
+	require.True(t, cerror.ErrDispatchRuleInvalid.Equal(err))
+
+	d, err := NewEventRouter(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			DispatchRules: []*config.DispatchRule{
+				{
+					Matcher:       []string{"test.*"},
+					PartitionRule: "columns",
+					Columns:       []string{"Tenant_ID"},
+				},
+			},
+		},
+	}, "test")
+	require.NoError(t, err)
+	require.IsType(t, &partition.ColumnsDispatcher{}, d.GetPartitionDispatcher("test", "t1"))
+
+	newTableInfo := func(schema, table string, columns ...string) *model.TableInfo {
+		info := &timodel.TableInfo{Name: timodel.NewCIStr(table)}
+		for i, name := range columns {
+			info.Columns = append(info.Columns, &timodel.ColumnInfo{
+				ID:    int64(i + 1),
+				Name:  timodel.NewCIStr(name),
+				State: timodel.StatePublic,
+			})
+		}
+		return model.WrapTableInfo(1, schema, 0, info)
+	}
+	err = d.VerifyTables([]*model.TableInfo{
+		newTableInfo("test", "t1", "id", "tenant_id"),
+		// The table does not use the columns partition dispatcher.
+		newTableInfo("test1", "t1", "id"),
+	})
+	require.NoError(t, err)
+	err = d.VerifyTables([]*model.TableInfo{newTableInfo("test", "t2", "id")})
+	require.True(t, cerror.ErrDispatchRuleInvalid.Equal(err))
+	require.Regexp(t, "column Tenant_ID required by the columns partition "+
+		"dispatcher is not found in table test.t2", err)
+}
+
 func TestGetDLLDispatchRuleByProtocol(t *testing.T) {
 	t.Parallel()
 
@@ -348,6 +401,10 @@ func TestGetDLLDispatchRuleByProtocol(t *testing.T) {
 			protocol:     config.ProtocolOpen,
 			expectedRule: PartitionAll,
 		},
+		{
+			protocol:     config.ProtocolDebezium,
+			expectedRule: PartitionZero,
+		},
 	}
 
 	for _, test := range tests {
@@ -468,4 +525,16 @@ func TestGetTopicForDDL(t *testing.T) {
 	for _, test := range tests {
 		require.Equal(t, test.expectedTopic, d.GetTopicForDDL(test.ddl))
 	}
+
+	// All the DDL events are sent to the schema change topic if it's set.
+	d, err = NewEventRouter(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			Protocol:          config.ProtocolDebezium.String(),
+			SchemaChangeTopic: "schema_changes",
+		},
+	}, "test")
+	require.Nil(t, err)
+	for _, test := range tests {
+		require.Equal(t, "schema_changes", d.GetTopicForDDL(test.ddl))
+	}
 }
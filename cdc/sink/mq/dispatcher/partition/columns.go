@@ -0,0 +1,70 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partition
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/hash"
+)
+
+// ColumnsDispatcher is a partition dispatcher which dispatches events
+// based on the values of the given columns.
+type ColumnsDispatcher struct {
+	hasher  *hash.PositionInertia
+	lock    sync.Mutex
+	columns []string
+}
+
+// NewColumnsDispatcher creates a ColumnsDispatcher.
+func NewColumnsDispatcher(columns []string) *ColumnsDispatcher {
+	return &ColumnsDispatcher{
+		hasher:  hash.NewPositionInertia(),
+		columns: columns,
+	}
+}
+
+// Columns returns the names of the columns used for dispatching.
+func (r *ColumnsDispatcher) Columns() []string {
+	return r.columns
+}
+
+// DispatchRowChangedEvent returns the target partition to which
+// a row changed event should be dispatched.
+func (r *ColumnsDispatcher) DispatchRowChangedEvent(row *model.RowChangedEvent, partitionNum int32) int32 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.hasher.Reset()
+	r.hasher.Write([]byte(row.Table.Schema), []byte(row.Table.Table))
+
+	// Delete events only carry the pre columns.
+	dispatchCols := row.Columns
+	if len(row.Columns) == 0 {
+		dispatchCols = row.PreColumns
+	}
+	// Hash the values in the order of the configured columns, so that
+	// the result does not depend on the column order of the table.
+	for _, name := range r.columns {
+		for _, col := range dispatchCols {
+			if col == nil || !strings.EqualFold(col.Name, name) {
+				continue
+			}
+			r.hasher.Write([]byte(name), []byte(model.ColumnValueString(col.Value)))
+			break
+		}
+	}
+	return int32(r.hasher.Sum32() % uint32(partitionNum))
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partition
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnsDispatcher(t *testing.T) {
+	t.Parallel()
+
+	table := &model.TableName{Schema: "test", Table: "t1"}
+	newColumns := func(id, tenantID, name interface{}) []*model.Column {
+		return []*model.Column{
+			{Name: "id", Value: id, Flag: model.HandleKeyFlag},
+			{Name: "tenant_id", Value: tenantID},
+			{Name: "name", Value: name},
+		}
+	}
+	d := NewColumnsDispatcher([]string{"TENANT_ID"})
+	require.Equal(t, []string{"TENANT_ID"}, d.Columns())
+
+	insert := &model.RowChangedEvent{Table: table, Columns: newColumns(1, 10, "a")}
+	p := d.DispatchRowChangedEvent(insert, 16)
+	require.True(t, p >= 0 && p < 16)
+
+	// Rows with the same tenant are dispatched to the same partition
+	// regardless of other columns.
+	update := &model.RowChangedEvent{
+		Table:      table,
+		PreColumns: newColumns(1, 10, "a"),
+		Columns:    newColumns(2, 10, "b"),
+	}
+	require.Equal(t, p, d.DispatchRowChangedEvent(update, 16))
+	// Delete events are dispatched by the pre columns.
+	del := &model.RowChangedEvent{Table: table, PreColumns: newColumns(3, 10, "c")}
+	require.Equal(t, p, d.DispatchRowChangedEvent(del, 16))
+
+	partitions := make(map[int32]struct{})
+	for i := 0; i < 100; i++ {
+		row := &model.RowChangedEvent{Table: table, Columns: newColumns(1, i, "a")}
+		partitions[d.DispatchRowChangedEvent(row, 16)] = struct{}{}
+	}
+	require.Greater(t, len(partitions), 1)
+}
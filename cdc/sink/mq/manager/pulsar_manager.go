@@ -0,0 +1,43 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+)
+
+// pulsarTopicManager is a manager for pulsar topics.
+// Pulsar brokers create the topic automatically when it is looked up
+// for the first time, so the manager only needs to fetch the partitions.
+type pulsarTopicManager struct {
+	producer *pulsar.Producer
+}
+
+// NewPulsarTopicManager creates a new topic manager.
+// The partitions of topics are cached by the producer, so the partition
+// numbers returned by the manager are consistent with the producer.
+func NewPulsarTopicManager(producer *pulsar.Producer) *pulsarTopicManager {
+	return &pulsarTopicManager{producer: producer}
+}
+
+// GetPartitionNum returns the number of partitions of the topic.
+func (m *pulsarTopicManager) GetPartitionNum(topic string) (int32, error) {
+	return m.producer.GetPartitionNum(topic)
+}
+
+// CreateTopicAndWaitUntilVisible looks up the topic, which is created
+// by the pulsar broker automatically if it doesn't exist.
+func (m *pulsarTopicManager) CreateTopicAndWaitUntilVisible(topic string) (int32, error) {
+	return m.producer.GetPartitionNum(topic)
+}
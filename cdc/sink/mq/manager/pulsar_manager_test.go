@@ -0,0 +1,39 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPulsarGetPartitionNum(t *testing.T) {
+	t.Parallel()
+
+	client := pulsar.NewMockClient(map[string]int{"partitioned": 4})
+	producer := pulsar.NewProducer(model.DefaultChangeFeedID("test"), client, pulsar.NewConfig())
+	defer producer.Close()
+	manager := NewPulsarTopicManager(producer)
+
+	num, err := manager.GetPartitionNum("partitioned")
+	require.Nil(t, err)
+	require.Equal(t, int32(4), num)
+
+	num, err = manager.CreateTopicAndWaitUntilVisible("new-topic")
+	require.Nil(t, err)
+	require.Equal(t, int32(1), num)
+}
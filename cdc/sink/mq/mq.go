@@ -26,15 +26,19 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/builder"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sink/mq/columnselector"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
 	"github.com/pingcap/tiflow/cdc/sink/mq/manager"
 	"github.com/pingcap/tiflow/cdc/sink/mq/producer"
 	"github.com/pingcap/tiflow/cdc/sink/mq/producer/kafka"
+	pulsarproducer "github.com/pingcap/tiflow/cdc/sink/mq/producer/pulsar"
 	"github.com/pingcap/tiflow/pkg/chann"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/pingcap/tiflow/pkg/util"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -48,6 +52,7 @@ type resolvedTsEvent struct {
 type mqSink struct {
 	mqProducer     producer.Producer
 	eventRouter    *dispatcher.EventRouter
+	columnSelector *columnselector.ColumnSelector
 	encoderBuilder codec.EncoderBuilder
 	protocol       config.Protocol
 
@@ -80,17 +85,32 @@ func newMqSink(
 		return nil, errors.Trace(err)
 	}
 
+	columnSelector, err := columnselector.New(replicaConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	captureAddr := contextutil.CaptureAddrFromCtx(ctx)
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
 	role := contextutil.RoleFromCtx(ctx)
 
+	var claimCheck *claimcheck.ClaimCheck
+	if encoderConfig.ClaimCheckStorageURI != "" {
+		claimCheck, err = claimcheck.New(ctx, changefeedID,
+			encoderConfig.ClaimCheckStorageURI, encoderConfig.MaxMessageBytes)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	encoder := encoderBuilder.Build()
 	statistics := metrics.NewStatistics(ctx, captureAddr, metrics.SinkTypeMQ)
-	flushWorker := newFlushWorker(encoder, mqProducer, statistics)
+	flushWorker := newFlushWorker(encoder, claimCheck, mqProducer, statistics)
 
 	s := &mqSink{
 		mqProducer:     mqProducer,
 		eventRouter:    eventRouter,
+		columnSelector: columnSelector,
 		encoderBuilder: encoderBuilder,
 		protocol:       encoderConfig.Protocol,
 		topicManager:   topicManager,
@@ -137,6 +157,7 @@ func (k *mqSink) AddTable(tableID model.TableID) error {
 func (k *mqSink) EmitRowChangedEvents(ctx context.Context, rows ...*model.RowChangedEvent) error {
 	rowsCount := 0
 	for _, row := range rows {
+		row = k.columnSelector.Apply(row)
 		topic := k.eventRouter.GetTopicForRowChange(row)
 		partitionNum, err := k.topicManager.GetPartitionNum(topic)
 		if err != nil {
@@ -387,6 +408,11 @@ func NewKafkaSaramaSink(ctx context.Context, sinkURI *url.URL,
 	if err := baseConfig.Apply(sinkURI); err != nil {
 		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
 	}
+	// The owner doesn't send messages in transactions.
+	if baseConfig.EnableTransactional && !contextutil.IsOwnerFromCtx(ctx) {
+		return nil, cerror.ErrKafkaTransactionalNotSupported.GenWithStackByArgs(
+			"the old sink, please set `enable-new-sink` to true")
+	}
 
 	saramaConfig, err := kafka.NewSaramaConfig(ctx, baseConfig)
 	if err != nil {
@@ -471,3 +497,71 @@ func NewKafkaSaramaSink(ctx context.Context, sinkURI *url.URL,
 	}
 	return sink, nil
 }
+
+// NewPulsarSink creates a new Pulsar mqSink.
+func NewPulsarSink(ctx context.Context, sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan error,
+	clientCreator pulsar.ClientCreator,
+) (*mqSink, error) {
+	topic := strings.TrimFunc(sinkURI.Path, func(r rune) bool {
+		return r == '/'
+	})
+	if topic == "" {
+		return nil, cerror.ErrPulsarInvalidConfig.GenWithStack("no topic is specified in sink-uri")
+	}
+
+	baseConfig := pulsar.NewConfig()
+	if err := baseConfig.Apply(sinkURI); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var protocol config.Protocol
+	if err := protocol.FromString(replicaConfig.Sink.Protocol); err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+	}
+
+	encoderConfig := common.NewConfig(protocol)
+	if err := encoderConfig.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+	}
+	// always set encoder's `MaxMessageBytes` equal to producer's `MaxMessageBytes`
+	// to prevent that the encoder generate batched message too large.
+	encoderConfig = encoderConfig.WithMaxMessageBytes(baseConfig.MaxMessageBytes)
+
+	if err := encoderConfig.Validate(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+	}
+
+	client, err := clientCreator(baseConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p := pulsar.NewProducer(contextutil.ChangefeedIDFromCtx(ctx), client, baseConfig)
+	// we must close the producer when this func return cause by an error
+	// otherwise the client will never be closed and lead to an goroutine leak
+	defer func() {
+		if err != nil {
+			p.Close()
+		}
+	}()
+
+	topicManager := manager.NewPulsarTopicManager(p)
+	if _, err = topicManager.CreateTopicAndWaitUntilVisible(topic); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	sink, err := newMqSink(
+		ctx,
+		topicManager,
+		pulsarproducer.NewPulsarProducer(ctx, p, errCh),
+		topic,
+		replicaConfig,
+		encoderConfig,
+		errCh,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sink, nil
+}
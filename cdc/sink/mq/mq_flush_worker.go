@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sink/mq/producer"
 	"github.com/pingcap/tiflow/pkg/chann"
@@ -62,7 +63,10 @@ type flushWorker struct {
 	// It is also used to notify that the flush has completed.
 	needsFlush chan<- struct{}
 
-	encoder    codec.EventBatchEncoder
+	encoder codec.EventBatchEncoder
+	// claimCheck offloads the messages larger than max-message-bytes,
+	// it is nil if claim-check is disabled.
+	claimCheck *claimcheck.ClaimCheck
 	producer   producer.Producer
 	statistics *metrics.Statistics
 }
@@ -70,6 +74,7 @@ type flushWorker struct {
 // newFlushWorker creates a new flush worker.
 func newFlushWorker(
 	encoder codec.EventBatchEncoder,
+	claimCheck *claimcheck.ClaimCheck,
 	producer producer.Producer,
 	statistics *metrics.Statistics,
 ) *flushWorker {
@@ -77,6 +82,7 @@ func newFlushWorker(
 		msgChan:    chann.New[mqEvent](),
 		ticker:     time.NewTicker(FlushInterval),
 		encoder:    encoder,
+		claimCheck: claimCheck,
 		producer:   producer,
 		statistics: statistics,
 	}
@@ -172,6 +178,11 @@ func (w *flushWorker) asyncSend(
 		err := w.statistics.RecordBatchExecution(func() (int, error) {
 			thisBatchSize := 0
 			for _, message := range w.encoder.Build() {
+				if w.claimCheck != nil {
+					if err := w.claimCheck.Handle(ctx, message); err != nil {
+						return 0, errors.Trace(err)
+					}
+				}
 				err := w.producer.AsyncSendMessage(ctx, key.Topic, key.Partition, message)
 				if err != nil {
 					return 0, err
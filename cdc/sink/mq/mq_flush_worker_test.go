@@ -95,7 +95,7 @@ func newTestWorker(ctx context.Context) (*flushWorker, *mockProducer) {
 		panic(err)
 	}
 	producer := NewMockProducer()
-	return newFlushWorker(encoder, producer,
+	return newFlushWorker(encoder, nil, producer,
 		metrics.NewStatistics(ctx, "", metrics.SinkTypeMQ)), producer
 }
 
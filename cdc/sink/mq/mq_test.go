@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/pingcap/errors"
@@ -28,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/stretchr/testify/require"
 )
 
@@ -164,6 +166,76 @@ func TestKafkaSink(t *testing.T) {
 	}
 }
 
+func TestPulsarSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uri := "pulsar://127.0.0.1:6650/test?max-message-bytes=1048576&protocol=open-protocol"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 1)
+
+	client := pulsar.NewMockClient(map[string]int{"test": 3})
+	sink, err := NewPulsarSink(ctx, sinkURI, replicaConfig, errCh,
+		pulsar.NewMockClientCreator(client))
+	require.Nil(t, err)
+
+	encoder := sink.encoderBuilder.Build()
+	require.IsType(t, &open.BatchEncoder{}, encoder)
+	require.Equal(t, 1048576, encoder.(*open.BatchEncoder).MaxMessageBytes)
+
+	tableID := model.TableID(1)
+	row := &model.RowChangedEvent{
+		Table: &model.TableName{
+			Schema:  "test",
+			Table:   "t1",
+			TableID: tableID,
+		},
+		StartTs:  100,
+		CommitTs: 120,
+		Columns: []*model.Column{{
+			Name:  "col1",
+			Type:  mysql.TypeVarchar,
+			Value: []byte("aa"),
+		}},
+	}
+	err = sink.EmitRowChangedEvents(ctx, row)
+	require.Nil(t, err)
+	_, err = sink.FlushRowChangedEvents(ctx, tableID, model.NewResolvedTs(uint64(120)))
+	require.Nil(t, err)
+	checkpointTs := waitCheckpointTs(t, sink, tableID, uint64(120))
+	require.Equal(t, uint64(120), checkpointTs)
+
+	// The DDL event is broadcast to all the partitions.
+	ddl := &model.DDLEvent{
+		StartTs:  130,
+		CommitTs: 140,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "a", Table: "b",
+		},
+		Query: "create table a",
+		Type:  1,
+	}
+	err = sink.EmitDDLEvent(ctx, ddl)
+	require.Nil(t, err)
+	rows := 0
+	for i := int32(0); i < 3; i++ {
+		messages := client.Messages("test", i)
+		require.NotEmpty(t, messages)
+		rows += len(messages) - 1
+	}
+	require.Equal(t, 1, rows)
+
+	err = sink.Close(ctx)
+	if err != nil {
+		require.Equal(t, context.Canceled, errors.Cause(err))
+	}
+	// The producer is closed asynchronously.
+	require.Eventually(t, client.IsClosed, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestFlushRowChangedEvents(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -16,6 +16,7 @@ package kafka
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/security"
@@ -48,6 +50,14 @@ type Config struct {
 	SASL            *security.SASL
 	// control whether to create topic
 	AutoCreate bool
+	// EnableTransactional makes the processors send the messages in Kafka
+	// transactions, and a message is acknowledged only after its
+	// transaction is committed, so that the consumers reading with
+	// `read_committed` never see the messages of an aborted batch.
+	// It's only supported by the new sink.
+	EnableTransactional bool
+	// TransactionTimeout is the `transaction.timeout.ms` of the producer.
+	TransactionTimeout time.Duration
 
 	// Timeout for sarama `config.Net` configurations, default to `10s`
 	DialTimeout  time.Duration
@@ -60,15 +70,16 @@ func NewConfig() *Config {
 	return &Config{
 		Version: "2.4.0",
 		// MaxMessageBytes will be used to initialize producer
-		MaxMessageBytes:   config.DefaultMaxMessageBytes,
-		ReplicationFactor: 1,
-		Compression:       "none",
-		Credential:        &security.Credential{},
-		SASL:              &security.SASL{},
-		AutoCreate:        true,
-		DialTimeout:       10 * time.Second,
-		WriteTimeout:      10 * time.Second,
-		ReadTimeout:       10 * time.Second,
+		MaxMessageBytes:    config.DefaultMaxMessageBytes,
+		ReplicationFactor:  1,
+		Compression:        "none",
+		Credential:         &security.Credential{},
+		SASL:               &security.SASL{},
+		AutoCreate:         true,
+		TransactionTimeout: time.Minute,
+		DialTimeout:        10 * time.Second,
+		WriteTimeout:       10 * time.Second,
+		ReadTimeout:        10 * time.Second,
 	}
 }
 
@@ -156,6 +167,24 @@ func (c *Config) Apply(sinkURI *url.URL) error {
 		c.AutoCreate = autoCreate
 	}
 
+	s = params.Get("enable-transactional")
+	if s != "" {
+		enableTransactional, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		c.EnableTransactional = enableTransactional
+	}
+
+	s = params.Get("transaction-timeout")
+	if s != "" {
+		a, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		c.TransactionTimeout = a
+	}
+
 	s = params.Get("dial-timeout")
 	if s != "" {
 		a, err := time.ParseDuration(s)
@@ -437,6 +466,33 @@ func NewSaramaConfig(ctx context.Context, c *Config) (*sarama.Config, error) {
 	return config, err
 }
 
+// CompleteSaramaTransactionConfig enables the idempotent and transactional
+// producer which sends the messages of a changefeed on a capture.
+func CompleteSaramaTransactionConfig(
+	config *sarama.Config, c *Config, changefeedID model.ChangeFeedID, captureAddr string,
+) error {
+	if !config.Version.IsAtLeast(sarama.V0_11_0_0) {
+		return cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"transactional producer requires kafka-version >= 0.11.0.0, but got %s", c.Version)
+	}
+	config.Producer.Idempotent = true
+	// The idempotent producer requires at most one in-flight request per broker
+	// connection, otherwise the messages may be reordered when retrying.
+	config.Net.MaxOpenRequests = 1
+	config.Producer.Transaction.ID = transactionalID(changefeedID, captureAddr)
+	config.Producer.Transaction.Timeout = c.TransactionTimeout
+	return nil
+}
+
+// transactionalID returns the transactional ID of the producer of a changefeed
+// on a capture. The capture address doesn't change when the capture or the
+// processor is restarted, so the new producer fences its zombie predecessor
+// and aborts the transaction left behind by it.
+func transactionalID(changefeedID model.ChangeFeedID, captureAddr string) string {
+	return fmt.Sprintf("TiCDC_transactional_producer_%s_%s_%s",
+		changefeedID.Namespace, changefeedID.ID, captureAddr)
+}
+
 func completeSaramaSASLConfig(config *sarama.Config, c *Config) {
 	if c.SASL != nil && c.SASL.SASLMechanism != "" {
 		config.Net.SASL.Enable = true
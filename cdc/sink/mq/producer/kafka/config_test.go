@@ -24,6 +24,7 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -120,6 +121,49 @@ func TestConfigTimeouts(t *testing.T) {
 	require.Equal(t, 2*time.Minute, saramaConfig.Net.WriteTimeout)
 }
 
+func TestConfigTransactional(t *testing.T) {
+	cfg := NewConfig()
+	require.False(t, cfg.EnableTransactional)
+	require.Equal(t, time.Minute, cfg.TransactionTimeout)
+
+	uri := "kafka://127.0.0.1:9092/kafka-test?enable-transactional=true&transaction-timeout=30s"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	require.Nil(t, cfg.Apply(sinkURI))
+	require.True(t, cfg.EnableTransactional)
+	require.Equal(t, 30*time.Second, cfg.TransactionTimeout)
+
+	ctx := contextutil.PutChangefeedIDInCtx(context.Background(),
+		model.DefaultChangeFeedID("test"))
+	ctx = contextutil.PutCaptureAddrInCtx(ctx, "127.0.0.1:8300")
+	saramaConfig, err := NewSaramaConfig(ctx, cfg)
+	require.Nil(t, err)
+	// The transaction is only enabled for the producers of the DML sinks.
+	require.False(t, saramaConfig.Producer.Idempotent)
+	require.Empty(t, saramaConfig.Producer.Transaction.ID)
+
+	err = CompleteSaramaTransactionConfig(saramaConfig, cfg,
+		model.DefaultChangeFeedID("test"), "127.0.0.1:8300")
+	require.Nil(t, err)
+	require.True(t, saramaConfig.Producer.Idempotent)
+	require.Equal(t, 1, saramaConfig.Net.MaxOpenRequests)
+	require.Equal(t, "TiCDC_transactional_producer_default_test_127.0.0.1:8300",
+		saramaConfig.Producer.Transaction.ID)
+	require.Equal(t, 30*time.Second, saramaConfig.Producer.Transaction.Timeout)
+	require.Nil(t, saramaConfig.Validate())
+
+	cfg.Version = "0.10.2.0"
+	saramaConfig, err = NewSaramaConfig(ctx, cfg)
+	require.Nil(t, err)
+	err = CompleteSaramaTransactionConfig(saramaConfig, cfg,
+		model.DefaultChangeFeedID("test"), "127.0.0.1:8300")
+	require.Regexp(t, ".*CDC:ErrKafkaInvalidConfig.*", err)
+
+	sinkURI, err = url.Parse("kafka://127.0.0.1:9092/kafka-test?enable-transactional=abc")
+	require.Nil(t, err)
+	require.NotNil(t, NewConfig().Apply(sinkURI))
+}
+
 func TestCompleteConfigByOpts(t *testing.T) {
 	cfg := NewConfig()
 
@@ -110,6 +110,9 @@ func (k *kafkaSaramaProducer) AsyncSendMessage(
 		Value:     sarama.ByteEncoder(message.Value),
 		Partition: partition,
 	}
+	if message.ClaimCheck {
+		msg.Headers = []sarama.RecordHeader{{Key: []byte(common.ClaimCheckHeaderKey)}}
+	}
 	k.mu.Lock()
 	k.mu.inflight++
 	log.Debug("emitting inflight messages to kafka", zap.Int64("inflight", k.mu.inflight))
@@ -0,0 +1,124 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// pulsarProducer is the pulsar implementation of producer.Producer.
+type pulsarProducer struct {
+	id       model.ChangeFeedID
+	role     util.Role
+	producer *pulsar.Producer
+	errCh    chan error
+
+	mu struct {
+		sync.Mutex
+		// err records the first error met when sending messages asynchronously.
+		err error
+	}
+}
+
+// NewPulsarProducer creates a pulsar producer.
+func NewPulsarProducer(
+	ctx context.Context,
+	producer *pulsar.Producer,
+	errCh chan error,
+) *pulsarProducer {
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	role := contextutil.RoleFromCtx(ctx)
+	log.Info("Starting pulsar producer ...",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID), zap.Any("role", role))
+	return &pulsarProducer{
+		id:       changefeedID,
+		role:     role,
+		producer: producer,
+		errCh:    errCh,
+	}
+}
+
+// AsyncSendMessage implements producer.Producer.
+func (p *pulsarProducer) AsyncSendMessage(
+	ctx context.Context, topic string, partition int32, message *common.Message,
+) error {
+	return p.producer.AsyncSend(ctx, topic, partition, message, func(err error) {
+		if err != nil {
+			p.handleError(ctx, err)
+			return
+		}
+		if message.Callback != nil {
+			message.Callback()
+		}
+	})
+}
+
+func (p *pulsarProducer) handleError(ctx context.Context, err error) {
+	p.mu.Lock()
+	if p.mu.err == nil {
+		p.mu.err = err
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+	case p.errCh <- err:
+	default:
+		log.Error("error channel is full", zap.Error(err),
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID), zap.Any("role", p.role))
+	}
+}
+
+// SyncBroadcastMessage implements producer.Producer.
+func (p *pulsarProducer) SyncBroadcastMessage(
+	ctx context.Context, topic string, partitionsNum int32, message *common.Message,
+) error {
+	for i := int32(0); i < partitionsNum; i++ {
+		if err := p.producer.SyncSend(ctx, topic, i, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush implements producer.Producer.
+// It waits until all the messages sent asynchronously are acknowledged,
+// and returns the error met when sending them if any.
+func (p *pulsarProducer) Flush(_ context.Context) error {
+	if err := p.producer.Flush(); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.mu.err
+}
+
+// Close implements producer.Producer.
+func (p *pulsarProducer) Close() error {
+	log.Info("stop the pulsar producer", zap.String("namespace", p.id.Namespace),
+		zap.String("changefeed", p.id.ID), zap.Any("role", p.role))
+	p.producer.Close()
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPulsarProducer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := pulsar.NewMockClient(map[string]int{"test": 2})
+	errCh := make(chan error, 1)
+	p := NewPulsarProducer(ctx,
+		pulsar.NewProducer(model.DefaultChangeFeedID("test"), client, pulsar.NewConfig()), errCh)
+
+	called := 0
+	message := &common.Message{
+		Key:      []byte("key"),
+		Value:    []byte("value"),
+		Callback: func() { called++ },
+	}
+	require.Nil(t, p.AsyncSendMessage(ctx, "test", 1, message))
+	require.Nil(t, p.Flush(ctx))
+	require.Equal(t, 1, called)
+	require.Len(t, client.Messages("test", 1), 1)
+
+	require.Nil(t, p.SyncBroadcastMessage(ctx, "test", 2, message))
+	require.Len(t, client.Messages("test", 0), 1)
+	require.Len(t, client.Messages("test", 1), 2)
+
+	client.SetSendError(errors.New("fake"))
+	require.Nil(t, p.AsyncSendMessage(ctx, "test", 0, message))
+	require.Equal(t, 1, called)
+	require.Regexp(t, ".*CDC:ErrPulsarAsyncSendMessage.*", <-errCh)
+	require.Regexp(t, ".*CDC:ErrPulsarAsyncSendMessage.*", p.Flush(ctx))
+
+	require.Nil(t, p.Close())
+	require.True(t, client.IsClosed())
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	dmutils "github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/errorutil"
@@ -94,6 +95,9 @@ func NewMySQLSink(
 	}
 
 	params.enableOldValue = replicaConfig.EnableOldValue
+	if replicaConfig.Bidirectional != nil {
+		params.replicationID = replicaConfig.Bidirectional.ReplicationID
+	}
 
 	// dsn format of the driver:
 	// [username[:password]@][protocol[(address)]]/dbname[?param1=value1&...&paramN=valueN]
@@ -170,6 +174,14 @@ func NewMySQLSink(
 	db.SetMaxIdleConns(params.workerCount)
 	db.SetMaxOpenConns(params.workerCount)
 
+	if params.replicationID != 0 {
+		for _, query := range bidirectional.CreateMarkTableSQLs() {
+			if _, err := db.ExecContext(ctx, query); err != nil {
+				return nil, cerror.WrapError(cerror.ErrCreateMarkTableFailed, err)
+			}
+		}
+	}
+
 	metricConflictDetectDurationHis := metrics.ConflictDetectDurationHis.
 		WithLabelValues(params.changefeedID.Namespace, params.changefeedID.ID)
 	metricBucketSizeCounters := make([]prometheus.Counter, params.workerCount)
@@ -729,6 +741,13 @@ func (s *mysqlSink) prepareDMLs(rows []*model.RowChangedEvent, bucket int) *prep
 		}
 	}
 
+	// marked records the tables whose mark has been updated, since all the
+	// rows are executed in one downstream transaction.
+	var marked map[model.TableName]struct{}
+	if s.params.replicationID != 0 {
+		marked = make(map[model.TableName]struct{})
+	}
+
 	for _, row := range rows {
 		var query string
 		var args []interface{}
@@ -737,6 +756,15 @@ func (s *mysqlSink) prepareDMLs(rows []*model.RowChangedEvent, bucket int) *prep
 			startTs[len(startTs)-1] != row.StartTs { // Try to deduplicate starts ts.
 			startTs = append(startTs, row.StartTs)
 		}
+		if marked != nil {
+			if _, ok := marked[*row.Table]; !ok {
+				marked[*row.Table] = struct{}{}
+				query, args = bidirectional.UpdateMarkSQL(row.Table.Schema,
+					row.Table.Table, bucket, s.params.replicationID)
+				sqls = append(sqls, query)
+				values = append(values, args)
+			}
+		}
 
 		// If the old value is enabled, is not in safe mode and is an update event, then translate to UPDATE.
 		// NOTICE: Only update events with the old value feature enabled will have both columns and preColumns.
@@ -92,6 +92,8 @@ type sinkParams struct {
 	safeMode            bool
 	timezone            string
 	tls                 string
+	// replicationID marks the transactions written by the sink if it's not 0.
+	replicationID uint64
 }
 
 func (s *sinkParams) Clone() *sinkParams {
@@ -257,6 +257,22 @@ func (s *mysqlSyncPointStore) SinkSyncPoint(ctx context.Context,
 	return cerror.WrapError(cerror.ErrMySQLTxnError, err)
 }
 
+func (s *mysqlSyncPointStore) GetLatestSyncPoint(ctx context.Context,
+	id model.ChangeFeedID,
+) (primaryTs, secondaryTs uint64, err error) {
+	query := "select primary_ts, secondary_ts from " + schemaName + "." + syncPointTableName +
+		" where ticdc_cluster_id = ? and changefeed = ?" +
+		" order by cast(primary_ts as unsigned) desc limit 1"
+	err = s.db.QueryRowContext(ctx, query, s.clusterID, id.ID).Scan(&primaryTs, &secondaryTs)
+	if err == sql.ErrNoRows {
+		return 0, 0, cerror.ErrSyncPointNotFound.GenWithStackByArgs(id.ID)
+	}
+	if err != nil {
+		return 0, 0, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return primaryTs, secondaryTs, nil
+}
+
 func (s *mysqlSyncPointStore) Close() error {
 	err := s.db.Close()
 	return cerror.WrapError(cerror.ErrMySQLConnectionError, err)
@@ -0,0 +1,53 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLatestSyncPoint(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.Nil(t, err)
+	store := &mysqlSyncPointStore{db: db, clusterID: "default"}
+	id := model.DefaultChangeFeedID("test")
+	ctx := context.Background()
+
+	mock.ExpectQuery("select primary_ts, secondary_ts from tidb_cdc.syncpoint_v1.*").
+		WithArgs("default", "test").
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}).
+			AddRow("435000000000000001", "435000000000000009"))
+	primaryTs, secondaryTs, err := store.GetLatestSyncPoint(ctx, id)
+	require.Nil(t, err)
+	require.Equal(t, uint64(435000000000000001), primaryTs)
+	require.Equal(t, uint64(435000000000000009), secondaryTs)
+
+	mock.ExpectQuery("select primary_ts, secondary_ts from tidb_cdc.syncpoint_v1.*").
+		WithArgs("default", "test").
+		WillReturnError(sql.ErrNoRows)
+	_, _, err = store.GetLatestSyncPoint(ctx, id)
+	require.Regexp(t, ".*CDC:ErrSyncPointNotFound.*", err)
+
+	mock.ExpectClose()
+	require.Nil(t, store.Close())
+	require.Nil(t, mock.ExpectationsWereMet())
+}
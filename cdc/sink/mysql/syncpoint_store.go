@@ -31,6 +31,10 @@ type SyncPointStore interface {
 	// SinkSyncPoint record the syncpoint(a map with ts) in downstream db
 	SinkSyncPoint(ctx context.Context, id model.ChangeFeedID, checkpointTs uint64) error
 
+	// GetLatestSyncPoint returns the latest recorded syncpoint of the changefeed,
+	// primaryTs is the upstream ts and secondaryTs is the corresponding downstream ts.
+	GetLatestSyncPoint(ctx context.Context, id model.ChangeFeedID) (primaryTs, secondaryTs uint64, err error)
+
 	// Close closes the SyncPointSink
 	Close() error
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/mysql"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 )
 
 // Sink is an abstraction for anything that a changefeed may emit into.
@@ -126,6 +127,16 @@ func init() {
 		return mq.NewKafkaSaramaSink(ctx, sinkURI, config, errCh)
 	}
 	sinkIniterMap["kafka+ssl"] = sinkIniterMap["kafka"]
+
+	// register pulsar sink
+	sinkIniterMap["pulsar"] = func(
+		ctx context.Context, changefeedID model.ChangeFeedID, sinkURI *url.URL,
+		config *config.ReplicaConfig,
+		errCh chan error,
+	) (Sink, error) {
+		return mq.NewPulsarSink(ctx, sinkURI, config, errCh, pulsar.NewClient)
+	}
+	sinkIniterMap["pulsar+ssl"] = sinkIniterMap["pulsar"]
 }
 
 // New creates a new sink with the sink-uri
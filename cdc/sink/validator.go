@@ -19,8 +19,12 @@ import (
 
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/mq/columnselector"
+	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/factory"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	psink "github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/util"
 )
 
@@ -34,6 +38,15 @@ func Validate(ctx context.Context, sinkURI string, cfg *config.ReplicaConfig) er
 
 	errCh := make(chan error)
 	ctx, cancel := context.WithCancel(contextutil.PutRoleInCtx(ctx, util.RoleClient))
+	// The cloud storage sink is only implemented based on sinkV2.
+	if isStorageSinkURI(sinkURI) {
+		defer cancel()
+		s, err := factory.New(ctx, sinkURI, cfg, errCh)
+		if err != nil {
+			return err
+		}
+		return s.Close()
+	}
 	s, err := New(ctx, model.DefaultChangeFeedID("sink-verify"), sinkURI, cfg, errCh)
 	if err != nil {
 		cancel()
@@ -56,6 +69,67 @@ func Validate(ctx context.Context, sinkURI string, cfg *config.ReplicaConfig) er
 	return nil
 }
 
+// ValidateColumnSelectors checks that the column selectors do not remove any
+// column which is required by the partition dispatchers of the given tables.
+// It only takes effect for MQ sinks.
+func ValidateColumnSelectors(
+	sinkURIStr string, cfg *config.ReplicaConfig, tableInfos []*model.TableInfo,
+) error {
+	if len(cfg.Sink.ColumnSelectors) == 0 {
+		return nil
+	}
+	sinkURI, err := url.Parse(sinkURIStr)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	if !psink.IsMQScheme(sinkURI.Scheme) {
+		return nil
+	}
+
+	// The topic is irrelevant here, we only care about the partition dispatchers.
+	eventRouter, err := dispatcher.NewEventRouter(cfg, "")
+	if err != nil {
+		return err
+	}
+	selector, err := columnselector.New(cfg)
+	if err != nil {
+		return err
+	}
+	return selector.VerifyTables(tableInfos, eventRouter)
+}
+
+// ValidateDispatchRules checks that the columns referred by the partition
+// dispatchers exist in the given tables. It only takes effect for MQ sinks.
+func ValidateDispatchRules(
+	sinkURIStr string, cfg *config.ReplicaConfig, tableInfos []*model.TableInfo,
+) error {
+	if len(cfg.Sink.DispatchRules) == 0 {
+		return nil
+	}
+	sinkURI, err := url.Parse(sinkURIStr)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	if !psink.IsMQScheme(sinkURI.Scheme) {
+		return nil
+	}
+
+	eventRouter, err := dispatcher.NewEventRouter(cfg, "")
+	if err != nil {
+		return err
+	}
+	return eventRouter.VerifyTables(tableInfos)
+}
+
+// isStorageSinkURI returns true if the sink URI is a cloud storage sink.
+func isStorageSinkURI(sinkURIStr string) bool {
+	sinkURI, err := url.Parse(sinkURIStr)
+	if err != nil {
+		return false
+	}
+	return psink.IsStorageScheme(sinkURI.Scheme)
+}
+
 // preCheckSinkURI do some pre-check for sink URI.
 // 1. Check if sink URI is empty.
 // 2. Check if we use correct IPv6 format in URI.(if needed)
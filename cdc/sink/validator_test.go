@@ -14,8 +14,10 @@
 package sink
 
 import (
+	"context"
 	"testing"
 
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,3 +89,16 @@ func TestPreCheckSinkURI(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCloudStorageSink(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sinkURI := "file://" + t.TempDir() + "?protocol=csv"
+	err := Validate(ctx, sinkURI, config.GetDefaultReplicaConfig())
+	require.Nil(t, err)
+
+	sinkURI = "file://" + t.TempDir() + "?protocol=open-protocol"
+	err = Validate(ctx, sinkURI, config.GetDefaultReplicaConfig())
+	require.Contains(t, err.Error(), "is incompatible with file scheme")
+}
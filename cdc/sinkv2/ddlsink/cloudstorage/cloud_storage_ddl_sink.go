@@ -0,0 +1,119 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink"
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	putil "github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// Assert DDLEventSink implementation
+var _ ddlsink.DDLEventSink = (*ddlSink)(nil)
+
+// metadata is the content of the metadata file.
+type metadata struct {
+	CheckpointTs uint64 `json:"checkpoint-ts"`
+}
+
+// ddlSink writes the table schemas and the checkpoint-ts to the storage.
+type ddlSink struct {
+	// id indicates which changefeed this sink belongs to.
+	id      model.ChangeFeedID
+	storage storage.ExternalStorage
+
+	mu               sync.Mutex
+	lastCheckpointTs uint64
+}
+
+// NewCloudStorageDDLSink creates a ddl sink for cloud storage.
+func NewCloudStorageDDLSink(ctx context.Context, sinkURI *url.URL) (*ddlSink, error) {
+	storage, err := putil.GetExternalStorageFromURI(ctx, sinkURI.String())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &ddlSink{
+		id:      contextutil.ChangefeedIDFromCtx(ctx),
+		storage: storage,
+	}, nil
+}
+
+// WriteDDLEvent writes the table schema after the DDL into a new schema file,
+// the DDLs which don't belong to a table, such as `CREATE DATABASE`, are skipped.
+func (d *ddlSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	if ddl.TableInfo == nil || ddl.TableInfo.Table == "" {
+		log.Info("cloud storage DDL sink skips the DDL of database",
+			zap.String("namespace", d.id.Namespace),
+			zap.String("changefeed", d.id.ID),
+			zap.String("query", ddl.Query))
+		return nil
+	}
+
+	var def cloudstorage.TableDefinition
+	def.FromDDLEvent(ddl)
+	data, err := def.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	path := cloudstorage.GenerateSchemaFilePath(def.VersionedTable())
+	if err := d.storage.WriteFile(ctx, path, data); err != nil {
+		return errors.Trace(err)
+	}
+	log.Info("cloud storage DDL sink wrote the table schema",
+		zap.String("namespace", d.id.Namespace),
+		zap.String("changefeed", d.id.ID),
+		zap.String("path", path),
+		zap.String("query", ddl.Query))
+	return nil
+}
+
+// WriteCheckpointTs writes the checkpoint-ts into the metadata file,
+// it's only written when the checkpoint-ts advances.
+func (d *ddlSink) WriteCheckpointTs(ctx context.Context,
+	ts uint64, tables []model.TableName,
+) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if ts <= d.lastCheckpointTs {
+		return nil
+	}
+
+	data, err := json.Marshal(metadata{CheckpointTs: ts})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := d.storage.WriteFile(ctx, cloudstorage.MetadataFileName, data); err != nil {
+		return errors.Trace(err)
+	}
+	d.lastCheckpointTs = ts
+	return nil
+}
+
+// Close closes the sink.
+func (d *ddlSink) Close() error {
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDDLEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parentDir := t.TempDir()
+	sinkURI, err := url.Parse("file:///" + parentDir + "?protocol=csv")
+	require.Nil(t, err)
+	sink, err := NewCloudStorageDDLSink(ctx, sinkURI)
+	require.Nil(t, err)
+
+	ddl := &model.DDLEvent{
+		CommitTs: 100,
+		Query:    "alter table test.table1 add col2 varchar(64)",
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "test",
+			Table:  "table1",
+			ColumnInfo: []*model.ColumnInfo{
+				{Name: "col1", Type: mysql.TypeLong},
+				{Name: "col2", Type: mysql.TypeVarchar},
+			},
+		},
+	}
+	err = sink.WriteDDLEvent(ctx, ddl)
+	require.Nil(t, err)
+
+	data, err := os.ReadFile(path.Join(parentDir, "test/table1/100/schema.json"))
+	require.Nil(t, err)
+	require.Contains(t, string(data), `"Query": "alter table test.table1 add col2 varchar(64)"`)
+	require.Contains(t, string(data), `"ColumnType": "varchar"`)
+
+	// the DDL of a database is skipped.
+	err = sink.WriteDDLEvent(ctx, &model.DDLEvent{
+		CommitTs:  101,
+		Query:     "create database test2",
+		TableInfo: &model.SimpleTableInfo{Schema: "test2"},
+	})
+	require.Nil(t, err)
+	_, err = os.Stat(path.Join(parentDir, "test2"))
+	require.True(t, os.IsNotExist(err))
+
+	require.Nil(t, sink.Close())
+}
+
+func TestWriteCheckpointTs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parentDir := t.TempDir()
+	sinkURI, err := url.Parse("file:///" + parentDir + "?protocol=csv")
+	require.Nil(t, err)
+	sink, err := NewCloudStorageDDLSink(ctx, sinkURI)
+	require.Nil(t, err)
+
+	err = sink.WriteCheckpointTs(ctx, 100, nil)
+	require.Nil(t, err)
+	metadata, err := os.ReadFile(path.Join(parentDir, "metadata"))
+	require.Nil(t, err)
+	require.JSONEq(t, `{"checkpoint-ts":100}`, string(metadata))
+
+	// the checkpoint-ts doesn't fall back.
+	err = sink.WriteCheckpointTs(ctx, 99, nil)
+	require.Nil(t, err)
+	metadata, err = os.ReadFile(path.Join(parentDir, "metadata"))
+	require.Nil(t, err)
+	require.JSONEq(t, `{"checkpoint-ts":100}`, string(metadata))
+
+	require.Nil(t, sink.Close())
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/mq/producer/kafka"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/blackhole"
+	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/cloudstorage"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/mq"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/mq/ddlproducer"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/mysql"
@@ -27,6 +28,7 @@ import (
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 )
 
 // New creates a new ddlsink.DDLEventSink by schema.
@@ -44,10 +46,14 @@ func New(
 	case sink.KafkaSchema, sink.KafkaSSLSchema:
 		return mq.NewKafkaDDLSink(ctx, sinkURI, cfg,
 			kafka.NewAdminClientImpl, ddlproducer.NewKafkaDDLProducer)
+	case sink.PulsarSchema, sink.PulsarSSLSchema:
+		return mq.NewPulsarDDLSink(ctx, sinkURI, cfg, pulsar.NewClient)
 	case sink.BlackHoleSchema:
 		return blackhole.New(), nil
 	case sink.MySQLSSLSchema, sink.MySQLSchema, sink.TiDBSchema, sink.TiDBSSLSchema:
 		return mysql.NewMySQLDDLSink(ctx, sinkURI, cfg, pmysql.CreateMySQLDBConn)
+	case sink.S3Schema, sink.FileSchema, sink.GCSSchema:
+		return cloudstorage.NewCloudStorageDDLSink(ctx, sinkURI)
 	default:
 		return nil,
 			cerror.ErrSinkURIInvalid.GenWithStack("the sink scheme (%s) is not supported", schema)
@@ -0,0 +1,116 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddlproducer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"go.uber.org/zap"
+)
+
+var _ DDLProducer = (*pulsarDDLProducer)(nil)
+
+// pulsarDDLProducer is used to send DDL messages to pulsar.
+type pulsarDDLProducer struct {
+	// id indicates this sink belongs to which processor(changefeed).
+	id model.ChangeFeedID
+	// producer sends messages to the partitions of pulsar topics.
+	producer *pulsar.Producer
+	// closedMu is used to protect `closed`.
+	// We need to ensure that closed producers are never written to.
+	closedMu sync.RWMutex
+	// closed is used to indicate whether the producer is closed.
+	// We also use it to guard against double closes.
+	closed bool
+}
+
+// NewPulsarDDLProducer creates a pulsar DDL producer.
+func NewPulsarDDLProducer(ctx context.Context, producer *pulsar.Producer) DDLProducer {
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	log.Info("Starting pulsar DDL producer ...",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID))
+
+	return &pulsarDDLProducer{
+		id:       changefeedID,
+		producer: producer,
+	}
+}
+
+func (p *pulsarDDLProducer) SyncBroadcastMessage(ctx context.Context, topic string,
+	totalPartitionsNum int32, message *common.Message,
+) error {
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	for i := int32(0); i < totalPartitionsNum; i++ {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		default:
+		}
+		if err := p.producer.SyncSend(ctx, topic, i, message); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (p *pulsarDDLProducer) SyncSendMessage(ctx context.Context, topic string,
+	partitionNum int32, message *common.Message,
+) error {
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	default:
+		return errors.Trace(p.producer.SyncSend(ctx, topic, partitionNum, message))
+	}
+}
+
+func (p *pulsarDDLProducer) Close() {
+	// We have to hold the lock to prevent write to closed producer.
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+	// If the producer was already closed, we should skip the close operation.
+	if p.closed {
+		log.Warn("Pulsar DDL producer already closed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+		return
+	}
+	p.closed = true
+	p.producer.Close()
+	log.Info("Pulsar DDL producer closed",
+		zap.String("namespace", p.id.Namespace),
+		zap.String("changefeed", p.id.ID))
+}
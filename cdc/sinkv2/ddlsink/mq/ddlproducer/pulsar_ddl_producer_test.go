@@ -0,0 +1,49 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddlproducer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPulsarSyncSendAndBroadcastMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := pulsar.NewMockClient(map[string]int{"test": 3})
+	producer := NewPulsarDDLProducer(ctx,
+		pulsar.NewProducer(model.DefaultChangeFeedID("test"), client, pulsar.NewConfig()))
+
+	message := &common.Message{Key: []byte("key"), Value: []byte("value")}
+	require.Nil(t, producer.SyncSendMessage(ctx, "test", 1, message))
+	require.Len(t, client.Messages("test", 1), 1)
+
+	require.Nil(t, producer.SyncBroadcastMessage(ctx, "test", 3, message))
+	require.Len(t, client.Messages("test", 0), 1)
+	require.Len(t, client.Messages("test", 1), 2)
+	require.Len(t, client.Messages("test", 2), 1)
+
+	producer.Close()
+	require.True(t, client.IsClosed())
+	err := producer.SyncSendMessage(ctx, "test", 0, message)
+	require.Regexp(t, ".*CDC:ErrPulsarProducerClosed.*", err)
+	err = producer.SyncBroadcastMessage(ctx, "test", 3, message)
+	require.Regexp(t, ".*CDC:ErrPulsarProducerClosed.*", err)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/mq/ddlproducer"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/stretchr/testify/require"
 )
 
@@ -326,3 +327,47 @@ func TestWriteCheckpointTsWhenCanalJsonTiDBExtensionIsDisable(t *testing.T) {
 	require.Len(t, s.producer.(*ddlproducer.MockDDLProducer).GetAllEvents(),
 		0, "No topic and partition should be broadcast")
 }
+
+func TestPulsarWriteDDLEventAndCheckpointTs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uri := "pulsar://127.0.0.1:6650/test?max-message-bytes=1048576" +
+		"&protocol=canal-json&enable-tidb-extension=true"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	client := pulsar.NewMockClient(map[string]int{"test": 3})
+	s, err := NewPulsarDDLSink(ctx, sinkURI, replicaConfig,
+		pulsar.NewMockClientCreator(client))
+	require.Nil(t, err)
+	require.NotNil(t, s)
+
+	ddl := &model.DDLEvent{
+		CommitTs: 417318403368288260,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "cdc", Table: "person",
+		},
+		Query: "create table person(id int, name varchar(32), primary key(id))",
+		Type:  mm.ActionCreateTable,
+	}
+	err = s.WriteDDLEvent(ctx, ddl)
+	require.Nil(t, err)
+	// The DDL event of canal-json protocol is sent to the partition zero.
+	require.Len(t, client.Messages("test", 0), 1)
+	require.Len(t, client.Messages("test", 1), 0)
+
+	err = s.WriteCheckpointTs(ctx, uint64(417318403368288260), nil)
+	require.Nil(t, err)
+	// The checkpoint ts is broadcast to all the partitions.
+	require.Len(t, client.Messages("test", 0), 2)
+	require.Len(t, client.Messages("test", 1), 1)
+	require.Len(t, client.Messages("test", 2), 1)
+
+	require.Nil(t, s.Close())
+	require.True(t, client.IsClosed())
+}
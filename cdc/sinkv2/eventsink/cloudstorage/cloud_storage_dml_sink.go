@@ -0,0 +1,163 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/builder"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/metrics"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	putil "github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+// Assert EventSink[E event.TableEvent] implementation
+var _ eventsink.EventSink[*model.SingleTableTxn] = (*dmlSink)(nil)
+
+// dmlSink is the cloud storage sink.
+// It will send the events to cloud storage systems, such as
+// Amazon S3, GCS and the local file system.
+type dmlSink struct {
+	// id indicates this sink belongs to which processor(changefeed).
+	id model.ChangeFeedID
+	// protocol indicates the protocol used to encode the data files.
+	protocol config.Protocol
+	// workers write the encoded events of the tables to the storage,
+	// the events of a table are always handled by the same worker.
+	workers []*dmlWorker
+	// statistics is used to record DML metrics.
+	statistics *metrics.Statistics
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewCloudStorageSink creates a cloud storage sink.
+func NewCloudStorageSink(ctx context.Context,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan error,
+) (*dmlSink, error) {
+	cfg := cloudstorage.NewConfig()
+	if err := cfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var protocol config.Protocol
+	if err := protocol.FromString(replicaConfig.Sink.Protocol); err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageSinkInvalidConfig, err)
+	}
+	if protocol != config.ProtocolCSV && protocol != config.ProtocolCanalJSON {
+		return nil, cerror.ErrStorageSinkInvalidConfig.GenWithStack(
+			"protocol %s is not supported by cloud storage sink", protocol)
+	}
+
+	encoderConfig := common.NewConfig(protocol)
+	if err := encoderConfig.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageSinkInvalidConfig, err)
+	}
+	if err := encoderConfig.Validate(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageSinkInvalidConfig, err)
+	}
+	encoderBuilder, err := builder.NewEventBatchEncoderBuilder(ctx, encoderConfig)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageSinkInvalidConfig, err)
+	}
+
+	storage, err := putil.GetExternalStorageFromURI(ctx, sinkURI.String())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	s := &dmlSink{
+		id:         changefeedID,
+		protocol:   protocol,
+		workers:    make([]*dmlWorker, cfg.WorkerCount),
+		statistics: metrics.NewStatistics(ctx, sink.TxnSink),
+	}
+	extension := cloudstorage.GetFileExtension(protocol)
+	for i := range s.workers {
+		s.workers[i] = newDMLWorker(i, changefeedID, storage, cfg,
+			extension, encoderBuilder.Build(), s.statistics)
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, w := range s.workers {
+		s.wg.Add(1)
+		go func(w *dmlWorker) {
+			defer s.wg.Done()
+			if err := w.run(ctx); err != nil && errors.Cause(err) != context.Canceled {
+				select {
+				case <-ctx.Done():
+					return
+				case errCh <- err:
+				default:
+					log.Error("Error channel is full in cloud storage DML sink",
+						zap.Error(err),
+						zap.String("namespace", changefeedID.Namespace),
+						zap.String("changefeed", changefeedID.ID))
+				}
+			}
+		}(w)
+	}
+
+	return s, nil
+}
+
+// WriteEvents writes events to the sink.
+// This is an asynchronously and thread-safe method.
+func (s *dmlSink) WriteEvents(txns ...*eventsink.TxnCallbackableEvent) error {
+	for _, txn := range txns {
+		if txn.GetTableSinkState() == state.TableSinkStopping {
+			// The table where the event comes from is in stopping, so it's safe
+			// to drop the event directly.
+			txn.Callback()
+			continue
+		}
+		// The events of the same table are always dispatched to the same worker,
+		// so that they are written in order.
+		idx := int(uint64(txn.Event.Table.TableID) % uint64(len(s.workers)))
+		// This never be blocked because this is an unbounded channel.
+		s.workers[idx].inputCh.In() <- txn
+	}
+
+	return nil
+}
+
+// Close closes the sink.
+func (s *dmlSink) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	for _, w := range s.workers {
+		w.close()
+	}
+	s.statistics.Close()
+	return nil
+}
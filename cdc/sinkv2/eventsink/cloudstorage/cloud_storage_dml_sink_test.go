@@ -0,0 +1,173 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTxns(tableStatus *state.TableSinkState, counter *int64) []*eventsink.TxnCallbackableEvent {
+	txns := make([]*eventsink.TxnCallbackableEvent, 0, 10)
+	table := &model.TableName{Schema: "test", Table: "table1", TableID: 100}
+	for i := 0; i < 10; i++ {
+		txn := &model.SingleTableTxn{
+			Table:    table,
+			CommitTs: uint64(100 + i),
+		}
+		for j := 0; j < 2; j++ {
+			txn.Rows = append(txn.Rows, &model.RowChangedEvent{
+				CommitTs:         uint64(100 + i),
+				Table:            table,
+				TableInfoVersion: 33,
+				Columns: []*model.Column{
+					{Name: "c1", Type: mysql.TypeLong, Value: int64(i*2 + j)},
+					{Name: "c2", Type: mysql.TypeVarchar, Value: []byte("hello world")},
+				},
+			})
+		}
+		txns = append(txns, &eventsink.TxnCallbackableEvent{
+			Event: txn,
+			Callback: func() {
+				atomic.AddInt64(counter, 1)
+			},
+			SinkState: tableStatus,
+		})
+	}
+	return txns
+}
+
+func TestCloudStorageWriteEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parentDir := t.TempDir()
+	uri := fmt.Sprintf("file:///%s?worker-count=2&flush-interval=2s&protocol=csv", parentDir)
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 5)
+
+	s, err := NewCloudStorageSink(ctx, sinkURI, replicaConfig, errCh)
+	require.Nil(t, err)
+
+	var cnt int64
+	tableStatus := state.TableSinkSinking
+	err = s.WriteEvents(newTestTxns(&tableStatus, &cnt)...)
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cnt) == 10
+	}, 10*time.Second, 100*time.Millisecond)
+
+	date := cloudstorage.GenerateDateStr(config.DateSeparatorDay.String(), time.Now())
+	dir := path.Join(parentDir, "test/table1/33", date)
+	files, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, "CDC000001.csv", files[0].Name())
+	content, err := os.ReadFile(path.Join(dir, files[0].Name()))
+	require.Nil(t, err)
+	require.Contains(t, string(content), "\"I\",\"table1\",\"test\",0,\"hello world\"\r\n")
+
+	// The schema file of the table version is written from the rows
+	// since there is no DDL which creates it.
+	content, err = os.ReadFile(path.Join(parentDir, "test/table1/33/schema.json"))
+	require.Nil(t, err)
+	require.Contains(t, string(content), `"TableColumnsTotal": 2`)
+	require.Contains(t, string(content), `"ColumnType": "varchar"`)
+
+	// The events of the stopping tables are dropped directly.
+	tableStatus = state.TableSinkStopping
+	err = s.WriteEvents(newTestTxns(&tableStatus, &cnt)...)
+	require.Nil(t, err)
+	require.Equal(t, int64(20), atomic.LoadInt64(&cnt))
+
+	require.Nil(t, s.Close())
+}
+
+func TestCloudStorageFileIndex(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	parentDir := t.TempDir()
+	date := cloudstorage.GenerateDateStr(config.DateSeparatorDay.String(), time.Now())
+	dir := path.Join(parentDir, "test/table1/33", date)
+	require.Nil(t, os.MkdirAll(dir, 0o755))
+	// The data files written before the sink restarts should not be overwritten.
+	require.Nil(t, os.WriteFile(path.Join(dir, "CDC000005.json"), []byte("{}"), 0o644))
+	// The schema file written by the DDL sink should not be overwritten.
+	schemaPath := path.Join(parentDir, "test/table1/33/schema.json")
+	require.Nil(t, os.WriteFile(schemaPath, []byte("{}"), 0o644))
+
+	uri := fmt.Sprintf("file:///%s?flush-interval=2s&protocol=canal-json", parentDir)
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 5)
+
+	s, err := NewCloudStorageSink(ctx, sinkURI, replicaConfig, errCh)
+	require.Nil(t, err)
+
+	var cnt int64
+	tableStatus := state.TableSinkSinking
+	err = s.WriteEvents(newTestTxns(&tableStatus, &cnt)...)
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cnt) == 10
+	}, 10*time.Second, 100*time.Millisecond)
+
+	_, err = os.Stat(path.Join(dir, "CDC000006.json"))
+	require.Nil(t, err)
+	content, err := os.ReadFile(schemaPath)
+	require.Nil(t, err)
+	require.Equal(t, "{}", string(content))
+
+	// The table is moved to another capture and then moved back, the data
+	// files written by the other capture should not be overwritten.
+	require.Nil(t, os.WriteFile(path.Join(dir, "CDC000009.json"), []byte("{}"), 0o644))
+	newTableStatus := state.TableSinkSinking
+	err = s.WriteEvents(newTestTxns(&newTableStatus, &cnt)...)
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cnt) == 20
+	}, 10*time.Second, 100*time.Millisecond)
+
+	_, err = os.Stat(path.Join(dir, "CDC000010.json"))
+	require.Nil(t, err)
+	content, err = os.ReadFile(path.Join(dir, "CDC000009.json"))
+	require.Nil(t, err)
+	require.Equal(t, "{}", string(content))
+
+	require.Nil(t, s.Close())
+}
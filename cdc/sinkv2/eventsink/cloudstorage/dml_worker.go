@@ -0,0 +1,325 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/metrics"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/chann"
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"go.uber.org/zap"
+)
+
+// lineTerminator is appended to each encoded row in the data files.
+var lineTerminator = []byte("\r\n")
+
+// tableBuffer caches the encoded rows of a versioned table
+// which haven't been written to the storage.
+type tableBuffer struct {
+	data      bytes.Buffer
+	rowsCount int
+	callbacks []eventsink.CallbackFunc
+	// firstRow is used to build the table definition if the schema
+	// file of the table version is missing.
+	firstRow *model.RowChangedEvent
+}
+
+// fileIndex records the index of the last data file of a table
+// in the date directory.
+type fileIndex struct {
+	date  string
+	index uint64
+}
+
+// dmlWorker encodes the events of tables and writes them to the storage.
+type dmlWorker struct {
+	// id is the index of the worker.
+	id int
+	// changeFeedID indicates this sink belongs to which processor(changefeed).
+	changeFeedID model.ChangeFeedID
+	storage      storage.ExternalStorage
+	config       *cloudstorage.Config
+	// extension is the extension of the data files.
+	extension string
+	// inputCh caches the transactions to be written.
+	// It is an unbounded channel.
+	inputCh *chann.Chann[*eventsink.TxnCallbackableEvent]
+	// encoder is used to encode the rows.
+	encoder codec.EventBatchEncoder
+	// statistics is used to record DML metrics.
+	statistics *metrics.Statistics
+
+	// tableVersions records the latest schema version of each table,
+	// the version of a row is zero if old value is disabled and it's
+	// a deletion, so the latest known version is used in this case.
+	tableVersions map[model.TableID]uint64
+	// tableSinkStates records the state of the table sink which the events
+	// of each table come from, a new one means the table is added to this
+	// capture again.
+	tableSinkStates map[model.TableID]*state.TableSinkState
+	buffers         map[cloudstorage.VersionedTable]*tableBuffer
+	fileIndexes     map[cloudstorage.VersionedTable]*fileIndex
+	// schemaFiles records the table versions whose schema file is known
+	// to exist in the storage.
+	schemaFiles map[cloudstorage.VersionedTable]struct{}
+}
+
+func newDMLWorker(
+	id int,
+	changefeedID model.ChangeFeedID,
+	storage storage.ExternalStorage,
+	config *cloudstorage.Config,
+	extension string,
+	encoder codec.EventBatchEncoder,
+	statistics *metrics.Statistics,
+) *dmlWorker {
+	return &dmlWorker{
+		id:              id,
+		changeFeedID:    changefeedID,
+		storage:         storage,
+		config:          config,
+		extension:       extension,
+		inputCh:         chann.New[*eventsink.TxnCallbackableEvent](),
+		encoder:         encoder,
+		statistics:      statistics,
+		tableVersions:   make(map[model.TableID]uint64),
+		tableSinkStates: make(map[model.TableID]*state.TableSinkState),
+		buffers:         make(map[cloudstorage.VersionedTable]*tableBuffer),
+		fileIndexes:     make(map[cloudstorage.VersionedTable]*fileIndex),
+		schemaFiles:     make(map[cloudstorage.VersionedTable]struct{}),
+	}
+}
+
+// run starts a loop that keeps collecting and writing transactions
+// until it encounters an error or is interrupted.
+func (w *dmlWorker) run(ctx context.Context) (retErr error) {
+	defer func() {
+		log.Info("cloud storage sink DML worker exited", zap.Int("workerID", w.id),
+			zap.String("namespace", w.changeFeedID.Namespace),
+			zap.String("changefeed", w.changeFeedID.ID),
+			zap.Error(retErr))
+	}()
+
+	ticker := time.NewTicker(w.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-ticker.C:
+			for table := range w.buffers {
+				if err := w.flush(ctx, table); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		case txn, ok := <-w.inputCh.Out():
+			if !ok {
+				return nil
+			}
+			table, err := w.appendTxn(ctx, txn)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if w.buffers[table].data.Len() >= w.config.FileSize {
+				if err := w.flush(ctx, table); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+	}
+}
+
+// appendTxn encodes the rows of a transaction into the buffer of its table.
+func (w *dmlWorker) appendTxn(
+	ctx context.Context, txn *eventsink.TxnCallbackableEvent,
+) (cloudstorage.VersionedTable, error) {
+	tableID := txn.Event.Table.TableID
+	if w.tableSinkStates[tableID] != txn.SinkState {
+		// The table may have been replicated by other captures since it was
+		// removed from this one, so the cached file indexes are stale.
+		for table := range w.fileIndexes {
+			if table.TableID == tableID {
+				delete(w.fileIndexes, table)
+			}
+		}
+		w.tableSinkStates[tableID] = txn.SinkState
+	}
+	version := w.tableVersions[tableID]
+	for _, row := range txn.Event.Rows {
+		if row.TableInfoVersion != 0 {
+			version = row.TableInfoVersion
+			break
+		}
+	}
+	w.tableVersions[tableID] = version
+
+	table := cloudstorage.VersionedTable{TableName: *txn.Event.Table, Version: version}
+	buffer, ok := w.buffers[table]
+	if !ok {
+		buffer = &tableBuffer{}
+		w.buffers[table] = buffer
+	}
+
+	if buffer.firstRow == nil && len(txn.Event.Rows) > 0 {
+		buffer.firstRow = txn.Event.Rows[0]
+	}
+	for _, row := range txn.Event.Rows {
+		if err := w.encoder.AppendRowChangedEvent(ctx, "", row, nil); err != nil {
+			return table, errors.Trace(err)
+		}
+	}
+	for _, msg := range w.encoder.Build() {
+		buffer.data.Write(msg.Value)
+		buffer.data.Write(lineTerminator)
+		buffer.rowsCount += msg.GetRowsCount()
+	}
+	buffer.callbacks = append(buffer.callbacks, txn.Callback)
+	w.statistics.ObserveRows(txn.Event.Rows...)
+	return table, nil
+}
+
+// flush writes the buffered rows of a table into a new data file,
+// and then notifies the transactions are flushed.
+func (w *dmlWorker) flush(ctx context.Context, table cloudstorage.VersionedTable) error {
+	buffer := w.buffers[table]
+	delete(w.buffers, table)
+	if buffer.data.Len() == 0 {
+		for _, cb := range buffer.callbacks {
+			cb()
+		}
+		return nil
+	}
+
+	if err := w.writeSchemaFileIfMissing(ctx, table, buffer.firstRow); err != nil {
+		return errors.Trace(err)
+	}
+	filePath, err := w.generateDataFilePath(ctx, table)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = w.statistics.RecordBatchExecution(func() (int, error) {
+		if err := w.storage.WriteFile(ctx, filePath, buffer.data.Bytes()); err != nil {
+			return 0, err
+		}
+		return buffer.rowsCount, nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	log.Debug("cloud storage sink flushed data file",
+		zap.Int("workerID", w.id),
+		zap.String("namespace", w.changeFeedID.Namespace),
+		zap.String("changefeed", w.changeFeedID.ID),
+		zap.String("path", filePath),
+		zap.Int("rows", buffer.rowsCount))
+
+	for _, cb := range buffer.callbacks {
+		cb()
+	}
+	return nil
+}
+
+// writeSchemaFileIfMissing makes sure the schema file of a table version
+// exists before its first data file is written. The schema file is written
+// by the DDL sink normally, but there is none for the versions which are
+// created before the changefeed starts, so it's built from the row here.
+func (w *dmlWorker) writeSchemaFileIfMissing(
+	ctx context.Context, table cloudstorage.VersionedTable, row *model.RowChangedEvent,
+) error {
+	if _, ok := w.schemaFiles[table]; ok {
+		return nil
+	}
+	schemaPath := cloudstorage.GenerateSchemaFilePath(table)
+	exists, err := w.storage.FileExists(ctx, schemaPath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !exists && row != nil {
+		var def cloudstorage.TableDefinition
+		def.FromRowChangedEvent(row, table.Version)
+		data, err := def.Marshal()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := w.storage.WriteFile(ctx, schemaPath, data); err != nil {
+			return errors.Trace(err)
+		}
+		log.Info("cloud storage sink wrote the missing table schema",
+			zap.Int("workerID", w.id),
+			zap.String("namespace", w.changeFeedID.Namespace),
+			zap.String("changefeed", w.changeFeedID.ID),
+			zap.String("path", schemaPath))
+	}
+	w.schemaFiles[table] = struct{}{}
+	return nil
+}
+
+// generateDataFilePath returns the path of the next data file of a table.
+// The index of the data files in a directory keeps increasing, and it
+// continues from the existing files when the directory is visited first
+// after the table is added to the worker.
+func (w *dmlWorker) generateDataFilePath(
+	ctx context.Context, table cloudstorage.VersionedTable,
+) (string, error) {
+	date := cloudstorage.GenerateDateStr(w.config.DateSeparator, time.Now())
+	dir := cloudstorage.GenerateDataDirPath(table, date)
+
+	idx, ok := w.fileIndexes[table]
+	if !ok || idx.date != date {
+		var maxIndex uint64
+		err := w.storage.WalkDir(ctx, &storage.WalkOption{SubDir: dir},
+			func(filePath string, _ int64) error {
+				// only the data files directly in the directory are concerned.
+				if path.Dir(filePath) != dir || path.Ext(filePath) != w.extension {
+					return nil
+				}
+				index, err := cloudstorage.FetchIndexFromFileName(filePath)
+				if err != nil {
+					return nil
+				}
+				if index > maxIndex {
+					maxIndex = index
+				}
+				return nil
+			})
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		idx = &fileIndex{date: date, index: maxIndex}
+		w.fileIndexes[table] = idx
+	}
+
+	idx.index++
+	return path.Join(dir, cloudstorage.GenerateDataFileName(idx.index, w.extension)), nil
+}
+
+func (w *dmlWorker) close() {
+	w.inputCh.Close()
+	// We must finish consuming the data here,
+	// otherwise it will cause the channel to not close properly.
+	for range w.inputCh.Out() {
+		// Do nothing. We do not care about the data.
+	}
+}
@@ -22,6 +22,10 @@ import (
 type TableEvent interface {
 	// GetCommitTs returns the commit timestamp of the event.
 	GetCommitTs() uint64
+	// GetRowsCount returns the number of rows in the event.
+	GetRowsCount() int
+	// ApproximateBytes returns the approximate size of the event in bytes.
+	ApproximateBytes() int
 }
 
 // CallbackFunc is the callback function for callbackable event.
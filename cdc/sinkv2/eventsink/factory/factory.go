@@ -17,9 +17,11 @@ import (
 	"context"
 	"strings"
 
+	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/blackhole"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/cloudstorage"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/txn"
@@ -28,6 +30,7 @@ import (
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -40,6 +43,8 @@ type SinkFactory struct {
 	sinkType sink.Type
 	rowSink  eventsink.EventSink[*model.RowChangedEvent]
 	txnSink  eventsink.EventSink[*model.SingleTableTxn]
+	// throttler is shared by all the table sinks created by the factory.
+	throttler *tablesink.Throttler
 }
 
 // New creates a new SinkFactory by schema.
@@ -53,7 +58,10 @@ func New(ctx context.Context,
 		return nil, err
 	}
 
-	s := &SinkFactory{}
+	s := &SinkFactory{
+		throttler: tablesink.NewThrottler(
+			contextutil.ChangefeedIDFromCtx(ctx), contextutil.TimezoneFromCtx(ctx)),
+	}
 	schema := strings.ToLower(sinkURI.Scheme)
 	switch schema {
 	case sink.MySQLSchema, sink.MySQLSSLSchema, sink.TiDBSchema, sink.TiDBSSLSchema:
@@ -63,14 +71,30 @@ func New(ctx context.Context,
 		}
 		s.txnSink = txnSink
 		s.sinkType = sink.TxnSink
+		s.throttler.OnMaxConnectionsChange(txnSink.SetMaxConnections)
 	case sink.KafkaSchema, sink.KafkaSSLSchema:
 		mqs, err := mq.NewKafkaDMLSink(ctx, sinkURI, cfg, errCh,
-			kafka.NewSaramaAdminClient, dmlproducer.NewKafkaDMLProducer)
+			kafka.NewSaramaAdminClient, dmlproducer.NewKafkaDMLProducer,
+			dmlproducer.NewKafkaTxnDMLProducer)
 		if err != nil {
 			return nil, err
 		}
 		s.rowSink = mqs
 		s.sinkType = sink.RowSink
+	case sink.PulsarSchema, sink.PulsarSSLSchema:
+		mqs, err := mq.NewPulsarDMLSink(ctx, sinkURI, cfg, errCh, pulsar.NewClient)
+		if err != nil {
+			return nil, err
+		}
+		s.rowSink = mqs
+		s.sinkType = sink.RowSink
+	case sink.S3Schema, sink.FileSchema, sink.GCSSchema:
+		storageSink, err := cloudstorage.NewCloudStorageSink(ctx, sinkURI, cfg, errCh)
+		if err != nil {
+			return nil, err
+		}
+		s.txnSink = storageSink
+		s.sinkType = sink.TxnSink
 	case sink.BlackHoleSchema:
 		bs := blackhole.New()
 		s.rowSink = bs
@@ -79,20 +103,31 @@ func New(ctx context.Context,
 		return nil,
 			cerror.ErrSinkURIInvalid.GenWithStack("the sink scheme (%s) is not supported", schema)
 	}
+	// The limits are split among the captures in the first UpdateThrottle.
+	s.throttler.Update(cfg.Throttle, 1)
 
 	return s, nil
 }
 
+// UpdateThrottle applies the throttle config to all the table sinks, the
+// limits are split among the given number of captures of the changefeed.
+// It should be called periodically to follow the time of day schedule.
+func (s *SinkFactory) UpdateThrottle(cfg *config.ThrottleConfig, captures int) {
+	if s.throttler != nil {
+		s.throttler.Update(cfg, captures)
+	}
+}
+
 // CreateTableSink creates a TableSink by schema.
 func (s *SinkFactory) CreateTableSink(tableID model.TableID, totalRowsCounter prometheus.Counter) tablesink.TableSink {
 	switch s.sinkType {
 	case sink.RowSink:
 		// We have to indicate the type here, otherwise it can not be compiled.
 		return tablesink.New[*model.RowChangedEvent](tableID,
-			s.rowSink, &eventsink.RowChangeEventAppender{}, totalRowsCounter)
+			s.rowSink, &eventsink.RowChangeEventAppender{}, s.throttler, totalRowsCounter)
 	case sink.TxnSink:
 		return tablesink.New[*model.SingleTableTxn](tableID,
-			s.txnSink, &eventsink.TxnEventAppender{}, totalRowsCounter)
+			s.txnSink, &eventsink.TxnEventAppender{}, s.throttler, totalRowsCounter)
 	default:
 		panic("unknown sink type")
 	}
@@ -66,7 +66,8 @@ func newForTest(ctx context.Context,
 	case "kafka", "kafka+ssl":
 		mqs, err := mq.NewKafkaDMLSink(ctx, sinkURI, cfg, errCh,
 			// Use mock kafka clients for test.
-			kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer)
+			kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer,
+			dmlproducer.NewTxnDMLMockProducer)
 		if err != nil {
 			return nil, err
 		}
@@ -40,3 +40,20 @@ type DMLProducer interface {
 // It's usually a buffered channel.
 type Factory func(ctx context.Context, client sarama.Client,
 	adminClient kafka.ClusterAdminClient, errCh chan error) (DMLProducer, error)
+
+// TxnDMLProducer is the interface for the producer which sends
+// the messages in transactions.
+type TxnDMLProducer interface {
+	DMLProducer
+
+	// Commit waits for all the messages sent since the last commit to be
+	// acknowledged, and commits them in one transaction. The callbacks of
+	// the messages are called only after the transaction is committed.
+	Commit(ctx context.Context) error
+}
+
+// TxnFactory is a function to create a transactional producer.
+// The client must be configured with the transactional ID of the capture,
+// and it's closed along with the producer.
+type TxnFactory func(ctx context.Context,
+	client sarama.Client, errCh chan error) (TxnDMLProducer, error)
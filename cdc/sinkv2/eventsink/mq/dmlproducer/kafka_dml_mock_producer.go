@@ -78,3 +78,82 @@ func (m *MockDMLProducer) GetEvents(key mqv1.TopicPartitionKey) []*common.Messag
 	defer m.mu.Unlock()
 	return m.events[key]
 }
+
+var _ TxnDMLProducer = (*MockTxnDMLProducer)(nil)
+
+// MockTxnDMLProducer is a mock transactional producer for test.
+// The callbacks of the messages are called when they are committed.
+type MockTxnDMLProducer struct {
+	*MockDMLProducer
+
+	client      sarama.Client
+	uncommitted []*common.Message
+	commits     int
+	closed      bool
+}
+
+// NewTxnDMLMockProducer creates a mock transactional producer.
+func NewTxnDMLMockProducer(_ context.Context,
+	client sarama.Client, _ chan error,
+) (TxnDMLProducer, error) {
+	return &MockTxnDMLProducer{
+		MockDMLProducer: &MockDMLProducer{
+			events: make(map[mqv1.TopicPartitionKey][]*common.Message),
+		},
+		client: client,
+	}, nil
+}
+
+// AsyncSendMessage appends a message to the current transaction.
+func (m *MockTxnDMLProducer) AsyncSendMessage(ctx context.Context, topic string,
+	partition int32, message *common.Message,
+) error {
+	if err := m.MockDMLProducer.AsyncSendMessage(ctx, topic, partition, message); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.uncommitted = append(m.uncommitted, message)
+	return nil
+}
+
+// Commit calls the callbacks of the messages in the current transaction.
+func (m *MockTxnDMLProducer) Commit(_ context.Context) error {
+	m.mu.Lock()
+	messages := m.uncommitted
+	m.uncommitted = nil
+	if len(messages) > 0 {
+		m.commits++
+	}
+	m.mu.Unlock()
+	for _, message := range messages {
+		if message.Callback != nil {
+			message.Callback()
+		}
+	}
+	return nil
+}
+
+// Close closes the client and marks the producer closed.
+func (m *MockTxnDMLProducer) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.client != nil {
+		_ = m.client.Close()
+	}
+	m.closed = true
+}
+
+// GetCommits returns the number of committed transactions.
+func (m *MockTxnDMLProducer) GetCommits() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.commits
+}
+
+// IsClosed returns whether the producer is closed.
+func (m *MockTxnDMLProducer) IsClosed() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.closed
+}
@@ -163,6 +163,9 @@ func (k *kafkaDMLProducer) AsyncSendMessage(
 		Value:     sarama.ByteEncoder(message.Value),
 		Metadata:  messageMetaData{callback: message.Callback},
 	}
+	if message.ClaimCheck {
+		msg.Headers = []sarama.RecordHeader{{Key: []byte(common.ClaimCheckHeaderKey)}}
+	}
 
 	select {
 	case <-ctx.Done():
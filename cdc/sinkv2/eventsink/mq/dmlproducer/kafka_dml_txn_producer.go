@@ -0,0 +1,330 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var _ TxnDMLProducer = (*kafkaTxnDMLProducer)(nil)
+
+// kafkaTxnDMLProducer sends the messages of a changefeed in Kafka transactions.
+// The transactional ID is derived from the changefeed and the capture, so the
+// producer created after the processor is restarted fences this one.
+type kafkaTxnDMLProducer struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id model.ChangeFeedID
+	// transactionalID is the transactional ID of the client.
+	transactionalID string
+	// client is owned by the producer, and closed along with it.
+	client sarama.Client
+	// asyncProducer is transactional.
+	asyncProducer sarama.AsyncProducer
+
+	// closedMu is used to protect `closed`.
+	closedMu sync.RWMutex
+	// closed is used to indicate whether the producer is closed.
+	closed bool
+	// closedChan is used to notify the run loop to exit.
+	closedChan chan struct{}
+
+	mu struct {
+		sync.Mutex
+		// inflight is the number of messages which haven't been acknowledged.
+		inflight int64
+		// callbacks of the acknowledged messages in the current transaction.
+		callbacks []eventsink.CallbackFunc
+		err       error
+	}
+	// notifyCh is notified when all inflight messages are acknowledged
+	// or an error occurs.
+	notifyCh chan struct{}
+}
+
+// NewKafkaTxnDMLProducer creates a new transactional kafka producer.
+func NewKafkaTxnDMLProducer(
+	ctx context.Context,
+	client sarama.Client,
+	errCh chan error,
+) (TxnDMLProducer, error) {
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	log.Info("Starting kafka transactional DML producer ...",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("transactionalID", client.Config().Producer.Transaction.ID))
+
+	// Initializing the producer ID fences the previous producer
+	// with the same transactional ID.
+	asyncProducer, err := sarama.NewAsyncProducerFromClient(client)
+	if err != nil {
+		go func() {
+			if err := client.Close(); err != nil {
+				log.Error("Close sarama client with error in kafka "+
+					"transactional DML producer", zap.Error(err),
+					zap.String("namespace", changefeedID.Namespace),
+					zap.String("changefeed", changefeedID.ID))
+			}
+		}()
+		return nil, cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
+	}
+	return newKafkaTxnDMLProducer(ctx, changefeedID,
+		client, asyncProducer, errCh), nil
+}
+
+func newKafkaTxnDMLProducer(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	client sarama.Client,
+	asyncProducer sarama.AsyncProducer,
+	errCh chan error,
+) *kafkaTxnDMLProducer {
+	k := &kafkaTxnDMLProducer{
+		id:              changefeedID,
+		transactionalID: client.Config().Producer.Transaction.ID,
+		client:          client,
+		asyncProducer:   asyncProducer,
+		closedChan:      make(chan struct{}),
+		notifyCh:        make(chan struct{}, 1),
+	}
+
+	go func() {
+		if err := k.run(ctx); err != nil && errors.Cause(err) != context.Canceled {
+			k.setError(err)
+			select {
+			case <-ctx.Done():
+				return
+			case errCh <- err:
+				log.Error("Kafka transactional DML producer run error", zap.Error(err),
+					zap.String("namespace", k.id.Namespace),
+					zap.String("changefeed", k.id.ID),
+					zap.String("transactionalID", k.transactionalID))
+			default:
+				log.Error("Error channel is full in kafka transactional DML producer",
+					zap.Error(err),
+					zap.String("namespace", k.id.Namespace),
+					zap.String("changefeed", k.id.ID),
+					zap.String("transactionalID", k.transactionalID))
+			}
+		}
+	}()
+	return k
+}
+
+// AsyncSendMessage sends a message in the current transaction,
+// the transaction is begun lazily by the first message after a commit.
+func (k *kafkaTxnDMLProducer) AsyncSendMessage(
+	ctx context.Context, topic string,
+	partition int32, message *common.Message,
+) error {
+	k.closedMu.RLock()
+	defer k.closedMu.RUnlock()
+	if k.closed {
+		return cerror.ErrKafkaProducerClosed.GenWithStackByArgs()
+	}
+
+	if k.asyncProducer.TxnStatus()&sarama.ProducerTxnFlagInTransaction == 0 {
+		if err := k.asyncProducer.BeginTxn(); err != nil {
+			return cerror.WrapError(cerror.ErrKafkaAsyncSendMessage, err)
+		}
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Partition: partition,
+		Key:       sarama.StringEncoder(message.Key),
+		Value:     sarama.ByteEncoder(message.Value),
+		Metadata:  messageMetaData{callback: message.Callback},
+	}
+	if message.ClaimCheck {
+		msg.Headers = []sarama.RecordHeader{{Key: []byte(common.ClaimCheckHeaderKey)}}
+	}
+
+	k.mu.Lock()
+	k.mu.inflight++
+	k.mu.Unlock()
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	case k.asyncProducer.Input() <- msg:
+	}
+	return nil
+}
+
+// Commit implements TxnDMLProducer.
+func (k *kafkaTxnDMLProducer) Commit(ctx context.Context) error {
+	k.closedMu.RLock()
+	defer k.closedMu.RUnlock()
+	if k.closed {
+		return cerror.ErrKafkaProducerClosed.GenWithStackByArgs()
+	}
+
+	for {
+		k.mu.Lock()
+		err, inflight := k.mu.err, k.mu.inflight
+		k.mu.Unlock()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if inflight == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-k.closedChan:
+			return cerror.ErrKafkaProducerClosed.GenWithStackByArgs()
+		case <-k.notifyCh:
+		}
+	}
+	if k.asyncProducer.TxnStatus()&sarama.ProducerTxnFlagInTransaction == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	if err := k.asyncProducer.CommitTxn(); err != nil {
+		log.Warn("Commit kafka transaction failed, try to abort it", zap.Error(err),
+			zap.String("namespace", k.id.Namespace),
+			zap.String("changefeed", k.id.ID),
+			zap.String("transactionalID", k.transactionalID))
+		// The transaction may be left open if aborting fails, but the
+		// transaction coordinator aborts it after the transaction timeout,
+		// or it's aborted when the next producer of the capture fences this one.
+		if abortErr := k.asyncProducer.AbortTxn(); abortErr != nil {
+			log.Warn("Abort kafka transaction failed", zap.Error(abortErr),
+				zap.String("namespace", k.id.Namespace),
+				zap.String("changefeed", k.id.ID),
+				zap.String("transactionalID", k.transactionalID))
+		}
+		return cerror.WrapError(cerror.ErrKafkaCommitTransaction, err)
+	}
+
+	k.mu.Lock()
+	callbacks := k.mu.callbacks
+	k.mu.callbacks = nil
+	k.mu.Unlock()
+	for _, callback := range callbacks {
+		callback()
+	}
+	log.Debug("Kafka transaction committed",
+		zap.Duration("duration", time.Since(start)),
+		zap.Int("messages", len(callbacks)),
+		zap.String("namespace", k.id.Namespace),
+		zap.String("changefeed", k.id.ID),
+		zap.String("transactionalID", k.transactionalID))
+	return nil
+}
+
+// Close closes the producer and the client. The open transaction is
+// aborted by the transaction coordinator after the transaction timeout,
+// or by the next producer of the capture.
+func (k *kafkaTxnDMLProducer) Close() {
+	k.closedMu.Lock()
+	defer k.closedMu.Unlock()
+	if k.closed {
+		log.Warn("Kafka transactional DML producer already closed",
+			zap.String("namespace", k.id.Namespace),
+			zap.String("changefeed", k.id.ID),
+			zap.String("transactionalID", k.transactionalID))
+		return
+	}
+	close(k.closedChan)
+	k.closed = true
+	// Close the clients asynchronously for the same reason as kafkaDMLProducer.
+	go func() {
+		start := time.Now()
+		if err := k.client.Close(); err != nil {
+			log.Error("Close sarama client with error in kafka "+
+				"transactional DML producer", zap.Error(err),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("namespace", k.id.Namespace),
+				zap.String("changefeed", k.id.ID),
+				zap.String("transactionalID", k.transactionalID))
+		}
+		start = time.Now()
+		if err := k.asyncProducer.Close(); err != nil {
+			log.Error("Close async client with error in kafka "+
+				"transactional DML producer", zap.Error(err),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("namespace", k.id.Namespace),
+				zap.String("changefeed", k.id.ID),
+				zap.String("transactionalID", k.transactionalID))
+		} else {
+			log.Info("Async client closed in kafka "+
+				"transactional DML producer", zap.Duration("duration", time.Since(start)),
+				zap.String("namespace", k.id.Namespace),
+				zap.String("changefeed", k.id.ID),
+				zap.String("transactionalID", k.transactionalID))
+		}
+	}()
+}
+
+func (k *kafkaTxnDMLProducer) run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-k.closedChan:
+			return nil
+		case ack := <-k.asyncProducer.Successes():
+			if ack == nil {
+				continue
+			}
+			k.mu.Lock()
+			if callback := ack.Metadata.(messageMetaData).callback; callback != nil {
+				k.mu.callbacks = append(k.mu.callbacks, callback)
+			}
+			k.mu.inflight--
+			inflight := k.mu.inflight
+			k.mu.Unlock()
+			if inflight == 0 {
+				k.notify()
+			}
+		case err := <-k.asyncProducer.Errors():
+			// See the comment in kafkaDMLProducer.run.
+			if err == nil {
+				return nil
+			}
+			return cerror.WrapError(cerror.ErrKafkaAsyncSendMessage, err)
+		}
+	}
+}
+
+// setError records the error which fails the current and following commits.
+func (k *kafkaTxnDMLProducer) setError(err error) {
+	k.mu.Lock()
+	if k.mu.err == nil {
+		k.mu.err = err
+	}
+	k.mu.Unlock()
+	k.notify()
+}
+
+func (k *kafkaTxnDMLProducer) notify() {
+	select {
+	case k.notifyCh <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	kafkav1 "github.com/pingcap/tiflow/cdc/sink/mq/producer/kafka"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestTxnProducerCommit(t *testing.T) {
+	t.Parallel()
+
+	leader, topic := initBroker(t, false)
+	defer leader.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	config := getConfig(leader.Addr())
+	saramaConfig, err := kafkav1.NewSaramaConfig(ctx, config)
+	require.Nil(t, err)
+	client, err := sarama.NewClient(config.BrokerEndpoints, saramaConfig)
+	require.Nil(t, err)
+
+	config.Version = "0.11.0.0"
+	txnConfig, err := kafkav1.NewSaramaConfig(ctx, config)
+	require.Nil(t, err)
+	changefeedID := model.DefaultChangeFeedID("test")
+	err = kafkav1.CompleteSaramaTransactionConfig(txnConfig, config, changefeedID, "127.0.0.1:8300")
+	require.Nil(t, err)
+	asyncProducer := mocks.NewAsyncProducer(t, txnConfig)
+	require.True(t, asyncProducer.IsTransactional())
+
+	errCh := make(chan error, 1)
+	producer := newKafkaTxnDMLProducer(ctx, changefeedID, client, asyncProducer, errCh)
+
+	// Nothing to commit.
+	require.Nil(t, producer.Commit(ctx))
+
+	count := atomic.NewInt64(0)
+	for i := 0; i < 2; i++ {
+		asyncProducer.ExpectInputAndSucceed()
+		err = producer.AsyncSendMessage(ctx, topic, int32(i), &common.Message{
+			Key:      []byte("test-key"),
+			Value:    []byte("test-value"),
+			Callback: func() { count.Add(1) },
+		})
+		require.Nil(t, err)
+	}
+	require.NotZero(t, asyncProducer.TxnStatus()&sarama.ProducerTxnFlagInTransaction)
+	// The messages are acknowledged, but the callbacks are only
+	// called after the transaction is committed.
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, int64(0), count.Load())
+	require.Nil(t, producer.Commit(ctx))
+	require.Equal(t, int64(2), count.Load())
+	require.Equal(t, sarama.ProducerTxnFlagReady, asyncProducer.TxnStatus())
+
+	// A failed message fails the commit.
+	asyncProducer.ExpectInputAndFail(errors.New("produce failed"))
+	err = producer.AsyncSendMessage(ctx, topic, int32(0), &common.Message{
+		Key:      []byte("test-key"),
+		Value:    []byte("test-value"),
+		Callback: func() { count.Add(1) },
+	})
+	require.Nil(t, err)
+	err = producer.Commit(ctx)
+	require.Regexp(t, ".*CDC:ErrKafkaAsyncSendMessage.*", err)
+	require.Regexp(t, ".*CDC:ErrKafkaAsyncSendMessage.*", <-errCh)
+	require.Equal(t, int64(2), count.Load())
+
+	producer.Close()
+	err = producer.AsyncSendMessage(ctx, topic, int32(0), &common.Message{})
+	require.Regexp(t, ".*CDC:ErrKafkaProducerClosed.*", err)
+	err = producer.Commit(ctx)
+	require.Regexp(t, ".*CDC:ErrKafkaProducerClosed.*", err)
+}
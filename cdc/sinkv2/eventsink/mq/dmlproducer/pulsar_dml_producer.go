@@ -0,0 +1,115 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"go.uber.org/zap"
+)
+
+var _ DMLProducer = (*pulsarDMLProducer)(nil)
+
+// pulsarDMLProducer is used to send messages to pulsar.
+type pulsarDMLProducer struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id model.ChangeFeedID
+	// producer sends messages to the partitions of pulsar topics.
+	producer *pulsar.Producer
+	// errCh is used to report the errors met when sending messages asynchronously.
+	errCh chan error
+	// closedMu is used to protect `closed`.
+	// We need to ensure that closed producers are never written to.
+	closedMu sync.RWMutex
+	// closed is used to indicate whether the producer is closed.
+	// We also use it to guard against double closes.
+	closed bool
+}
+
+// NewPulsarDMLProducer creates a new pulsar producer.
+func NewPulsarDMLProducer(
+	ctx context.Context,
+	producer *pulsar.Producer,
+	errCh chan error,
+) DMLProducer {
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	log.Info("Starting pulsar DML producer ...",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID))
+
+	return &pulsarDMLProducer{
+		id:       changefeedID,
+		producer: producer,
+		errCh:    errCh,
+	}
+}
+
+func (p *pulsarDMLProducer) AsyncSendMessage(
+	ctx context.Context, topic string,
+	partition int32, message *common.Message,
+) error {
+	// We have to hold the lock to avoid writing to a closed producer.
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	// If the producer is closed, we should skip the message and return an error.
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	return p.producer.AsyncSend(ctx, topic, partition, message, func(err error) {
+		if err != nil {
+			select {
+			case <-ctx.Done():
+			case p.errCh <- err:
+				log.Error("Pulsar DML producer send message error", zap.Error(err),
+					zap.String("namespace", p.id.Namespace),
+					zap.String("changefeed", p.id.ID))
+			default:
+				log.Error("Error channel is full in pulsar DML producer", zap.Error(err),
+					zap.String("namespace", p.id.Namespace),
+					zap.String("changefeed", p.id.ID))
+			}
+			return
+		}
+		if message.Callback != nil {
+			message.Callback()
+		}
+	})
+}
+
+func (p *pulsarDMLProducer) Close() {
+	// We have to hold the lock to synchronize closing with writing.
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+	// If the producer has already been closed, we should skip this close operation.
+	if p.closed {
+		log.Warn("Pulsar DML producer already closed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+		return
+	}
+	p.closed = true
+	p.producer.Close()
+	log.Info("Pulsar DML producer closed",
+		zap.String("namespace", p.id.Namespace),
+		zap.String("changefeed", p.id.ID))
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestPulsarProducerAck(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := pulsar.NewMockClient(map[string]int{"test": 2})
+	errCh := make(chan error, 1)
+	producer := NewPulsarDMLProducer(ctx,
+		pulsar.NewProducer(model.DefaultChangeFeedID("test"), client, pulsar.NewConfig()), errCh)
+
+	count := atomic.NewInt64(0)
+	for i := 0; i < 10; i++ {
+		err := producer.AsyncSendMessage(ctx, "test", int32(i%2), &common.Message{
+			Key:   []byte("test-key"),
+			Value: []byte("test-value"),
+			Callback: func() {
+				count.Add(1)
+			},
+		})
+		require.Nil(t, err)
+	}
+	require.Equal(t, int64(10), count.Load())
+	require.Len(t, client.Messages("test", 0), 5)
+	require.Len(t, client.Messages("test", 1), 5)
+
+	// The error is reported to errCh and the callback is not called.
+	client.SetSendError(errors.New("fake"))
+	err := producer.AsyncSendMessage(ctx, "test", 0, &common.Message{
+		Callback: func() {
+			count.Add(1)
+		},
+	})
+	require.Nil(t, err)
+	require.Regexp(t, ".*CDC:ErrPulsarAsyncSendMessage.*", <-errCh)
+	require.Equal(t, int64(10), count.Load())
+
+	producer.Close()
+	require.True(t, client.IsClosed())
+	// Close the producer twice should not panic.
+	producer.Close()
+	err = producer.AsyncSendMessage(ctx, "test", 0, &common.Message{})
+	require.Regexp(t, ".*CDC:ErrPulsarProducerClosed.*", err)
+}
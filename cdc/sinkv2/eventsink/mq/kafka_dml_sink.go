@@ -20,6 +20,8 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/sink/mq/columnselector"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
 	"github.com/pingcap/tiflow/cdc/sink/mq/producer/kafka"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
@@ -27,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pkafka "github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/rcrowley/go-metrics"
 	"go.uber.org/zap"
 )
 
@@ -38,6 +41,7 @@ func NewKafkaDMLSink(
 	errCh chan error,
 	adminClientCreator pkafka.ClusterAdminClientCreator,
 	producerCreator dmlproducer.Factory,
+	txnProducerFactory dmlproducer.TxnFactory,
 ) (_ *dmlSink, err error) {
 	topic, err := mqutil.GetTopic(sinkURI)
 	if err != nil {
@@ -111,16 +115,56 @@ func NewKafkaDMLSink(
 		return nil, errors.Trace(err)
 	}
 
+	columnSelector, err := columnselector.New(replicaConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	encoderConfig, err := mqutil.GetEncoderConfig(sinkURI, protocol, replicaConfig,
 		saramaConfig.Producer.MaxMessageBytes)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
-	s, err := newSink(ctx, p, topicManager, eventRouter, encoderConfig, errCh)
+	var txnProducer dmlproducer.TxnDMLProducer
+	if baseConfig.EnableTransactional {
+		// The transactional ID is a part of the client config, so the
+		// transactional producer has its own client, which is shared by
+		// all the tables of the changefeed on this capture.
+		txnConfig := newTxnSaramaConfig(saramaConfig)
+		if err = kafka.CompleteSaramaTransactionConfig(txnConfig, baseConfig,
+			contextutil.ChangefeedIDFromCtx(ctx),
+			contextutil.CaptureAddrFromCtx(ctx)); err != nil {
+			return nil, errors.Trace(err)
+		}
+		var txnClient sarama.Client
+		txnClient, err = sarama.NewClient(baseConfig.BrokerEndpoints, txnConfig)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
+		}
+		txnProducer, err = txnProducerFactory(ctx, txnClient, errCh)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrKafkaNewSaramaProducer, err)
+		}
+	}
+
+	s, err := newSink(ctx, p, topicManager, eventRouter, columnSelector,
+		encoderConfig, txnProducer, errCh)
 	if err != nil {
+		if txnProducer != nil {
+			txnProducer.Close()
+		}
 		return nil, errors.Trace(err)
 	}
 
 	return s, nil
 }
+
+// newTxnSaramaConfig copies the sarama config for the transactional producer.
+// The metrics of the transactional producer are not collected, so a separate
+// registry is used to keep the metrics of the other client intact.
+func newTxnSaramaConfig(saramaConfig *sarama.Config) *sarama.Config {
+	txnConfig := *saramaConfig
+	txnConfig.MetricRegistry = metrics.NewRegistry()
+	return &txnConfig
+}
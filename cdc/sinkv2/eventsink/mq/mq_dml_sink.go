@@ -22,8 +22,10 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/builder"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
+	"github.com/pingcap/tiflow/cdc/sink/mq/columnselector"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
 	"github.com/pingcap/tiflow/cdc/sink/mq/manager"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
@@ -46,9 +48,17 @@ type dmlSink struct {
 	// protocol indicates the protocol used by this sink.
 	protocol config.Protocol
 
+	// worker sends the events of all tables.
 	worker *worker
+	// transactional is true if the worker sends the events in transactions.
+	transactional bool
+	// producer is only used to hold the clients and collect metrics
+	// if the events are sent by the transactional producer of the worker.
+	producer dmlproducer.DMLProducer
 	// eventRouter used to route events to the right topic and partition.
 	eventRouter *dispatcher.EventRouter
+	// columnSelector used to remove the unselected columns from the events.
+	columnSelector *columnselector.ColumnSelector
 	// topicManager used to manage topics.
 	// It is also responsible for creating topics.
 	topicManager manager.TopicManager
@@ -61,7 +71,9 @@ func newSink(ctx context.Context,
 	producer dmlproducer.DMLProducer,
 	topicManager manager.TopicManager,
 	eventRouter *dispatcher.EventRouter,
+	columnSelector *columnselector.ColumnSelector,
 	encoderConfig *common.Config,
+	txnProducer dmlproducer.TxnDMLProducer,
 	errCh chan error,
 ) (*dmlSink, error) {
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
@@ -70,19 +82,35 @@ func newSink(ctx context.Context,
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
 	}
-	encoder := encoderBuilder.Build()
+	var claimCheck *claimcheck.ClaimCheck
+	if encoderConfig.ClaimCheckStorageURI != "" {
+		claimCheck, err = claimcheck.New(ctx, changefeedID,
+			encoderConfig.ClaimCheckStorageURI, encoderConfig.MaxMessageBytes)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 
 	statistics := metrics.NewStatistics(ctx, sink.RowSink)
-	w := newWorker(changefeedID, encoder, producer, statistics)
-
 	s := &dmlSink{
 		id:             changefeedID,
 		protocol:       encoderConfig.Protocol,
-		worker:         w,
 		eventRouter:    eventRouter,
+		columnSelector: columnSelector,
 		topicManager:   topicManager,
 		encoderBuilder: encoderBuilder,
 	}
+	// All the tables of the changefeed on this capture share the worker and
+	// the transactional producer, whose transactional ID is derived from the
+	// changefeed and the capture. So nothing of a table is left behind after
+	// it's moved to another capture, and the producer of a restarted
+	// processor fences the producer of its zombie predecessor.
+	if txnProducer != nil {
+		s.transactional = true
+		s.producer = producer
+		producer = txnProducer
+	}
+	s.worker = newWorker(changefeedID, encoderBuilder.Build(), claimCheck, producer, statistics)
 
 	// Spawn a goroutine to send messages by the worker.
 	go func() {
@@ -105,20 +133,35 @@ func newSink(ctx context.Context,
 // WriteEvents writes events to the sink.
 // This is an asynchronously and thread-safe method.
 func (s *dmlSink) WriteEvents(rows ...*eventsink.RowChangeCallbackableEvent) error {
-	for _, row := range rows {
+	// The table sink writes all the events of a table before a resolved ts
+	// in one call, so the last event of each table is the boundary where
+	// the transactional producer commits.
+	var lastIndexes map[model.TableID]int
+	if s.transactional {
+		lastIndexes = make(map[model.TableID]int)
+		for i, row := range rows {
+			lastIndexes[row.Event.Table.TableID] = i
+		}
+	}
+	for i, row := range rows {
+		row.Event = s.columnSelector.Apply(row.Event)
 		topic := s.eventRouter.GetTopicForRowChange(row.Event)
 		partitionNum, err := s.topicManager.GetPartitionNum(topic)
 		if err != nil {
 			return errors.Trace(err)
 		}
 		partition := s.eventRouter.GetPartitionForRowChange(row.Event, partitionNum)
-		// This never be blocked because this is an unbounded channel.
-		s.worker.msgChan.In() <- mqEvent{
+		event := mqEvent{
 			key: mqv1.TopicPartitionKey{
 				Topic: topic, Partition: partition,
 			},
 			rowEvent: row,
 		}
+		if s.transactional {
+			event.resolved = lastIndexes[row.Event.Table.TableID] == i
+		}
+		// This never be blocked because this is an unbounded channel.
+		s.worker.msgChan.In() <- event
 	}
 
 	return nil
@@ -127,5 +170,8 @@ func (s *dmlSink) WriteEvents(rows ...*eventsink.RowChangeCallbackableEvent) err
 // Close closes the sink.
 func (s *dmlSink) Close() error {
 	s.worker.close()
+	if s.producer != nil {
+		s.producer.Close()
+	}
 	return nil
 }
@@ -27,7 +27,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 )
 
 func initBroker(t *testing.T, partitionNum int) (*sarama.MockBroker, string) {
@@ -69,7 +71,8 @@ func TestNewKafkaDMLSinkFailed(t *testing.T) {
 	errCh := make(chan error, 1)
 
 	s, err := NewKafkaDMLSink(ctx, sinkURI, replicaConfig, errCh,
-		kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer)
+		kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer,
+		dmlproducer.NewTxnDMLMockProducer)
 	require.ErrorContains(t, err, "Avro protocol requires parameter \"schema-registry\"",
 		"should report error when protocol is avro but schema-registry is not set")
 	require.Nil(t, s)
@@ -95,7 +98,8 @@ func TestWriteEvents(t *testing.T) {
 	errCh := make(chan error, 1)
 
 	s, err := NewKafkaDMLSink(ctx, sinkURI, replicaConfig, errCh,
-		kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer)
+		kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer,
+		dmlproducer.NewTxnDMLMockProducer)
 	require.Nil(t, err)
 	require.NotNil(t, s)
 
@@ -124,3 +128,112 @@ func TestWriteEvents(t *testing.T) {
 	err = s.Close()
 	require.Nil(t, err)
 }
+
+func TestWriteEventsTransactional(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leader, topic := initBroker(t, kafka.DefaultMockPartitionNum)
+	defer leader.Close()
+	uriTemplate := "kafka://%s/%s?kafka-version=0.11.0.0&max-batch-size=1" +
+		"&max-message-bytes=1048576&partition-num=1&enable-transactional=true" +
+		"&kafka-client-id=unit-test&auto-create-topic=false&protocol=open-protocol"
+	uri := fmt.Sprintf(uriTemplate, leader.Addr(), topic)
+
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 1)
+
+	s, err := NewKafkaDMLSink(ctx, sinkURI, replicaConfig, errCh,
+		kafka.NewMockAdminClient, dmlproducer.NewDMLMockProducer,
+		dmlproducer.NewTxnDMLMockProducer)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	require.True(t, s.transactional)
+
+	tableStatus := state.TableSinkSinking
+	var flushed atomic.Int64
+	events := make([]*eventsink.RowChangeCallbackableEvent, 0, 100)
+	for i := 0; i < 100; i++ {
+		tableID := model.TableID(i%2 + 1)
+		events = append(events, &eventsink.RowChangeCallbackableEvent{
+			Event: &model.RowChangedEvent{
+				CommitTs: 1,
+				Table: &model.TableName{
+					Schema: "a", Table: fmt.Sprintf("t%d", tableID), TableID: tableID,
+				},
+				Columns: []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+			},
+			Callback:  func() { flushed.Inc() },
+			SinkState: &tableStatus,
+		})
+	}
+
+	err = s.WriteEvents(events...)
+	require.Nil(t, err)
+	// The callbacks are called after the transactions are committed.
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 100
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+
+	// All the tables are sent by the shared transactional producer.
+	producer := s.worker.producer.(*dmlproducer.MockTxnDMLProducer)
+	require.Len(t, producer.GetAllEvents(), 100)
+	require.GreaterOrEqual(t, producer.GetCommits(), 1)
+
+	err = s.Close()
+	require.Nil(t, err)
+	require.True(t, producer.IsClosed())
+}
+
+func TestPulsarWriteEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uri := "pulsar://127.0.0.1:6650/test?max-message-bytes=1048576&protocol=open-protocol"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 1)
+
+	client := pulsar.NewMockClient(map[string]int{"test": 3})
+	s, err := NewPulsarDMLSink(ctx, sinkURI, replicaConfig, errCh,
+		pulsar.NewMockClientCreator(client))
+	require.Nil(t, err)
+	require.NotNil(t, s)
+
+	tableStatus := state.TableSinkSinking
+	row := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+	}
+
+	var flushed atomic.Int64
+	events := make([]*eventsink.RowChangeCallbackableEvent, 0, 100)
+	for i := 0; i < 100; i++ {
+		events = append(events, &eventsink.RowChangeCallbackableEvent{
+			Event:     row,
+			Callback:  func() { flushed.Inc() },
+			SinkState: &tableStatus,
+		})
+	}
+
+	err = s.WriteEvents(events...)
+	require.Nil(t, err)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 100
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, errCh, 0)
+	err = s.Close()
+	require.Nil(t, err)
+	require.True(t, client.IsClosed())
+}
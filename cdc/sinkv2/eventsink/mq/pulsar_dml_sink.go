@@ -0,0 +1,101 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/sink/mq/columnselector"
+	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
+	"github.com/pingcap/tiflow/cdc/sink/mq/manager"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
+	mqutil "github.com/pingcap/tiflow/cdc/sinkv2/util/mq"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/pulsar"
+	"go.uber.org/zap"
+)
+
+// NewPulsarDMLSink will verify the config and create a Pulsar DML Sink.
+func NewPulsarDMLSink(
+	ctx context.Context,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan error,
+	clientCreator pulsar.ClientCreator,
+) (_ *dmlSink, err error) {
+	topic, err := mqutil.GetTopic(sinkURI)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	baseConfig := pulsar.NewConfig()
+	if err := baseConfig.Apply(sinkURI); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	protocol, err := mqutil.GetProtocol(replicaConfig.Sink.Protocol)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	client, err := clientCreator(baseConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	log.Info("Try to create a DML sink producer",
+		zap.String("url", baseConfig.URL))
+	producer := pulsar.NewProducer(contextutil.ChangefeedIDFromCtx(ctx), client, baseConfig)
+	p := dmlproducer.NewPulsarDMLProducer(ctx, producer, errCh)
+	// Preventing leaks when error occurs.
+	// This also closes the client in p.Close().
+	defer func() {
+		if err != nil {
+			p.Close()
+		}
+	}()
+
+	topicManager := manager.NewPulsarTopicManager(producer)
+	if _, err = topicManager.CreateTopicAndWaitUntilVisible(topic); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	eventRouter, err := dispatcher.NewEventRouter(replicaConfig, topic)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	columnSelector, err := columnselector.New(replicaConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	encoderConfig, err := mqutil.GetEncoderConfig(sinkURI, protocol, replicaConfig,
+		baseConfig.MaxMessageBytes)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	s, err := newSink(ctx, p, topicManager, eventRouter, columnSelector,
+		encoderConfig, nil, errCh)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return s, nil
+}
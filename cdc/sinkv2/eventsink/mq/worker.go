@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
@@ -35,6 +36,11 @@ import (
 type mqEvent struct {
 	key      mqv1.TopicPartitionKey
 	rowEvent *eventsink.RowChangeCallbackableEvent
+	// resolved is true if the event is the last one of its table in a flush
+	// of the table sink, i.e. all events before a resolved ts are sent, or all
+	// events allowed by the changefeed throttle if the flush is throttled.
+	// The transactional producer only commits after such an event.
+	resolved bool
 }
 
 // worker will send messages to the DML producer on a batch basis.
@@ -48,6 +54,9 @@ type worker struct {
 	ticker *time.Ticker
 	// encoder is used to encode the messages.
 	encoder codec.EventBatchEncoder
+	// claimCheck is used to offload the messages larger than max-message-bytes.
+	// It is nil if claim-check is disabled.
+	claimCheck *claimcheck.ClaimCheck
 	// producer is used to send the messages to the Kafka broker.
 	producer dmlproducer.DMLProducer
 	// statistics is used to record DML metrics.
@@ -58,6 +67,7 @@ type worker struct {
 func newWorker(
 	id model.ChangeFeedID,
 	encoder codec.EventBatchEncoder,
+	claimCheck *claimcheck.ClaimCheck,
 	producer dmlproducer.DMLProducer,
 	statistics *metrics.Statistics,
 ) *worker {
@@ -66,6 +76,7 @@ func newWorker(
 		msgChan:      chann.New[mqEvent](),
 		ticker:       time.NewTicker(mqv1.FlushInterval),
 		encoder:      encoder,
+		claimCheck:   claimCheck,
 		producer:     producer,
 		statistics:   statistics,
 	}
@@ -103,6 +114,16 @@ func (w *worker) run(ctx context.Context) (retErr error) {
 		if err != nil {
 			return errors.Trace(err)
 		}
+		// The transaction is committed after a batch containing a resolved
+		// event is sent, so it may span several batches, and the callbacks of
+		// the events are called after the transaction is committed.
+		// A batch is cut by size or time, so the resolved event can be
+		// anywhere in it.
+		if txnProducer, ok := w.producer.(dmlproducer.TxnDMLProducer); ok && hasResolved(msgs) {
+			if err := txnProducer.Commit(ctx); err != nil {
+				return errors.Trace(err)
+			}
+		}
 	}
 }
 
@@ -154,6 +175,16 @@ func (w *worker) batch(
 	}
 }
 
+// hasResolved returns whether there is a resolved event in the messages.
+func hasResolved(events []mqEvent) bool {
+	for _, event := range events {
+		if event.resolved {
+			return true
+		}
+	}
+	return false
+}
+
 // group is responsible for grouping messages by the partition.
 func (w *worker) group(
 	events []mqEvent,
@@ -191,6 +222,11 @@ func (w *worker) asyncSend(
 		}
 
 		for _, message := range w.encoder.Build() {
+			if w.claimCheck != nil {
+				if err := w.claimCheck.Handle(ctx, message); err != nil {
+					return errors.Trace(err)
+				}
+			}
 			err := w.statistics.RecordBatchExecution(func() (int, error) {
 				err := w.producer.AsyncSendMessage(ctx, key.Topic, key.Partition, message)
 				if err != nil {
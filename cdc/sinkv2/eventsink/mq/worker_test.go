@@ -15,12 +15,16 @@ package mq
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec/builder"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/cdc/sink/codec/open"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
@@ -29,6 +33,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 )
 
 func newTestWorker(ctx context.Context, t *testing.T) (*worker, dmlproducer.DMLProducer) {
@@ -41,7 +46,7 @@ func newTestWorker(ctx context.Context, t *testing.T) (*worker, dmlproducer.DMLP
 	p, err := dmlproducer.NewDMLMockProducer(context.Background(), nil, nil, nil)
 	require.Nil(t, err)
 	id := model.DefaultChangeFeedID("test")
-	return newWorker(id, encoder, p, metrics.NewStatistics(ctx, sink.RowSink)), p
+	return newWorker(id, encoder, nil, p, metrics.NewStatistics(ctx, sink.RowSink)), p
 }
 
 func TestBatch(t *testing.T) {
@@ -298,6 +303,64 @@ func TestAsyncSend(t *testing.T) {
 	require.Len(t, mp.GetEvents(key3), 2)
 }
 
+func TestAsyncSendWithClaimCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	worker, p := newTestWorker(ctx, t)
+	defer worker.close()
+	claimCheck, err := claimcheck.New(ctx, worker.changeFeedID, "file://"+t.TempDir(), 200)
+	require.NoError(t, err)
+	worker.claimCheck = claimCheck
+	// The open protocol encoder allows the rows larger than max-message-bytes.
+	worker.encoder.(*open.BatchEncoder).ClaimCheck = true
+
+	key := mqv1.TopicPartitionKey{Topic: "test", Partition: 1}
+	tableStatus := state.TableSinkSinking
+	events := []mqEvent{
+		{
+			rowEvent: &eventsink.RowChangeCallbackableEvent{
+				Event: &model.RowChangedEvent{
+					CommitTs: 1,
+					Table:    &model.TableName{Schema: "a", Table: "b"},
+					Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+				},
+				Callback:  func() {},
+				SinkState: &tableStatus,
+			},
+			key: key,
+		},
+		{
+			rowEvent: &eventsink.RowChangeCallbackableEvent{
+				Event: &model.RowChangedEvent{
+					CommitTs: 2,
+					Table:    &model.TableName{Schema: "a", Table: "b"},
+					Columns: []*model.Column{{
+						Name: "col1", Type: 1, Value: strings.Repeat("b", 1024),
+					}},
+				},
+				Callback:  func() {},
+				SinkState: &tableStatus,
+			},
+			key: key,
+		},
+	}
+
+	err = worker.asyncSend(ctx, worker.group(events))
+	require.NoError(t, err)
+	messages := p.(*dmlproducer.MockDMLProducer).GetEvents(key)
+	require.Len(t, messages, 2)
+	require.False(t, messages[0].ClaimCheck)
+	require.True(t, messages[1].ClaimCheck)
+	require.Less(t, len(messages[1].Value), 1024)
+
+	// The original message can be fetched by the reference.
+	_, value, err := claimcheck.NewFetcher("").Fetch(ctx, messages[1].Value)
+	require.NoError(t, err)
+	require.Contains(t, string(value), strings.Repeat("b", 1024))
+}
+
 func TestAsyncSendWhenTableStopping(t *testing.T) {
 	t.Parallel()
 
@@ -375,3 +438,112 @@ func TestAbort(t *testing.T) {
 	cancel()
 	wg.Wait()
 }
+
+func TestCommitAtResolvedEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	encoderConfig := common.NewConfig(config.ProtocolOpen).WithMaxMessageBytes(200)
+	builder, err := builder.NewEventBatchEncoderBuilder(context.Background(), encoderConfig)
+	require.Nil(t, err)
+	p, err := dmlproducer.NewTxnDMLMockProducer(context.Background(), nil, nil)
+	require.Nil(t, err)
+	worker := newWorker(model.DefaultChangeFeedID("test"), builder.Build(), nil, p,
+		metrics.NewStatistics(ctx, sink.RowSink))
+	defer worker.close()
+	go func() {
+		_ = worker.run(ctx)
+	}()
+
+	key := mqv1.TopicPartitionKey{Topic: "test", Partition: 1}
+	tableStatus := state.TableSinkSinking
+	row := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b", TableID: 1},
+		Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+	}
+	var flushed atomic.Int64
+	send := func(n int) {
+		for i := 0; i < n; i++ {
+			worker.msgChan.In() <- mqEvent{
+				key: key,
+				rowEvent: &eventsink.RowChangeCallbackableEvent{
+					Event:     row,
+					Callback:  func() { flushed.Inc() },
+					SinkState: &tableStatus,
+				},
+				resolved: i == n-1,
+			}
+		}
+	}
+
+	// The events before a resolved ts are sent in several batches,
+	// but they are committed in one transaction.
+	send(mqv1.FlushBatchSize*2 + 1)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == int64(mqv1.FlushBatchSize*2+1)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, p.(*dmlproducer.MockTxnDMLProducer).GetCommits())
+
+	send(10)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == int64(mqv1.FlushBatchSize*2+11)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 2, p.(*dmlproducer.MockTxnDMLProducer).GetCommits())
+}
+
+func TestCommitAtResolvedEventInBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	encoderConfig := common.NewConfig(config.ProtocolOpen).WithMaxMessageBytes(200)
+	builder, err := builder.NewEventBatchEncoderBuilder(context.Background(), encoderConfig)
+	require.Nil(t, err)
+	p, err := dmlproducer.NewTxnDMLMockProducer(context.Background(), nil, nil)
+	require.Nil(t, err)
+	worker := newWorker(model.DefaultChangeFeedID("test"), builder.Build(), nil, p,
+		metrics.NewStatistics(ctx, sink.RowSink))
+	defer worker.close()
+
+	key := mqv1.TopicPartitionKey{Topic: "test", Partition: 1}
+	tableStatus := state.TableSinkSinking
+	row := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b", TableID: 1},
+		Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+	}
+	var flushed atomic.Int64
+	// Every 7th event is resolved, so the batches cut by FlushBatchSize
+	// never end at a resolved event, and the last events aren't resolved.
+	total := mqv1.FlushBatchSize*3 + 5
+	lastResolved := 0
+	for i := 1; i <= total; i++ {
+		resolved := i%7 == 0
+		if resolved {
+			lastResolved = i
+		}
+		worker.msgChan.In() <- mqEvent{
+			key: key,
+			rowEvent: &eventsink.RowChangeCallbackableEvent{
+				Event:     row,
+				Callback:  func() { flushed.Inc() },
+				SinkState: &tableStatus,
+			},
+			resolved: resolved,
+		}
+	}
+	require.NotEqual(t, 0, mqv1.FlushBatchSize%7)
+	require.NotEqual(t, total, lastResolved)
+	go func() {
+		_ = worker.run(ctx)
+	}()
+
+	// All the events before the last resolved event are committed, and
+	// the events sent along with it in the same batch are committed too.
+	require.Eventually(t, func() bool {
+		return flushed.Load() >= int64(lastResolved)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.GreaterOrEqual(t, p.(*dmlproducer.MockTxnDMLProducer).GetCommits(), 3)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/metrics"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/quotes"
@@ -52,6 +53,9 @@ type mysqlBackend struct {
 	db          *sql.DB
 	cfg         *pmysql.Config
 	dmlMaxRetry uint64
+	// replicationID marks the transactions written by the backend if it's
+	// not 0, see package bidirectional for details.
+	replicationID uint64
 
 	events []*eventsink.TxnCallbackableEvent
 	rows   int
@@ -90,6 +94,14 @@ func NewMySQLBackends(
 	db.SetMaxIdleConns(cfg.WorkerCount)
 	db.SetMaxOpenConns(cfg.WorkerCount)
 
+	var replicationID uint64
+	if replicaConfig.Bidirectional != nil {
+		if err := createMarkTable(ctx, db); err != nil {
+			return nil, err
+		}
+		replicationID = replicaConfig.Bidirectional.ReplicationID
+	}
+
 	backends := make([]*mysqlBackend, 0, cfg.WorkerCount)
 	for i := 0; i < cfg.WorkerCount; i++ {
 		backends = append(backends, &mysqlBackend{
@@ -100,6 +112,8 @@ func NewMySQLBackends(
 			dmlMaxRetry: defaultDMLMaxRetry,
 			statistics:  statistics,
 
+			replicationID: replicationID,
+
 			metricTxnSinkDMLBatchCommit:   metrics.TxnSinkDMLBatchCommit.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 			metricTxnSinkDMLBatchCallback: metrics.TxnSinkDMLBatchCallback.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		})
@@ -113,6 +127,25 @@ func NewMySQLBackends(
 	return backends, nil
 }
 
+func createMarkTable(ctx context.Context, db *sql.DB) error {
+	for _, query := range bidirectional.CreateMarkTableSQLs() {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			return cerror.WrapError(cerror.ErrCreateMarkTableFailed, err)
+		}
+	}
+	return nil
+}
+
+// SetMaxConnections limits the number of connections to the downstream, the
+// backends share a connection pool. n <= 0 restores it to the worker count.
+func (s *mysqlBackend) SetMaxConnections(n int) {
+	if n <= 0 || n > s.cfg.WorkerCount {
+		n = s.cfg.WorkerCount
+	}
+	s.db.SetMaxIdleConns(n)
+	s.db.SetMaxOpenConns(n)
+}
+
 // OnTxnEvent implements interface backend.
 func (s *mysqlBackend) OnTxnEvent(event *eventsink.TxnCallbackableEvent) (needFlush bool) {
 	s.events = append(s.events, event)
@@ -222,12 +255,29 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 		}
 	}
 
+	// marked records the tables whose mark has been updated, since all the
+	// transactions are executed in one downstream transaction.
+	var marked map[model.TableName]struct{}
+	if s.replicationID != 0 {
+		marked = make(map[model.TableName]struct{})
+	}
+
 	rowCount := 0
 	for _, event := range s.events {
 		if event.Callback != nil {
 			callbacks = append(callbacks, event.Callback)
 		}
 
+		if marked != nil && event.Event.Table != nil {
+			if _, ok := marked[*event.Event.Table]; !ok {
+				marked[*event.Event.Table] = struct{}{}
+				query, args := bidirectional.UpdateMarkSQL(event.Event.Table.Schema,
+					event.Event.Table.Table, s.workerID, s.replicationID)
+				sqls = append(sqls, query)
+				values = append(values, args)
+			}
+		}
+
 		for _, row := range event.Event.Rows {
 			if len(startTs) == 0 || startTs[len(startTs)-1] != row.StartTs {
 				startTs = append(startTs, row.StartTs)
@@ -34,6 +34,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/metrics"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
@@ -149,6 +150,50 @@ func TestPrepareDML(t *testing.T) {
 	}
 }
 
+func TestPrepareDMLWithMark(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ms := newMySQLBackendWithoutDB(ctx)
+	ms.workerID = 3
+	ms.replicationID = 1
+
+	table := &model.TableName{Schema: "common_1", Table: "uk_without_pk"}
+	newTxn := func(startTs model.Ts, value int) *eventsink.TxnCallbackableEvent {
+		return &eventsink.TxnCallbackableEvent{
+			Event: &model.SingleTableTxn{Table: table, StartTs: startTs, Rows: []*model.RowChangedEvent{{
+				StartTs:  startTs,
+				CommitTs: startTs + 1,
+				Table:    table,
+				Columns: []*model.Column{{
+					Name:  "a1",
+					Type:  mysql.TypeLong,
+					Flag:  model.BinaryFlag | model.MultipleKeyFlag | model.HandleKeyFlag,
+					Value: value,
+				}},
+				IndexColumns: [][]int{{0}},
+			}}},
+		}
+	}
+	ms.events = []*eventsink.TxnCallbackableEvent{newTxn(1, 1), newTxn(3, 2)}
+	ms.rows = 2
+
+	// The table is marked only once since the transactions are executed in
+	// one downstream transaction.
+	markSQL, markArgs := bidirectional.UpdateMarkSQL("common_1", "uk_without_pk", 3, 1)
+	dmls := ms.prepareDMLs()
+	require.Equal(t, &preparedDMLs{
+		startTs: []model.Ts{1, 3},
+		sqls: []string{
+			markSQL,
+			"REPLACE INTO `common_1`.`uk_without_pk`(`a1`) VALUES (?);",
+			"REPLACE INTO `common_1`.`uk_without_pk`(`a1`) VALUES (?);",
+		},
+		values:   [][]interface{}{markArgs, {1}, {2}},
+		rowCount: 2,
+	}, dmls)
+}
+
 func TestAdjustSQLMode(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -90,6 +90,18 @@ func NewMySQLSink(
 	return sink, nil
 }
 
+// SetMaxConnections limits the number of connections to the downstream,
+// n <= 0 means the connections are not limited.
+func (s *sink) SetMaxConnections(n int) {
+	for _, w := range s.workers {
+		if b, ok := w.backend.(interface{ SetMaxConnections(int) }); ok {
+			// All the backends share a connection pool.
+			b.SetMaxConnections(n)
+			return
+		}
+	}
+}
+
 // WriteEvents writes events to the sink.
 func (s *sink) WriteEvents(rows ...*eventsink.TxnCallbackableEvent) error {
 	if atomic.LoadInt32(&s.closed) != 0 {
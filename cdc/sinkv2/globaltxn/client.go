@@ -0,0 +1,179 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globaltxn
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/p2p"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// Client creates table sinks which forward rows to the coordinator.
+// There is one client for a changefeed on each capture.
+type Client struct {
+	changefeedID  model.ChangeFeedID
+	captureID     model.CaptureID
+	messageServer *p2p.MessageServer
+	messageRouter p2p.MessageRouter
+
+	mu struct {
+		sync.Mutex
+		// coordinator is the capture where the coordinator runs,
+		// it's learned from heartbeats.
+		coordinator  model.CaptureID
+		epoch        string
+		checkpointTs model.Ts
+		resolvedTs   model.Ts
+		throttled    bool
+		// sent is set once a message is sent to the coordinator.
+		sent bool
+		err  error
+	}
+	sinkIDGen atomic.Uint64
+}
+
+// NewClient creates a Client.
+func NewClient(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	captureID model.CaptureID,
+	messageServer *p2p.MessageServer,
+	messageRouter p2p.MessageRouter,
+) (*Client, error) {
+	c := &Client{
+		changefeedID:  changefeedID,
+		captureID:     captureID,
+		messageServer: messageServer,
+		messageRouter: messageRouter,
+	}
+	_, err := messageServer.SyncAddHandler(ctx, clientTopic(changefeedID),
+		&heartbeatMessage{}, func(sender string, value interface{}) error {
+			c.handleHeartbeat(value.(*heartbeatMessage))
+			return nil
+		})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return c, nil
+}
+
+// CreateTableSink creates a table sink which starts at startTs.
+func (c *Client) CreateTableSink(
+	tableID model.TableID, startTs model.Ts, totalRowsCounter prometheus.Counter,
+) tablesink.TableSink {
+	sinkID := fmt.Sprintf("%s-%d-%d", c.captureID, tableID, c.sinkIDGen.Inc())
+	return newTableSink(c, tableID, sinkID, startTs, totalRowsCounter)
+}
+
+// Close closes the client.
+func (c *Client) Close(ctx context.Context) error {
+	return errors.Trace(c.messageServer.SyncRemoveHandler(ctx, clientTopic(c.changefeedID)))
+}
+
+func (c *Client) handleHeartbeat(msg *heartbeatMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.err != nil {
+		return
+	}
+	if c.mu.epoch != msg.Epoch {
+		if c.mu.sent {
+			// Rows buffered in the previous coordinator are lost,
+			// the changefeed must be restarted from the checkpoint.
+			c.mu.err = cerror.ErrGlobalTxnCoordinatorChanged.GenWithStackByArgs(
+				c.mu.epoch, msg.Epoch)
+			return
+		}
+		log.Info("global transaction coordinator found",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.String("coordinator", msg.CaptureID),
+			zap.String("epoch", msg.Epoch))
+		c.mu.coordinator = msg.CaptureID
+		c.mu.epoch = msg.Epoch
+		c.mu.checkpointTs = 0
+	}
+	if msg.CheckpointTs > c.mu.checkpointTs {
+		c.mu.checkpointTs = msg.CheckpointTs
+	}
+	c.mu.resolvedTs = msg.ResolvedTs
+	c.mu.throttled = msg.Throttled
+}
+
+// checkpointTs returns the checkpoint ts of the coordinator.
+func (c *Client) checkpointTs() model.Ts {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.mu.checkpointTs
+}
+
+// throttled returns whether a table sink which has sent the rows up to
+// sentResolvedTs should hold back its rows, because the coordinator exceeds
+// its memory quota and the table isn't lagging behind.
+func (c *Client) throttled(sentResolvedTs model.Ts) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.mu.throttled && sentResolvedTs > c.mu.resolvedTs
+}
+
+func (c *Client) getError() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.mu.err
+}
+
+// send sends the message to the coordinator. It returns false if the
+// message can't be sent for now.
+func (c *Client) send(ctx context.Context, msg *tableMessage, blocking bool) (bool, error) {
+	c.mu.Lock()
+	coordinator, err := c.mu.coordinator, c.mu.err
+	c.mu.Unlock()
+	if err != nil {
+		return false, err
+	}
+	if coordinator == "" {
+		return false, nil
+	}
+	client := c.messageRouter.GetClient(coordinator)
+	if client == nil {
+		return false, nil
+	}
+
+	if blocking {
+		_, err = client.SendMessage(ctx, coordinatorTopic(c.changefeedID), msg)
+	} else {
+		_, err = client.TrySendMessage(ctx, coordinatorTopic(c.changefeedID), msg)
+	}
+	if err != nil {
+		if cerror.ErrPeerMessageSendTryAgain.Equal(err) ||
+			cerror.ErrPeerMessageClientClosed.Equal(err) {
+			return false, nil
+		}
+		return false, errors.Trace(err)
+	}
+	c.mu.Lock()
+	c.mu.sent = true
+	c.mu.Unlock()
+	return true, nil
+}
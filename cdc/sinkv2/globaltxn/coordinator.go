@@ -0,0 +1,501 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globaltxn
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/p2p"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const tickInterval = 50 * time.Millisecond
+
+// tableProgress is the progress of a table in the coordinator.
+type tableProgress struct {
+	// sinkID is the current table sink of the table.
+	sinkID  string
+	nextSeq uint64
+	// resolvedTs means all rows of the table whose commitTs are
+	// not greater than it have been received.
+	resolvedTs model.Ts
+	// rows are ordered by commitTs.
+	rows []*model.RowChangedEvent
+}
+
+// truncate removes the rows whose commitTs are greater than ts,
+// and returns the approximate bytes of the removed rows.
+func (t *tableProgress) truncate(ts model.Ts) int {
+	i := sort.Search(len(t.rows), func(i int) bool {
+		return t.rows[i].CommitTs > ts
+	})
+	size := rowsBytes(t.rows[i:])
+	t.rows = t.rows[:i]
+	return size
+}
+
+// txnBatch contains the transactions dispatched in one round.
+type txnBatch struct {
+	resolvedTs model.Ts
+	// size is the approximate bytes of the rows in the batch, which are
+	// held by the backend sink until the batch is finished.
+	size    int
+	pending atomic.Int64
+}
+
+func rowsBytes(rows []*model.RowChangedEvent) int {
+	size := 0
+	for _, row := range rows {
+		size += row.ApproximateBytes()
+	}
+	return size
+}
+
+// Coordinator assembles the rows of all tables in a changefeed into
+// transactions, and writes each transaction to the downstream atomically.
+//
+// It runs along with the owner of the changefeed. Table sinks on all
+// captures forward their rows and resolved ts to it, and it only writes
+// the transactions whose commitTs are not greater than the minimum
+// resolved ts of all tables, so that every transaction is complete.
+//
+// Rows are buffered in memory until all tables are resolved, so the
+// memory used by the coordinator is limited by memoryQuota. Once the quota
+// is exceeded, the coordinator throttles the table sinks through heartbeats:
+// the tables ahead of the resolved ts hold back their rows, and only the
+// lagging tables can send rows, so that the resolved ts can advance and the
+// buffered rows can be written. Rows held back in the table sinks are not
+// acknowledged to processors, so they are blocked by their memory quota.
+type Coordinator struct {
+	changefeedID model.ChangeFeedID
+	captureID    model.CaptureID
+	epoch        string
+
+	messageServer *p2p.MessageServer
+	messageRouter p2p.MessageRouter
+	backendSink   eventsink.EventSink[*model.SingleTableTxn]
+	sinkState     state.TableSinkState
+	sinkErrCh     <-chan error
+	memoryQuota   uint64
+
+	mu struct {
+		sync.Mutex
+		tables map[model.TableID]*tableProgress
+		// requiredTables are all tables in the changefeed, rows can't be
+		// written until all of them are registered.
+		requiredTables map[model.TableID]struct{}
+		captures       []model.CaptureID
+		// initialized is set after the first tick, before which
+		// the required tables are unknown.
+		initialized bool
+		// resolvedTs means all transactions whose commitTs are not greater
+		// than it have been dispatched to the backend sink.
+		resolvedTs model.Ts
+		batches    []*txnBatch
+		// memoryUsage is the approximate bytes of the buffered rows and
+		// the rows which are being written by the backend sink.
+		memoryUsage uint64
+		// throttled is set when memoryUsage exceeds the memory quota.
+		throttled bool
+		err       error
+	}
+	checkpointTs atomic.Uint64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewCoordinator creates a Coordinator and starts it.
+// The coordinator takes the ownership of the backend sink.
+func NewCoordinator(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+	captureID model.CaptureID,
+	startTs model.Ts,
+	messageServer *p2p.MessageServer,
+	messageRouter p2p.MessageRouter,
+	backendSink eventsink.EventSink[*model.SingleTableTxn],
+	sinkErrCh <-chan error,
+	memoryQuota uint64,
+) (*Coordinator, error) {
+	c := &Coordinator{
+		changefeedID:  changefeedID,
+		captureID:     captureID,
+		epoch:         fmt.Sprintf("%s-%d", captureID, time.Now().UnixNano()),
+		messageServer: messageServer,
+		messageRouter: messageRouter,
+		backendSink:   backendSink,
+		sinkState:     state.TableSinkSinking,
+		sinkErrCh:     sinkErrCh,
+		memoryQuota:   memoryQuota,
+	}
+	c.mu.tables = make(map[model.TableID]*tableProgress)
+	c.mu.requiredTables = make(map[model.TableID]struct{})
+	c.mu.resolvedTs = startTs
+	c.checkpointTs.Store(startTs)
+
+	errCh, err := messageServer.SyncAddHandler(ctx, coordinatorTopic(changefeedID),
+		&tableMessage{}, func(sender string, value interface{}) error {
+			c.handleMessage(sender, value.(*tableMessage))
+			return nil
+		})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.run(ctx, errCh)
+	}()
+	log.Info("global transaction coordinator started",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("epoch", c.epoch),
+		zap.Uint64("startTs", startTs),
+		zap.Uint64("memoryQuota", memoryQuota))
+	return c, nil
+}
+
+// Tick updates the tables and captures of the changefeed.
+// It returns the error met by the coordinator if any.
+func (c *Coordinator) Tick(tables []model.TableID, captures []model.CaptureID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mu.requiredTables = make(map[model.TableID]struct{}, len(tables))
+	for _, tableID := range tables {
+		c.mu.requiredTables[tableID] = struct{}{}
+	}
+	c.mu.captures = captures
+	c.mu.initialized = true
+	return c.mu.err
+}
+
+// CheckpointTs returns the ts that all transactions whose commitTs are not
+// greater than it have been written to the downstream.
+func (c *Coordinator) CheckpointTs() model.Ts {
+	return c.checkpointTs.Load()
+}
+
+// Close stops the coordinator and closes the backend sink.
+func (c *Coordinator) Close(ctx context.Context) {
+	c.cancel()
+	c.wg.Wait()
+	if err := c.messageServer.SyncRemoveHandler(ctx, coordinatorTopic(c.changefeedID)); err != nil {
+		log.Warn("failed to remove global transaction handler",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.Error(err))
+	}
+	c.sinkState.Store(state.TableSinkStopping)
+	if err := c.backendSink.Close(); err != nil {
+		log.Warn("failed to close global transaction sink",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.Error(err))
+	}
+	log.Info("global transaction coordinator closed",
+		zap.String("namespace", c.changefeedID.Namespace),
+		zap.String("changefeed", c.changefeedID.ID),
+		zap.String("epoch", c.epoch))
+}
+
+func (c *Coordinator) run(ctx context.Context, handlerErrCh <-chan error) {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-handlerErrCh:
+			c.setError(errors.Trace(err))
+		case err := <-c.sinkErrCh:
+			c.setError(errors.Trace(err))
+		case <-ticker.C:
+			if err := c.dispatch(); err != nil {
+				c.setError(err)
+			}
+			c.broadcastHeartbeat(ctx)
+		}
+	}
+}
+
+func (c *Coordinator) setError(err error) {
+	if err == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.err == nil {
+		log.Error("global transaction coordinator meets error",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.Error(err))
+		c.mu.err = err
+	}
+}
+
+func (c *Coordinator) handleMessage(sender string, msg *tableMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.err != nil {
+		return
+	}
+
+	table := c.mu.tables[msg.TableID]
+	if table == nil || table.sinkID != msg.SinkID {
+		if msg.Seq != 0 {
+			// The table sink has been replaced by a new one.
+			log.Debug("ignore message from a stale table sink",
+				zap.String("namespace", c.changefeedID.Namespace),
+				zap.String("changefeed", c.changefeedID.ID),
+				zap.String("sender", sender),
+				zap.Int64("tableID", msg.TableID),
+				zap.String("sinkID", msg.SinkID))
+			return
+		}
+		if table == nil {
+			table = &tableProgress{resolvedTs: msg.StartTs}
+			c.mu.tables[msg.TableID] = table
+		} else {
+			// Rows of the previous table sink which are not resolved may belong
+			// to partial transactions, they will be sent again by the new one.
+			c.releaseMemory(table.truncate(table.resolvedTs))
+			if msg.StartTs > table.resolvedTs {
+				table.resolvedTs = msg.StartTs
+			}
+		}
+		table.sinkID = msg.SinkID
+		table.nextSeq = 0
+		log.Info("table sink registered to global transaction coordinator",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.String("sender", sender),
+			zap.Int64("tableID", msg.TableID),
+			zap.String("sinkID", msg.SinkID),
+			zap.Uint64("startTs", msg.StartTs),
+			zap.Uint64("resolvedTs", table.resolvedTs))
+	}
+
+	if msg.Seq != table.nextSeq {
+		c.mu.err = cerror.ErrGlobalTxnUnexpectedMessage.GenWithStackByArgs(
+			fmt.Sprintf("table %d sink %s expects seq %d but got %d",
+				msg.TableID, msg.SinkID, table.nextSeq, msg.Seq))
+		return
+	}
+	table.nextSeq++
+
+	// Transactions which are not greater than the resolved ts have been
+	// received, or even written. Rows of them are sent again when the
+	// table sink is recreated, so just drop them.
+	lowerBound := table.resolvedTs
+	if c.mu.resolvedTs > lowerBound {
+		lowerBound = c.mu.resolvedTs
+	}
+	size := 0
+	for _, row := range msg.Rows {
+		if row.CommitTs <= lowerBound {
+			continue
+		}
+		table.rows = append(table.rows, row)
+		size += row.ApproximateBytes()
+	}
+	c.mu.memoryUsage += uint64(size)
+	if msg.ResolvedTs > table.resolvedTs {
+		table.resolvedTs = msg.ResolvedTs
+	}
+	if msg.Closed {
+		// The table sink won't send the remaining rows of partial transactions.
+		c.releaseMemory(table.truncate(table.resolvedTs))
+	}
+	c.updateThrottled()
+}
+
+// updateThrottled throttles the table sinks if the memory quota is exceeded,
+// and stops throttling once enough memory is released.
+func (c *Coordinator) updateThrottled() {
+	throttled := c.mu.memoryUsage > c.memoryQuota
+	if throttled == c.mu.throttled {
+		return
+	}
+	c.mu.throttled = throttled
+	if throttled {
+		log.Warn("global transaction coordinator exceeds the memory quota, "+
+			"throttle the tables ahead of the resolved ts",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.Uint64("memoryUsage", c.mu.memoryUsage),
+			zap.Uint64("memoryQuota", c.memoryQuota),
+			zap.Uint64("resolvedTs", c.mu.resolvedTs))
+	} else {
+		log.Info("global transaction coordinator stops throttling",
+			zap.String("namespace", c.changefeedID.Namespace),
+			zap.String("changefeed", c.changefeedID.ID),
+			zap.Uint64("memoryUsage", c.mu.memoryUsage),
+			zap.Uint64("resolvedTs", c.mu.resolvedTs))
+	}
+}
+
+// releaseMemory releases the memory of rows which are dropped or written.
+func (c *Coordinator) releaseMemory(size int) {
+	if uint64(size) > c.mu.memoryUsage {
+		c.mu.memoryUsage = 0
+		return
+	}
+	c.mu.memoryUsage -= uint64(size)
+}
+
+// dispatch writes the transactions which are resolved by all tables
+// to the backend sink.
+func (c *Coordinator) dispatch() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.err != nil {
+		return nil
+	}
+
+	// Advance the checkpoint ts with the finished batches.
+	for len(c.mu.batches) > 0 && c.mu.batches[0].pending.Load() == 0 {
+		c.checkpointTs.Store(c.mu.batches[0].resolvedTs)
+		c.releaseMemory(c.mu.batches[0].size)
+		c.mu.batches = c.mu.batches[1:]
+	}
+	c.updateThrottled()
+
+	resolvedTs, ok := c.minResolvedTs()
+	if !ok || resolvedTs <= c.mu.resolvedTs {
+		return nil
+	}
+
+	var rows []*model.RowChangedEvent
+	for tableID, table := range c.mu.tables {
+		i := sort.Search(len(table.rows), func(i int) bool {
+			return table.rows[i].CommitTs > resolvedTs
+		})
+		rows = append(rows, table.rows[:i]...)
+		table.rows = append([]*model.RowChangedEvent(nil), table.rows[i:]...)
+		if _, ok := c.mu.requiredTables[tableID]; !ok && len(table.rows) == 0 {
+			delete(c.mu.tables, tableID)
+		}
+	}
+	// Rows of the same table are kept in order.
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].CommitTs != rows[j].CommitTs {
+			return rows[i].CommitTs < rows[j].CommitTs
+		}
+		if rows[i].StartTs != rows[j].StartTs {
+			return rows[i].StartTs < rows[j].StartTs
+		}
+		return rows[i].Table.TableID < rows[j].Table.TableID
+	})
+
+	var txns []*model.SingleTableTxn
+	for _, row := range rows {
+		if len(txns) == 0 ||
+			txns[len(txns)-1].CommitTs != row.CommitTs ||
+			txns[len(txns)-1].StartTs != row.StartTs {
+			txns = append(txns, &model.SingleTableTxn{
+				Table:    row.Table,
+				StartTs:  row.StartTs,
+				CommitTs: row.CommitTs,
+			})
+		}
+		// Rows of a global transaction can belong to different tables,
+		// so don't use SingleTableTxn.Append here.
+		txn := txns[len(txns)-1]
+		txn.Rows = append(txn.Rows, row)
+	}
+
+	batch := &txnBatch{resolvedTs: resolvedTs, size: rowsBytes(rows)}
+	batch.pending.Store(int64(len(txns)))
+	events := make([]*eventsink.TxnCallbackableEvent, 0, len(txns))
+	for _, txn := range txns {
+		events = append(events, &eventsink.TxnCallbackableEvent{
+			Event:     txn,
+			Callback:  func() { batch.pending.Dec() },
+			SinkState: &c.sinkState,
+		})
+	}
+	c.mu.batches = append(c.mu.batches, batch)
+	c.mu.resolvedTs = resolvedTs
+	if len(events) == 0 {
+		return nil
+	}
+	return errors.Trace(c.backendSink.WriteEvents(events...))
+}
+
+// minResolvedTs returns the minimum resolved ts of all required tables.
+// It returns false if some required table hasn't been registered yet.
+//
+// Tables are only scheduled after the owner knows them, so tables which
+// are not required must have been dropped, and they don't produce
+// rows anymore.
+func (c *Coordinator) minResolvedTs() (model.Ts, bool) {
+	if !c.mu.initialized || len(c.mu.requiredTables) == 0 {
+		return 0, false
+	}
+	resolvedTs := model.Ts(math.MaxUint64)
+	for tableID := range c.mu.requiredTables {
+		table, ok := c.mu.tables[tableID]
+		if !ok {
+			return 0, false
+		}
+		if table.resolvedTs < resolvedTs {
+			resolvedTs = table.resolvedTs
+		}
+	}
+	return resolvedTs, true
+}
+
+func (c *Coordinator) broadcastHeartbeat(ctx context.Context) {
+	c.mu.Lock()
+	captures := c.mu.captures
+	msg := &heartbeatMessage{
+		CaptureID:    c.captureID,
+		Epoch:        c.epoch,
+		CheckpointTs: c.CheckpointTs(),
+		ResolvedTs:   c.mu.resolvedTs,
+		Throttled:    c.mu.throttled,
+	}
+	c.mu.Unlock()
+
+	for _, captureID := range captures {
+		client := c.messageRouter.GetClient(captureID)
+		if client == nil {
+			continue
+		}
+		_, err := client.TrySendMessage(ctx, clientTopic(c.changefeedID), msg)
+		if err != nil && !cerror.ErrPeerMessageSendTryAgain.Equal(err) &&
+			!cerror.ErrPeerMessageClientClosed.Equal(err) {
+			log.Warn("failed to send global transaction heartbeat",
+				zap.String("namespace", c.changefeedID.Namespace),
+				zap.String("changefeed", c.changefeedID.ID),
+				zap.String("capture", captureID),
+				zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globaltxn
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/p2p"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+// Assert EventSink implementation
+var _ eventsink.EventSink[*model.SingleTableTxn] = (*mockEventSink)(nil)
+
+type mockEventSink struct {
+	mu     sync.Mutex
+	events []*eventsink.TxnCallbackableEvent
+}
+
+func (m *mockEventSink) WriteEvents(rows ...*eventsink.TxnCallbackableEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, rows...)
+	return nil
+}
+
+func (m *mockEventSink) Close() error {
+	// Do nothing.
+	return nil
+}
+
+// acknowledge all received events by calling the callback functions.
+func (m *mockEventSink) acknowledge() []*model.SingleTableTxn {
+	m.mu.Lock()
+	events := m.events
+	m.events = nil
+	m.mu.Unlock()
+
+	txns := make([]*model.SingleTableTxn, 0, len(events))
+	for _, event := range events {
+		event.Callback()
+		txns = append(txns, event.Event)
+	}
+	return txns
+}
+
+func (m *mockEventSink) len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.events)
+}
+
+func newTestRow(tableID model.TableID, table string, commitTs model.Ts, value int64) *model.RowChangedEvent {
+	return &model.RowChangedEvent{
+		StartTs:  commitTs - 1,
+		CommitTs: commitTs,
+		Table: &model.TableName{
+			Schema:  "test",
+			Table:   table,
+			TableID: tableID,
+		},
+		Columns: []*model.Column{
+			{Name: "a", Type: 3, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: value},
+		},
+	}
+}
+
+func newTestCoordinator(backendSink *mockEventSink, startTs model.Ts) *Coordinator {
+	c := &Coordinator{
+		changefeedID: model.DefaultChangeFeedID("test"),
+		captureID:    "capture-0",
+		backendSink:  backendSink,
+		sinkState:    state.TableSinkSinking,
+		memoryQuota:  config.DefaultGlobalTxnMemoryQuota,
+	}
+	c.mu.tables = make(map[model.TableID]*tableProgress)
+	c.mu.requiredTables = make(map[model.TableID]struct{})
+	c.mu.resolvedTs = startTs
+	c.checkpointTs.Store(startTs)
+	return c
+}
+
+func TestCoordinatorGroupTxnsAcrossTables(t *testing.T) {
+	t.Parallel()
+
+	backendSink := &mockEventSink{}
+	c := newTestCoordinator(backendSink, 100)
+	require.NoError(t, c.Tick([]model.TableID{1, 2}, nil))
+
+	c.handleMessage("capture-0", &tableMessage{
+		TableID: 1, SinkID: "s1", StartTs: 100, ResolvedTs: 110,
+		Rows: []*model.RowChangedEvent{
+			newTestRow(1, "t1", 105, 1),
+			newTestRow(1, "t1", 107, 2),
+		},
+	})
+	// Table 2 isn't registered yet.
+	require.NoError(t, c.dispatch())
+	require.Equal(t, 0, backendSink.len())
+
+	c.handleMessage("capture-1", &tableMessage{
+		TableID: 2, SinkID: "s2", StartTs: 100, ResolvedTs: 108,
+		Rows: []*model.RowChangedEvent{
+			newTestRow(2, "t2", 105, 3),
+			newTestRow(2, "t2", 108, 4),
+		},
+	})
+	c.handleMessage("capture-1", &tableMessage{
+		TableID: 2, SinkID: "s2", Seq: 1,
+		Rows: []*model.RowChangedEvent{newTestRow(2, "t2", 109, 5)},
+	})
+	require.NoError(t, c.dispatch())
+	require.Equal(t, model.Ts(100), c.CheckpointTs())
+	txns := backendSink.acknowledge()
+	require.Len(t, txns, 3)
+	// Rows of the same commitTs are grouped into one transaction
+	// even if they belong to different tables.
+	require.Equal(t, model.Ts(105), txns[0].CommitTs)
+	require.Len(t, txns[0].Rows, 2)
+	require.Equal(t, "t1", txns[0].Rows[0].Table.Table)
+	require.Equal(t, "t2", txns[0].Rows[1].Table.Table)
+	require.Equal(t, model.Ts(107), txns[1].CommitTs)
+	require.Equal(t, model.Ts(108), txns[2].CommitTs)
+
+	require.NoError(t, c.dispatch())
+	require.Equal(t, model.Ts(108), c.CheckpointTs())
+
+	// Table 2 is dropped, it doesn't block table 1 anymore.
+	require.NoError(t, c.Tick([]model.TableID{1}, nil))
+	require.NoError(t, c.dispatch())
+	txns = backendSink.acknowledge()
+	require.Len(t, txns, 1)
+	require.Equal(t, model.Ts(109), txns[0].CommitTs)
+	require.NoError(t, c.dispatch())
+	require.Equal(t, model.Ts(110), c.CheckpointTs())
+}
+
+func TestCoordinatorTableSinkReplaced(t *testing.T) {
+	t.Parallel()
+
+	backendSink := &mockEventSink{}
+	c := newTestCoordinator(backendSink, 100)
+	require.NoError(t, c.Tick([]model.TableID{1}, nil))
+
+	c.handleMessage("capture-0", &tableMessage{
+		TableID: 1, SinkID: "s1", StartTs: 100, ResolvedTs: 104,
+		Rows: []*model.RowChangedEvent{newTestRow(1, "t1", 103, 1)},
+	})
+	// Rows of a partial transaction.
+	c.handleMessage("capture-0", &tableMessage{
+		TableID: 1, SinkID: "s1", Seq: 1,
+		Rows: []*model.RowChangedEvent{newTestRow(1, "t1", 106, 2)},
+	})
+
+	// The table is moved to another capture and starts at 102.
+	c.handleMessage("capture-1", &tableMessage{
+		TableID: 1, SinkID: "s2", StartTs: 102,
+		Rows: []*model.RowChangedEvent{
+			// It has been received from the previous table sink.
+			newTestRow(1, "t1", 103, 1),
+			newTestRow(1, "t1", 106, 2),
+			newTestRow(1, "t1", 106, 3),
+		},
+		ResolvedTs: 106,
+	})
+	// Messages from the stale table sink are ignored.
+	c.handleMessage("capture-0", &tableMessage{
+		TableID: 1, SinkID: "s1", Seq: 2,
+		Rows:       []*model.RowChangedEvent{newTestRow(1, "t1", 107, 4)},
+		ResolvedTs: 108,
+	})
+	require.NoError(t, c.dispatch())
+	txns := backendSink.acknowledge()
+	require.Len(t, txns, 2)
+	require.Equal(t, model.Ts(103), txns[0].CommitTs)
+	require.Len(t, txns[0].Rows, 1)
+	require.Equal(t, model.Ts(106), txns[1].CommitTs)
+	require.Len(t, txns[1].Rows, 2)
+	require.NoError(t, c.dispatch())
+	require.Equal(t, model.Ts(106), c.CheckpointTs())
+
+	// A message is lost.
+	c.handleMessage("capture-1", &tableMessage{
+		TableID: 1, SinkID: "s2", Seq: 2, ResolvedTs: 110,
+	})
+	err := c.Tick([]model.TableID{1}, nil)
+	require.True(t, cerror.ErrGlobalTxnUnexpectedMessage.Equal(err))
+}
+
+func TestCoordinatorMemoryQuota(t *testing.T) {
+	t.Parallel()
+
+	backendSink := &mockEventSink{}
+	c := newTestCoordinator(backendSink, 100)
+	row := newTestRow(1, "t1", 105, 1)
+	c.memoryQuota = uint64(row.ApproximateBytes() * 3)
+	require.NoError(t, c.Tick([]model.TableID{1, 2}, nil))
+
+	c.handleMessage("capture-0", &tableMessage{
+		TableID: 1, SinkID: "s1", StartTs: 100, ResolvedTs: 110,
+		Rows: []*model.RowChangedEvent{row, newTestRow(1, "t1", 107, 2)},
+	})
+	c.handleMessage("capture-1", &tableMessage{
+		TableID: 2, SinkID: "s2", StartTs: 100, ResolvedTs: 106,
+	})
+	require.NoError(t, c.dispatch())
+	require.Equal(t, uint64(row.ApproximateBytes()*2), c.mu.memoryUsage)
+
+	// Memory of written rows is released when the batch is finished.
+	backendSink.acknowledge()
+	require.NoError(t, c.dispatch())
+	require.Equal(t, model.Ts(106), c.CheckpointTs())
+	require.Equal(t, uint64(row.ApproximateBytes()), c.mu.memoryUsage)
+
+	// Table 2 lags behind, so rows of table 1 are accumulated,
+	// and the tables ahead of the resolved ts are throttled.
+	c.handleMessage("capture-0", &tableMessage{
+		TableID: 1, SinkID: "s1", Seq: 1, ResolvedTs: 120,
+		Rows: []*model.RowChangedEvent{
+			newTestRow(1, "t1", 111, 3),
+			newTestRow(1, "t1", 112, 4),
+			newTestRow(1, "t1", 113, 5),
+		},
+	})
+	require.NoError(t, c.Tick([]model.TableID{1, 2}, nil))
+	require.True(t, c.mu.throttled)
+	require.Equal(t, model.Ts(106), c.mu.resolvedTs)
+
+	// The lagging table catches up, then the rows are written
+	// and the throttling stops once the memory is released.
+	c.handleMessage("capture-1", &tableMessage{
+		TableID: 2, SinkID: "s2", Seq: 1, ResolvedTs: 115,
+		Rows: []*model.RowChangedEvent{newTestRow(2, "t2", 112, 6)},
+	})
+	require.NoError(t, c.dispatch())
+	require.True(t, c.mu.throttled)
+	require.Len(t, backendSink.acknowledge(), 4)
+	require.NoError(t, c.dispatch())
+	require.False(t, c.mu.throttled)
+	require.Equal(t, model.Ts(115), c.CheckpointTs())
+	require.Zero(t, c.mu.memoryUsage)
+	require.NoError(t, c.Tick([]model.TableID{1, 2}, nil))
+}
+
+func TestGlobalTxnAcrossCaptures(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster := p2p.NewMockCluster(t, 2)
+	defer cluster.Close()
+	node0, node1 := cluster.Nodes["capture-0"], cluster.Nodes["capture-1"]
+	changefeedID := model.DefaultChangeFeedID("test")
+
+	backendSink := &mockEventSink{}
+	coordinator, err := NewCoordinator(ctx, changefeedID, node0.ID, 100,
+		node0.Server, node0.Router, backendSink, make(chan error),
+		config.DefaultGlobalTxnMemoryQuota)
+	require.NoError(t, err)
+	defer coordinator.Close(ctx)
+	require.NoError(t, coordinator.Tick(
+		[]model.TableID{1, 2}, []model.CaptureID{node0.ID, node1.ID}))
+
+	client0, err := NewClient(ctx, changefeedID, node0.ID, node0.Server, node0.Router)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, client0.Close(ctx)) }()
+	client1, err := NewClient(ctx, changefeedID, node1.ID, node1.Server, node1.Router)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, client1.Close(ctx)) }()
+
+	sink1 := client0.CreateTableSink(1, 100, prometheus.NewCounter(prometheus.CounterOpts{}))
+	sink2 := client1.CreateTableSink(2, 100, prometheus.NewCounter(prometheus.CounterOpts{}))
+	sink1.AppendRowChangedEvents(newTestRow(1, "t1", 105, 1))
+	sink2.AppendRowChangedEvents(newTestRow(2, "t2", 105, 2))
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, sink1.UpdateResolvedTs(model.NewResolvedTs(110)))
+		require.NoError(t, sink2.UpdateResolvedTs(model.NewResolvedTs(110)))
+		return backendSink.len() > 0
+	}, 10*time.Second, 50*time.Millisecond)
+
+	txns := backendSink.acknowledge()
+	require.Len(t, txns, 1)
+	require.Len(t, txns[0].Rows, 2)
+	require.Eventually(t, func() bool {
+		return sink1.GetCheckpointTs().Ts == 110 && sink2.GetCheckpointTs().Ts == 110
+	}, 10*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, sink1.Close(ctx))
+	require.NoError(t, sink2.Close(ctx))
+}
+
+func TestGlobalTxnThrottleWhenTableLags(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster := p2p.NewMockCluster(t, 2)
+	defer cluster.Close()
+	node0, node1 := cluster.Nodes["capture-0"], cluster.Nodes["capture-1"]
+	changefeedID := model.DefaultChangeFeedID("test")
+
+	backendSink := &mockEventSink{}
+	row := newTestRow(1, "t1", 105, 1)
+	coordinator, err := NewCoordinator(ctx, changefeedID, node0.ID, 100,
+		node0.Server, node0.Router, backendSink, make(chan error),
+		uint64(row.ApproximateBytes()*4))
+	require.NoError(t, err)
+	defer coordinator.Close(ctx)
+	require.NoError(t, coordinator.Tick(
+		[]model.TableID{1, 2}, []model.CaptureID{node0.ID, node1.ID}))
+
+	client0, err := NewClient(ctx, changefeedID, node0.ID, node0.Server, node0.Router)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, client0.Close(ctx)) }()
+	client1, err := NewClient(ctx, changefeedID, node1.ID, node1.Server, node1.Router)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, client1.Close(ctx)) }()
+
+	sink1 := client0.CreateTableSink(1, 100, prometheus.NewCounter(prometheus.CounterOpts{}))
+	sink2 := client1.CreateTableSink(2, 100, prometheus.NewCounter(prometheus.CounterOpts{}))
+	memoryUsage := func() uint64 {
+		coordinator.mu.Lock()
+		defer coordinator.mu.Unlock()
+		return coordinator.mu.memoryUsage
+	}
+
+	// Table 2 lags at 101, while table 1 keeps producing rows.
+	ts := model.Ts(101)
+	require.Eventually(t, func() bool {
+		require.NoError(t, sink2.UpdateResolvedTs(model.NewResolvedTs(101)))
+		ts++
+		sink1.AppendRowChangedEvents(newTestRow(1, "t1", ts, int64(ts)))
+		require.NoError(t, sink1.UpdateResolvedTs(model.NewResolvedTs(ts)))
+		return client0.throttled(ts) && len(sink1.(*tableSink).pendingMessages) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Rows are held back in the table sink instead of the coordinator,
+	// and they are not acknowledged to the processor.
+	time.Sleep(tickInterval)
+	usage := memoryUsage()
+	require.Greater(t, usage, uint64(row.ApproximateBytes()*4))
+	for i := 0; i < 10; i++ {
+		ts++
+		sink1.AppendRowChangedEvents(newTestRow(1, "t1", ts, int64(ts)))
+		require.NoError(t, sink1.UpdateResolvedTs(model.NewResolvedTs(ts)))
+		time.Sleep(tickInterval / 5)
+	}
+	require.Equal(t, usage, memoryUsage())
+	require.Equal(t, model.Ts(101), sink1.GetCheckpointTs().Ts)
+	require.NoError(t, coordinator.Tick(
+		[]model.TableID{1, 2}, []model.CaptureID{node0.ID, node1.ID}))
+
+	// Table 2 catches up, then all rows of table 1 are written.
+	written := 0
+	require.Eventually(t, func() bool {
+		require.NoError(t, sink1.UpdateResolvedTs(model.NewResolvedTs(ts)))
+		require.NoError(t, sink2.UpdateResolvedTs(model.NewResolvedTs(ts)))
+		written += len(backendSink.acknowledge())
+		return sink1.GetCheckpointTs().Ts == ts && sink2.GetCheckpointTs().Ts == ts
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Equal(t, int(ts-101), written)
+	require.Zero(t, memoryUsage())
+
+	require.NoError(t, sink1.Close(ctx))
+	require.NoError(t, sink2.Close(ctx))
+}
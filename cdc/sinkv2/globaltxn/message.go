@@ -0,0 +1,140 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globaltxn
+
+import (
+	"fmt"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/redo"
+	"github.com/pingcap/tiflow/pkg/p2p"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// coordinatorTopic returns the topic on which the coordinator receives
+// rows and resolved ts from the table sinks.
+func coordinatorTopic(changefeed model.ChangeFeedID) p2p.Topic {
+	return fmt.Sprintf("changefeed/%s/%s/global-txn/coordinator",
+		changefeed.Namespace, changefeed.ID)
+}
+
+// clientTopic returns the topic on which the clients receive heartbeats
+// from the coordinator.
+func clientTopic(changefeed model.ChangeFeedID) p2p.Topic {
+	return fmt.Sprintf("changefeed/%s/%s/global-txn/client",
+		changefeed.Namespace, changefeed.ID)
+}
+
+// tableMessage is sent from a table sink to the coordinator.
+// Messages of a table sink are numbered by Seq, and they must
+// be handled in order.
+type tableMessage struct {
+	TableID model.TableID
+	// SinkID identifies a table sink. A new table sink is created
+	// when a table is moved to another capture or restarted.
+	SinkID string
+	Seq    uint64
+	// StartTs is the start ts of the table sink, it's only meaningful
+	// in the first message of a table sink.
+	StartTs model.Ts
+	Rows    []*model.RowChangedEvent
+	// ResolvedTs indicates that all rows of the table whose commitTs are
+	// not greater than it have been sent. Zero means no progress.
+	ResolvedTs model.Ts
+	// Closed is set in the last message of a table sink.
+	Closed bool
+}
+
+// Marshal implements p2p.Serializable.
+// Rows are encoded in the same way as the redo log.
+func (m *tableMessage) Marshal() ([]byte, error) {
+	b := msgp.AppendInt64(nil, m.TableID)
+	b = msgp.AppendString(b, m.SinkID)
+	b = msgp.AppendUint64(b, m.Seq)
+	b = msgp.AppendUint64(b, m.StartTs)
+	b = msgp.AppendUint64(b, m.ResolvedTs)
+	b = msgp.AppendBool(b, m.Closed)
+	b = msgp.AppendArrayHeader(b, uint32(len(m.Rows)))
+	for _, row := range m.Rows {
+		// ReplicatingTs is not a part of the redo log.
+		b = msgp.AppendUint64(b, row.ReplicatingTs)
+		var err error
+		b, err = redo.RowToRedo(row).MarshalMsg(b)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return b, nil
+}
+
+// Unmarshal implements p2p.Serializable.
+func (m *tableMessage) Unmarshal(data []byte) (err error) {
+	if m.TableID, data, err = msgp.ReadInt64Bytes(data); err != nil {
+		return errors.Trace(err)
+	}
+	if m.SinkID, data, err = msgp.ReadStringBytes(data); err != nil {
+		return errors.Trace(err)
+	}
+	if m.Seq, data, err = msgp.ReadUint64Bytes(data); err != nil {
+		return errors.Trace(err)
+	}
+	if m.StartTs, data, err = msgp.ReadUint64Bytes(data); err != nil {
+		return errors.Trace(err)
+	}
+	if m.ResolvedTs, data, err = msgp.ReadUint64Bytes(data); err != nil {
+		return errors.Trace(err)
+	}
+	if m.Closed, data, err = msgp.ReadBoolBytes(data); err != nil {
+		return errors.Trace(err)
+	}
+	var n uint32
+	if n, data, err = msgp.ReadArrayHeaderBytes(data); err != nil {
+		return errors.Trace(err)
+	}
+	m.Rows = make([]*model.RowChangedEvent, 0, n)
+	for i := uint32(0); i < n; i++ {
+		var replicatingTs uint64
+		if replicatingTs, data, err = msgp.ReadUint64Bytes(data); err != nil {
+			return errors.Trace(err)
+		}
+		redoRow := &model.RedoRowChangedEvent{}
+		if data, err = redoRow.UnmarshalMsg(data); err != nil {
+			return errors.Trace(err)
+		}
+		row := redo.LogToRow(redoRow)
+		row.ReplicatingTs = replicatingTs
+		m.Rows = append(m.Rows, row)
+	}
+	return nil
+}
+
+// heartbeatMessage is broadcast by the coordinator to all captures.
+type heartbeatMessage struct {
+	// CaptureID is the capture where the coordinator runs.
+	CaptureID model.CaptureID `json:"capture-id"`
+	// Epoch identifies the coordinator. It changes when the owner
+	// is re-elected or the changefeed is restarted.
+	Epoch string `json:"epoch"`
+	// CheckpointTs means all transactions whose commitTs are not
+	// greater than it have been written to the downstream.
+	CheckpointTs model.Ts `json:"checkpoint-ts"`
+	// ResolvedTs means all transactions whose commitTs are not greater
+	// than it have been dispatched to the downstream.
+	ResolvedTs model.Ts `json:"resolved-ts"`
+	// Throttled is set when the coordinator exceeds its memory quota, then
+	// the table sinks ahead of ResolvedTs hold back their rows until it's
+	// unset, and only the lagging tables can send rows to advance ResolvedTs.
+	Throttled bool `json:"throttled"`
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globaltxn
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableMessageMarshal(t *testing.T) {
+	t.Parallel()
+
+	msg := &tableMessage{
+		TableID:    1,
+		SinkID:     "capture-1-1-0",
+		Seq:        2,
+		StartTs:    100,
+		ResolvedTs: 110,
+		Rows: []*model.RowChangedEvent{
+			newTestRow(1, "t1", 105, 1),
+			newTestRow(1, "t1", 105, 2),
+		},
+	}
+	msg.Rows[0].ReplicatingTs = 99
+	data, err := msg.Marshal()
+	require.NoError(t, err)
+
+	decoded := &tableMessage{}
+	require.NoError(t, decoded.Unmarshal(data))
+	require.Equal(t, msg.TableID, decoded.TableID)
+	require.Equal(t, msg.SinkID, decoded.SinkID)
+	require.Equal(t, msg.Seq, decoded.Seq)
+	require.Equal(t, msg.StartTs, decoded.StartTs)
+	require.Equal(t, msg.ResolvedTs, decoded.ResolvedTs)
+	require.False(t, decoded.Closed)
+	require.Len(t, decoded.Rows, 2)
+	require.Equal(t, uint64(99), decoded.Rows[0].ReplicatingTs)
+	for i, row := range decoded.Rows {
+		require.Equal(t, msg.Rows[i].Table, row.Table)
+		require.Equal(t, msg.Rows[i].CommitTs, row.CommitTs)
+		require.Equal(t, msg.Rows[i].Columns[0].Value, row.Columns[0].Value)
+	}
+
+	closed := &tableMessage{TableID: 1, SinkID: "capture-1-1-0", Seq: 3, Closed: true}
+	data, err = closed.Marshal()
+	require.NoError(t, err)
+	decoded = &tableMessage{}
+	require.NoError(t, decoded.Unmarshal(data))
+	require.True(t, decoded.Closed)
+	require.Empty(t, decoded.Rows)
+}
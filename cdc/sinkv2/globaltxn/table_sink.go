@@ -0,0 +1,179 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package globaltxn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// maxRowsPerMessage limits the size of a message sent to the coordinator.
+const maxRowsPerMessage = 256
+
+// Assert TableSink implementation
+var _ tablesink.TableSink = (*tableSink)(nil)
+
+// tableSink forwards rows and resolved ts of a table to the coordinator.
+type tableSink struct {
+	client  *Client
+	tableID model.TableID
+	sinkID  string
+	startTs model.Ts
+
+	nextSeq       uint64
+	maxResolvedTs model.ResolvedTs
+	// NOTICE: It is ordered by commitTs.
+	rowBuffer []*model.RowChangedEvent
+	// pendingMessages are the messages which haven't been sent yet.
+	pendingMessages []*tableMessage
+	// sentResolvedTs is the resolved ts of the latest sent message.
+	sentResolvedTs atomic.Uint64
+	state          state.TableSinkState
+
+	// For dataflow metrics.
+	metricsTableSinkTotalRows prometheus.Counter
+}
+
+func newTableSink(
+	client *Client,
+	tableID model.TableID,
+	sinkID string,
+	startTs model.Ts,
+	totalRowsCounter prometheus.Counter,
+) *tableSink {
+	s := &tableSink{
+		client:  client,
+		tableID: tableID,
+		sinkID:  sinkID,
+		startTs: startTs,
+		// The first resolved ts is always sent to register the table sink.
+		maxResolvedTs:             model.NewResolvedTs(0),
+		state:                     state.TableSinkSinking,
+		metricsTableSinkTotalRows: totalRowsCounter,
+	}
+	s.sentResolvedTs.Store(startTs)
+	return s
+}
+
+func (s *tableSink) AppendRowChangedEvents(rows ...*model.RowChangedEvent) {
+	s.rowBuffer = append(s.rowBuffer, rows...)
+	s.metricsTableSinkTotalRows.Add(float64(len(rows)))
+}
+
+func (s *tableSink) UpdateResolvedTs(resolvedTs model.ResolvedTs) error {
+	if s.maxResolvedTs.Less(resolvedTs) {
+		s.maxResolvedTs = resolvedTs
+		i := 0
+		for i < len(s.rowBuffer) && s.rowBuffer[i].CommitTs <= resolvedTs.Ts {
+			i++
+		}
+		resolvedRows := s.rowBuffer[:i]
+		s.rowBuffer = append(make([]*model.RowChangedEvent, 0, len(s.rowBuffer[i:])), s.rowBuffer[i:]...)
+		for len(resolvedRows) > maxRowsPerMessage {
+			s.appendMessage(resolvedRows[:maxRowsPerMessage], 0, false)
+			resolvedRows = resolvedRows[maxRowsPerMessage:]
+		}
+		s.appendMessage(resolvedRows, resolvedTs.Ts, false)
+	}
+	return s.sendPendingMessages(context.Background(), false)
+}
+
+func (s *tableSink) appendMessage(rows []*model.RowChangedEvent, resolvedTs model.Ts, closed bool) {
+	s.pendingMessages = append(s.pendingMessages, &tableMessage{
+		TableID:    s.tableID,
+		SinkID:     s.sinkID,
+		Seq:        s.nextSeq,
+		StartTs:    s.startTs,
+		Rows:       rows,
+		ResolvedTs: resolvedTs,
+		Closed:     closed,
+	})
+	s.nextSeq++
+}
+
+// sendPendingMessages sends the pending messages in order. The rows are held
+// back if the coordinator throttles the table sink, and they are still
+// accounted against the memory quota of the processor, since the checkpoint
+// ts of the table sink doesn't pass them. The messages are always sent when
+// blocking, i.e. the table sink is being closed.
+func (s *tableSink) sendPendingMessages(ctx context.Context, blocking bool) error {
+	for len(s.pendingMessages) > 0 {
+		msg := s.pendingMessages[0]
+		// The first message registers the table sink, it's required
+		// to advance the resolved ts of the coordinator.
+		if !blocking && len(msg.Rows) > 0 && msg.Seq != 0 &&
+			s.client.throttled(s.sentResolvedTs.Load()) {
+			return nil
+		}
+		ok, err := s.client.send(ctx, msg, blocking)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if msg.ResolvedTs > s.sentResolvedTs.Load() {
+			s.sentResolvedTs.Store(msg.ResolvedTs)
+		}
+		s.pendingMessages[0] = nil
+		s.pendingMessages = s.pendingMessages[1:]
+	}
+	return nil
+}
+
+// GetCheckpointTs returns the checkpoint ts of the table sink. Rows are
+// written to the downstream when the coordinator checkpoint ts passes
+// their commitTs, and the table sink has sent them.
+func (s *tableSink) GetCheckpointTs() model.ResolvedTs {
+	checkpointTs := s.client.checkpointTs()
+	if sent := s.sentResolvedTs.Load(); sent < checkpointTs {
+		checkpointTs = sent
+	}
+	if checkpointTs < s.startTs {
+		checkpointTs = s.startTs
+	}
+	return model.NewResolvedTs(checkpointTs)
+}
+
+// Close the table sink. It tries to notify the coordinator that
+// the table sink is closed.
+func (s *tableSink) Close(ctx context.Context) error {
+	currentState := s.state.Load()
+	if currentState == state.TableSinkStopping ||
+		currentState == state.TableSinkStopped {
+		log.Warn(fmt.Sprintf("Table sink is already %s", currentState.String()),
+			zap.Int64("tableID", s.tableID))
+		return nil
+	}
+	s.state.Store(state.TableSinkStopping)
+	s.appendMessage(nil, 0, true)
+	if err := s.sendPendingMessages(ctx, true); err != nil {
+		log.Warn("Failed to notify the coordinator that the table sink is closed",
+			zap.Int64("tableID", s.tableID),
+			zap.String("sinkID", s.sinkID),
+			zap.Error(err))
+	}
+	s.state.Store(state.TableSinkStopped)
+	log.Info("Table sink stopped", zap.Int64("tableID", s.tableID),
+		zap.String("sinkID", s.sinkID))
+	return nil
+}
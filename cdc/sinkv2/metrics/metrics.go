@@ -77,6 +77,27 @@ var (
 		}, []string{"namespace", "changefeed"})
 )
 
+// ---------- Metrics for the throughput throttle of table sinks. ---------- //
+var (
+	// ThrottleLimitGauge records the throttle limits in effect, 0 means unlimited.
+	ThrottleLimitGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "sinkv2",
+			Name:      "throttle_limit",
+			Help:      "The throttle limits in effect of a changefeed, 0 means unlimited.",
+		}, []string{"namespace", "changefeed", "type"}) // type is rows, bytes or connections
+
+	// ThrottledFlushCounter is the counter of table sink flushes delayed by the throttle.
+	ThrottledFlushCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "sinkv2",
+			Name:      "throttled_flush_count",
+			Help:      "Total count of table sink flushes delayed by the throttle.",
+		}, []string{"namespace", "changefeed"})
+)
+
 // ---------- Metrics used in Statistics. ---------- //
 var (
 	// ExecBatchHistogram records batch size of a txn.
@@ -128,6 +149,9 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(TxnSinkDMLBatchCommit)
 	registry.MustRegister(TxnSinkDMLBatchCallback)
 
+	registry.MustRegister(ThrottleLimitGauge)
+	registry.MustRegister(ThrottledFlushCounter)
+
 	registry.MustRegister(ExecBatchHistogram)
 	registry.MustRegister(ExecDDLHistogram)
 	registry.MustRegister(LargeRowSizeHistogram)
@@ -41,6 +41,10 @@ type eventTableSink[E eventsink.TableEvent] struct {
 	// NOTICE: It is ordered by commitTs.
 	eventBuffer []E
 	state       state.TableSinkState
+	// throttler is nil if the throughput of the table sink is unlimited.
+	throttler *Throttler
+	// throttled indicates some resolved events are held back by the throttler.
+	throttled bool
 
 	// For dataflow metrics.
 	metricsTableSinkTotalRows prometheus.Counter
@@ -51,6 +55,7 @@ func New[E eventsink.TableEvent](
 	tableID model.TableID,
 	backendSink eventsink.EventSink[E],
 	appender eventsink.Appender[E],
+	throttler *Throttler,
 	totalRowsCounter prometheus.Counter,
 ) *eventTableSink[E] {
 	return &eventTableSink[E]{
@@ -62,6 +67,7 @@ func New[E eventsink.TableEvent](
 		eventAppender:             appender,
 		eventBuffer:               make([]E, 0, 1024),
 		state:                     state.TableSinkSinking,
+		throttler:                 throttler,
 		metricsTableSinkTotalRows: totalRowsCounter,
 	}
 }
@@ -72,19 +78,30 @@ func (e *eventTableSink[E]) AppendRowChangedEvents(rows ...*model.RowChangedEven
 }
 
 func (e *eventTableSink[E]) UpdateResolvedTs(resolvedTs model.ResolvedTs) error {
-	// If resolvedTs is not greater than maxResolvedTs,
-	// the flush is unnecessary.
-	if !e.maxResolvedTs.Less(resolvedTs) {
+	// If resolvedTs is not greater than maxResolvedTs and no events
+	// are held back by the throttler, the flush is unnecessary.
+	if !e.maxResolvedTs.Less(resolvedTs) && !e.throttled {
 		return nil
 	}
-	e.maxResolvedTs = resolvedTs
+	if e.maxResolvedTs.Less(resolvedTs) {
+		e.maxResolvedTs = resolvedTs
+	}
+	resolvedTs = e.maxResolvedTs
 
 	i := sort.Search(len(e.eventBuffer), func(i int) bool {
 		return e.eventBuffer[i].GetCommitTs() > resolvedTs.Ts
 	})
+	// Only the events allowed by the throttler are written, the rest are
+	// written in the following calls. The resolvedTs can not be added to
+	// progressTracker until all the events before it are written.
+	n := allow(e.throttler, e.eventBuffer[:i])
+	e.throttled = n < i
+	i = n
 	// Despite the lack of data, we have to move forward with progress.
 	if i == 0 {
-		e.progressTracker.addResolvedTs(resolvedTs)
+		if !e.throttled {
+			e.progressTracker.addResolvedTs(resolvedTs)
+		}
 		return nil
 	}
 	resolvedEvents := e.eventBuffer[:i]
@@ -104,7 +121,9 @@ func (e *eventTableSink[E]) UpdateResolvedTs(resolvedTs model.ResolvedTs) error
 		resolvedCallbackableEvents = append(resolvedCallbackableEvents, ce)
 	}
 	// Do not forget to add the resolvedTs to progressTracker.
-	e.progressTracker.addResolvedTs(resolvedTs)
+	if !e.throttled {
+		e.progressTracker.addResolvedTs(resolvedTs)
+	}
 	return e.backendSink.WriteEvents(resolvedCallbackableEvents...)
 }
 
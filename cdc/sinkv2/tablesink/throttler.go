@@ -0,0 +1,185 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablesink
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/metrics"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// Throttler limits the throughput of all table sinks of a changefeed on a
+// capture. It is shared by the table sinks and is safe for concurrent use.
+// Every capture has its own Throttler which enforces its share of the limits.
+type Throttler struct {
+	changefeedID model.ChangeFeedID
+	tz           *time.Location
+	// now is used to mock the current time in tests.
+	now func() time.Time
+
+	mu struct {
+		sync.Mutex
+		limits config.ThrottleLimits
+		rows   *rate.Limiter
+		bytes  *rate.Limiter
+		// setMaxConnections is called when the max connections limit changes,
+		// it is nil if the backend sink can not limit its connections.
+		setMaxConnections func(n int)
+	}
+
+	metricRowsLimit        prometheus.Gauge
+	metricBytesLimit       prometheus.Gauge
+	metricConnectionsLimit prometheus.Gauge
+	metricThrottledFlushes prometheus.Counter
+}
+
+// NewThrottler creates a Throttler without any limit, the time of day
+// schedule is evaluated in the given time zone.
+func NewThrottler(changefeedID model.ChangeFeedID, tz *time.Location) *Throttler {
+	if tz == nil {
+		tz = time.Local
+	}
+	t := &Throttler{
+		changefeedID: changefeedID,
+		tz:           tz,
+		now:          time.Now,
+
+		metricRowsLimit: metrics.ThrottleLimitGauge.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID, "rows"),
+		metricBytesLimit: metrics.ThrottleLimitGauge.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID, "bytes"),
+		metricConnectionsLimit: metrics.ThrottleLimitGauge.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID, "connections"),
+		metricThrottledFlushes: metrics.ThrottledFlushCounter.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+	}
+	return t
+}
+
+// OnMaxConnectionsChange registers the function called with the new limit
+// when the max connections limit changes, 0 means unlimited.
+func (t *Throttler) OnMaxConnectionsChange(f func(n int)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.mu.setMaxConnections = f
+}
+
+// Update applies the throttle config and the schedule at the current time.
+// The limits are split among the given number of captures which replicate
+// the changefeed. It is called periodically so that the time of day schedule
+// and the changes of captures take effect, a nil config removes all the limits.
+func (t *Throttler) Update(cfg *config.ThrottleConfig, captures int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var limits config.ThrottleLimits
+	if cfg != nil {
+		limits = cfg.LimitsAt(t.now().In(t.tz)).Split(captures)
+	}
+	if limits == t.mu.limits {
+		return
+	}
+	log.Info("throttle limits changed",
+		zap.String("namespace", t.changefeedID.Namespace),
+		zap.String("changefeed", t.changefeedID.ID),
+		zap.Any("old", t.mu.limits),
+		zap.Any("new", limits))
+
+	if limits.RowsPerSecond != t.mu.limits.RowsPerSecond {
+		t.mu.rows = newThrottleLimiter(limits.RowsPerSecond)
+		t.metricRowsLimit.Set(float64(limits.RowsPerSecond))
+	}
+	if limits.BytesPerSecond != t.mu.limits.BytesPerSecond {
+		t.mu.bytes = newThrottleLimiter(limits.BytesPerSecond)
+		t.metricBytesLimit.Set(float64(limits.BytesPerSecond))
+	}
+	if limits.MaxConnections != t.mu.limits.MaxConnections {
+		if t.mu.setMaxConnections != nil {
+			t.mu.setMaxConnections(limits.MaxConnections)
+		}
+		t.metricConnectionsLimit.Set(float64(limits.MaxConnections))
+	}
+	t.mu.limits = limits
+}
+
+// Limits returns the limits in effect on this capture.
+func (t *Throttler) Limits() config.ThrottleLimits {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.mu.limits
+}
+
+// allow returns how many of the events can be written to the backend sink now.
+// The events are taken in order until the quota of this second runs out. An
+// event larger than the quota of a second is allowed once the quota is full,
+// so that it won't be blocked forever.
+func allow[E eventsink.TableEvent](t *Throttler, events []E) int {
+	if t == nil {
+		return len(events)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.mu.rows == nil && t.mu.bytes == nil {
+		return len(events)
+	}
+	now := t.now()
+	for i, e := range events {
+		rows, ok := reserve(now, t.mu.rows, e.GetRowsCount())
+		if !ok {
+			t.metricThrottledFlushes.Inc()
+			return i
+		}
+		if _, ok := reserve(now, t.mu.bytes, e.ApproximateBytes()); !ok {
+			// Give back the rows reserved for the event.
+			if rows != nil {
+				rows.CancelAt(now)
+			}
+			t.metricThrottledFlushes.Inc()
+			return i
+		}
+	}
+	return len(events)
+}
+
+// reserve takes n tokens from the limiter if they are available now.
+// It returns a nil reservation if the limiter is nil, which means unlimited.
+func reserve(now time.Time, l *rate.Limiter, n int) (*rate.Reservation, bool) {
+	if l == nil {
+		return nil, true
+	}
+	if n > l.Burst() {
+		n = l.Burst()
+	}
+	r := l.ReserveN(now, n)
+	if r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil, false
+	}
+	return r, true
+}
+
+func newThrottleLimiter(limit int64) *rate.Limiter {
+	if limit == 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(limit), int(limit))
+}
@@ -0,0 +1,196 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablesink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func newThrottler4Test(now *time.Time) *Throttler {
+	t := NewThrottler(model.DefaultChangeFeedID("throttle-test"), time.UTC)
+	t.now = func() time.Time { return *now }
+	return t
+}
+
+func TestThrottlerUpdate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 10, 1, 8, 0, 0, 0, time.UTC)
+	throttler := newThrottler4Test(&now)
+	var maxConnections []int
+	throttler.OnMaxConnectionsChange(func(n int) {
+		maxConnections = append(maxConnections, n)
+	})
+
+	cfg := &config.ThrottleConfig{
+		ThrottleLimits: config.ThrottleLimits{RowsPerSecond: 1000},
+		Schedule: []*config.ThrottleWindow{{
+			Start: "09:00",
+			End:   "18:00",
+			ThrottleLimits: config.ThrottleLimits{
+				RowsPerSecond: 10, MaxConnections: 2,
+			},
+		}},
+	}
+	throttler.Update(cfg, 1)
+	require.Equal(t, cfg.ThrottleLimits, throttler.Limits())
+	require.Empty(t, maxConnections)
+
+	// The window takes effect.
+	now = now.Add(time.Hour)
+	throttler.Update(cfg, 1)
+	require.Equal(t, cfg.Schedule[0].ThrottleLimits, throttler.Limits())
+	require.Equal(t, []int{2}, maxConnections)
+
+	// All the limits are removed.
+	throttler.Update(nil, 1)
+	require.Equal(t, config.ThrottleLimits{}, throttler.Limits())
+	require.Equal(t, []int{2, 0}, maxConnections)
+}
+
+func TestThrottlerSplitAmongCaptures(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 10, 1, 8, 0, 0, 0, time.UTC)
+	rows := func(n int) []*model.RowChangedEvent {
+		res := make([]*model.RowChangedEvent, 0, n)
+		for i := 0; i < n; i++ {
+			res = append(res, &model.RowChangedEvent{
+				Table:   &model.TableName{Schema: "test", Table: "t"},
+				Columns: []*model.Column{{ApproximateBytes: 100}},
+			})
+		}
+		return res
+	}
+	cfg := &config.ThrottleConfig{
+		ThrottleLimits: config.ThrottleLimits{RowsPerSecond: 10, MaxConnections: 3},
+	}
+
+	// The changefeed is replicated by two captures, and each of them
+	// enforces half of the limits.
+	captures := []*Throttler{newThrottler4Test(&now), newThrottler4Test(&now)}
+	for _, throttler := range captures {
+		throttler.Update(cfg, len(captures))
+		require.Equal(t, config.ThrottleLimits{RowsPerSecond: 5, MaxConnections: 2},
+			throttler.Limits())
+	}
+	total := 0
+	for _, throttler := range captures {
+		total += allow(throttler, rows(10))
+	}
+	require.Equal(t, 10, total)
+
+	// A capture joins the changefeed, the shares are rounded up.
+	captures = append(captures, newThrottler4Test(&now))
+	now = now.Add(time.Second)
+	total = 0
+	for _, throttler := range captures {
+		throttler.Update(cfg, len(captures))
+		require.Equal(t, config.ThrottleLimits{RowsPerSecond: 4, MaxConnections: 1},
+			throttler.Limits())
+		total += allow(throttler, rows(10))
+	}
+	require.Equal(t, 12, total)
+}
+
+func TestThrottlerAllow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 10, 1, 8, 0, 0, 0, time.UTC)
+	throttler := newThrottler4Test(&now)
+	rows := func(n int) []*model.RowChangedEvent {
+		res := make([]*model.RowChangedEvent, 0, n)
+		for i := 0; i < n; i++ {
+			res = append(res, &model.RowChangedEvent{
+				Table:   &model.TableName{Schema: "test", Table: "t"},
+				Columns: []*model.Column{{ApproximateBytes: 100}},
+			})
+		}
+		return res
+	}
+	bytesPerRow := rows(1)[0].ApproximateBytes()
+
+	// No limit.
+	require.Equal(t, 10, allow(throttler, rows(10)))
+	require.Equal(t, 10, allow[*model.RowChangedEvent](nil, rows(10)))
+
+	throttler.Update(&config.ThrottleConfig{
+		ThrottleLimits: config.ThrottleLimits{RowsPerSecond: 4},
+	}, 1)
+	require.Equal(t, 4, allow(throttler, rows(10)))
+	require.Equal(t, 0, allow(throttler, rows(10)))
+	now = now.Add(500 * time.Millisecond)
+	require.Equal(t, 2, allow(throttler, rows(10)))
+
+	// The bytes limit is checked as well, and the rows are given back if an
+	// event exceeds the bytes limit.
+	throttler.Update(&config.ThrottleConfig{
+		ThrottleLimits: config.ThrottleLimits{
+			RowsPerSecond: 4, BytesPerSecond: int64(bytesPerRow * 2),
+		},
+	}, 1)
+	now = now.Add(time.Second)
+	require.Equal(t, 2, allow(throttler, rows(10)))
+	throttler.Update(&config.ThrottleConfig{
+		ThrottleLimits: config.ThrottleLimits{RowsPerSecond: 4},
+	}, 1)
+	require.Equal(t, 2, allow(throttler, rows(10)))
+
+	// An event larger than the quota of a second is allowed once the quota is full.
+	txn := &model.SingleTableTxn{Rows: rows(10)}
+	require.Equal(t, 0, allow(throttler, []*model.SingleTableTxn{txn}))
+	now = now.Add(time.Second)
+	require.Equal(t, 1, allow(throttler, []*model.SingleTableTxn{txn}))
+}
+
+func TestUpdateResolvedTsWithThrottle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 10, 1, 8, 0, 0, 0, time.UTC)
+	throttler := newThrottler4Test(&now)
+	throttler.Update(&config.ThrottleConfig{
+		ThrottleLimits: config.ThrottleLimits{RowsPerSecond: 3},
+	}, 1)
+	sink := &mockEventSink{}
+	tb := New[*model.SingleTableTxn](1, sink, &eventsink.TxnEventAppender{},
+		throttler, prometheus.NewCounter(prometheus.CounterOpts{}))
+	tb.AppendRowChangedEvents(getTestRows()...)
+
+	// The txns have 1, 1, 2, 2 and 1 rows before 104.
+	require.Nil(t, tb.UpdateResolvedTs(model.NewResolvedTs(104)))
+	require.Len(t, sink.events, 2)
+	sink.acknowledge(104)
+	require.Equal(t, model.NewResolvedTs(0), tb.GetCheckpointTs())
+
+	// The rest events are written in the following calls with the same resolved ts.
+	now = now.Add(time.Second)
+	require.Nil(t, tb.UpdateResolvedTs(model.NewResolvedTs(104)))
+	require.Len(t, sink.events, 1)
+	sink.acknowledge(104)
+	require.Equal(t, model.NewResolvedTs(0), tb.GetCheckpointTs())
+
+	now = now.Add(time.Second)
+	require.Nil(t, tb.UpdateResolvedTs(model.NewResolvedTs(104)))
+	require.Len(t, sink.events, 2)
+	sink.acknowledge(104)
+	require.Equal(t, model.NewResolvedTs(104), tb.GetCheckpointTs())
+	require.False(t, tb.throttled)
+}
@@ -17,11 +17,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/quotes"
 	"go.uber.org/zap"
 )
 
@@ -29,22 +36,43 @@ import (
 type checkSumChecker interface {
 	getCheckSum(ctx context.Context, db string, f filter.Filter) (map[string]string, error)
 	getAllDBs(ctx context.Context) ([]string, error)
+	getColumns(ctx context.Context, tableName string) ([]columnInfo, error)
+	getRangeCheckSum(ctx context.Context, db, table string, columns []columnInfo, r *keyRange) (string, int64, error)
+	splitRange(ctx context.Context, db, table string, r *keyRange, size int) ([]*keyRange, error)
+}
+
+// queryExecutor is implemented by both *sql.DB and *sql.Conn, a *sql.Conn is
+// required to read at a snapshot since tidb_snapshot is a session variable.
+type queryExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
 type checker struct {
-	db *sql.DB
+	db queryExecutor
 }
 
-// nolint:deadcode, will be used in next pr
-func newChecker(db *sql.DB) *checker {
+func newChecker(db queryExecutor) *checker {
 	return &checker{
 		db: db,
 	}
 }
 
+// setSnapshot makes all the following reads of the checker read the data at ts.
+func (c *checker) setSnapshot(ctx context.Context, ts uint64) error {
+	_, err := c.db.ExecContext(ctx, fmt.Sprintf("SET @@tidb_snapshot = '%d'", ts))
+	return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+}
+
 func (c *checker) getCheckSum(ctx context.Context, db string, f filter.Filter) (map[string]string, error) {
 	_, err := c.db.ExecContext(ctx, fmt.Sprintf("USE %s", db))
 	if err != nil {
+		// All tables of the schema are missing if the schema doesn't exist.
+		if mysqlErr, ok := errors.Cause(err).(*dmysql.MySQLError); ok &&
+			mysqlErr.Number == mysql.ErrBadDB {
+			return map[string]string{}, nil
+		}
 		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
 	}
 
@@ -151,7 +179,8 @@ func (c *checker) getColumns(ctx context.Context, tableName string) ([]columnInf
 	return result, nil
 }
 
-func (c *checker) doChecksum(ctx context.Context, columns []columnInfo, databaseName, tableName string) (string, error) {
+// concatColumns returns the expression which concatenates all columns of a row.
+func concatColumns(columns []columnInfo) string {
 	var columnNames, isNull []string
 	for _, item := range columns {
 		columnNames = append(columnNames, item.Field)
@@ -165,7 +194,11 @@ func (c *checker) doChecksum(ctx context.Context, columns []columnInfo, database
 
 	// ref: https://www.percona.com/doc/percona-toolkit/LATEST/pt-table-checksum.html
 	// TODO: hash function as a option
-	concat := fmt.Sprintf("CONCAT_WS(',', %s, %s)", a, b)
+	return fmt.Sprintf("CONCAT_WS(',', %s, %s)", a, b)
+}
+
+func (c *checker) doChecksum(ctx context.Context, columns []columnInfo, databaseName, tableName string) (string, error) {
+	concat := concatColumns(columns)
 	// nolint:gosec
 	query := fmt.Sprintf("SELECT BIT_XOR(CAST(crc32(%s) AS UNSIGNED)) AS checksum FROM %s", concat, tableName)
 
@@ -178,22 +211,197 @@ func (c *checker) doChecksum(ctx context.Context, columns []columnInfo, database
 	return checkSum, cerror.WrapError(cerror.ErrMySQLQueryError, err)
 }
 
+// keyRange is a range of rows ordered by the primary key columns, the lower
+// bound is exclusive and the upper bound is inclusive, a nil bound means the
+// range is unbounded on that side.
+type keyRange struct {
+	columns []columnInfo
+	lower   []string
+	upper   []string
+}
+
+func (r *keyRange) columnNames() []string {
+	names := make([]string, 0, len(r.columns))
+	for _, col := range r.columns {
+		names = append(names, col.Field)
+	}
+	return names
+}
+
+func (r *keyRange) quotedColumns() string {
+	names := make([]string, 0, len(r.columns))
+	for _, col := range r.columns {
+		names = append(names, quotes.QuoteName(col.Field))
+	}
+	return strings.Join(names, ", ")
+}
+
+// where returns the condition of the range and its arguments.
+func (r *keyRange) where() (string, []interface{}) {
+	tuple := "(" + r.quotedColumns() + ")"
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(r.columns)), ", ") + ")"
+	var conds []string
+	var args []interface{}
+	if r.lower != nil {
+		conds = append(conds, tuple+" > "+placeholders)
+		args = append(args, r.bindBound(r.lower)...)
+	}
+	if r.upper != nil {
+		conds = append(conds, tuple+" <= "+placeholders)
+		args = append(args, r.bindBound(r.upper)...)
+	}
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// bindBound converts the bound values of integer columns to integers, so that
+// they are not compared as floating-point numbers which may lose precision.
+func (r *keyRange) bindBound(bound []string) []interface{} {
+	args := make([]interface{}, 0, len(bound))
+	for i, v := range bound {
+		tp := strings.ToLower(r.columns[i].Type)
+		if !strings.Contains(tp, "int") {
+			args = append(args, v)
+			continue
+		}
+		if strings.Contains(tp, "unsigned") {
+			if u, err := strconv.ParseUint(v, 10, 64); err == nil {
+				args = append(args, u)
+				continue
+			}
+		} else if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			args = append(args, n)
+			continue
+		}
+		args = append(args, v)
+	}
+	return args
+}
+
+// getRangeCheckSum returns the checksum and the number of rows in the range.
+func (c *checker) getRangeCheckSum(
+	ctx context.Context, db, table string, columns []columnInfo, r *keyRange,
+) (string, int64, error) {
+	where, args := r.where()
+	// nolint:gosec
+	query := fmt.Sprintf("SELECT COUNT(*) AS cnt, BIT_XOR(CAST(crc32(%s) AS UNSIGNED)) AS checksum FROM %s WHERE %s",
+		concatColumns(columns), quotes.QuoteSchema(db, table), where)
+
+	log.Debug("do range checkSum",
+		zap.String("db", db),
+		zap.String("table", table),
+		zap.String("query", query))
+	var (
+		count    int64
+		checkSum string
+	)
+	err := c.db.QueryRowContext(ctx, query, args...).Scan(&count, &checkSum)
+	if err != nil {
+		return "", 0, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return checkSum, count, nil
+}
+
+// splitRange splits the range into consecutive chunks of at most size rows,
+// the chunks cover the whole range.
+func (c *checker) splitRange(
+	ctx context.Context, db, table string, r *keyRange, size int,
+) ([]*keyRange, error) {
+	var chunks []*keyRange
+	lower := r.lower
+	for {
+		chunk := &keyRange{columns: r.columns, lower: lower, upper: r.upper}
+		where, args := chunk.where()
+		// nolint:gosec
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT 1 OFFSET %d",
+			chunk.quotedColumns(), quotes.QuoteSchema(db, table), where, chunk.quotedColumns(), size-1)
+		bound, err := c.queryBound(ctx, query, len(r.columns), args)
+		if err != nil {
+			return nil, err
+		}
+		if bound == nil {
+			return append(chunks, chunk), nil
+		}
+		chunk.upper = bound
+		chunks = append(chunks, chunk)
+		if r.upper != nil && equalBound(bound, r.upper) {
+			return chunks, nil
+		}
+		lower = bound
+	}
+}
+
+// queryBound returns the values of the first row, or nil if there is no row.
+func (c *checker) queryBound(
+	ctx context.Context, query string, n int, args []interface{},
+) ([]string, error) {
+	rows, err := c.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer func() {
+		if err = rows.Close(); err != nil {
+			log.Error("queryBound close rows failed", zap.Error(err))
+		}
+	}()
+
+	if !rows.Next() {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, rows.Err())
+	}
+	values := make([]sql.NullString, n)
+	dest := make([]interface{}, n)
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	bound := make([]string, n)
+	for i, v := range values {
+		bound[i] = v.String
+	}
+	return bound, nil
+}
+
+func equalBound(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // TODO: use ADMIN CHECKSUM TABLE for tidb if needed
-var compareCheckSum = func(ctx context.Context, upstreamChecker, downstreamChecker checkSumChecker, f filter.Filter) (bool, error) {
+// compareCheckSum compares the checksums of all tables that are not ignored by
+// the filter, and returns the tables whose checksums differ.
+var compareCheckSum = func(
+	ctx context.Context, upstreamChecker, downstreamChecker checkSumChecker, f filter.Filter,
+) ([]*model.TableMismatch, error) {
 	dbs, err := upstreamChecker.getAllDBs(ctx)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
+	var mismatches []*model.TableMismatch
 	for _, db := range dbs {
 		sourceCheckSum, err := upstreamChecker.getCheckSum(ctx, db, f)
 		if err != nil {
-			return false, err
+			return nil, err
+		}
+		if len(sourceCheckSum) == 0 {
+			// the schema may not exist in the downstream if all its tables are filtered.
+			continue
 		}
 
 		sinkCheckSum, err := downstreamChecker.getCheckSum(ctx, db, f)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
 		if len(sourceCheckSum) != len(sinkCheckSum) {
@@ -202,19 +410,41 @@ var compareCheckSum = func(ctx context.Context, upstreamChecker, downstreamCheck
 				zap.Any("sink", sinkCheckSum))
 		}
 
-		for k, v := range sourceCheckSum {
+		tables := make([]string, 0, len(sourceCheckSum))
+		for k := range sourceCheckSum {
+			tables = append(tables, k)
+		}
+		sort.Strings(tables)
+		for _, k := range tables {
+			v := sourceCheckSum[k]
 			target, ok := sinkCheckSum[k]
 			if !ok {
-				log.Warn("cannot find checker at sink, it may eligible to replicate",
-					zap.String("sourceChecker", v),
-					zap.String("tableName", k))
+				log.Error("table not found at sink",
+					zap.String("db", db),
+					zap.String("table", k),
+					zap.String("source", v))
+				mismatches = append(mismatches, &model.TableMismatch{
+					Schema:            db,
+					Table:             k,
+					UpstreamChecksum:  v,
+					MissingDownstream: true,
+				})
 				continue
 			}
 			if v != target {
-				log.Error("checker mismatch", zap.String("source", v), zap.String("sink", target))
-				return false, nil
+				log.Error("checker mismatch",
+					zap.String("db", db),
+					zap.String("table", k),
+					zap.String("source", v),
+					zap.String("sink", target))
+				mismatches = append(mismatches, &model.TableMismatch{
+					Schema:             db,
+					Table:              k,
+					UpstreamChecksum:   v,
+					DownstreamChecksum: target,
+				})
 			}
 		}
 	}
-	return true, nil
+	return mismatches, nil
 }
@@ -19,7 +19,10 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	dmysql "github.com/go-sql-driver/mysql"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/stretchr/testify/mock"
@@ -34,13 +37,12 @@ func TestCompareCheckSum(t *testing.T) {
 		wantDBErr     error
 		wantSourceErr error
 		wantSinkErr   error
-		wantRet       bool
+		wantRet       []*model.TableMismatch
 	}{
 		{
 			name:       "happy",
 			wantSource: map[string]string{"t1": "1", "t2": "2"},
 			wantSink:   map[string]string{"t1": "1", "t2": "2"},
-			wantRet:    true,
 		},
 		{
 			name:       "getAllDBs err",
@@ -63,7 +65,24 @@ func TestCompareCheckSum(t *testing.T) {
 		{
 			name:       "not match",
 			wantSource: map[string]string{"t1": "1", "t2": "2"},
-			wantSink:   map[string]string{"t1": "11", "t2": "21"},
+			wantSink:   map[string]string{"t1": "11", "t2": "2"},
+			wantRet: []*model.TableMismatch{
+				{Schema: "1", Table: "t1", UpstreamChecksum: "1", DownstreamChecksum: "11"},
+				{Schema: "2", Table: "t1", UpstreamChecksum: "1", DownstreamChecksum: "11"},
+			},
+		},
+		{
+			name:       "missing table",
+			wantSource: map[string]string{"t1": "1", "t2": "2"},
+			wantSink:   map[string]string{"t2": "2"},
+			wantRet: []*model.TableMismatch{
+				{Schema: "1", Table: "t1", UpstreamChecksum: "1", MissingDownstream: true},
+				{Schema: "2", Table: "t1", UpstreamChecksum: "1", MissingDownstream: true},
+			},
+		},
+		{
+			name:       "empty schema",
+			wantSource: map[string]string{},
 		},
 	}
 	for _, tt := range tests {
@@ -88,6 +107,21 @@ func TestCompareCheckSum(t *testing.T) {
 	}
 }
 
+func TestGetCheckSumSchemaNotExist(t *testing.T) {
+	db, mockDB, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.Nil(t, err)
+	mockDB.ExpectExec("USE db").WillReturnError(&dmysql.MySQLError{Number: mysql.ErrBadDB})
+	c := &checker{
+		db: db,
+	}
+	f, err := filter.NewFilter(config.GetDefaultReplicaConfig(), "")
+	require.Nil(t, err)
+	ret, err := c.getCheckSum(context.Background(), "db", f)
+	require.Nil(t, err)
+	require.Empty(t, ret)
+	require.Nil(t, mockDB.ExpectationsWereMet())
+}
+
 func TestGetAllDBs(t *testing.T) {
 	type args struct {
 		dbs []string
@@ -324,3 +358,108 @@ func TestGetCheckSum(t *testing.T) {
 		}
 	}
 }
+
+func TestKeyRangeWhere(t *testing.T) {
+	columns := []columnInfo{
+		{Field: "a", Type: "bigint(20) unsigned"},
+		{Field: "b", Type: "varchar(10)"},
+	}
+	r := &keyRange{columns: columns}
+	where, args := r.where()
+	require.Equal(t, "TRUE", where)
+	require.Nil(t, args)
+
+	r.lower = []string{"18446744073709551615", "x"}
+	where, args = r.where()
+	require.Equal(t, "(`a`, `b`) > (?, ?)", where)
+	require.Equal(t, []interface{}{uint64(18446744073709551615), "x"}, args)
+
+	r.upper = []string{"20", "y"}
+	where, args = r.where()
+	require.Equal(t, "(`a`, `b`) > (?, ?) AND (`a`, `b`) <= (?, ?)", where)
+	require.Equal(t, []interface{}{uint64(18446744073709551615), "x", uint64(20), "y"}, args)
+	require.Equal(t, []string{"a", "b"}, r.columnNames())
+
+	r = &keyRange{columns: []columnInfo{{Field: "id", Type: "int(11)"}}, upper: []string{"-1"}}
+	where, args = r.where()
+	require.Equal(t, "(`id`) <= (?)", where)
+	require.Equal(t, []interface{}{int64(-1)}, args)
+}
+
+func TestGetRangeCheckSum(t *testing.T) {
+	db, mockDB, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.Nil(t, err)
+	columns := []columnInfo{{Field: "id", Type: "int(11)", Key: "PRI"}, {Field: "v"}}
+	r := &keyRange{columns: columns[:1], lower: []string{"1"}, upper: []string{"10"}}
+
+	mockDB.ExpectQuery("SELECT COUNT(*) AS cnt, BIT_XOR(CAST(crc32(CONCAT_WS(',', id,v, ISNULL(id),ISNULL(v))) AS UNSIGNED)) AS checksum "+
+		"FROM `d`.`t` WHERE (`id`) > (?) AND (`id`) <= (?)").
+		WithArgs(int64(1), int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"cnt", "checksum"}).AddRow(9, "123"))
+	c := newChecker(db)
+	checkSum, count, err := c.getRangeCheckSum(context.Background(), "d", "t", columns, r)
+	require.Nil(t, err)
+	require.Equal(t, "123", checkSum)
+	require.Equal(t, int64(9), count)
+
+	mockDB.ExpectQuery("SELECT COUNT(*) AS cnt, BIT_XOR(CAST(crc32(CONCAT_WS(',', id,v, ISNULL(id),ISNULL(v))) AS UNSIGNED)) AS checksum " +
+		"FROM `d`.`t` WHERE (`id`) > (?) AND (`id`) <= (?)").
+		WillReturnError(errors.New("err"))
+	_, _, err = c.getRangeCheckSum(context.Background(), "d", "t", columns, r)
+	require.Regexp(t, ".*CDC:ErrMySQLQueryError.*", err)
+	require.Nil(t, mockDB.ExpectationsWereMet())
+}
+
+func TestSplitRange(t *testing.T) {
+	db, mockDB, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.Nil(t, err)
+	columns := []columnInfo{{Field: "id", Type: "int(11)", Key: "PRI"}}
+	c := newChecker(db)
+
+	// the whole table is split into (nil, 2], (2, 4] and (4, nil].
+	mockDB.ExpectQuery("SELECT `id` FROM `d`.`t` WHERE TRUE ORDER BY `id` LIMIT 1 OFFSET 1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("2"))
+	mockDB.ExpectQuery("SELECT `id` FROM `d`.`t` WHERE (`id`) > (?) ORDER BY `id` LIMIT 1 OFFSET 1").
+		WithArgs(int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("4"))
+	mockDB.ExpectQuery("SELECT `id` FROM `d`.`t` WHERE (`id`) > (?) ORDER BY `id` LIMIT 1 OFFSET 1").
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	chunks, err := c.splitRange(context.Background(), "d", "t", &keyRange{columns: columns}, 2)
+	require.Nil(t, err)
+	require.Equal(t, []*keyRange{
+		{columns: columns, upper: []string{"2"}},
+		{columns: columns, lower: []string{"2"}, upper: []string{"4"}},
+		{columns: columns, lower: []string{"4"}},
+	}, chunks)
+
+	// the split stops once the upper bound of the range is reached.
+	mockDB.ExpectQuery("SELECT `id` FROM `d`.`t` WHERE (`id`) > (?) AND (`id`) <= (?) ORDER BY `id` LIMIT 1 OFFSET 0").
+		WithArgs(int64(2), int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("3"))
+	mockDB.ExpectQuery("SELECT `id` FROM `d`.`t` WHERE (`id`) > (?) AND (`id`) <= (?) ORDER BY `id` LIMIT 1 OFFSET 0").
+		WithArgs(int64(3), int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("4"))
+	chunks, err = c.splitRange(context.Background(), "d", "t",
+		&keyRange{columns: columns, lower: []string{"2"}, upper: []string{"4"}}, 1)
+	require.Nil(t, err)
+	require.Equal(t, []*keyRange{
+		{columns: columns, lower: []string{"2"}, upper: []string{"3"}},
+		{columns: columns, lower: []string{"3"}, upper: []string{"4"}},
+	}, chunks)
+
+	mockDB.ExpectQuery("SELECT `id` FROM `d`.`t` WHERE TRUE ORDER BY `id` LIMIT 1 OFFSET 1").
+		WillReturnError(errors.New("err"))
+	_, err = c.splitRange(context.Background(), "d", "t", &keyRange{columns: columns}, 2)
+	require.Regexp(t, ".*CDC:ErrMySQLQueryError.*", err)
+	require.Nil(t, mockDB.ExpectationsWereMet())
+}
+
+func TestSetSnapshot(t *testing.T) {
+	db, mockDB, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.Nil(t, err)
+	mockDB.ExpectExec("SET @@tidb_snapshot = '435000000000000001'").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.Nil(t, newChecker(db).setSnapshot(context.Background(), 435000000000000001))
+	require.Nil(t, mockDB.ExpectationsWereMet())
+}
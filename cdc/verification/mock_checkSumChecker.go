@@ -72,3 +72,77 @@ func (_m *mockCheckSumChecker) getCheckSum(ctx context.Context, db string, f fil
 
 	return r0, r1
 }
+
+// getColumns provides a mock function with given fields: ctx, tableName
+func (_m *mockCheckSumChecker) getColumns(ctx context.Context, tableName string) ([]columnInfo, error) {
+	ret := _m.Called(ctx, tableName)
+
+	var r0 []columnInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string) []columnInfo); ok {
+		r0 = rf(ctx, tableName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]columnInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tableName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// getRangeCheckSum provides a mock function with given fields: ctx, db, table, columns, r
+func (_m *mockCheckSumChecker) getRangeCheckSum(ctx context.Context, db string, table string, columns []columnInfo, r *keyRange) (string, int64, error) {
+	ret := _m.Called(ctx, db, table, columns, r)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []columnInfo, *keyRange) string); ok {
+		r0 = rf(ctx, db, table, columns, r)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []columnInfo, *keyRange) int64); ok {
+		r1 = rf(ctx, db, table, columns, r)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, []columnInfo, *keyRange) error); ok {
+		r2 = rf(ctx, db, table, columns, r)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// splitRange provides a mock function with given fields: ctx, db, table, r, size
+func (_m *mockCheckSumChecker) splitRange(ctx context.Context, db string, table string, r *keyRange, size int) ([]*keyRange, error) {
+	ret := _m.Called(ctx, db, table, r, size)
+
+	var r0 []*keyRange
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *keyRange, int) []*keyRange); ok {
+		r0 = rf(ctx, db, table, r, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*keyRange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *keyRange, int) error); ok {
+		r1 = rf(ctx, db, table, r, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
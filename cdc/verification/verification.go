@@ -0,0 +1,328 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verification
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/mysql"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultChunkSize = 10000
+	// maxMismatchRanges is the max number of mismatched key ranges reported
+	// for a table, the drill down stops once it is reached.
+	maxMismatchRanges = 32
+)
+
+// Config is the config to verify a changefeed.
+type Config struct {
+	ChangefeedID  model.ChangeFeedID
+	SinkURI       string
+	ReplicaConfig *config.ReplicaConfig
+}
+
+// Verify compares the upstream data at the primary ts and the downstream data
+// at the secondary ts of the latest syncpoint of the changefeed. The tables
+// whose checksums differ are split into chunks by the primary key, and the
+// mismatched chunks are drilled down to the smallest key ranges that differ.
+func Verify(ctx context.Context, cfg *Config) (*model.ChangefeedVerification, error) {
+	id := cfg.ChangefeedID
+	if !cfg.ReplicaConfig.EnableSyncPoint {
+		return nil, cerror.ErrChangefeedVerificationUnavailable.
+			GenWithStackByArgs(id.ID, "the sync point is not enabled")
+	}
+	vcfg := cfg.ReplicaConfig.Verification
+	if vcfg == nil || vcfg.UpstreamURI == "" {
+		return nil, cerror.ErrChangefeedVerificationUnavailable.
+			GenWithStackByArgs(id.ID, "the verification upstream-uri is not set")
+	}
+	chunkSize := vcfg.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultChunkSize
+	}
+	f, err := filter.NewFilter(cfg.ReplicaConfig, "")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	primaryTs, secondaryTs, err := getLatestSyncPoint(ctx, cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	upstreamDB, err := openDB(ctx, id, vcfg.UpstreamURI, cfg.ReplicaConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer closeDB(upstreamDB)
+	downstreamDB, err := openDB(ctx, id, cfg.SinkURI, cfg.ReplicaConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer closeDB(downstreamDB)
+
+	upstreamConn, err := snapshotConn(ctx, upstreamDB, primaryTs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer closeConn(upstreamConn)
+	downstreamConn, err := snapshotConn(ctx, downstreamDB, secondaryTs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer closeConn(downstreamConn)
+
+	log.Info("start to verify changefeed",
+		zap.String("namespace", id.Namespace),
+		zap.String("changefeed", id.ID),
+		zap.Uint64("primaryTs", primaryTs),
+		zap.Uint64("secondaryTs", secondaryTs))
+	result := &model.ChangefeedVerification{
+		PrimaryTs:   primaryTs,
+		SecondaryTs: secondaryTs,
+		StartTime:   time.Now(),
+	}
+	result.Mismatches, err = verify(ctx,
+		newChecker(upstreamConn), newChecker(downstreamConn), f, chunkSize)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result.Consistent = len(result.Mismatches) == 0
+	result.EndTime = time.Now()
+	log.Info("verify changefeed finished",
+		zap.String("namespace", id.Namespace),
+		zap.String("changefeed", id.ID),
+		zap.Bool("consistent", result.Consistent),
+		zap.Duration("duration", result.EndTime.Sub(result.StartTime)))
+	return result, nil
+}
+
+// verifyChangefeed is used to mock Verify in tests.
+var verifyChangefeed = Verify
+
+// Run verifies the changefeed every interval and records the results into
+// etcd until the context is canceled.
+func Run(ctx context.Context, cfg *Config, etcdClient etcd.CDCEtcdClient) error {
+	ticker := time.NewTicker(cfg.ReplicaConfig.Verification.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-ticker.C:
+		}
+		result, err := verifyChangefeed(ctx, cfg)
+		if err != nil {
+			log.Warn("verify changefeed failed",
+				zap.String("namespace", cfg.ChangefeedID.Namespace),
+				zap.String("changefeed", cfg.ChangefeedID.ID),
+				zap.Error(err))
+			continue
+		}
+		if !result.Consistent {
+			log.Error("changefeed data mismatch",
+				zap.String("namespace", cfg.ChangefeedID.Namespace),
+				zap.String("changefeed", cfg.ChangefeedID.ID),
+				zap.Any("mismatches", result.Mismatches))
+		}
+		err = etcdClient.PutChangefeedVerification(ctx, cfg.ChangefeedID, result)
+		if err != nil {
+			log.Warn("record changefeed verification failed",
+				zap.String("namespace", cfg.ChangefeedID.Namespace),
+				zap.String("changefeed", cfg.ChangefeedID.ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// verify compares the checksums of all tables and drills down the tables
+// whose checksums differ.
+func verify(
+	ctx context.Context,
+	upstreamChecker, downstreamChecker checkSumChecker,
+	f filter.Filter, chunkSize int,
+) ([]*model.TableMismatch, error) {
+	mismatches, err := compareCheckSum(ctx, upstreamChecker, downstreamChecker, f)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range mismatches {
+		// There is nothing to drill down if the whole table is missing.
+		if m.MissingDownstream {
+			continue
+		}
+		if err := compareTable(ctx, upstreamChecker, downstreamChecker, m, chunkSize); err != nil {
+			return nil, err
+		}
+	}
+	return mismatches, nil
+}
+
+// compareTable splits the table into chunks by the primary key and records
+// the mismatched key ranges into m.
+func compareTable(
+	ctx context.Context,
+	upstreamChecker, downstreamChecker checkSumChecker,
+	m *model.TableMismatch, chunkSize int,
+) error {
+	columns, err := upstreamChecker.getColumns(ctx, quotes.QuoteSchema(m.Schema, m.Table))
+	if err != nil {
+		return err
+	}
+	// The primary key columns are in the order of the table columns, which is
+	// still a total order of the rows.
+	var handleColumns []columnInfo
+	for _, col := range columns {
+		if col.Key == "PRI" {
+			handleColumns = append(handleColumns, col)
+		}
+	}
+	if len(handleColumns) == 0 {
+		log.Warn("table has no primary key, skip comparing chunks",
+			zap.String("db", m.Schema), zap.String("table", m.Table))
+		return nil
+	}
+
+	chunks, err := upstreamChecker.splitRange(ctx, m.Schema, m.Table,
+		&keyRange{columns: handleColumns}, chunkSize)
+	if err != nil {
+		return err
+	}
+	return compareRanges(ctx, upstreamChecker, downstreamChecker, m, columns, chunks)
+}
+
+// compareRanges compares the checksums of the ranges, a mismatched range is
+// split into halves recursively until it can not be split any further.
+func compareRanges(
+	ctx context.Context,
+	upstreamChecker, downstreamChecker checkSumChecker,
+	m *model.TableMismatch, columns []columnInfo, ranges []*keyRange,
+) error {
+	for _, r := range ranges {
+		if len(m.Ranges) >= maxMismatchRanges {
+			return nil
+		}
+		upstreamCheckSum, upstreamCount, err := upstreamChecker.
+			getRangeCheckSum(ctx, m.Schema, m.Table, columns, r)
+		if err != nil {
+			return err
+		}
+		downstreamCheckSum, downstreamCount, err := downstreamChecker.
+			getRangeCheckSum(ctx, m.Schema, m.Table, columns, r)
+		if err != nil {
+			return err
+		}
+		if upstreamCheckSum == downstreamCheckSum && upstreamCount == downstreamCount {
+			continue
+		}
+
+		// A range is split by the upstream rows, so a range with at most one
+		// upstream row is the smallest one.
+		if upstreamCount > 1 {
+			subRanges, err := upstreamChecker.splitRange(ctx, m.Schema, m.Table,
+				r, int((upstreamCount+1)/2))
+			if err != nil {
+				return err
+			}
+			if len(subRanges) > 1 {
+				err = compareRanges(ctx, upstreamChecker, downstreamChecker, m, columns, subRanges)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		m.Ranges = append(m.Ranges, &model.KeyRangeMismatch{
+			Columns:            r.columnNames(),
+			LowerBound:         r.lower,
+			UpperBound:         r.upper,
+			UpstreamChecksum:   upstreamCheckSum,
+			DownstreamChecksum: downstreamCheckSum,
+			UpstreamRowCount:   upstreamCount,
+			DownstreamRowCount: downstreamCount,
+		})
+	}
+	return nil
+}
+
+func getLatestSyncPoint(ctx context.Context, cfg *Config) (uint64, uint64, error) {
+	store, err := mysql.NewSyncPointStore(ctx, cfg.ChangefeedID, cfg.SinkURI,
+		cfg.ReplicaConfig.SyncPointRetention)
+	if err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.Warn("close syncpoint store failed", zap.Error(err))
+		}
+	}()
+	return store.GetLatestSyncPoint(ctx, cfg.ChangefeedID)
+}
+
+func openDB(
+	ctx context.Context, id model.ChangeFeedID, uri string, replicaConfig *config.ReplicaConfig,
+) (*sql.DB, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	cfg := pmysql.NewConfig()
+	if err = cfg.Apply(ctx, id, u, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+	dsn, err := pmysql.GenerateDSN(ctx, u, cfg, pmysql.CreateMySQLDBConn)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return pmysql.CreateMySQLDBConn(ctx, dsn)
+}
+
+// snapshotConn returns a connection which reads the data at ts.
+func snapshotConn(ctx context.Context, db *sql.DB, ts uint64) (*sql.Conn, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLConnectionError, err)
+	}
+	if err = newChecker(conn).setSnapshot(ctx, ts); err != nil {
+		closeConn(conn)
+		return nil, err
+	}
+	return conn, nil
+}
+
+func closeDB(db *sql.DB) {
+	if err := db.Close(); err != nil {
+		log.Warn("close db failed", zap.Error(err))
+	}
+}
+
+func closeConn(conn *sql.Conn) {
+	if err := conn.Close(); err != nil {
+		log.Warn("close db connection failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDrillDown(t *testing.T) {
+	ctx := context.Background()
+	columns := []columnInfo{{Field: "id", Type: "int(11)", Key: "PRI"}, {Field: "v"}}
+	handle := columns[:1]
+	chunk1 := &keyRange{columns: handle, upper: []string{"4"}}
+	chunk2 := &keyRange{columns: handle, lower: []string{"4"}}
+	half1 := &keyRange{columns: handle, lower: []string{"4"}, upper: []string{"5"}}
+	half2 := &keyRange{columns: handle, lower: []string{"5"}}
+	matchRange := func(expected *keyRange) interface{} {
+		return mock.MatchedBy(func(r *keyRange) bool {
+			return equalBound(r.lower, expected.lower) && equalBound(r.upper, expected.upper) &&
+				(r.lower == nil) == (expected.lower == nil) && (r.upper == nil) == (expected.upper == nil)
+		})
+	}
+
+	up := &mockCheckSumChecker{}
+	up.On("getAllDBs", mock.Anything).Return([]string{"d"}, nil)
+	up.On("getCheckSum", mock.Anything, "d", mock.Anything).
+		Return(map[string]string{"t": "1", "nopk": "2", "missing": "5"}, nil)
+	up.On("getColumns", mock.Anything, "`d`.`t`").Return(columns, nil)
+	up.On("getColumns", mock.Anything, "`d`.`nopk`").Return([]columnInfo{{Field: "v"}}, nil)
+	up.On("splitRange", mock.Anything, "d", "t", matchRange(&keyRange{}), 4).
+		Return([]*keyRange{chunk1, chunk2}, nil)
+	up.On("splitRange", mock.Anything, "d", "t", matchRange(chunk2), 1).
+		Return([]*keyRange{half1, half2}, nil)
+	up.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(chunk1)).
+		Return("10", int64(4), nil)
+	up.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(chunk2)).
+		Return("20", int64(2), nil)
+	up.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(half1)).
+		Return("21", int64(1), nil)
+	up.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(half2)).
+		Return("22", int64(1), nil)
+
+	down := &mockCheckSumChecker{}
+	down.On("getCheckSum", mock.Anything, "d", mock.Anything).
+		Return(map[string]string{"t": "3", "nopk": "4"}, nil)
+	down.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(chunk1)).
+		Return("10", int64(4), nil)
+	down.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(chunk2)).
+		Return("23", int64(2), nil)
+	down.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(half1)).
+		Return("21", int64(1), nil)
+	down.On("getRangeCheckSum", mock.Anything, "d", "t", columns, matchRange(half2)).
+		Return("0", int64(0), nil)
+
+	f, err := filter.NewFilter(config.GetDefaultReplicaConfig(), "")
+	require.Nil(t, err)
+	mismatches, err := verify(ctx, up, down, f, 4)
+	require.Nil(t, err)
+	require.Equal(t, []*model.TableMismatch{
+		{
+			// The missing table is reported without being drilled down.
+			Schema:            "d",
+			Table:             "missing",
+			UpstreamChecksum:  "5",
+			MissingDownstream: true,
+		},
+		{
+			Schema:             "d",
+			Table:              "nopk",
+			UpstreamChecksum:   "2",
+			DownstreamChecksum: "4",
+		},
+		{
+			Schema:             "d",
+			Table:              "t",
+			UpstreamChecksum:   "1",
+			DownstreamChecksum: "3",
+			Ranges: []*model.KeyRangeMismatch{{
+				Columns:            []string{"id"},
+				LowerBound:         []string{"5"},
+				UpstreamChecksum:   "22",
+				DownstreamChecksum: "0",
+				UpstreamRowCount:   1,
+				DownstreamRowCount: 0,
+			}},
+		},
+	}, mismatches)
+	up.AssertExpectations(t)
+	down.AssertExpectations(t)
+}
+
+func TestVerifyUnavailable(t *testing.T) {
+	ctx := context.Background()
+	cfg := &Config{
+		ChangefeedID:  model.DefaultChangeFeedID("test"),
+		SinkURI:       "mysql://127.0.0.1:3306/",
+		ReplicaConfig: config.GetDefaultReplicaConfig(),
+	}
+	_, err := Verify(ctx, cfg)
+	require.Regexp(t, ".*CDC:ErrChangefeedVerificationUnavailable.*sync point.*", err)
+
+	cfg.ReplicaConfig.EnableSyncPoint = true
+	_, err = Verify(ctx, cfg)
+	require.Regexp(t, ".*CDC:ErrChangefeedVerificationUnavailable.*upstream-uri.*", err)
+}
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := &Config{
+		ChangefeedID:  model.DefaultChangeFeedID("test"),
+		ReplicaConfig: config.GetDefaultReplicaConfig(),
+	}
+	cfg.ReplicaConfig.Verification = &config.VerificationConfig{Interval: time.Millisecond}
+	result := &model.ChangefeedVerification{PrimaryTs: 1, SecondaryTs: 2}
+	original := verifyChangefeed
+	defer func() { verifyChangefeed = original }()
+	verifyChangefeed = func(_ context.Context, c *Config) (*model.ChangefeedVerification, error) {
+		require.Equal(t, cfg, c)
+		return result, nil
+	}
+
+	ctrl := gomock.NewController(t)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	etcdClient.EXPECT().PutChangefeedVerification(gomock.Any(), cfg.ChangefeedID, result).
+		DoAndReturn(func(context.Context, model.ChangeFeedID, *model.ChangefeedVerification) error {
+			cancel()
+			return nil
+		}).MinTimes(1)
+	require.ErrorIs(t, Run(ctx, cfg, etcdClient), context.Canceled)
+}
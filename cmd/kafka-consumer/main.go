@@ -37,8 +37,15 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/avro"
 	"github.com/pingcap/tiflow/cdc/sink/codec/canal"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/cdc/sink/codec/craft"
+	"github.com/pingcap/tiflow/cdc/sink/codec/debezium"
+	"github.com/pingcap/tiflow/cdc/sink/codec/maxwell"
 	"github.com/pingcap/tiflow/cdc/sink/codec/open"
+	"github.com/pingcap/tiflow/cdc/sink/codec/protobuf"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
 	cmdUtil "github.com/pingcap/tiflow/pkg/cmd/util"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -65,6 +72,13 @@ var (
 	protocol            config.Protocol
 	enableTiDBExtension bool
 
+	// schemaRegistryURI is only used by the avro protocol
+	schemaRegistryURI string
+
+	// claimCheckStorageURI overrides the external storage in the claim-check
+	// references, e.g. to provide the credentials.
+	claimCheckStorageURI string
+
 	// eventRouterReplicaConfig only used to initialize the consumer's eventRouter
 	// which then can be used to check RowChangedEvent dispatched correctness
 	eventRouterReplicaConfig *config.ReplicaConfig
@@ -84,6 +98,9 @@ func init() {
 	flag.StringVar(&upstreamURIStr, "upstream-uri", "", "Kafka uri")
 	flag.StringVar(&downstreamURIStr, "downstream-uri", "", "downstream sink uri")
 	flag.StringVar(&configFile, "config", "", "config file for changefeed")
+	flag.StringVar(&schemaRegistryURI, "schema-registry-uri", "", "schema registry uri for avro protocol")
+	flag.StringVar(&claimCheckStorageURI, "claim-check-storage-uri", "",
+		"external storage uri to fetch the claim-check messages, the one in the messages is used if not set")
 	flag.StringVar(&logPath, "log-file", "cdc_kafka_consumer.log", "log file path")
 	flag.StringVar(&logLevel, "log-level", "info", "log file path")
 	flag.StringVar(&timezone, "tz", "System", "Specify time zone of Kafka consumer")
@@ -180,13 +197,26 @@ func init() {
 		if err != nil {
 			log.Panic("invalid enable-tidb-extension of upstream-uri")
 		}
-		if protocol != config.ProtocolCanalJSON && b {
-			log.Panic("enable-tidb-extension only work with canal-json")
+		if b && protocol != config.ProtocolCanalJSON &&
+			protocol != config.ProtocolAvro && protocol != config.ProtocolMaxwell &&
+			protocol != config.ProtocolDebezium {
+			log.Panic("enable-tidb-extension only work with canal-json/avro/maxwell/debezium")
 		}
 
 		enableTiDBExtension = b
 	}
 
+	// avro, maxwell and debezium messages carry no resolved ts unless the
+	// TiDB extension is enabled, so the events can never be flushed without it.
+	if (protocol == config.ProtocolAvro || protocol == config.ProtocolMaxwell ||
+		protocol == config.ProtocolDebezium) && !enableTiDBExtension {
+		log.Panic("avro/maxwell/debezium protocol requires enable-tidb-extension",
+			zap.Any("protocol", protocol))
+	}
+	if protocol == config.ProtocolAvro && schemaRegistryURI == "" {
+		log.Panic("avro protocol requires schema-registry-uri")
+	}
+
 	if configFile != "" {
 		eventRouterReplicaConfig = config.GetDefaultReplicaConfig()
 		eventRouterReplicaConfig.Sink.Protocol = protocol.String()
@@ -261,6 +291,11 @@ func newSaramaConfig() (*sarama.Config, error) {
 	config.Metadata.Retry.Backoff = 500 * time.Millisecond
 	config.Consumer.Retry.Backoff = 500 * time.Millisecond
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	// Only read the committed messages, so the messages of the aborted
+	// transactions are skipped if the transactional producer is enabled.
+	if version.IsAtLeast(sarama.V0_11_0_0) {
+		config.Consumer.IsolationLevel = sarama.ReadCommitted
+	}
 
 	if len(ca) != 0 {
 		config.Net.TLS.Enable = true
@@ -375,6 +410,10 @@ type Consumer struct {
 
 	protocol            config.Protocol
 	enableTiDBExtension bool
+	avroSchemaManager   *avro.SchemaManager
+	claimCheckFetcher   *claimcheck.Fetcher
+	// tz is used to decode the TIMESTAMP values of the debezium protocol.
+	tz *time.Location
 
 	eventRouter *dispatcher.EventRouter
 }
@@ -394,6 +433,14 @@ func NewConsumer(ctx context.Context) (*Consumer, error) {
 	}
 	c.protocol = protocol
 	c.enableTiDBExtension = enableTiDBExtension
+	c.claimCheckFetcher = claimcheck.NewFetcher(claimCheckStorageURI)
+	c.tz = tz
+	if protocol == config.ProtocolAvro {
+		c.avroSchemaManager, err = avro.NewAvroSchemaManager(ctx, nil, schemaRegistryURI, "")
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 
 	// this means user has input config file to enable dispatcher check
 	// some protocol does not provide enough information to check the
@@ -487,6 +534,16 @@ func (g *eventsGroup) Resolve(resolveTs uint64) []*model.RowChangedEvent {
 	return result
 }
 
+// isClaimCheckMessage returns whether the message is a claim-check reference.
+func isClaimCheckMessage(message *sarama.ConsumerMessage) bool {
+	for _, header := range message.Headers {
+		if header != nil && string(header.Key) == common.ClaimCheckHeaderKey {
+			return true
+		}
+	}
+	return false
+}
+
 // ConsumeClaim must start a consumer loop of ConsumerGroupClaim's Messages().
 func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	ctx := context.Background()
@@ -504,11 +561,31 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 			decoder codec.EventBatchDecoder
 			err     error
 		)
+		// The original message of a claim-check reference is fetched from
+		// the external storage, then decoded as usual.
+		key, value := message.Key, message.Value
+		if isClaimCheckMessage(message) {
+			key, value, err = c.claimCheckFetcher.Fetch(ctx, message.Value)
+			if err != nil {
+				log.Panic("fetch claim-check message failed",
+					zap.ByteString("reference", message.Value), zap.Error(err))
+			}
+		}
 		switch c.protocol {
 		case config.ProtocolOpen, config.ProtocolDefault:
-			decoder, err = open.NewBatchDecoder(message.Key, message.Value)
+			decoder, err = open.NewBatchDecoder(key, value)
 		case config.ProtocolCanalJSON:
-			decoder = canal.NewBatchDecoder(message.Value, c.enableTiDBExtension)
+			decoder = canal.NewBatchDecoder(value, c.enableTiDBExtension)
+		case config.ProtocolCraft:
+			decoder, err = craft.NewBatchDecoder(value)
+		case config.ProtocolMaxwell:
+			decoder = maxwell.NewBatchDecoder(value)
+		case config.ProtocolDebezium:
+			decoder = debezium.NewBatchDecoder(key, value, c.tz)
+		case config.ProtocolProtobuf:
+			decoder, err = protobuf.NewBatchDecoder(value)
+		case config.ProtocolAvro:
+			decoder = avro.NewDecoder(ctx, c.avroSchemaManager, key, value)
 		default:
 			log.Panic("Protocol not supported", zap.Any("Protocol", c.protocol))
 		}
@@ -543,7 +620,7 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 				// but all DDL event messages should be consumed.
 				ddl, err := decoder.NextDDLEvent()
 				if err != nil {
-					log.Panic("decode message value failed", zap.ByteString("value", message.Value))
+					log.Panic("decode message value failed", zap.ByteString("value", value))
 				}
 				if partition == 0 {
 					c.appendDDL(ddl)
@@ -551,7 +628,7 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 			case model.MessageTypeRow:
 				row, err := decoder.NextRowChangedEvent()
 				if err != nil {
-					log.Panic("decode message value failed", zap.ByteString("value", message.Value))
+					log.Panic("decode message value failed", zap.ByteString("value", value))
 				}
 
 				if c.eventRouter != nil {
@@ -595,7 +672,7 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 			case model.MessageTypeResolved:
 				ts, err := decoder.NextResolvedEvent()
 				if err != nil {
-					log.Panic("decode message value failed", zap.ByteString("value", message.Value))
+					log.Panic("decode message value failed", zap.ByteString("value", value))
 				}
 				resolvedTs := atomic.LoadUint64(&sink.resolvedTs)
 				// `resolvedTs` should be monotonically increasing, it's allowed to receive redundant one.
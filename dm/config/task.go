@@ -94,8 +94,9 @@ var (
 	defaultChunkFilesize = "64"
 	defaultSkipTzUTC     = true
 	// LoaderConfig.
-	defaultPoolSize = 16
-	defaultDir      = "./dumped_data"
+	defaultPoolSize          = 16
+	defaultDir               = "./dumped_data"
+	defaultChecksumChunkSize = 10000
 	// SyncerConfig.
 	defaultWorkerCount             = 16
 	defaultBatch                   = 100
@@ -263,15 +264,24 @@ type LoaderConfig struct {
 	SQLMode     string               `yaml:"-" toml:"-" json:"-"` // wrote by dump unit (DM op) or jobmaster (DM in engine)
 	ImportMode  LoadMode             `yaml:"import-mode" toml:"import-mode" json:"import-mode"`
 	OnDuplicate DuplicateResolveType `yaml:"on-duplicate" toml:"on-duplicate" json:"on-duplicate"`
+	// ValidateChecksum compares CRC32 checksums of upstream and downstream chunk by chunk after data is loaded,
+	// tables are split into chunks of ChecksumChunkSize rows by primary key (or not null unique key).
+	// The upstream is read at the dump's snapshot, so it requires consistency flush or lock of MySQL, or snapshot of TiDB.
+	// A mismatch pauses the task, it's validated again after resuming, or ignored by `handle-error <task> skip`.
+	// The validation of MySQL is skipped if the upstream has changed since dump, which also pauses the task until
+	// it's accepted by `handle-error <task> skip`.
+	ValidateChecksum  bool `yaml:"validate-checksum" toml:"validate-checksum" json:"validate-checksum"`
+	ChecksumChunkSize int  `yaml:"checksum-chunk-size" toml:"checksum-chunk-size" json:"checksum-chunk-size"`
 }
 
 // DefaultLoaderConfig return default loader config for task.
 func DefaultLoaderConfig() LoaderConfig {
 	return LoaderConfig{
-		PoolSize:    defaultPoolSize,
-		Dir:         defaultDir,
-		ImportMode:  LoadModeSQL,
-		OnDuplicate: OnDuplicateReplace,
+		PoolSize:          defaultPoolSize,
+		Dir:               defaultDir,
+		ImportMode:        LoadModeSQL,
+		OnDuplicate:       OnDuplicateReplace,
+		ChecksumChunkSize: defaultChecksumChunkSize,
 	}
 }
 
@@ -305,6 +315,10 @@ func (m *LoaderConfig) adjust() error {
 		return terror.ErrConfigInvalidDuplicateResolution.Generate(m.OnDuplicate)
 	}
 
+	if m.ValidateChecksum && m.ChecksumChunkSize <= 0 {
+		m.ChecksumChunkSize = defaultChecksumChunkSize
+	}
+
 	return nil
 }
 
@@ -368,7 +382,10 @@ type ValidatorConfig struct {
 	BatchQuerySize     int      `yaml:"batch-query-size" toml:"batch-query-size" json:"batch-query-size"`
 	MaxPendingRowSize  string   `yaml:"max-pending-row-size" toml:"max-pending-row-size" json:"max-pending-row-size"`
 	MaxPendingRowCount int      `yaml:"max-pending-row-count" toml:"max-pending-row-count" json:"max-pending-row-count"`
-	StartTime          string   `yaml:"-" toml:"start-time" json:"-"`
+	// AutoRepair makes validator repair error rows right after they're persisted,
+	// rows still pending in syncer are skipped and retried on next meta flush.
+	AutoRepair bool   `yaml:"auto-repair" toml:"auto-repair" json:"auto-repair"`
+	StartTime  string `yaml:"-" toml:"start-time" json:"-"`
 }
 
 func (v *ValidatorConfig) Adjust() error {
@@ -637,3 +637,89 @@ func (cp *LightningCheckpointList) Close() {
 		cp.logger.Error("close checkpoint list db", log.ShortError(err))
 	}
 }
+
+// checksumResult is the result of checksum validation persisted by checksumCheckpoint.
+type checksumResult struct {
+	result        string
+	totalChunks   int64
+	mismatchCount int
+	// at most maxReportedMismatchChunks mismatched chunks are kept.
+	mismatches []string
+}
+
+// checksumCheckpoint persists the result of checksum validation in downstream, so a resumed task doesn't validate
+// again after the validation is done, and a mismatch or a skipped validation can be ignored by `handle-error skip`.
+type checksumCheckpoint struct {
+	schema     string
+	tableName  string
+	sourceName string
+}
+
+func newChecksumCheckpoint(cfg *config.SubTaskConfig) *checksumCheckpoint {
+	return &checksumCheckpoint{
+		schema:     dbutil.ColumnName(cfg.MetaSchema),
+		tableName:  dbutil.TableName(cfg.MetaSchema, cputil.LoaderChecksum(cfg.Name)),
+		sourceName: cfg.SourceID,
+	}
+}
+
+func (cp *checksumCheckpoint) prepare(ctx context.Context, connection *conn.BaseConn) error {
+	createSchema := fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", cp.schema)
+	createTable := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		source_name varchar(255) NOT NULL,
+		result varchar(10) NOT NULL COMMENT 'passed,mismatch,skipped,ignored,unchecked',
+		total_chunks bigint NOT NULL DEFAULT 0,
+		mismatch_count int NOT NULL DEFAULT 0,
+		mismatch_chunks longtext NOT NULL,
+		update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		PRIMARY KEY (source_name)
+	)`, cp.tableName)
+	tctx := tcontext.NewContext(ctx, log.With(zap.String("job", "checksum-checkpoint")))
+	_, err := connection.ExecuteSQL(tctx, nil, "checksum-checkpoint", []string{createSchema, createTable})
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
+// load returns the persisted result, or nil if the validation is not done yet.
+func (cp *checksumCheckpoint) load(ctx context.Context, connection *conn.BaseConn) (*checksumResult, error) {
+	query := fmt.Sprintf("SELECT `result`, `total_chunks`, `mismatch_count`, `mismatch_chunks` FROM %s WHERE `source_name` = ?", cp.tableName)
+	tctx := tcontext.NewContext(ctx, log.With(zap.String("job", "checksum-checkpoint")))
+	// nolint:rowserrcheck
+	rows, err := connection.QuerySQL(tctx, query, cp.sourceName)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, terror.WithScope(terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	var (
+		r          checksumResult
+		mismatches string
+	)
+	if err = rows.Scan(&r.result, &r.totalChunks, &r.mismatchCount, &mismatches); err != nil {
+		return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	if mismatches != "" {
+		if err = json.Unmarshal([]byte(mismatches), &r.mismatches); err != nil {
+			return nil, terror.Annotate(err, "parse mismatched chunks of checksum result")
+		}
+	}
+	return &r, nil
+}
+
+func (cp *checksumCheckpoint) save(ctx context.Context, connection *conn.BaseConn, r *checksumResult) error {
+	mismatches, err := json.Marshal(r.mismatches)
+	if err != nil {
+		return terror.Annotate(err, "marshal mismatched chunks of checksum result")
+	}
+	sql := fmt.Sprintf("INSERT INTO %s (`source_name`, `result`, `total_chunks`, `mismatch_count`, `mismatch_chunks`) VALUES (?, ?, ?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE `result` = VALUES(`result`), `total_chunks` = VALUES(`total_chunks`), "+
+		"`mismatch_count` = VALUES(`mismatch_count`), `mismatch_chunks` = VALUES(`mismatch_chunks`)", cp.tableName)
+	tctx := tcontext.NewContext(ctx, log.With(zap.String("job", "checksum-checkpoint")))
+	_, err = connection.ExecuteSQL(tctx, nil, "checksum-checkpoint", []string{sql},
+		[]interface{}{cp.sourceName, r.result, r.totalChunks, r.mismatchCount, string(mismatches)})
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "save checksum result"), terror.ScopeDownstream)
+	}
+	return nil
+}
@@ -0,0 +1,742 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
+	"github.com/pingcap/tidb/dumpling/export"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/filter"
+	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/checker"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/dumpling"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+const (
+	checksumStageRunning  = "running"
+	checksumStageFinished = "finished"
+	// the validation is skipped because the upstream has changed since dump.
+	checksumStageSkipped = "skipped"
+
+	// results of checksum validation persisted in downstream, see checksumCheckpoint.
+	checksumResultPassed   = "passed"
+	checksumResultMismatch = "mismatch"
+	checksumResultSkipped  = "skipped"
+	// the mismatch is ignored by `handle-error skip`.
+	checksumResultIgnored = "ignored"
+	// the skipped validation is accepted by `handle-error skip`.
+	checksumResultUnchecked = "unchecked"
+
+	// at most this number of mismatched chunks are kept in status and error message.
+	maxReportedMismatchChunks = 100
+)
+
+// checksumTable is a table to be validated and its routed table in downstream.
+type checksumTable struct {
+	source *filter.Table
+	target *filter.Table
+	// tableInfo only contains the columns taking part in checksum.
+	tableInfo *model.TableInfo
+	keys      []*model.ColumnInfo
+}
+
+// checksumChunk is a range of rows of a table, split by the key columns.
+// nil lower or upper bound means the range is unbounded on that side.
+type checksumChunk struct {
+	table *checksumTable
+	lower []interface{}
+	upper []interface{}
+}
+
+// where returns the condition and arguments to select rows in (lower, upper].
+func (c *checksumChunk) where() (string, []interface{}) {
+	keys := make([]string, 0, len(c.table.keys))
+	for _, col := range c.table.keys {
+		keys = append(keys, dbutil.ColumnName(col.Name.O))
+	}
+	cols := strings.Join(keys, ",")
+	holders := strings.TrimSuffix(strings.Repeat("?,", len(keys)), ",")
+	if len(keys) > 1 {
+		cols = "(" + cols + ")"
+		holders = "(" + holders + ")"
+	}
+
+	conds := make([]string, 0, 2)
+	args := make([]interface{}, 0, len(c.lower)+len(c.upper))
+	if c.lower != nil {
+		conds = append(conds, fmt.Sprintf("%s > %s", cols, holders))
+		args = append(args, c.lower...)
+	}
+	if c.upper != nil {
+		conds = append(conds, fmt.Sprintf("%s <= %s", cols, holders))
+		args = append(args, c.upper...)
+	}
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// String implements fmt.Stringer, it's used to report the mismatched chunk to user.
+func (c *checksumChunk) String() string {
+	keys := make([]string, 0, len(c.table.keys))
+	for _, col := range c.table.keys {
+		keys = append(keys, col.Name.O)
+	}
+	bound := func(vals []interface{}, unbounded string) string {
+		if vals == nil {
+			return unbounded
+		}
+		strs := make([]string, 0, len(vals))
+		for _, v := range vals {
+			strs = append(strs, fmt.Sprintf("%v", v))
+		}
+		return "(" + strings.Join(strs, ",") + ")"
+	}
+	return fmt.Sprintf("%s -> %s (%s) in (%s, %s]",
+		c.table.source, c.table.target, strings.Join(keys, ","), bound(c.lower, "-inf"), bound(c.upper, "+inf"))
+}
+
+// checksumValidator compares CRC32 checksums of loaded data between upstream and downstream chunk by chunk.
+// it's shared by Loader and LightningLoader, and runs after all data is loaded.
+type checksumValidator struct {
+	cfg      *config.SubTaskConfig
+	logger   log.Logger
+	timeZone string
+	// isTiDB and consistency are resolved by init.
+	isTiDB      bool
+	consistency string
+
+	stage          atomic.String
+	checkedChunks  atomic.Int64
+	totalChunks    atomic.Int64
+	atDumpSnapshot atomic.Bool
+
+	mu         sync.Mutex
+	mismatches []string
+
+	checkpoint *checksumCheckpoint
+}
+
+func newChecksumValidator(cfg *config.SubTaskConfig, logger log.Logger, timeZone string) *checksumValidator {
+	return &checksumValidator{
+		cfg:        cfg,
+		logger:     logger.WithFields(zap.String("component", "checksum validator")),
+		timeZone:   timeZone,
+		checkpoint: newChecksumCheckpoint(cfg),
+	}
+}
+
+// fillStatus fills the checksum part of load status.
+func (v *checksumValidator) fillStatus(s *pb.LoadStatus) {
+	s.ChecksumStage = v.stage.Load()
+	s.ChecksumCheckedChunks = v.checkedChunks.Load()
+	s.ChecksumTotalChunks = v.totalChunks.Load()
+	s.ChecksumAtDumpSnapshot = v.atDumpSnapshot.Load()
+	v.mu.Lock()
+	s.ChecksumMismatchChunks = append([]string(nil), v.mismatches...)
+	v.mu.Unlock()
+}
+
+func (v *checksumValidator) reset() {
+	v.stage.Store(checksumStageRunning)
+	v.checkedChunks.Store(0)
+	v.totalChunks.Store(0)
+	v.atDumpSnapshot.Store(false)
+	v.mu.Lock()
+	v.mismatches = nil
+	v.mu.Unlock()
+}
+
+// restore restores the status from the persisted result.
+func (v *checksumValidator) restore(r *checksumResult) {
+	skipped := r.result == checksumResultSkipped || r.result == checksumResultUnchecked
+	stage := checksumStageFinished
+	if skipped {
+		stage = checksumStageSkipped
+	}
+	v.stage.Store(stage)
+	v.checkedChunks.Store(r.totalChunks)
+	v.totalChunks.Store(r.totalChunks)
+	v.atDumpSnapshot.Store(!skipped)
+	v.mu.Lock()
+	v.mismatches = r.mismatches
+	v.mu.Unlock()
+}
+
+// currentResult returns the result to persist after run.
+func (v *checksumValidator) currentResult(result string, mismatchCount int) *checksumResult {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return &checksumResult{
+		result:        result,
+		totalChunks:   v.totalChunks.Load(),
+		mismatchCount: mismatchCount,
+		mismatches:    append([]string(nil), v.mismatches...),
+	}
+}
+
+func (v *checksumValidator) addMismatch(chunk *checksumChunk) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if len(v.mismatches) < maxReportedMismatchChunks {
+		v.mismatches = append(v.mismatches, chunk.String())
+	}
+}
+
+// init resolves the upstream server type and the consistency of dump, and checks whether the upstream can be
+// read at the dump's snapshot.
+func (v *checksumValidator) init(ctx context.Context) error {
+	fromDB, err := conn.DefaultDBProvider.Apply(&v.cfg.From)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeUpstream)
+	}
+	defer fromDB.Close()
+	version, err := dbutil.ShowVersion(ctx, fromDB.DB)
+	if err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	return v.resolveConsistency(version)
+}
+
+// resolveConsistency resolves the consistency of dump in the same way as dumpling. TiDB can be read at the dump's
+// snapshot TSO. MySQL can't read historical data, so the dump's snapshot can only be taken again under the lock
+// of consistency flush or lock, see startSnapshotAtDump.
+func (v *checksumValidator) resolveConsistency(version string) error {
+	consistency, err := dumpling.ParseConsistency(&v.logger, v.cfg.MydumperConfig.ExtraArgs)
+	if err != nil {
+		v.logger.Warn("parsed some unsupported arguments", zap.Error(err))
+	}
+	v.isTiDB = checker.IsTiDBFromVersion(version)
+	server := "MySQL"
+	if v.isTiDB {
+		server = "TiDB"
+	}
+	if consistency == export.ConsistencyTypeAuto {
+		consistency = export.ConsistencyTypeFlush
+		if v.isTiDB {
+			consistency = export.ConsistencyTypeSnapshot
+		}
+	}
+	switch {
+	case v.isTiDB && consistency == export.ConsistencyTypeSnapshot:
+	case !v.isTiDB && (consistency == export.ConsistencyTypeFlush || consistency == export.ConsistencyTypeLock):
+	default:
+		return terror.ErrLoadUnitChecksumConsistency.Generate(consistency, server)
+	}
+	v.consistency = consistency
+	return nil
+}
+
+// validate connects to upstream and validates the data loaded to toDB, see run for details. The result is persisted
+// in downstream, so the validation is not done again after the task is resumed, except for a mismatch which may be
+// fixed by user before resuming. A skipped validation returns an error to pause the task, so user knows the data is
+// not validated, until it's accepted by `handle-error skip`.
+func (v *checksumValidator) validate(ctx context.Context, toDB *conn.BaseDB, metaBinlog string) error {
+	saved, err := v.loadResult(ctx, toDB)
+	if err != nil {
+		return err
+	}
+	if saved != nil && saved.result != checksumResultMismatch {
+		v.logger.Info("checksum validation has been done", zap.String("result", saved.result))
+		v.restore(saved)
+		if saved.result == checksumResultSkipped {
+			return terror.ErrLoadUnitChecksumSkipped.Generate()
+		}
+		return nil
+	}
+
+	dumpSnapshot := metaBinlog
+	if v.isTiDB {
+		ts, err2 := dumpling.ParseTiDBSnapshotTS(ctx, v.cfg.LoaderConfig.Dir, "metadata", v.cfg.ExtStorage)
+		if err2 != nil {
+			return terror.ErrParseMydumperMeta.Generate(err2, "no snapshot TSO of TiDB")
+		}
+		dumpSnapshot = strconv.FormatUint(ts, 10)
+	}
+
+	fromDB, err := conn.DefaultDBProvider.Apply(&v.cfg.From)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeUpstream)
+	}
+	defer fromDB.Close()
+	mismatchCount, err := v.run(ctx, fromDB, toDB, dumpSnapshot)
+	result := checksumResultPassed
+	switch {
+	case terror.ErrLoadUnitChecksumMismatch.Equal(err):
+		result = checksumResultMismatch
+	case err != nil:
+		return err
+	case v.stage.Load() == checksumStageSkipped:
+		result = checksumResultSkipped
+		err = terror.ErrLoadUnitChecksumSkipped.Generate()
+	}
+	// the validation may take a long time, save the result in a new connection.
+	if err2 := v.saveResult(ctx, toDB, v.currentResult(result, mismatchCount)); err2 != nil {
+		return err2
+	}
+	return err
+}
+
+// loadResult prepares the checkpoint and loads the persisted result, nil if the validation is not done yet.
+func (v *checksumValidator) loadResult(ctx context.Context, toDB *conn.BaseDB) (*checksumResult, error) {
+	cpConn, err := toDB.GetBaseConn(ctx)
+	if err != nil {
+		return nil, terror.WithScope(terror.Annotate(err, "initialize connection"), terror.ScopeDownstream)
+	}
+	defer conn.CloseBaseConnWithoutErr(toDB, cpConn)
+	if err = v.checkpoint.prepare(ctx, cpConn); err != nil {
+		return nil, err
+	}
+	return v.checkpoint.load(ctx, cpConn)
+}
+
+func (v *checksumValidator) saveResult(ctx context.Context, toDB *conn.BaseDB, r *checksumResult) error {
+	cpConn, err := toDB.GetBaseConn(ctx)
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "initialize connection"), terror.ScopeDownstream)
+	}
+	defer conn.CloseBaseConnWithoutErr(toDB, cpConn)
+	return v.checkpoint.save(ctx, cpConn, r)
+}
+
+// ignoreResult ignores the persisted mismatch or skipped validation for `handle-error skip`, so the resumed task
+// goes on without validating again.
+func (v *checksumValidator) ignoreResult(ctx context.Context, toDB *conn.BaseDB) error {
+	cpConn, err := toDB.GetBaseConn(ctx)
+	if err != nil {
+		return terror.WithScope(terror.Annotate(err, "initialize connection"), terror.ScopeDownstream)
+	}
+	defer conn.CloseBaseConnWithoutErr(toDB, cpConn)
+	if err = v.checkpoint.prepare(ctx, cpConn); err != nil {
+		return err
+	}
+	saved, err := v.checkpoint.load(ctx, cpConn)
+	if err != nil {
+		return err
+	}
+	if saved == nil {
+		return terror.ErrLoadUnitChecksumNoMismatch.Generate("not available")
+	}
+	switch saved.result {
+	case checksumResultMismatch:
+		saved.result = checksumResultIgnored
+		v.logger.Warn("checksum mismatch is ignored", zap.Int("mismatched chunks", saved.mismatchCount))
+	case checksumResultSkipped:
+		saved.result = checksumResultUnchecked
+		v.logger.Warn("skipped checksum validation is accepted, the loaded data is not validated")
+	default:
+		return terror.ErrLoadUnitChecksumNoMismatch.Generate(saved.result)
+	}
+	if err = v.checkpoint.save(ctx, cpConn, saved); err != nil {
+		return err
+	}
+	v.restore(saved)
+	return nil
+}
+
+// handleError handles `handle-error` for the load unit, only skipping a checksum mismatch or a skipped validation
+// is supported.
+func (v *checksumValidator) handleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
+	if req.Op != pb.ErrorOp_Skip || req.BinlogPos != "" {
+		return "", terror.ErrWorkerOperSyncUnitOnly.Generate(pb.UnitType_Load)
+	}
+	toDB, err := conn.DefaultDBProvider.Apply(&v.cfg.To)
+	if err != nil {
+		return "", terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer toDB.Close()
+	return "", v.ignoreResult(ctx, toDB)
+}
+
+// run validates all migrated tables of the subtask at the dump's snapshot. dumpSnapshot is the snapshot TSO of
+// TiDB or the binlog position of MySQL recorded by dump.
+//
+// TiDB is read at the snapshot TSO. For MySQL, the snapshot is started under the same lock as dump, it's identical
+// to the dump's one if the binlog position under the lock is still the dumped one. Otherwise, the upstream has
+// changed since dump and the dump's snapshot can't be read any more, so the validation is skipped, and validate
+// reports it by an error.
+// A mismatch means the loaded data is different from the dumped data, the number of mismatched chunks and an error
+// are returned to pause the task.
+func (v *checksumValidator) run(ctx context.Context, fromDB, toDB *conn.BaseDB, dumpSnapshot string) (int, error) {
+	v.reset()
+
+	fromConn, err := fromDB.DB.Conn(ctx)
+	if err != nil {
+		return 0, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	defer fromConn.Close()
+	toConn, err := toDB.DB.Conn(ctx)
+	if err != nil {
+		return 0, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+	defer toConn.Close()
+
+	// CRC32 is calculated on the string form of values, use same time zone to get same form of timestamp.
+	if _, err = fromConn.ExecContext(ctx, "SET SESSION time_zone = ?", v.timeZone); err != nil {
+		return 0, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	if _, err = toConn.ExecContext(ctx, "SET SESSION time_zone = ?", v.timeZone); err != nil {
+		return 0, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+
+	var tables []*checksumTable
+	if v.isTiDB {
+		if _, err = fromConn.ExecContext(ctx, "SET SESSION tidb_snapshot = ?", dumpSnapshot); err != nil {
+			return 0, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+		}
+		defer func() {
+			if _, err2 := fromConn.ExecContext(context.Background(), "SET SESSION tidb_snapshot = ''"); err2 != nil {
+				v.logger.Warn("fail to reset upstream snapshot", log.ShortError(err2))
+			}
+		}()
+		if tables, err = v.fetchTables(ctx, fromConn); err != nil {
+			return 0, err
+		}
+	} else {
+		// the tables are fetched before the snapshot is started to lock them like dump, their structures
+		// are the dumped ones as long as the binlog position is not changed.
+		if tables, err = v.fetchTables(ctx, fromConn); err != nil {
+			return 0, err
+		}
+		if len(tables) > 0 {
+			atDumpSnapshot, err2 := v.startSnapshotAtDump(ctx, fromDB, fromConn, tables, dumpSnapshot)
+			if err2 != nil {
+				return 0, err2
+			}
+			if !atDumpSnapshot {
+				v.stage.Store(checksumStageSkipped)
+				return 0, nil
+			}
+			defer func() {
+				if _, err2 := fromConn.ExecContext(context.Background(), "ROLLBACK"); err2 != nil {
+					v.logger.Warn("fail to rollback upstream snapshot", log.ShortError(err2))
+				}
+			}()
+		}
+	}
+	v.atDumpSnapshot.Store(true)
+
+	chunks := make([]*checksumChunk, 0, len(tables))
+	for _, table := range tables {
+		tableChunks, err2 := v.splitChunks(ctx, fromConn, table)
+		if err2 != nil {
+			return 0, err2
+		}
+		chunks = append(chunks, tableChunks...)
+		v.totalChunks.Add(int64(len(tableChunks)))
+	}
+
+	mismatchCount := 0
+	for _, chunk := range chunks {
+		where, args := chunk.where()
+		upstream, err2 := dbutil.GetCRC32Checksum(ctx, fromConn, chunk.table.source.Schema, chunk.table.source.Name, chunk.table.tableInfo, where, args)
+		if err2 != nil {
+			return 0, terror.WithScope(terror.DBErrorAdapt(err2, terror.ErrDBDriverError), terror.ScopeUpstream)
+		}
+		downstream, err2 := dbutil.GetCRC32Checksum(ctx, toConn, chunk.table.target.Schema, chunk.table.target.Name, chunk.table.tableInfo, where, args)
+		if err2 != nil {
+			return 0, terror.WithScope(terror.DBErrorAdapt(err2, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+		if upstream != downstream {
+			mismatchCount++
+			v.addMismatch(chunk)
+			v.logger.Warn("checksum mismatch", zap.Stringer("chunk", chunk),
+				zap.Int64("upstream", upstream), zap.Int64("downstream", downstream))
+		}
+		v.checkedChunks.Inc()
+	}
+	v.stage.Store(checksumStageFinished)
+	v.logger.Info("checksum validation finished", zap.Int("tables", len(tables)),
+		zap.Int("chunks", len(chunks)), zap.Int("mismatched chunks", mismatchCount))
+
+	if mismatchCount > 0 {
+		v.mu.Lock()
+		reported := strings.Join(v.mismatches, "; ")
+		v.mu.Unlock()
+		return mismatchCount, terror.ErrLoadUnitChecksumMismatch.Generate(mismatchCount, reported)
+	}
+	return 0, nil
+}
+
+// startSnapshotAtDump starts a consistent snapshot of MySQL in fromConn in the same way as dump, which holds the
+// lock of consistency flush or lock while the snapshot is started. The transactions committed before the lock are
+// already written to binlog, so the snapshot is identical to the dump's one if the binlog position under the lock
+// is still the one recorded by dump. It returns false if the upstream has changed since dump.
+func (v *checksumValidator) startSnapshotAtDump(
+	ctx context.Context, fromDB *conn.BaseDB, fromConn *sql.Conn, tables []*checksumTable, metaBinlog string,
+) (bool, error) {
+	if metaBinlog == "" {
+		v.logger.Warn("dump doesn't record binlog position, skip checksum validation")
+		return false, nil
+	}
+	lockConn, err := fromDB.DB.Conn(ctx)
+	if err != nil {
+		return false, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	defer lockConn.Close()
+
+	lockSQL := "FLUSH TABLES WITH READ LOCK"
+	if v.consistency == export.ConsistencyTypeLock {
+		names := make([]string, 0, len(tables))
+		for _, table := range tables {
+			names = append(names, dbutil.TableName(table.source.Schema, table.source.Name)+" READ")
+		}
+		lockSQL = "LOCK TABLES " + strings.Join(names, ", ")
+	}
+	if _, err = lockConn.ExecContext(ctx, lockSQL); err != nil {
+		return false, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	defer func() {
+		if _, err2 := lockConn.ExecContext(context.Background(), "UNLOCK TABLES"); err2 != nil {
+			v.logger.Warn("fail to unlock upstream tables", log.ShortError(err2))
+		}
+	}()
+
+	pos, _, err := conn.GetPosAndGs(tcontext.NewContext(ctx, v.logger), fromDB, v.cfg.Flavor)
+	if err != nil {
+		return false, terror.WithScope(err, terror.ScopeUpstream)
+	}
+	if pos.String() != metaBinlog {
+		v.logger.Warn("upstream has changed since dump, the dump's snapshot can't be read, skip checksum validation",
+			zap.String("dump position", metaBinlog), zap.Stringer("current position", pos))
+		return false, nil
+	}
+	if _, err = fromConn.ExecContext(ctx, "START TRANSACTION WITH CONSISTENT SNAPSHOT"); err != nil {
+		return false, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	return true, nil
+}
+
+// fetchTables returns the tables to validate. Tables merged from multiple upstream tables, tables
+// without primary key or not null unique key and tables whose key is changed by column mapping are skipped.
+func (v *checksumValidator) fetchTables(ctx context.Context, fromConn *sql.Conn) ([]*checksumTable, error) {
+	baList, err := filter.New(v.cfg.CaseSensitive, v.cfg.BAList)
+	if err != nil {
+		return nil, terror.ErrLoadUnitGenBAList.Delegate(err)
+	}
+	tableRouter, err := regexprrouter.NewRegExprRouter(v.cfg.CaseSensitive, v.cfg.RouteRules)
+	if err != nil {
+		return nil, terror.ErrLoadUnitGenTableRouter.Delegate(err)
+	}
+	var columnMapping *cm.Mapping
+	if len(v.cfg.ColumnMappingRules) > 0 {
+		columnMapping, err = cm.NewMapping(v.cfg.CaseSensitive, v.cfg.ColumnMappingRules)
+		if err != nil {
+			return nil, terror.ErrLoadUnitGenColumnMapping.Delegate(err)
+		}
+	}
+
+	doTables, err := utils.FetchAllDoTables(ctx, fromConn, baList)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeUpstream)
+	}
+
+	tctx := tcontext.NewContext(ctx, v.logger)
+	tables := make([]*checksumTable, 0, len(doTables))
+	targetCount := make(map[string]int)
+	for schema, tbls := range doTables {
+		for _, tbl := range tbls {
+			targetSchema, targetTable := fetchMatchedLiteral(tctx, tableRouter, schema, tbl)
+			table := &checksumTable{
+				source: &filter.Table{Schema: schema, Name: tbl},
+				target: &filter.Table{Schema: targetSchema, Name: targetTable},
+			}
+			tables = append(tables, table)
+			targetCount[table.target.String()]++
+		}
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].source.String() < tables[j].source.String()
+	})
+
+	res := make([]*checksumTable, 0, len(tables))
+	for _, table := range tables {
+		// downstream table also contains rows of other upstream tables, can't compare them by chunk.
+		routed := table.source.String() != table.target.String()
+		if targetCount[table.target.String()] > 1 || (v.cfg.IsSharding && routed) {
+			v.logger.Info("skip merged table", zap.Stringer("table", table.source), zap.Stringer("target", table.target))
+			continue
+		}
+
+		tableInfo, err2 := dbutil.GetTableInfo(ctx, fromConn, table.source.Schema, table.source.Name)
+		if err2 != nil {
+			return nil, terror.WithScope(terror.DBErrorAdapt(err2, terror.ErrDBDriverError), terror.ScopeUpstream)
+		}
+		table.keys = checksumKeys(tableInfo)
+		if len(table.keys) == 0 {
+			v.logger.Info("skip table without primary key or not null unique key", zap.Stringer("table", table.source))
+			continue
+		}
+
+		mapped := mappedColumns(columnMapping, v.cfg.CaseSensitive, table.source)
+		if keyMapped(table.keys, mapped) {
+			v.logger.Info("skip table whose key is changed by column mapping", zap.Stringer("table", table.source))
+			continue
+		}
+		columns := make([]*model.ColumnInfo, 0, len(tableInfo.Columns))
+		for _, col := range tableInfo.Columns {
+			if col.IsGenerated() || mapped[col.Name.L] {
+				continue
+			}
+			columns = append(columns, col)
+		}
+		table.tableInfo = &model.TableInfo{Name: tableInfo.Name, Columns: columns}
+		res = append(res, table)
+	}
+	return res, nil
+}
+
+// splitChunks splits the table into chunks of ChecksumChunkSize rows by its key columns.
+func (v *checksumValidator) splitChunks(ctx context.Context, fromConn *sql.Conn, table *checksumTable) ([]*checksumChunk, error) {
+	keys := make([]string, 0, len(table.keys))
+	for _, col := range table.keys {
+		keys = append(keys, dbutil.ColumnName(col.Name.O))
+	}
+	orderBy := strings.Join(keys, ",")
+
+	var (
+		chunks []*checksumChunk
+		lower  []interface{}
+	)
+	for {
+		where, args := (&checksumChunk{table: table, lower: lower}).where()
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT 1 OFFSET %d",
+			orderBy, dbutil.TableName(table.source.Schema, table.source.Name), where, orderBy, v.cfg.ChecksumChunkSize-1)
+		upper, err := queryBoundary(ctx, fromConn, query, args, len(keys))
+		if err != nil {
+			return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+		}
+		if upper == nil {
+			break
+		}
+		chunks = append(chunks, &checksumChunk{table: table, lower: lower, upper: upper})
+		lower = upper
+	}
+	return append(chunks, &checksumChunk{table: table, lower: lower}), nil
+}
+
+// queryBoundary returns the key values of the boundary row, or nil if the row doesn't exist.
+func queryBoundary(ctx context.Context, fromConn *sql.Conn, query string, args []interface{}, keyCount int) ([]interface{}, error) {
+	rows, err := fromConn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	// key columns are not null.
+	vals := make([]string, keyCount)
+	dest := make([]interface{}, keyCount)
+	for i := range vals {
+		dest[i] = &vals[i]
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	boundary := make([]interface{}, keyCount)
+	for i, val := range vals {
+		boundary[i] = val
+	}
+	return boundary, rows.Err()
+}
+
+// checksumKeys returns the primary key columns, or the first not null unique key columns if no primary key.
+func checksumKeys(tableInfo *model.TableInfo) []*model.ColumnInfo {
+	if tableInfo.PKIsHandle {
+		if pk := tableInfo.GetPkColInfo(); pk != nil {
+			return []*model.ColumnInfo{pk}
+		}
+	}
+	indexColumns := func(index *model.IndexInfo) []*model.ColumnInfo {
+		cols := make([]*model.ColumnInfo, 0, len(index.Columns))
+		for _, col := range index.Columns {
+			cols = append(cols, tableInfo.Columns[col.Offset])
+		}
+		return cols
+	}
+
+	var uk *model.IndexInfo
+	for _, index := range tableInfo.Indices {
+		if index.Primary {
+			return indexColumns(index)
+		}
+		if uk != nil || !index.Unique {
+			continue
+		}
+		notNull := true
+		for _, col := range index.Columns {
+			if !mysql.HasNotNullFlag(tableInfo.Columns[col.Offset].GetFlag()) {
+				notNull = false
+				break
+			}
+		}
+		if notNull {
+			uk = index
+		}
+	}
+	if uk != nil {
+		return indexColumns(uk)
+	}
+	return nil
+}
+
+// mappedColumns returns the lower case names of columns whose values are changed by column mapping.
+func mappedColumns(columnMapping *cm.Mapping, caseSensitive bool, table *filter.Table) map[string]bool {
+	mapped := make(map[string]bool)
+	if columnMapping == nil {
+		return mapped
+	}
+	schema, tbl := table.Schema, table.Name
+	if !caseSensitive {
+		schema, tbl = strings.ToLower(schema), strings.ToLower(tbl)
+	}
+	for _, r := range columnMapping.Match(schema, tbl) {
+		if rule, ok := r.(*cm.Rule); ok {
+			mapped[strings.ToLower(rule.TargetColumn)] = true
+		}
+	}
+	return mapped
+}
+
+func keyMapped(keys []*model.ColumnInfo, mapped map[string]bool) bool {
+	for _, col := range keys {
+		if mapped[col.Name.L] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,354 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
+	"github.com/pingcap/tidb/dumpling/export"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/filter"
+	router "github.com/pingcap/tidb/util/table-router"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func TestChecksumKeys(t *testing.T) {
+	cases := []struct {
+		createSQL string
+		keys      []string
+	}{
+		{"create table t (id int primary key, a int)", []string{"id"}},
+		{"create table t (a varchar(10), b int, c int, primary key (a, b))", []string{"a", "b"}},
+		{"create table t (a int, b int not null, c int not null, unique key (a), unique key (c, b))", []string{"c", "b"}},
+		{"create table t (a int, b int, unique key (a))", nil},
+		{"create table t (a int, b int)", nil},
+	}
+	p := parser.New()
+	for _, cs := range cases {
+		ti, err := dbutil.GetTableInfoBySQL(cs.createSQL, p)
+		require.NoError(t, err)
+		var keys []string
+		for _, col := range checksumKeys(ti) {
+			keys = append(keys, col.Name.O)
+		}
+		require.Equal(t, cs.keys, keys, cs.createSQL)
+	}
+}
+
+func TestChecksumChunkWhere(t *testing.T) {
+	ti, err := dbutil.GetTableInfoBySQL("create table t (a varchar(10), b int, primary key (a, b))", parser.New())
+	require.NoError(t, err)
+	table := &checksumTable{
+		source: &filter.Table{Schema: "db", Name: "t"},
+		target: &filter.Table{Schema: "db2", Name: "t2"},
+		keys:   checksumKeys(ti),
+	}
+
+	chunk := &checksumChunk{table: table}
+	where, args := chunk.where()
+	require.Equal(t, "TRUE", where)
+	require.Len(t, args, 0)
+
+	chunk = &checksumChunk{table: table, lower: []interface{}{"x", "1"}, upper: []interface{}{"y", "2"}}
+	where, args = chunk.where()
+	require.Equal(t, "(`a`,`b`) > (?,?) AND (`a`,`b`) <= (?,?)", where)
+	require.Equal(t, []interface{}{"x", "1", "y", "2"}, args)
+	require.Equal(t, "`db`.`t` -> `db2`.`t2` (a,b) in ((x,1), (y,2)]", chunk.String())
+
+	table.keys = table.keys[1:]
+	chunk = &checksumChunk{table: table, upper: []interface{}{"2"}}
+	where, args = chunk.where()
+	require.Equal(t, "`b` <= ?", where)
+	require.Equal(t, []interface{}{"2"}, args)
+	require.Equal(t, "`db`.`t` -> `db2`.`t2` (b) in (-inf, (2)]", chunk.String())
+}
+
+func mockChecksumTables(t *testing.T) (*conn.BaseDB, *conn.BaseDB, sqlmock.Sqlmock, sqlmock.Sqlmock) {
+	t.Helper()
+	fromDB, fromMock, err := sqlmock.New()
+	require.NoError(t, err)
+	toDB, toMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	fromMock.ExpectExec("SET SESSION time_zone = ?").WithArgs("+08:00").WillReturnResult(sqlmock.NewResult(0, 0))
+	toMock.ExpectExec("SET SESSION time_zone = ?").WithArgs("+08:00").WillReturnResult(sqlmock.NewResult(0, 0))
+	return conn.NewBaseDB(fromDB), conn.NewBaseDB(toDB), fromMock, toMock
+}
+
+func mockFetchChecksumTables(fromMock sqlmock.Sqlmock) {
+	fromMock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"Database"}).AddRow("db").AddRow("other"))
+	fromMock.ExpectQuery("SHOW FULL TABLES IN `db`").WillReturnRows(
+		sqlmock.NewRows([]string{"Tables_in_db", "Table_type"}).AddRow("t", "BASE TABLE").AddRow("no_pk", "BASE TABLE"))
+	fromMock.ExpectQuery("SHOW CREATE TABLE `db`.`no_pk`").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("no_pk", "create table no_pk (a int)"))
+	fromMock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+	fromMock.ExpectQuery("SHOW CREATE TABLE `db`.`t`").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("t", "create table t (id int primary key, name varchar(10), c int)"))
+	fromMock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+}
+
+func mockMasterStatus(fromMock sqlmock.Sqlmock, binlogPos uint32) {
+	fromMock.ExpectQuery("SHOW MASTER STATUS").WillReturnRows(
+		sqlmock.NewRows([]string{"File", "Position", "Binlog_Do_DB", "Binlog_Ignore_DB", "Executed_Gtid_Set"}).
+			AddRow("mysql-bin.000001", binlogPos, "", "", ""))
+}
+
+func mockChecksumChunks(fromMock, toMock sqlmock.Sqlmock) {
+	// split into (-inf, 2], (2, 4], (4, +inf)
+	fromMock.ExpectQuery("SELECT `id` FROM `db`.`t` WHERE TRUE ORDER BY `id` LIMIT 1 OFFSET 1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("2"))
+	fromMock.ExpectQuery("SELECT `id` FROM `db`.`t` WHERE `id` > \\? ORDER BY `id` LIMIT 1 OFFSET 1").
+		WithArgs("2").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("4"))
+	fromMock.ExpectQuery("SELECT `id` FROM `db`.`t` WHERE `id` > \\? ORDER BY `id` LIMIT 1 OFFSET 1").
+		WithArgs("4").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// column `c` is changed by column mapping, so it's excluded.
+	checksumSQL := "SELECT BIT_XOR\\(CAST\\(CRC32\\(CONCAT_WS\\(',', `id`, `name`, CONCAT\\(ISNULL\\(`id`\\), ISNULL\\(`name`\\)\\)\\)\\)AS UNSIGNED\\)\\) AS checksum FROM "
+	checksum := func(mock sqlmock.Sqlmock, table, where string, crc int64, args ...driver.Value) {
+		mock.ExpectQuery(checksumSQL + table + " WHERE " + where).WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows([]string{"checksum"}).AddRow(crc))
+	}
+	checksum(fromMock, "`db`.`t`", "`id` <= \\?", 100, "2")
+	checksum(toMock, "`db2`.`t2`", "`id` <= \\?", 100, "2")
+	checksum(fromMock, "`db`.`t`", "`id` > \\? AND `id` <= \\?", 200, "2", "4")
+	checksum(toMock, "`db2`.`t2`", "`id` > \\? AND `id` <= \\?", 201, "2", "4")
+	checksum(fromMock, "`db`.`t`", "`id` > \\?", 300, "4")
+	checksum(toMock, "`db2`.`t2`", "`id` > \\?", 300, "4")
+}
+
+func newChecksumTestConfig() *config.SubTaskConfig {
+	return &config.SubTaskConfig{
+		Flavor: "mysql",
+		BAList: &filter.Rules{DoDBs: []string{"db"}},
+		RouteRules: []*router.TableRule{
+			{SchemaPattern: "db", TablePattern: "t", TargetSchema: "db2", TargetTable: "t2"},
+		},
+		ColumnMappingRules: []*cm.Rule{
+			{PatternSchema: "db", PatternTable: "t", TargetColumn: "c", Expression: cm.AddPrefix, Arguments: []string{"x"}},
+		},
+		LoaderConfig: config.LoaderConfig{ValidateChecksum: true, ChecksumChunkSize: 2},
+		Name:         "task",
+		SourceID:     "source",
+		MetaSchema:   "dm_meta",
+	}
+}
+
+func TestChecksumValidatorRun(t *testing.T) {
+	cfg := newChecksumTestConfig()
+	metaBinlog := "(mysql-bin.000001, 1234)"
+	mismatch := "`db`.`t` -> `db2`.`t2` (id) in ((2), (4)]"
+
+	// the snapshot is started under FTWRL like dump, mismatch returns an error.
+	v := newChecksumValidator(cfg, log.L(), "+08:00")
+	require.NoError(t, v.resolveConsistency("8.0.30"))
+	require.Equal(t, export.ConsistencyTypeFlush, v.consistency)
+	fromDB, toDB, fromMock, toMock := mockChecksumTables(t)
+	mockFetchChecksumTables(fromMock)
+	fromMock.ExpectExec("FLUSH TABLES WITH READ LOCK").WillReturnResult(sqlmock.NewResult(0, 0))
+	mockMasterStatus(fromMock, 1234)
+	fromMock.ExpectExec("START TRANSACTION WITH CONSISTENT SNAPSHOT").WillReturnResult(sqlmock.NewResult(0, 0))
+	fromMock.ExpectExec("UNLOCK TABLES").WillReturnResult(sqlmock.NewResult(0, 0))
+	mockChecksumChunks(fromMock, toMock)
+	fromMock.ExpectExec("ROLLBACK").WillReturnResult(sqlmock.NewResult(0, 0))
+	mismatchCount, err := v.run(context.Background(), fromDB, toDB, metaBinlog)
+	require.True(t, terror.ErrLoadUnitChecksumMismatch.Equal(err), err)
+	require.Equal(t, 1, mismatchCount)
+	require.Contains(t, err.Error(), mismatch)
+	require.NoError(t, fromMock.ExpectationsWereMet())
+	require.NoError(t, toMock.ExpectationsWereMet())
+	s := &pb.LoadStatus{}
+	v.fillStatus(s)
+	require.Equal(t, &pb.LoadStatus{
+		ChecksumStage:          checksumStageFinished,
+		ChecksumCheckedChunks:  3,
+		ChecksumTotalChunks:    3,
+		ChecksumAtDumpSnapshot: true,
+		ChecksumMismatchChunks: []string{mismatch},
+	}, s)
+
+	// upstream has changed since dump, the validation is skipped.
+	cfg.MydumperConfig.ExtraArgs = "--consistency lock"
+	v = newChecksumValidator(cfg, log.L(), "+08:00")
+	require.NoError(t, v.resolveConsistency("8.0.30"))
+	require.Equal(t, export.ConsistencyTypeLock, v.consistency)
+	fromDB, toDB, fromMock, toMock = mockChecksumTables(t)
+	mockFetchChecksumTables(fromMock)
+	fromMock.ExpectExec("LOCK TABLES `db`.`t` READ").WillReturnResult(sqlmock.NewResult(0, 0))
+	mockMasterStatus(fromMock, 2345)
+	fromMock.ExpectExec("UNLOCK TABLES").WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = v.run(context.Background(), fromDB, toDB, metaBinlog)
+	require.NoError(t, err)
+	require.NoError(t, fromMock.ExpectationsWereMet())
+	require.NoError(t, toMock.ExpectationsWereMet())
+	s = &pb.LoadStatus{}
+	v.fillStatus(s)
+	require.Equal(t, &pb.LoadStatus{ChecksumStage: checksumStageSkipped}, s)
+}
+
+func TestChecksumValidatorRunTiDB(t *testing.T) {
+	cfg := newChecksumTestConfig()
+	mismatch := "`db`.`t` -> `db2`.`t2` (id) in ((2), (4)]"
+
+	// TiDB is read at the snapshot TSO of dump.
+	v := newChecksumValidator(cfg, log.L(), "+08:00")
+	require.NoError(t, v.resolveConsistency("5.7.25-TiDB-v6.3.0"))
+	require.True(t, v.isTiDB)
+	require.Equal(t, export.ConsistencyTypeSnapshot, v.consistency)
+	fromDB, toDB, fromMock, toMock := mockChecksumTables(t)
+	fromMock.ExpectExec("SET SESSION tidb_snapshot = ?").WithArgs("436529516445990913").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mockFetchChecksumTables(fromMock)
+	mockChecksumChunks(fromMock, toMock)
+	fromMock.ExpectExec("SET SESSION tidb_snapshot = ''").WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err := v.run(context.Background(), fromDB, toDB, "436529516445990913")
+	require.True(t, terror.ErrLoadUnitChecksumMismatch.Equal(err), err)
+	require.Contains(t, err.Error(), mismatch)
+	require.NoError(t, fromMock.ExpectationsWereMet())
+	require.NoError(t, toMock.ExpectationsWereMet())
+}
+
+func TestChecksumResolveConsistency(t *testing.T) {
+	cases := []struct {
+		extraArgs   string
+		version     string
+		consistency string
+	}{
+		{"", "8.0.30", export.ConsistencyTypeFlush},
+		{"--consistency flush", "8.0.30", export.ConsistencyTypeFlush},
+		{"--consistency lock", "5.7.38-log", export.ConsistencyTypeLock},
+		{"--consistency none", "8.0.30", ""},
+		{"--no-locks", "8.0.30", ""},
+		{"--consistency snapshot", "8.0.30", ""},
+		{"", "5.7.25-TiDB-v6.3.0", export.ConsistencyTypeSnapshot},
+		{"--consistency snapshot", "5.7.25-TiDB-v6.3.0", export.ConsistencyTypeSnapshot},
+		{"--consistency none", "5.7.25-TiDB-v6.3.0", ""},
+		{"--consistency lock", "5.7.25-TiDB-v6.3.0", ""},
+	}
+	for _, cs := range cases {
+		cfg := newChecksumTestConfig()
+		cfg.MydumperConfig.ExtraArgs = cs.extraArgs
+		v := newChecksumValidator(cfg, log.L(), "+08:00")
+		err := v.resolveConsistency(cs.version)
+		if cs.consistency == "" {
+			require.True(t, terror.ErrLoadUnitChecksumConsistency.Equal(err), cs)
+			continue
+		}
+		require.NoError(t, err, cs)
+		require.Equal(t, cs.consistency, v.consistency, cs)
+	}
+}
+
+// mockChecksumCheckpoint returns a downstream which has the persisted checksum result in rows.
+func mockChecksumCheckpoint(t *testing.T, rows *sqlmock.Rows) (*conn.BaseDB, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE SCHEMA IF NOT EXISTS `dm_meta`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `dm_meta`.`task_loader_checksum`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT `result`, `total_chunks`, `mismatch_count`, `mismatch_chunks` FROM `dm_meta`.`task_loader_checksum`").
+		WithArgs("source").WillReturnRows(rows)
+	return conn.NewBaseDB(db), mock
+}
+
+func TestChecksumCheckpoint(t *testing.T) {
+	cfg := newChecksumTestConfig()
+	mismatch := "`db`.`t` -> `db2`.`t2` (id) in ((2), (4)]"
+	columns := []string{"result", "total_chunks", "mismatch_count", "mismatch_chunks"}
+
+	// no mismatch to skip before validation.
+	v := newChecksumValidator(cfg, log.L(), "+08:00")
+	toDB, mock := mockChecksumCheckpoint(t, sqlmock.NewRows(columns))
+	err := v.ignoreResult(context.Background(), toDB)
+	require.True(t, terror.ErrLoadUnitChecksumNoMismatch.Equal(err), err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// `handle-error skip` ignores the persisted mismatch.
+	toDB, mock = mockChecksumCheckpoint(t, sqlmock.NewRows(columns).AddRow(checksumResultMismatch, 3, 1, `["`+mismatch+`"]`))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `dm_meta`.`task_loader_checksum`").
+		WithArgs("source", checksumResultIgnored, 3, 1, "[\"`db`.`t` -\\u003e `db2`.`t2` (id) in ((2), (4)]\"]").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, v.ignoreResult(context.Background(), toDB))
+	require.NoError(t, mock.ExpectationsWereMet())
+	s := &pb.LoadStatus{}
+	v.fillStatus(s)
+	require.Equal(t, &pb.LoadStatus{
+		ChecksumStage:          checksumStageFinished,
+		ChecksumCheckedChunks:  3,
+		ChecksumTotalChunks:    3,
+		ChecksumAtDumpSnapshot: true,
+		ChecksumMismatchChunks: []string{mismatch},
+	}, s)
+
+	// the resumed task doesn't validate again, nor connect to upstream.
+	v = newChecksumValidator(cfg, log.L(), "+08:00")
+	toDB, mock = mockChecksumCheckpoint(t, sqlmock.NewRows(columns).AddRow(checksumResultIgnored, 3, 1, `["`+mismatch+`"]`))
+	require.NoError(t, v.validate(context.Background(), toDB, "(mysql-bin.000001, 1234)"))
+	require.NoError(t, mock.ExpectationsWereMet())
+	s = &pb.LoadStatus{}
+	v.fillStatus(s)
+	require.Equal(t, checksumStageFinished, s.ChecksumStage)
+	require.Equal(t, []string{mismatch}, s.ChecksumMismatchChunks)
+
+	// the skipped validation pauses the resumed task again without validating.
+	v = newChecksumValidator(cfg, log.L(), "+08:00")
+	toDB, mock = mockChecksumCheckpoint(t, sqlmock.NewRows(columns).AddRow(checksumResultSkipped, 0, 0, "null"))
+	err = v.validate(context.Background(), toDB, "(mysql-bin.000001, 1234)")
+	require.True(t, terror.ErrLoadUnitChecksumSkipped.Equal(err), err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	s = &pb.LoadStatus{}
+	v.fillStatus(s)
+	require.Equal(t, &pb.LoadStatus{ChecksumStage: checksumStageSkipped}, s)
+
+	// `handle-error skip` accepts the skipped validation.
+	toDB, mock = mockChecksumCheckpoint(t, sqlmock.NewRows(columns).AddRow(checksumResultSkipped, 0, 0, "null"))
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `dm_meta`.`task_loader_checksum`").
+		WithArgs("source", checksumResultUnchecked, 0, 0, "null").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, v.ignoreResult(context.Background(), toDB))
+	require.NoError(t, mock.ExpectationsWereMet())
+	toDB, mock = mockChecksumCheckpoint(t, sqlmock.NewRows(columns).AddRow(checksumResultUnchecked, 0, 0, "null"))
+	require.NoError(t, v.validate(context.Background(), toDB, "(mysql-bin.000001, 1234)"))
+	require.NoError(t, mock.ExpectationsWereMet())
+	s = &pb.LoadStatus{}
+	v.fillStatus(s)
+	require.Equal(t, &pb.LoadStatus{ChecksumStage: checksumStageSkipped}, s)
+
+	// only a mismatch or a skipped validation can be skipped.
+	toDB, mock = mockChecksumCheckpoint(t, sqlmock.NewRows(columns).AddRow(checksumResultPassed, 3, 0, "null"))
+	err = v.ignoreResult(context.Background(), toDB)
+	require.True(t, terror.ErrLoadUnitChecksumNoMismatch.Equal(err), err)
+	require.Contains(t, err.Error(), checksumResultPassed)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// other operations of `handle-error` are not supported by load unit.
+	_, err = v.handleError(context.Background(), &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace})
+	require.True(t, terror.ErrWorkerOperSyncUnitOnly.Equal(err), err)
+}
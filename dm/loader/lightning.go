@@ -38,6 +38,7 @@ import (
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/unit"
 )
@@ -73,6 +74,9 @@ type LightningLoader struct {
 	closed         atomic.Bool
 	metaBinlog     atomic.String
 	metaBinlogGTID atomic.String
+
+	// nil if checksum validation is disabled
+	checksum *checksumValidator
 }
 
 // NewLightning creates a new Loader importing data with lightning.
@@ -119,6 +123,14 @@ func (l *LightningLoader) Type() pb.UnitType {
 	return pb.UnitType_Load
 }
 
+// HandleError handles `handle-error` for the load unit, only skipping a checksum mismatch or a skipped validation is supported.
+func (l *LightningLoader) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
+	if l.checksum == nil {
+		return "", terror.ErrWorkerOperSyncUnitOnly.Generate(l.Type())
+	}
+	return l.checksum.handleError(ctx, req)
+}
+
 // Init initializes loader for a load task, but not start Process.
 // if fail, it should not call l.Close.
 func (l *LightningLoader) Init(ctx context.Context) (err error) {
@@ -159,6 +171,12 @@ func (l *LightningLoader) Init(ctx context.Context) (err error) {
 		}
 	}
 	l.timeZone = timeZone
+	if l.cfg.ValidateChecksum {
+		l.checksum = newChecksumValidator(l.cfg, l.logger, timeZone)
+		if err = l.checksum.init(ctx); err != nil {
+			return err
+		}
+	}
 
 	for k, v := range l.cfg.To.Session {
 		if strings.ToLower(k) == "sql_mode" {
@@ -337,6 +355,12 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 	} else {
 		l.finish.Store(true)
 	}
+	// validate before cleaning dump files, so the task can be resumed to validate again.
+	if err == nil && l.finish.Load() && l.checksum != nil {
+		if err = l.checksum.validate(ctx, l.toDB, l.metaBinlog.Load()); err != nil {
+			return err
+		}
+	}
 	if err == nil && l.finish.Load() && l.cfg.Mode == config.ModeFull {
 		if err = delLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 			return err
@@ -473,6 +497,9 @@ func (l *LightningLoader) status() *pb.LoadStatus {
 		MetaBinlog:     l.metaBinlog.Load(),
 		MetaBinlogGTID: l.metaBinlogGTID.Load(),
 	}
+	if l.checksum != nil {
+		l.checksum.fillStatus(s)
+	}
 	return s
 }
 
@@ -451,6 +451,9 @@ type Loader struct {
 	metaBinlog     atomic.String
 	metaBinlogGTID atomic.String
 
+	// nil if checksum validation is disabled
+	checksum *checksumValidator
+
 	// record process error rather than log.Fatal
 	runFatalChan chan *pb.ProcessError
 
@@ -484,6 +487,14 @@ func (l *Loader) Type() pb.UnitType {
 	return pb.UnitType_Load
 }
 
+// HandleError handles `handle-error` for the load unit, only skipping a checksum mismatch or a skipped validation is supported.
+func (l *Loader) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
+	if l.checksum == nil {
+		return "", terror.ErrWorkerOperSyncUnitOnly.Generate(l.Type())
+	}
+	return l.checksum.handleError(ctx, req)
+}
+
 // Init initializes loader for a load task, but not start Process.
 // if fail, it should not call l.Close.
 func (l *Loader) Init(ctx context.Context) (err error) {
@@ -552,6 +563,12 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 		}
 	}
 	lcfg.To.Session["time_zone"] = timeZone
+	if l.cfg.ValidateChecksum {
+		l.checksum = newChecksumValidator(l.cfg, l.logger, timeZone)
+		if err = l.checksum.init(ctx); err != nil {
+			return err
+		}
+	}
 
 	hasSQLMode := false
 	for k := range l.cfg.To.Session {
@@ -768,6 +785,12 @@ func (l *Loader) Restore(ctx context.Context) error {
 		l.finish.Store(true)
 		l.logger.Info("all data files have been finished", zap.Duration("cost time", time.Since(begin)))
 		if l.checkPoint.AllFinished() {
+			// validate before cleaning dump files, so the task can be resumed to validate again.
+			if l.checksum != nil {
+				if err = l.checksum.validate(ctx, l.toDB, l.metaBinlog.Load()); err != nil {
+					return err
+				}
+			}
 			if l.cfg.Mode == config.ModeFull {
 				if err = delLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 					return err
@@ -34,6 +34,9 @@ func (l *Loader) Status(_ *binlog.SourceStatus) interface{} {
 		MetaBinlog:     l.metaBinlog.Load(),
 		MetaBinlogGTID: l.metaBinlogGTID.Load(),
 	}
+	if l.checksum != nil {
+		l.checksum.fillStatus(s)
+	}
 	go l.printStatus()
 	return s
 }
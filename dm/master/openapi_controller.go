@@ -39,7 +39,9 @@ import (
 	"github.com/pingcap/tiflow/dm/master/workerrpc"
 	"github.com/pingcap/tiflow/dm/openapi"
 	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
 // nolint:unparam
@@ -820,3 +822,311 @@ func (s *Server) convertTaskConfig(ctx context.Context, req openapi.ConverterTas
 	}
 	return task, taskCfg, nil
 }
+
+func (s *Server) startValidation(ctx context.Context, taskName string, req openapi.StartValidationRequest) error {
+	startReq := &pb.StartValidationRequest{TaskName: taskName}
+	if req.Mode != nil {
+		startReq.Mode = &pb.StartValidationRequest_ModeValue{ModeValue: string(*req.Mode)}
+	}
+	if req.StartTime != nil {
+		startReq.StartTime = &pb.StartValidationRequest_StartTimeValue{StartTimeValue: *req.StartTime}
+	}
+	if req.SourceNameList != nil {
+		startReq.Sources = *req.SourceNameList
+	}
+	resp, err := s.StartValidation(ctx, startReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
+func (s *Server) stopValidation(ctx context.Context, taskName string, req openapi.StopValidationRequest) error {
+	stopReq := &pb.StopValidationRequest{TaskName: taskName}
+	if req.SourceNameList != nil {
+		stopReq.Sources = *req.SourceNameList
+	}
+	resp, err := s.StopValidation(ctx, stopReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
+func (s *Server) getValidationStatus(ctx context.Context, taskName string, req openapi.DMAPIGetValidationStatusParams) (*openapi.GetValidationStatusResponse, error) {
+	// use invalid stage to represent all stages
+	statusReq := &pb.GetValidationStatusRequest{TaskName: taskName, FilterStatus: pb.Stage_InvalidStage}
+	if req.TableStage != nil {
+		switch *req.TableStage {
+		case "running":
+			statusReq.FilterStatus = pb.Stage_Running
+		case "stopped":
+			statusReq.FilterStatus = pb.Stage_Stopped
+		default:
+			return nil, terror.ErrOpenAPICommonError.New("table stage should be either `running` or `stopped`")
+		}
+	}
+	resp, err := s.GetValidationStatus(ctx, statusReq)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+
+	res := &openapi.GetValidationStatusResponse{
+		Validators:    make([]openapi.ValidatorStatus, 0, len(resp.Validators)),
+		TableStatuses: make([]openapi.ValidationTableStatus, 0, len(resp.TableStatuses)),
+	}
+	for _, validator := range resp.Validators {
+		validatorStatus := openapi.ValidatorStatus{
+			SourceName:          validator.Source,
+			Mode:                validator.Mode,
+			Stage:               validator.Stage.String(),
+			ValidatorBinlog:     validator.ValidatorBinlog,
+			ValidatorBinlogGtid: validator.ValidatorBinlogGtid,
+			ProcessedRowsStatus: validator.ProcessedRowsStatus,
+			PendingRowsStatus:   validator.PendingRowsStatus,
+			ErrorRowsStatus:     validator.ErrorRowsStatus,
+		}
+		// add error if some error happens
+		if validator.Result != nil && len(validator.Result.Errors) > 0 {
+			var errorMsgs string
+			for _, err := range validator.Result.Errors {
+				errorMsgs += fmt.Sprintf("%s\n", err.Message)
+			}
+			validatorStatus.ErrorMsg = &errorMsgs
+		}
+		res.Validators = append(res.Validators, validatorStatus)
+	}
+	for _, tableStatus := range resp.TableStatuses {
+		res.TableStatuses = append(res.TableStatuses, openapi.ValidationTableStatus{
+			SourceName:  tableStatus.Source,
+			SourceTable: tableStatus.SrcTable,
+			TargetTable: tableStatus.DstTable,
+			Stage:       tableStatus.Stage.String(),
+			Message:     tableStatus.Message,
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) listValidationError(ctx context.Context, taskName string, req openapi.DMAPIGetValidationErrorListParams) ([]openapi.ValidationError, error) {
+	errorReq := &pb.GetValidationErrorRequest{TaskName: taskName, ErrState: pb.ValidateErrorState_NewErr}
+	if req.ErrorState != nil {
+		switch *req.ErrorState {
+		case "all":
+			// use invalid state to represent all states
+			errorReq.ErrState = pb.ValidateErrorState_InvalidErr
+		case "ignored":
+			errorReq.ErrState = pb.ValidateErrorState_IgnoredErr
+		case "unprocessed":
+			errorReq.ErrState = pb.ValidateErrorState_NewErr
+		default:
+			return nil, terror.ErrOpenAPICommonError.New("error state should be either `all`, `ignored`, or `unprocessed`")
+		}
+	}
+	resp, err := s.GetValidationError(ctx, errorReq)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+
+	validationErrorList := make([]openapi.ValidationError, 0, len(resp.Error))
+	for _, validationErr := range resp.Error {
+		var state openapi.ValidationErrorState
+		switch validationErr.Status {
+		case pb.ValidateErrorState_IgnoredErr:
+			state = openapi.ValidationErrorStateIgnored
+		case pb.ValidateErrorState_ResolvedErr:
+			state = openapi.ValidationErrorStateResolved
+		default:
+			state = openapi.ValidationErrorStateUnprocessed
+		}
+		validationErrorList = append(validationErrorList, openapi.ValidationError{
+			Id:          validationErr.Id,
+			SourceName:  validationErr.Source,
+			SourceTable: validationErr.SrcTable,
+			SourceData:  validationErr.SrcData,
+			TargetTable: validationErr.DstTable,
+			TargetData:  validationErr.DstData,
+			ErrorType:   validationErr.ErrorType,
+			State:       state,
+			Time:        validationErr.Time,
+			Message:     validationErr.Message,
+		})
+	}
+	return validationErrorList, nil
+}
+
+func (s *Server) operateValidationError(ctx context.Context, taskName string, req openapi.OperateValidationErrorRequest) error {
+	operateReq := &pb.OperateValidationErrorRequest{TaskName: taskName}
+	switch req.Op {
+	case openapi.OperateValidationErrorRequestOpIgnore:
+		operateReq.Op = pb.ValidationErrOp_IgnoreErrOp
+	case openapi.OperateValidationErrorRequestOpResolve:
+		operateReq.Op = pb.ValidationErrOp_ResolveErrOp
+	case openapi.OperateValidationErrorRequestOpClear:
+		operateReq.Op = pb.ValidationErrOp_ClearErrOp
+	case openapi.OperateValidationErrorRequestOpRepair:
+		operateReq.Op = pb.ValidationErrOp_RepairErrOp
+	default:
+		return terror.ErrOpenAPICommonError.Generatef("op should be either `%s`, `%s`, `%s`, or `%s`",
+			openapi.OperateValidationErrorRequestOpIgnore,
+			openapi.OperateValidationErrorRequestOpResolve,
+			openapi.OperateValidationErrorRequestOpClear,
+			openapi.OperateValidationErrorRequestOpRepair)
+	}
+	operateReq.IsAllError = req.IsAllErrors != nil && *req.IsAllErrors
+	if (req.ErrorId == nil) == !operateReq.IsAllError {
+		return terror.ErrOpenAPICommonError.New("either `is_all_errors` or `error_id` should be set")
+	}
+	if req.ErrorId != nil {
+		operateReq.ErrId = *req.ErrorId
+	}
+	resp, err := s.OperateValidationError(ctx, operateReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
+func (s *Server) listShardDDLLock(ctx context.Context, taskName string, req openapi.DMAPIGetShardDDLLockListParams) ([]openapi.ShardDDLLock, error) {
+	showReq := &pb.ShowDDLLocksRequest{Task: taskName}
+	if req.SourceNameList != nil {
+		showReq.Sources = *req.SourceNameList
+	}
+	resp, err := s.ShowDDLLocks(ctx, showReq)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+
+	lockList := make([]openapi.ShardDDLLock, 0, len(resp.Locks))
+	for _, lock := range resp.Locks {
+		lockList = append(lockList, openapi.ShardDDLLock{
+			Id:       lock.ID,
+			TaskName: lock.Task,
+			Mode:     lock.Mode,
+			Owner:    lock.Owner,
+			DdlList:  lock.DDLs,
+			Synced:   lock.Synced,
+			Unsynced: lock.Unsynced,
+		})
+	}
+	return lockList, nil
+}
+
+func (s *Server) unlockShardDDLLock(ctx context.Context, taskName string, req openapi.UnlockShardDDLLockRequest) error {
+	if lockTask := utils.ExtractTaskFromLockID(req.LockId); lockTask != taskName {
+		return terror.ErrOpenAPICommonError.Generatef("lock %s does not belong to task %s", req.LockId, taskName)
+	}
+	unlockReq := &pb.UnlockDDLLockRequest{ID: req.LockId, Op: pb.UnlockDDLLockOp_SkipLock}
+	if req.Action != nil {
+		switch *req.Action {
+		case openapi.UnlockShardDDLLockRequestActionSkip:
+			unlockReq.Op = pb.UnlockDDLLockOp_SkipLock
+		case openapi.UnlockShardDDLLockRequestActionExec:
+			unlockReq.Op = pb.UnlockDDLLockOp_ExecLock
+		default:
+			return terror.ErrOpenAPICommonError.Generatef("action should be either `%s` or `%s`",
+				openapi.UnlockShardDDLLockRequestActionSkip, openapi.UnlockShardDDLLockRequestActionExec)
+		}
+	}
+	if req.ReplaceOwner != nil {
+		unlockReq.ReplaceOwner = *req.ReplaceOwner
+	}
+	if req.ForceRemove != nil {
+		unlockReq.ForceRemove = *req.ForceRemove
+	}
+	if req.SourceNameList != nil {
+		unlockReq.Sources = *req.SourceNameList
+	}
+	if req.Database != nil {
+		unlockReq.Database = *req.Database
+	}
+	if req.Table != nil {
+		unlockReq.Table = *req.Table
+	}
+	resp, err := s.UnlockDDLLock(ctx, unlockReq)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	return nil
+}
+
+func (s *Server) handleError(ctx context.Context, taskName string, req openapi.HandleErrorRequest) ([]openapi.HandleErrorSourceResult, error) {
+	handleReq := &pb.HandleErrorRequest{Task: taskName}
+	var sqlList []string
+	if req.SqlList != nil {
+		sqlList = *req.SqlList
+	}
+	switch req.Op {
+	case openapi.HandleErrorRequestOpSkip, openapi.HandleErrorRequestOpRevert, openapi.HandleErrorRequestOpList:
+		if len(sqlList) > 0 {
+			return nil, terror.ErrOpenAPICommonError.Generatef("sql_list can not be used for `%s` operation", req.Op)
+		}
+	case openapi.HandleErrorRequestOpReplace, openapi.HandleErrorRequestOpInject:
+		if len(sqlList) == 0 {
+			return nil, terror.ErrOpenAPICommonError.Generatef("sql_list must be specified for `%s` operation", req.Op)
+		}
+	default:
+		return nil, terror.ErrOpenAPICommonError.Generatef("invalid operation `%s`", req.Op)
+	}
+	switch req.Op {
+	case openapi.HandleErrorRequestOpSkip:
+		handleReq.Op = pb.ErrorOp_Skip
+	case openapi.HandleErrorRequestOpReplace:
+		handleReq.Op = pb.ErrorOp_Replace
+	case openapi.HandleErrorRequestOpRevert:
+		handleReq.Op = pb.ErrorOp_Revert
+	case openapi.HandleErrorRequestOpInject:
+		handleReq.Op = pb.ErrorOp_Inject
+	case openapi.HandleErrorRequestOpList:
+		handleReq.Op = pb.ErrorOp_List
+	}
+	handleReq.Sqls = sqlList
+	if req.BinlogPos != nil && *req.BinlogPos != "" {
+		if _, err := binlog.VerifyBinlogPos(*req.BinlogPos); err != nil {
+			return nil, err
+		}
+		handleReq.BinlogPos = *req.BinlogPos
+	}
+	if req.SourceNameList != nil {
+		handleReq.Sources = *req.SourceNameList
+	}
+	resp, err := s.HandleError(ctx, handleReq)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+
+	resultList := make([]openapi.HandleErrorSourceResult, 0, len(resp.Sources))
+	for _, sourceResp := range resp.Sources {
+		resultList = append(resultList, openapi.HandleErrorSourceResult{
+			SourceName: sourceResp.Source,
+			WorkerName: sourceResp.Worker,
+			Result:     sourceResp.Result,
+			Msg:        sourceResp.Msg,
+		})
+	}
+	return resultList, nil
+}
@@ -547,6 +547,174 @@ func (s *OpenAPIControllerSuite) TestTaskControllerWithInvalidTask() {
 	}
 }
 
+func (s *OpenAPIControllerSuite) TestValidationAndHandleErrorController() {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := setupTestServer(ctx, s.T())
+	defer func() {
+		cancel()
+		server.Close()
+	}()
+
+	// create source and task
+	worker1Name := "worker1"
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	mockWorkerClient := pbmock.NewMockWorkerClient(ctrl)
+	{
+		worker1Addr := "172.16.10.72:8262"
+		s.NoError(server.scheduler.AddWorker(worker1Name, worker1Addr))
+		worker1 := server.scheduler.GetWorkerByName(worker1Name)
+		worker1.ToFree()
+		server.scheduler.SetWorkerClientForTest(worker1Name, newMockRPCClient(mockWorkerClient))
+
+		_, err := server.createSource(ctx, openapi.CreateSourceRequest{Source: *s.testSource, WorkerName: &worker1Name})
+		s.NoError(err)
+		_, err = server.createTask(ctx, openapi.CreateTaskRequest{Task: *s.testTask})
+		s.NoError(err)
+	}
+	taskName := s.testTask.Name
+	sourceName := s.testSource.SourceName
+
+	// start and stop validation
+	{
+		s.Error(server.stopValidation(ctx, taskName, openapi.StopValidationRequest{}))
+
+		invalidStartTime := "invalid"
+		err := server.startValidation(ctx, taskName, openapi.StartValidationRequest{StartTime: &invalidStartTime})
+		s.Error(err)
+		s.Contains(err.Error(), "start-time should be in the format")
+
+		mode := openapi.StartValidationRequestModeFast
+		s.NoError(server.startValidation(ctx, taskName, openapi.StartValidationRequest{Mode: &mode}))
+		s.True(server.scheduler.ValidatorEnabled(taskName, sourceName))
+		s.Equal(config.ValidationFast, server.scheduler.GetSubTaskCfgsByTask(taskName)[sourceName].ValidatorCfg.Mode)
+
+		s.NoError(server.stopValidation(ctx, taskName, openapi.StopValidationRequest{}))
+		s.Error(server.startValidation(ctx, "not-exist-task", openapi.StartValidationRequest{}))
+	}
+
+	// get validation status
+	{
+		mockWorkerClient.EXPECT().GetWorkerValidatorStatus(gomock.Any(), gomock.Any()).Return(&pb.GetValidationStatusResponse{
+			Result: true,
+			Validators: []*pb.ValidationStatus{{
+				Task:                taskName,
+				Source:              sourceName,
+				Mode:                config.ValidationFast,
+				Stage:               pb.Stage_Stopped,
+				ProcessedRowsStatus: "insert/update/delete: 1/0/0",
+				Result:              &pb.ProcessResult{Errors: []*pb.ProcessError{{Message: "validator error"}}},
+			}},
+			TableStatuses: []*pb.ValidationTableStatus{{
+				Source:   sourceName,
+				SrcTable: "`db`.`tbl`",
+				DstTable: "`db`.`tbl`",
+				Stage:    pb.Stage_Stopped,
+			}},
+		}, nil)
+		invalidStage := openapi.DMAPIGetValidationStatusParamsTableStage("paused")
+		_, err := server.getValidationStatus(ctx, taskName, openapi.DMAPIGetValidationStatusParams{TableStage: &invalidStage})
+		s.Error(err)
+
+		resp, err := server.getValidationStatus(ctx, taskName, openapi.DMAPIGetValidationStatusParams{})
+		s.NoError(err)
+		s.Len(resp.Validators, 1)
+		s.Equal(sourceName, resp.Validators[0].SourceName)
+		s.Equal(pb.Stage_Stopped.String(), resp.Validators[0].Stage)
+		s.Equal("insert/update/delete: 1/0/0", resp.Validators[0].ProcessedRowsStatus)
+		s.Contains(*resp.Validators[0].ErrorMsg, "validator error")
+		s.Len(resp.TableStatuses, 1)
+		s.Equal("`db`.`tbl`", resp.TableStatuses[0].SourceTable)
+	}
+
+	// get and operate validation errors
+	{
+		mockWorkerClient.EXPECT().GetValidatorError(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *pb.GetValidationErrorRequest, _ ...interface{}) (*pb.GetValidationErrorResponse, error) {
+				s.Equal(pb.ValidateErrorState_IgnoredErr, req.ErrState)
+				return &pb.GetValidationErrorResponse{
+					Result: true,
+					Error: []*pb.ValidationError{{
+						Id:       "1",
+						Source:   sourceName,
+						SrcTable: "`db`.`tbl`",
+						Status:   pb.ValidateErrorState_IgnoredErr,
+					}},
+				}, nil
+			})
+		errorState := openapi.DMAPIGetValidationErrorListParamsErrorState("ignored")
+		errorList, err := server.listValidationError(ctx, taskName, openapi.DMAPIGetValidationErrorListParams{ErrorState: &errorState})
+		s.NoError(err)
+		s.Len(errorList, 1)
+		s.Equal("1", errorList[0].Id)
+		s.Equal(openapi.ValidationErrorStateIgnored, errorList[0].State)
+
+		// either is_all_errors or error_id should be set
+		req := openapi.OperateValidationErrorRequest{Op: openapi.OperateValidationErrorRequestOpResolve}
+		s.Error(server.operateValidationError(ctx, taskName, req))
+		isAllErrors := true
+		errID := uint64(1)
+		req.IsAllErrors = &isAllErrors
+		req.ErrorId = &errID
+		s.Error(server.operateValidationError(ctx, taskName, req))
+
+		mockWorkerClient.EXPECT().OperateValidatorError(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *pb.OperateValidationErrorRequest, _ ...interface{}) (*pb.OperateValidationErrorResponse, error) {
+				s.Equal(pb.ValidationErrOp_ResolveErrOp, req.Op)
+				s.Equal(uint64(1), req.ErrId)
+				s.False(req.IsAllError)
+				return &pb.OperateValidationErrorResponse{Result: true}, nil
+			})
+		req.IsAllErrors = nil
+		s.NoError(server.operateValidationError(ctx, taskName, req))
+
+		mockWorkerClient.EXPECT().OperateValidatorError(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *pb.OperateValidationErrorRequest, _ ...interface{}) (*pb.OperateValidationErrorResponse, error) {
+				s.Equal(pb.ValidationErrOp_RepairErrOp, req.Op)
+				s.True(req.IsAllError)
+				return &pb.OperateValidationErrorResponse{Result: true}, nil
+			})
+		req = openapi.OperateValidationErrorRequest{Op: openapi.OperateValidationErrorRequestOpRepair, IsAllErrors: &isAllErrors}
+		s.NoError(server.operateValidationError(ctx, taskName, req))
+	}
+
+	// shard DDL locks
+	{
+		lockList, err := server.listShardDDLLock(ctx, taskName, openapi.DMAPIGetShardDDLLockListParams{})
+		s.NoError(err)
+		s.Len(lockList, 0)
+
+		req := openapi.UnlockShardDDLLockRequest{LockId: "another-task-`db`.`tbl`"}
+		err = server.unlockShardDDLLock(ctx, taskName, req)
+		s.Error(err)
+		s.Contains(err.Error(), "does not belong to task")
+	}
+
+	// handle error
+	{
+		_, err := server.handleError(ctx, taskName, openapi.HandleErrorRequest{Op: openapi.HandleErrorRequestOpReplace})
+		s.Error(err)
+		sqlList := []string{"alter table tb add column c int"}
+		_, err = server.handleError(ctx, taskName, openapi.HandleErrorRequest{Op: openapi.HandleErrorRequestOpSkip, SqlList: &sqlList})
+		s.Error(err)
+		invalidPos := "invalid-pos"
+		_, err = server.handleError(ctx, taskName, openapi.HandleErrorRequest{Op: openapi.HandleErrorRequestOpSkip, BinlogPos: &invalidPos})
+		s.Error(err)
+
+		mockWorkerClient.EXPECT().HandleError(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *pb.HandleWorkerErrorRequest, _ ...interface{}) (*pb.CommonWorkerResponse, error) {
+				s.Equal(pb.ErrorOp_Replace, req.Op)
+				s.Equal(sqlList, req.Sqls)
+				return &pb.CommonWorkerResponse{Result: true, Source: sourceName, Worker: worker1Name}, nil
+			})
+		resultList, err := server.handleError(ctx, taskName, openapi.HandleErrorRequest{Op: openapi.HandleErrorRequestOpReplace, SqlList: &sqlList})
+		s.NoError(err)
+		s.Len(resultList, 1)
+		s.Equal(sourceName, resultList[0].SourceName)
+		s.True(resultList[0].Result)
+	}
+}
+
 func TestOpenAPIControllerSuite(t *testing.T) {
 	suite.Run(t, new(OpenAPIControllerSuite))
 }
@@ -776,6 +776,110 @@ func (s *Server) DMAPIOperateTableStructure(c *gin.Context, taskName string, sou
 	}
 }
 
+// DMAPIStartValidation start the validator of a task url is: (POST /api/v1/tasks/{task-name}/validation/start).
+func (s *Server) DMAPIStartValidation(c *gin.Context, taskName string) {
+	var req openapi.StartValidationRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err := s.startValidation(c.Request.Context(), taskName, req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIStopValidation stop the validator of a task url is: (POST /api/v1/tasks/{task-name}/validation/stop).
+func (s *Server) DMAPIStopValidation(c *gin.Context, taskName string) {
+	var req openapi.StopValidationRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err := s.stopValidation(c.Request.Context(), taskName, req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIGetValidationStatus get the validation status of a task url is: (GET /api/v1/tasks/{task-name}/validation/status).
+func (s *Server) DMAPIGetValidationStatus(c *gin.Context, taskName string, params openapi.DMAPIGetValidationStatusParams) {
+	resp, err := s.getValidationStatus(c.Request.Context(), taskName, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIGetValidationErrorList get the validation errors of a task url is: (GET /api/v1/tasks/{task-name}/validation/errors).
+func (s *Server) DMAPIGetValidationErrorList(c *gin.Context, taskName string, params openapi.DMAPIGetValidationErrorListParams) {
+	errorList, err := s.listValidationError(c.Request.Context(), taskName, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := openapi.GetValidationErrorListResponse{Total: len(errorList), Data: errorList}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIOperateValidationError operate the validation errors of a task url is: (PUT /api/v1/tasks/{task-name}/validation/errors).
+func (s *Server) DMAPIOperateValidationError(c *gin.Context, taskName string) {
+	var req openapi.OperateValidationErrorRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err := s.operateValidationError(c.Request.Context(), taskName, req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIGetShardDDLLockList get the shard DDL locks of a task url is: (GET /api/v1/tasks/{task-name}/shard_ddl_locks).
+func (s *Server) DMAPIGetShardDDLLockList(c *gin.Context, taskName string, params openapi.DMAPIGetShardDDLLockListParams) {
+	lockList, err := s.listShardDDLLock(c.Request.Context(), taskName, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := openapi.GetShardDDLLockListResponse{Total: len(lockList), Data: lockList}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPIUnlockShardDDLLock unlock a shard DDL lock of a task url is: (POST /api/v1/tasks/{task-name}/shard_ddl_locks/unlock).
+func (s *Server) DMAPIUnlockShardDDLLock(c *gin.Context, taskName string) {
+	var req openapi.UnlockShardDDLLockRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err := s.unlockShardDDLLock(c.Request.Context(), taskName, req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPIHandleError handle the binlog event which causes an error url is: (POST /api/v1/tasks/{task-name}/handle_error).
+func (s *Server) DMAPIHandleError(c *gin.Context, taskName string) {
+	var req openapi.HandleErrorRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resultList, err := s.handleError(c.Request.Context(), taskName, req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := openapi.HandleErrorResponse{Total: len(resultList), Data: resultList}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
 // DMAPIConvertTask turns task into the format of a configuration file or vice versa url is: (POST /api/v1/tasks/,).
 func (s *Server) DMAPIConvertTask(c *gin.Context) {
 	var req openapi.ConverterTaskRequest
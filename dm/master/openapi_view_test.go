@@ -1162,6 +1162,28 @@ func (s *OpenAPIViewSuite) TestTaskAPI() {
 
 	s.testSourceOperationWithTask(&source1, &task, s1)
 
+	// start and stop validation
+	validationURL := fmt.Sprintf("%s/%s/validation", taskURL, task.Name)
+	result = testutil.NewRequest().Post(validationURL+"/start").WithJsonBody(openapi.StartValidationRequest{}).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	s.True(s1.scheduler.ValidatorEnabled(task.Name, source1Name))
+	result = testutil.NewRequest().Post(validationURL+"/stop").WithJsonBody(openapi.StopValidationRequest{}).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	result = testutil.NewRequest().Get(validationURL+"/errors?error_state=resolved").GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+
+	// list shard DDL locks
+	result = testutil.NewRequest().Get(fmt.Sprintf("%s/%s/shard_ddl_locks", taskURL, task.Name)).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	var resultLockList openapi.GetShardDDLLockListResponse
+	s.NoError(result.UnmarshalBodyToObject(&resultLockList))
+	s.Equal(0, resultLockList.Total)
+
+	// handle error without replace sqls
+	handleErrorReq := openapi.HandleErrorRequest{Op: openapi.HandleErrorRequestOpReplace}
+	result = testutil.NewRequest().Post(fmt.Sprintf("%s/%s/handle_error", taskURL, task.Name)).WithJsonBody(handleErrorReq).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+
 	// stop task
 	stopTaskURL := fmt.Sprintf("%s/%s/stop", taskURL, task.Name)
 	stopTaskReq := openapi.StopTaskRequest{}
@@ -216,6 +216,19 @@ type ClientInterface interface {
 
 	DMAPIUpdateTask(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIHandleError request with any body
+	DMAPIHandleErrorWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIHandleError(ctx context.Context, taskName string, body DMAPIHandleErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetShardDDLLockList request
+	DMAPIGetShardDDLLockList(ctx context.Context, taskName string, params *DMAPIGetShardDDLLockListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIUnlockShardDDLLock request with any body
+	DMAPIUnlockShardDDLLockWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIUnlockShardDDLLock(ctx context.Context, taskName string, body DMAPIUnlockShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetTaskMigrateTargets request
 	DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -248,6 +261,27 @@ type ClientInterface interface {
 	DMAPIStopTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	DMAPIStopTask(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetValidationErrorList request
+	DMAPIGetValidationErrorList(ctx context.Context, taskName string, params *DMAPIGetValidationErrorListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIOperateValidationError request with any body
+	DMAPIOperateValidationErrorWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIOperateValidationError(ctx context.Context, taskName string, body DMAPIOperateValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIStartValidation request with any body
+	DMAPIStartValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIStartValidation(ctx context.Context, taskName string, body DMAPIStartValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetValidationStatus request
+	DMAPIGetValidationStatus(ctx context.Context, taskName string, params *DMAPIGetValidationStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIStopValidation request with any body
+	DMAPIStopValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIStopValidation(ctx context.Context, taskName string, body DMAPIStopValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) DMAPIGetClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -802,6 +836,66 @@ func (c *Client) DMAPIUpdateTask(ctx context.Context, taskName string, body DMAP
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIHandleErrorWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIHandleErrorRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIHandleError(ctx context.Context, taskName string, body DMAPIHandleErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIHandleErrorRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetShardDDLLockList(ctx context.Context, taskName string, params *DMAPIGetShardDDLLockListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetShardDDLLockListRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIUnlockShardDDLLockWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUnlockShardDDLLockRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIUnlockShardDDLLock(ctx context.Context, taskName string, body DMAPIUnlockShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIUnlockShardDDLLockRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIGetTaskMigrateTargetsRequest(c.Server, taskName, sourceName, params)
 	if err != nil {
@@ -946,6 +1040,102 @@ func (c *Client) DMAPIStopTask(ctx context.Context, taskName string, body DMAPIS
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIGetValidationErrorList(ctx context.Context, taskName string, params *DMAPIGetValidationErrorListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetValidationErrorListRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIOperateValidationErrorWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateValidationErrorRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIOperateValidationError(ctx context.Context, taskName string, body DMAPIOperateValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIOperateValidationErrorRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStartValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStartValidationRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStartValidation(ctx context.Context, taskName string, body DMAPIStartValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStartValidationRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetValidationStatus(ctx context.Context, taskName string, params *DMAPIGetValidationStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetValidationStatusRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStopValidationWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStopValidationRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIStopValidation(ctx context.Context, taskName string, body DMAPIStopValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIStopValidationRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewDMAPIGetClusterInfoRequest generates requests for DMAPIGetClusterInfo
 func NewDMAPIGetClusterInfoRequest(server string) (*http.Request, error) {
 	var err error
@@ -2317,8 +2507,19 @@ func NewDMAPIUpdateTaskRequestWithBody(server string, taskName string, contentTy
 	return req, nil
 }
 
-// NewDMAPIGetTaskMigrateTargetsRequest generates requests for DMAPIGetTaskMigrateTargets
-func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams) (*http.Request, error) {
+// NewDMAPIHandleErrorRequest calls the generic DMAPIHandleError builder with application/json body
+func NewDMAPIHandleErrorRequest(server string, taskName string, body DMAPIHandleErrorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIHandleErrorRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIHandleErrorRequestWithBody generates requests for DMAPIHandleError with any type of body
+func NewDMAPIHandleErrorRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2328,9 +2529,38 @@ func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, source
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/handle_error", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetShardDDLLockListRequest generates requests for DMAPIGetShardDDLLockList
+func NewDMAPIGetShardDDLLockListRequest(server string, taskName string, params *DMAPIGetShardDDLLockListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
 	if err != nil {
 		return nil, err
 	}
@@ -2340,7 +2570,7 @@ func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, source
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/migrate_targets", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard_ddl_locks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2352,22 +2582,8 @@ func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, source
 
 	queryValues := queryURL.Query()
 
-	if params.SchemaPattern != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schema_pattern", runtime.ParamLocationQuery, *params.SchemaPattern); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-	}
-
-	if params.TablePattern != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_pattern", runtime.ParamLocationQuery, *params.TablePattern); err != nil {
+	if params.SourceNameList != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2390,20 +2606,24 @@ func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, source
 	return req, nil
 }
 
-// NewDMAPIGetSchemaListByTaskAndSourceRequest generates requests for DMAPIGetSchemaListByTaskAndSource
-func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string, sourceName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+// NewDMAPIUnlockShardDDLLockRequest calls the generic DMAPIUnlockShardDDLLock builder with application/json body
+func NewDMAPIUnlockShardDDLLockRequest(server string, taskName string, body DMAPIUnlockShardDDLLockJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIUnlockShardDDLLockRequestWithBody(server, taskName, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewDMAPIUnlockShardDDLLockRequestWithBody generates requests for DMAPIUnlockShardDDLLock with any type of body
+func NewDMAPIUnlockShardDDLLockRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
 	if err != nil {
 		return nil, err
 	}
@@ -2413,7 +2633,7 @@ func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard_ddl_locks/unlock", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2423,16 +2643,18 @@ func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDMAPIGetTableListByTaskAndSourceRequest generates requests for DMAPIGetTableListByTaskAndSource
-func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string, sourceName string, schemaName string) (*http.Request, error) {
+// NewDMAPIGetTaskMigrateTargetsRequest generates requests for DMAPIGetTaskMigrateTargets
+func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2449,19 +2671,12 @@ func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/migrate_targets", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2471,13 +2686,134 @@ func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	queryValues := queryURL.Query()
+
+	if params.SchemaPattern != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schema_pattern", runtime.ParamLocationQuery, *params.SchemaPattern); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 	}
 
-	return req, nil
-}
+	if params.TablePattern != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_pattern", runtime.ParamLocationQuery, *params.TablePattern); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIGetSchemaListByTaskAndSourceRequest generates requests for DMAPIGetSchemaListByTaskAndSource
+func NewDMAPIGetSchemaListByTaskAndSourceRequest(server string, taskName string, sourceName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIGetTableListByTaskAndSourceRequest generates requests for DMAPIGetTableListByTaskAndSource
+func NewDMAPIGetTableListByTaskAndSourceRequest(server string, taskName string, sourceName string, schemaName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
 
 // NewDMAPIDeleteTableStructureRequest generates requests for DMAPIDeleteTableStructure
 func NewDMAPIDeleteTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string) (*http.Request, error) {
@@ -2576,10 +2912,276 @@ func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName
 		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIOperateTableStructureRequest calls the generic DMAPIOperateTableStructure builder with application/json body
+func NewDMAPIOperateTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIOperateTableStructureRequestWithBody(server, taskName, sourceName, schemaName, tableName, "application/json", bodyReader)
+}
+
+// NewDMAPIOperateTableStructureRequestWithBody generates requests for DMAPIOperateTableStructure with any type of body
+func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIStartTaskRequest calls the generic DMAPIStartTask builder with application/json body
+func NewDMAPIStartTaskRequest(server string, taskName string, body DMAPIStartTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStartTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIStartTaskRequestWithBody generates requests for DMAPIStartTask with any type of body
+func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetTaskStatusRequest generates requests for DMAPIGetTaskStatus
+func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIGetTaskStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SourceNameList != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIStopTaskRequest calls the generic DMAPIStopTask builder with application/json body
+func NewDMAPIStopTaskRequest(server string, taskName string, body DMAPIStopTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIStopTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+}
+
+// NewDMAPIStopTaskRequestWithBody generates requests for DMAPIStopTask with any type of body
+func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIGetValidationErrorListRequest generates requests for DMAPIGetValidationErrorList
+func NewDMAPIGetValidationErrorListRequest(server string, taskName string, params *DMAPIGetValidationErrorListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/errors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.ErrorState != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error_state", runtime.ParamLocationQuery, *params.ErrorState); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -2589,19 +3191,19 @@ func NewDMAPIGetTableStructureRequest(server string, taskName string, sourceName
 	return req, nil
 }
 
-// NewDMAPIOperateTableStructureRequest calls the generic DMAPIOperateTableStructure builder with application/json body
-func NewDMAPIOperateTableStructureRequest(server string, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody) (*http.Request, error) {
+// NewDMAPIOperateValidationErrorRequest calls the generic DMAPIOperateValidationError builder with application/json body
+func NewDMAPIOperateValidationErrorRequest(server string, taskName string, body DMAPIOperateValidationErrorJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIOperateTableStructureRequestWithBody(server, taskName, sourceName, schemaName, tableName, "application/json", bodyReader)
+	return NewDMAPIOperateValidationErrorRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// NewDMAPIOperateTableStructureRequestWithBody generates requests for DMAPIOperateTableStructure with any type of body
-func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIOperateValidationErrorRequestWithBody generates requests for DMAPIOperateValidationError with any type of body
+func NewDMAPIOperateValidationErrorRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2611,33 +3213,12 @@ func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "source-name", runtime.ParamLocationPath, sourceName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "schema-name", runtime.ParamLocationPath, schemaName)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "table-name", runtime.ParamLocationPath, tableName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/sources/%s/schemas/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/errors", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2657,19 +3238,19 @@ func NewDMAPIOperateTableStructureRequestWithBody(server string, taskName string
 	return req, nil
 }
 
-// NewDMAPIStartTaskRequest calls the generic DMAPIStartTask builder with application/json body
-func NewDMAPIStartTaskRequest(server string, taskName string, body DMAPIStartTaskJSONRequestBody) (*http.Request, error) {
+// NewDMAPIStartValidationRequest calls the generic DMAPIStartValidation builder with application/json body
+func NewDMAPIStartValidationRequest(server string, taskName string, body DMAPIStartValidationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIStartTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+	return NewDMAPIStartValidationRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// NewDMAPIStartTaskRequestWithBody generates requests for DMAPIStartTask with any type of body
-func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIStartValidationRequestWithBody generates requests for DMAPIStartValidation with any type of body
+func NewDMAPIStartValidationRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2684,7 +3265,7 @@ func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentTyp
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/start", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/start", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2704,8 +3285,8 @@ func NewDMAPIStartTaskRequestWithBody(server string, taskName string, contentTyp
 	return req, nil
 }
 
-// NewDMAPIGetTaskStatusRequest generates requests for DMAPIGetTaskStatus
-func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIGetTaskStatusParams) (*http.Request, error) {
+// NewDMAPIGetValidationStatusRequest generates requests for DMAPIGetValidationStatus
+func NewDMAPIGetValidationStatusRequest(server string, taskName string, params *DMAPIGetValidationStatusParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2720,7 +3301,7 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/status", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/status", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2732,8 +3313,8 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 
 	queryValues := queryURL.Query()
 
-	if params.SourceNameList != nil {
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
+	if params.TableStage != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "table_stage", runtime.ParamLocationQuery, *params.TableStage); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2756,19 +3337,19 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 	return req, nil
 }
 
-// NewDMAPIStopTaskRequest calls the generic DMAPIStopTask builder with application/json body
-func NewDMAPIStopTaskRequest(server string, taskName string, body DMAPIStopTaskJSONRequestBody) (*http.Request, error) {
+// NewDMAPIStopValidationRequest calls the generic DMAPIStopValidation builder with application/json body
+func NewDMAPIStopValidationRequest(server string, taskName string, body DMAPIStopValidationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDMAPIStopTaskRequestWithBody(server, taskName, "application/json", bodyReader)
+	return NewDMAPIStopValidationRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// NewDMAPIStopTaskRequestWithBody generates requests for DMAPIStopTask with any type of body
-func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDMAPIStopValidationRequestWithBody generates requests for DMAPIStopValidation with any type of body
+func NewDMAPIStopValidationRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2783,7 +3364,7 @@ func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tasks/%s/stop", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/stop", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2972,6 +3553,19 @@ type ClientWithResponsesInterface interface {
 
 	DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error)
 
+	// DMAPIHandleError request with any body
+	DMAPIHandleErrorWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIHandleErrorResponse, error)
+
+	DMAPIHandleErrorWithResponse(ctx context.Context, taskName string, body DMAPIHandleErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIHandleErrorResponse, error)
+
+	// DMAPIGetShardDDLLockList request
+	DMAPIGetShardDDLLockListWithResponse(ctx context.Context, taskName string, params *DMAPIGetShardDDLLockListParams, reqEditors ...RequestEditorFn) (*DMAPIGetShardDDLLockListResponse, error)
+
+	// DMAPIUnlockShardDDLLock request with any body
+	DMAPIUnlockShardDDLLockWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUnlockShardDDLLockResponse, error)
+
+	DMAPIUnlockShardDDLLockWithResponse(ctx context.Context, taskName string, body DMAPIUnlockShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUnlockShardDDLLockResponse, error)
+
 	// DMAPIGetTaskMigrateTargets request
 	DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error)
 
@@ -3004,6 +3598,27 @@ type ClientWithResponsesInterface interface {
 	DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
 
 	DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
+
+	// DMAPIGetValidationErrorList request
+	DMAPIGetValidationErrorListWithResponse(ctx context.Context, taskName string, params *DMAPIGetValidationErrorListParams, reqEditors ...RequestEditorFn) (*DMAPIGetValidationErrorListResponse, error)
+
+	// DMAPIOperateValidationError request with any body
+	DMAPIOperateValidationErrorWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateValidationErrorResponse, error)
+
+	DMAPIOperateValidationErrorWithResponse(ctx context.Context, taskName string, body DMAPIOperateValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateValidationErrorResponse, error)
+
+	// DMAPIStartValidation request with any body
+	DMAPIStartValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartValidationResponse, error)
+
+	DMAPIStartValidationWithResponse(ctx context.Context, taskName string, body DMAPIStartValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartValidationResponse, error)
+
+	// DMAPIGetValidationStatus request
+	DMAPIGetValidationStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetValidationStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetValidationStatusResponse, error)
+
+	// DMAPIStopValidation request with any body
+	DMAPIStopValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopValidationResponse, error)
+
+	DMAPIStopValidationWithResponse(ctx context.Context, taskName string, body DMAPIStopValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopValidationResponse, error)
 }
 
 type DMAPIGetClusterInfoResponse struct {
@@ -3768,6 +4383,74 @@ func (r DMAPIUpdateTaskResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIHandleErrorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandleErrorResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIHandleErrorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIHandleErrorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetShardDDLLockListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetShardDDLLockListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetShardDDLLockListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetShardDDLLockListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIUnlockShardDDLLockResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIUnlockShardDDLLockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIUnlockShardDDLLockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DMAPIGetTaskMigrateTargetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3971,6 +4654,118 @@ func (r DMAPIStopTaskResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIGetValidationErrorListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetValidationErrorListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetValidationErrorListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetValidationErrorListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIOperateValidationErrorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIOperateValidationErrorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIOperateValidationErrorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIStartValidationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIStartValidationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIStartValidationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetValidationStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetValidationStatusResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetValidationStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetValidationStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIStopValidationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIStopValidationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIStopValidationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // DMAPIGetClusterInfoWithResponse request returning *DMAPIGetClusterInfoResponse
 func (c *ClientWithResponses) DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error) {
 	rsp, err := c.DMAPIGetClusterInfo(ctx, reqEditors...)
@@ -4344,33 +5139,76 @@ func (c *ClientWithResponses) DMAPIDeleteTaskWithResponse(ctx context.Context, t
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDeleteTaskResponse(rsp)
+	return ParseDMAPIDeleteTaskResponse(rsp)
+}
+
+// DMAPIGetTaskWithResponse request returning *DMAPIGetTaskResponse
+func (c *ClientWithResponses) DMAPIGetTaskWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskResponse, error) {
+	rsp, err := c.DMAPIGetTask(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskResponse(rsp)
+}
+
+// DMAPIUpdateTaskWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateTaskResponse
+func (c *ClientWithResponses) DMAPIUpdateTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
+	rsp, err := c.DMAPIUpdateTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
+	rsp, err := c.DMAPIUpdateTask(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateTaskResponse(rsp)
+}
+
+// DMAPIHandleErrorWithBodyWithResponse request with arbitrary body returning *DMAPIHandleErrorResponse
+func (c *ClientWithResponses) DMAPIHandleErrorWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIHandleErrorResponse, error) {
+	rsp, err := c.DMAPIHandleErrorWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIHandleErrorResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIHandleErrorWithResponse(ctx context.Context, taskName string, body DMAPIHandleErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIHandleErrorResponse, error) {
+	rsp, err := c.DMAPIHandleError(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIHandleErrorResponse(rsp)
 }
 
-// DMAPIGetTaskWithResponse request returning *DMAPIGetTaskResponse
-func (c *ClientWithResponses) DMAPIGetTaskWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskResponse, error) {
-	rsp, err := c.DMAPIGetTask(ctx, taskName, params, reqEditors...)
+// DMAPIGetShardDDLLockListWithResponse request returning *DMAPIGetShardDDLLockListResponse
+func (c *ClientWithResponses) DMAPIGetShardDDLLockListWithResponse(ctx context.Context, taskName string, params *DMAPIGetShardDDLLockListParams, reqEditors ...RequestEditorFn) (*DMAPIGetShardDDLLockListResponse, error) {
+	rsp, err := c.DMAPIGetShardDDLLockList(ctx, taskName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskResponse(rsp)
+	return ParseDMAPIGetShardDDLLockListResponse(rsp)
 }
 
-// DMAPIUpdateTaskWithBodyWithResponse request with arbitrary body returning *DMAPIUpdateTaskResponse
-func (c *ClientWithResponses) DMAPIUpdateTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
-	rsp, err := c.DMAPIUpdateTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+// DMAPIUnlockShardDDLLockWithBodyWithResponse request with arbitrary body returning *DMAPIUnlockShardDDLLockResponse
+func (c *ClientWithResponses) DMAPIUnlockShardDDLLockWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUnlockShardDDLLockResponse, error) {
+	rsp, err := c.DMAPIUnlockShardDDLLockWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIUpdateTaskResponse(rsp)
+	return ParseDMAPIUnlockShardDDLLockResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
-	rsp, err := c.DMAPIUpdateTask(ctx, taskName, body, reqEditors...)
+func (c *ClientWithResponses) DMAPIUnlockShardDDLLockWithResponse(ctx context.Context, taskName string, body DMAPIUnlockShardDDLLockJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUnlockShardDDLLockResponse, error) {
+	rsp, err := c.DMAPIUnlockShardDDLLock(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIUpdateTaskResponse(rsp)
+	return ParseDMAPIUnlockShardDDLLockResponse(rsp)
 }
 
 // DMAPIGetTaskMigrateTargetsWithResponse request returning *DMAPIGetTaskMigrateTargetsResponse
@@ -4478,6 +5316,75 @@ func (c *ClientWithResponses) DMAPIStopTaskWithResponse(ctx context.Context, tas
 	return ParseDMAPIStopTaskResponse(rsp)
 }
 
+// DMAPIGetValidationErrorListWithResponse request returning *DMAPIGetValidationErrorListResponse
+func (c *ClientWithResponses) DMAPIGetValidationErrorListWithResponse(ctx context.Context, taskName string, params *DMAPIGetValidationErrorListParams, reqEditors ...RequestEditorFn) (*DMAPIGetValidationErrorListResponse, error) {
+	rsp, err := c.DMAPIGetValidationErrorList(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetValidationErrorListResponse(rsp)
+}
+
+// DMAPIOperateValidationErrorWithBodyWithResponse request with arbitrary body returning *DMAPIOperateValidationErrorResponse
+func (c *ClientWithResponses) DMAPIOperateValidationErrorWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateValidationErrorResponse, error) {
+	rsp, err := c.DMAPIOperateValidationErrorWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOperateValidationErrorResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIOperateValidationErrorWithResponse(ctx context.Context, taskName string, body DMAPIOperateValidationErrorJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateValidationErrorResponse, error) {
+	rsp, err := c.DMAPIOperateValidationError(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOperateValidationErrorResponse(rsp)
+}
+
+// DMAPIStartValidationWithBodyWithResponse request with arbitrary body returning *DMAPIStartValidationResponse
+func (c *ClientWithResponses) DMAPIStartValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartValidationResponse, error) {
+	rsp, err := c.DMAPIStartValidationWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStartValidationResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIStartValidationWithResponse(ctx context.Context, taskName string, body DMAPIStartValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartValidationResponse, error) {
+	rsp, err := c.DMAPIStartValidation(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStartValidationResponse(rsp)
+}
+
+// DMAPIGetValidationStatusWithResponse request returning *DMAPIGetValidationStatusResponse
+func (c *ClientWithResponses) DMAPIGetValidationStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetValidationStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetValidationStatusResponse, error) {
+	rsp, err := c.DMAPIGetValidationStatus(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetValidationStatusResponse(rsp)
+}
+
+// DMAPIStopValidationWithBodyWithResponse request with arbitrary body returning *DMAPIStopValidationResponse
+func (c *ClientWithResponses) DMAPIStopValidationWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopValidationResponse, error) {
+	rsp, err := c.DMAPIStopValidationWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStopValidationResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIStopValidationWithResponse(ctx context.Context, taskName string, body DMAPIStopValidationJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopValidationResponse, error) {
+	rsp, err := c.DMAPIStopValidation(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStopValidationResponse(rsp)
+}
+
 // ParseDMAPIGetClusterInfoResponse parses an HTTP response from a DMAPIGetClusterInfoWithResponse call
 func ParseDMAPIGetClusterInfoResponse(rsp *http.Response) (*DMAPIGetClusterInfoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -5438,6 +6345,97 @@ func ParseDMAPIUpdateTaskResponse(rsp *http.Response) (*DMAPIUpdateTaskResponse,
 	return response, nil
 }
 
+// ParseDMAPIHandleErrorResponse parses an HTTP response from a DMAPIHandleErrorWithResponse call
+func ParseDMAPIHandleErrorResponse(rsp *http.Response) (*DMAPIHandleErrorResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIHandleErrorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandleErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIGetShardDDLLockListResponse parses an HTTP response from a DMAPIGetShardDDLLockListWithResponse call
+func ParseDMAPIGetShardDDLLockListResponse(rsp *http.Response) (*DMAPIGetShardDDLLockListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetShardDDLLockListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetShardDDLLockListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIUnlockShardDDLLockResponse parses an HTTP response from a DMAPIUnlockShardDDLLockWithResponse call
+func ParseDMAPIUnlockShardDDLLockResponse(rsp *http.Response) (*DMAPIUnlockShardDDLLockResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIUnlockShardDDLLockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
+
 // ParseDMAPIGetTaskMigrateTargetsResponse parses an HTTP response from a DMAPIGetTaskMigrateTargetsWithResponse call
 func ParseDMAPIGetTaskMigrateTargetsResponse(rsp *http.Response) (*DMAPIGetTaskMigrateTargetsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -5702,3 +6700,144 @@ func ParseDMAPIStopTaskResponse(rsp *http.Response) (*DMAPIStopTaskResponse, err
 
 	return response, nil
 }
+
+// ParseDMAPIGetValidationErrorListResponse parses an HTTP response from a DMAPIGetValidationErrorListWithResponse call
+func ParseDMAPIGetValidationErrorListResponse(rsp *http.Response) (*DMAPIGetValidationErrorListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetValidationErrorListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetValidationErrorListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIOperateValidationErrorResponse parses an HTTP response from a DMAPIOperateValidationErrorWithResponse call
+func ParseDMAPIOperateValidationErrorResponse(rsp *http.Response) (*DMAPIOperateValidationErrorResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIOperateValidationErrorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIStartValidationResponse parses an HTTP response from a DMAPIStartValidationWithResponse call
+func ParseDMAPIStartValidationResponse(rsp *http.Response) (*DMAPIStartValidationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIStartValidationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIGetValidationStatusResponse parses an HTTP response from a DMAPIGetValidationStatusWithResponse call
+func ParseDMAPIGetValidationStatusResponse(rsp *http.Response) (*DMAPIGetValidationStatusResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetValidationStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetValidationStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIStopValidationResponse parses an HTTP response from a DMAPIStopValidationWithResponse call
+func ParseDMAPIStopValidationResponse(rsp *http.Response) (*DMAPIStopValidationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIStopValidationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
@@ -122,6 +122,15 @@ type ServerInterface interface {
 	// update a task
 	// (PUT /api/v1/tasks/{task-name})
 	DMAPIUpdateTask(c *gin.Context, taskName string)
+	// skip, replace, revert, inject or list the operations on the binlog event which causes an error
+	// (POST /api/v1/tasks/{task-name}/handle_error)
+	DMAPIHandleError(c *gin.Context, taskName string)
+	// get the un-resolved shard DDL locks of a task
+	// (GET /api/v1/tasks/{task-name}/shard_ddl_locks)
+	DMAPIGetShardDDLLockList(c *gin.Context, taskName string, params DMAPIGetShardDDLLockListParams)
+	// unlock (resolve) a shard DDL lock of a task manually
+	// (POST /api/v1/tasks/{task-name}/shard_ddl_locks/unlock)
+	DMAPIUnlockShardDDLLock(c *gin.Context, taskName string)
 	// get task source table and target table route relation
 	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/migrate_targets)
 	DMAPIGetTaskMigrateTargets(c *gin.Context, taskName string, sourceName string, params DMAPIGetTaskMigrateTargetsParams)
@@ -149,6 +158,21 @@ type ServerInterface interface {
 	// stop a task
 	// (POST /api/v1/tasks/{task-name}/stop)
 	DMAPIStopTask(c *gin.Context, taskName string)
+	// get the validation errors of a task
+	// (GET /api/v1/tasks/{task-name}/validation/errors)
+	DMAPIGetValidationErrorList(c *gin.Context, taskName string, params DMAPIGetValidationErrorListParams)
+	// ignore, resolve, clear or repair the validation errors of a task
+	// (PUT /api/v1/tasks/{task-name}/validation/errors)
+	DMAPIOperateValidationError(c *gin.Context, taskName string)
+	// enable or start the validator of a task
+	// (POST /api/v1/tasks/{task-name}/validation/start)
+	DMAPIStartValidation(c *gin.Context, taskName string)
+	// get the validation status of a task
+	// (GET /api/v1/tasks/{task-name}/validation/status)
+	DMAPIGetValidationStatus(c *gin.Context, taskName string, params DMAPIGetValidationStatusParams)
+	// stop the validator of a task
+	// (POST /api/v1/tasks/{task-name}/validation/stop)
+	DMAPIStopValidation(c *gin.Context, taskName string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -806,6 +830,79 @@ func (siw *ServerInterfaceWrapper) DMAPIUpdateTask(c *gin.Context) {
 	siw.Handler.DMAPIUpdateTask(c, taskName)
 }
 
+// DMAPIHandleError operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIHandleError(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIHandleError(c, taskName)
+}
+
+// DMAPIGetShardDDLLockList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetShardDDLLockList(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetShardDDLLockListParams
+
+	// ------------- Optional query parameter "source_name_list" -------------
+	if paramValue := c.Query("source_name_list"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source_name_list: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetShardDDLLockList(c, taskName, params)
+}
+
+// DMAPIUnlockShardDDLLock operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIUnlockShardDDLLock(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIUnlockShardDDLLock(c, taskName)
+}
+
 // DMAPIGetTaskMigrateTargets operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskMigrateTargets(c *gin.Context) {
 	var err error
@@ -1139,6 +1236,132 @@ func (siw *ServerInterfaceWrapper) DMAPIStopTask(c *gin.Context) {
 	siw.Handler.DMAPIStopTask(c, taskName)
 }
 
+// DMAPIGetValidationErrorList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetValidationErrorList(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetValidationErrorListParams
+
+	// ------------- Optional query parameter "error_state" -------------
+	if paramValue := c.Query("error_state"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "error_state", c.Request.URL.Query(), &params.ErrorState)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter error_state: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetValidationErrorList(c, taskName, params)
+}
+
+// DMAPIOperateValidationError operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIOperateValidationError(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIOperateValidationError(c, taskName)
+}
+
+// DMAPIStartValidation operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIStartValidation(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIStartValidation(c, taskName)
+}
+
+// DMAPIGetValidationStatus operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetValidationStatus(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetValidationStatusParams
+
+	// ------------- Optional query parameter "table_stage" -------------
+	if paramValue := c.Query("table_stage"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "table_stage", c.Request.URL.Query(), &params.TableStage)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter table_stage: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetValidationStatus(c, taskName, params)
+}
+
+// DMAPIStopValidation operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIStopValidation(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIStopValidation(c, taskName)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL     string
@@ -1225,6 +1448,12 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name", wrapper.DMAPIUpdateTask)
 
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/handle_error", wrapper.DMAPIHandleError)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/shard_ddl_locks", wrapper.DMAPIGetShardDDLLockList)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/shard_ddl_locks/unlock", wrapper.DMAPIUnlockShardDDLLock)
+
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/migrate_targets", wrapper.DMAPIGetTaskMigrateTargets)
 
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/schemas", wrapper.DMAPIGetSchemaListByTaskAndSource)
@@ -1243,114 +1472,146 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/stop", wrapper.DMAPIStopTask)
 
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/validation/errors", wrapper.DMAPIGetValidationErrorList)
+
+	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name/validation/errors", wrapper.DMAPIOperateValidationError)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/validation/start", wrapper.DMAPIStartValidation)
+
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/validation/status", wrapper.DMAPIGetValidationStatus)
+
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/validation/stop", wrapper.DMAPIStopValidation)
+
 	return router
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbOJLwX8Gj5z7sTEmWZDtO4qv9kMSerO+cl4o9tbe1lWMgEpSwJgEGAO3Rpvzf",
-	"r/BCEiQBkrItx5pkP+w4Igh0N/odjea3UUjTjBJEBB8dfxvxcIVSqP58lSAm3kECl4hd0owmdLmWv2eM",
-	"ZogJjNSoFeVC/hf9AdMsQaPj0Xz/+d5sb7Y3H41HYp3Jn7hgmCxHt+NRRll9+MvZy4NyHCYCLREb3d6O",
-	"Rwx9zTFD0ej4n3oR8/LncjRd/AuFQs76Jsm5QOwdlP/fhhFGkfo1QjxkOBOYktGx+hVxDmgMxAqBMGcM",
-	"EQFSNQkgNEKjsQut4xf7R07cYIKvUXsdShJMEOACityshrlZxl5BsByVsy4oTRAkctoEwQg54Mfcnknh",
-	"YIYOmJTAFNW3TU/jQKyxF+rNAtkSurEmcsfm+FkISkYLUs1pgbDG/QdD8eh49P+nFZNODYdOnex5Ox4t",
-	"GYwhgYPneavH21NoUpQzBAnWPI4FSnnffJoJ7ekMRSBjUP07YzRFYoVyPhjIj+Ur9sQ3lF3dGc6/q5f9",
-	"cN76t1K/+t3kbEFzEgWc5ixEQcHI9TXVEKCHADmklDtNs/ay6Zp/TSazrgUFXPqXkg97F1FjXSu0xVFP",
-	"MVwcJenrkLoI5ZRPSq4RkzwL+dUn9DVHmovqeysgv+pjKTmBYiTIr4KQkhgvgxgnDqLph0A+BJiANUwT",
-	"EFOWQgFWQmT8eDqNaMj3MkyWIcz2QppO/72aChwtplzARYKmcpGJnidnUM47kdNN4jxJ9pxk68OcZ5Rw",
-	"9KdE3eYYhY4DUidvMAQFulAc5GUNzWB9FNKTWGrLx/OTfqY3K/ohfiBWdlHOtegJ5nJjPqEErq1lG3ow",
-	"lH8AQQEXNAMQMDkcMDN+3IDSolKp2Pv1+XuYonM52snwJ3maXSg/pA1e5Z9EeZqBnOA2THLZBAkUBYoR",
-	"1W+ad0fHo4jmiwRVe0fydCF9ufEIcYFTKFAgqIBJwOjN0DdjTDBfoShYrAXa+KUNFtKQObDCRBwdjno9",
-	"1Nr74zahWqg0wXRTycVsp2QzXoNM9DKbehosMEnoMlgKHDn5gwlMluDt5dlJYczzjAuGYAr0qzVjh17C",
-	"eRzu709QOHsxmc/Ry8liH4aT2f7hPgzn89lsdnA8nzx/cfhyNB6RPEkkXg2XtTKRNRDdVr8EUeqzyup3",
-	"g6kN/wKTvZn83/5wWCJsvJ0Y5onklb2pfqCXqMMmwYgwQ6GgbA1uVoghBZrel4QuAeZSMUh+GgDBNrTD",
-	"KWOU/R2L1TvEudPXkSyj7A1AcmyLjdSvQSjdnta76hkItUvUlKaxeTXlS9+bqQGqzzZUE41teFyS9BYJ",
-	"49GekZj6HYBQDwpcYmGeASy3rdQauU9tSE0zzOVvhk1NPC2gunHTAYncdj+GERRwcORQj7YdAY5SYMrS",
-	"DlCaUlLk6t1IaP59eCRMKLNlJLTv84DQV87U9sHWDsODAm58kC2DL324B6R56eJvGeR3eMmUC8uWSPAH",
-	"BL428WNg8rCcky+qOR8D+ktpgC8Ey0ORM+THQgMYhCrwCPjXpB7UvPl0+uryFFy+en1+Cr6I+Rfwly84",
-	"+gIwEX+Zz38B7z9cgve/n5+DV79ffgjO3r/5dPru9P3l+OOns3evPv0D/PfpP/Qbv4Dpr5f/759G76Mo",
-	"wCRCf3wGb85/v7g8/XR6An6d/gJO3789e3/61zNC6MlrcHL626vfzy/Bm7+9+nRxevnXXMQv0sUhePPh",
-	"/PzV5Wnxb+lWudISBrV2pBYtnIkS5ew6hqvf5wMi0/L1Yi6Lqs6taiTvHjw9fTCbze6dnj6nMOoPuxIK",
-	"I3fY1REF+f2MFAlo3GVLKCpUreelx9+mB6NLhjh3PtRxynCYGlRrBUT2fNbSdVQcgLtI3sjC3pcvfOny",
-	"QTz0Yv9o3ksNw/V9rPRBeeCoO2EVrlB4FTDEVVjS5LiMoYkaAcwIOxqqHmIOMsg5ivaAW9Tvk0QZ12Hs",
-	"wbSpiXuDXh2nIKB0iDfojZOcr2oRnA626rP+nWGBuIrVNF5yAZXIlhhkFBMBuPwFCnDyDoSQaEnGAsBY",
-	"RgYMlXGpfK1Iv7WOZPjXJAgpEYg4cONfE7CmObiBRFgY1vbOYWnAl3BemZrCGkhzMwZfwn3/owP3o3vY",
-	"l/90Gpg1CdvI/p5FsKA5zQROMRc4BHwFWSTJKDWAtN7gBouVzribraEkWYOco0hG2ARAE6gCGoY54wAT",
-	"75wnJ+cgrQWn5dY0k4/WPrkY13FWs41T0/ubpY85cwX5VUYilPjnGchogsM1qGWc27H/Hxlmxq8r5GnW",
-	"FCY1SGcQBNb5mXI5O34uTIgnD2KZOfknu9aOX7nuwdGstfTlCoFisJSgDDFMIxzCJFkDo/LidkpGoxWN",
-	"gZkcXMMkR8dALSEZiqOQkojfDXqGUohJwDMYohoG82dN+N9hgtM8BTFDCESYXwH1loLh7eu7LH/r44kH",
-	"zWM/Yt6uL09XWzNDIY7XBnieL6zsXEwZaIG9B85iQKgA+k0seUKduUtVJQAlCNzgJAELpBTQHrhQkJqz",
-	"nWOwD9Hzo8ODw0n8/GU8mc/Ri8kiQvtFOlQ6mi80KvP+BGBD0ts0dsm72tY3Sojb9FAWTR9NFULZFnGV",
-	"eQ70w8ojtGzYzzzyTuWRb31c0h+t2Gq7ziWmeqIKPepTNGhYHIRqMdGGpSLqXxpUnY/B/OXzl7+4hL22",
-	"rof5XDx3D2brZi43CJpwRRWEBOjhAQihCFdBngVpWRFVB+JmJT0UJpW4GgvyTDtT5e5Y4ZdPzJ16dTP+",
-	"rPDem/J8oaZ0uYnu0ouCiJora9N9ygmRL/dpzjqzOpnIRte1wz6iF2C7VPGFclfL45i2nGl3Vukedbwz",
-	"rpJk/VmYRmLsAoU5w2LdXkY50aZKhvOk7uFp8xZjlESlZVvhKEJEO9dLJMqgxp6oNgmIGU3VEOV7xdLP",
-	"aaulRviKmAhgktAbFAUhaYP9hqYpJeC90cwXF+dAvoNjHEKdPCiJ1UsczpMghP7Ay5pYq6pipM1tTp6V",
-	"E0tMvFP/Zk0n8fh4+s54C9P/eTZ7WVSFNFDrX/UKrf2LvqnWk7uSMXwtUbtC67IkxVq8Z71mZFSnpYMG",
-	"bQCd0mGCsreM5pkjbRwl7VK33o2OMeMiSGiorYzrFRmNomizaYXOpruG5mTzCVvJEjX7uMK5hUgJtrWg",
-	"k6hllU5D1ejf3b5ezS+JYcJb6ZHSkqgoXGsAGTap12sq3rzetibGrazM5aD1qHSztRMpo7lcKiillbnW",
-	"OS7z7gUhTuA1dVgz/XtZ11fSquH2uSSxCPFd1AamJtJd+OjMAEDObyiLvDOWA+pTHhw+OxriiRYZBvfc",
-	"8qE178HB7MgVzWZFQqGzlFUNqlyVMh7peskOXaSgWhat88yoGCff6aoXtSpFB1eFaq9js6Lb3uNPyK+G",
-	"F3VcQn5VlXSMRzl3+XoGN/mwhR+jVAystgscGWqzZF2Ei391aKEOx8cq2fU7PnrUZJj3Y5Pct17pQbrq",
-	"WfqLUrRDxFXeT7pEN4y6fM+C53kJTC/PV6xyD/5lKEtwCD183CjHbGfNTDWz8baTNdAlzyYN7tCJG9Zx",
-	"FpxlA+LkHRmWd1Z2MpTSaxSkSJ8rD7Yk+j2VV1au7AJy5QlF9IaYeKj42Z26hzEKUhqhQOAUBVGRI21H",
-	"RzhFoHgszYp8s8g7W3p7xp0apyLXIP3QEDats5hQQDpgg/zKlAuqATZA+7PZ0WQ2n8z2wfzZ8ezwePZs",
-	"WIn1haBZ55bdHycJLM3FYKrfQKzjFo0vzeqkf8YHYlarR2g7qXmaDRR0qyp3g0K4wTonoTAaCIl1UG0d",
-	"ejrYpDij7+DQPiXlD/L7TN6FGmj89YGYXaxJWGGmTtndmMlHQMFmc4U6pxq7XHyGOE2uURQoD52GV4Hn",
-	"KL1TzRYXRpykcZ8U+3VnQUqDp1OVVuToyPFJrN0VCSb/oed1ILuQlMBkKaniWsI+dbtZ4XBVJsQwB8XL",
-	"G8XxrazjwPygw0SHiIhAZEMLLcwBULBAK0wiK+U25N0yQHQYFfmsE6PaCD9Guq4CXRd3PAfAZcrZB9PA",
-	"koOlDNq79lwPaGw7ZAjkZFLMYm99p1jXMgW90bRNCBvJ2q6PhyUF69vj3IymHLjoZIXvtlD52MolzKo8",
-	"4r65RF+JVlvSLk3lR1t5+tREjBNJP5brhAKMIizfgsnH2ug+vf8ak3O6/E1N9knO5TLLiKwgCVGgb90G",
-	"RXHeCpIl6q31sFxCHcMAnmcy0lFHgqp0QF/mjaIEZEm+xGTIZVu8JJShQB0yS2Yoyd+40KuGgYwhcxyt",
-	"hjl36xoxrpM//YoRCWjIUD9litKJcpSbRHA4vQp9Ligrqi+8BzbVpN4aKr87YXMjv3KHd5QEUa7CGeGY",
-	"bUVv5OatIIl0bjVOcChQpDBRkWme6gPTLNGp6OIigya+JV+WmpVKRrn37uOOG7hWBymUSl0EBZJmzVos",
-	"Q5ybepPReFQVn7gX02Z9WFpEeUPqBSs3cpe0RG9trQrvU11AXApycyelwJgxQI0ZDy9OVkrMVCg3hLuR",
-	"a92ANrrU+QQK+FqGbkWCxb2VBeRFNGZ2L86TRCJCQoZSRHTtMExUPWrFsDBJhjpuFQg92qrB7E38nbvS",
-	"ZCC3vXDoUtfphEBK4OXEHEBRnNgm6BolLV1vlJyyro7QRf5c+NUe/VcbUyMtiNJkiK4zMJga7HYFXQaF",
-	"QEzVrmib5AfGN7yC639PmIod+zP6zh34LU8Sw+9SeH0Xha1cgeTEUr4kF3HHBU3CMReIhI7TPqWjiGA0",
-	"AYXawsT4YeoAT5c7USYVZqwua5WzAch5ziSv1vcmF9RFAjmdp+5GUCaj1wizttrfmxbrB0Zht2bWAwKx",
-	"YghG9Wqzw6YlUwTTL0j6hZQYd9Ppw+LUO/P8yDm1fqN3ah8HnJGQbcYBlhLyMIA0bMECirBe2Dpv18PZ",
-	"c0kXdMUowf8ul1JzAPQHCnP1k5SHrzkkAqul3MVsWTKQfE1E7kzD+p0Wt3dRiYy6UdOimdGYlY/Ue8Ju",
-	"3hDFEZnluPguRijNvcES5o2hS7gTq2a9BsBNcBqL+UyGP8IofbjO+IJfDQ4vKp+mnVhrRLvVCrODOJzt",
-	"Hx1M9l+EzyfzOXo+gUfPDiZH4Wzx4jB69jI+mB3PJ89nh/PD/YPx7Nnh88PoILSGvzh4tj/Znx1Ei/3D",
-	"oyg6iI7nk/nzmbMlSL1+zGrxoR5UhXy+NzNaJ9ChMz2wnZx/Rxbet/k1L9MDyoShBErb0V0oLFVn6bSE",
-	"Zo/7PLmmtbzVHtnG8zR1bt3j9hK5idFgt9bi5L7shA2HdxuKHGnhnV4ImmUqe1BVPP1mLtY44wunr+0v",
-	"0tNOvaD2UYjt4vOBMX/DeqqHaoKCfx0qQz4edsbHO2sbBvKlHSN78idjcIOTKIQsKhID9eB3Mfn1nlnx",
-	"1hmnL1suqvKMdhA2AFbhhLXzfM4yFz47ITx2uOKeh9yMiCKuS7JNlqbAmDe2ZX5HCg5cwGeRG+QZ3sTG",
-	"Ebt2kLRK03TT9ElVpGynAuUuhSFbqppw1kmUNPHuOkozKR/e81J6jdgNwwJtdMBdvqW9bWFWKf/ov/VU",
-	"rdsPuu9eYgxxolri8Kt2fqqj8sJ5+bBUp/3drgoFVk3q1F1No5KHIeLcA+5mdXztucZtariA0lfhHrQB",
-	"13A1pBd/5F5ajU41XUelHeGGvwSlvdHVit47T+ZyEweF9RLUlMXwrsZdfQe9dyiZ6SuSabR1fPiLz97G",
-	"hFu9+XyrUj9CKuPkhIaOhN3JO/AhQ+TVxzNw8uGNVLksGR2P+nrqTaTxnGiXFlNiWuzp+CKmisWxUIi3",
-	"FigOYY5HR5KA6nQiQwRmeHQ8OlA/SY0vVgraKczw9Ho+Nf0bpsX0xl8qWyudRWqtVx/P6u2J1CGl1qxq",
-	"vv3ZzGT8ikJvmOlUsUTjX1wXwlR+VGcPVHcjJEX1hlnUikxtIs/TFLL16FjiAMpGSCSmgOfhCkAOat2R",
-	"BFxyq3PR6LMqGfVhr5VPkwBKDF/TaP1guLf7LLWQNsuChVz39gnvQ65oVtuKPSfhb8ctftQHzHwoS1Zd",
-	"pR6HMR1drLrIMh4dPiAYrc5ojqW1Oe8QDKvhbWG4NtmY6Tf9h4oIb7X+S5D2Ax079SGOE0yQJtt7fdqU",
-	"QQZTpHf5n63jLwu8IiZX7SKgWI0KQzCyYBjZalwffbvym/6+0p9bjHPo8MOf2I5STddG++JBG1k4DAMl",
-	"rGp59jgS5mixtmMSZrVd3kjCzMZMvxkvbCMJM97jAAmzwfNLmAXDjy1h9SbanRsZpXsFcE7JeovECQ3/",
-	"6+LDe48o1cGSc5X3/NrsFtEQqOUqqCIaNiAyPmoHOH+7fHc+CBw5sAecldAH5D5wdJDXr3qqRoV9zCzl",
-	"q7jvpW4Ol1coFE9/zRFbW0yNxSooRziY2F06dTt2fExhDRgSOdOtXHSZ1sR0cSiuIrhAqDUv2ASGz9vV",
-	"vo7ekA5JsS/YJkUH1wYfNIdU/FDE+CpG4779t5t9b8vZdvQT39zhnj8YPGVO5MnbOd0ID0ASFaWJEBB0",
-	"Y++6a8PbOmD6zTpZ6LdyJ+phyRSdOmGZ0IVqp5MT/DWv3wr3G7z6Qccgg+e9lddWGDHV97toVkACE25a",
-	"1xR9CVRCx5RTuFSHmuOeOmMHDK/mAwD7eGo8xIbsIq88jk3bpj3p0Gdlx+BDJy8aylMBYvWRkrZ96WKI",
-	"vjTOzvDE5+3YPVca/7aeCJXg3n4f1nhieshkseB9bds00p/lUElwv9tjPt6xWyzaFzM8OduiifwAm1o1",
-	"pujYU/2NjJ9bus0tLd3Q++6oCsk2E9ZPRX+6H9OcuL43dGvsya5qhqpBWJwT3WKyuHT1MAy2geL4wdnL",
-	"8YWhXeUuo6S2zlxl65sO3qp6q/64rNXuLzvcDX7anKY4oNYWc3Nesr6+OyDE1k0EhyRrt8A6/hY82w1w",
-	"640Td+SAqugypItXfcnZoewx/ab/qDJ4A5hF1Xw/PV4ZdxT4epavcB+4vLP+d6tcWr+Rv1tMquuf786j",
-	"ZVeRIRqsbLv1dKxh58WZRzkLanw2aUfYx/62s/2t8/t7WIJBwmPzwWm/e3Vphv3oucZ2OeufxcUqGKFU",
-	"VRRA/T0EXSvQw136iKdPMxVfjetlIMnzkF895um3uTe1WBfNy3SbJ9eaxbOhBqtsq9W1qkM+mss227mN",
-	"N0pPWzZzy6q29XFABxMqIiemzdzTUbQlVBW762r6Icf7l7pPz/YO9+3rAt/zaN/1pawdOucvvxNV3+Gm",
-	"OpuGxTfseY95NB+73+b+1z+n72UBHGsexhxgkuVC91Y2ulT3mS+w0l1Gqy/V6x7llIFrHCJwjRiHW2Wi",
-	"Bkq7w0aXqkBKUZmYRq2mnTyNAWz26G8RdW8A5xV3x4aZ1OJ22CPUs+64ai8v591Lx19WN/u2IevmTtf3",
-	"U+8+AJ6oPq/t7CbCNdVNZnqU+5ka9Ej73ryjujkb7G8Jnt3Rz6Z10N3Z4ptqkbdJDV+DOzaKju0ufY6w",
-	"uIRlYFDsa++303Vz/pvVTQU+2FjuzjbNfjjF3rbXXVvuLZCr7lj/3PSdKU0buu8t/X03rf1UOaKr2FrB",
-	"gK4RAThWXeoBzxdF2MfKXkU/y619kf4AM7EzfPEIudLvoZ0aQeShrzNeR1G1f/f7SqqfMgNstYr6fgnG",
-	"2Y+eYCyrqwcmGC2T5TmfK3rwFf01h6SDan07+c4oskcvjnCesegu0aY78chX9PDr8Bl1I+nuCdWYXx//",
-	"TLzNLTt3Mq7O6uzqCkgi043W/MBoLsxdNFy7WHx3qRxcS1ZWkb1eS1q/ItHdTtB/EKH8Wd3Wxd/uErd7",
-	"c/GGJW9lsdtPlv5ZhLezsuSsxHtgUZLvLRK0YUpikaALwfJQ5OynTD01mRr7O9r6SF5wwGCau78Vtfvp",
-	"+5rkcYvFN03O/JSQnxIy/z7BUp35dj9Y6hRDf5asTM/8FMWNF/9RBPHhU5RWUrAph3+uWmwtcRuazW6v",
-	"VcDeOpfyE+A/WOa79enzXb2Pq78vfrfk87CbRdaHDHdQ2ZctzXe9tn5HLzGZaxWaezbjTpr1Ki/9Lfwf",
-	"TndptHdfddHMr7nUx0fYdbGj9ebza5rvRTSFmKjW8yNJajOBWxeM+rrdRzQc3OLe9LSffs1xeDVRGnii",
-	"y1InVVewmo4ZuTwzhfZ2obrBYjWJUgsetWwbmqILbDmu+OH28+3/BQAA//8+kDDk07YAAA==",
+	"H4sIAAAAAAAC/+xdW3PbOJb+K1juPnR3SZZkOzdvzUM6dqez61wqds/s1FRWgUhIwpgCGAC0W5P1f9/C",
+	"jQRJgKRsy7HizMO0I+JycPDh4NwAfI1iusooQUTw6OhrxOMlWkH158sUMfEWErhA7JxmNKWLtfw9YzRD",
+	"TGCkSi0pF/K/6E+4ylIUHUWT/Wd7473x3iQaRGKdyZ+4YJgsoutBlFFWLf5i/OKgKIeJQAvEouvrQcTQ",
+	"lxwzlERH/9CdmMqfitJ09k8UC9nqqzTnArG3UP5/k0aYJOrXBPGY4UxgSqIj9SviHNA5EEsE4pwxRARY",
+	"qUYAoQmKBr5hHT3ff+odG0zxJWr2Q0mKCQJcQJGb3jA33bg9CJajotUZpSmCRDabIpggD/2Yuy2pMZii",
+	"PRolcIWq06ab8QysNheqph1sQd1AM7llcsIQghJo05VG2lQ45f6DoXl0FP37qATpyCB05IXn9SBaMDiH",
+	"BPZu57Uu7zahWVG0ME2xxjgWaMW72tMgdJszHIGMQfXvjNEVEkuU895EfiiquA1fUXZxYzr/piqH6bwO",
+	"T6Wu+s3W2YzmJJlymrMYTS2Qq32qIkAXAbJIse40z5rdrtb8Szoct3Uo4CLclfzY2Ykq6+uhuRx1E/2X",
+	"o2R9lVIfo7zrk5JLxCRmIb/4iL7kiIvm3ArIL7ogJRtQQIL8YhpTMseL6RynHqbpj0B+BJiANVylYE7Z",
+	"CgqwFCLjR6NRQmO+l2GyiGG2F9PV6F/LkcDJbMQFnKVoJDsZ6nZyBmW7Q9nccJ6n6Z6XbV0j5xklHH2X",
+	"Q3cRo4bjodSLDYagQGcKQUFoaIB1cUg34oitEOaH3aA3PYYpviMo+zjn6/QYczkxH1EK1063NTkYyz+A",
+	"oIALmgEImCwOmCk/qFHpcKkQ7N3y/B1coVNZ2gv443yVnSk9pEleqZ8k+SoDOcFNmmS3KRIomSogqt80",
+	"dqOjKKH5LEXl3JF8NUNMdou4wCso0FRQAdMpo1d9a84xwXyJkulsLdDGlTboSFPmGRUm4ulh1KmhVuoP",
+	"moxqDKVOpp9LPrCdkM2wBpnoBJv6Op1hktLFdCFw4sUHE5gswOvzN8d2M88zLhiCK6CrVjY79AJO5vH+",
+	"/hDF4+fDyQS9GM72YTwc7x/uw3gyGY/HB0eT4bPnhy+iQUTyNIWzhspabpEVEv27fkGilGflrt9Opt74",
+	"Z5jsjeX/9vvTkmCj7cxhnoroKNob6Q+6iyptkowEMxQLytbgaokYUqTpeUnpAmAuBYPEUw8KtiEdThij",
+	"7G9YLN8izr26joSM2m8AkmUbMFK/TmOaeOqqbyDWKlF9NQ1M1RVfhGquDFFde0PZ0MClx7eSXiNhNNo3",
+	"ZE7DCkCsC019y8J8A1hOWyE18pDYGER9Vf662VQfp0NU+9i0QSKnPTzCBArY23KotOszcJQAk630EZrR",
+	"QPfePgiN37sfhG5324M4W0KWHB+fntL44g7H4Da79SEolesuiS/0we2TrXWeOyVcN7lt8qUaeoc8L6yU",
+	"LZP8Fi+Y0sLZAgl+h8RXGr6PkdwtcvJZ2eZ9UH8udYgzwfJY5AyFR6EJnMbKdpryL2nVLnv18eTl+Qk4",
+	"f/nr6Qn4LCafwU+fcfIZYCJ+mkx+Bu/en4N3f5yegpd/nL+fvnn36uPJ25N354MPH9+8ffnx7+C/T/6u",
+	"a/wMRr+c/9s/zNaFkikmCfrzE3h1+sfZ+cnHk2Pwy+hncPLu9Zt3J395Qwg9/hUcn/z28o/Tc/Dq95cf",
+	"z07O/5KL+fPV7BC8en96+vL8xP5baoY+z4oZWtPYTGZeX4/S1z3F1e+THsZ1Ud225XA1MFV/hSlOlF6l",
+	"VLA7XPC1lreNubK7rnWjWaNtTsRvMB4D7dBKutQFKdu4bcpCrdYY4nQxqI/Hy5+ao/nOQykH4/H41qGU",
+	"3yFJUqTQEnSgGCMsox43QkY5ln+CnKNEGqArKOKlMbwAukREDJThU9oSVzhNwQwBmGUp1pVkAVURJbqO",
+	"32T7P2WyTbTldnB0sP9s7OMUzRSfSL6SHOAXOIskU7IUxkj9Jb2A0SDCRHFhECmL6pOnJceX2svs0rpC",
+	"aXYNIv4lLWrWrNcvKZBfNOdma2AoBJAkQJNWMi0alKBuyrA22NKse97vRPY4LVoHIpd28jZlUKjPxkiM",
+	"oemx7G35ZsysFnFos8k9370uTLtTuFULIgaKSt8wTylMuv14KYWJ34/X4lYLG64rJKDxv3iH73wvXEiN",
+	"QhmjC4Z4ALfK8dWfphpLGx42tz2n6+pQPIT7WF4L691WeIfir70EvQyMdXLDYKlL3r9XEgW1R0DiJYov",
+	"puXaqIl8hoaqBCiAWw6r/Ig5yCDnKNkDfsXrNl75QZXGjpHW9eJOL6qWuwiobT7oRZ2nOV9WXILae1dt",
+	"9W8MC8TVFqfHZTc8NYKMYiIAl79AAY7fghgSvZKxAHAuEJNcto5OWc2M3yOvvqQywCMQCew2a5qDK0iE",
+	"M8Jo0K73g8/xpFT8rW4ulf8B+Bzvhz8d+D/dQtv/T6+6vyZxc7B/ZAm0PKeZwCvMBY4Bl/4TyUYpAeSe",
+	"Aq6wWOoQrpkaStK13pCvlogAaDyfgMZxzrgM4IXaPD4+BauKt7OYmvpW4MxTC3BrunxQO9NOT5+vEifW",
+	"Ia4HUU77AFiCAJ4DzKcwTaeqEJfrdg5Tjnr6NyuVK4tBtVJfDVdLJJbIpQXANAWmbzpvh7jW7KotMpRB",
+	"LFfJUIJBTzqjV2DO6KqMBAgKEnpF9L+0Rloo9IAvaZ4mUidlOSESWoNCfcQLQpnZpWmqcmDiFEEWDUzP",
+	"HsWxnwrmSfLYRrrV7W2EDznzRQfKUIZkCMkzkNEUx2tQCVU3gwZ/ZpihKlTGdZioQlCLY6wDO0V3LjAt",
+	"LgMBFBenRCB2CdNKvwdPx42uz5cI2MISNhlimCY4hmm6BmZrmzdjOXpYyQCYxiW8cnQEVBdScHAUU5Lw",
+	"m1HP0ApiMuUZjFFlBJMndfrfYoJX+QrMGZIhKH4BVC1Fw+tfb9L9dQgTdxoAv8eAX1eAr9JnhmI8Xxvi",
+	"eT5zwnpzykCD7D3wZg4IFUDXxBITksYUCsQFoAQVFnCutKMzRalJCjkC+xA9e3p4cDicP3sxl3HU58NZ",
+	"gvZtHFVa/c/1UCbdkcPaSm/y2Lfe1bS+Uou4yQ+luahvxaJsLnEVsp7qj17b6kcAeqcC0NchlHRbpa7Y",
+	"rtnkOu2yNDGrTdR4aDOo9DLRG0vJ1J9qXJ0MwOTFsxc/+xZ7pd8A+HyYuwXY2sHlJ0EzzqZPSoLunoBY",
+	"+tymeTZdFanUQXVNlQV5ppXmYnYcMzu0zL1ydTN8luPeG/F8ppr0jCqQs2mZqFFZae5jofC1S84qWL0g",
+	"cofrm+EQ0y3ZPlF8psySwqHYXGfqu055VW5Gx0/YHfuoueXOUJwzLNbNbpSxZNJrOU+rGp7e3uYYpUmx",
+	"sy1xkiCijagFEoXx6jZUaUSr68LqXnPtqq2LpZqbAjEhjQ96hZJpTJpkv6KrFSXgnZHMZ2enQNbBcxxD",
+	"7STq61QdRJyn0xiGDWynYS2qbEkXbV7MyoblSIJN/+Y0J8fx4eSt0RZG//Nk/ML8XR9ad68XaB3u9FXZ",
+	"n5yVjOFLObQLtC5yWZ3OO/qrW8BVXnp40CTQuzrchIjmBkKGxmZLtJmubPRUlq0jKUnSZjZ9JyS01HZj",
+	"hvxiOBl+TmaTz3ufxWzy2bv9eNOlSgLl9wHIEOfWx0CZ43GoetvKUr6u6BXRQt3rN0GeTQemDMFkDfR3",
+	"s1I3Wygq0TjoNM9JqOsMEeVIEVT1foO+ayhTIrekxnDecmVQTnrBDoe8INwwWbxmNM880ZIbgWiOGRfT",
+	"lMZaqWmfrE1mQaVMdE3BDVlrWq/wsDaQ3kwtsslrC0L/7jctNvAzKeee3nAwB7p6RaMw1ZvKi7FiSu2s",
+	"p18r50jbLIKCLJf7oVICeNUj1YuEeQovqUd50r8X508KXtWsDJ9MsB4lH7eBObvjP6Djay2DnF9RlgRb",
+	"LApUmzw4fPK0j+FjHVr+timrbDwHB+OnPudJZv1XrUeuVKFSMy7M37ZKrqUsF6qjQLWGiW25ZpTRO1Aj",
+	"v/qdXtJK7maHwzpz3KwU7RUFlyEXNwaec8SCY5MfG+NjlIqep0KmnsCX6bK6hO2/WqRQi55dTkSLnq1L",
+	"Dfsp2y7LQ/0VBovP5d+dPK31b67czHJrvWLUZ+pYzPOCmE7Ml1C5BX4ZylIcwwCOa+H1ppNWF7AWcroG",
+	"+mieia55ZOKG5418wXovdgRkovUEEkMreommKyTgRjuJrqfCVcpymkGuFO8yklH87I8IwjmaSq1nKvAK",
+	"TRPrkm8a49LHbj/LbUXWtOEsR26PebSdLBnJQkWkhzYoXdiygAoFVAjaH4+fDseT4XgfTJ4cjQ+Pxk/6",
+	"HQVUc1ZG2IIz51fWL4uKRluvhQ2r0aUl5MofPEOIWAWkDBPIcFuepk7EyfxzDreXlNTC7pLTEgfW90YZ",
+	"KAd9g/He1azRrHWh3Z41cuA0F73XyhXE2rmhUUqz6oJ5wvuPrAccbzs+b9eVLOWmVZOvsp47g3PccIMT",
+	"Pr03KZkV0ZMSJ2HKSb7xyBWbuduyxrp2tbATsktHOlMFjYHXc2RnaxKXI1PZXv6RyU9A0eYCUvbkozkn",
+	"1lUyVSYdjS+mgZSu1n3ZnoT3ssafsdSWGWdPtqtxevfekh0tMQg5an9mnPHP6nY9g51JTmCykFzhAa+N",
+	"zf64WuJ4WTjsMQe28kbuk0ZUpGf8QjR1uhgRMRVZ34Q/E6CeztASk8QJCfSpW3gUPFqI/NY6okqJ8Ih0",
+	"fp/KD+47Jl2lPw+cdbCQXp62OdcFatMOGQKO49Gd+s6jXYVrqdP94jLCHWRl1gf9ghbV6fFORn0d+Pjk",
+	"+HvcRRWClW8xqzS928Y6Qgc3mivt3GQgNoVnSEzMcSr5x3LtgYJJonLfYfqhUrpL7v+KySld/KYa+yjb",
+	"8m3LiCwhidFUXyc0tUd2lpAsUGfOoWNDaAUM8DzLKBNKk1MpbKpZkCQpyNJ8gUmfW4R0LtRUJcFIMBTs",
+	"r/aui4GMIZMuo4p5Z+sSMa69hd2CEQlo2FAZf5SshvJbIwLusZLU8LmgzGYBBgPKZaPBXN6wOlEPCfha",
+	"oWSa5Mr+FZ7WlvRKTt5S5berSFmKY4ESNRLHVihPNdgT2pr5fsNBCpmp36SRmLiCa9lpTKmURVAgua05",
+	"nVWjDU5IosVK6edHU9qQquA4027ix+o8caf8QSt9rLBYyPWZlAvGlAGqzKD/kUUlxMy5xdrirjnnN+CN",
+	"PgB5DAX8FXJUeOT8U2kpt+Z71azEJGZohYg+WQFTdUqtBCxM076KW0lCh7Sqgb0+fu+s1AHk3y88stQX",
+	"PRVILXjZMAdQWKs2RZcobch6I+TU7tpsTf1s9eqA/KuUqbAWJKu0j6wzNJiTmc1M7gwKgZjKrdN7UpiY",
+	"UPGSrv89Zsps7Q4BeWfgtzxNDd7l4g3dgOQ4lyQSi/UlUcQ9N88QjrlAJPZkIygZRQSjKbBiCxOjh6kE",
+	"A52OSZkUmHN1C0XRGoCc50xitTo3uaA+FsjmAnmBgjJpvSaYNcX+3sj2PzUCu9GyLjAVS4ZgUs2GPazv",
+	"ZIphuoLkX0yJUTf9GdmrYMuTp96m8apX0yEEvCEx2wwBjhAKAEBubNOZzJSpDqCZr+u2JVXQJaME/6vo",
+	"SrUB0J8oztVPcj18ySERWHXlT7bN0p7sqw/kxjysnnT3axflkpGFmjwzErPUkTozgEwNYWOqZQUROi6t",
+	"JPcGXZgafbvwe+JNfzWC6+TUOgttGWELo9DhWu0LftHbvCh1muCpWmvtlj2MD+bxeP/pwXD/efxMZvY9",
+	"G8KnTw6GT+Px7Plh8uTF/GAsM/vGh5PD/YPB+Mnhs8PkIHaKPz94sj/cHx8ks/3Dp0lykBxNhhP/Wdla",
+	"fmtJhf5QJhqHappTwUXFQ697YDtBopawTWjyK1pmgJQhQymUe0f7QQYpOgulJTZz3KXJ1XfLa62RbdxO",
+	"XeZWNe4gk+sj6q3WOkju8k64dASnwfpIrXYqHeCZ8h6UGZm/mQOeXvvCq2uHk4i1Ul85BVRR8XlPm7+2",
+	"e6qPqgGLX4/IkJ/7BYV5azJMT1y6NnLAfzKQiZpJLFPOjGOgavzOhr/c0iveCIqHvOWizOdpGmE9aBVe",
+	"WlsDus52EdonRGAfLtFzl5ORUKRDZmaAxYh5bVomN+Rgzw5CO3KNPf1v5/TYri0sLd007Tx9UClM20lZ",
+	"ukkm0ZbSbLyJNQVPgrOOVplcH8FQJr1E7IphgTY7M2praW1bmF6KP7pP35b9dpMeOh8/hzhVd33yi6Z/",
+	"qiVVx3sIvucFEmVRN7TqlV31TSWPY8R5gNzNEj+bbQ2a3PAR9QeREQQ3YTuIDH1usAILe4lLEBfyO6DM",
+	"2Hn6JMnx8Sl3/F+mCVnCq1BY4eM//SC/APeIlxKvbiYEJu658OpR8LKXOZUbkU7s6Ua+Ku1kAplh2SR2",
+	"z+MBZeB207x040qeFknjXjmiaFEFFTGGfKArbSVZpcf2ttkk1CBtWeZFrbpI4E7vw+6/eerO7/lq6/oN",
+	"Yg2+y6P12q9rPG5y+XM3Bci3ZWuJFQyz+86/qSr6ntVG+VV5Y20Icfbuoi4lttMn4k2wrdguOckYjRHn",
+	"Ot6rfLdJeWOA334xroogmXW/SbMA7rNtKP7V1N+qD8XlV63bKpUDdxYtGwwd5ZS0g8q9xq0trc55PcVQ",
+	"UsvL6wZA7wkuBWWHhCwSe3ocH2xzfLV20265eN1f9p82W6bHVFAWmoSS80VG3/bzj3WDjF5xJw2q7kPO",
+	"9Wk03UMphri8O81ZgiOzAEdODkbwEFY5KSY81ihpjiX1JM6UrpOHCUdMjHIl0UcJSpHwagfFIPp2Z8vf",
+	"tMPOtbIR5AvEtCX71AuF8n1aF4LZ2S3iGx2Hugmx2D/PPmD6FlXttvW2rLgWz3I4Pb2p05c9Bq/fMPds",
+	"cGAdFYKalHne9vhEV07fDdLpuxLoa08T3f1da8HHdbZ62dq1ivIJxAhMj2nsWcfHb8H7DJGXH96A4/ev",
+	"okGUszQ6irrehRnK7XiovZeYEvNMjHYlz6laSlikyNeBzbc5ip5KBkbqTiVEYIajo+hA/SSNe7FU1I5g",
+	"hkeXk5G5wHdkmzeuseJ2yjeJ6uvlhzfVK/a1DqSMaNXe/nhsgrv2zLG6A1SfGBz9k2urr3SZtb7j5b/M",
+	"X3G9tqNpm1VNIs9XK8jW0ZEcAygu8ydzCngeLwHkoHLDv4AL7ty+H31Sx8lCo9cae50Bahn+SpP1nY29",
+	"+VZAY9CmWzCT/V4/4HnQW1RlKva8jL8eNPCocwl5X0iWLyPcDzA9LzG0sWUQHd4hGY3XPTxda89Ny8Jw",
+	"Hm2zG9cmEzP6qv9Qzv9rLf+UJuKfqffzeYoJ0mx7p3f4DDK4QnqW/9HIdHLIs+EX+bsUYJHdCCKHhsgV",
+	"4zrL0RfKDr+N+KkBnEOPCv3AZpRqvtae4Os1kVZh6LnCymc77meFeZ4J2bEV5jwduNEKMxMz+mq0sI1W",
+	"mNEee6wwl7zwCnNoeNwrrPoQZOtEJqs9S5x3Zb1G4pjG/3X2/l1gKVXJkm0VV8404ZbQGKjuSqoSGtco",
+	"MjpqCzm/n7897UWOLNhBzlKs0jZy7P0fXaKnfKmmC8xyfdm7INQlVoWZpzD9JUds7YAai2VpCHpA7M+S",
+	"vx54HgReA4ZEzvRJSZ2RPzQXCtpjyj4SKvfobULDp+1KX8/jQJ6V4t71lGLuxUG9SIkH6xhXNhoPzb/7",
+	"YOW2lG3Pm5ibK9yTO6OnCCQ8+H1Ov4Si3jMwp1AgIOjKnXXfhDdlwOirk0TSvcsdq48FKFplwiKlM3Wz",
+	"a07wl7x6QVl4w6vmtPTa8II3djQFho76qUtTDSUw5eYWVXtFnnLomMxZn+hQbdxSZuzAxqtxAGAXpgZ9",
+	"9pBdxMr97Gnb3E9a5Jn5IrF2GE44o/JUW058WnYbILrcODuDiU/b2fd8se/r6+s6udffBhoPTA4ZLxa8",
+	"7d42SvTT0pLQFrXHPEC9WxDtshke3N6imXwHk4pIjzk9IT+mdNtTWqiht51RZZJttlg/2qvSH+d24nsz",
+	"/9rsJ7sqGcq7quc50a8d2PP1dwOwDQTHI4fXCflu0GWE1NbBVVyL2YKt8pmPxwut5lMn/dXgh400hYDK",
+	"Cw2bY8kQ0dNNq++z7+Os3QJ0wtdzbtfArd7hvyMBKsN/3VbQOdsXHqOv+o/Sg9cDLCpZ9OFhZdBylivQ",
+	"fTn2nt0ns/tGafXypd0CqT4LcHOMFmmWfSRYkTD4cHbD1jPS9xILqr3/vSPwUW9ZVd6Gsad7bqthCQYJ",
+	"nyPWoV6dm2KP3dfYTGf9XlQsCwRQnp+C+mk+nSvQgS4d4umSTPKIT599UmFenpu8x+i3OSI/WwN7kfMi",
+	"FO623/puWMUNqm29etZHvdv6EbXBRu5pZ8/csqi109wmZhWTU3N07uEI2oKqEu76CFqf8L4c91aD++4Z",
+	"u28Z2vc9zr1Dcf7i3d7qDNfF2Sim5BIxm7nbNv264Dbn35LSAQE81xjGHGCS5UK/u2JkqX7yzI5Kv0Ag",
+	"D0ObZzLVc1nyAnUcIyAT8OFWQVQb0u7A6FwlSCkuE/OIg3nZjM4BrD8X12DqXg/k2WsC+m2p9iKAe8hn",
+	"3XHRbvl6Oxl/Xl7isI21bg5CfzvxHiLggcrzysxusrhG+j7BDuH+RhW6p3mvX0eyOQz2t0TP7shnPau3",
+	"gMVX+cNGOXw1dGxkHbsXMnvM4oKWnkZx6Cbnnc6bC1+iUxfgvTfL3Zmm8aMT7M39um3Kgwly5cUkPyZ9",
+	"Z1LT+s57Q37fTGo/VES0JVsrGtAlIgDP1a0VgOcza/ax4tqDH+nWIUu/xzaxM7i4B1/pt5BONSPyMHQJ",
+	"cktSdXj2u1KqHzIAtppFfTsH4/ixOxiL7OqeDkZnyxrpJ1SmyN5m1mKR/q6KKiofGUqdkX+jVP8KBbuD",
+	"THmp5MDeiCj/kK7XAcBEXgEDKFOiVj+4ZPHGAdXnQM0rJPq1EPOKGMw54gASYJ/z2QTq+n0f+35fj4QG",
+	"5zbOXuHDh7I3+15j3uXAXn0idjGPwnn0DiggFheVcu2/v4HsrgF6lKtLZDukePOm2cemcgSv2v1ekio0",
+	"DsBPBnA/A1iDXIk4sIIkl5O7KfS8aT32lQb7AkufKFLlZRe+izL2fnIqvRJctTI171dFoVzJX/q3qJ8a",
+	"a29Qlfnl/lPpmmjZuY1AorWSlCkP/+vVYn5gNBfmCDuu3Edy81XZOwW9SD7/dS15/ZIkN0u8eySL8kdS",
+	"fBu+/Znxt0bxhpnyRY78D0j/yN3f2bXkTeC/46Uk68l7lzaLZKjrylkei5z9WFMPbU0Nwo9ChFhuEdCb",
+	"5/7XxHc/6l9ZedyB+KYxnR8r5McKmXwbY6kKvt03llqXYTi4VkR1fizFjTt/LAvx7t2MTiyxvg6/L2+j",
+	"XnEbbpvtWquAnemxZ7LMIwyYF+Pe9Ws81CTfMO7R70CyOQF3g+PIPyJ3d6iF7OjZZ3MaU6NnM3TSrFN4",
+	"0exRyi6afR+ii2Y3k1zlE24jJHvvFmK1Bwd3Kg/BnDIuB63TNtQLXPZ5PJ94U6WmtoT7RLR977T6pKB9",
+	"aBCqJ8rKBwbdUp/u3QLzzNwuJi40Z68lXaGHGVZjyyMTgX4mfG8mgV6CA2ASEAYgThFkgDLAUAYx2xxY",
+	"faVqb9Phr5XHWB+bAVGO/ju5DZAyoA0KB1mU3RGi+hgbJUd3zORobtLKeLebdPA2ElVq2ryTxG7G5YEQ",
+	"LmiWfeMteHcvYPK++nt7UPeyUR6xkKTZ9yMji5cx+glGWRexSzvR1Uc31zTfS+gKYqKe3IyuPxUN+J0Z",
+	"UdcrnwmNez/tad7yHH3JcXwxVBJ/qI/jD8vXECpOksjnWuYXW6dKHnoaJiuHHtVtkxr7+lVRzv5w/en6",
+	"/wcAJlI7GI/iAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,6 +8,37 @@ import (
 	"fmt"
 )
 
+// Defines values for HandleErrorRequestOp.
+const (
+	HandleErrorRequestOpInject HandleErrorRequestOp = "inject"
+
+	HandleErrorRequestOpList HandleErrorRequestOp = "list"
+
+	HandleErrorRequestOpReplace HandleErrorRequestOp = "replace"
+
+	HandleErrorRequestOpRevert HandleErrorRequestOp = "revert"
+
+	HandleErrorRequestOpSkip HandleErrorRequestOp = "skip"
+)
+
+// Defines values for OperateValidationErrorRequestOp.
+const (
+	OperateValidationErrorRequestOpClear OperateValidationErrorRequestOp = "clear"
+
+	OperateValidationErrorRequestOpIgnore OperateValidationErrorRequestOp = "ignore"
+
+	OperateValidationErrorRequestOpRepair OperateValidationErrorRequestOp = "repair"
+
+	OperateValidationErrorRequestOpResolve OperateValidationErrorRequestOp = "resolve"
+)
+
+// Defines values for StartValidationRequestMode.
+const (
+	StartValidationRequestModeFast StartValidationRequestMode = "fast"
+
+	StartValidationRequestModeFull StartValidationRequestMode = "full"
+)
+
 // Defines values for TaskOnDuplicate.
 const (
 	TaskOnDuplicateError TaskOnDuplicate = "error"
@@ -42,6 +73,22 @@ const (
 	TaskStageStopped TaskStage = "Stopped"
 )
 
+// Defines values for UnlockShardDDLLockRequestAction.
+const (
+	UnlockShardDDLLockRequestActionExec UnlockShardDDLLockRequestAction = "exec"
+
+	UnlockShardDDLLockRequestActionSkip UnlockShardDDLLockRequestAction = "skip"
+)
+
+// Defines values for ValidationErrorState.
+const (
+	ValidationErrorStateIgnored ValidationErrorState = "ignored"
+
+	ValidationErrorStateResolved ValidationErrorState = "resolved"
+
+	ValidationErrorStateUnprocessed ValidationErrorState = "unprocessed"
+)
+
 // AlertManagerTopology defines model for AlertManagerTopology.
 type AlertManagerTopology struct {
 	Host string `json:"host"`
@@ -172,6 +219,12 @@ type GetClusterWorkerListResponse struct {
 	Total int             `json:"total"`
 }
 
+// GetShardDDLLockListResponse defines model for GetShardDDLLockListResponse.
+type GetShardDDLLockListResponse struct {
+	Data  []ShardDDLLock `json:"data"`
+	Total int            `json:"total"`
+}
+
 // GetSourceListResponse defines model for GetSourceListResponse.
 type GetSourceListResponse struct {
 	Data  []Source `json:"data"`
@@ -209,12 +262,54 @@ type GetTaskTableStructureResponse struct {
 	TableName       string  `json:"table_name"`
 }
 
+// GetValidationErrorListResponse defines model for GetValidationErrorListResponse.
+type GetValidationErrorListResponse struct {
+	Data  []ValidationError `json:"data"`
+	Total int               `json:"total"`
+}
+
+// GetValidationStatusResponse defines model for GetValidationStatusResponse.
+type GetValidationStatusResponse struct {
+	TableStatuses []ValidationTableStatus `json:"table_statuses"`
+	Validators    []ValidatorStatus       `json:"validators"`
+}
+
 // GrafanaTopology defines model for GrafanaTopology.
 type GrafanaTopology struct {
 	Host string `json:"host"`
 	Port int    `json:"port"`
 }
 
+// HandleErrorRequest defines model for HandleErrorRequest.
+type HandleErrorRequest struct {
+	// position used to match binlog event, the operation will be applied to the matched event
+	BinlogPos *string              `json:"binlog_pos,omitempty"`
+	Op        HandleErrorRequestOp `json:"op"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// sql list used by replace and inject operation
+	SqlList *[]string `json:"sql_list,omitempty"`
+}
+
+// HandleErrorRequestOp defines model for HandleErrorRequest.Op.
+type HandleErrorRequestOp string
+
+// HandleErrorResponse defines model for HandleErrorResponse.
+type HandleErrorResponse struct {
+	Data  []HandleErrorSourceResult `json:"data"`
+	Total int                       `json:"total"`
+}
+
+// HandleErrorSourceResult defines model for HandleErrorSourceResult.
+type HandleErrorSourceResult struct {
+	Msg        string `json:"msg"`
+	Result     bool   `json:"result"`
+	SourceName string `json:"source_name"`
+	WorkerName string `json:"worker_name"`
+}
+
 // status of load unit
 type LoadStatus struct {
 	FinishedBytes  int64  `json:"finished_bytes"`
@@ -252,6 +347,21 @@ type OperateTaskTableStructureRequest struct {
 	Sync *bool `json:"sync,omitempty"`
 }
 
+// OperateValidationErrorRequest defines model for OperateValidationErrorRequest.
+type OperateValidationErrorRequest struct {
+	// id of the error to operate, required if is_all_errors is false
+	ErrorId *uint64 `json:"error_id,omitempty"`
+
+	// whether to operate all errors of the task
+	IsAllErrors *bool `json:"is_all_errors,omitempty"`
+
+	// repair re-syncs the row from upstream to downstream, the validator should be running
+	Op OperateValidationErrorRequestOp `json:"op"`
+}
+
+// repair re-syncs the row from upstream to downstream, the validator should be running
+type OperateValidationErrorRequestOp string
+
 // PrometheusTopology defines model for PrometheusTopology.
 type PrometheusTopology struct {
 	Host string `json:"host"`
@@ -332,6 +442,23 @@ type Security struct {
 	SslKeyContent string `json:"ssl_key_content"`
 }
 
+// un-resolved shard DDL lock
+type ShardDDLLock struct {
+	DdlList []string `json:"ddl_list"`
+	Id      string   `json:"id"`
+
+	// shard DDL mode, pessimistic or optimistic
+	Mode  string `json:"mode"`
+	Owner string `json:"owner"`
+
+	// already synced sources
+	Synced   []string `json:"synced"`
+	TaskName string   `json:"task_name"`
+
+	// pending to sync sources
+	Unsynced []string `json:"unsynced"`
+}
+
 // ShardingGroup defines model for ShardingGroup.
 type ShardingGroup struct {
 	DdlList       []string `json:"ddl_list"`
@@ -414,6 +541,21 @@ type StartTaskRequest struct {
 	StartTime *string `json:"start_time,omitempty"`
 }
 
+// StartValidationRequest defines model for StartValidationRequest.
+type StartValidationRequest struct {
+	// validation mode, only used when the validator has not been enabled, default is full
+	Mode *StartValidationRequestMode `json:"mode,omitempty"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// start time of binlog for validation, only used when the validator has not been enabled
+	StartTime *string `json:"start_time,omitempty"`
+}
+
+// validation mode, only used when the validator has not been enabled, default is full
+type StartValidationRequestMode string
+
 // StopTaskRequest defines model for StopTaskRequest.
 type StopTaskRequest struct {
 	// source name list
@@ -423,6 +565,12 @@ type StopTaskRequest struct {
 	TimeoutDuration *string `json:"timeout_duration,omitempty"`
 }
 
+// StopValidationRequest defines model for StopValidationRequest.
+type StopValidationRequest struct {
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
 // SubTaskStatus defines model for SubTaskStatus.
 type SubTaskStatus struct {
 	// status of dump unit
@@ -654,6 +802,31 @@ type TaskTemplateResponse struct {
 	SuccessTaskList []string `json:"success_task_list"`
 }
 
+// UnlockShardDDLLockRequest defines model for UnlockShardDDLLockRequest.
+type UnlockShardDDLLockRequest struct {
+	// whether to skip or execute the DDLs
+	Action *UnlockShardDDLLockRequestAction `json:"action,omitempty"`
+
+	// database name of the table, only used in optimistic mode
+	Database *string `json:"database,omitempty"`
+
+	// force to remove the DDL lock
+	ForceRemove *bool  `json:"force_remove,omitempty"`
+	LockId      string `json:"lock_id"`
+
+	// source to replace the default owner
+	ReplaceOwner *string `json:"replace_owner,omitempty"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// table name, only used in optimistic mode
+	Table *string `json:"table,omitempty"`
+}
+
+// whether to skip or execute the DDLs
+type UnlockShardDDLLockRequestAction string
+
 // UpdateSourceRequest defines model for UpdateSourceRequest.
 type UpdateSourceRequest struct {
 	// source
@@ -666,6 +839,54 @@ type UpdateTaskRequest struct {
 	Task Task `json:"task"`
 }
 
+// row change which fails the validation
+type ValidationError struct {
+	ErrorType string `json:"error_type"`
+
+	// error id
+	Id          string               `json:"id"`
+	Message     string               `json:"message"`
+	SourceData  string               `json:"source_data"`
+	SourceName  string               `json:"source_name"`
+	SourceTable string               `json:"source_table"`
+	State       ValidationErrorState `json:"state"`
+	TargetData  string               `json:"target_data"`
+	TargetTable string               `json:"target_table"`
+	Time        string               `json:"time"`
+}
+
+// ValidationErrorState defines model for ValidationError.State.
+type ValidationErrorState string
+
+// validation status of table
+type ValidationTableStatus struct {
+	Message     string `json:"message"`
+	SourceName  string `json:"source_name"`
+	SourceTable string `json:"source_table"`
+	Stage       string `json:"stage"`
+	TargetTable string `json:"target_table"`
+}
+
+// status of validator
+type ValidatorStatus struct {
+	// error message when something wrong
+	ErrorMsg *string `json:"error_msg,omitempty"`
+
+	// count of error row changes by unprocessed/ignored/resolved
+	ErrorRowsStatus string `json:"error_rows_status"`
+	Mode            string `json:"mode"`
+
+	// count of pending row changes by insert/update/delete
+	PendingRowsStatus string `json:"pending_rows_status"`
+
+	// count of processed row changes by insert/update/delete
+	ProcessedRowsStatus string `json:"processed_rows_status"`
+	SourceName          string `json:"source_name"`
+	Stage               string `json:"stage"`
+	ValidatorBinlog     string `json:"validator_binlog"`
+	ValidatorBinlogGtid string `json:"validator_binlog_gtid"`
+}
+
 // worker name list
 type WorkerNameList []string
 
@@ -763,6 +984,18 @@ type DMAPIGetTaskParams struct {
 // DMAPIUpdateTaskJSONBody defines parameters for DMAPIUpdateTask.
 type DMAPIUpdateTaskJSONBody UpdateTaskRequest
 
+// DMAPIHandleErrorJSONBody defines parameters for DMAPIHandleError.
+type DMAPIHandleErrorJSONBody HandleErrorRequest
+
+// DMAPIGetShardDDLLockListParams defines parameters for DMAPIGetShardDDLLockList.
+type DMAPIGetShardDDLLockListParams struct {
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
+// DMAPIUnlockShardDDLLockJSONBody defines parameters for DMAPIUnlockShardDDLLock.
+type DMAPIUnlockShardDDLLockJSONBody UnlockShardDDLLockRequest
+
 // DMAPIGetTaskMigrateTargetsParams defines parameters for DMAPIGetTaskMigrateTargets.
 type DMAPIGetTaskMigrateTargetsParams struct {
 	SchemaPattern *string `json:"schema_pattern,omitempty"`
@@ -784,6 +1017,33 @@ type DMAPIGetTaskStatusParams struct {
 // DMAPIStopTaskJSONBody defines parameters for DMAPIStopTask.
 type DMAPIStopTaskJSONBody StopTaskRequest
 
+// DMAPIGetValidationErrorListParams defines parameters for DMAPIGetValidationErrorList.
+type DMAPIGetValidationErrorListParams struct {
+	// filter validation errors by state
+	ErrorState *DMAPIGetValidationErrorListParamsErrorState `json:"error_state,omitempty"`
+}
+
+// DMAPIGetValidationErrorListParamsErrorState defines parameters for DMAPIGetValidationErrorList.
+type DMAPIGetValidationErrorListParamsErrorState string
+
+// DMAPIOperateValidationErrorJSONBody defines parameters for DMAPIOperateValidationError.
+type DMAPIOperateValidationErrorJSONBody OperateValidationErrorRequest
+
+// DMAPIStartValidationJSONBody defines parameters for DMAPIStartValidation.
+type DMAPIStartValidationJSONBody StartValidationRequest
+
+// DMAPIGetValidationStatusParams defines parameters for DMAPIGetValidationStatus.
+type DMAPIGetValidationStatusParams struct {
+	// filter validation tables by stage
+	TableStage *DMAPIGetValidationStatusParamsTableStage `json:"table_stage,omitempty"`
+}
+
+// DMAPIGetValidationStatusParamsTableStage defines parameters for DMAPIGetValidationStatus.
+type DMAPIGetValidationStatusParamsTableStage string
+
+// DMAPIStopValidationJSONBody defines parameters for DMAPIStopValidation.
+type DMAPIStopValidationJSONBody StopValidationRequest
+
 // DMAPIUpdateClusterInfoJSONRequestBody defines body for DMAPIUpdateClusterInfo for application/json ContentType.
 type DMAPIUpdateClusterInfoJSONRequestBody DMAPIUpdateClusterInfoJSONBody
 
@@ -820,6 +1080,12 @@ type DMAPIImportTaskTemplateJSONRequestBody DMAPIImportTaskTemplateJSONBody
 // DMAPIUpdateTaskJSONRequestBody defines body for DMAPIUpdateTask for application/json ContentType.
 type DMAPIUpdateTaskJSONRequestBody DMAPIUpdateTaskJSONBody
 
+// DMAPIHandleErrorJSONRequestBody defines body for DMAPIHandleError for application/json ContentType.
+type DMAPIHandleErrorJSONRequestBody DMAPIHandleErrorJSONBody
+
+// DMAPIUnlockShardDDLLockJSONRequestBody defines body for DMAPIUnlockShardDDLLock for application/json ContentType.
+type DMAPIUnlockShardDDLLockJSONRequestBody DMAPIUnlockShardDDLLockJSONBody
+
 // DMAPIOperateTableStructureJSONRequestBody defines body for DMAPIOperateTableStructure for application/json ContentType.
 type DMAPIOperateTableStructureJSONRequestBody DMAPIOperateTableStructureJSONBody
 
@@ -829,6 +1095,15 @@ type DMAPIStartTaskJSONRequestBody DMAPIStartTaskJSONBody
 // DMAPIStopTaskJSONRequestBody defines body for DMAPIStopTask for application/json ContentType.
 type DMAPIStopTaskJSONRequestBody DMAPIStopTaskJSONBody
 
+// DMAPIOperateValidationErrorJSONRequestBody defines body for DMAPIOperateValidationError for application/json ContentType.
+type DMAPIOperateValidationErrorJSONRequestBody DMAPIOperateValidationErrorJSONBody
+
+// DMAPIStartValidationJSONRequestBody defines body for DMAPIStartValidation for application/json ContentType.
+type DMAPIStartValidationJSONRequestBody DMAPIStartValidationJSONBody
+
+// DMAPIStopValidationJSONRequestBody defines body for DMAPIStopValidation for application/json ContentType.
+type DMAPIStopValidationJSONRequestBody DMAPIStopValidationJSONBody
+
 // Getter for additional properties for Task_BinlogFilterRule. Returns the specified
 // element and whether it was found
 func (a Task_BinlogFilterRule) Get(fieldName string) (value TaskBinLogFilterRule, found bool) {
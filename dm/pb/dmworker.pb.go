@@ -379,6 +379,7 @@ const (
 	ValidationErrOp_IgnoreErrOp  ValidationErrOp = 1
 	ValidationErrOp_ResolveErrOp ValidationErrOp = 2
 	ValidationErrOp_ClearErrOp   ValidationErrOp = 3
+	ValidationErrOp_RepairErrOp  ValidationErrOp = 4
 )
 
 var ValidationErrOp_name = map[int32]string{
@@ -386,6 +387,7 @@ var ValidationErrOp_name = map[int32]string{
 	1: "IgnoreErrOp",
 	2: "ResolveErrOp",
 	3: "ClearErrOp",
+	4: "RepairErrOp",
 }
 
 var ValidationErrOp_value = map[string]int32{
@@ -393,6 +395,7 @@ var ValidationErrOp_value = map[string]int32{
 	"IgnoreErrOp":  1,
 	"ResolveErrOp": 2,
 	"ClearErrOp":   3,
+	"RepairErrOp":  4,
 }
 
 func (x ValidationErrOp) String() string {
@@ -755,7 +758,12 @@ type LoadStatus struct {
 	TotalBytes     int64  `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
 	Progress       string `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
 	MetaBinlog     string `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
-	MetaBinlogGTID string `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	MetaBinlogGTID         string   `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	ChecksumStage          string   `protobuf:"bytes,6,opt,name=checksumStage,proto3" json:"checksumStage,omitempty"`
+	ChecksumCheckedChunks  int64    `protobuf:"varint,7,opt,name=checksumCheckedChunks,proto3" json:"checksumCheckedChunks,omitempty"`
+	ChecksumTotalChunks    int64    `protobuf:"varint,8,opt,name=checksumTotalChunks,proto3" json:"checksumTotalChunks,omitempty"`
+	ChecksumAtDumpSnapshot bool     `protobuf:"varint,9,opt,name=checksumAtDumpSnapshot,proto3" json:"checksumAtDumpSnapshot,omitempty"`
+	ChecksumMismatchChunks []string `protobuf:"bytes,10,rep,name=checksumMismatchChunks,proto3" json:"checksumMismatchChunks,omitempty"`
 }
 
 func (m *LoadStatus) Reset()         { *m = LoadStatus{} }
@@ -826,6 +834,41 @@ func (m *LoadStatus) GetMetaBinlogGTID() string {
 	return ""
 }
 
+func (m *LoadStatus) GetChecksumStage() string {
+	if m != nil {
+		return m.ChecksumStage
+	}
+	return ""
+}
+
+func (m *LoadStatus) GetChecksumCheckedChunks() int64 {
+	if m != nil {
+		return m.ChecksumCheckedChunks
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetChecksumTotalChunks() int64 {
+	if m != nil {
+		return m.ChecksumTotalChunks
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetChecksumAtDumpSnapshot() bool {
+	if m != nil {
+		return m.ChecksumAtDumpSnapshot
+	}
+	return false
+}
+
+func (m *LoadStatus) GetChecksumMismatchChunks() []string {
+	if m != nil {
+		return m.ChecksumMismatchChunks
+	}
+	return nil
+}
+
 // ShardingGroup represents a DDL sharding group, this is used by SyncStatus, and is differ from ShardingGroup in syncer pkg
 // target: target table name
 // DDL: in syncing DDL
@@ -3494,184 +3537,189 @@ func init() {
 func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
 var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0x4d, 0x6f, 0x24, 0x57,
-	0x71, 0xba, 0xe7, 0xbb, 0x66, 0xec, 0x6d, 0xbf, 0xf5, 0x2e, 0x13, 0x67, 0x77, 0xe2, 0xf4, 0x46,
-	0xc1, 0xb1, 0x82, 0x95, 0x98, 0xa0, 0xa0, 0x48, 0x90, 0x64, 0xed, 0x8d, 0x77, 0x83, 0x37, 0xde,
-	0x6d, 0x3b, 0xe6, 0x84, 0x44, 0xbb, 0xfb, 0x79, 0xdc, 0xb8, 0xa7, 0xbb, 0xb7, 0xbb, 0xc7, 0x96,
-	0x0f, 0x88, 0x0b, 0xe2, 0x0a, 0x17, 0x90, 0x40, 0x70, 0x00, 0x09, 0x89, 0x13, 0x07, 0x0e, 0x1c,
-	0x39, 0x02, 0xc7, 0x88, 0x13, 0x47, 0x94, 0xfd, 0x23, 0xa8, 0xea, 0xbd, 0xd7, 0xfd, 0x7a, 0x3e,
-	0xbc, 0x59, 0x24, 0x6e, 0x5d, 0x1f, 0xaf, 0xaa, 0x5e, 0x7d, 0xbd, 0xaa, 0xb1, 0x61, 0xd9, 0x1f,
-	0x5f, 0xc6, 0xe9, 0x39, 0x4f, 0xb7, 0x92, 0x34, 0xce, 0x63, 0x66, 0x26, 0x27, 0xf6, 0x06, 0xb0,
-	0xa7, 0x13, 0x9e, 0x5e, 0x1d, 0xe6, 0x6e, 0x3e, 0xc9, 0x1c, 0xfe, 0x6c, 0xc2, 0xb3, 0x9c, 0x31,
-	0x68, 0x44, 0xee, 0x98, 0x0f, 0x8c, 0x75, 0x63, 0xa3, 0xeb, 0xd0, 0xb7, 0x9d, 0xc0, 0xea, 0x4e,
-	0x3c, 0x1e, 0xc7, 0xd1, 0xf7, 0x49, 0x86, 0xc3, 0xb3, 0x24, 0x8e, 0x32, 0xce, 0x6e, 0x43, 0x2b,
-	0xe5, 0xd9, 0x24, 0xcc, 0x89, 0xbb, 0xe3, 0x48, 0x88, 0x59, 0x50, 0x1f, 0x67, 0xa3, 0x81, 0x49,
-	0x22, 0xf0, 0x13, 0x39, 0xb3, 0x78, 0x92, 0x7a, 0x7c, 0x50, 0x27, 0xa4, 0x84, 0x10, 0x2f, 0xec,
-	0x1a, 0x34, 0x04, 0x5e, 0x40, 0xf6, 0x9f, 0x0d, 0xb8, 0x59, 0x31, 0xee, 0xa5, 0x35, 0xbe, 0x07,
-	0x7d, 0xa1, 0x43, 0x48, 0x20, 0xbd, 0xbd, 0x6d, 0x6b, 0x2b, 0x39, 0xd9, 0x3a, 0xd4, 0xf0, 0x4e,
-	0x85, 0x8b, 0xbd, 0x0f, 0x4b, 0xd9, 0xe4, 0xe4, 0xc8, 0xcd, 0xce, 0xe5, 0xb1, 0xc6, 0x7a, 0x7d,
-	0xa3, 0xb7, 0xbd, 0x42, 0xc7, 0x74, 0x82, 0x53, 0xe5, 0xb3, 0xff, 0x68, 0x40, 0x6f, 0xe7, 0x8c,
-	0x7b, 0x12, 0x46, 0x43, 0x13, 0x37, 0xcb, 0xb8, 0xaf, 0x0c, 0x15, 0x10, 0x5b, 0x85, 0x66, 0x1e,
-	0xe7, 0x6e, 0x48, 0xa6, 0x36, 0x1d, 0x01, 0xb0, 0x21, 0x40, 0x36, 0xf1, 0x3c, 0x9e, 0x65, 0xa7,
-	0x93, 0x90, 0x4c, 0x6d, 0x3a, 0x1a, 0x06, 0xa5, 0x9d, 0xba, 0x41, 0xc8, 0x7d, 0x72, 0x53, 0xd3,
-	0x91, 0x10, 0x1b, 0x40, 0xfb, 0xd2, 0x4d, 0xa3, 0x20, 0x1a, 0x0d, 0x9a, 0x44, 0x50, 0x20, 0x9e,
-	0xf0, 0x79, 0xee, 0x06, 0xe1, 0xa0, 0xb5, 0x6e, 0x6c, 0xf4, 0x1d, 0x09, 0xd9, 0x5f, 0x18, 0x00,
-	0xbb, 0x93, 0x71, 0x22, 0xcd, 0x5c, 0x87, 0x1e, 0x59, 0x70, 0xe4, 0x9e, 0x84, 0x3c, 0x23, 0x5b,
-	0xeb, 0x8e, 0x8e, 0x62, 0x1b, 0x70, 0xc3, 0x8b, 0xc7, 0x49, 0xc8, 0x73, 0xee, 0x4b, 0x2e, 0x34,
-	0xdd, 0x70, 0xa6, 0xd1, 0xec, 0x0d, 0x58, 0x3a, 0x0d, 0xa2, 0x20, 0x3b, 0xe3, 0xfe, 0xfd, 0xab,
-	0x9c, 0x0b, 0x97, 0x1b, 0x4e, 0x15, 0xc9, 0x6c, 0xe8, 0x2b, 0x84, 0x13, 0x5f, 0x66, 0x74, 0x21,
-	0xc3, 0xa9, 0xe0, 0xd8, 0xdb, 0xb0, 0xc2, 0xb3, 0x3c, 0x18, 0xbb, 0x39, 0x3f, 0x42, 0x53, 0x88,
-	0xb1, 0x49, 0x8c, 0xb3, 0x04, 0xfb, 0xaf, 0x06, 0xc0, 0x7e, 0xec, 0xfa, 0xf2, 0x4a, 0x33, 0x66,
-	0x88, 0x4b, 0x4d, 0x99, 0x31, 0x04, 0xa0, 0x5b, 0x0a, 0x16, 0x93, 0x58, 0x34, 0x0c, 0x5b, 0x83,
-	0x4e, 0x92, 0xc6, 0xa3, 0x94, 0x67, 0x99, 0x4c, 0xd9, 0x02, 0xc6, 0xb3, 0x63, 0x9e, 0xbb, 0xf7,
-	0x83, 0x28, 0x8c, 0x47, 0x32, 0x71, 0x35, 0x0c, 0x7b, 0x13, 0x96, 0x4b, 0x68, 0xef, 0xe8, 0xd1,
-	0x2e, 0xd9, 0xde, 0x75, 0xa6, 0xb0, 0xf6, 0x2f, 0x0d, 0x58, 0x3a, 0x3c, 0x73, 0x53, 0x3f, 0x88,
-	0x46, 0x7b, 0x69, 0x3c, 0x49, 0x30, 0x6a, 0xb9, 0x9b, 0x8e, 0x78, 0x2e, 0xcb, 0x4f, 0x42, 0x58,
-	0x94, 0xbb, 0xbb, 0xfb, 0x68, 0x67, 0x1d, 0x8b, 0x12, 0xbf, 0xc5, 0x3d, 0xd3, 0x2c, 0xdf, 0x8f,
-	0x3d, 0x37, 0x0f, 0xe2, 0x48, 0x9a, 0x59, 0x45, 0x52, 0xe1, 0x5d, 0x45, 0x1e, 0x65, 0x4e, 0x9d,
-	0x0a, 0x8f, 0x20, 0xbc, 0xdf, 0x24, 0x92, 0x94, 0x26, 0x51, 0x0a, 0xd8, 0xfe, 0x5d, 0x03, 0xe0,
-	0xf0, 0x2a, 0xf2, 0xa6, 0x72, 0xe4, 0xc1, 0x05, 0x8f, 0xf2, 0x6a, 0x8e, 0x08, 0x14, 0x0a, 0x13,
-	0x29, 0x93, 0x28, 0x57, 0x16, 0x30, 0xbb, 0x03, 0xdd, 0x94, 0x7b, 0x3c, 0xca, 0x91, 0x58, 0x27,
-	0x62, 0x89, 0xc0, 0x6c, 0x18, 0xbb, 0x59, 0xce, 0xd3, 0x8a, 0x33, 0x2b, 0x38, 0xb6, 0x09, 0x96,
-	0x0e, 0xef, 0xe5, 0x81, 0x2f, 0x1d, 0x3a, 0x83, 0x47, 0x79, 0x74, 0x09, 0x25, 0xaf, 0x25, 0xe4,
-	0xe9, 0x38, 0x94, 0xa7, 0xc3, 0x24, 0xaf, 0x2d, 0xe4, 0x4d, 0xe3, 0x51, 0xde, 0x49, 0x18, 0x7b,
-	0xe7, 0x41, 0x34, 0xa2, 0x00, 0x74, 0xc8, 0x55, 0x15, 0x1c, 0xfb, 0x0e, 0x58, 0x93, 0x28, 0xe5,
-	0x59, 0x1c, 0x5e, 0x70, 0x9f, 0xe2, 0x98, 0x0d, 0xba, 0x5a, 0xdb, 0xd0, 0x23, 0xec, 0xcc, 0xb0,
-	0x6a, 0x11, 0x02, 0xd1, 0x29, 0x64, 0x84, 0x86, 0x00, 0x27, 0x64, 0xc8, 0xd1, 0x55, 0xc2, 0x07,
-	0x3d, 0x91, 0x65, 0x25, 0x86, 0xbd, 0x03, 0x37, 0x33, 0xee, 0xc5, 0x91, 0x9f, 0xdd, 0xe7, 0x67,
-	0x41, 0xe4, 0x3f, 0x26, 0x5f, 0x0c, 0xfa, 0xe4, 0xe2, 0x79, 0x24, 0xcc, 0x18, 0x32, 0x7c, 0x77,
-	0x77, 0xff, 0xe0, 0x32, 0xe2, 0xe9, 0x60, 0x49, 0x64, 0x4c, 0x05, 0x89, 0xe1, 0xf6, 0xe2, 0xe8,
-	0x34, 0x0c, 0xbc, 0xfc, 0x71, 0x36, 0x1a, 0x2c, 0x13, 0x8f, 0x8e, 0xb2, 0x7f, 0x6b, 0x40, 0x5f,
-	0xef, 0xa1, 0x5a, 0x77, 0x37, 0x16, 0x74, 0x77, 0x53, 0xef, 0xee, 0xec, 0xad, 0xa2, 0x8b, 0x8b,
-	0xae, 0x4c, 0x7e, 0x7a, 0x92, 0xc6, 0xd8, 0xee, 0x1c, 0x22, 0x14, 0x8d, 0xfd, 0x5d, 0xe8, 0xa5,
-	0x3c, 0x74, 0xaf, 0x8a, 0x76, 0x8c, 0xfc, 0x37, 0x90, 0xdf, 0x29, 0xd1, 0x8e, 0xce, 0x63, 0xff,
-	0xc3, 0x84, 0x9e, 0x46, 0x9c, 0xc9, 0x31, 0xe3, 0x2b, 0xe6, 0x98, 0xb9, 0x20, 0xc7, 0xd6, 0x95,
-	0x49, 0x93, 0x93, 0xdd, 0x20, 0x95, 0x65, 0xa7, 0xa3, 0x0a, 0x8e, 0x4a, 0x52, 0xeb, 0x28, 0xec,
-	0xaa, 0x1a, 0xa8, 0xa5, 0xf4, 0x34, 0x9a, 0x6d, 0x01, 0x23, 0xd4, 0x8e, 0x9b, 0x7b, 0x67, 0x9f,
-	0x27, 0x32, 0xca, 0x2d, 0x4a, 0x95, 0x39, 0x14, 0xf6, 0x1a, 0x34, 0xb3, 0xdc, 0x1d, 0x71, 0x4a,
-	0xe9, 0xe5, 0xed, 0x2e, 0xa5, 0x20, 0x22, 0x1c, 0x81, 0xd7, 0x9c, 0xdf, 0x79, 0x81, 0xf3, 0xed,
-	0xbf, 0xd4, 0x61, 0xa9, 0xf2, 0xea, 0xcd, 0x9b, 0x0e, 0x4a, 0x8d, 0xe6, 0x02, 0x8d, 0xeb, 0xd0,
-	0x98, 0x44, 0x81, 0x08, 0xf6, 0xf2, 0x76, 0x1f, 0xe9, 0x9f, 0x47, 0x41, 0x8e, 0x59, 0xec, 0x10,
-	0x45, 0xb3, 0xa9, 0xf1, 0xa2, 0x84, 0x78, 0x07, 0x6e, 0x96, 0x25, 0xb4, 0xbb, 0xbb, 0xbf, 0x1f,
-	0x7b, 0xe7, 0x45, 0x87, 0x9d, 0x47, 0x62, 0x4c, 0xcc, 0x06, 0xd4, 0x0a, 0x1e, 0xd6, 0xc4, 0x74,
-	0xf0, 0x75, 0x68, 0x7a, 0xf8, 0x5a, 0x93, 0x97, 0x64, 0x42, 0x69, 0xcf, 0xf7, 0xc3, 0x9a, 0x23,
-	0xe8, 0xec, 0x0d, 0x68, 0xf8, 0x93, 0x71, 0x22, 0x7d, 0xb5, 0x8c, 0x7c, 0xe5, 0xf3, 0xf9, 0xb0,
-	0xe6, 0x10, 0x15, 0xb9, 0xc2, 0xd8, 0xf5, 0x07, 0xdd, 0x92, 0xab, 0x7c, 0x91, 0x90, 0x0b, 0xa9,
-	0xc8, 0x85, 0xb5, 0x4d, 0x75, 0x2e, 0xb9, 0xca, 0x36, 0x8b, 0x5c, 0x48, 0x65, 0xef, 0x01, 0x5c,
-	0xb8, 0x61, 0xe0, 0x8b, 0xa6, 0xde, 0x23, 0xde, 0x55, 0xe4, 0x3d, 0x2e, 0xb0, 0x32, 0xeb, 0x35,
-	0xbe, 0xfb, 0x1d, 0x68, 0x65, 0x22, 0xfd, 0xbf, 0x0b, 0x2b, 0x95, 0x98, 0xed, 0x07, 0x19, 0x39,
-	0x58, 0x90, 0x07, 0xc6, 0xa2, 0x81, 0x46, 0x9d, 0x1f, 0x02, 0x90, 0x27, 0x1e, 0xa4, 0x69, 0x9c,
-	0xaa, 0xc1, 0xca, 0x28, 0x06, 0x2b, 0xfb, 0x2e, 0x74, 0xd1, 0x03, 0xd7, 0x90, 0xf1, 0xea, 0x8b,
-	0xc8, 0x09, 0xf4, 0xe9, 0xce, 0x4f, 0xf7, 0x17, 0x70, 0xb0, 0x6d, 0x58, 0x15, 0xd3, 0x8d, 0x28,
-	0x82, 0x27, 0x71, 0x16, 0x90, 0x27, 0x44, 0x39, 0xce, 0xa5, 0xe1, 0x03, 0xc4, 0x51, 0xdc, 0xe1,
-	0xd3, 0x7d, 0xf5, 0x5a, 0x2b, 0xd8, 0xfe, 0x16, 0x74, 0x51, 0xa3, 0x50, 0xb7, 0x01, 0x2d, 0x22,
-	0x28, 0x3f, 0x58, 0x45, 0x10, 0xa4, 0x41, 0x8e, 0xa4, 0xdb, 0x3f, 0x37, 0xa0, 0x27, 0x9a, 0x9c,
-	0x38, 0xf9, 0xb2, 0x3d, 0x6e, 0xbd, 0x72, 0x5c, 0x75, 0x09, 0x5d, 0xe2, 0x16, 0x00, 0xb5, 0x29,
-	0xc1, 0xd0, 0x28, 0x93, 0xa2, 0xc4, 0x3a, 0x1a, 0x07, 0x06, 0xa6, 0x84, 0xe6, 0xb8, 0xf6, 0xd7,
-	0x26, 0xf4, 0x65, 0x48, 0x05, 0xcb, 0xff, 0xa9, 0x58, 0x65, 0x3d, 0x35, 0xf4, 0x7a, 0x7a, 0x53,
-	0xd5, 0x53, 0xb3, 0xbc, 0x46, 0x99, 0x45, 0x65, 0x39, 0xdd, 0x93, 0xe5, 0xd4, 0x22, 0xb6, 0x25,
-	0x55, 0x4e, 0x8a, 0x4b, 0x54, 0xd3, 0x3d, 0x59, 0x4d, 0xed, 0x92, 0xa9, 0x48, 0xa9, 0xa2, 0x98,
-	0xee, 0xc9, 0x62, 0xea, 0x94, 0x4c, 0x45, 0x98, 0x55, 0x2d, 0xdd, 0x6f, 0x43, 0x93, 0xc2, 0x69,
-	0x7f, 0x00, 0x96, 0xee, 0x1a, 0xaa, 0x89, 0x37, 0x25, 0xb1, 0x92, 0x0a, 0x1a, 0x93, 0x23, 0xcf,
-	0x3e, 0x83, 0xa5, 0x4a, 0x2b, 0xc2, 0x97, 0x39, 0xc8, 0x76, 0xdc, 0xc8, 0xe3, 0x61, 0x31, 0xdf,
-	0x6b, 0x18, 0x2d, 0xc9, 0xcc, 0x52, 0xb2, 0x14, 0x51, 0x49, 0x32, 0x6d, 0x4a, 0xaf, 0x57, 0xa6,
-	0xf4, 0x7f, 0x19, 0xd0, 0xd7, 0x0f, 0xe0, 0xa0, 0xff, 0x20, 0x4d, 0x77, 0x62, 0x5f, 0x44, 0xb3,
-	0xe9, 0x28, 0x10, 0x53, 0x1f, 0x3f, 0x43, 0x37, 0xcb, 0x64, 0x06, 0x16, 0xb0, 0xa4, 0x1d, 0x7a,
-	0x71, 0xa2, 0xf6, 0xae, 0x02, 0x96, 0xb4, 0x7d, 0x7e, 0xc1, 0x43, 0xf9, 0x40, 0x15, 0x30, 0x6a,
-	0x7b, 0xcc, 0xb3, 0x0c, 0xd3, 0x44, 0xf4, 0x55, 0x05, 0xe2, 0x29, 0xc7, 0xbd, 0xdc, 0x71, 0x27,
-	0x19, 0x97, 0xb3, 0x55, 0x01, 0xa3, 0x5b, 0x70, 0x3f, 0x74, 0xd3, 0x78, 0x12, 0xa9, 0x89, 0x4a,
-	0xc3, 0xd8, 0x97, 0xb0, 0xf2, 0x64, 0x92, 0x8e, 0x38, 0x25, 0xb1, 0x5a, 0x37, 0xd7, 0xa0, 0x13,
-	0x44, 0xae, 0x97, 0x07, 0x17, 0x5c, 0x7a, 0xb2, 0x80, 0x31, 0x7f, 0xf3, 0x60, 0xcc, 0xe5, 0x48,
-	0x49, 0xdf, 0xc8, 0x7f, 0x1a, 0x84, 0x9c, 0xf2, 0x5a, 0x5e, 0x49, 0xc1, 0x54, 0xa2, 0xe2, 0x4d,
-	0x96, 0xcb, 0xa4, 0x80, 0xec, 0xdf, 0x98, 0xb0, 0x76, 0x90, 0xf0, 0xd4, 0xcd, 0xb9, 0x58, 0x60,
-	0x0f, 0xbd, 0x33, 0x3e, 0x76, 0x95, 0x09, 0x77, 0xc0, 0x8c, 0x13, 0x52, 0x2e, 0xf3, 0x5d, 0x90,
-	0x0f, 0x12, 0xc7, 0x8c, 0x13, 0x32, 0xc2, 0xcd, 0xce, 0xa5, 0x6f, 0xe9, 0x7b, 0xe1, 0x36, 0xbb,
-	0x06, 0x1d, 0xdf, 0xcd, 0xdd, 0x13, 0x37, 0xe3, 0xca, 0xa7, 0x0a, 0xa6, 0xc5, 0x0f, 0xf7, 0x24,
-	0xe9, 0x51, 0x01, 0x90, 0x24, 0xd2, 0x26, 0xbd, 0x29, 0x21, 0xe4, 0x3e, 0x0d, 0x27, 0xd9, 0x19,
-	0xb9, 0xb1, 0xe3, 0x08, 0x00, 0x6d, 0x29, 0x72, 0xbe, 0x23, 0x9f, 0x8b, 0x21, 0xc0, 0x69, 0x1a,
-	0x8f, 0x45, 0x63, 0xa1, 0x07, 0xa8, 0xe3, 0x68, 0x18, 0x45, 0x3f, 0x12, 0x6b, 0x05, 0x94, 0x74,
-	0x81, 0xb1, 0x73, 0x58, 0x3a, 0x7e, 0x57, 0xa6, 0xfd, 0x63, 0x9e, 0xbb, 0x6c, 0x4d, 0x73, 0x07,
-	0xa0, 0x3b, 0x90, 0x22, 0x9d, 0xf1, 0xc2, 0xee, 0xa1, 0x5a, 0x4e, 0x5d, 0x6b, 0x39, 0xca, 0x83,
-	0x0d, 0x4a, 0x71, 0xfa, 0xb6, 0xdf, 0x83, 0x55, 0x19, 0x91, 0xe3, 0x77, 0x51, 0xeb, 0xc2, 0x58,
-	0x08, 0xb2, 0x50, 0x6f, 0xff, 0xdd, 0x80, 0x5b, 0x53, 0xc7, 0x5e, 0xfa, 0x77, 0x81, 0xf7, 0xa1,
-	0x81, 0x6b, 0xd8, 0xa0, 0x4e, 0xa5, 0x79, 0x0f, 0x75, 0xcc, 0x15, 0xb9, 0x85, 0xc0, 0x83, 0x28,
-	0x4f, 0xaf, 0x1c, 0x3a, 0xb0, 0xf6, 0x29, 0x74, 0x0b, 0x14, 0xca, 0x3d, 0xe7, 0x57, 0xaa, 0xfb,
-	0x9e, 0xf3, 0x2b, 0x9c, 0x28, 0x2e, 0xdc, 0x70, 0x22, 0x5c, 0x23, 0x1f, 0xd8, 0x8a, 0x63, 0x1d,
-	0x41, 0xff, 0xc0, 0xfc, 0xb6, 0x61, 0xff, 0x18, 0x06, 0x0f, 0xdd, 0xc8, 0x0f, 0x65, 0x3e, 0x8a,
-	0xa6, 0x20, 0x5d, 0xf0, 0xaa, 0xe6, 0x82, 0x1e, 0x4a, 0x21, 0xea, 0x35, 0xd9, 0x78, 0x07, 0xba,
-	0x27, 0xea, 0x39, 0x94, 0x8e, 0x2f, 0x11, 0x94, 0x33, 0xcf, 0xc2, 0x4c, 0xae, 0x7f, 0xf4, 0x6d,
-	0xdf, 0x82, 0x9b, 0x7b, 0x3c, 0x17, 0xba, 0x77, 0x4e, 0x47, 0x52, 0xb3, 0xbd, 0x01, 0xab, 0x55,
-	0xb4, 0x74, 0xae, 0x05, 0x75, 0xef, 0xb4, 0x78, 0x6a, 0xbc, 0xd3, 0x91, 0x7d, 0x08, 0x77, 0xc5,
-	0xb4, 0x34, 0x39, 0x41, 0x13, 0xb0, 0xf5, 0x7d, 0x9e, 0xf8, 0x6e, 0xce, 0xd5, 0x25, 0xb6, 0x61,
-	0x35, 0x13, 0xb4, 0x9d, 0xd3, 0xd1, 0x51, 0x3c, 0x0e, 0x0f, 0xf3, 0x34, 0x88, 0x94, 0x8c, 0xb9,
-	0x34, 0x7b, 0x1f, 0x86, 0x8b, 0x84, 0x4a, 0x43, 0x06, 0xd0, 0x96, 0x3f, 0x8a, 0xc8, 0x30, 0x2b,
-	0x70, 0x36, 0xce, 0xf6, 0x08, 0xd6, 0xf6, 0x78, 0x3e, 0x33, 0x33, 0x95, 0x6d, 0x07, 0x75, 0x7c,
-	0x56, 0x3e, 0x8f, 0x05, 0xcc, 0xbe, 0x01, 0xfd, 0xd3, 0x20, 0xcc, 0x79, 0x2a, 0x77, 0x8e, 0x99,
-	0x5c, 0xaf, 0x90, 0xed, 0x9f, 0xd6, 0xc1, 0x9a, 0x56, 0x53, 0xc4, 0xc9, 0x98, 0xdb, 0x35, 0xcc,
-	0x4a, 0xd7, 0x60, 0xd0, 0x18, 0x63, 0x63, 0x97, 0x35, 0x83, 0xdf, 0x65, 0xa1, 0x35, 0x16, 0x14,
-	0xda, 0x06, 0xdc, 0x90, 0xd3, 0x5f, 0xac, 0xf6, 0x1a, 0xb9, 0x40, 0x4c, 0xa1, 0x71, 0x60, 0x9e,
-	0x42, 0xd1, 0xba, 0x21, 0xfa, 0xcd, 0x3c, 0x92, 0x36, 0x8d, 0xb7, 0xbf, 0xc2, 0x34, 0x9e, 0x08,
-	0x82, 0xf8, 0xe9, 0x46, 0xba, 0xac, 0x23, 0x84, 0xcf, 0x21, 0xb1, 0xb7, 0x61, 0x25, 0xe1, 0x11,
-	0x2e, 0xc4, 0x1a, 0x7f, 0x97, 0xf8, 0x67, 0x09, 0x78, 0x4d, 0x7a, 0x2a, 0x35, 0x5e, 0x10, 0xd7,
-	0x9c, 0x42, 0xdb, 0x7f, 0x30, 0xe0, 0x56, 0x19, 0x06, 0xfa, 0x49, 0xea, 0x05, 0xdb, 0xe9, 0x1a,
-	0x74, 0xb2, 0xd4, 0x23, 0x4e, 0xf5, 0x72, 0x2a, 0x98, 0x3a, 0x79, 0x96, 0x0b, 0x9a, 0x7c, 0x66,
-	0x14, 0xfc, 0xe2, 0xd8, 0x0c, 0xa0, 0x3d, 0xae, 0x3e, 0x9f, 0x12, 0xb4, 0xff, 0x66, 0xc0, 0xab,
-	0x73, 0xb3, 0xf2, 0x7f, 0xf8, 0x79, 0x13, 0x8a, 0xd0, 0x65, 0xb2, 0x99, 0x5d, 0xbf, 0x25, 0xe0,
-	0xbc, 0xf1, 0x21, 0x2c, 0xe5, 0xa5, 0x67, 0xb8, 0xfa, 0x79, 0xf3, 0x95, 0xea, 0x41, 0xcd, 0x79,
-	0x4e, 0x95, 0xdf, 0x3e, 0x87, 0x57, 0x2a, 0xf6, 0x57, 0x3a, 0xd7, 0x36, 0x4d, 0xe1, 0xc8, 0xcb,
-	0x65, 0xff, 0xba, 0xad, 0x09, 0x16, 0x53, 0x2f, 0x51, 0x9d, 0x82, 0xaf, 0x52, 0x88, 0x66, 0xb5,
-	0x10, 0xed, 0xdf, 0x9b, 0x70, 0x63, 0x4a, 0x15, 0x5b, 0x06, 0x33, 0xf0, 0x65, 0x20, 0xcd, 0xc0,
-	0x5f, 0x58, 0x54, 0x7a, 0x70, 0xeb, 0x53, 0xc1, 0xc5, 0x36, 0x92, 0x7a, 0xbb, 0x6e, 0xee, 0xca,
-	0x57, 0x5a, 0x81, 0x95, 0xb0, 0x37, 0xa7, 0xc2, 0x3e, 0x80, 0xb6, 0x9f, 0xe5, 0x74, 0x4a, 0xd4,
-	0x8e, 0x02, 0xb1, 0x01, 0x53, 0x36, 0xd2, 0x0f, 0x35, 0x62, 0xee, 0x29, 0x11, 0x6c, 0xab, 0x58,
-	0xbd, 0x3a, 0xd7, 0xfa, 0x44, 0x72, 0x15, 0x53, 0x4f, 0x57, 0xb6, 0x0e, 0x9c, 0x7a, 0xb4, 0x8c,
-	0x82, 0x6a, 0x46, 0x3d, 0x9b, 0x6a, 0x73, 0x32, 0x20, 0x2f, 0x9d, 0x4f, 0x6f, 0xa9, 0x61, 0x58,
-	0xa4, 0xd2, 0xcd, 0x6a, 0x46, 0x54, 0xe6, 0xe1, 0x5f, 0x19, 0x70, 0x57, 0x3d, 0x99, 0xf3, 0x13,
-	0xe1, 0x9e, 0xf6, 0x84, 0xcd, 0x4a, 0x92, 0x4f, 0x19, 0x4d, 0xd1, 0x1f, 0x87, 0xa1, 0x58, 0x7f,
-	0x4c, 0x35, 0x45, 0x2b, 0x4c, 0x25, 0x33, 0xea, 0x53, 0x2d, 0x7a, 0x95, 0xac, 0x7d, 0x24, 0x7e,
-	0x0e, 0x6f, 0x38, 0x02, 0xb0, 0x3f, 0x85, 0xe1, 0x22, 0xbb, 0x5e, 0xd6, 0x1f, 0x9b, 0xe7, 0xd0,
-	0x12, 0x73, 0x0f, 0x5b, 0x82, 0xee, 0xa3, 0x88, 0x6a, 0xe8, 0x20, 0xb1, 0x6a, 0xac, 0x03, 0x8d,
-	0xc3, 0x3c, 0x4e, 0x2c, 0x83, 0x75, 0xa1, 0xf9, 0x04, 0x07, 0x5f, 0xcb, 0x64, 0x00, 0x2d, 0x6c,
-	0x8c, 0x63, 0x6e, 0xd5, 0x11, 0x7d, 0x98, 0xbb, 0x69, 0x6e, 0x35, 0x10, 0x2d, 0x5e, 0x30, 0xab,
-	0xc9, 0x96, 0x01, 0x3e, 0x9e, 0xe4, 0xb1, 0x64, 0x6b, 0x21, 0x6d, 0x97, 0x87, 0x3c, 0xe7, 0x56,
-	0x7b, 0xf3, 0x27, 0x74, 0x64, 0x84, 0x2f, 0x6d, 0x5f, 0xea, 0x22, 0xd8, 0xaa, 0xb1, 0x36, 0xd4,
-	0x3f, 0xe3, 0x97, 0x96, 0xc1, 0x7a, 0xd0, 0x76, 0x26, 0x51, 0x14, 0x44, 0x23, 0xa1, 0x8f, 0x54,
-	0xfb, 0x56, 0x1d, 0x09, 0x68, 0x50, 0xc2, 0x7d, 0xab, 0xc1, 0xfa, 0xd0, 0xf9, 0x44, 0xfe, 0xce,
-	0x6d, 0x35, 0x91, 0x84, 0x6c, 0x78, 0xa6, 0x85, 0x24, 0x52, 0x8e, 0x50, 0x1b, 0x21, 0x3a, 0x85,
-	0x50, 0x67, 0xf3, 0x00, 0x3a, 0x6a, 0xc9, 0x63, 0x37, 0xa0, 0x27, 0x6d, 0x40, 0x94, 0x55, 0xc3,
-	0x0b, 0xd1, 0xbb, 0x6c, 0x19, 0x78, 0x79, 0x5c, 0xd7, 0x2c, 0x13, 0xbf, 0x70, 0x27, 0xb3, 0xea,
-	0xe4, 0x90, 0xab, 0xc8, 0xb3, 0x1a, 0xc8, 0x48, 0xb3, 0xbd, 0xe5, 0x6f, 0x3e, 0x86, 0x36, 0x7d,
-	0x1e, 0xe0, 0xc8, 0xb2, 0x2c, 0xe5, 0x49, 0x8c, 0x55, 0x43, 0x9f, 0xa2, 0x76, 0xc1, 0x6d, 0xa0,
-	0x6f, 0xe8, 0x3a, 0x02, 0x36, 0xd1, 0x04, 0xe1, 0x27, 0x81, 0xa8, 0x6f, 0xfe, 0xcc, 0x80, 0x8e,
-	0x9a, 0xca, 0xd9, 0x4d, 0xb8, 0xa1, 0x9c, 0x24, 0x51, 0x42, 0xe2, 0x1e, 0xcf, 0x05, 0xc2, 0x32,
-	0x48, 0x41, 0x01, 0x9a, 0xe8, 0x57, 0x87, 0x8f, 0xe3, 0x0b, 0x2e, 0x31, 0x75, 0x54, 0x89, 0x4b,
-	0xa0, 0x84, 0x1b, 0x78, 0x00, 0x61, 0x2a, 0x75, 0xab, 0xc9, 0x6e, 0x03, 0x43, 0xf0, 0x71, 0x30,
-	0xc2, 0x74, 0x12, 0xa3, 0x72, 0x66, 0xb5, 0x36, 0x3f, 0x82, 0x8e, 0x9a, 0x48, 0x35, 0x3b, 0x14,
-	0xaa, 0xb0, 0x43, 0x20, 0x2c, 0xa3, 0x54, 0x2c, 0x31, 0xe6, 0xe6, 0x31, 0x6d, 0x72, 0x38, 0xd0,
-	0x69, 0x9e, 0x91, 0x18, 0x99, 0x5e, 0xe7, 0x41, 0x22, 0x03, 0xce, 0x93, 0xd0, 0xf5, 0x8a, 0x04,
-	0xbb, 0xe0, 0x69, 0x6e, 0xd5, 0xf1, 0xfb, 0x51, 0xf4, 0x23, 0xee, 0x61, 0x86, 0x61, 0x18, 0x82,
-	0x2c, 0xb7, 0x9a, 0x9b, 0xfb, 0xd0, 0x3b, 0x56, 0x8d, 0xfe, 0x20, 0xc1, 0x0b, 0x28, 0xe3, 0x4a,
-	0xac, 0x55, 0x43, 0x9d, 0x94, 0x9d, 0x05, 0xd6, 0x32, 0xd8, 0x0a, 0x2c, 0x61, 0x34, 0x4a, 0x94,
-	0xb9, 0xf9, 0x14, 0xd8, 0x6c, 0x8b, 0x42, 0xa7, 0x95, 0x06, 0x5b, 0x35, 0xb4, 0xe4, 0x33, 0x7e,
-	0x89, 0xdf, 0x14, 0xc3, 0x47, 0xa3, 0x28, 0x4e, 0x39, 0xd1, 0x54, 0x0c, 0xe9, 0xa7, 0x38, 0x44,
-	0xd4, 0x37, 0x8f, 0xa7, 0x9a, 0xf9, 0x41, 0xa2, 0xa5, 0x3b, 0xc1, 0x56, 0x8d, 0x92, 0x8f, 0xa4,
-	0x08, 0x84, 0x74, 0x20, 0x89, 0x11, 0x18, 0x13, 0x15, 0xed, 0x84, 0xdc, 0x4d, 0x05, 0x5c, 0xdf,
-	0xfe, 0x53, 0x0b, 0x5a, 0x62, 0x66, 0x65, 0x1f, 0x41, 0x4f, 0xfb, 0xa3, 0x21, 0xa3, 0x4e, 0x3b,
-	0xfb, 0x27, 0xce, 0xb5, 0xaf, 0xcd, 0xe0, 0x45, 0x7b, 0xb0, 0x6b, 0xec, 0x43, 0x80, 0x72, 0x47,
-	0x65, 0xb7, 0x68, 0xf0, 0x99, 0xde, 0x59, 0xd7, 0x06, 0xf4, 0xeb, 0xc6, 0x9c, 0x3f, 0x88, 0xda,
-	0x35, 0xf6, 0x3d, 0x58, 0x92, 0x3d, 0x48, 0xa4, 0x16, 0x1b, 0x6a, 0x1b, 0xc6, 0x9c, 0xed, 0xf3,
-	0x5a, 0x61, 0x9f, 0x14, 0xc2, 0x44, 0xfa, 0xb0, 0xc1, 0x9c, 0x75, 0x45, 0x88, 0x79, 0x65, 0xe1,
-	0x22, 0x63, 0xd7, 0xd8, 0x1e, 0xf4, 0xc4, 0xba, 0x21, 0x3a, 0xeb, 0x1d, 0xe4, 0x5d, 0xb4, 0x7f,
-	0x5c, 0x6b, 0xd0, 0x0e, 0xf4, 0xf5, 0x0d, 0x81, 0x91, 0x27, 0xe7, 0xac, 0x12, 0x42, 0xc8, 0xbc,
-	0x65, 0xc2, 0xae, 0x31, 0x17, 0x6e, 0xcf, 0x9f, 0xf3, 0xd9, 0xeb, 0xe5, 0xcf, 0xb0, 0x0b, 0x16,
-	0x8b, 0x35, 0xfb, 0x3a, 0x96, 0x42, 0xc5, 0x0f, 0x60, 0x50, 0x28, 0x2f, 0xd2, 0x5a, 0x66, 0xc5,
-	0x50, 0x9a, 0xb6, 0x60, 0x35, 0x58, 0x7b, 0x6d, 0x21, 0xbd, 0x10, 0x7f, 0x04, 0x2b, 0x25, 0x43,
-	0x2c, 0xdc, 0xc7, 0xee, 0xce, 0x9c, 0xab, 0xb8, 0x75, 0xb8, 0x88, 0x5c, 0x48, 0xfd, 0x61, 0xb9,
-	0xdc, 0x56, 0x25, 0xbf, 0xae, 0xc7, 0x76, 0xbe, 0x74, 0xfb, 0x3a, 0x16, 0xa5, 0xe1, 0xfe, 0xe0,
-	0x9f, 0x5f, 0x0e, 0x8d, 0x2f, 0xbe, 0x1c, 0x1a, 0xff, 0xf9, 0x72, 0x68, 0xfc, 0xe2, 0xf9, 0xb0,
-	0xf6, 0xc5, 0xf3, 0x61, 0xed, 0xdf, 0xcf, 0x87, 0xb5, 0x93, 0x16, 0xfd, 0x5b, 0xc0, 0x37, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x92, 0x9d, 0xb5, 0xc0, 0x28, 0x20, 0x00, 0x00,
+	// 2909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x24, 0x57,
+	0x11, 0x9f, 0x9e, 0xef, 0xa9, 0xb1, 0xbd, 0xbd, 0x6f, 0xbd, 0x4b, 0xc7, 0xd9, 0x9d, 0x38, 0xbd,
+	0x51, 0x70, 0x2c, 0x58, 0x25, 0x66, 0x21, 0x28, 0x12, 0x24, 0x59, 0x7b, 0xe3, 0xdd, 0x60, 0xc7,
+	0xbb, 0x6d, 0x67, 0x39, 0x21, 0xd1, 0x9e, 0x7e, 0x1e, 0x37, 0xee, 0xe9, 0xee, 0xed, 0xd7, 0x63,
+	0xcb, 0x07, 0xc4, 0x05, 0x71, 0x85, 0x0b, 0x48, 0x20, 0x38, 0x80, 0x84, 0xc4, 0x89, 0x03, 0xe2,
+	0xcc, 0x11, 0x38, 0x46, 0x9c, 0x38, 0xa2, 0xe4, 0x1f, 0x41, 0x55, 0xef, 0xbd, 0xee, 0xd7, 0xf3,
+	0xe1, 0xcd, 0x22, 0x71, 0xeb, 0xfa, 0x55, 0xbd, 0x7a, 0xd5, 0x55, 0xf5, 0xea, 0x55, 0xf5, 0x0c,
+	0xac, 0x04, 0xe3, 0x8b, 0x24, 0x3b, 0xe3, 0xd9, 0xbd, 0x34, 0x4b, 0xf2, 0x84, 0xd5, 0xd3, 0x63,
+	0x77, 0x03, 0xd8, 0xd3, 0x09, 0xcf, 0x2e, 0x0f, 0x73, 0x3f, 0x9f, 0x08, 0x8f, 0x3f, 0x9f, 0x70,
+	0x91, 0x33, 0x06, 0xcd, 0xd8, 0x1f, 0x73, 0xc7, 0x5a, 0xb7, 0x36, 0x7a, 0x1e, 0x3d, 0xbb, 0x29,
+	0xac, 0x6e, 0x27, 0xe3, 0x71, 0x12, 0x7f, 0x9f, 0x74, 0x78, 0x5c, 0xa4, 0x49, 0x2c, 0x38, 0xbb,
+	0x05, 0xed, 0x8c, 0x8b, 0x49, 0x94, 0x93, 0x74, 0xd7, 0x53, 0x14, 0xb3, 0xa1, 0x31, 0x16, 0x23,
+	0xa7, 0x4e, 0x2a, 0xf0, 0x11, 0x25, 0x45, 0x32, 0xc9, 0x86, 0xdc, 0x69, 0x10, 0xa8, 0x28, 0xc4,
+	0xa5, 0x5d, 0x4e, 0x53, 0xe2, 0x92, 0x72, 0xff, 0x6c, 0xc1, 0x8d, 0x8a, 0x71, 0x2f, 0xbd, 0xe3,
+	0x7d, 0x58, 0x92, 0x7b, 0x48, 0x0d, 0xb4, 0x6f, 0x7f, 0xcb, 0xbe, 0x97, 0x1e, 0xdf, 0x3b, 0x34,
+	0x70, 0xaf, 0x22, 0xc5, 0xde, 0x85, 0x65, 0x31, 0x39, 0x3e, 0xf2, 0xc5, 0x99, 0x5a, 0xd6, 0x5c,
+	0x6f, 0x6c, 0xf4, 0xb7, 0xae, 0xd3, 0x32, 0x93, 0xe1, 0x55, 0xe5, 0xdc, 0x3f, 0x5a, 0xd0, 0xdf,
+	0x3e, 0xe5, 0x43, 0x45, 0xa3, 0xa1, 0xa9, 0x2f, 0x04, 0x0f, 0xb4, 0xa1, 0x92, 0x62, 0xab, 0xd0,
+	0xca, 0x93, 0xdc, 0x8f, 0xc8, 0xd4, 0x96, 0x27, 0x09, 0x36, 0x00, 0x10, 0x93, 0xe1, 0x90, 0x0b,
+	0x71, 0x32, 0x89, 0xc8, 0xd4, 0x96, 0x67, 0x20, 0xa8, 0xed, 0xc4, 0x0f, 0x23, 0x1e, 0x90, 0x9b,
+	0x5a, 0x9e, 0xa2, 0x98, 0x03, 0x9d, 0x0b, 0x3f, 0x8b, 0xc3, 0x78, 0xe4, 0xb4, 0x88, 0xa1, 0x49,
+	0x5c, 0x11, 0xf0, 0xdc, 0x0f, 0x23, 0xa7, 0xbd, 0x6e, 0x6d, 0x2c, 0x79, 0x8a, 0x72, 0x3f, 0xb3,
+	0x00, 0x76, 0x26, 0xe3, 0x54, 0x99, 0xb9, 0x0e, 0x7d, 0xb2, 0xe0, 0xc8, 0x3f, 0x8e, 0xb8, 0x20,
+	0x5b, 0x1b, 0x9e, 0x09, 0xb1, 0x0d, 0xb8, 0x36, 0x4c, 0xc6, 0x69, 0xc4, 0x73, 0x1e, 0x28, 0x29,
+	0x34, 0xdd, 0xf2, 0xa6, 0x61, 0xf6, 0x06, 0x2c, 0x9f, 0x84, 0x71, 0x28, 0x4e, 0x79, 0xf0, 0xe0,
+	0x32, 0xe7, 0xd2, 0xe5, 0x96, 0x57, 0x05, 0x99, 0x0b, 0x4b, 0x1a, 0xf0, 0x92, 0x0b, 0x41, 0x2f,
+	0x64, 0x79, 0x15, 0x8c, 0x7d, 0x0d, 0xae, 0x73, 0x91, 0x87, 0x63, 0x3f, 0xe7, 0x47, 0x68, 0x0a,
+	0x09, 0xb6, 0x48, 0x70, 0x96, 0xe1, 0xfe, 0xb5, 0x01, 0xb0, 0x97, 0xf8, 0x81, 0x7a, 0xa5, 0x19,
+	0x33, 0xe4, 0x4b, 0x4d, 0x99, 0x31, 0x00, 0xa0, 0xb7, 0x94, 0x22, 0x75, 0x12, 0x31, 0x10, 0xb6,
+	0x06, 0xdd, 0x34, 0x4b, 0x46, 0x19, 0x17, 0x42, 0xa5, 0x6c, 0x41, 0xe3, 0xda, 0x31, 0xcf, 0xfd,
+	0x07, 0x61, 0x1c, 0x25, 0x23, 0x95, 0xb8, 0x06, 0xc2, 0xde, 0x84, 0x95, 0x92, 0xda, 0x3d, 0x7a,
+	0xbc, 0x43, 0xb6, 0xf7, 0xbc, 0x29, 0x14, 0x2d, 0x1d, 0x62, 0xca, 0x88, 0xc9, 0xf8, 0x30, 0xf7,
+	0x47, 0x9c, 0x42, 0xd5, 0xf3, 0xaa, 0x20, 0xbb, 0x0f, 0x37, 0x35, 0x40, 0x09, 0xc6, 0x83, 0xed,
+	0xd3, 0x49, 0x7c, 0x26, 0x9c, 0x0e, 0x19, 0x3d, 0x9f, 0xc9, 0xde, 0x86, 0x1b, 0x9a, 0x41, 0x9e,
+	0x52, 0x6b, 0xba, 0xb4, 0x66, 0x1e, 0x8b, 0x7d, 0x0b, 0x6e, 0x69, 0xf8, 0xc3, 0x9c, 0x52, 0x24,
+	0xf6, 0x53, 0x71, 0x9a, 0xe4, 0x4e, 0x8f, 0x32, 0x78, 0x01, 0xd7, 0x5c, 0xb7, 0x1f, 0x8a, 0xb1,
+	0x9f, 0x0f, 0x4f, 0xd5, 0x66, 0xb0, 0xde, 0xd8, 0xe8, 0x79, 0x0b, 0xb8, 0xee, 0x2f, 0x2d, 0x58,
+	0x3e, 0x3c, 0xf5, 0xb3, 0x20, 0x8c, 0x47, 0xbb, 0x59, 0x32, 0x49, 0x31, 0x67, 0x73, 0x3f, 0x1b,
+	0xf1, 0x5c, 0x15, 0x1f, 0x45, 0x61, 0x49, 0xda, 0xd9, 0xd9, 0xc3, 0x28, 0xa1, 0x3e, 0x7a, 0x96,
+	0x51, 0xce, 0x44, 0xbe, 0x97, 0x0c, 0xfd, 0x3c, 0x4c, 0x62, 0x15, 0xa4, 0x2a, 0x88, 0x1a, 0xc5,
+	0x65, 0x3c, 0xa4, 0x73, 0x83, 0x6b, 0x15, 0x85, 0xd1, 0x9d, 0xc4, 0x8a, 0xd3, 0x22, 0x4e, 0x41,
+	0xbb, 0xbf, 0x6b, 0x02, 0x1c, 0x5e, 0xc6, 0xc3, 0xa9, 0x13, 0xf2, 0xf0, 0x9c, 0xc7, 0x79, 0xf5,
+	0x84, 0x48, 0x08, 0x95, 0xc9, 0x03, 0x93, 0xea, 0x44, 0x2a, 0x68, 0x76, 0x1b, 0x7a, 0x19, 0x1f,
+	0xf2, 0x38, 0x47, 0x66, 0x83, 0x98, 0x25, 0x80, 0x67, 0x61, 0xec, 0x8b, 0x9c, 0x67, 0x95, 0x54,
+	0xaa, 0x60, 0x6c, 0x13, 0x6c, 0x93, 0xde, 0xcd, 0xc3, 0x40, 0xa5, 0xd3, 0x0c, 0x8e, 0xfa, 0xe8,
+	0x25, 0xb4, 0x3e, 0x99, 0x4f, 0x15, 0x0c, 0xf5, 0x99, 0x34, 0xe9, 0xeb, 0x48, 0x7d, 0xd3, 0x38,
+	0xea, 0x3b, 0x8e, 0x92, 0xe1, 0x59, 0x18, 0x8f, 0x28, 0x00, 0x5d, 0x72, 0x55, 0x05, 0x63, 0xdf,
+	0x01, 0x7b, 0x12, 0x67, 0x5c, 0x24, 0xd1, 0x39, 0x0f, 0x28, 0x8e, 0xc2, 0xe9, 0x19, 0x45, 0xd3,
+	0x8c, 0xb0, 0x37, 0x23, 0x6a, 0x44, 0x08, 0x64, 0x9d, 0x94, 0x14, 0x9e, 0xb1, 0x63, 0x32, 0xe4,
+	0xe8, 0x32, 0xe5, 0x4e, 0x5f, 0x9e, 0xb1, 0x12, 0xc1, 0xfc, 0x16, 0x7c, 0x98, 0xc4, 0x81, 0x78,
+	0xc0, 0x4f, 0xc3, 0x38, 0xd8, 0x27, 0x5f, 0x38, 0x4b, 0x32, 0xbf, 0xe7, 0xb0, 0x30, 0x63, 0xc8,
+	0xf0, 0x9d, 0x9d, 0xbd, 0x83, 0x8b, 0x98, 0x67, 0xce, 0xb2, 0xcc, 0x98, 0x0a, 0x88, 0xe1, 0x1e,
+	0x26, 0xf1, 0x49, 0x14, 0x0e, 0xf3, 0x7d, 0x31, 0x72, 0x56, 0x48, 0xc6, 0x84, 0xdc, 0xdf, 0x5a,
+	0xb0, 0x64, 0xde, 0x20, 0xc6, 0xdd, 0x66, 0x2d, 0xb8, 0xdb, 0xea, 0xe6, 0xdd, 0xc6, 0xde, 0x2a,
+	0xee, 0x30, 0x79, 0x27, 0x91, 0x9f, 0x9e, 0x64, 0x09, 0x16, 0x7b, 0x8f, 0x18, 0xc5, 0xb5, 0xf6,
+	0x0e, 0xf4, 0x33, 0x1e, 0xf9, 0x97, 0xc5, 0x65, 0x84, 0xf2, 0xd7, 0x50, 0xde, 0x2b, 0x61, 0xcf,
+	0x94, 0x71, 0xff, 0x51, 0x87, 0xbe, 0xc1, 0x9c, 0xc9, 0x31, 0xeb, 0x4b, 0xe6, 0x58, 0x7d, 0x41,
+	0x8e, 0xad, 0x6b, 0x93, 0x26, 0xc7, 0x3b, 0x61, 0xa6, 0x8e, 0x9d, 0x09, 0x15, 0x12, 0x95, 0xa4,
+	0x36, 0x21, 0xbc, 0x53, 0x0c, 0xd2, 0x48, 0xe9, 0x69, 0x98, 0xdd, 0x03, 0x46, 0xd0, 0x36, 0x16,
+	0x8e, 0x4f, 0x53, 0x15, 0xe5, 0x36, 0xa5, 0xca, 0x1c, 0x0e, 0x7b, 0x0d, 0x5a, 0x82, 0x4a, 0x29,
+	0xa6, 0xf4, 0xca, 0x56, 0x8f, 0x52, 0x10, 0x01, 0x4f, 0xe2, 0x86, 0xf3, 0xbb, 0x2f, 0x70, 0xbe,
+	0xfb, 0x97, 0x06, 0x2c, 0x57, 0xee, 0xfc, 0x79, 0xbd, 0x51, 0xb9, 0x63, 0x7d, 0xc1, 0x8e, 0xeb,
+	0xd0, 0x9c, 0xc4, 0xa1, 0x0c, 0xf6, 0xca, 0xd6, 0x12, 0xf2, 0x3f, 0x8d, 0xc3, 0x1c, 0xb3, 0xd8,
+	0x23, 0x8e, 0x61, 0x53, 0xf3, 0x45, 0x09, 0xf1, 0x36, 0xdc, 0x28, 0x8f, 0xd0, 0xce, 0xce, 0xde,
+	0x5e, 0x32, 0x3c, 0x2b, 0xee, 0x97, 0x79, 0x2c, 0xc6, 0x64, 0x67, 0x44, 0xa5, 0xe0, 0x51, 0x4d,
+	0xf6, 0x46, 0x5f, 0x85, 0x16, 0x15, 0x65, 0xa7, 0x53, 0x26, 0x94, 0xd1, 0xbc, 0x3c, 0xaa, 0x79,
+	0x92, 0xcf, 0xde, 0x80, 0x66, 0x30, 0x19, 0xa7, 0xca, 0x57, 0x2b, 0x28, 0x57, 0x36, 0x0f, 0x8f,
+	0x6a, 0x1e, 0x71, 0x51, 0x2a, 0x4a, 0xfc, 0xc0, 0xe9, 0x95, 0x52, 0xe5, 0x7d, 0x8c, 0x52, 0xc8,
+	0x45, 0x29, 0x3c, 0xdb, 0x0e, 0x94, 0x52, 0x65, 0x99, 0x45, 0x29, 0xe4, 0xb2, 0xfb, 0x00, 0xe7,
+	0x7e, 0x14, 0x06, 0xb2, 0xa8, 0xf7, 0x49, 0x76, 0x15, 0x65, 0x9f, 0x15, 0xa8, 0xca, 0x7a, 0x43,
+	0xee, 0x41, 0x17, 0xda, 0x42, 0xa6, 0xff, 0x77, 0xe1, 0x7a, 0x25, 0x66, 0x7b, 0xa1, 0x20, 0x07,
+	0x4b, 0xb6, 0x63, 0x2d, 0x6a, 0xe7, 0xf4, 0xfa, 0x01, 0x00, 0x79, 0xe2, 0x61, 0x96, 0x25, 0x99,
+	0x6e, 0x2b, 0xad, 0xa2, 0xad, 0x74, 0xef, 0x40, 0x0f, 0x3d, 0x70, 0x05, 0x1b, 0x5f, 0x7d, 0x11,
+	0x3b, 0x85, 0x25, 0x7a, 0xe7, 0xa7, 0x7b, 0x0b, 0x24, 0xd8, 0x16, 0xac, 0xca, 0xde, 0x4e, 0x1e,
+	0x82, 0x27, 0x89, 0x08, 0xc9, 0x13, 0xf2, 0x38, 0xce, 0xe5, 0xe1, 0x05, 0xc4, 0x51, 0xdd, 0xe1,
+	0xd3, 0x3d, 0xdd, 0xab, 0x68, 0xda, 0xfd, 0x26, 0xf4, 0x70, 0x47, 0xb9, 0xdd, 0x06, 0xb4, 0x89,
+	0xa1, 0xfd, 0x60, 0x17, 0x41, 0x50, 0x06, 0x79, 0x8a, 0xef, 0xfe, 0xdc, 0x82, 0xbe, 0x2c, 0x72,
+	0x72, 0xe5, 0xcb, 0xd6, 0xb8, 0xf5, 0xca, 0x72, 0x5d, 0x25, 0x4c, 0x8d, 0xf7, 0x00, 0xa8, 0x4c,
+	0x49, 0x81, 0x66, 0x99, 0x14, 0x25, 0xea, 0x19, 0x12, 0x18, 0x98, 0x92, 0x9a, 0xe3, 0xda, 0x5f,
+	0xd7, 0x61, 0x49, 0x85, 0x54, 0x8a, 0xfc, 0x9f, 0x0e, 0xab, 0x3a, 0x4f, 0x4d, 0xf3, 0x3c, 0xbd,
+	0xa9, 0xcf, 0x53, 0xab, 0x7c, 0x8d, 0x32, 0x8b, 0xca, 0xe3, 0x74, 0x57, 0x1d, 0xa7, 0x36, 0x89,
+	0x2d, 0xeb, 0xe3, 0xa4, 0xa5, 0x88, 0x89, 0x42, 0x74, 0x9a, 0x3a, 0xa5, 0x50, 0x91, 0x52, 0xc5,
+	0x61, 0xba, 0xab, 0x0e, 0x53, 0xb7, 0x14, 0x2a, 0xc2, 0xac, 0xcf, 0xd2, 0x83, 0x0e, 0xb4, 0x28,
+	0x9c, 0xee, 0x7b, 0x60, 0x9b, 0xae, 0xa1, 0x33, 0xf1, 0xa6, 0x62, 0x56, 0x52, 0xc1, 0x10, 0xf2,
+	0xd4, 0xda, 0xe7, 0xb0, 0x5c, 0x29, 0x45, 0x78, 0x33, 0x87, 0x62, 0xdb, 0x8f, 0x87, 0x3c, 0x2a,
+	0xa6, 0x1b, 0x03, 0x31, 0x92, 0xac, 0x5e, 0x6a, 0x56, 0x2a, 0x2a, 0x49, 0x66, 0xcc, 0x28, 0x8d,
+	0xca, 0x8c, 0xf2, 0x2f, 0x0b, 0x96, 0xcc, 0x05, 0x38, 0xe6, 0x3c, 0xcc, 0xb2, 0xed, 0x24, 0x90,
+	0xd1, 0x6c, 0x79, 0x9a, 0xc4, 0xd4, 0xc7, 0xc7, 0xc8, 0x17, 0x42, 0x65, 0x60, 0x41, 0x2b, 0xde,
+	0xe1, 0x30, 0x49, 0xf5, 0xd4, 0x59, 0xd0, 0x8a, 0xb7, 0xc7, 0xcf, 0x79, 0xa4, 0x2e, 0xa8, 0x82,
+	0xc6, 0xdd, 0xf6, 0xb9, 0x10, 0x98, 0x26, 0xb2, 0xae, 0x6a, 0x12, 0x57, 0x79, 0xfe, 0xc5, 0xb6,
+	0x3f, 0x11, 0xba, 0x57, 0x2f, 0x68, 0x74, 0x0b, 0x4e, 0xc7, 0x7e, 0x96, 0x4c, 0x62, 0xdd, 0x51,
+	0x19, 0x88, 0x7b, 0x01, 0xd7, 0x9f, 0x4c, 0xb2, 0x11, 0xa7, 0x24, 0xd6, 0xc3, 0xf6, 0x1a, 0x74,
+	0xc3, 0xd8, 0x1f, 0xe6, 0xe1, 0x39, 0x57, 0x9e, 0x2c, 0x68, 0xcc, 0xdf, 0x3c, 0x1c, 0x73, 0xd5,
+	0x52, 0xd2, 0x33, 0xca, 0x9f, 0x84, 0x11, 0xa7, 0xbc, 0x56, 0xaf, 0xa4, 0x69, 0x3a, 0xa2, 0xf2,
+	0x4e, 0x56, 0xa3, 0xb4, 0xa4, 0xdc, 0xdf, 0xd4, 0x61, 0xed, 0x20, 0xe5, 0x99, 0x9f, 0x73, 0x39,
+	0xbe, 0x1f, 0x0e, 0x4f, 0xf9, 0xd8, 0xd7, 0x26, 0xdc, 0x86, 0x7a, 0x92, 0x3a, 0x56, 0x99, 0xef,
+	0x92, 0x7d, 0x90, 0x7a, 0xf5, 0x24, 0x25, 0x23, 0x7c, 0x71, 0xa6, 0x7c, 0x4b, 0xcf, 0x0b, 0x67,
+	0xf9, 0x35, 0xe8, 0x06, 0x7e, 0xee, 0x1f, 0xfb, 0x82, 0x6b, 0x9f, 0x6a, 0x9a, 0xc6, 0x5e, 0x9c,
+	0x12, 0x95, 0x47, 0x25, 0x41, 0x9a, 0x68, 0x37, 0xe5, 0x4d, 0x45, 0xa1, 0xf4, 0x49, 0x34, 0x11,
+	0xa7, 0xe4, 0xc6, 0xae, 0x27, 0x09, 0xb4, 0xa5, 0xc8, 0xf9, 0xae, 0xba, 0x2e, 0x06, 0x00, 0x27,
+	0x59, 0x32, 0x96, 0x85, 0x45, 0x0d, 0x2a, 0x06, 0xa2, 0xf9, 0x47, 0x72, 0xac, 0x80, 0x92, 0x2f,
+	0x11, 0x37, 0x87, 0xe5, 0x67, 0xef, 0xa8, 0xb4, 0xdf, 0xe7, 0xb9, 0xcf, 0xd6, 0x0c, 0x77, 0x00,
+	0xba, 0x03, 0x39, 0xca, 0x19, 0x2f, 0xac, 0x1e, 0xba, 0xe4, 0x34, 0x8c, 0x92, 0xa3, 0x3d, 0xd8,
+	0xa4, 0x14, 0xa7, 0x67, 0xf7, 0x3e, 0xac, 0xaa, 0x88, 0x3c, 0x7b, 0x07, 0x77, 0x5d, 0x18, 0x0b,
+	0xc9, 0x96, 0xdb, 0xbb, 0x7f, 0xb7, 0xe0, 0xe6, 0xd4, 0xb2, 0x97, 0xfe, 0x2a, 0xf2, 0x2e, 0x34,
+	0x71, 0x08, 0x75, 0x1a, 0x74, 0x34, 0xef, 0xe2, 0x1e, 0x73, 0x55, 0xde, 0x43, 0xe2, 0x61, 0x9c,
+	0x67, 0x97, 0x1e, 0x2d, 0x58, 0xfb, 0x18, 0x7a, 0x05, 0x84, 0x7a, 0xcf, 0xf8, 0xa5, 0xae, 0xbe,
+	0x67, 0xfc, 0x12, 0x3b, 0x8a, 0x73, 0x3f, 0x9a, 0x48, 0xd7, 0xa8, 0x0b, 0xb6, 0xe2, 0x58, 0x4f,
+	0xf2, 0xdf, 0xab, 0x7f, 0xdb, 0x72, 0x7f, 0x0c, 0xce, 0x23, 0x3f, 0x0e, 0x22, 0x95, 0x8f, 0xb2,
+	0x28, 0x28, 0x17, 0xbc, 0x6a, 0xb8, 0xa0, 0x8f, 0x5a, 0x88, 0x7b, 0x45, 0x36, 0xde, 0x86, 0xde,
+	0xb1, 0xbe, 0x0e, 0x95, 0xe3, 0x4b, 0x00, 0x57, 0x88, 0xe7, 0x91, 0x50, 0xe3, 0x1f, 0x3d, 0xbb,
+	0x37, 0xe1, 0xc6, 0x2e, 0xcf, 0xe5, 0xde, 0xdb, 0x27, 0x23, 0xb5, 0xb3, 0xbb, 0x01, 0xab, 0x55,
+	0x58, 0x39, 0xd7, 0x86, 0xc6, 0xf0, 0xa4, 0xb8, 0x6a, 0x86, 0x27, 0x23, 0xf7, 0x10, 0xee, 0xc8,
+	0x6e, 0x69, 0x72, 0x8c, 0x26, 0x60, 0xe9, 0xfb, 0x34, 0x0d, 0xfc, 0x9c, 0xeb, 0x97, 0xd8, 0x82,
+	0x55, 0x21, 0x79, 0xdb, 0x27, 0xa3, 0xa3, 0x64, 0x1c, 0x1d, 0xe6, 0x59, 0x18, 0x6b, 0x1d, 0x73,
+	0x79, 0xee, 0x1e, 0x0c, 0x16, 0x29, 0x55, 0x86, 0x38, 0xd0, 0x51, 0x9f, 0x84, 0x54, 0x98, 0x35,
+	0x39, 0x1b, 0x67, 0x77, 0x04, 0x6b, 0xbb, 0x3c, 0x9f, 0xe9, 0x99, 0xca, 0xb2, 0x83, 0x7b, 0x7c,
+	0x52, 0x5e, 0x8f, 0x05, 0xcd, 0xbe, 0x8e, 0xdf, 0x67, 0xa2, 0x9c, 0x67, 0x72, 0xc9, 0x6c, 0xae,
+	0x57, 0xd8, 0xee, 0x4f, 0x1b, 0x60, 0x4f, 0x6f, 0x53, 0xc4, 0xc9, 0x9a, 0x5b, 0x35, 0xea, 0x95,
+	0xaa, 0xc1, 0xa0, 0x39, 0xc6, 0xc2, 0xae, 0xce, 0x0c, 0x3e, 0x97, 0x07, 0xad, 0xb9, 0xe0, 0xa0,
+	0x6d, 0xc0, 0x35, 0xd5, 0xfd, 0x25, 0x7a, 0xae, 0x51, 0x03, 0xc4, 0x14, 0x8c, 0x0d, 0xf3, 0x14,
+	0x44, 0xe3, 0x86, 0xac, 0x37, 0xf3, 0x58, 0x46, 0x37, 0xde, 0xf9, 0x12, 0xdd, 0x78, 0x2a, 0x19,
+	0xf2, 0xc3, 0x95, 0x72, 0x59, 0x57, 0x2a, 0x9f, 0xc3, 0xc2, 0x2f, 0x5b, 0x29, 0x8f, 0x71, 0x20,
+	0x36, 0xe4, 0x7b, 0x24, 0x3f, 0xcb, 0xc0, 0xd7, 0xa4, 0xab, 0xd2, 0x90, 0x05, 0xf9, 0x9a, 0x53,
+	0xb0, 0xfb, 0x07, 0x0b, 0x6e, 0x96, 0x61, 0xa0, 0x0f, 0x72, 0x2f, 0x98, 0x4e, 0xd7, 0xa0, 0x2b,
+	0xb2, 0x21, 0x49, 0xea, 0x9b, 0x53, 0xd3, 0xc8, 0x0b, 0x44, 0x2e, 0x79, 0xea, 0x9a, 0xd1, 0xf4,
+	0x8b, 0x63, 0xe3, 0x40, 0x67, 0x5c, 0xbd, 0x3e, 0x15, 0xe9, 0xfe, 0xcd, 0x82, 0x57, 0xe7, 0x66,
+	0xe5, 0xff, 0xf0, 0x71, 0x17, 0x8a, 0xd0, 0x09, 0x55, 0xcc, 0xae, 0x9e, 0x12, 0xb0, 0xdf, 0x78,
+	0x1f, 0x96, 0xf3, 0xd2, 0x33, 0x5c, 0x7f, 0xdc, 0x7d, 0xa5, 0xba, 0xd0, 0x70, 0x9e, 0x57, 0x95,
+	0x77, 0xcf, 0xe0, 0x95, 0x8a, 0xfd, 0x95, 0xca, 0xb5, 0x45, 0x5d, 0x38, 0xca, 0x72, 0x55, 0xbf,
+	0x6e, 0x19, 0x8a, 0x65, 0xd7, 0x4b, 0x5c, 0xaf, 0x90, 0xab, 0x1c, 0xc4, 0x7a, 0xf5, 0x20, 0xba,
+	0xbf, 0xaf, 0xc3, 0xb5, 0xa9, 0xad, 0xd8, 0x0a, 0xd4, 0xc3, 0x40, 0x05, 0xb2, 0x1e, 0x06, 0x0b,
+	0x0f, 0x95, 0x19, 0xdc, 0xc6, 0x54, 0x70, 0xb1, 0x8c, 0x64, 0xc3, 0x1d, 0x3f, 0xf7, 0xd5, 0x2d,
+	0xad, 0xc9, 0x4a, 0xd8, 0x5b, 0x53, 0x61, 0x77, 0xa0, 0x13, 0x88, 0x9c, 0x56, 0xc9, 0xb3, 0xa3,
+	0x49, 0x2c, 0xc0, 0x94, 0x8d, 0xf4, 0xa1, 0x46, 0xf6, 0x3d, 0x25, 0xc0, 0xee, 0x15, 0xa3, 0x57,
+	0xf7, 0x4a, 0x9f, 0x28, 0xa9, 0xa2, 0xeb, 0xe9, 0xa9, 0xd2, 0x11, 0x8e, 0x2b, 0x19, 0x05, 0xd5,
+	0x8c, 0x7a, 0x3e, 0x55, 0xe6, 0x54, 0x40, 0x5e, 0x3a, 0x9f, 0xde, 0xd2, 0xcd, 0xb0, 0x4c, 0xa5,
+	0x1b, 0xd5, 0x8c, 0xa8, 0xf4, 0xc3, 0xbf, 0xb2, 0xe0, 0x8e, 0xbe, 0x32, 0xe7, 0x27, 0xc2, 0x5d,
+	0xe3, 0x0a, 0x9b, 0xd5, 0xa4, 0xae, 0x32, 0xea, 0xa2, 0x3f, 0x8c, 0x22, 0x5a, 0xe9, 0xd4, 0x75,
+	0x17, 0xad, 0x91, 0x4a, 0x66, 0x34, 0xa6, 0x4a, 0xf4, 0x2a, 0x59, 0xfb, 0x58, 0xfe, 0x18, 0xd0,
+	0xf4, 0x24, 0xe1, 0x7e, 0x0c, 0x83, 0x45, 0x76, 0xbd, 0xac, 0x3f, 0x36, 0xcf, 0xa0, 0x2d, 0xfb,
+	0x1e, 0xb6, 0x0c, 0xbd, 0xc7, 0x31, 0x9d, 0xa1, 0x83, 0xd4, 0xae, 0xb1, 0x2e, 0x34, 0x0f, 0xf3,
+	0x24, 0xb5, 0x2d, 0xd6, 0x83, 0xd6, 0x13, 0x7f, 0x22, 0xb8, 0x5d, 0x67, 0x00, 0x6d, 0x2c, 0x8c,
+	0x63, 0x6e, 0x37, 0x10, 0x3e, 0xcc, 0xfd, 0x2c, 0xb7, 0x9b, 0x08, 0xcb, 0x1b, 0xcc, 0x6e, 0xb1,
+	0x15, 0x80, 0x0f, 0x27, 0x79, 0xa2, 0xc4, 0xda, 0xc8, 0xdb, 0xe1, 0x11, 0xcf, 0xb9, 0xdd, 0xd9,
+	0xfc, 0x09, 0x2d, 0x19, 0xe1, 0x4d, 0xbb, 0xa4, 0xf6, 0x22, 0xda, 0xae, 0xb1, 0x0e, 0x34, 0x3e,
+	0xe1, 0x17, 0xb6, 0xc5, 0xfa, 0xd0, 0xf1, 0x26, 0x31, 0xfe, 0xb2, 0x21, 0xf7, 0xa3, 0xad, 0x03,
+	0xbb, 0x81, 0x0c, 0x34, 0x28, 0xe5, 0x81, 0xdd, 0x64, 0x4b, 0xd0, 0xfd, 0x48, 0x7d, 0xe5, 0xb7,
+	0x5b, 0xc8, 0x42, 0x31, 0x5c, 0xd3, 0x46, 0x16, 0x6d, 0x8e, 0x54, 0x07, 0x29, 0x5a, 0x85, 0x54,
+	0x77, 0xf3, 0x00, 0xba, 0x7a, 0xc8, 0x63, 0xd7, 0xa0, 0xaf, 0x6c, 0x40, 0xc8, 0xae, 0xe1, 0x0b,
+	0xd1, 0xbd, 0x6c, 0x5b, 0xf8, 0xf2, 0x38, 0xae, 0xd9, 0x75, 0x7c, 0xc2, 0x99, 0xcc, 0x6e, 0x90,
+	0x43, 0x2e, 0xe3, 0xa1, 0xdd, 0x44, 0x41, 0xea, 0xed, 0xed, 0x60, 0x73, 0x1f, 0x3a, 0xf4, 0x78,
+	0x80, 0x2d, 0xcb, 0x8a, 0xd2, 0xa7, 0x10, 0xbb, 0x86, 0x3e, 0xc5, 0xdd, 0xa5, 0xb4, 0x85, 0xbe,
+	0xa1, 0xd7, 0x91, 0x74, 0x1d, 0x4d, 0x90, 0x7e, 0x92, 0x40, 0x63, 0xf3, 0x67, 0x16, 0x74, 0x75,
+	0x57, 0xce, 0x6e, 0xc0, 0x35, 0xed, 0x24, 0x05, 0x49, 0x8d, 0xbb, 0x3c, 0x97, 0x80, 0x6d, 0xd1,
+	0x06, 0x05, 0x59, 0x47, 0xbf, 0x7a, 0x7c, 0x9c, 0x9c, 0x73, 0x85, 0x34, 0x70, 0x4b, 0x1c, 0x02,
+	0x15, 0xdd, 0xc4, 0x05, 0x7b, 0xa1, 0x3a, 0xea, 0x76, 0x8b, 0xdd, 0x02, 0x86, 0xe4, 0x7e, 0x38,
+	0xc2, 0x74, 0x92, 0xad, 0xb2, 0xb0, 0xdb, 0x9b, 0x1f, 0x40, 0x57, 0x77, 0xa4, 0x86, 0x1d, 0x1a,
+	0x2a, 0xec, 0x90, 0x80, 0x6d, 0x95, 0x1b, 0x2b, 0xa4, 0xbe, 0xf9, 0x0c, 0x3a, 0xaa, 0xa1, 0x33,
+	0x3c, 0xa3, 0x10, 0x95, 0x5e, 0x67, 0x61, 0xaa, 0x02, 0xce, 0xd3, 0xc8, 0x1f, 0x16, 0x09, 0x76,
+	0xce, 0xb3, 0xdc, 0x6e, 0xe0, 0xf3, 0xe3, 0xf8, 0x47, 0x7c, 0x88, 0x19, 0x86, 0x61, 0x08, 0x45,
+	0x6e, 0xb7, 0x36, 0xf7, 0xa0, 0xff, 0x4c, 0x17, 0xfa, 0x03, 0xfc, 0x25, 0x81, 0x69, 0xe3, 0x4a,
+	0xd4, 0xae, 0xe1, 0x9e, 0x94, 0x9d, 0x05, 0x6a, 0x5b, 0xec, 0x3a, 0x2c, 0x63, 0x34, 0x4a, 0xa8,
+	0xbe, 0xf9, 0x14, 0xd8, 0x6c, 0x89, 0x42, 0xa7, 0x95, 0x06, 0xdb, 0x35, 0xb4, 0xe4, 0x13, 0x7e,
+	0x81, 0xcf, 0x14, 0xc3, 0xc7, 0xa3, 0x38, 0xc9, 0x38, 0xf1, 0x74, 0x0c, 0xe9, 0x53, 0x1c, 0x02,
+	0x8d, 0xcd, 0xd1, 0x54, 0x31, 0x3f, 0x48, 0x8d, 0x74, 0x27, 0xda, 0xae, 0x51, 0xf2, 0x91, 0x16,
+	0x09, 0x28, 0x07, 0x92, 0x1a, 0x89, 0xd4, 0x71, 0xa3, 0xed, 0x88, 0xfb, 0x99, 0xa4, 0x1b, 0x72,
+	0xa3, 0xd4, 0x0f, 0x15, 0xd0, 0xdc, 0xfa, 0x53, 0x1b, 0xda, 0xb2, 0x89, 0x65, 0x1f, 0x40, 0xdf,
+	0xf8, 0x0d, 0x95, 0x51, 0xe9, 0x9d, 0xfd, 0xc5, 0x77, 0xed, 0x2b, 0x33, 0xb8, 0xac, 0x17, 0x6e,
+	0x8d, 0xbd, 0x0f, 0x50, 0x0e, 0xad, 0xec, 0x26, 0x75, 0x42, 0xd3, 0x43, 0xec, 0x9a, 0x83, 0xf0,
+	0xbc, 0xdf, 0x87, 0xdd, 0x1a, 0xfb, 0x1e, 0x2c, 0xab, 0xa2, 0x24, 0x73, 0x8d, 0x0d, 0x8c, 0x91,
+	0x63, 0xce, 0x38, 0x7a, 0xa5, 0xb2, 0x8f, 0x0a, 0x65, 0x32, 0x9f, 0x98, 0x33, 0x67, 0x7e, 0x91,
+	0x6a, 0x5e, 0x59, 0x38, 0xd9, 0xb8, 0x35, 0xb6, 0x0b, 0x7d, 0x39, 0x7f, 0xc8, 0x52, 0x7b, 0x1b,
+	0x65, 0x17, 0x0d, 0x24, 0x57, 0x1a, 0xb4, 0x0d, 0x4b, 0xe6, 0xc8, 0xc0, 0xc8, 0x93, 0x73, 0x66,
+	0x8b, 0x35, 0x67, 0x96, 0x51, 0x28, 0xf1, 0xe1, 0xd6, 0xfc, 0xc6, 0x9f, 0xbd, 0x5e, 0x7e, 0x97,
+	0x5d, 0x30, 0x69, 0xac, 0xb9, 0x57, 0x89, 0x14, 0x5b, 0xfc, 0x00, 0x9c, 0x62, 0xf3, 0x22, 0xcf,
+	0x55, 0x56, 0x0c, 0x94, 0x69, 0x0b, 0x66, 0x85, 0xb5, 0xd7, 0x16, 0xf2, 0x0b, 0xf5, 0x47, 0x70,
+	0xbd, 0x14, 0x48, 0xa4, 0xfb, 0xd8, 0x9d, 0x99, 0x75, 0x15, 0xb7, 0x0e, 0x16, 0xb1, 0x0b, 0xad,
+	0x3f, 0x2c, 0xa7, 0xdd, 0xaa, 0xe6, 0xd7, 0xcd, 0xd8, 0xce, 0xd7, 0xee, 0x5e, 0x25, 0xa2, 0x77,
+	0x78, 0xe0, 0xfc, 0xf3, 0xf3, 0x81, 0xf5, 0xd9, 0xe7, 0x03, 0xeb, 0x3f, 0x9f, 0x0f, 0xac, 0x5f,
+	0x7c, 0x31, 0xa8, 0x7d, 0xf6, 0xc5, 0xa0, 0xf6, 0xef, 0x2f, 0x06, 0xb5, 0xe3, 0x36, 0xfd, 0x4b,
+	0xe2, 0x1b, 0xff, 0x1d, 0x00, 0x53, 0x52, 0x77, 0x5e, 0x37, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4370,6 +4418,42 @@ func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChecksumMismatchChunks) > 0 {
+		for iNdEx := len(m.ChecksumMismatchChunks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChecksumMismatchChunks[iNdEx])
+			copy(dAtA[i:], m.ChecksumMismatchChunks[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.ChecksumMismatchChunks[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ChecksumAtDumpSnapshot {
+		i--
+		if m.ChecksumAtDumpSnapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChecksumTotalChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ChecksumTotalChunks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChecksumCheckedChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ChecksumCheckedChunks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChecksumStage) > 0 {
+		i -= len(m.ChecksumStage)
+		copy(dAtA[i:], m.ChecksumStage)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ChecksumStage)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.MetaBinlogGTID) > 0 {
 		i -= len(m.MetaBinlogGTID)
 		copy(dAtA[i:], m.MetaBinlogGTID)
@@ -6664,6 +6748,25 @@ func (m *LoadStatus) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	l = len(m.ChecksumStage)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.ChecksumCheckedChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.ChecksumCheckedChunks))
+	}
+	if m.ChecksumTotalChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.ChecksumTotalChunks))
+	}
+	if m.ChecksumAtDumpSnapshot {
+		n += 2
+	}
+	if len(m.ChecksumMismatchChunks) > 0 {
+		for _, s := range m.ChecksumMismatchChunks {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8537,6 +8640,128 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.MetaBinlogGTID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumStage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumStage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumCheckedChunks", wireType)
+			}
+			m.ChecksumCheckedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumCheckedChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumTotalChunks", wireType)
+			}
+			m.ChecksumTotalChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumTotalChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumAtDumpSnapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChecksumAtDumpSnapshot = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumMismatchChunks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumMismatchChunks = append(m.ChecksumMismatchChunks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -23,6 +23,11 @@ func LightningCheckpoint(task string) string {
 	return task + "_lightning_checkpoint_list"
 }
 
+// LoaderChecksum returns the table name of load unit's checksum validation result.
+func LoaderChecksum(task string) string {
+	return task + "_loader_checksum"
+}
+
 // SyncerCheckpoint returns syncer's checkpoint table name.
 func SyncerCheckpoint(task string) string {
 	return task + "_syncer_checkpoint"
@@ -53,3 +58,7 @@ func ValidatorErrorChange(task string) string {
 func ValidatorTableStatus(task string) string {
 	return task + "_validator_table_status"
 }
+
+func ValidatorRepairChange(task string) string {
+	return task + "_validator_repair_change"
+}
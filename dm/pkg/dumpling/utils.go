@@ -38,6 +38,9 @@ import (
 // DefaultTableFilter is the default table filter for dumpling.
 var DefaultTableFilter = []string{"*.*", export.DefaultTableFilter}
 
+// tidbBinlogName is the binlog name recorded in the meta file when dumping from TiDB.
+const tidbBinlogName = "tidb-binlog"
+
 // ParseMetaData parses mydumper's output meta file and returns binlog location.
 // since v2.0.0, dumpling maybe configured to output master status after connection pool is established,
 // we return this location as well.
@@ -95,6 +98,10 @@ func parseMetaDataByReader(filename, flavor string, rd io.Reader) (*binlog.Locat
 			case "Log":
 				pos.Name = value
 			case "Pos":
+				// TiDB records the snapshot TSO as the position, which is not a binlog location.
+				if pos.Name == tidbBinlogName {
+					continue
+				}
 				pos64, err3 := strconv.ParseUint(value, 10, 32)
 				if err3 != nil {
 					return err3
@@ -177,6 +184,69 @@ func parseMetaDataByReader(filename, flavor string, rd io.Reader) (*binlog.Locat
 	return locPtr, locPtr2, nil
 }
 
+// ParseTiDBSnapshotTS parses dumpling's output meta file and returns the snapshot TSO when dumping from TiDB.
+func ParseTiDBSnapshotTS(
+	ctx context.Context,
+	dir string,
+	filename string,
+	extStorage brstorage.ExternalStorage,
+) (uint64, error) {
+	fd, err := storage.OpenFile(ctx, dir, filename, extStorage)
+	if err != nil {
+		return 0, err
+	}
+	defer fd.Close()
+
+	return parseTiDBSnapshotTSByReader(filename, fd)
+}
+
+func parseTiDBSnapshotTSByReader(filename string, rd io.Reader) (uint64, error) {
+	var (
+		inMasterStatus bool
+		name           string
+	)
+	br := bufio.NewReader(rd)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "SHOW MASTER STATUS:":
+			inMasterStatus = true
+		case len(line) == 0:
+			inMasterStatus = false
+		case inMasterStatus:
+			parts := strings.SplitN(line, ":", 2)
+			if len(parts) != 2 {
+				break
+			}
+			key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+			switch key {
+			case "Log":
+				name = value
+			case "Pos":
+				if name != tidbBinlogName {
+					return 0, terror.ErrMetadataNoBinlogLoc.Generate(filename)
+				}
+				return strconv.ParseUint(value, 10, 64)
+			}
+		}
+		if err == io.EOF {
+			return 0, terror.ErrMetadataNoBinlogLoc.Generate(filename)
+		}
+	}
+}
+
+// ParseConsistency returns the consistency level of dumpling configured by extra args. Like the dump unit, the
+// consistency parsed before an unsupported argument is still returned along with the error.
+func ParseConsistency(logger *log.Logger, extraArgs string) (string, error) {
+	dumpCfg := export.DefaultConfig()
+	err := ParseExtraArgs(logger, dumpCfg, ParseArgLikeBash(strings.Fields(extraArgs)))
+	return dumpCfg.Consistency, err
+}
+
 func readFollowingGTIDs(br *bufio.Reader, flavor string) (string, error) {
 	var following strings.Builder
 	for {
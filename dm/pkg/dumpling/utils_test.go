@@ -261,6 +261,33 @@ Finished dump at: 2020-12-02 17:13:56
 	c.Assert(err, IsNil)
 	_, _, err = ParseMetaData(ctx, fdir, fname, "mysql", nil)
 	c.Assert(terror.ErrMetadataNoBinlogLoc.Equal(err), IsTrue)
+
+	// TiDB records the snapshot TSO as the position, which is not a binlog location.
+	tidbMeta := `Started dump at: 2022-09-22 15:03:32
+SHOW MASTER STATUS:
+	Log: tidb-binlog
+	Pos: 436064826418331650
+	GTID:
+
+Finished dump at: 2022-09-22 15:03:33
+`
+	err = os.WriteFile(f.Name(), []byte(tidbMeta), 0o644)
+	c.Assert(err, IsNil)
+	_, _, err = ParseMetaData(ctx, fdir, fname, "mysql", nil)
+	c.Assert(terror.ErrMetadataNoBinlogLoc.Equal(err), IsTrue)
+	ts, err := ParseTiDBSnapshotTS(ctx, fdir, fname, nil)
+	c.Assert(err, IsNil)
+	c.Assert(ts, Equals, uint64(436064826418331650))
+
+	// MySQL doesn't have a snapshot TSO.
+	err = os.WriteFile(f.Name(), []byte(testCases[0].source), 0o644)
+	c.Assert(err, IsNil)
+	_, err = ParseTiDBSnapshotTS(ctx, fdir, fname, nil)
+	c.Assert(terror.ErrMetadataNoBinlogLoc.Equal(err), IsTrue)
+	err = os.WriteFile(f.Name(), []byte(noBinlogLoc), 0o644)
+	c.Assert(err, IsNil)
+	_, err = ParseTiDBSnapshotTS(ctx, fdir, fname, nil)
+	c.Assert(terror.ErrMetadataNoBinlogLoc.Equal(err), IsTrue)
 }
 
 func (t *testSuite) TestParseArgs(c *C) {
@@ -378,6 +378,10 @@ const (
 	codeLoadUnitGenBAList
 	codeLoadTaskWorkerNotMatch
 	codeLoadCheckPointNotMatch
+	codeLoadUnitChecksumMismatch
+	codeLoadUnitChecksumConsistency
+	codeLoadUnitChecksumNoMismatch
+	codeLoadUnitChecksumSkipped
 )
 
 // Sync unit error code.
@@ -637,6 +641,7 @@ const (
 	codeValidatorNotFound
 	codeValidatorPanic
 	codeValidatorTooMuchPending
+	codeValidatorNotRunning
 )
 
 // Schema-tracker error code.
@@ -1067,6 +1072,10 @@ var (
 	ErrLoadUnitGenBAList           = New(codeLoadUnitGenBAList, ClassLoadUnit, ScopeInternal, LevelHigh, "generate block allow list", "Please check the `block-allow-list` config in task configuration file.")
 	ErrLoadTaskWorkerNotMatch      = New(codeLoadTaskWorkerNotMatch, ClassFunctional, ScopeInternal, LevelHigh, "different worker in load stage, previous worker: %s, current worker: %s", "Please check if the previous worker is online.")
 	ErrLoadTaskCheckPointNotMatch  = New(codeLoadCheckPointNotMatch, ClassFunctional, ScopeInternal, LevelHigh, "inconsistent checkpoints between loader and target database", "If you want to redo the whole task, please check that you have not forgotten to add -remove-meta flag for start-task command.")
+	ErrLoadUnitChecksumMismatch    = New(codeLoadUnitChecksumMismatch, ClassLoadUnit, ScopeInternal, LevelHigh, "checksum of %d chunks mismatch between upstream and downstream after load, mismatched chunks: %s", "Please fix the data in the downstream and resume the task to validate again, or use `handle-error <task> skip` to ignore the mismatch.")
+	ErrLoadUnitChecksumConsistency = New(codeLoadUnitChecksumConsistency, ClassLoadUnit, ScopeInternal, LevelHigh, "checksum validation requires reading upstream at the dump's snapshot, which is not supported with consistency %s on %s", "Please set `consistency` of dump to `flush` or `lock` for MySQL, or `snapshot` for TiDB, or disable `validate-checksum` in `loaders` config.")
+	ErrLoadUnitChecksumNoMismatch  = New(codeLoadUnitChecksumNoMismatch, ClassLoadUnit, ScopeInternal, LevelMedium, "no checksum mismatch or skipped validation to skip, the result of checksum validation is %s", "`handle-error skip` of load unit only ignores the mismatch or the skipped validation of checksum, please check the error of the task by `query-status`.")
+	ErrLoadUnitChecksumSkipped     = New(codeLoadUnitChecksumSkipped, ClassLoadUnit, ScopeInternal, LevelHigh, "checksum validation is skipped because the dump's snapshot of upstream can't be read any more, the loaded data is not validated", "Please use `handle-error <task> skip` to go on without validation, or restart the task from dump and keep the upstream unchanged until the data is loaded.")
 
 	// Sync unit error.
 	ErrSyncerUnitPanic                   = New(codeSyncerUnitPanic, ClassSyncUnit, ScopeInternal, LevelHigh, "panic error: %v", "")
@@ -1322,6 +1331,7 @@ var (
 	ErrValidatorNotFound          = New(codeValidatorNotFound, ClassValidator, ScopeNotSet, LevelMedium, "validator not found for task %s with source %s", "")
 	ErrValidatorPanic             = New(codeValidatorPanic, ClassValidator, ScopeInternal, LevelHigh, "panic error: %v", "")
 	ErrValidatorTooMuchPending    = New(codeValidatorTooMuchPending, ClassValidator, ScopeInternal, LevelMedium, "too much pending data, stop validator. row size(curr/max): %d/%d, row count(curr/max): %d/%d", "")
+	ErrValidatorNotRunning        = New(codeValidatorNotRunning, ClassValidator, ScopeNotSet, LevelMedium, "validator is not running, cannot repair error rows", "Please start validator by `validation start` first.")
 
 	// Schema-tracker error.
 	ErrSchemaTrackerInvalidJSON        = New(codeSchemaTrackerInvalidJSON, ClassSchemaTracker, ScopeDownstream, LevelHigh, "saved schema of `%s`.`%s` is not proper JSON", "")
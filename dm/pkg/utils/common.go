@@ -64,7 +64,7 @@ func TrimQuoteMark(s string) string {
 }
 
 // FetchAllDoTables returns all need to do tables after filtered (fetches from upstream MySQL).
-func FetchAllDoTables(ctx context.Context, db *sql.DB, bw *filter.Filter) (map[string][]string, error) {
+func FetchAllDoTables(ctx context.Context, db dbutil.QueryExecutor, bw *filter.Filter) (map[string][]string, error) {
 	schemas, err := dbutil.GetSchemas(ctx, db)
 
 	failpoint.Inject("FetchAllDoTablesFailed", func(val failpoint.Value) {
@@ -15,6 +15,7 @@ package syncer
 
 import (
 	"math"
+	"sync"
 	"time"
 
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
@@ -31,6 +32,9 @@ import (
 // this mechanism meets quiescent consistency to ensure correctness.
 // causality relation is consisted of groups of keys separated by flush job, and such design helps removed flushed dml job keys.
 type causality struct {
+	// relationMu guards writes to relation, run is the only writer so it can read relation without lock.
+	// It's also held by runIfNoPendingKeys to block new jobs.
+	relationMu  sync.RWMutex
 	relation    *causalityRelation
 	outCh       chan *job
 	inCh        chan *job
@@ -57,6 +61,7 @@ func causalityWrap(inCh chan *job, syncer *Syncer) chan *job {
 		sessCtx:       syncer.sessCtx,
 		workerCount:   syncer.cfg.WorkerCount,
 	}
+	syncer.setCausality(causality)
 
 	go func() {
 		causality.run()
@@ -76,10 +81,14 @@ func (c *causality) run() {
 
 		switch j.tp {
 		case flush, asyncFlush:
+			c.relationMu.Lock()
 			c.relation.rotate(j.flushSeq)
+			c.relationMu.Unlock()
 		case gc:
 			// gc is only used on inner-causality logic
+			c.relationMu.Lock()
 			c.relation.gc(j.flushSeq)
+			c.relationMu.Unlock()
 			continue
 		default:
 			keys := j.dml.CausalityKeys()
@@ -88,9 +97,13 @@ func (c *causality) run() {
 			if c.detectConflict(keys) {
 				c.logger.Debug("meet causality key, will generate a conflict job to flush all sqls", zap.Strings("keys", keys))
 				c.outCh <- newConflictJob(c.workerCount)
+				c.relationMu.Lock()
 				c.relation.clear()
+				c.relationMu.Unlock()
 			}
+			c.relationMu.Lock()
 			j.dmlQueueKey = c.add(keys)
+			c.relationMu.Unlock()
 			c.logger.Debug("key for keys", zap.String("key", j.dmlQueueKey), zap.Strings("keys", keys))
 		}
 		c.metricProxies.Metrics.ConflictDetectDurationHistogram.Observe(time.Since(startTime).Seconds())
@@ -147,6 +160,20 @@ func (c *causality) detectConflict(keys []string) bool {
 	return false
 }
 
+// runIfNoPendingKeys calls fn if none of the keys is held by causality relation, and returns whether fn is
+// called. New DML jobs are blocked before entering the relation until fn returns, so no change on the same
+// row can be sent to downstream by syncer while fn is running.
+func (c *causality) runIfNoPendingKeys(keys []string, fn func() error) (bool, error) {
+	c.relationMu.Lock()
+	defer c.relationMu.Unlock()
+	for _, key := range keys {
+		if _, ok := c.relation.get(key); ok {
+			return false, nil
+		}
+	}
+	return true, fn()
+}
+
 // dmlJobKeyRelationGroup stores a group of dml job key relations as data, and a flush job seq representing last flush job before adding any job keys.
 type dmlJobKeyRelationGroup struct {
 	data            map[string]string
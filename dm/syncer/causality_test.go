@@ -128,6 +128,59 @@ func TestCausality(t *testing.T) {
 	}
 }
 
+func TestCausalityRunIfNoPendingKeys(t *testing.T) {
+	t.Parallel()
+
+	ti := mockTableInfo(t, "create table tb(a int primary key, b int unique);")
+	jobCh := make(chan *job, 10)
+	syncer := &Syncer{
+		cfg: &config.SubTaskConfig{
+			SyncerConfig: config.SyncerConfig{
+				QueueSize: 1024,
+			},
+			Name:     "task",
+			SourceID: "source",
+		},
+		tctx:    tcontext.Background().WithLogger(log.L()),
+		sessCtx: utils.NewSessionCtx(map[string]string{"time_zone": "UTC"}),
+	}
+	syncer.metricsProxies = metrics.DefaultMetricsProxies.CacheForOneTask("task", "worker", "source")
+	causalityCh := causalityWrap(jobCh, syncer)
+	table := &cdcmodel.TableName{Schema: "test", Table: "t1"}
+	location := binlog.MustZeroLocation(mysql.MySQLFlavor)
+	ec := &eventContext{startLocation: location, endLocation: location, lastLocation: location}
+	change := sqlmodel.NewRowChange(table, nil, nil, []interface{}{1, 2}, ti, nil, nil)
+
+	// a job of the same row is blocked until the repair is done
+	repairing, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		ok, err := syncer.runIfCausalityKeysNotPending(change.CausalityKeys(), func() error {
+			close(repairing)
+			<-done
+			return nil
+		})
+		require.True(t, ok)
+		require.NoError(t, err)
+	}()
+	<-repairing
+	jobCh <- newDMLJob(change, ec)
+	require.Never(t, func() bool {
+		return len(causalityCh) > 0
+	}, 300*time.Millisecond, 10*time.Millisecond)
+	close(done)
+	require.Eventually(t, func() bool {
+		return len(causalityCh) == 1
+	}, 3*time.Second, 10*time.Millisecond)
+
+	// the row is pending in causality now, it's not repaired
+	ok, err := syncer.runIfCausalityKeysNotPending(change.CausalityKeys(), func() error {
+		t.Fatal("should not be called")
+		return nil
+	})
+	require.False(t, ok)
+	require.NoError(t, err)
+}
+
 func (s *testSyncerSuite) TestCasualityRelation(c *C) {
 	rm := newCausalityRelation()
 	c.Assert(rm.len(), Equals, 0)
@@ -982,6 +982,13 @@ func (v *DataValidator) persistCheckpointAndData(loc binlog.Location) error {
 	}
 	v.newErrorRowCount.Store(0)
 	v.setFlushedLoc(&loc)
+
+	if v.cfg.ValidatorCfg.AutoRepair {
+		// failed to repair doesn't affect validation, rows left will be repaired on next flush or by user.
+		if err = v.repairErrors(v.tctx, v.toDB, 0, true); err != nil {
+			v.L.Warn("failed to auto repair error rows", zap.Error(err))
+		}
+	}
 	return nil
 }
 
@@ -1272,12 +1279,20 @@ func (v *DataValidator) OperateValidatorError(validateOp pb.ValidationErrOp, err
 		err   error
 		dbCfg config.DBConfig
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), validatorDmctlOpTimeout)
+	timeout := validatorDmctlOpTimeout
+	if validateOp == pb.ValidationErrOp_RepairErrOp {
+		// repair queries upstream and writes downstream row by row, it takes longer.
+		// hold the lock so validator won't be stopped while repairing.
+		timeout = validationDBTimeout
+		v.RLock()
+		defer v.RUnlock()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	tctx := tcontext.NewContext(ctx, v.L)
 	defer cancel()
 	failpoint.Inject("MockValidationQuery", func() {
 		toDB = v.persistHelper.db
-		failpoint.Return(v.persistHelper.operateError(tctx, toDB, validateOp, errID, isAll))
+		failpoint.Return(v.operateError(tctx, toDB, validateOp, errID, isAll))
 	})
 	dbCfg = v.cfg.To
 	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetMaxIdleConns(1)
@@ -1286,7 +1301,14 @@ func (v *DataValidator) OperateValidatorError(validateOp pb.ValidationErrOp, err
 		return err
 	}
 	defer dbconn.CloseBaseDB(tctx, toDB)
-	return v.persistHelper.operateError(tctx, toDB, validateOp, errID, isAll)
+	return v.operateError(tctx, toDB, validateOp, errID, isAll)
+}
+
+func (v *DataValidator) operateError(tctx *tcontext.Context, db *conn.BaseDB, validateOp pb.ValidationErrOp, errID uint64, isAll bool) error {
+	if validateOp == pb.ValidationErrOp_RepairErrOp {
+		return v.repairErrors(tctx, db, errID, isAll)
+	}
+	return v.persistHelper.operateError(tctx, db, validateOp, errID, isAll)
 }
 
 func (v *DataValidator) getErrorRowCount(timeout time.Duration) ([errorStateTypeCount]int64, error) {
@@ -159,6 +159,9 @@ type Syncer struct {
 	isTransactionEnd    bool
 	waitTransactionLock sync.Mutex
 
+	causalityLock sync.RWMutex
+	causality     *causality
+
 	tableRouter     *regexprrouter.RouteTable
 	binlogFilter    *bf.BinlogEvent
 	columnMapping   *cm.Mapping
@@ -1525,6 +1528,24 @@ func (s *Syncer) syncDML() {
 	}
 }
 
+func (s *Syncer) setCausality(c *causality) {
+	s.causalityLock.Lock()
+	defer s.causalityLock.Unlock()
+	s.causality = c
+}
+
+// runIfCausalityKeysNotPending calls fn if none of the causality keys is held by the causality of the running
+// DML pipeline, and returns whether fn is called. Syncer can't send a change on the same row to downstream
+// while fn is running.
+func (s *Syncer) runIfCausalityKeysNotPending(keys []string, fn func() error) (bool, error) {
+	s.causalityLock.RLock()
+	defer s.causalityLock.RUnlock()
+	if s.causality == nil {
+		return true, fn()
+	}
+	return s.causality.runIfNoPendingKeys(keys, fn)
+}
+
 func (s *Syncer) waitBeforeRunExit(ctx context.Context) {
 	defer s.runWg.Done()
 	failpoint.Inject("checkCheckpointInMiddleOfTransaction", func() {
@@ -64,6 +64,7 @@ type validatorPersistHelper struct {
 	pendingChangeTableName string
 	errorChangeTableName   string
 	tableStatusTableName   string
+	repairChangeTableName  string
 
 	db                *conn.BaseDB
 	schemaInitialized atomic.Bool
@@ -91,6 +92,7 @@ func newValidatorCheckpointHelper(validator *DataValidator) *validatorPersistHel
 		pendingChangeTableName: dbutil.TableName(cfg.MetaSchema, cputil.ValidatorPendingChange(cfg.Name)),
 		errorChangeTableName:   dbutil.TableName(cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name)),
 		tableStatusTableName:   dbutil.TableName(cfg.MetaSchema, cputil.ValidatorTableStatus(cfg.Name)),
+		repairChangeTableName:  dbutil.TableName(cfg.MetaSchema, cputil.ValidatorRepairChange(cfg.Name)),
 	}
 
 	return c
@@ -188,6 +190,20 @@ func (c *validatorPersistHelper) createTable(tctx *tcontext.Context) error {
 			UNIQUE KEY uk_source_schema_table_key(source, src_schema_name, src_table_name),
 			INDEX idx_stage(stage)
 		)`,
+		`CREATE TABLE IF NOT EXISTS ` + c.repairChangeTableName + ` (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			source VARCHAR(32) NOT NULL,
+			error_id BIGINT NOT NULL,
+			src_schema_name VARCHAR(128) NOT NULL,
+			src_table_name VARCHAR(128) NOT NULL,
+			row_pk VARCHAR(` + maxRowKeyLengthStr + `) NOT NULL,
+			dst_schema_name VARCHAR(128) NOT NULL,
+			dst_table_name VARCHAR(128) NOT NULL,
+			repair_type VARCHAR(16) NOT NULL,
+			data JSON NOT NULL,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_source_error(source, error_id)
+		)`,
 	}
 	tctx.L().Info("create checkpoint and data table", zap.Strings("statements", sqls))
 	for _, q := range sqls {
@@ -722,3 +738,103 @@ func (c *validatorPersistHelper) deleteError(tctx *tcontext.Context, db *conn.Ba
 	_, err := db.ExecContext(tctx, query, args...)
 	return err
 }
+
+// errorRowForRepair is an error row loaded from meta table which is going to be repaired.
+type errorRowForRepair struct {
+	id      uint64
+	source  filter.Table
+	target  filter.Table
+	key     string
+	srcData []interface{}
+}
+
+// loadErrorForRepair loads error rows which can be repaired. when isAll is true, only new error rows are loaded,
+// rows ignored by user are left as is, else the error row of errID is loaded unless it's resolved already.
+func (c *validatorPersistHelper) loadErrorForRepair(tctx *tcontext.Context, db *conn.BaseDB, errID uint64, isAll bool) ([]*errorRowForRepair, error) {
+	query := "SELECT id, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, data " +
+		"FROM " + c.errorChangeTableName + " WHERE source=?"
+	args := []interface{}{c.cfg.SourceID}
+	if isAll {
+		query += " AND status=?"
+		args = append(args, int(pb.ValidateErrorState_NewErr))
+	} else {
+		query += " AND id=? AND status<>?"
+		args = append(args, errID, int(pb.ValidateErrorState_ResolvedErr))
+	}
+	rows, err := db.QueryContext(tctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*errorRowForRepair, 0)
+	for rows.Next() {
+		var (
+			id                                                                  uint64
+			srcSchemaName, srcTableName, key, dstSchemaName, dstTableName, data string
+		)
+		if err = rows.Scan(&id, &srcSchemaName, &srcTableName, &key, &dstSchemaName, &dstTableName, &data); err != nil {
+			return nil, err
+		}
+		// decode numbers as string to keep precision of big integers and decimals.
+		var srcData []interface{}
+		decoder := json.NewDecoder(strings.NewReader(data))
+		decoder.UseNumber()
+		if err = decoder.Decode(&srcData); err != nil {
+			return nil, err
+		}
+		for i, val := range srcData {
+			if num, ok := val.(json.Number); ok {
+				srcData[i] = num.String()
+			}
+		}
+		res = append(res, &errorRowForRepair{
+			id:      id,
+			source:  filter.Table{Schema: srcSchemaName, Name: srcTableName},
+			target:  filter.Table{Schema: dstSchemaName, Name: dstTableName},
+			key:     key,
+			srcData: srcData,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// execRepair writes the repair of an error row to downstream, records it and marks the error row as resolved,
+// all in one transaction.
+func (c *validatorPersistHelper) execRepair(tctx *tcontext.Context, db *conn.BaseDB, row *errorRowForRepair,
+	repairQuery string, repairArgs []interface{}, repairType string, data []interface{},
+) (err error) {
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	tx, err := db.DB.BeginTx(tctx.Ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				tctx.L().Warn("failed to rollback repair", zap.Error(rbErr))
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+	if _, err = tx.ExecContext(tctx.Ctx, repairQuery, repairArgs...); err != nil {
+		return err
+	}
+	query := "INSERT INTO " + c.repairChangeTableName +
+		" (source, error_id, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, repair_type, data)" +
+		" VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	if _, err = tx.ExecContext(tctx.Ctx, query, c.cfg.SourceID, row.id, row.source.Schema, row.source.Name, row.key,
+		row.target.Schema, row.target.Name, repairType, string(dataBytes)); err != nil {
+		return err
+	}
+	query = "UPDATE " + c.errorChangeTableName + " SET status=? WHERE source=? AND id=?"
+	_, err = tx.ExecContext(tctx.Ctx, query, int(pb.ValidateErrorState_ResolvedErr), c.cfg.SourceID, row.id)
+	return err
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/util/dbutil"
+	"go.uber.org/zap"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+)
+
+const (
+	repairTypeReplace = "replace"
+	repairTypeDelete  = "delete"
+)
+
+// repairErrors repairs error rows by re-reading current row from upstream, then write a REPLACE to downstream
+// if the row exists, else a DELETE. what's repaired is recorded into meta table, and the error row is marked
+// as resolved.
+// rows whose key is still pending in the causality of syncer are skipped, syncer will overwrite them later.
+// db is used to write both downstream and meta tables, they're in the same cluster.
+func (v *DataValidator) repairErrors(tctx *tcontext.Context, db *conn.BaseDB, errID uint64, isAll bool) error {
+	if v.Stage() != pb.Stage_Running {
+		return terror.ErrValidatorNotRunning.Generate()
+	}
+	errRows, err := v.persistHelper.loadErrorForRepair(tctx, db, errID, isAll)
+	if err != nil {
+		return err
+	}
+	var repaired, skipped int
+	for _, row := range errRows {
+		ok, err2 := v.repairErrorRow(tctx, db, row)
+		if err2 != nil {
+			return err2
+		}
+		if ok {
+			repaired++
+		} else {
+			skipped++
+		}
+	}
+	v.L.Info("repair error rows", zap.Int("repaired", repaired), zap.Int("skipped", skipped))
+	return nil
+}
+
+// repairErrorRow returns false if the row is skipped.
+func (v *DataValidator) repairErrorRow(tctx *tcontext.Context, db *conn.BaseDB, row *errorRowForRepair) (bool, error) {
+	logger := v.L.WithFields(zap.Uint64("error id", row.id), zap.Stringer("table", &row.source),
+		zap.String("key", row.key))
+	validateTbl, err := v.genValidateTableInfo(&row.source, len(row.srcData))
+	if err != nil {
+		return false, err
+	}
+	if validateTbl.message != "" {
+		logger.Warn("skip repairing row", zap.String("reason", validateTbl.message))
+		return false, nil
+	}
+
+	newRowChange := func(preValues, postValues []interface{}) *sqlmodel.RowChange {
+		rowChange := sqlmodel.NewRowChange(
+			&cdcmodel.TableName{Schema: row.source.Schema, Table: row.source.Name},
+			&cdcmodel.TableName{Schema: row.target.Schema, Table: row.target.Name},
+			preValues, postValues,
+			validateTbl.srcTableInfo, validateTbl.downstreamTableInfo.TableInfo,
+			v.syncer.sessCtx,
+		)
+		rowChange.SetWhereHandle(validateTbl.downstreamTableInfo.WhereHandle)
+		return rowChange
+	}
+	delRow := newRowChange(row.srcData, nil)
+	// the upstream row is read and written to downstream while syncer is blocked from changing the same row,
+	// otherwise a newer change flushed by syncer in between would be overwritten by the stale upstream row.
+	var repairType string
+	ok, err := v.syncer.runIfCausalityKeysNotPending(delRow.CausalityKeys(), func() error {
+		upstreamData, err2 := v.getUpstreamRow(tctx, delRow)
+		if err2 != nil {
+			return err2
+		}
+		var (
+			query string
+			args  []interface{}
+			data  []interface{}
+		)
+		if upstreamData == nil {
+			repairType, data = repairTypeDelete, row.srcData
+			query, args = delRow.GenSQL(sqlmodel.DMLDelete)
+		} else {
+			repairType, data = repairTypeReplace, upstreamData
+			query, args = newRowChange(nil, upstreamData).GenSQL(sqlmodel.DMLReplace)
+		}
+		// we do not retry, let user do it
+		return v.persistHelper.execRepair(tctx, db, row, query, args, repairType, data)
+	})
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		logger.Info("row is pending in syncer, skip repairing it")
+		return false, nil
+	}
+	logger.Info("row repaired", zap.String("type", repairType))
+	return true, nil
+}
+
+// getUpstreamRow queries current data of the row from upstream, returns nil if it doesn't exist.
+func (v *DataValidator) getUpstreamRow(tctx *tcontext.Context, row *sqlmodel.RowChange) ([]interface{}, error) {
+	cond := &Cond{
+		TargetTbl: row.GetSourceTable().QuoteString(),
+		Columns:   row.SourceTableInfo().Columns,
+		PK:        row.UniqueNotNullIdx(),
+		PkValues:  [][]string{row.RowStrIdentity()},
+	}
+	columnNames := make([]string, 0, len(cond.Columns))
+	for _, col := range cond.Columns {
+		columnNames = append(columnNames, dbutil.ColumnName(col.Name.O))
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s",
+		strings.Join(columnNames, ", "), cond.TargetTbl, cond.GetWhere())
+	rows, err := v.fromDB.QueryContext(tctx, query, cond.GetArgs()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []interface{}
+	if rows.Next() {
+		rowData, err2 := scanRow(rows)
+		if err2 != nil {
+			return nil, err2
+		}
+		res = make([]interface{}, len(rowData))
+		for i, d := range rowData {
+			if d.Valid {
+				res[i] = d.String
+			}
+		}
+	}
+	return res, rows.Err()
+}
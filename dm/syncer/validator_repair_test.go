@@ -0,0 +1,145 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/filter"
+	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
+	router "github.com/pingcap/tidb/util/table-router"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+)
+
+func TestValidatorRepairErrors(t *testing.T) {
+	var (
+		schemaName     = "test"
+		tableName      = "tbl"
+		tbl            = filter.Table{Schema: schemaName, Name: tableName}
+		createTableSQL = "CREATE TABLE `" + tableName + "`(id int primary key, v varchar(100))"
+	)
+	cfg := genSubtaskConfig(t)
+	syncerObj := NewSyncer(cfg, nil, nil)
+	var err error
+	syncerObj.tableRouter, err = regexprrouter.NewRegExprRouter(cfg.CaseSensitive, []*router.TableRule{})
+	require.NoError(t, err)
+	trackDB, trackMock, err := sqlmock.New()
+	require.NoError(t, err)
+	trackMock.ExpectBegin()
+	trackMock.ExpectExec("SET SESSION SQL_MODE.*").WillReturnResult(sqlmock.NewResult(0, 0))
+	trackMock.ExpectCommit()
+	trackMock.ExpectQuery("SHOW CREATE TABLE.*").WillReturnRows(
+		trackMock.NewRows([]string{"Table", "Create Table"}).AddRow(tableName, createTableSQL))
+	dbConn, err := trackDB.Conn(context.Background())
+	require.NoError(t, err)
+	syncerObj.downstreamTrackConn = dbconn.NewDBConn(cfg, conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{}))
+	syncerObj.schemaTracker, err = schema.NewTestTracker(context.Background(), cfg.Name, syncerObj.downstreamTrackConn, log.L())
+	require.NoError(t, err)
+	defer syncerObj.schemaTracker.Close()
+	require.NoError(t, syncerObj.schemaTracker.CreateSchemaIfNotExists(schemaName))
+	stmt, err := parseSQL(createTableSQL)
+	require.NoError(t, err)
+	require.NoError(t, syncerObj.schemaTracker.Exec(context.Background(), schemaName, stmt))
+
+	upDB, upMock, err := sqlmock.New()
+	require.NoError(t, err)
+	downDB, downMock, err := sqlmock.New()
+	require.NoError(t, err)
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	validator.ctx, validator.cancel = context.WithCancel(context.Background())
+	defer validator.cancel()
+	validator.tctx = tcontext.NewContext(validator.ctx, validator.L)
+	validator.fromDB = conn.NewBaseDB(upDB, func() {})
+	metaDB := conn.NewBaseDB(downDB, func() {})
+
+	// validator not running
+	err = validator.repairErrors(validator.tctx, metaDB, 0, true)
+	require.True(t, terror.ErrValidatorNotRunning.Equal(err))
+
+	validator.setStage(pb.Stage_Running)
+	// row 3 is still pending in syncer
+	tblInfo := genValidateTableInfo(t, createTableSQL)
+	c := &causality{relation: newCausalityRelation()}
+	c.add(genRowChangeJob(tbl, tblInfo, "3", rowDeleted, []interface{}{3, "c"}).row.CausalityKeys())
+	syncerObj.setCausality(c)
+
+	sourceID := cfg.SourceID
+	helper := validator.persistHelper
+	errRowColumns := []string{"id", "src_schema_name", "src_table_name", "row_pk", "dst_schema_name", "dst_table_name", "data"}
+	downMock.ExpectQuery("SELECT .* FROM "+regexp.QuoteMeta(helper.errorChangeTableName)+" WHERE source=\\? AND status=\\?").
+		WithArgs(sourceID, int(pb.ValidateErrorState_NewErr)).
+		WillReturnRows(sqlmock.NewRows(errRowColumns).
+			AddRow(1, schemaName, tableName, "1", schemaName, tableName, `[1, "a"]`).
+			AddRow(2, schemaName, tableName, "2", schemaName, tableName, `[2, "b"]`).
+			AddRow(3, schemaName, tableName, "3", schemaName, tableName, `[3, "c"]`))
+	// row 1 is changed in upstream, replace it
+	upMock.ExpectQuery("SELECT `id`, `v` FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow("1", "aa"))
+	downMock.ExpectBegin()
+	downMock.ExpectExec("REPLACE INTO `test`.`tbl`").WithArgs("1", "aa").WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectExec("INSERT INTO "+regexp.QuoteMeta(helper.repairChangeTableName)).
+		WithArgs(sourceID, 1, schemaName, tableName, "1", schemaName, tableName, repairTypeReplace, `["1","aa"]`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectExec("UPDATE "+regexp.QuoteMeta(helper.errorChangeTableName)+" SET status=\\? WHERE source=\\? AND id=\\?").
+		WithArgs(int(pb.ValidateErrorState_ResolvedErr), sourceID, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectCommit()
+	// row 2 is deleted in upstream, delete it
+	upMock.ExpectQuery("SELECT `id`, `v` FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}))
+	downMock.ExpectBegin()
+	downMock.ExpectExec("DELETE FROM `test`.`tbl`").WithArgs("2").WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectExec("INSERT INTO "+regexp.QuoteMeta(helper.repairChangeTableName)).
+		WithArgs(sourceID, 2, schemaName, tableName, "2", schemaName, tableName, repairTypeDelete, `["2","b"]`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectExec("UPDATE "+regexp.QuoteMeta(helper.errorChangeTableName)+" SET status=\\? WHERE source=\\? AND id=\\?").
+		WithArgs(int(pb.ValidateErrorState_ResolvedErr), sourceID, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectCommit()
+	require.NoError(t, validator.repairErrors(validator.tctx, metaDB, 0, true))
+
+	// the repair is rolled back if failed to mark the error row resolved
+	downMock.ExpectQuery("SELECT .* FROM "+regexp.QuoteMeta(helper.errorChangeTableName)+" WHERE source=\\? AND status=\\?").
+		WithArgs(sourceID, int(pb.ValidateErrorState_NewErr)).
+		WillReturnRows(sqlmock.NewRows(errRowColumns).
+			AddRow(4, schemaName, tableName, "4", schemaName, tableName, `[4, "d"]`))
+	upMock.ExpectQuery("SELECT `id`, `v` FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("4").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow("4", "dd"))
+	downMock.ExpectBegin()
+	downMock.ExpectExec("REPLACE INTO `test`.`tbl`").WithArgs("4", "dd").WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectExec("INSERT INTO " + regexp.QuoteMeta(helper.repairChangeTableName)).
+		WillReturnError(errors.New("failed to insert"))
+	downMock.ExpectRollback()
+	require.ErrorContains(t, validator.repairErrors(validator.tctx, metaDB, 0, true), "failed to insert")
+
+	// repair by error id, the row is resolved already
+	downMock.ExpectQuery("SELECT .* FROM "+regexp.QuoteMeta(helper.errorChangeTableName)+" WHERE source=\\? AND id=\\? AND status<>\\?").
+		WithArgs(sourceID, 1, int(pb.ValidateErrorState_ResolvedErr)).
+		WillReturnRows(sqlmock.NewRows(errRowColumns))
+	require.NoError(t, validator.repairErrors(validator.tctx, metaDB, 1, false))
+
+	require.NoError(t, upMock.ExpectationsWereMet())
+	require.NoError(t, downMock.ExpectationsWereMet())
+}
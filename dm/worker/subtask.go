@@ -839,15 +839,20 @@ func (st *SubTask) fail(err error) {
 	})
 }
 
-// HandleError handle error for syncer unit.
+// errorHandler is implemented by the units supporting `handle-error`.
+type errorHandler interface {
+	HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error)
+}
+
+// HandleError handle error for syncer unit, or skip the checksum mismatch for load unit.
 func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest, relay relay.Process) (string, error) {
 	// TODO: do we need lock here?
-	syncUnit, ok := st.currUnit.(*syncer.Syncer)
+	handler, ok := st.currUnit.(errorHandler)
 	if !ok {
 		return "", terror.ErrWorkerOperSyncUnitOnly.Generate(st.currUnit.Type())
 	}
 
-	msg, err := syncUnit.HandleError(ctx, req)
+	msg, err := handler.HandleError(ctx, req)
 	if err != nil {
 		return "", err
 	}
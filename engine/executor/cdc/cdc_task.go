@@ -0,0 +1,206 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/framework/registry"
+	"github.com/pingcap/tiflow/engine/model"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// cdcTask hosts the puller, sorter and sink of a set of tables.
+type cdcTask struct {
+	framework.BaseWorker
+
+	cfg        *Config
+	masterID   frameModel.MasterID
+	replicator replicator
+	cancelFn   context.CancelFunc
+	wg         sync.WaitGroup
+
+	statusCode struct {
+		sync.RWMutex
+		code frameModel.WorkerState
+	}
+	runError struct {
+		sync.RWMutex
+		err error
+	}
+
+	statusRateLimiter *rate.Limiter
+}
+
+// RegisterWorker is used to register cdc task worker into global registry
+func RegisterWorker() {
+	factory := registry.NewSimpleWorkerFactory(newCDCTask)
+	registry.GlobalWorkerRegistry().MustRegisterWorkerType(frameModel.CdcTask, factory)
+}
+
+func newCDCTask(ctx *dcontext.Context, _ frameModel.WorkerID, masterID frameModel.MasterID, conf *Config) *cdcTask {
+	task := &cdcTask{
+		cfg:               conf,
+		masterID:          masterID,
+		statusRateLimiter: rate.NewLimiter(rate.Every(time.Second), 1),
+	}
+	task.replicator = newReplicator(conf, task.loadJobStatus)
+	return task
+}
+
+// InitImpl implements WorkerImpl.InitImpl
+func (task *cdcTask) InitImpl(ctx context.Context) error {
+	log.Info("init the cdc task", zap.String("task-id", task.ID()),
+		zap.Int("index", task.cfg.Index), zap.Any("checkpoints", task.cfg.Checkpoints))
+	task.setState(frameModel.WorkerStateNormal)
+	// Don't use the ctx from the caller. Caller may cancel the ctx after InitImpl returns.
+	ctx, task.cancelFn = context.WithCancel(context.Background())
+	task.wg.Add(1)
+	go func() {
+		defer task.wg.Done()
+		err := task.replicator.Run(ctx)
+		if err != nil {
+			log.Error("cdc task failed", zap.String("task-id", task.ID()), zap.Error(err))
+			task.setRunError(err)
+			task.setState(frameModel.WorkerStateError)
+		}
+	}()
+	return nil
+}
+
+// Tick implements WorkerImpl.Tick
+func (task *cdcTask) Tick(ctx context.Context) error {
+	if task.statusRateLimiter.Allow() {
+		err := task.BaseWorker.UpdateStatus(ctx, task.Status())
+		if errors.ErrWorkerUpdateStatusTryAgain.Equal(err) {
+			log.Warn("update status try again later", zap.String("task-id", task.ID()), zap.Error(err))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	exitReason := framework.ExitReasonUnknown
+	switch task.getState() {
+	case frameModel.WorkerStateError:
+		exitReason = framework.ExitReasonFailed
+	case frameModel.WorkerStateStopped:
+		exitReason = framework.ExitReasonCanceled
+	default:
+	}
+	if exitReason == framework.ExitReasonUnknown {
+		return nil
+	}
+
+	return task.BaseWorker.Exit(ctx, exitReason, task.getRunError(), task.Status().ExtBytes)
+}
+
+// Status implements WorkerImpl.Status
+func (task *cdcTask) Status() frameModel.WorkerStatus {
+	status := &Status{
+		Index:       task.cfg.Index,
+		Checkpoints: task.replicator.Checkpoints(),
+	}
+	statusBytes, err := json.Marshal(status)
+	if err != nil {
+		log.Panic("get status failed", zap.String("task-id", task.ID()), zap.Error(err))
+	}
+	return frameModel.WorkerStatus{
+		State:    task.getState(),
+		ExtBytes: statusBytes,
+	}
+}
+
+// Workload implements WorkerImpl.Workload
+func (task *cdcTask) Workload() model.RescUnit {
+	return model.RescUnit(len(task.replicator.Checkpoints()))
+}
+
+// OnMasterMessage implements WorkerImpl.OnMasterMessage
+func (task *cdcTask) OnMasterMessage(ctx context.Context, topic p2p.Topic, message p2p.MessageValue) error {
+	switch msg := message.(type) {
+	case *frameModel.StatusChangeRequest:
+		switch msg.ExpectState {
+		case frameModel.WorkerStateStopped:
+			task.setState(frameModel.WorkerStateStopped)
+		default:
+			log.Info("cdc task: ignore status change state", zap.Int32("state", int32(msg.ExpectState)))
+		}
+	default:
+		log.Info("unsupported message", zap.Any("message", message))
+	}
+	return nil
+}
+
+// CloseImpl implements WorkerImpl.CloseImpl
+func (task *cdcTask) CloseImpl(ctx context.Context) error {
+	if task.cancelFn != nil {
+		task.cancelFn()
+	}
+	task.wg.Wait()
+	return nil
+}
+
+// loadJobStatus loads the status persisted by the job master, which shares
+// the business metastore with the task.
+func (task *cdcTask) loadJobStatus(ctx context.Context) (*JobStatus, error) {
+	resp, err := task.MetaKVClient().Get(ctx, task.masterID)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	status := &JobStatus{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (task *cdcTask) getState() frameModel.WorkerState {
+	task.statusCode.RLock()
+	defer task.statusCode.RUnlock()
+	return task.statusCode.code
+}
+
+func (task *cdcTask) setState(status frameModel.WorkerState) {
+	task.statusCode.Lock()
+	defer task.statusCode.Unlock()
+	task.statusCode.code = status
+}
+
+func (task *cdcTask) getRunError() error {
+	task.runError.RLock()
+	defer task.runError.RUnlock()
+	return task.runError.err
+}
+
+func (task *cdcTask) setRunError(err error) {
+	task.runError.Lock()
+	defer task.runError.Unlock()
+	task.runError.err = err
+}
@@ -0,0 +1,210 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	kvmock "github.com/pingcap/tiflow/engine/pkg/meta/mock"
+	metaModel "github.com/pingcap/tiflow/engine/pkg/meta/model"
+)
+
+type mockBaseWorker struct {
+	mock.Mock
+	framework.BaseWorker
+	metaKVClient metaModel.KVClient
+}
+
+func (m *mockBaseWorker) MetaKVClient() metaModel.KVClient {
+	return m.metaKVClient
+}
+
+func (m *mockBaseWorker) ID() frameModel.WorkerID {
+	return "cdc-task-id"
+}
+
+func (m *mockBaseWorker) UpdateStatus(ctx context.Context, status frameModel.WorkerStatus) error {
+	return m.Called(status.State).Error(0)
+}
+
+func (m *mockBaseWorker) Exit(ctx context.Context, reason framework.ExitReason, err error, detail []byte) error {
+	return m.Called(reason, err).Error(0)
+}
+
+type fakeReplicator struct {
+	runErr      error
+	checkpoints map[string]uint64
+}
+
+func (r *fakeReplicator) Run(ctx context.Context) error {
+	if r.runErr != nil {
+		return r.runErr
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (r *fakeReplicator) Checkpoints() map[string]uint64 {
+	return r.checkpoints
+}
+
+func newTestCDCTask(t *testing.T, rep *fakeReplicator) (*cdcTask, *mockBaseWorker) {
+	newReplicator = func(*Config, jobStatusLoader) replicator { return rep }
+	t.Cleanup(func() {
+		newReplicator = func(cfg *Config, loadJobStatus jobStatusLoader) replicator {
+			return newTableReplicator(cfg, loadJobStatus)
+		}
+	})
+	task := newCDCTask(dcontext.Background(), "cdc-task-id", "cdc-job", &Config{
+		Index:       1,
+		Checkpoints: map[string]uint64{"db.t1": 100},
+	})
+	base := &mockBaseWorker{metaKVClient: kvmock.NewMetaMock()}
+	task.BaseWorker = base
+	return task, base
+}
+
+func TestParseTableName(t *testing.T) {
+	name, err := ParseTableName("db.t1.x")
+	require.NoError(t, err)
+	require.Equal(t, "db", name.Schema)
+	require.Equal(t, "t1.x", name.Table)
+
+	for _, invalid := range []string{"", "db", "db.", ".t1"} {
+		_, err = ParseTableName(invalid)
+		require.Regexp(t, ".*ErrCDCJobInvalidConfig.*", err)
+	}
+}
+
+func TestCDCTaskStatusAndStop(t *testing.T) {
+	ctx := context.Background()
+	task, base := newTestCDCTask(t, &fakeReplicator{checkpoints: map[string]uint64{"db.t1": 120}})
+	require.NoError(t, task.InitImpl(ctx))
+
+	status := task.Status()
+	require.Equal(t, frameModel.WorkerStateNormal, status.State)
+	taskStatus := &Status{}
+	require.NoError(t, json.Unmarshal(status.ExtBytes, taskStatus))
+	require.Equal(t, &Status{Index: 1, Checkpoints: map[string]uint64{"db.t1": 120}}, taskStatus)
+
+	base.On("UpdateStatus", frameModel.WorkerStateNormal).Return(nil).Once()
+	require.NoError(t, task.Tick(ctx))
+
+	require.NoError(t, task.OnMasterMessage(ctx, "", &frameModel.StatusChangeRequest{
+		ExpectState: frameModel.WorkerStateStopped,
+	}))
+	base.On("Exit", framework.ExitReasonCanceled, nil).Return(nil).Once()
+	require.NoError(t, task.Tick(ctx))
+	require.NoError(t, task.CloseImpl(ctx))
+	base.AssertExpectations(t)
+}
+
+func TestCDCTaskFailed(t *testing.T) {
+	ctx := context.Background()
+	runErr := errors.New("replicate failed")
+	task, base := newTestCDCTask(t, &fakeReplicator{runErr: runErr})
+	require.NoError(t, task.InitImpl(ctx))
+	require.Eventually(t, func() bool {
+		return task.getState() == frameModel.WorkerStateError
+	}, time.Second, 10*time.Millisecond)
+
+	base.On("UpdateStatus", frameModel.WorkerStateError).Return(nil).Once()
+	base.On("Exit", framework.ExitReasonFailed, runErr).Return(nil).Once()
+	require.NoError(t, task.Tick(ctx))
+	require.NoError(t, task.CloseImpl(ctx))
+	base.AssertExpectations(t)
+}
+
+func TestDDLQueue(t *testing.T) {
+	newJob := func(state timodel.JobState, commitTs uint64) *timodel.Job {
+		return &timodel.Job{State: state, BinlogInfo: &timodel.HistoryInfo{FinishedTS: commitTs}}
+	}
+	q := &ddlQueue{}
+	q.advance(100)
+	require.Equal(t, uint64(100), q.barrierTs())
+	require.Nil(t, q.front())
+
+	// Jobs not done and jobs pushed already are ignored.
+	q.push(newJob(timodel.JobStateRunning, 110))
+	q.push(newJob(timodel.JobStateSynced, 120))
+	q.push(newJob(timodel.JobStateSynced, 120))
+	q.push(newJob(timodel.JobStateDone, 130))
+	q.advance(140)
+	require.Equal(t, uint64(119), q.barrierTs())
+
+	require.Equal(t, uint64(120), q.front().BinlogInfo.FinishedTS)
+	q.pop()
+	require.Equal(t, uint64(129), q.barrierTs())
+	q.pop()
+	require.Nil(t, q.front())
+	require.Equal(t, uint64(140), q.barrierTs())
+}
+
+func TestCDCTaskLoadJobStatus(t *testing.T) {
+	ctx := context.Background()
+	task, base := newTestCDCTask(t, &fakeReplicator{})
+	status, err := task.loadJobStatus(ctx)
+	require.NoError(t, err)
+	require.Nil(t, status)
+
+	// The task reads the part of the status of the job master it needs.
+	_, err = base.metaKVClient.Put(ctx, "cdc-job", `{"cfg":{"sink-uri":"blackhole://"},`+
+		`"groups":[["db.t1"],["db.t2","db.t3"]],"checkpoints":{"db.t1":100,"db.t2":120,"db.t3":130},`+
+		`"checkpoint-ts":100,"barrier-ts":149,"ddl-ts":110}`)
+	require.NoError(t, err)
+	status, err = task.loadJobStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &JobStatus{
+		Groups:       [][]string{{"db.t1"}, {"db.t2", "db.t3"}},
+		Checkpoints:  map[string]uint64{"db.t1": 100, "db.t2": 120, "db.t3": 130},
+		CheckpointTs: 100,
+		BarrierTs:    149,
+		DDLTs:        110,
+	}, status)
+}
+
+func TestAffectedTables(t *testing.T) {
+	checkpoints := map[string]uint64{"db.t1": 100, "db.t2": 200, "db2.t1": 100, "db2.t2": 100}
+	newEvent := func(tp timodel.ActionType, schema, table string, pre *model.SimpleTableInfo) *model.DDLEvent {
+		return &model.DDLEvent{
+			CommitTs:     150,
+			Type:         tp,
+			TableInfo:    &model.SimpleTableInfo{Schema: schema, Table: table},
+			PreTableInfo: pre,
+		}
+	}
+	require.Equal(t, []string{"db.t1"},
+		AffectedTables(checkpoints, newEvent(timodel.ActionAddColumn, "db", "t1", nil)))
+	// The checkpoint of db.t2 is after the DDL.
+	require.Empty(t, AffectedTables(checkpoints, newEvent(timodel.ActionAddColumn, "db", "t2", nil)))
+	require.Empty(t, AffectedTables(checkpoints, newEvent(timodel.ActionCreateTable, "db", "t3", nil)))
+	renameEvent := newEvent(timodel.ActionRenameTable,
+		"db", "t3", &model.SimpleTableInfo{Schema: "db", Table: "t1"})
+	require.Equal(t, []string{"db.t1"}, AffectedTables(checkpoints, renameEvent))
+	require.True(t, IsRename(renameEvent))
+	require.Equal(t, []string{"db2.t1", "db2.t2"},
+		AffectedTables(checkpoints, newEvent(timodel.ActionDropSchema, "db2", "", nil)))
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"strings"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+)
+
+// Config is the config of a cdc task. A cdc task replicates a set of
+// tables from the upstream TiDB cluster to the sink.
+type Config struct {
+	// Index is the index of the table group assigned to the task.
+	Index         int                   `json:"index"`
+	ChangefeedID  string                `json:"changefeed-id"`
+	PDAddrs       []string              `json:"pd-addrs"`
+	Security      *security.Credential  `json:"security"`
+	SinkURI       string                `json:"sink-uri"`
+	ReplicaConfig *config.ReplicaConfig `json:"replica-config"`
+	// Checkpoints maps the names of the tables, in the form of
+	// `schema.table`, to the ts from which they start replicating.
+	Checkpoints map[string]uint64 `json:"checkpoints"`
+	// StartTs is the ts from which the DDLs are pulled if the task has no
+	// tables, e.g. all the tables of its group have been dropped.
+	StartTs uint64 `json:"start-ts"`
+}
+
+// Status is the business status of a cdc task, Checkpoints contains all the
+// tables replicated by the task.
+type Status struct {
+	Index       int               `json:"index"`
+	Checkpoints map[string]uint64 `json:"checkpoints"`
+}
+
+// JobStatus is the status of a cdc job persisted by the job master. The tasks
+// read it from the metastore to learn the tables assigned to them and the ts
+// the DDLs have been executed to.
+type JobStatus struct {
+	// Groups are the table groups, the i-th group is replicated by the task
+	// with index i. The job master assigns the tables created by DDLs to the
+	// groups, and updates the groups when the tables are dropped or renamed.
+	Groups [][]string `json:"groups"`
+	// Checkpoints maps each table to its checkpoint ts.
+	Checkpoints map[string]uint64 `json:"checkpoints"`
+	// CheckpointTs is the minimum checkpoint ts among all tables.
+	CheckpointTs uint64 `json:"checkpoint-ts"`
+	// BarrierTs is the ts the tables can be flushed to. The DDLs committed
+	// after it have not been executed by the job master yet.
+	BarrierTs uint64 `json:"barrier-ts"`
+	// DDLTs is the commit ts of the last DDL executed by the job master.
+	DDLTs uint64 `json:"ddl-ts"`
+}
+
+// ParseTableName parses a table name in the form of `schema.table`.
+func ParseTableName(name string) (model.TableName, error) {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return model.TableName{}, errors.ErrCDCJobInvalidConfig.GenWithStackByArgs(
+			"table name must be in the form of schema.table, got " + name)
+	}
+	return model.TableName{Schema: parts[0], Table: parts[1]}, nil
+}
+
+// TableKey returns the name of a table in the form of `schema.table`.
+func TableKey(schema, table string) string {
+	return schema + "." + table
+}
@@ -0,0 +1,311 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/entry/schema"
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/puller"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"golang.org/x/sync/errgroup"
+)
+
+// SchemaTracker pulls the DDL jobs of the upstream and applies them to a
+// schema storage. The jobs are queued to be handled by the caller in order.
+type SchemaTracker struct {
+	storage entry.SchemaStorage
+	ddls    ddlQueue
+}
+
+// NewSchemaTracker creates a schema tracker from startTs, the goroutines
+// pulling the DDL jobs are spawned in wg.
+func NewSchemaTracker(
+	ctx context.Context,
+	wg *errgroup.Group,
+	up *upstream.Upstream,
+	startTs uint64,
+	replicaConfig *config.ReplicaConfig,
+	changefeedID model.ChangeFeedID,
+) (*SchemaTracker, error) {
+	kvCfg := config.GetGlobalServerConfig().KVClient
+	ddlCtx := contextutil.PutTableInfoInCtx(ctx, -1, puller.DDLPullerTableName)
+	ddlPuller, err := puller.NewDDLJobPuller(ddlCtx, up.PDClient, up.GrpcPool,
+		up.RegionCache, up.KVStorage, up.PDClock, startTs, kvCfg,
+		replicaConfig, changefeedID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta, err := kv.GetSnapshotMeta(up.KVStorage, startTs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	t := &SchemaTracker{}
+	t.storage, err = entry.NewSchemaStorage(meta, startTs,
+		replicaConfig.ForceReplicate, changefeedID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	wg.Go(func() error {
+		return ddlPuller.Run(ddlCtx)
+	})
+	wg.Go(func() error {
+		for {
+			var jobEntry *model.DDLJobEntry
+			select {
+			case <-ctx.Done():
+				return nil
+			case jobEntry = <-ddlPuller.Output():
+			}
+			if jobEntry.OpType == model.OpTypeResolved {
+				t.storage.AdvanceResolvedTs(jobEntry.CRTs)
+				t.ddls.advance(jobEntry.CRTs)
+			}
+			if jobEntry.Err != nil {
+				return errors.Trace(jobEntry.Err)
+			}
+			if jobEntry.Job == nil {
+				continue
+			}
+			if err := t.storage.HandleDDLJob(jobEntry.Job); err != nil {
+				return errors.Trace(err)
+			}
+			t.ddls.push(jobEntry.Job)
+		}
+	})
+	return t, nil
+}
+
+// SchemaStorage returns the schema storage of the tracker.
+func (t *SchemaTracker) SchemaStorage() entry.SchemaStorage {
+	return t.storage
+}
+
+// FrontDDL returns the first DDL job not handled yet, or nil if there is none.
+func (t *SchemaTracker) FrontDDL() *timodel.Job {
+	return t.ddls.front()
+}
+
+// PopDDL removes the first DDL job after it's handled.
+func (t *SchemaTracker) PopDDL() {
+	t.ddls.pop()
+}
+
+// BarrierTs returns the ts the tables can be flushed to without passing
+// a DDL job not handled yet.
+func (t *SchemaTracker) BarrierTs() uint64 {
+	return t.ddls.barrierTs()
+}
+
+// DDLEvents returns the DDL events of a job.
+func (t *SchemaTracker) DDLEvents(ctx context.Context, job *timodel.Job) ([]*model.DDLEvent, error) {
+	preSnap, err := t.storage.GetSnapshot(ctx, job.BinlogInfo.FinishedTS-1)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return buildDDLEvents(preSnap, job)
+}
+
+// TableByName returns the table with the name in the snapshot at ts.
+func (t *SchemaTracker) TableByName(
+	ctx context.Context, ts uint64, schema, table string,
+) (*model.TableInfo, bool, error) {
+	snap, err := t.storage.GetSnapshot(ctx, ts)
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	tableInfo, ok := snap.TableByName(schema, table)
+	return tableInfo, ok, nil
+}
+
+// ddlQueue holds the DDL jobs which are not handled by the replicator yet,
+// in the order of their commit ts.
+type ddlQueue struct {
+	mu   sync.Mutex
+	jobs []*timodel.Job
+	// lastTs is the commit ts of the last job pushed into the queue.
+	lastTs uint64
+	// resolvedTs is the resolved ts of the DDL puller, no more DDL jobs
+	// with a commit ts not greater than it will be pushed.
+	resolvedTs uint64
+}
+
+// push appends a DDL job to the queue, jobs which are not done or have
+// been pushed already are ignored.
+func (q *ddlQueue) push(job *timodel.Job) {
+	if !job.IsSynced() && !job.IsDone() {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if job.BinlogInfo.FinishedTS <= q.lastTs {
+		return
+	}
+	q.jobs = append(q.jobs, job)
+	q.lastTs = job.BinlogInfo.FinishedTS
+}
+
+// advance advances the resolved ts of the queue.
+func (q *ddlQueue) advance(ts uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if ts > q.resolvedTs {
+		q.resolvedTs = ts
+	}
+}
+
+// front returns the first job in the queue, or nil if the queue is empty.
+func (q *ddlQueue) front() *timodel.Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.jobs) == 0 {
+		return nil
+	}
+	return q.jobs[0]
+}
+
+// pop removes the first job from the queue.
+func (q *ddlQueue) pop() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.jobs[0] = nil
+	q.jobs = q.jobs[1:]
+}
+
+// barrierTs returns the ts the table sinks can be resolved to. Rows committed
+// after a DDL must not be flushed before the DDL is executed, so it is the
+// commit ts of the first job minus one if there are pending jobs.
+func (q *ddlQueue) barrierTs() uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.jobs) > 0 {
+		return q.jobs[0].BinlogInfo.FinishedTS - 1
+	}
+	return q.resolvedTs
+}
+
+// buildDDLEvents builds the DDL events of a job from the snapshot before the
+// job is applied. A rename tables job results in an event for each table.
+func buildDDLEvents(snap *schema.Snapshot, job *timodel.Job) ([]*model.DDLEvent, error) {
+	if job.Type == timodel.ActionRenameTables {
+		return buildRenameTablesEvents(snap, job)
+	}
+	preTableInfo, err := snap.PreTableInfo(job)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := snap.FillSchemaName(job); err != nil {
+		return nil, errors.Trace(err)
+	}
+	event := new(model.DDLEvent)
+	event.FromJob(job, preTableInfo)
+	return []*model.DDLEvent{event}, nil
+}
+
+func buildRenameTablesEvents(snap *schema.Snapshot, job *timodel.Job) ([]*model.DDLEvent, error) {
+	var (
+		oldSchemaIDs, newSchemaIDs, oldTableIDs []int64
+		newTableNames, oldSchemaNames           []*timodel.CIStr
+	)
+	err := job.DecodeArgs(&oldSchemaIDs, &newSchemaIDs,
+		&newTableNames, &oldTableIDs, &oldSchemaNames)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	multiTableInfos := job.BinlogInfo.MultipleTableInfos
+	if len(multiTableInfos) != len(oldSchemaIDs) ||
+		len(multiTableInfos) != len(newSchemaIDs) ||
+		len(multiTableInfos) != len(newTableNames) ||
+		len(multiTableInfos) != len(oldTableIDs) ||
+		len(multiTableInfos) != len(oldSchemaNames) {
+		return nil, cerror.ErrInvalidDDLJob.GenWithStackByArgs(job.ID)
+	}
+
+	events := make([]*model.DDLEvent, 0, len(multiTableInfos))
+	for i, tableInfo := range multiTableInfos {
+		newSchema, ok := snap.SchemaByID(newSchemaIDs[i])
+		if !ok {
+			return nil, cerror.ErrSnapshotSchemaNotFound.GenWithStackByArgs(newSchemaIDs[i])
+		}
+		preTableInfo, ok := snap.PhysicalTableByID(tableInfo.ID)
+		if !ok {
+			return nil, cerror.ErrSchemaStorageTableMiss.GenWithStackByArgs(job.TableID)
+		}
+		event := new(model.DDLEvent)
+		event.FromRenameTablesJob(job, oldSchemaNames[i].O,
+			newSchema.Name.O, preTableInfo, tableInfo)
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// IsTableCreation returns whether the DDL creates a new table.
+func IsTableCreation(tp timodel.ActionType) bool {
+	switch tp {
+	case timodel.ActionCreateTable, timodel.ActionRecoverTable:
+		return true
+	default:
+		return false
+	}
+}
+
+// AffectedTables returns the tables in checkpoints changed by a DDL event.
+// The tables with checkpoints not less than the commit ts of the event were
+// started after the DDL, so they are not affected.
+func AffectedTables(checkpoints map[string]uint64, event *model.DDLEvent) []string {
+	var tables []string
+	add := func(name string) {
+		if checkpoint, ok := checkpoints[name]; !ok || checkpoint >= event.CommitTs {
+			return
+		}
+		for _, table := range tables {
+			if table == name {
+				return
+			}
+		}
+		tables = append(tables, name)
+	}
+	if event.TableInfo.Table == "" {
+		for name := range checkpoints {
+			if tableName, err := ParseTableName(name); err == nil &&
+				tableName.Schema == event.TableInfo.Schema {
+				add(name)
+			}
+		}
+		sort.Strings(tables)
+		return tables
+	}
+	add(TableKey(event.TableInfo.Schema, event.TableInfo.Table))
+	if event.PreTableInfo != nil {
+		add(TableKey(event.PreTableInfo.Schema, event.PreTableInfo.Table))
+	}
+	return tables
+}
+
+// IsRename returns whether the DDL event renames a table.
+func IsRename(event *model.DDLEvent) bool {
+	return event.PreTableInfo != nil && (event.Type == timodel.ActionRenameTable ||
+		event.Type == timodel.ActionRenameTables)
+}
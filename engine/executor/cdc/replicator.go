@@ -0,0 +1,597 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/entry/schema"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/puller"
+	sinkmetric "github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/factory"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
+	"github.com/pingcap/tiflow/cdc/sorter/memory"
+	"github.com/pingcap/tiflow/pkg/config"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// ddlCheckInterval is the interval to check the DDL queue.
+	ddlCheckInterval = 50 * time.Millisecond
+	// jobStatusCheckInterval is the interval to load the job status
+	// persisted by the job master.
+	jobStatusCheckInterval = time.Second
+)
+
+// replicator replicates a set of tables to the sink.
+type replicator interface {
+	// Run replicates the tables until ctx is canceled or an error occurs.
+	Run(ctx context.Context) error
+	// Checkpoints returns the checkpoint ts of each table.
+	Checkpoints() map[string]uint64
+}
+
+// jobStatusLoader loads the job status persisted by the job master,
+// it returns nil if the status is not persisted yet.
+type jobStatusLoader func(ctx context.Context) (*JobStatus, error)
+
+// newReplicator creates a replicator, it can be replaced in unit tests.
+var newReplicator = func(cfg *Config, loadJobStatus jobStatusLoader) replicator {
+	return newTableReplicator(cfg, loadJobStatus)
+}
+
+// tableReplicator runs a puller, a sorter and a table sink for each physical
+// table. The DDLs are executed once by the job master, the replicator doesn't
+// flush the rows after a DDL until the job master has executed it, and then
+// tracks the tables dropped, renamed or truncated by the DDL. The tables
+// created by DDLs are started when the job master assigns them to the task.
+type tableReplicator struct {
+	cfg           *Config
+	changefeedID  model.ChangeFeedID
+	loadJobStatus jobStatusLoader
+	// barrierTs is the barrier ts of the job master, the DDLs committed
+	// before it have been executed.
+	barrierTs atomic.Uint64
+	// handledTs is the commit ts of the last DDL handled by the replicator.
+	// It's only accessed by the goroutine running the DDLs.
+	handledTs uint64
+
+	mu          sync.Mutex
+	checkpoints map[string]uint64
+	tables      map[string]map[model.TableID]*physicalTable
+
+	// The following fields are set when the replicator starts, they are
+	// used to start the tables created or changed by DDLs.
+	up            *upstream.Upstream
+	wg            *errgroup.Group
+	replicaConfig *config.ReplicaConfig
+	tracker       *SchemaTracker
+	mounter       entry.Mounter
+	sinkFactory   *factory.SinkFactory
+	totalRows     prometheus.Counter
+}
+
+// physicalTable is a table or a partition being replicated.
+type physicalTable struct {
+	sink   tablesink.TableSink
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newTableReplicator(cfg *Config, loadJobStatus jobStatusLoader) *tableReplicator {
+	checkpoints := make(map[string]uint64, len(cfg.Checkpoints))
+	for name, ts := range cfg.Checkpoints {
+		checkpoints[name] = ts
+	}
+	return &tableReplicator{
+		cfg:           cfg,
+		changefeedID:  model.DefaultChangeFeedID(cfg.ChangefeedID),
+		loadJobStatus: loadJobStatus,
+		checkpoints:   checkpoints,
+		tables:        make(map[string]map[model.TableID]*physicalTable),
+	}
+}
+
+// Checkpoints implements replicator.Checkpoints.
+func (r *tableReplicator) Checkpoints() map[string]uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make(map[string]uint64, len(r.checkpoints))
+	for name, checkpoint := range r.checkpoints {
+		// A partitioned table has a table sink for each partition, and
+		// the checkpoint of the table is the minimum among them.
+		var minTs uint64
+		first := true
+		for _, t := range r.tables[name] {
+			if ts := t.sink.GetCheckpointTs().Ts; first || ts < minTs {
+				minTs = ts
+				first = false
+			}
+		}
+		if minTs > checkpoint {
+			checkpoint = minTs
+		}
+		ret[name] = checkpoint
+	}
+	return ret
+}
+
+// Run implements replicator.Run.
+func (r *tableReplicator) Run(ctx context.Context) error {
+	upManager := upstream.NewManager(ctx, r.cfg.ChangefeedID)
+	defer upManager.Close()
+	credential := r.cfg.Security
+	if credential == nil {
+		credential = &security.Credential{}
+	}
+	up, err := upManager.AddDefaultUpstream(r.cfg.PDAddrs, credential)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx = contextutil.PutChangefeedIDInCtx(ctx, r.changefeedID)
+	ctx = contextutil.PutRoleInCtx(ctx, util.RoleProcessor)
+	r.up = up
+	r.wg, ctx = errgroup.WithContext(ctx)
+
+	err = r.start(ctx)
+	if err != nil {
+		cancel()
+	}
+	if waitErr := r.wg.Wait(); err == nil {
+		err = waitErr
+	}
+	r.closeTableSinks()
+	if r.sinkFactory != nil {
+		if closeErr := r.sinkFactory.Close(); closeErr != nil {
+			log.Warn("close sink factory failed", zap.Error(closeErr))
+		}
+	}
+	if errors.Cause(err) == context.Canceled {
+		return nil
+	}
+	return err
+}
+
+// start spawns the goroutines replicating the tables and their DDLs in r.wg.
+func (r *tableReplicator) start(ctx context.Context) error {
+	r.replicaConfig = r.cfg.ReplicaConfig
+	if r.replicaConfig == nil {
+		r.replicaConfig = config.GetDefaultReplicaConfig()
+	}
+
+	// The DDLs are pulled from the start ts of the task if it has no tables.
+	startTs := r.cfg.StartTs
+	first := true
+	for _, ts := range r.cfg.Checkpoints {
+		if first || ts < startTs {
+			startTs = ts
+			first = false
+		}
+	}
+	r.handledTs = startTs
+
+	var err error
+	r.tracker, err = NewSchemaTracker(ctx, r.wg, r.up, startTs,
+		r.replicaConfig, r.changefeedID)
+	if err != nil {
+		return err
+	}
+	tz, err := util.GetTimezone(config.GetGlobalServerConfig().TZ)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tableFilter, err := filter.NewFilter(r.replicaConfig, "")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	r.mounter = entry.NewMounter(r.tracker.SchemaStorage(), r.changefeedID, tz, tableFilter,
+		r.replicaConfig.EnableOldValue, r.replicaConfig.EmitVirtualGeneratedColumns)
+
+	errCh := make(chan error, 16)
+	r.sinkFactory, err = factory.New(ctx, r.cfg.SinkURI, r.replicaConfig, errCh)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	r.wg.Go(func() error {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			return errors.Trace(err)
+		}
+	})
+
+	r.totalRows = sinkmetric.TableSinkTotalRowsCountCounter.
+		WithLabelValues(r.changefeedID.Namespace, r.changefeedID.ID)
+	for name, checkpointTs := range r.cfg.Checkpoints {
+		tableName, err := ParseTableName(name)
+		if err != nil {
+			return err
+		}
+		// The tables may be created or renamed after the start ts of the
+		// task, so they are looked up in the snapshots at their checkpoints.
+		tableInfo, ok, err := r.tracker.TableByName(ctx, checkpointTs,
+			tableName.Schema, tableName.Table)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return derrors.ErrCDCTableNotFound.GenWithStackByArgs(name, checkpointTs)
+		}
+		for _, tableID := range physicalTableIDs(tableInfo) {
+			r.startPhysicalTable(ctx, name, tableID, checkpointTs)
+		}
+	}
+	r.wg.Go(func() error {
+		return r.runDDLs(ctx)
+	})
+	return nil
+}
+
+// runDDLs handles the DDL jobs in the queue in order after the job master
+// executes them, and starts the tables assigned to the task by the job master.
+func (r *tableReplicator) runDDLs(ctx context.Context) error {
+	ticker := time.NewTicker(ddlCheckInterval)
+	defer ticker.Stop()
+	statusTicker := time.NewTicker(jobStatusCheckInterval)
+	defer statusTicker.Stop()
+	for {
+		for job := r.tracker.FrontDDL(); job != nil &&
+			job.BinlogInfo.FinishedTS <= r.barrierTs.Load(); job = r.tracker.FrontDDL() {
+			if err := r.handleDDL(ctx, job); err != nil {
+				return errors.Trace(err)
+			}
+			r.tracker.PopDDL()
+			r.handledTs = job.BinlogInfo.FinishedTS
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-statusTicker.C:
+			if err := r.syncJobStatus(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// syncJobStatus loads the job status, advances the barrier ts and starts the
+// tables assigned to the task. A table is started after the replicator has
+// handled the DDLs up to its start ts, otherwise it may be a table renamed by
+// a DDL not handled yet, which is tracked when the DDL is handled.
+func (r *tableReplicator) syncJobStatus(ctx context.Context) error {
+	status, err := r.loadJobStatus(ctx)
+	if err != nil {
+		log.Warn("load cdc job status failed, try next time",
+			zap.String("changefeed", r.cfg.ChangefeedID), zap.Error(err))
+		return nil
+	}
+	if status == nil {
+		return nil
+	}
+	if status.BarrierTs > r.barrierTs.Load() {
+		r.barrierTs.Store(status.BarrierTs)
+	}
+	if r.cfg.Index >= len(status.Groups) {
+		return nil
+	}
+	for _, name := range status.Groups[r.cfg.Index] {
+		startTs, ok := status.Checkpoints[name]
+		r.mu.Lock()
+		_, tracked := r.checkpoints[name]
+		r.mu.Unlock()
+		if tracked || !ok || startTs > r.handledTs {
+			continue
+		}
+		tableName, err := ParseTableName(name)
+		if err != nil {
+			return err
+		}
+		tableInfo, exists, err := r.tracker.TableByName(ctx, startTs,
+			tableName.Schema, tableName.Table)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return derrors.ErrCDCTableNotFound.GenWithStackByArgs(name, startTs)
+		}
+		log.Info("start replicating the assigned table", zap.String("changefeed", r.cfg.ChangefeedID),
+			zap.Int("index", r.cfg.Index), zap.String("table", name), zap.Uint64("startTs", startTs))
+		r.mu.Lock()
+		r.checkpoints[name] = startTs
+		r.mu.Unlock()
+		for _, tableID := range physicalTableIDs(tableInfo) {
+			r.startPhysicalTable(ctx, name, tableID, startTs)
+		}
+	}
+	return nil
+}
+
+func (r *tableReplicator) handleDDL(ctx context.Context, job *timodel.Job) error {
+	events, err := r.tracker.DDLEvents(ctx, job)
+	if err != nil {
+		return err
+	}
+	snap, err := r.tracker.SchemaStorage().GetSnapshot(ctx, job.BinlogInfo.FinishedTS)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, event := range events {
+		if err := r.handleDDLEvent(ctx, snap, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleDDLEvent updates the tables of the task changed by a DDL event
+// with the snapshot after the DDL.
+func (r *tableReplicator) handleDDLEvent(
+	ctx context.Context, snap *schema.Snapshot, event *model.DDLEvent,
+) error {
+	if event.TableInfo == nil {
+		return nil
+	}
+	r.mu.Lock()
+	tables := AffectedTables(r.checkpoints, event)
+	r.mu.Unlock()
+	if len(tables) == 0 {
+		return nil
+	}
+
+	if IsRename(event) {
+		name := TableKey(event.TableInfo.Schema, event.TableInfo.Table)
+		oldName := TableKey(event.PreTableInfo.Schema, event.PreTableInfo.Table)
+		for i := range tables {
+			if tables[i] == oldName && oldName != name {
+				r.renameTable(oldName, name)
+				tables[i] = name
+			}
+		}
+	}
+	for _, table := range tables {
+		if err := r.syncTable(ctx, snap, table, event.CommitTs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *tableReplicator) renameTable(oldName, newName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkpoints[newName] = r.checkpoints[oldName]
+	delete(r.checkpoints, oldName)
+	if tables, ok := r.tables[oldName]; ok {
+		r.tables[newName] = tables
+		delete(r.tables, oldName)
+	}
+}
+
+// syncTable updates the physical tables of a table with the snapshot after a
+// DDL, the table is removed if it is dropped, and the partitions created by
+// the DDL start replicating from its commit ts.
+func (r *tableReplicator) syncTable(
+	ctx context.Context, snap *schema.Snapshot, name string, commitTs uint64,
+) error {
+	tableName, err := ParseTableName(name)
+	if err != nil {
+		return err
+	}
+	tableIDs := make(map[model.TableID]struct{})
+	tableInfo, exists := snap.TableByName(tableName.Schema, tableName.Table)
+	if exists {
+		for _, tableID := range physicalTableIDs(tableInfo) {
+			tableIDs[tableID] = struct{}{}
+		}
+	}
+
+	var stopped []*physicalTable
+	var started []model.TableID
+	r.mu.Lock()
+	for tableID, t := range r.tables[name] {
+		if _, ok := tableIDs[tableID]; !ok {
+			stopped = append(stopped, t)
+			delete(r.tables[name], tableID)
+		}
+	}
+	for tableID := range tableIDs {
+		if _, ok := r.tables[name][tableID]; !ok {
+			started = append(started, tableID)
+		}
+	}
+	if exists {
+		// All the rows before the DDL have been flushed.
+		if r.checkpoints[name] < commitTs {
+			r.checkpoints[name] = commitTs
+		}
+	} else {
+		log.Info("stop replicating the dropped table", zap.String("changefeed", r.cfg.ChangefeedID),
+			zap.String("table", name), zap.Uint64("commitTs", commitTs))
+		delete(r.checkpoints, name)
+		delete(r.tables, name)
+	}
+	r.mu.Unlock()
+
+	for _, t := range stopped {
+		r.stopPhysicalTable(ctx, name, t)
+	}
+	for _, tableID := range started {
+		r.startPhysicalTable(ctx, name, tableID, commitTs)
+	}
+	return nil
+}
+
+func (r *tableReplicator) startPhysicalTable(
+	ctx context.Context, name string, tableID model.TableID, startTs uint64,
+) {
+	tableCtx, cancel := context.WithCancel(ctx)
+	t := &physicalTable{
+		sink:   r.sinkFactory.CreateTableSink(tableID, r.totalRows),
+		cancel: cancel,
+	}
+	r.mu.Lock()
+	if r.tables[name] == nil {
+		r.tables[name] = make(map[model.TableID]*physicalTable)
+	}
+	r.tables[name][tableID] = t
+	r.mu.Unlock()
+	r.runTable(tableCtx, t, name, tableID, startTs)
+}
+
+func (r *tableReplicator) stopPhysicalTable(ctx context.Context, name string, t *physicalTable) {
+	t.cancel()
+	t.wg.Wait()
+	if err := t.sink.Close(ctx); err != nil {
+		log.Warn("close table sink failed",
+			zap.String("table", name), zap.Error(err))
+	}
+}
+
+func (r *tableReplicator) runTable(
+	ctx context.Context,
+	t *physicalTable,
+	tableName string,
+	tableID model.TableID,
+	startTs uint64,
+) {
+	ctx = contextutil.PutTableInfoInCtx(ctx, tableID, tableName)
+	kvCfg := config.GetGlobalServerConfig().KVClient
+	// Always pull the old value internally, the same as the processor does.
+	plr := puller.New(ctx, r.up.PDClient, r.up.GrpcPool, r.up.RegionCache,
+		r.up.KVStorage, r.up.PDClock, startTs,
+		[]regionspan.Span{regionspan.GetTableSpan(tableID)},
+		kvCfg, r.changefeedID, tableID, tableName)
+	sorter := memory.NewEntrySorter()
+
+	// The errors caused by stopping the table are ignored.
+	goTable := func(fn func() error) {
+		t.wg.Add(1)
+		r.wg.Go(func() error {
+			defer t.wg.Done()
+			if err := fn(); err != nil && ctx.Err() == nil {
+				return err
+			}
+			return nil
+		})
+	}
+	goTable(func() error {
+		return plr.Run(ctx)
+	})
+	goTable(func() error {
+		return sorter.Run(ctx)
+	})
+	goTable(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case rawKV := <-plr.Output():
+				if rawKV == nil {
+					continue
+				}
+				sorter.AddEntry(ctx, model.NewPolymorphicEvent(rawKV))
+			}
+		}
+	})
+	goTable(func() error {
+		for {
+			var ev *model.PolymorphicEvent
+			select {
+			case <-ctx.Done():
+				return nil
+			case ev = <-sorter.Output():
+			}
+			if ev == nil || ev.CRTs < startTs {
+				continue
+			}
+			if ev.IsResolved() {
+				// Don't flush the rows after the DDLs not executed by the job
+				// master, or not handled by the replicator yet.
+				resolvedTs := ev.CRTs
+				if barrierTs := r.barrierTs.Load(); barrierTs < resolvedTs {
+					resolvedTs = barrierTs
+				}
+				if barrierTs := r.tracker.BarrierTs(); barrierTs < resolvedTs {
+					resolvedTs = barrierTs
+				}
+				if err := t.sink.UpdateResolvedTs(model.NewResolvedTs(resolvedTs)); err != nil {
+					return errors.Trace(err)
+				}
+				continue
+			}
+			ignored, err := r.mounter.DecodeEvent(ctx, ev)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if ignored || ev.Row == nil {
+				continue
+			}
+			t.sink.AppendRowChangedEvents(ev.Row)
+		}
+	})
+}
+
+func (r *tableReplicator) closeTableSinks() {
+	checkpoints := r.Checkpoints()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, tables := range r.tables {
+		for _, t := range tables {
+			t.cancel()
+			if err := t.sink.Close(context.Background()); err != nil {
+				log.Warn("close table sink failed",
+					zap.String("table", name), zap.Error(err))
+			}
+		}
+	}
+	r.checkpoints = checkpoints
+	r.tables = make(map[string]map[model.TableID]*physicalTable)
+}
+
+// physicalTableIDs returns the IDs of the partitions of a partitioned table,
+// or the ID of the table itself.
+func physicalTableIDs(tableInfo *model.TableInfo) []model.TableID {
+	pi := tableInfo.GetPartitionInfo()
+	if pi == nil {
+		return []model.TableID{tableInfo.ID}
+	}
+	tableIDs := make([]model.TableID, 0, len(pi.Definitions))
+	for _, def := range pi.Definitions {
+		tableIDs = append(tableIDs, def.ID)
+	}
+	return tableIDs
+}
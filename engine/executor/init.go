@@ -16,9 +16,11 @@ package executor
 import (
 	"sync"
 
+	cdctask "github.com/pingcap/tiflow/engine/executor/cdc"
 	cvstask "github.com/pingcap/tiflow/engine/executor/cvs"
 	dmtask "github.com/pingcap/tiflow/engine/executor/dm"
 	"github.com/pingcap/tiflow/engine/framework/registry"
+	"github.com/pingcap/tiflow/engine/jobmaster/cdc"
 	cvs "github.com/pingcap/tiflow/engine/jobmaster/cvsjob"
 	"github.com/pingcap/tiflow/engine/jobmaster/dm"
 )
@@ -31,5 +33,7 @@ func registerWorkers() {
 	cvs.RegisterWorker()
 	dm.RegisterWorker()
 	dmtask.RegisterWorker()
+	cdc.RegisterWorker()
+	cdctask.RegisterWorker()
 	registry.RegisterFake(registry.GlobalWorkerRegistry())
 }
@@ -623,7 +623,7 @@ func (m *DefaultBaseMaster) PrepareWorkerConfig(
 	workerType frameModel.WorkerType, config WorkerConfig,
 ) (rawConfig []byte, workerID frameModel.WorkerID, err error) {
 	switch workerType {
-	case frameModel.CvsJobMaster, frameModel.FakeJobMaster, frameModel.DMJobMaster:
+	case frameModel.CvsJobMaster, frameModel.FakeJobMaster, frameModel.DMJobMaster, frameModel.CdcJobMaster:
 		masterMeta, ok := config.(*frameModel.MasterMeta)
 		if !ok {
 			err = derror.ErrMasterInvalidMeta.GenWithStackByArgs(config)
@@ -0,0 +1,449 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/engine/executor/worker"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/framework/registry"
+	"github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// Status is the status of a cdc job, it is persisted in the metastore
+// so that the job can be resumed from the checkpoints after failover.
+// The workers read it to learn their tables and the barrier ts.
+type Status struct {
+	*Config `json:"cfg"`
+	cdcTask.JobStatus
+}
+
+type workerInfo struct {
+	handle     framework.WorkerHandle
+	needCreate bool
+}
+
+// JobMaster defines cdc job master. It splits the tables into groups,
+// creates a worker for each group, and persists the checkpoints reported
+// by the workers into the metastore. It also executes the DDLs once the
+// workers have flushed the rows before them, and assigns the tables
+// created by DDLs to the groups.
+type JobMaster struct {
+	framework.BaseJobMaster
+
+	workerID frameModel.WorkerID
+	ctx      context.Context
+	clocker  clock.Clock
+
+	mu              sync.Mutex
+	status          *Status
+	workers         []*workerInfo
+	launchedWorkers map[frameModel.WorkerID]int
+
+	statusCode struct {
+		sync.RWMutex
+		code frameModel.WorkerState
+	}
+	statusRateLimiter *rate.Limiter
+
+	cancelDDLs context.CancelFunc
+	ddlWg      sync.WaitGroup
+	ddlErr     atomic.Error
+}
+
+var _ framework.JobMasterImpl = (*JobMaster)(nil)
+
+// RegisterWorker is used to register cdc job master into global registry
+func RegisterWorker() {
+	factory := registry.NewSimpleWorkerFactory(NewCDCJobMaster)
+	registry.GlobalWorkerRegistry().MustRegisterWorkerType(frameModel.CdcJobMaster, factory)
+}
+
+// NewCDCJobMaster creates a new cdc job master
+func NewCDCJobMaster(ctx *dcontext.Context, workerID frameModel.WorkerID, masterID frameModel.MasterID, conf *Config) *JobMaster {
+	jm := &JobMaster{
+		workerID:          workerID,
+		ctx:               ctx.Context,
+		clocker:           clock.New(),
+		status:            &Status{Config: conf},
+		launchedWorkers:   make(map[frameModel.WorkerID]int),
+		statusRateLimiter: rate.NewLimiter(rate.Every(time.Second*2), 1),
+	}
+	log.Info("new cdc jobmaster", zap.String("id", jm.workerID))
+	return jm
+}
+
+// InitImpl implements JobMasterImpl.InitImpl
+func (jm *JobMaster) InitImpl(ctx context.Context) error {
+	log.Info("initializing the cdc jobmaster", zap.String("id", jm.workerID))
+	jm.setState(frameModel.WorkerStateInit)
+	if err := jm.status.Config.Adjust(); err != nil {
+		return err
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	jm.status.Groups = splitTables(jm.status.Tables, jm.status.TablesPerWorker)
+	jm.status.Checkpoints = make(map[string]uint64, len(jm.status.Tables))
+	for _, table := range jm.status.Tables {
+		jm.status.Checkpoints[table] = jm.status.StartTs
+	}
+	jm.status.CheckpointTs = jm.status.StartTs
+	jm.resetWorkers()
+
+	// The status has to be persisted before we set this master to normal status,
+	// so that the table groups are stable across failovers.
+	if err := jm.persistStatus(ctx); err != nil {
+		return err
+	}
+	jm.startDDLs()
+	jm.setState(frameModel.WorkerStateNormal)
+	return nil
+}
+
+// OnMasterRecovered implements JobMasterImpl.OnMasterRecovered
+func (jm *JobMaster) OnMasterRecovered(ctx context.Context) error {
+	log.Info("recovering cdc jobmaster", zap.String("id", jm.workerID))
+	resp, err := jm.MetaKVClient().Get(ctx, jm.workerID)
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) != 1 {
+		return errors.ErrMasterNotFound.GenWithStackByArgs(jm.workerID)
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	status := &Status{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, status); err != nil {
+		return err
+	}
+	jm.status = status
+	jm.resetWorkers()
+	jm.startDDLs()
+	jm.setState(frameModel.WorkerStateNormal)
+	log.Info("cdc jobmaster recovered", zap.String("id", jm.workerID),
+		zap.Uint64("checkpoint-ts", status.CheckpointTs))
+	return nil
+}
+
+// Tick implements JobMasterImpl.Tick
+func (jm *JobMaster) Tick(ctx context.Context) error {
+	if !jm.IsMasterReady() {
+		if jm.statusRateLimiter.Allow() {
+			log.Info("cdc jobmaster is not ready", zap.String("id", jm.workerID))
+		}
+		return nil
+	}
+
+	if err := jm.ddlErr.Load(); err != nil {
+		return err
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	if jm.getState() == frameModel.WorkerStateStopped {
+		log.Info("cdc jobmaster stopped", zap.String("id", jm.workerID))
+		return jm.BaseJobMaster.Exit(ctx, framework.ExitReasonCanceled, nil, jm.statusBytes())
+	}
+
+	for idx, info := range jm.workers {
+		if info.needCreate {
+			workerID, err := jm.CreateWorker(frameModel.CdcTask, jm.taskConfig(idx),
+				model.RescUnit(len(jm.status.Groups[idx])))
+			if err != nil {
+				log.Warn("create worker failed, try next time",
+					zap.String("id", jm.workerID), zap.Int("index", idx), zap.Error(err))
+				continue
+			}
+			jm.launchedWorkers[workerID] = idx
+			info.needCreate = false
+			continue
+		}
+		if info.handle == nil {
+			// still awaiting online
+			continue
+		}
+		jm.updateCheckpoints(info.handle.Status())
+	}
+
+	if jm.statusRateLimiter.Allow() {
+		if err := jm.persistStatus(ctx); err != nil {
+			log.Warn("persist cdc job status failed, try next time",
+				zap.String("id", jm.workerID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// OnWorkerDispatched implements JobMasterImpl.OnWorkerDispatched
+func (jm *JobMaster) OnWorkerDispatched(worker framework.WorkerHandle, err error) error {
+	if err == nil {
+		return nil
+	}
+	log.Warn("cdc worker dispatched failed", zap.String("id", jm.workerID),
+		zap.String("worker-id", worker.ID()), zap.Error(err))
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	idx, ok := jm.launchedWorkers[worker.ID()]
+	if !ok {
+		log.Panic("failed worker not found", zap.String("worker-id", worker.ID()))
+	}
+	delete(jm.launchedWorkers, worker.ID())
+	jm.workers[idx].needCreate = true
+	jm.workers[idx].handle = nil
+	return nil
+}
+
+// OnWorkerOnline implements JobMasterImpl.OnWorkerOnline
+func (jm *JobMaster) OnWorkerOnline(worker framework.WorkerHandle) error {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	idx, ok := jm.launchedWorkers[worker.ID()]
+	if !ok {
+		// The worker was created before the job master failed over.
+		log.Info("cdc jobmaster recovering and get worker", zap.String("id", jm.workerID),
+			zap.String("worker-id", worker.ID()))
+		status := &cdcTask.Status{}
+		if err := json.Unmarshal(worker.Status().ExtBytes, status); err != nil {
+			return err
+		}
+		idx = status.Index
+		if idx < 0 || idx >= len(jm.workers) {
+			return errors.ErrCDCJobInvalidConfig.GenWithStackByArgs("unknown table group of worker " + worker.ID())
+		}
+		jm.launchedWorkers[worker.ID()] = idx
+	}
+	log.Info("cdc worker online", zap.String("id", jm.workerID),
+		zap.String("worker-id", worker.ID()), zap.Int("index", idx))
+	jm.workers[idx].handle = worker
+	jm.workers[idx].needCreate = false
+	return nil
+}
+
+// OnWorkerOffline implements JobMasterImpl.OnWorkerOffline.
+// The worker is re-created from the latest checkpoints of its tables,
+// unless the job is being stopped.
+func (jm *JobMaster) OnWorkerOffline(worker framework.WorkerHandle, reason error) error {
+	log.Info("cdc worker offline", zap.String("id", jm.workerID),
+		zap.String("worker-id", worker.ID()), zap.Error(reason))
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	idx, ok := jm.launchedWorkers[worker.ID()]
+	if !ok {
+		log.Panic("offline worker not found", zap.String("worker-id", worker.ID()))
+	}
+	delete(jm.launchedWorkers, worker.ID())
+	jm.updateCheckpoints(worker.Status())
+	jm.workers[idx].handle = nil
+	jm.workers[idx].needCreate = jm.getState() != frameModel.WorkerStateStopped
+	return nil
+}
+
+// OnWorkerStatusUpdated implements JobMasterImpl.OnWorkerStatusUpdated
+func (jm *JobMaster) OnWorkerStatusUpdated(worker framework.WorkerHandle, newStatus *frameModel.WorkerStatus) error {
+	return nil
+}
+
+// OnWorkerMessage implements JobMasterImpl.OnWorkerMessage
+func (jm *JobMaster) OnWorkerMessage(worker framework.WorkerHandle, topic p2p.Topic, message p2p.MessageValue) error {
+	return nil
+}
+
+// CloseImpl implements JobMasterImpl.CloseImpl
+func (jm *JobMaster) CloseImpl(ctx context.Context) error {
+	jm.stopDDLs()
+	return nil
+}
+
+// StopImpl implements JobMasterImpl.StopImpl
+func (jm *JobMaster) StopImpl(ctx context.Context) error {
+	return nil
+}
+
+// ID implements JobMasterImpl.ID
+func (jm *JobMaster) ID() worker.RunnableID {
+	return jm.workerID
+}
+
+// Workload implements JobMasterImpl.Workload
+func (jm *JobMaster) Workload() model.RescUnit {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	return model.RescUnit(len(jm.status.Checkpoints))
+}
+
+// OnMasterMessage implements JobMasterImpl.OnMasterMessage
+func (jm *JobMaster) OnMasterMessage(ctx context.Context, topic p2p.Topic, message p2p.MessageValue) error {
+	return nil
+}
+
+// OnCancel implements JobMasterImpl.OnCancel
+func (jm *JobMaster) OnCancel(ctx context.Context) error {
+	log.Info("cdc jobmaster: OnCancel", zap.String("id", jm.workerID))
+	jm.setState(frameModel.WorkerStateStopped)
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	for _, info := range jm.workers {
+		info.needCreate = false
+		if info.handle == nil {
+			continue
+		}
+		handle := info.handle.Unwrap()
+		if handle == nil {
+			log.Info("skip sending message to tombstone worker", zap.String("worker-id", info.handle.ID()))
+			continue
+		}
+		topic := frameModel.WorkerStatusChangeRequestTopic(jm.BaseJobMaster.ID(), handle.ID())
+		msg := &frameModel.StatusChangeRequest{
+			SendTime:     jm.clocker.Mono(),
+			FromMasterID: jm.BaseJobMaster.ID(),
+			Epoch:        jm.BaseJobMaster.CurrentEpoch(),
+			ExpectState:  frameModel.WorkerStateStopped,
+		}
+		sendCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+		err := handle.SendMessage(sendCtx, topic, msg, false /*nonblocking*/)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnOpenAPIInitialized implements JobMasterImpl.OnOpenAPIInitialized.
+func (jm *JobMaster) OnOpenAPIInitialized(apiGroup *gin.RouterGroup) {}
+
+// Status implements JobMasterImpl.Status
+func (jm *JobMaster) Status() frameModel.WorkerStatus {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	return frameModel.WorkerStatus{
+		State:    jm.getState(),
+		ExtBytes: jm.statusBytes(),
+	}
+}
+
+// IsJobMasterImpl implements JobMasterImpl.IsJobMasterImpl
+func (jm *JobMaster) IsJobMasterImpl() {
+	panic("unreachable")
+}
+
+// resetWorkers marks all table groups as having no worker.
+// The caller must hold jm.mu.
+func (jm *JobMaster) resetWorkers() {
+	jm.workers = make([]*workerInfo, len(jm.status.Groups))
+	for i := range jm.workers {
+		jm.workers[i] = &workerInfo{needCreate: true}
+	}
+}
+
+// taskConfig returns the config of the worker for the idx-th group.
+// The caller must hold jm.mu.
+func (jm *JobMaster) taskConfig(idx int) *cdcTask.Config {
+	checkpoints := make(map[string]uint64, len(jm.status.Groups[idx]))
+	for _, table := range jm.status.Groups[idx] {
+		checkpoints[table] = jm.status.Checkpoints[table]
+	}
+	return &cdcTask.Config{
+		Index:         idx,
+		ChangefeedID:  jm.workerID,
+		PDAddrs:       jm.status.PDAddrs,
+		Security:      jm.status.Security,
+		SinkURI:       jm.status.SinkURI,
+		ReplicaConfig: jm.status.ReplicaConfig,
+		Checkpoints:   checkpoints,
+		StartTs:       jm.status.CheckpointTs,
+	}
+}
+
+// updateCheckpoints advances the checkpoints of the tables in the group of a
+// worker with the ones reported by it. The tables reported but not in the
+// group are ignored, they are dropped or renamed by a DDL executed by the job
+// master but not handled by the worker yet.
+// The caller must hold jm.mu.
+func (jm *JobMaster) updateCheckpoints(status *frameModel.WorkerStatus) {
+	if status == nil || len(status.ExtBytes) == 0 {
+		return
+	}
+	taskStatus := &cdcTask.Status{}
+	if err := json.Unmarshal(status.ExtBytes, taskStatus); err != nil {
+		log.Warn("unmarshal cdc task status failed", zap.String("id", jm.workerID), zap.Error(err))
+		return
+	}
+	if taskStatus.Index < 0 || taskStatus.Index >= len(jm.status.Groups) {
+		return
+	}
+	for _, table := range jm.status.Groups[taskStatus.Index] {
+		if ts, ok := taskStatus.Checkpoints[table]; ok && ts > jm.status.Checkpoints[table] {
+			jm.status.Checkpoints[table] = ts
+		}
+	}
+
+	var checkpointTs uint64
+	for _, ts := range jm.status.Checkpoints {
+		if checkpointTs == 0 || ts < checkpointTs {
+			checkpointTs = ts
+		}
+	}
+	if checkpointTs != 0 {
+		jm.status.CheckpointTs = checkpointTs
+	}
+}
+
+// persistStatus saves the status into the metastore.
+// The caller must hold jm.mu.
+func (jm *JobMaster) persistStatus(ctx context.Context) error {
+	_, err := jm.MetaKVClient().Put(ctx, jm.workerID, string(jm.statusBytes()))
+	return err
+}
+
+func (jm *JobMaster) statusBytes() []byte {
+	bytes, err := json.Marshal(jm.status)
+	if err != nil {
+		log.Panic("get status failed", zap.String("id", jm.workerID), zap.Error(err))
+	}
+	return bytes
+}
+
+func (jm *JobMaster) setState(code frameModel.WorkerState) {
+	jm.statusCode.Lock()
+	defer jm.statusCode.Unlock()
+	jm.statusCode.code = code
+}
+
+func (jm *JobMaster) getState() frameModel.WorkerState {
+	jm.statusCode.RLock()
+	defer jm.statusCode.RUnlock()
+	return jm.statusCode.code
+}
@@ -0,0 +1,375 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	cdcModel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink"
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/model"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	kvmock "github.com/pingcap/tiflow/engine/pkg/meta/mock"
+	metaModel "github.com/pingcap/tiflow/engine/pkg/meta/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/filter"
+)
+
+type mockBaseJobMaster struct {
+	mu sync.Mutex
+	mock.Mock
+
+	framework.BaseJobMaster
+	metaKVClient metaModel.KVClient
+}
+
+func (m *mockBaseJobMaster) ID() frameModel.WorkerID {
+	return "cdc-jobmaster-id"
+}
+
+func (m *mockBaseJobMaster) MetaKVClient() metaModel.KVClient {
+	return m.metaKVClient
+}
+
+func (m *mockBaseJobMaster) IsMasterReady() bool {
+	return true
+}
+
+func (m *mockBaseJobMaster) CreateWorker(
+	workerType framework.WorkerType, config framework.WorkerConfig,
+	cost model.RescUnit, resources ...resModel.ResourceID,
+) (frameModel.WorkerID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	args := m.Called(workerType, config)
+	return args.Get(0).(frameModel.WorkerID), args.Error(1)
+}
+
+func newWorkerHandle(t *testing.T, workerID string, index int, checkpoints map[string]uint64) *framework.MockWorkerHandler {
+	handle := &framework.MockWorkerHandler{WorkerID: workerID}
+	extBytes, err := json.Marshal(&cdcTask.Status{Index: index, Checkpoints: checkpoints})
+	require.NoError(t, err)
+	handle.On("Status").Return(&frameModel.WorkerStatus{
+		State:    frameModel.WorkerStateNormal,
+		ExtBytes: extBytes,
+	})
+	return handle
+}
+
+type fakeDDLTracker struct {
+	jobs       []*timodel.Job
+	events     map[uint64][]*cdcModel.DDLEvent
+	tables     map[string]bool
+	resolvedTs uint64
+}
+
+func (f *fakeDDLTracker) FrontDDL() *timodel.Job {
+	if len(f.jobs) == 0 {
+		return nil
+	}
+	return f.jobs[0]
+}
+
+func (f *fakeDDLTracker) PopDDL() {
+	f.jobs = f.jobs[1:]
+}
+
+func (f *fakeDDLTracker) BarrierTs() uint64 {
+	if len(f.jobs) > 0 {
+		return f.jobs[0].BinlogInfo.FinishedTS - 1
+	}
+	return f.resolvedTs
+}
+
+func (f *fakeDDLTracker) DDLEvents(_ context.Context, job *timodel.Job) ([]*cdcModel.DDLEvent, error) {
+	return f.events[job.BinlogInfo.FinishedTS], nil
+}
+
+func (f *fakeDDLTracker) TableByName(
+	_ context.Context, _ uint64, schema, table string,
+) (*cdcModel.TableInfo, bool, error) {
+	if !f.tables[cdcTask.TableKey(schema, table)] {
+		return nil, false, nil
+	}
+	return &cdcModel.TableInfo{TableInfo: &timodel.TableInfo{}}, true, nil
+}
+
+// addDDL adds a DDL job with a single event to the tracker.
+func (f *fakeDDLTracker) addDDL(
+	tp timodel.ActionType, commitTs uint64, query, schema, table string,
+	pre *cdcModel.SimpleTableInfo,
+) {
+	f.jobs = append(f.jobs, &timodel.Job{BinlogInfo: &timodel.HistoryInfo{FinishedTS: commitTs}})
+	f.events[commitTs] = []*cdcModel.DDLEvent{{
+		CommitTs:     commitTs,
+		Type:         tp,
+		Query:        query,
+		TableInfo:    &cdcModel.SimpleTableInfo{Schema: schema, Table: table},
+		PreTableInfo: pre,
+	}}
+}
+
+type recordDDLSink struct {
+	ddlsink.DDLEventSink
+	ddls []string
+}
+
+func (s *recordDDLSink) WriteDDLEvent(_ context.Context, ddl *cdcModel.DDLEvent) error {
+	s.ddls = append(s.ddls, ddl.Query)
+	return nil
+}
+
+func newTestJobMaster(t *testing.T, base *mockBaseJobMaster) *JobMaster {
+	// The DDLs are executed by calling handleDDLs in the tests.
+	originalNewDDLEnv := newDDLEnv
+	newDDLEnv = func(
+		ctx context.Context, _ *errgroup.Group, _ string, _ *Config, _ uint64,
+	) (*ddlEnv, func(), error) {
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	}
+	t.Cleanup(func() {
+		newDDLEnv = originalNewDDLEnv
+	})
+
+	jm := NewCDCJobMaster(dcontext.Background(), "cdc-job", "", &Config{
+		PDAddrs:         []string{"127.0.0.1:2379"},
+		SinkURI:         "blackhole://",
+		StartTs:         100,
+		Tables:          []string{"db.t3", "db.t1", "db.t2"},
+		TablesPerWorker: 2,
+	})
+	jm.BaseJobMaster = base
+	// persist the status on every tick
+	jm.statusRateLimiter = rate.NewLimiter(rate.Inf, 1)
+	t.Cleanup(func() {
+		require.NoError(t, jm.CloseImpl(context.Background()))
+	})
+	return jm
+}
+
+func TestConfigAdjust(t *testing.T) {
+	cfg := &Config{}
+	require.Regexp(t, ".*ErrCDCJobInvalidConfig.*", cfg.Adjust())
+
+	cfg = &Config{
+		PDAddrs: []string{"127.0.0.1:2379"},
+		SinkURI: "blackhole://",
+		StartTs: 1,
+		Tables:  []string{"db.t1", "t2"},
+	}
+	require.Regexp(t, ".*ErrCDCJobInvalidConfig.*", cfg.Adjust())
+	cfg.Tables = []string{"db.t1", "db.t1"}
+	require.Regexp(t, ".*duplicate table.*", cfg.Adjust())
+	cfg.Tables = []string{"db.t1", "db.t2"}
+	require.NoError(t, cfg.Adjust())
+	require.Equal(t, defaultTablesPerWorker, cfg.TablesPerWorker)
+	require.NotNil(t, cfg.ReplicaConfig)
+}
+
+func TestSplitTables(t *testing.T) {
+	require.Nil(t, splitTables(nil, 2))
+	require.Equal(t, [][]string{{"a.1", "a.2"}, {"a.3"}},
+		splitTables([]string{"a.3", "a.1", "a.2"}, 2))
+	require.Equal(t, [][]string{{"a.1", "a.2", "a.3"}},
+		splitTables([]string{"a.3", "a.1", "a.2"}, 5))
+}
+
+func TestJobMasterScheduleAndCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	base := &mockBaseJobMaster{metaKVClient: kvmock.NewMetaMock()}
+	jm := newTestJobMaster(t, base)
+	require.NoError(t, jm.InitImpl(ctx))
+	require.Equal(t, [][]string{{"db.t1", "db.t2"}, {"db.t3"}}, jm.status.Groups)
+
+	base.On("CreateWorker", frameModel.CdcTask, mock.MatchedBy(func(cfg *cdcTask.Config) bool {
+		return cfg.Index == 0
+	})).Return("worker-0", nil).Once()
+	base.On("CreateWorker", frameModel.CdcTask, mock.MatchedBy(func(cfg *cdcTask.Config) bool {
+		return cfg.Index == 1
+	})).Return("worker-1", nil).Once()
+	require.NoError(t, jm.Tick(ctx))
+	base.AssertExpectations(t)
+
+	require.NoError(t, jm.OnWorkerOnline(newWorkerHandle(t, "worker-0", 0,
+		map[string]uint64{"db.t1": 200, "db.t2": 150})))
+	require.NoError(t, jm.OnWorkerOnline(newWorkerHandle(t, "worker-1", 1,
+		map[string]uint64{"db.t3": 180})))
+	require.NoError(t, jm.Tick(ctx))
+	require.Equal(t, uint64(150), jm.status.CheckpointTs)
+	require.Equal(t, map[string]uint64{"db.t1": 200, "db.t2": 150, "db.t3": 180}, jm.status.Checkpoints)
+
+	// The worker is re-created from the latest checkpoints after it goes offline.
+	require.NoError(t, jm.OnWorkerOffline(newWorkerHandle(t, "worker-1", 1,
+		map[string]uint64{"db.t3": 190}), nil))
+	base.On("CreateWorker", frameModel.CdcTask, &cdcTask.Config{
+		Index:         1,
+		ChangefeedID:  "cdc-job",
+		PDAddrs:       []string{"127.0.0.1:2379"},
+		SinkURI:       "blackhole://",
+		ReplicaConfig: jm.status.ReplicaConfig,
+		Checkpoints:   map[string]uint64{"db.t3": 190},
+		StartTs:       150,
+	}).Return("worker-2", nil).Once()
+	require.NoError(t, jm.Tick(ctx))
+	base.AssertExpectations(t)
+
+	// The tables not in the group of a worker are ignored, they are dropped
+	// or renamed by the DDLs not handled by the worker yet.
+	jm.workers[0].handle = newWorkerHandle(t, "worker-0", 0,
+		map[string]uint64{"db.t1": 210, "db.t2": 160, "db.t4": 220})
+	require.NoError(t, jm.Tick(ctx))
+	require.Equal(t, [][]string{{"db.t1", "db.t2"}, {"db.t3"}}, jm.status.Groups)
+	require.Equal(t, map[string]uint64{"db.t1": 210, "db.t2": 160, "db.t3": 190},
+		jm.status.Checkpoints)
+	require.Equal(t, uint64(160), jm.status.CheckpointTs)
+	require.Equal(t, model.RescUnit(3), jm.Workload())
+
+	// A new job master recovers the status from the metastore.
+	jm2 := newTestJobMaster(t, base)
+	require.NoError(t, jm2.OnMasterRecovered(ctx))
+	require.Equal(t, jm.status, jm2.status)
+	require.Len(t, jm2.workers, 2)
+	require.NoError(t, jm2.OnWorkerOnline(newWorkerHandle(t, "worker-0", 0, nil)))
+	require.NoError(t, jm2.OnWorkerOnline(newWorkerHandle(t, "worker-2", 1, nil)))
+	require.NoError(t, jm2.Tick(ctx))
+	base.AssertExpectations(t)
+}
+
+func TestJobMasterExecuteDDLs(t *testing.T) {
+	ctx := context.Background()
+	base := &mockBaseJobMaster{metaKVClient: kvmock.NewMetaMock()}
+	jm := newTestJobMaster(t, base)
+	require.NoError(t, jm.InitImpl(ctx))
+	require.Equal(t, [][]string{{"db.t1", "db.t2"}, {"db.t3"}}, jm.status.Groups)
+
+	tracker := &fakeDDLTracker{
+		events: make(map[uint64][]*cdcModel.DDLEvent),
+		tables: map[string]bool{"db.t1": true, "db.t2": true, "db.t3": true},
+	}
+	sink := &recordDDLSink{}
+	env := &ddlEnv{tracker: tracker, sink: sink, forceReplicate: true}
+	var err error
+	env.filter, err = filter.NewFilter(config.GetDefaultReplicaConfig(), "")
+	require.NoError(t, err)
+	reach := func(ts uint64) {
+		jm.mu.Lock()
+		defer jm.mu.Unlock()
+		for table := range jm.status.Checkpoints {
+			jm.status.Checkpoints[table] = ts
+		}
+	}
+	persisted := func() *Status {
+		resp, err := base.metaKVClient.Get(ctx, jm.workerID)
+		require.NoError(t, err)
+		status := &Status{}
+		require.NoError(t, json.Unmarshal(resp.Kvs[0].Value, status))
+		return status
+	}
+
+	tracker.addDDL(timodel.ActionAddColumn, 200,
+		"alter table db.t1 add column c int", "db", "t1", nil)
+	tracker.addDDL(timodel.ActionCreateTable, 300,
+		"create table db.t4 (id int primary key)", "db", "t4", nil)
+	tracker.addDDL(timodel.ActionRenameTable, 400,
+		"rename table db.t3 to db.t5", "db", "t5",
+		&cdcModel.SimpleTableInfo{Schema: "db", Table: "t3"})
+	tracker.addDDL(timodel.ActionDropTable, 500, "drop table db.t2", "db", "t2", nil)
+	tracker.addDDL(timodel.ActionCreateSchema, 600, "create database db2", "db2", "", nil)
+
+	// The workers are held back at the DDL until they flush the rows before it.
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Equal(t, uint64(199), jm.status.BarrierTs)
+	require.Empty(t, sink.ddls)
+
+	// The DDL is executed once, and the barrier is advanced to the next DDL.
+	reach(199)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Equal(t, []string{"alter table db.t1 add column c int"}, sink.ddls)
+	require.Equal(t, uint64(299), jm.status.BarrierTs)
+	require.Equal(t, uint64(200), persisted().DDLTs)
+
+	// The created table is assigned to the group with the fewest tables.
+	tracker.tables["db.t4"] = true
+	reach(299)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Len(t, sink.ddls, 2)
+	require.Equal(t, [][]string{{"db.t1", "db.t2"}, {"db.t3", "db.t4"}}, jm.status.Groups)
+	require.Equal(t, uint64(300), jm.status.Checkpoints["db.t4"])
+	require.Equal(t, uint64(399), jm.status.BarrierTs)
+
+	// The renamed and dropped tables are updated in the groups.
+	delete(tracker.tables, "db.t3")
+	tracker.tables["db.t5"] = true
+	reach(399)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	delete(tracker.tables, "db.t2")
+	reach(499)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Equal(t, [][]string{{"db.t1"}, {"db.t4", "db.t5"}}, jm.status.Groups)
+	require.Equal(t, map[string]uint64{"db.t1": 499, "db.t4": 499, "db.t5": 499},
+		jm.status.Checkpoints)
+
+	// The DDL on a schema is executed once as well.
+	tracker.resolvedTs = 700
+	reach(599)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Equal(t, []string{
+		"alter table db.t1 add column c int",
+		"create table db.t4 (id int primary key)",
+		"rename table db.t3 to db.t5",
+		"drop table db.t2",
+		"create database db2",
+	}, sink.ddls)
+	require.Equal(t, uint64(700), jm.status.BarrierTs)
+	status := persisted()
+	require.Equal(t, uint64(600), status.DDLTs)
+	require.Equal(t, jm.status.Groups, status.Groups)
+
+	// A new group and its worker are added if all groups are full.
+	tracker.tables["db.t6"] = true
+	tracker.tables["db.t7"] = true
+	tracker.addDDL(timodel.ActionCreateTable, 800,
+		"create table db.t6 (id int primary key)", "db", "t6", nil)
+	tracker.addDDL(timodel.ActionCreateTable, 900,
+		"create table db.t7 (id int primary key)", "db", "t7", nil)
+	reach(799)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Equal(t, uint64(899), jm.status.BarrierTs)
+	reach(899)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Equal(t, [][]string{{"db.t1", "db.t6"}, {"db.t4", "db.t5"}, {"db.t7"}}, jm.status.Groups)
+	require.Len(t, jm.workers, 3)
+	require.True(t, jm.workers[2].needCreate)
+
+	// The DDLs executed before failover are skipped.
+	tracker.addDDL(timodel.ActionAddColumn, 900,
+		"alter table db.t7 add column c int", "db", "t7", nil)
+	require.NoError(t, jm.handleDDLs(ctx, env))
+	require.Len(t, sink.ddls, 7)
+}
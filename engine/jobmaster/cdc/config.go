@@ -0,0 +1,93 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"sort"
+
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+)
+
+const defaultTablesPerWorker = 16
+
+// Config is the config of a cdc job.
+type Config struct {
+	PDAddrs []string `json:"pd-addrs"`
+	// Security is the credential used to connect to the upstream cluster.
+	Security *security.Credential `json:"security"`
+	SinkURI  string               `json:"sink-uri"`
+	StartTs  uint64               `json:"start-ts"`
+	// Tables are the tables to replicate, in the form of `schema.table`.
+	// The tables created after the job starts are replicated as well if
+	// they are not filtered out by the replica config.
+	Tables []string `json:"tables"`
+	// TablesPerWorker is the maximum number of tables replicated by a worker.
+	TablesPerWorker int                   `json:"tables-per-worker"`
+	ReplicaConfig   *config.ReplicaConfig `json:"replica-config"`
+}
+
+// Adjust validates the config and fills in default values.
+func (c *Config) Adjust() error {
+	if len(c.PDAddrs) == 0 {
+		return errors.ErrCDCJobInvalidConfig.GenWithStackByArgs("pd-addrs is empty")
+	}
+	if c.SinkURI == "" {
+		return errors.ErrCDCJobInvalidConfig.GenWithStackByArgs("sink-uri is empty")
+	}
+	if c.StartTs == 0 {
+		return errors.ErrCDCJobInvalidConfig.GenWithStackByArgs("start-ts is not set")
+	}
+	if len(c.Tables) == 0 {
+		return errors.ErrCDCJobInvalidConfig.GenWithStackByArgs("tables is empty")
+	}
+	seen := make(map[string]struct{}, len(c.Tables))
+	for _, table := range c.Tables {
+		if _, err := cdcTask.ParseTableName(table); err != nil {
+			return err
+		}
+		if _, ok := seen[table]; ok {
+			return errors.ErrCDCJobInvalidConfig.GenWithStackByArgs("duplicate table " + table)
+		}
+		seen[table] = struct{}{}
+	}
+	if c.TablesPerWorker <= 0 {
+		c.TablesPerWorker = defaultTablesPerWorker
+	}
+	if c.ReplicaConfig == nil {
+		c.ReplicaConfig = config.GetDefaultReplicaConfig()
+	}
+	return nil
+}
+
+// splitTables splits the tables into groups, each group is
+// replicated by one worker.
+func splitTables(tables []string, tablesPerWorker int) [][]string {
+	sorted := make([]string, len(tables))
+	copy(sorted, tables)
+	sort.Strings(sorted)
+
+	var groups [][]string
+	for len(sorted) > 0 {
+		n := tablesPerWorker
+		if n > len(sorted) {
+			n = len(sorted)
+		}
+		groups = append(groups, sorted[:n])
+		sorted = sorted[n:]
+	}
+	return groups
+}
@@ -0,0 +1,377 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink"
+	ddlfactory "github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/factory"
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/pingcap/tiflow/pkg/util"
+)
+
+const (
+	// ddlCheckInterval is the interval to check the DDL queue and the
+	// checkpoints of the tables when waiting for a DDL to be executed.
+	ddlCheckInterval = 50 * time.Millisecond
+	// persistRetryInterval is the interval to retry persisting the status
+	// after a DDL is executed.
+	persistRetryInterval = time.Second
+)
+
+// ddlTracker provides the DDL jobs of the upstream in order,
+// it's implemented by cdcTask.SchemaTracker.
+type ddlTracker interface {
+	FrontDDL() *timodel.Job
+	PopDDL()
+	BarrierTs() uint64
+	DDLEvents(ctx context.Context, job *timodel.Job) ([]*model.DDLEvent, error)
+	TableByName(ctx context.Context, ts uint64, schema, table string) (*model.TableInfo, bool, error)
+}
+
+// ddlEnv is what the job master needs to execute the DDLs.
+type ddlEnv struct {
+	tracker        ddlTracker
+	sink           ddlsink.DDLEventSink
+	filter         filter.Filter
+	forceReplicate bool
+}
+
+// newDDLEnv creates the environment to execute the DDLs committed after
+// startTs, the goroutines pulling the DDLs are spawned in wg. The returned
+// function releases the environment. It can be replaced in unit tests.
+var newDDLEnv = func(
+	ctx context.Context, wg *errgroup.Group, jobID string, cfg *Config, startTs uint64,
+) (*ddlEnv, func(), error) {
+	upManager := upstream.NewManager(ctx, jobID)
+	credential := cfg.Security
+	if credential == nil {
+		credential = &security.Credential{}
+	}
+	up, err := upManager.AddDefaultUpstream(cfg.PDAddrs, credential)
+	if err != nil {
+		upManager.Close()
+		return nil, nil, errors.Trace(err)
+	}
+
+	changefeedID := model.DefaultChangeFeedID(jobID)
+	ctx = contextutil.PutChangefeedIDInCtx(ctx, changefeedID)
+	ctx = contextutil.PutRoleInCtx(ctx, util.RoleOwner)
+	env := &ddlEnv{forceReplicate: cfg.ReplicaConfig.ForceReplicate}
+	env.tracker, err = cdcTask.NewSchemaTracker(ctx, wg, up, startTs,
+		cfg.ReplicaConfig, changefeedID)
+	if err != nil {
+		upManager.Close()
+		return nil, nil, err
+	}
+	env.filter, err = filter.NewFilter(cfg.ReplicaConfig, "")
+	if err != nil {
+		upManager.Close()
+		return nil, nil, errors.Trace(err)
+	}
+	env.sink, err = ddlfactory.New(ctx, cfg.SinkURI, cfg.ReplicaConfig)
+	if err != nil {
+		upManager.Close()
+		return nil, nil, errors.Trace(err)
+	}
+	return env, func() {
+		if err := env.sink.Close(); err != nil {
+			log.Warn("close ddl sink failed", zap.String("id", jobID), zap.Error(err))
+		}
+		upManager.Close()
+	}, nil
+}
+
+// startDDLs starts executing the DDLs from the checkpoint ts of the job
+// in the background, the error is returned by the next Tick.
+func (jm *JobMaster) startDDLs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	jm.cancelDDLs = cancel
+	jm.ddlWg.Add(1)
+	go func() {
+		defer jm.ddlWg.Done()
+		if err := jm.runDDLs(ctx); err != nil && errors.Cause(err) != context.Canceled {
+			log.Error("cdc jobmaster executes ddls failed", zap.String("id", jm.workerID), zap.Error(err))
+			jm.ddlErr.Store(err)
+		}
+	}()
+}
+
+// stopDDLs stops executing the DDLs.
+func (jm *JobMaster) stopDDLs() {
+	if jm.cancelDDLs != nil {
+		jm.cancelDDLs()
+	}
+	jm.ddlWg.Wait()
+}
+
+func (jm *JobMaster) runDDLs(ctx context.Context) error {
+	jm.mu.Lock()
+	cfg, startTs := jm.status.Config, jm.status.CheckpointTs
+	jm.mu.Unlock()
+
+	wg, ctx := errgroup.WithContext(ctx)
+	env, closeEnv, err := newDDLEnv(ctx, wg, jm.workerID, cfg, startTs)
+	if err != nil {
+		return err
+	}
+	defer closeEnv()
+	wg.Go(func() error {
+		ticker := time.NewTicker(ddlCheckInterval)
+		defer ticker.Stop()
+		for {
+			if err := jm.handleDDLs(ctx, env); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+	return wg.Wait()
+}
+
+// handleDDLs executes the DDLs in the queue in order. A DDL is executed once
+// the checkpoints of all tables reach its commit ts, i.e. all the rows before
+// it have been flushed, and the barrier ts holds the tasks back from flushing
+// the rows after it until it's executed.
+func (jm *JobMaster) handleDDLs(ctx context.Context, env *ddlEnv) error {
+	for job := env.tracker.FrontDDL(); job != nil; job = env.tracker.FrontDDL() {
+		commitTs := job.BinlogInfo.FinishedTS
+		jm.mu.Lock()
+		// The DDL was executed before the job master failed over.
+		executed := commitTs <= jm.status.DDLTs
+		if !executed {
+			jm.advanceBarrier(commitTs - 1)
+		}
+		ready := executed || jm.checkpointsReached(commitTs-1)
+		jm.mu.Unlock()
+		if !ready {
+			return nil
+		}
+		if !executed {
+			if err := jm.executeDDL(ctx, env, job); err != nil {
+				return err
+			}
+		}
+		env.tracker.PopDDL()
+	}
+	jm.mu.Lock()
+	jm.advanceBarrier(env.tracker.BarrierTs())
+	jm.mu.Unlock()
+	return nil
+}
+
+// executeDDL executes the events of a DDL job, updates the tables changed by
+// it and persists the status, so the DDL isn't executed again after failover.
+func (jm *JobMaster) executeDDL(ctx context.Context, env *ddlEnv, job *timodel.Job) error {
+	events, err := env.tracker.DDLEvents(ctx, job)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err := jm.handleDDLEvent(ctx, env, event); err != nil {
+			return err
+		}
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	commitTs := job.BinlogInfo.FinishedTS
+	jm.status.DDLTs = commitTs
+	jm.advanceBarrier(commitTs)
+	for {
+		err := jm.persistStatus(ctx)
+		if err == nil {
+			return nil
+		}
+		log.Warn("persist cdc job status failed, retry later",
+			zap.String("id", jm.workerID), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-time.After(persistRetryInterval):
+		}
+	}
+}
+
+// handleDDLEvent executes a DDL event if it's about the tables of the job,
+// or it creates a table to replicate, or it's a DDL on a schema. Then the
+// tables are updated with the snapshot after the DDL, and the created table
+// is assigned to a table group.
+func (jm *JobMaster) handleDDLEvent(ctx context.Context, env *ddlEnv, event *model.DDLEvent) error {
+	if event.TableInfo == nil {
+		return nil
+	}
+	ignored, err := env.filter.ShouldIgnoreDDLEvent(event)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	name := cdcTask.TableKey(event.TableInfo.Schema, event.TableInfo.Table)
+	jm.mu.Lock()
+	tables := cdcTask.AffectedTables(jm.status.Checkpoints, event)
+	_, tracked := jm.status.Checkpoints[name]
+	jm.mu.Unlock()
+
+	created := false
+	if !ignored && !tracked && cdcTask.IsTableCreation(event.Type) {
+		tableInfo, ok, err := env.tracker.TableByName(ctx, event.CommitTs,
+			event.TableInfo.Schema, event.TableInfo.Table)
+		if err != nil {
+			return err
+		}
+		created = ok && tableInfo.IsEligible(env.forceReplicate)
+	}
+	isSchemaDDL := event.TableInfo.Table == ""
+	if !ignored && (len(tables) > 0 || created || isSchemaDDL) {
+		log.Info("execute ddl", zap.String("id", jm.workerID),
+			zap.String("query", event.Query), zap.Uint64("commitTs", event.CommitTs))
+		if err := env.sink.WriteDDLEvent(ctx, event); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	renamed := ""
+	if cdcTask.IsRename(event) {
+		renamed = cdcTask.TableKey(event.PreTableInfo.Schema, event.PreTableInfo.Table)
+	}
+	exists := make(map[string]bool, len(tables))
+	for i, table := range tables {
+		if table == renamed && renamed != name {
+			tables[i] = name
+			table = name
+		}
+		tableName, err := cdcTask.ParseTableName(table)
+		if err != nil {
+			return err
+		}
+		_, exists[table], err = env.tracker.TableByName(ctx, event.CommitTs,
+			tableName.Schema, tableName.Table)
+		if err != nil {
+			return err
+		}
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	if renamed != "" && renamed != name {
+		jm.renameTable(renamed, name)
+	}
+	for _, table := range tables {
+		if !exists[table] {
+			log.Info("stop replicating the dropped table", zap.String("id", jm.workerID),
+				zap.String("table", table), zap.Uint64("commitTs", event.CommitTs))
+			jm.removeTable(table)
+			continue
+		}
+		// All the rows before the DDL have been flushed.
+		if jm.status.Checkpoints[table] < event.CommitTs {
+			jm.status.Checkpoints[table] = event.CommitTs
+		}
+	}
+	if created {
+		jm.assignTable(name, event.CommitTs)
+	}
+	return nil
+}
+
+// advanceBarrier advances the barrier ts of the job.
+// The caller must hold jm.mu.
+func (jm *JobMaster) advanceBarrier(ts uint64) {
+	if ts > jm.status.BarrierTs {
+		jm.status.BarrierTs = ts
+	}
+}
+
+// checkpointsReached returns whether the checkpoints of all tables reach ts.
+// The caller must hold jm.mu.
+func (jm *JobMaster) checkpointsReached(ts uint64) bool {
+	for _, checkpoint := range jm.status.Checkpoints {
+		if checkpoint < ts {
+			return false
+		}
+	}
+	return true
+}
+
+// assignTable assigns a created table to the group with the fewest tables,
+// a new group is added if all groups are full.
+// The caller must hold jm.mu.
+func (jm *JobMaster) assignTable(name string, startTs uint64) {
+	idx := -1
+	for i, group := range jm.status.Groups {
+		if len(group) < jm.status.TablesPerWorker &&
+			(idx == -1 || len(group) < len(jm.status.Groups[idx])) {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		idx = len(jm.status.Groups)
+		jm.status.Groups = append(jm.status.Groups, nil)
+		jm.workers = append(jm.workers, &workerInfo{needCreate: true})
+	}
+	log.Info("assign the created table", zap.String("id", jm.workerID),
+		zap.String("table", name), zap.Int("index", idx), zap.Uint64("startTs", startTs))
+	jm.status.Groups[idx] = append(jm.status.Groups[idx], name)
+	sort.Strings(jm.status.Groups[idx])
+	jm.status.Checkpoints[name] = startTs
+}
+
+// renameTable renames a table in its group and the checkpoints.
+// The caller must hold jm.mu.
+func (jm *JobMaster) renameTable(oldName, newName string) {
+	for _, group := range jm.status.Groups {
+		for i, table := range group {
+			if table == oldName {
+				group[i] = newName
+				sort.Strings(group)
+				break
+			}
+		}
+	}
+	if checkpoint, ok := jm.status.Checkpoints[oldName]; ok {
+		jm.status.Checkpoints[newName] = checkpoint
+		delete(jm.status.Checkpoints, oldName)
+	}
+}
+
+// removeTable removes a table from its group and the checkpoints.
+// The caller must hold jm.mu.
+func (jm *JobMaster) removeTable(name string) {
+	for i, group := range jm.status.Groups {
+		for j, table := range group {
+			if table == name {
+				jm.status.Groups[i] = append(group[:j:j], group[j+1:]...)
+				break
+			}
+		}
+	}
+	delete(jm.status.Checkpoints, name)
+}
@@ -51,7 +51,7 @@ func (o *jobCreateOptions) addFlags(cmd *cobra.Command) {
 		return
 	}
 
-	cmd.Flags().Var(newJobTypeValue(enginepb.Job_TypeUnknown, &o.jobType), "job-type", "job type, one of [FakeJob, CVSDemo, DM]")
+	cmd.Flags().Var(newJobTypeValue(enginepb.Job_TypeUnknown, &o.jobType), "job-type", "job type, one of [FakeJob, CVSDemo, DM, CDC]")
 	cmd.Flags().StringVar(&o.jobConfigStr, "job-config", "", "path of config file for the job")
 	cmd.Flags().StringVar(&o.jobID, "job-id", "", "job id")
 
@@ -80,8 +80,10 @@ func (v *jobTypeValue) Set(val string) error {
 		*v = jobTypeValue(enginepb.Job_CVSDemo)
 	case "DM":
 		*v = jobTypeValue(enginepb.Job_DM)
+	case "CDC":
+		*v = jobTypeValue(enginepb.Job_CDC)
 	default:
-		return fmt.Errorf("job type must be one of [FakeJob, CVSDemo, DM]")
+		return fmt.Errorf("job type must be one of [FakeJob, CVSDemo, DM, CDC]")
 	}
 	return nil
 }
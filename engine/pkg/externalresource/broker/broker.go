@@ -16,11 +16,13 @@ package broker
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/pkg/client"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
 	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
 	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
 	"github.com/pingcap/tiflow/engine/pkg/rpcerror"
@@ -42,6 +44,14 @@ type DefaultBroker struct {
 	client     ResourceManagerClient
 
 	fileManager FileManager
+
+	// s3Factory is nil if s3 resources are not configured.
+	s3Factory internal.ExternalStorageFactory
+
+	// s3Temporaries tracks the s3 resources opened by each worker,
+	// so that the unpersisted ones can be removed when the worker exits.
+	s3Mu          sync.Mutex
+	s3Temporaries map[resModel.WorkerID][]*S3ResourceHandle
 }
 
 // NewBroker creates a new Impl instance
@@ -52,15 +62,22 @@ func NewBroker(
 ) *DefaultBroker {
 	log.Info("Create new resource broker",
 		zap.String("executor-id", string(executorID)),
-		zap.Any("config", config))
+		zap.Any("local-config", config.Local),
+		zap.String("s3-bucket", config.S3.Bucket),
+		zap.String("s3-prefix", config.S3.Prefix))
 
 	fm := NewLocalFileManager(config.Local)
-	return &DefaultBroker{
-		config:      config,
-		executorID:  executorID,
-		client:      client,
-		fileManager: fm,
+	b := &DefaultBroker{
+		config:        config,
+		executorID:    executorID,
+		client:        client,
+		fileManager:   fm,
+		s3Temporaries: make(map[resModel.WorkerID][]*S3ResourceHandle),
+	}
+	if config.S3Enabled() {
+		b.s3Factory = internal.NewS3StorageFactory(config.S3)
 	}
+	return b
 }
 
 // OpenStorage implements Broker.OpenStorage
@@ -80,7 +97,7 @@ func (b *DefaultBroker) OpenStorage(
 	case resModel.ResourceTypeLocalFile:
 		return b.newHandleForLocalFile(ctx, projectInfo, jobID, workerID, resourcePath)
 	case resModel.ResourceTypeS3:
-		log.Panic("resource type s3 is not supported for now")
+		return b.newHandleForS3(ctx, projectInfo, jobID, workerID, resourcePath)
 	default:
 		log.Panic("unsupported resource type", zap.String("resource-path", resourcePath))
 	}
@@ -100,6 +117,24 @@ func (b *DefaultBroker) OnWorkerClosed(ctx context.Context, workerID resModel.Wo
 			zap.String("job-id", jobID),
 			zap.Error(err))
 	}
+
+	b.s3Mu.Lock()
+	handles := b.s3Temporaries[workerID]
+	delete(b.s3Temporaries, workerID)
+	b.s3Mu.Unlock()
+
+	for _, h := range handles {
+		if h.isPersisted.Load() || h.isInvalid.Load() {
+			continue
+		}
+		if err := h.Discard(ctx); err != nil {
+			log.Warn("Failed to remove temporary s3 resource for worker",
+				zap.String("worker-id", workerID),
+				zap.String("job-id", jobID),
+				zap.String("resource-id", h.ID()),
+				zap.Error(err))
+		}
+	}
 }
 
 // RemoveResource implements pb.BrokerServiceServer.
@@ -182,6 +217,46 @@ func (b *DefaultBroker) newHandleForLocalFile(
 	return newLocalResourceHandle(projectInfo, resourceID, jobID, b.executorID, b.fileManager, desc, b.client)
 }
 
+func (b *DefaultBroker) newHandleForS3(
+	ctx context.Context,
+	projectInfo tenant.ProjectInfo,
+	jobID resModel.JobID,
+	workerID resModel.WorkerID,
+	resourceID resModel.ResourceID,
+) (Handle, error) {
+	if b.s3Factory == nil {
+		return nil, derrors.ErrS3ResourceNotConfigured.GenWithStackByArgs(b.executorID)
+	}
+
+	_, resName, err := resModel.ParseResourcePath(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	record, exists, err := b.checkForExistingResource(ctx, resModel.ResourceKey{JobID: jobID, ID: resourceID})
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := b.s3Factory.NewStorage(ctx, internal.S3ResourceSubDir(jobID, resName))
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Using s3 storage with path", zap.String("path", storage.URI()))
+
+	if exists {
+		return newS3ResourceHandle(projectInfo, resourceID, jobID,
+			b.executorID, record.Worker, storage, b.client, true), nil
+	}
+
+	h := newS3ResourceHandle(projectInfo, resourceID, jobID,
+		b.executorID, workerID, storage, b.client, false)
+	b.s3Mu.Lock()
+	b.s3Temporaries[workerID] = append(b.s3Temporaries[workerID], h)
+	b.s3Mu.Unlock()
+	return h, nil
+}
+
 func (b *DefaultBroker) checkForExistingResource(
 	ctx context.Context,
 	resourceKey resModel.ResourceKey,
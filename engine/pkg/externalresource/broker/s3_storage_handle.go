@@ -0,0 +1,134 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	brStorage "github.com/pingcap/tidb/br/pkg/storage"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/engine/pkg/tenant"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/atomic"
+)
+
+// S3ResourceHandle is a Handle for a resource stored in s3.
+// Unlike local file resources, s3 resources are not bound to
+// the executor that created them, so they survive executor failures.
+type S3ResourceHandle struct {
+	projectInfo tenant.ProjectInfo
+	id          resModel.ResourceID
+	jobID       resModel.JobID
+	executorID  resModel.ExecutorID
+	creator     resModel.WorkerID
+
+	inner  brStorage.ExternalStorage
+	client ResourceManagerClient
+
+	// isPersisted should be set to true if the
+	// resource has been registered with the servermaster.
+	isPersisted atomic.Bool
+	isInvalid   atomic.Bool
+}
+
+func newS3ResourceHandle(
+	projectInfo tenant.ProjectInfo,
+	resourceID resModel.ResourceID,
+	jobID resModel.JobID,
+	executorID resModel.ExecutorID,
+	creator resModel.WorkerID,
+	inner brStorage.ExternalStorage,
+	client ResourceManagerClient,
+	persisted bool,
+) *S3ResourceHandle {
+	h := &S3ResourceHandle{
+		projectInfo: projectInfo,
+		id:          resourceID,
+		jobID:       jobID,
+		executorID:  executorID,
+		creator:     creator,
+
+		inner:  inner,
+		client: client,
+	}
+	h.isPersisted.Store(persisted)
+	return h
+}
+
+// ID implements Handle.ID
+func (h *S3ResourceHandle) ID() resModel.ResourceID {
+	return h.id
+}
+
+// BrExternalStorage implements Handle.BrExternalStorage
+func (h *S3ResourceHandle) BrExternalStorage() brStorage.ExternalStorage {
+	return h.inner
+}
+
+// Persist implements Handle.Persist
+func (h *S3ResourceHandle) Persist(ctx context.Context) error {
+	if h.isInvalid.Load() {
+		// Trying to persist invalid resource.
+		return derrors.ErrInvalidResourceHandle.FastGenByArgs()
+	}
+	if h.isPersisted.Load() {
+		return nil
+	}
+
+	err := h.client.CreateResource(ctx, &pb.CreateResourceRequest{
+		ProjectInfo:     &pb.ProjectInfo{TenantId: h.projectInfo.TenantID(), ProjectId: h.projectInfo.ProjectID()},
+		ResourceId:      h.id,
+		CreatorExecutor: string(h.executorID),
+		JobId:           h.jobID,
+		CreatorWorkerId: h.creator,
+	})
+	if err != nil {
+		// TODO proper retrying.
+		return errors.Trace(err)
+	}
+	h.isPersisted.Store(true)
+	return nil
+}
+
+// Discard implements Handle.Discard
+func (h *S3ResourceHandle) Discard(ctx context.Context) error {
+	if h.isInvalid.Load() {
+		// Trying to discard invalid resource.
+		return derrors.ErrInvalidResourceHandle.FastGenByArgs()
+	}
+
+	if err := internal.RemoveAllFiles(ctx, h.inner); err != nil {
+		return err
+	}
+
+	if h.isPersisted.Load() {
+		err := h.client.RemoveResource(ctx, &pb.RemoveResourceRequest{
+			ResourceKey: &pb.ResourceKey{
+				JobId:      h.jobID,
+				ResourceId: h.id,
+			},
+		})
+		if err != nil {
+			// TODO proper retrying.
+			return errors.Trace(err)
+		}
+		h.isPersisted.Store(false)
+	}
+
+	h.isInvalid.Store(true)
+	return nil
+}
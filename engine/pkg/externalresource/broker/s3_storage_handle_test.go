@@ -0,0 +1,132 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/manager"
+	"github.com/pingcap/tiflow/engine/pkg/tenant"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newBrokerWithS3 returns a broker whose s3 resources are stored
+// in a local directory, which serves as a stand-in for a bucket.
+func newBrokerWithS3(t *testing.T) (*DefaultBroker, *manager.MockClient, string) {
+	brk, cli, _ := newBroker(t)
+	bucketDir := t.TempDir()
+	brk.s3Factory = internal.NewLocalStorageFactory(bucketDir)
+	return brk, cli, bucketDir
+}
+
+func s3FilePath(bucketDir, jobID, resName, fileName string) string {
+	return filepath.Join(bucketDir, internal.S3ResourceSubDir(jobID, resName), fileName)
+}
+
+func TestS3ResourceNotConfigured(t *testing.T) {
+	brk, cli, _ := newBroker(t)
+	_, err := brk.OpenStorage(context.Background(), tenant.NewProjectInfo("", ""),
+		"worker-1", "job-1", "/s3/test-1")
+	require.Regexp(t, ".*ErrS3ResourceNotConfigured.*", err)
+	cli.AssertExpectations(t)
+}
+
+func TestS3ResourcePersistAndReopen(t *testing.T) {
+	ctx := context.Background()
+	fakeProjectInfo := tenant.NewProjectInfo("fakeTenant", "fakeProject")
+	brk, cli, bucketDir := newBrokerWithS3(t)
+
+	cli.On("QueryResource", mock.Anything,
+		&pb.QueryResourceRequest{ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-1"}}, mock.Anything).
+		Return((*pb.QueryResourceResponse)(nil), status.Error(codes.NotFound, "resource manager error")).Once()
+	hdl, err := brk.OpenStorage(ctx, fakeProjectInfo, "worker-1", "job-1", "/s3/test-1")
+	require.NoError(t, err)
+	require.Equal(t, "/s3/test-1", hdl.ID())
+
+	err = hdl.BrExternalStorage().WriteFile(ctx, "1.txt", []byte("data"))
+	require.NoError(t, err)
+	require.FileExists(t, s3FilePath(bucketDir, "job-1", "test-1", "1.txt"))
+
+	cli.On("CreateResource", mock.Anything, &pb.CreateResourceRequest{
+		ProjectInfo:     &pb.ProjectInfo{TenantId: fakeProjectInfo.TenantID(), ProjectId: fakeProjectInfo.ProjectID()},
+		ResourceId:      "/s3/test-1",
+		CreatorExecutor: "executor-1",
+		JobId:           "job-1",
+		CreatorWorkerId: "worker-1",
+	}, mock.Anything).Return(nil).Once()
+	err = hdl.Persist(ctx)
+	require.NoError(t, err)
+	// Persisting twice is a no-op.
+	err = hdl.Persist(ctx)
+	require.NoError(t, err)
+
+	// Persisted resources are kept after the worker exits.
+	brk.OnWorkerClosed(ctx, "worker-1", "job-1")
+	require.FileExists(t, s3FilePath(bucketDir, "job-1", "test-1", "1.txt"))
+
+	// Another worker, possibly on another executor, can read the resource.
+	cli.On("QueryResource", mock.Anything,
+		&pb.QueryResourceRequest{ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-1"}}, mock.Anything).
+		Return(&pb.QueryResourceResponse{
+			CreatorExecutor: "executor-2",
+			JobId:           "job-1",
+			CreatorWorkerId: "worker-1",
+		}, nil).Once()
+	hdl, err = brk.OpenStorage(ctx, fakeProjectInfo, "worker-2", "job-1", "/s3/test-1")
+	require.NoError(t, err)
+	data, err := hdl.BrExternalStorage().ReadFile(ctx, "1.txt")
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), data)
+
+	cli.On("RemoveResource", mock.Anything, &pb.RemoveResourceRequest{
+		ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-1"},
+	}, mock.Anything).Return(nil).Once()
+	err = hdl.Discard(ctx)
+	require.NoError(t, err)
+	require.NoFileExists(t, s3FilePath(bucketDir, "job-1", "test-1", "1.txt"))
+
+	err = hdl.Discard(ctx)
+	require.Regexp(t, ".*ErrInvalidResourceHandle.*", err)
+	err = hdl.Persist(ctx)
+	require.Regexp(t, ".*ErrInvalidResourceHandle.*", err)
+
+	cli.AssertExpectations(t)
+}
+
+func TestS3ResourceRemovedOnWorkerClosed(t *testing.T) {
+	ctx := context.Background()
+	brk, cli, bucketDir := newBrokerWithS3(t)
+
+	cli.On("QueryResource", mock.Anything, mock.Anything, mock.Anything).
+		Return((*pb.QueryResourceResponse)(nil), status.Error(codes.NotFound, "resource manager error"))
+	hdl, err := brk.OpenStorage(ctx, tenant.NewProjectInfo("", ""), "worker-1", "job-1", "/s3/test-1")
+	require.NoError(t, err)
+	err = hdl.BrExternalStorage().WriteFile(ctx, "1.txt", []byte("data"))
+	require.NoError(t, err)
+
+	// Resources of other workers are not affected.
+	brk.OnWorkerClosed(ctx, "worker-2", "job-1")
+	require.FileExists(t, s3FilePath(bucketDir, "job-1", "test-1", "1.txt"))
+
+	brk.OnWorkerClosed(ctx, "worker-1", "job-1")
+	require.NoFileExists(t, s3FilePath(bucketDir, "job-1", "test-1", "1.txt"))
+	cli.AssertExpectations(t)
+}
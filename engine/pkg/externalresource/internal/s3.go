@@ -0,0 +1,113 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pingcap/errors"
+	brStorage "github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ExternalStorageFactory creates external storages rooted at
+// sub-directories of a common base location.
+type ExternalStorageFactory interface {
+	// NewStorage returns an ExternalStorage whose base path is subDir
+	// relative to the base location of the factory.
+	NewStorage(ctx context.Context, subDir string) (brStorage.ExternalStorage, error)
+}
+
+type s3StorageFactory struct {
+	config storagecfg.S3Config
+}
+
+// NewS3StorageFactory returns an ExternalStorageFactory that creates
+// storages in the configured bucket under the configured prefix.
+func NewS3StorageFactory(config storagecfg.S3Config) ExternalStorageFactory {
+	return &s3StorageFactory{config: config}
+}
+
+// NewStorage implements ExternalStorageFactory.
+func (f *s3StorageFactory) NewStorage(
+	ctx context.Context, subDir string,
+) (brStorage.ExternalStorage, error) {
+	uri := fmt.Sprintf("s3://%s/%s",
+		f.config.Bucket, strings.TrimPrefix(path.Join(f.config.Prefix, subDir), "/"))
+	opts := &brStorage.BackendOptions{S3: f.config.S3BackendOptions}
+	backend, err := brStorage.ParseBackend(uri, opts)
+	if err != nil {
+		return nil, derrors.ErrFailToCreateExternalStorage.Wrap(err)
+	}
+	storage, err := brStorage.New(ctx, backend, &brStorage.ExternalStorageOptions{
+		SendCredentials: false,
+	})
+	if err != nil {
+		return nil, derrors.ErrFailToCreateExternalStorage.Wrap(err)
+	}
+	return storage, nil
+}
+
+type localStorageFactory struct {
+	baseDir string
+}
+
+// NewLocalStorageFactory returns an ExternalStorageFactory backed by
+// a local directory. It behaves like an S3 bucket and is used as a
+// stand-in for S3 in tests.
+func NewLocalStorageFactory(baseDir string) ExternalStorageFactory {
+	return &localStorageFactory{baseDir: baseDir}
+}
+
+// NewStorage implements ExternalStorageFactory.
+func (f *localStorageFactory) NewStorage(
+	_ context.Context, subDir string,
+) (brStorage.ExternalStorage, error) {
+	storage, err := brStorage.NewLocalStorage(path.Join(f.baseDir, subDir))
+	if err != nil {
+		return nil, derrors.ErrFailToCreateExternalStorage.Wrap(err)
+	}
+	return storage, nil
+}
+
+// S3ResourceSubDir returns the sub-directory in which the files
+// of an s3 resource are stored. Resources are grouped by jobs,
+// so that all files belonging to a job share a common prefix.
+func S3ResourceSubDir(jobID string, resName string) string {
+	return path.Join(jobID, hex.EncodeToString([]byte(resName)))
+}
+
+// RemoveAllFiles removes all files in the given storage.
+func RemoveAllFiles(ctx context.Context, storage brStorage.ExternalStorage) error {
+	var toRemove []string
+	err := storage.WalkDir(ctx, &brStorage.WalkOption{}, func(path string, _ int64) error {
+		toRemove = append(toRemove, path)
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	for _, path := range toRemove {
+		if err := storage.DeleteFile(ctx, path); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
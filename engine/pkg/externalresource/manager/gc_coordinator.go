@@ -179,7 +179,8 @@ func (c *DefaultGCCoordinator) gcByStatusSnapshots(
 
 		// toRemove is used to remove meta records when
 		// the associated executors are offline.
-		// TODO adjust the mechanism when we implement S3 support.
+		// Note that s3 resources are kept since they are
+		// not bound to any executor.
 		toRemove []model.ExecutorID
 	)
 	for _, resMeta := range resources {
@@ -189,6 +190,11 @@ func (c *DefaultGCCoordinator) gcByStatusSnapshots(
 			continue
 		}
 
+		if tp, _, err := resModel.ParseResourcePath(resMeta.ID); err == nil &&
+			tp == resModel.ResourceTypeS3 {
+			continue
+		}
+
 		if _, exists := executorSet[resMeta.Executor]; !exists {
 			// The resource belongs to an offlined executor.
 			toRemove = append(toRemove, resMeta.Executor)
@@ -232,9 +238,9 @@ func (c *DefaultGCCoordinator) gcByOfflineExecutorID(ctx context.Context, execut
 	log.Info("Cleaning up resources meta for offlined executor",
 		zap.String("executor-id", string(executorID)))
 
-	// Currently, we only support local files, so the resources are bound to
-	// the executors. Hence, executors going offline means that the resource is
-	// already gone.
+	// Local file resources are bound to the executors. Hence, executors going
+	// offline means that the resource is already gone. S3 resources survive
+	// executor failures, so their meta records are not removed here.
 	// TODO Trigger GC for all resources and let the GCRunner decide whether to
 	// perform any action, or just remove the meta record.
 	_, err := c.metaClient.DeleteResourcesByExecutorID(ctx, executorID)
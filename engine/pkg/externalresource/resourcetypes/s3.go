@@ -0,0 +1,54 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetypes
+
+import (
+	"context"
+
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
+)
+
+// S3ResourceController defines operations specific to the s3 type.
+type S3ResourceController struct {
+	factory internal.ExternalStorageFactory
+}
+
+// NewS3ResourceType creates a new S3ResourceController.
+func NewS3ResourceType(config storagecfg.S3Config) *S3ResourceController {
+	return &S3ResourceController{factory: internal.NewS3StorageFactory(config)}
+}
+
+// GCHandler returns a closure to the invoker to perform GC.
+func (r *S3ResourceController) GCHandler() func(context.Context, *resModel.ResourceMeta) error {
+	return r.removeFilesInS3
+}
+
+// removeFilesInS3 removes all files under the prefix of the resource.
+// Since s3 resources are not bound to any executor, GC can proceed
+// even if the creator executor has gone offline.
+func (r *S3ResourceController) removeFilesInS3(ctx context.Context, resource *resModel.ResourceMeta) error {
+	_, resName, err := resModel.ParseResourcePath(resource.ID)
+	if err != nil {
+		return err
+	}
+
+	storage, err := r.factory.NewStorage(ctx, internal.S3ResourceSubDir(resource.Job, resName))
+	if err != nil {
+		return perrors.Annotate(err, "removeFilesInS3")
+	}
+	return internal.RemoveAllFiles(ctx, storage)
+}
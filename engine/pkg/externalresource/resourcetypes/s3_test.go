@@ -0,0 +1,59 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetypes
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveFilesInS3(t *testing.T) {
+	ctx := context.Background()
+	bucketDir := t.TempDir()
+	factory := internal.NewLocalStorageFactory(bucketDir)
+	resourceTp := &S3ResourceController{factory: factory}
+	gcHandler := resourceTp.GCHandler()
+
+	for _, resName := range []string{"resource-1", "resource-2"} {
+		storage, err := factory.NewStorage(ctx, internal.S3ResourceSubDir("job-1", resName))
+		require.NoError(t, err)
+		require.NoError(t, storage.WriteFile(ctx, "1.txt", []byte("data")))
+		require.NoError(t, storage.WriteFile(ctx, "2.txt", []byte("data")))
+	}
+
+	resMeta := &resModel.ResourceMeta{
+		ID:       "/s3/resource-1",
+		Job:      "job-1",
+		Worker:   "worker-1",
+		Executor: "executor-1",
+	}
+	err := gcHandler(ctx, resMeta)
+	require.NoError(t, err)
+
+	res1Dir := filepath.Join(bucketDir, internal.S3ResourceSubDir("job-1", "resource-1"))
+	res2Dir := filepath.Join(bucketDir, internal.S3ResourceSubDir("job-1", "resource-2"))
+	require.NoFileExists(t, filepath.Join(res1Dir, "1.txt"))
+	require.NoFileExists(t, filepath.Join(res1Dir, "2.txt"))
+	require.FileExists(t, filepath.Join(res2Dir, "1.txt"))
+	require.FileExists(t, filepath.Join(res2Dir, "2.txt"))
+
+	// GC is idempotent.
+	err = gcHandler(ctx, resMeta)
+	require.NoError(t, err)
+}
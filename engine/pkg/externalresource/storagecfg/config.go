@@ -13,12 +13,31 @@
 
 package storagecfg
 
+import (
+	brStorage "github.com/pingcap/tidb/br/pkg/storage"
+)
+
 // Config defines configurations for an external storage resource
 type Config struct {
 	Local LocalFileConfig `json:"local" toml:"local"`
+	S3    S3Config        `json:"s3" toml:"s3"`
 }
 
 // LocalFileConfig defines configurations for a local file based resource
 type LocalFileConfig struct {
 	BaseDir string `json:"base-dir" toml:"base-dir"`
 }
+
+// S3Config defines configurations for s3 based resources.
+// Any S3-compatible service (e.g. minio) can be used by setting
+// the endpoint in S3BackendOptions.
+type S3Config struct {
+	brStorage.S3BackendOptions
+	Bucket string `json:"bucket" toml:"bucket"`
+	Prefix string `json:"prefix" toml:"prefix"`
+}
+
+// S3Enabled returns true if s3 resources are configured.
+func (c *Config) S3Enabled() bool {
+	return c.S3.Bucket != ""
+}
@@ -42,6 +42,9 @@ var globalModels = []interface{}{
 	&execModel.Executor{},
 }
 
+// s3ResourcePattern matches the IDs of resources stored in s3.
+const s3ResourcePattern = "/" + string(resModel.ResourceTypeS3) + "/%"
+
 // TODO: retry and idempotent??
 // TODO: split different client to module
 
@@ -599,10 +602,11 @@ func (c *metaOpsClient) QueryResourcesByExecutorID(ctx context.Context, executor
 	return resources, nil
 }
 
-// DeleteResourcesByExecutorID delete all the resources of executorID
+// DeleteResourcesByExecutorID delete all the resources of executorID.
+// S3 resources are skipped because they are not bound to executors.
 func (c *metaOpsClient) DeleteResourcesByExecutorID(ctx context.Context, executorID engineModel.ExecutorID) (Result, error) {
 	result := c.db.WithContext(ctx).
-		Where("executor_id = ?", executorID).
+		Where("executor_id = ? AND id NOT LIKE ?", executorID, s3ResourcePattern).
 		Delete(&resModel.ResourceMeta{})
 	if result.Error == nil {
 		return &ormResult{rowsAffected: result.RowsAffected}, nil
@@ -611,10 +615,11 @@ func (c *metaOpsClient) DeleteResourcesByExecutorID(ctx context.Context, executo
 	return nil, errors.ErrMetaOpFail.Wrap(result.Error)
 }
 
-// DeleteResourcesByExecutorIDs delete all the resources of executorID
+// DeleteResourcesByExecutorIDs delete all the resources of executorIDs.
+// S3 resources are skipped because they are not bound to executors.
 func (c *metaOpsClient) DeleteResourcesByExecutorIDs(ctx context.Context, executorIDs []engineModel.ExecutorID) (Result, error) {
 	result := c.db.WithContext(ctx).
-		Where("executor_id in ?", executorIDs).
+		Where("executor_id in ? AND id NOT LIKE ?", executorIDs, s3ResourcePattern).
 		Delete(&resModel.ResourceMeta{})
 	if result.Error == nil {
 		return &ormResult{rowsAffected: result.RowsAffected}, nil
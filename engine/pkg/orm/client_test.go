@@ -1217,9 +1217,9 @@ func TestResource(t *testing.T) {
 			},
 			output: &ormResult{rowsAffected: 1},
 			mockExpectResFn: func(mock sqlmock.Sqlmock) {
-				expectedSQL := "DELETE FROM `resource_meta` WHERE executor_id"
+				expectedSQL := "DELETE FROM `resource_meta` WHERE executor_id = ? AND id NOT LIKE ?"
 				mock.ExpectExec(regexp.QuoteMeta(expectedSQL)).
-					WithArgs("executor-1").
+					WithArgs("executor-1", "/s3/%").
 					WillReturnResult(driver.RowsAffected(1))
 			},
 		},
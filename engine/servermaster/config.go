@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
 	metaModel "github.com/pingcap/tiflow/engine/pkg/meta/model"
 	"github.com/pingcap/tiflow/engine/servermaster/jobop"
 	"github.com/pingcap/tiflow/pkg/errors"
@@ -80,6 +81,10 @@ type Config struct {
 	Security *security.Credential `toml:"security" json:"security"`
 
 	JobBackoff *jobop.BackoffConfig `toml:"job-backoff" json:"job-backoff"`
+
+	// Storage is used to garbage collect external resources
+	// which are not bound to executors, e.g. s3 resources.
+	Storage storagecfg.Config `toml:"storage" json:"storage"`
 }
 
 func (c *Config) String() string {
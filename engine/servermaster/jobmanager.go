@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tiflow/engine/framework"
 	"github.com/pingcap/tiflow/engine/framework/metadata"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/jobmaster/cdc"
 	engineModel "github.com/pingcap/tiflow/engine/model"
 	pkgClient "github.com/pingcap/tiflow/engine/pkg/client"
 	"github.com/pingcap/tiflow/engine/pkg/clock"
@@ -266,6 +267,15 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 		meta.Type = frameModel.CvsJobMaster
 	case pb.Job_DM:
 		meta.Type = frameModel.DMJobMaster
+	case pb.Job_CDC:
+		extConfig := &cdc.Config{}
+		if err := json.Unmarshal(job.Config, extConfig); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decode config: %v", err)
+		}
+		if err := extConfig.Adjust(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid config: %v", err)
+		}
+		meta.Type = frameModel.CdcJobMaster
 	case pb.Job_FakeJob:
 		meta.Type = frameModel.FakeJobMaster
 	default:
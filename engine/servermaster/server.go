@@ -887,9 +887,13 @@ func (s *Server) runLeaderService(ctx context.Context) (err error) {
 		log.Info("job manager exited")
 	}()
 
-	s.gcRunner = externRescManager.NewGCRunner(s.frameMetaClient, map[resModel.ResourceType]externRescManager.GCHandlerFunc{
-		"local": resourcetypes.NewLocalFileResourceType(executorClients).GCHandler(),
-	})
+	gcHandlers := map[resModel.ResourceType]externRescManager.GCHandlerFunc{
+		resModel.ResourceTypeLocalFile: resourcetypes.NewLocalFileResourceType(executorClients).GCHandler(),
+	}
+	if s.cfg.Storage.S3Enabled() {
+		gcHandlers[resModel.ResourceTypeS3] = resourcetypes.NewS3ResourceType(s.cfg.Storage.S3).GCHandler()
+	}
+	s.gcRunner = externRescManager.NewGCRunner(s.frameMetaClient, gcHandlers)
 	s.gcCoordinator = externRescManager.NewGCCoordinator(s.executorManager, s.jobManager, s.frameMetaClient, s.gcRunner)
 
 	// TODO refactor this method to make it more readable and maintainable.
@@ -66,6 +66,11 @@ type CDCRESTClient struct {
 
 	// Client is a wrapped http client.
 	Client *httputil.Client
+
+	// username and password are set to the requests for the basic
+	// authentication if username is not empty.
+	username string
+	password string
 }
 
 // NewCDCRESTClient creates a new CDCRESTClient.
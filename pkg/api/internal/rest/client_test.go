@@ -48,6 +48,32 @@ func TestRestRequestSuccess(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRestRequestBasicAuth(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "root" || password != "secret" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	c, err := restClient(testServer)
+	require.Nil(t, err)
+	require.NotNil(t, c.Get().WithPrefix("test").Do(context.Background()).Error())
+
+	c, err = CDCRESTClientFromConfig(&Config{
+		Host:     testServer.URL,
+		APIPath:  "/api",
+		Version:  "v1",
+		Username: "root",
+		Password: "secret",
+	})
+	require.Nil(t, err)
+	require.Nil(t, c.Get().WithPrefix("test").Do(context.Background()).Error())
+}
+
 func TestRestRequestFailed(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		rw.WriteHeader(http.StatusNotFound)
@@ -33,6 +33,10 @@ type Config struct {
 	Credential *security.Credential
 	// API verion
 	Version string
+	// Username and Password are used for the basic authentication,
+	// no authentication is used if Username is empty.
+	Username string
+	Password string
 }
 
 // defaultServerURLFromConfig is used to build base URL and api path.
@@ -83,6 +87,8 @@ func CDCRESTClientFromConfig(config *Config) (*CDCRESTClient, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	restClient.username = config.Username
+	restClient.password = config.Password
 
 	return restClient, nil
 }
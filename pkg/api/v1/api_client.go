@@ -65,13 +65,18 @@ func (c *APIV1Client) Status() StatusInterface {
 	return newStatus(c)
 }
 
-// NewAPIClient creates a new APIV1Client.
-func NewAPIClient(ownerAddr string, credential *security.Credential) (*APIV1Client, error) {
+// NewAPIClient creates a new APIV1Client, the user and password are used for
+// the basic authentication if the user is not empty.
+func NewAPIClient(ownerAddr string, credential *security.Credential,
+	user, password string,
+) (*APIV1Client, error) {
 	c := &rest.Config{}
 	c.APIPath = "/api"
 	c.Version = "v1"
 	c.Host = ownerAddr
 	c.Credential = credential
+	c.Username = user
+	c.Password = password
 	client, err := rest.CDCRESTClientFromConfig(c)
 	if err != nil {
 		return nil, err
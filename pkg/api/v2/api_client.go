@@ -24,6 +24,9 @@ import (
 type APIV2Interface interface {
 	RESTClient() rest.CDCRESTInterface
 	ChangefeedsGetter
+	CapturesGetter
+	ProcessorsGetter
+	OwnerGetter
 	TsoGetter
 	UnsafeGetter
 }
@@ -63,16 +66,54 @@ func (c *APIV2Client) Changefeeds() ChangefeedInterface {
 	if c == nil {
 		return nil
 	}
-	return newChangefeeds(c)
+	return newChangefeeds(c, "")
 }
 
-// NewAPIClient creates a new APIV1Client.
-func NewAPIClient(serverAddr string, credential *security.Credential) (*APIV2Client, error) {
+// NamespacedChangefeeds returns a ChangefeedInterface with cdc api
+// for changefeeds in the given namespace
+func (c *APIV2Client) NamespacedChangefeeds(namespace string) ChangefeedInterface {
+	if c == nil {
+		return nil
+	}
+	return newChangefeeds(c, namespace)
+}
+
+// Captures returns a CaptureInterface with cdc api
+func (c *APIV2Client) Captures() CaptureInterface {
+	if c == nil {
+		return nil
+	}
+	return newCaptures(c)
+}
+
+// Processors returns a ProcessorInterface with cdc api
+func (c *APIV2Client) Processors() ProcessorInterface {
+	if c == nil {
+		return nil
+	}
+	return newProcessors(c)
+}
+
+// Owner returns an OwnerInterface with cdc api
+func (c *APIV2Client) Owner() OwnerInterface {
+	if c == nil {
+		return nil
+	}
+	return newOwner(c)
+}
+
+// NewAPIClient creates a new APIV2Client, the user and password are used for
+// the basic authentication if the user is not empty.
+func NewAPIClient(serverAddr string, credential *security.Credential,
+	user, password string,
+) (*APIV2Client, error) {
 	c := &rest.Config{}
 	c.APIPath = "/api"
 	c.Version = "v2"
 	c.Host = serverAddr
 	c.Credential = credential
+	c.Username = user
+	c.Password = password
 	client, err := rest.CDCRESTClientFromConfig(c)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -0,0 +1,96 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClientCoversAllRoutes makes sure every route of the v2 OpenAPI can be
+// requested by the client, a route added without a client method fails it.
+func TestClientCoversAllRoutes(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("null"))
+	}))
+	defer server.Close()
+
+	client, err := NewAPIClient(strings.TrimPrefix(server.URL, "http://"), nil, "", "")
+	require.Nil(t, err)
+
+	ctx := context.Background()
+	for _, cfs := range []ChangefeedInterface{
+		client.Changefeeds(), client.NamespacedChangefeeds("ns"),
+	} {
+		_, _ = cfs.Create(ctx, &v2.ChangefeedConfig{})
+		_, _ = cfs.GetInfo(ctx, "cf")
+		_, _ = cfs.Update(ctx, &v2.ChangefeedConfig{}, "cf")
+		_ = cfs.Resume(ctx, &v2.ResumeChangefeedConfig{}, "cf")
+		_, _ = cfs.Verify(ctx, &v2.VerifyChangefeedConfig{}, "cf")
+		_, _ = cfs.GetVerification(ctx, "cf")
+		_, _ = cfs.HandleDDLError(ctx, &v2.DDLErrorHandler{}, "cf")
+		_, _ = cfs.List(ctx, "")
+		_, _ = cfs.Get(ctx, "cf")
+		_ = cfs.Pause(ctx, "cf")
+		_ = cfs.Delete(ctx, "cf")
+		_ = cfs.MoveTable(ctx, "cf", &v2.MoveTableReq{})
+		_ = cfs.RebalanceTables(ctx, "cf")
+	}
+	_, _ = client.Changefeeds().VerifyTable(ctx, &v2.VerifyTableConfig{})
+	for _, namespace := range []string{"", "ns"} {
+		_, _ = client.Processors().List(ctx, namespace)
+		_, _ = client.Processors().Get(ctx, namespace, "cf", "capture")
+	}
+	_, _ = client.Captures().List(ctx)
+	_, _ = client.Captures().Drain(ctx, "capture")
+	_ = client.Owner().Resign(ctx)
+	_, _ = client.Tso().Query(ctx, &v2.UpstreamConfig{})
+	_, _ = client.Unsafe().Metadata(ctx)
+	_ = client.Unsafe().ResolveLock(ctx, &v2.ResolveLockReq{})
+	_ = client.Unsafe().DeleteServiceGcSafePoint(ctx, &v2.UpstreamConfig{})
+
+	router := gin.New()
+	v2.RegisterOpenAPIV2Routes(router, v2.NewOpenAPIV2ForTest(nil, nil))
+	param := regexp.MustCompile(`:[^/]+`)
+	for _, route := range router.Routes() {
+		pattern := regexp.MustCompile("^" + route.Method + " " +
+			param.ReplaceAllString(route.Path, "[^/]+") + "$")
+		covered := false
+		for _, r := range requests {
+			if pattern.MatchString(r) {
+				covered = true
+				break
+			}
+		}
+		require.True(t, covered, "no client method requests %s %s", route.Method, route.Path)
+	}
+}
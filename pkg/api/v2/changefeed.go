@@ -21,9 +21,14 @@ import (
 	"github.com/pingcap/tiflow/pkg/api/internal/rest"
 )
 
-// ChangefeedsGetter has a method to return a ChangefeedInterface.
+// ChangefeedsGetter has methods to return a ChangefeedInterface.
 type ChangefeedsGetter interface {
+	// Changefeeds returns a ChangefeedInterface for changefeeds
+	// in the default namespace.
 	Changefeeds() ChangefeedInterface
+	// NamespacedChangefeeds returns a ChangefeedInterface for changefeeds
+	// in the given namespace.
+	NamespacedChangefeeds(namespace string) ChangefeedInterface
 }
 
 // ChangefeedInterface has methods to work with Changefeed items.
@@ -40,26 +45,61 @@ type ChangefeedInterface interface {
 		name string) (*v2.ChangeFeedInfo, error)
 	// Resume resumes a changefeed with given config
 	Resume(ctx context.Context, cfg *v2.ResumeChangefeedConfig, name string) error
+	// Verify verifies a changefeed at its latest syncpoint
+	Verify(ctx context.Context, cfg *v2.VerifyChangefeedConfig,
+		name string) (*v2.ChangefeedVerification, error)
+	// GetVerification gets the latest verification result of a changefeed
+	GetVerification(ctx context.Context, name string) (*v2.ChangefeedVerification, error)
+	// HandleDDLError sets the handler of the failed DDLs of a changefeed
+	HandleDDLError(ctx context.Context, handler *v2.DDLErrorHandler,
+		name string) ([]*v2.DDLErrorHandler, error)
+	// List lists changefeeds in the given state
+	List(ctx context.Context, state string) ([]v2.ChangefeedCommonInfo, error)
+	// Get gets the detail of a changefeed
+	Get(ctx context.Context, name string) (*v2.ChangefeedDetail, error)
+	// Pause pauses a changefeed
+	Pause(ctx context.Context, name string) error
+	// Delete removes a changefeed
+	Delete(ctx context.Context, name string) error
+	// MoveTable moves a table of a changefeed to the target capture
+	MoveTable(ctx context.Context, name string, req *v2.MoveTableReq) error
+	// RebalanceTables rebalances tables of a changefeed among captures
+	RebalanceTables(ctx context.Context, name string) error
 }
 
 // changefeeds implements ChangefeedInterface
 type changefeeds struct {
 	client rest.CDCRESTInterface
+	// namespace is empty for changefeeds in the default namespace
+	namespace string
 }
 
 // newChangefeed returns changefeeds
-func newChangefeeds(c *APIV2Client) *changefeeds {
+func newChangefeeds(c *APIV2Client, namespace string) *changefeeds {
 	return &changefeeds{
-		client: c.RESTClient(),
+		client:    c.RESTClient(),
+		namespace: namespace,
 	}
 }
 
+// uri returns the uri of changefeeds, the elems are appended to it
+func (c *changefeeds) uri(elems ...string) string {
+	u := "changefeeds"
+	if c.namespace != "" {
+		u = fmt.Sprintf("namespaces/%s/changefeeds", c.namespace)
+	}
+	for _, elem := range elems {
+		u += "/" + elem
+	}
+	return u
+}
+
 func (c *changefeeds) Create(ctx context.Context,
 	cfg *v2.ChangefeedConfig,
 ) (*v2.ChangeFeedInfo, error) {
 	result := &v2.ChangeFeedInfo{}
 	err := c.client.Post().
-		WithURI("changefeeds").
+		WithURI(c.uri()).
 		WithBody(cfg).
 		Do(ctx).Into(result)
 	return result, err
@@ -81,7 +121,7 @@ func (c *changefeeds) GetInfo(ctx context.Context,
 	name string,
 ) (*v2.ChangeFeedInfo, error) {
 	result := &v2.ChangeFeedInfo{}
-	u := fmt.Sprintf("changefeeds/%s/meta_info", name)
+	u := c.uri(name, "meta_info")
 	err := c.client.Get().
 		WithURI(u).
 		Do(ctx).
@@ -93,7 +133,7 @@ func (c *changefeeds) Update(ctx context.Context,
 	cfg *v2.ChangefeedConfig, name string,
 ) (*v2.ChangeFeedInfo, error) {
 	result := &v2.ChangeFeedInfo{}
-	u := fmt.Sprintf("changefeeds/%s", name)
+	u := c.uri(name)
 	err := c.client.Put().
 		WithURI(u).
 		WithBody(cfg).
@@ -106,9 +146,105 @@ func (c *changefeeds) Update(ctx context.Context,
 func (c *changefeeds) Resume(ctx context.Context,
 	cfg *v2.ResumeChangefeedConfig, name string,
 ) error {
-	u := fmt.Sprintf("changefeeds/%s/resume", name)
+	u := c.uri(name, "resume")
 	return c.client.Post().
 		WithURI(u).
 		WithBody(cfg).
 		Do(ctx).Error()
 }
+
+// Verify a changefeed
+func (c *changefeeds) Verify(ctx context.Context,
+	cfg *v2.VerifyChangefeedConfig, name string,
+) (*v2.ChangefeedVerification, error) {
+	result := &v2.ChangefeedVerification{}
+	u := c.uri(name, "verify")
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *changefeeds) GetVerification(ctx context.Context,
+	name string,
+) (*v2.ChangefeedVerification, error) {
+	result := &v2.ChangefeedVerification{}
+	u := c.uri(name, "verification")
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// HandleDDLError sets the handler of the failed DDLs of a changefeed
+func (c *changefeeds) HandleDDLError(ctx context.Context,
+	handler *v2.DDLErrorHandler, name string,
+) ([]*v2.DDLErrorHandler, error) {
+	var result []*v2.DDLErrorHandler
+	u := c.uri(name, "ddl_error_handler")
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(handler).
+		Do(ctx).
+		Into(&result)
+	return result, err
+}
+
+// List returns the list of changefeeds
+func (c *changefeeds) List(ctx context.Context,
+	state string,
+) ([]v2.ChangefeedCommonInfo, error) {
+	var result []v2.ChangefeedCommonInfo
+	err := c.client.Get().
+		WithURI(c.uri()).
+		WithParam("state", state).
+		Do(ctx).
+		Into(&result)
+	return result, err
+}
+
+// Get returns the detail of a changefeed
+func (c *changefeeds) Get(ctx context.Context,
+	name string,
+) (*v2.ChangefeedDetail, error) {
+	result := &v2.ChangefeedDetail{}
+	err := c.client.Get().
+		WithURI(c.uri(name)).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Pause the changefeed
+func (c *changefeeds) Pause(ctx context.Context, name string) error {
+	return c.client.Post().
+		WithURI(c.uri(name, "pause")).
+		Do(ctx).Error()
+}
+
+// Delete the changefeed
+func (c *changefeeds) Delete(ctx context.Context, name string) error {
+	return c.client.Delete().
+		WithURI(c.uri(name)).
+		Do(ctx).Error()
+}
+
+// MoveTable moves a table of the changefeed to the target capture
+func (c *changefeeds) MoveTable(ctx context.Context,
+	name string, req *v2.MoveTableReq,
+) error {
+	return c.client.Post().
+		WithURI(c.uri(name, "tables", "move_table")).
+		WithBody(req).
+		Do(ctx).Error()
+}
+
+// RebalanceTables rebalances tables of the changefeed among captures
+func (c *changefeeds) RebalanceTables(ctx context.Context, name string) error {
+	return c.client.Post().
+		WithURI(c.uri(name, "tables", "rebalance_table")).
+		Do(ctx).Error()
+}
@@ -50,6 +50,20 @@ func (mr *MockChangefeedsGetterMockRecorder) Changefeeds() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Changefeeds", reflect.TypeOf((*MockChangefeedsGetter)(nil).Changefeeds))
 }
 
+// NamespacedChangefeeds mocks base method.
+func (m *MockChangefeedsGetter) NamespacedChangefeeds(namespace string) v20.ChangefeedInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespacedChangefeeds", namespace)
+	ret0, _ := ret[0].(v20.ChangefeedInterface)
+	return ret0
+}
+
+// NamespacedChangefeeds indicates an expected call of NamespacedChangefeeds.
+func (mr *MockChangefeedsGetterMockRecorder) NamespacedChangefeeds(namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespacedChangefeeds", reflect.TypeOf((*MockChangefeedsGetter)(nil).NamespacedChangefeeds), namespace)
+}
+
 // MockChangefeedInterface is a mock of ChangefeedInterface interface.
 type MockChangefeedInterface struct {
 	ctrl     *gomock.Controller
@@ -88,6 +102,35 @@ func (mr *MockChangefeedInterfaceMockRecorder) Create(ctx, cfg interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChangefeedInterface)(nil).Create), ctx, cfg)
 }
 
+// Delete mocks base method.
+func (m *MockChangefeedInterface) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChangefeedInterfaceMockRecorder) Delete(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChangefeedInterface)(nil).Delete), ctx, name)
+}
+
+// Get mocks base method.
+func (m *MockChangefeedInterface) Get(ctx context.Context, name string) (*v2.ChangefeedDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(*v2.ChangefeedDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockChangefeedInterfaceMockRecorder) Get(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChangefeedInterface)(nil).Get), ctx, name)
+}
+
 // GetInfo mocks base method.
 func (m *MockChangefeedInterface) GetInfo(ctx context.Context, name string) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +146,93 @@ func (mr *MockChangefeedInterfaceMockRecorder) GetInfo(ctx, name interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInfo", reflect.TypeOf((*MockChangefeedInterface)(nil).GetInfo), ctx, name)
 }
 
+// GetVerification mocks base method.
+func (m *MockChangefeedInterface) GetVerification(ctx context.Context, name string) (*v2.ChangefeedVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerification", ctx, name)
+	ret0, _ := ret[0].(*v2.ChangefeedVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerification indicates an expected call of GetVerification.
+func (mr *MockChangefeedInterfaceMockRecorder) GetVerification(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerification", reflect.TypeOf((*MockChangefeedInterface)(nil).GetVerification), ctx, name)
+}
+
+// HandleDDLError mocks base method.
+func (m *MockChangefeedInterface) HandleDDLError(ctx context.Context, handler *v2.DDLErrorHandler, name string) ([]*v2.DDLErrorHandler, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleDDLError", ctx, handler, name)
+	ret0, _ := ret[0].([]*v2.DDLErrorHandler)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleDDLError indicates an expected call of HandleDDLError.
+func (mr *MockChangefeedInterfaceMockRecorder) HandleDDLError(ctx, handler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDDLError", reflect.TypeOf((*MockChangefeedInterface)(nil).HandleDDLError), ctx, handler, name)
+}
+
+// List mocks base method.
+func (m *MockChangefeedInterface) List(ctx context.Context, state string) ([]v2.ChangefeedCommonInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, state)
+	ret0, _ := ret[0].([]v2.ChangefeedCommonInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockChangefeedInterfaceMockRecorder) List(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChangefeedInterface)(nil).List), ctx, state)
+}
+
+// MoveTable mocks base method.
+func (m *MockChangefeedInterface) MoveTable(ctx context.Context, name string, req *v2.MoveTableReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTable", ctx, name, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTable indicates an expected call of MoveTable.
+func (mr *MockChangefeedInterfaceMockRecorder) MoveTable(ctx, name, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTable", reflect.TypeOf((*MockChangefeedInterface)(nil).MoveTable), ctx, name, req)
+}
+
+// Pause mocks base method.
+func (m *MockChangefeedInterface) Pause(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockChangefeedInterfaceMockRecorder) Pause(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockChangefeedInterface)(nil).Pause), ctx, name)
+}
+
+// RebalanceTables mocks base method.
+func (m *MockChangefeedInterface) RebalanceTables(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceTables", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebalanceTables indicates an expected call of RebalanceTables.
+func (mr *MockChangefeedInterfaceMockRecorder) RebalanceTables(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceTables", reflect.TypeOf((*MockChangefeedInterface)(nil).RebalanceTables), ctx, name)
+}
+
 // Resume mocks base method.
 func (m *MockChangefeedInterface) Resume(ctx context.Context, cfg *v2.ResumeChangefeedConfig, name string) error {
 	m.ctrl.T.Helper()
@@ -132,6 +262,21 @@ func (mr *MockChangefeedInterfaceMockRecorder) Update(ctx, cfg, name interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChangefeedInterface)(nil).Update), ctx, cfg, name)
 }
 
+// Verify mocks base method.
+func (m *MockChangefeedInterface) Verify(ctx context.Context, cfg *v2.VerifyChangefeedConfig, name string) (*v2.ChangefeedVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, cfg, name)
+	ret0, _ := ret[0].(*v2.ChangefeedVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockChangefeedInterfaceMockRecorder) Verify(ctx, cfg, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockChangefeedInterface)(nil).Verify), ctx, cfg, name)
+}
+
 // VerifyTable mocks base method.
 func (m *MockChangefeedInterface) VerifyTable(ctx context.Context, cfg *v2.VerifyTableConfig) (*v2.Tables, error) {
 	m.ctrl.T.Helper()
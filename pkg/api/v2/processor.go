@@ -0,0 +1,83 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/internal/rest"
+)
+
+// ProcessorsGetter has a method to return a ProcessorInterface.
+type ProcessorsGetter interface {
+	Processors() ProcessorInterface
+}
+
+// ProcessorInterface has methods to work with Processor items.
+// We can also mock the processor operations by implement this interface.
+type ProcessorInterface interface {
+	// List lists the processors in the namespace, the processors in all the
+	// namespaces are listed if namespace is empty.
+	List(ctx context.Context, namespace string) ([]v2.ProcessorCommonInfo, error)
+	// Get gets the detail of the processor of a changefeed on a capture,
+	// the default namespace is used if namespace is empty.
+	Get(ctx context.Context, namespace, changefeedID,
+		captureID string) (*v2.ProcessorDetail, error)
+}
+
+// processors implements ProcessorInterface
+type processors struct {
+	client rest.CDCRESTInterface
+}
+
+// newProcessors returns processors
+func newProcessors(c *APIV2Client) *processors {
+	return &processors{
+		client: c.RESTClient(),
+	}
+}
+
+// List returns the list of processors
+func (c *processors) List(ctx context.Context,
+	namespace string,
+) ([]v2.ProcessorCommonInfo, error) {
+	var result []v2.ProcessorCommonInfo
+	u := "processors"
+	if namespace != "" {
+		u = fmt.Sprintf("namespaces/%s/%s", namespace, u)
+	}
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(&result)
+	return result, err
+}
+
+// Get returns the detail of a processor
+func (c *processors) Get(ctx context.Context,
+	namespace, changefeedID, captureID string,
+) (*v2.ProcessorDetail, error) {
+	result := &v2.ProcessorDetail{}
+	u := fmt.Sprintf("processors/%s/%s", changefeedID, captureID)
+	if namespace != "" {
+		u = fmt.Sprintf("namespaces/%s/%s", namespace, u)
+	}
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
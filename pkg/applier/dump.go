@@ -0,0 +1,205 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"unicode/utf8"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/redo"
+	"go.uber.org/zap"
+)
+
+const (
+	// DumpEventTypeAll dumps both row and DDL events.
+	DumpEventTypeAll = "all"
+	// DumpEventTypeRow dumps row events only.
+	DumpEventTypeRow = "row"
+	// DumpEventTypeDDL dumps DDL events only.
+	DumpEventTypeDDL = "ddl"
+
+	dumpBatch = 1024
+)
+
+// RedoDumpOptions specifies which events are dumped.
+type RedoDumpOptions struct {
+	// StartTs and EndTs limit the range (StartTs, EndTs] of the dumped events.
+	// The checkpoint ts and resolved ts in the redo meta are used if they're zero.
+	StartTs uint64
+	EndTs   uint64
+	// EventType is one of DumpEventTypeAll, DumpEventTypeRow and DumpEventTypeDDL.
+	EventType string
+}
+
+// dumpedEvent is the JSON representation of a redo log event.
+type dumpedEvent struct {
+	// Type is insert, update, delete or ddl.
+	Type       string          `json:"type"`
+	StartTs    uint64          `json:"start-ts"`
+	CommitTs   uint64          `json:"commit-ts"`
+	Schema     string          `json:"schema,omitempty"`
+	Table      string          `json:"table,omitempty"`
+	TableID    int64           `json:"table-id,omitempty"`
+	Columns    []*model.Column `json:"columns,omitempty"`
+	PreColumns []*model.Column `json:"pre-columns,omitempty"`
+	Query      string          `json:"query,omitempty"`
+}
+
+func newDumpedRow(row *model.RowChangedEvent) *dumpedEvent {
+	e := &dumpedEvent{
+		Type:       "update",
+		StartTs:    row.StartTs,
+		CommitTs:   row.CommitTs,
+		Columns:    readableColumns(row.Columns),
+		PreColumns: readableColumns(row.PreColumns),
+	}
+	if row.IsInsert() {
+		e.Type = "insert"
+	} else if row.IsDelete() {
+		e.Type = "delete"
+	}
+	if row.Table != nil {
+		e.Schema = row.Table.Schema
+		e.Table = row.Table.Table
+		e.TableID = row.Table.TableID
+	}
+	return e
+}
+
+func newDumpedDDL(ddl *model.DDLEvent) *dumpedEvent {
+	e := &dumpedEvent{
+		Type:     "ddl",
+		StartTs:  ddl.StartTs,
+		CommitTs: ddl.CommitTs,
+		Query:    ddl.Query,
+	}
+	if ddl.TableInfo != nil {
+		e.Schema = ddl.TableInfo.Schema
+		e.Table = ddl.TableInfo.Table
+		e.TableID = ddl.TableInfo.TableID
+	}
+	return e
+}
+
+// readableColumns converts text values, which are stored as bytes in
+// redo logs, to strings, otherwise they are encoded in base64.
+func readableColumns(cols []*model.Column) []*model.Column {
+	if len(cols) == 0 {
+		return nil
+	}
+	res := make([]*model.Column, 0, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			res = append(res, nil)
+			continue
+		}
+		c := *col
+		if v, ok := c.Value.([]byte); ok && utf8.Valid(v) {
+			c.Value = string(v)
+		}
+		res = append(res, &c)
+	}
+	return res
+}
+
+// Dump writes redo log events to w in the order of commit ts, one JSON
+// object per line.
+func (ra *RedoApplier) Dump(ctx context.Context, opts *RedoDumpOptions, w io.Writer) error {
+	var dumpRows, dumpDDLs bool
+	switch opts.EventType {
+	case "", DumpEventTypeAll:
+		dumpRows, dumpDDLs = true, true
+	case DumpEventTypeRow:
+		dumpRows = true
+	case DumpEventTypeDDL:
+		dumpDDLs = true
+	default:
+		return errors.Errorf("unknown event type %s, it should be one of %s, %s and %s",
+			opts.EventType, DumpEventTypeAll, DumpEventTypeRow, DumpEventTypeDDL)
+	}
+
+	rd, err := createRedoReader(ctx, ra.cfg)
+	if err != nil {
+		return err
+	}
+	defer rd.Close() //nolint:errcheck
+
+	checkpointTs, resolvedTs, err := rd.ReadMeta(ctx)
+	if err != nil {
+		return err
+	}
+	startTs, endTs := opts.StartTs, opts.EndTs
+	if startTs == 0 {
+		startTs = checkpointTs
+	}
+	if endTs == 0 {
+		endTs = resolvedTs
+	}
+	if startTs == endTs {
+		return nil
+	}
+	if err := rd.ResetReader(ctx, startTs, endTs); err != nil {
+		return err
+	}
+	log.Info("dump redo log starts",
+		zap.Uint64("startTs", startTs), zap.Uint64("endTs", endTs),
+		zap.String("eventType", opts.EventType))
+
+	var (
+		rows    []*model.RedoRowChangedEvent
+		ddls    []*model.RedoDDLEvent
+		rowsEOF = !dumpRows
+		ddlsEOF = !dumpDDLs
+	)
+	encoder := json.NewEncoder(w)
+	for {
+		if len(rows) == 0 && !rowsEOF {
+			if rows, err = rd.ReadNextLog(ctx, dumpBatch); err != nil {
+				return err
+			}
+			rowsEOF = len(rows) == 0
+		}
+		if len(ddls) == 0 && !ddlsEOF {
+			if ddls, err = rd.ReadNextDDL(ctx, dumpBatch); err != nil {
+				return err
+			}
+			ddlsEOF = len(ddls) == 0
+		}
+		if len(rows) == 0 && len(ddls) == 0 {
+			return nil
+		}
+
+		// A DDL is dumped before the rows with the same commit ts,
+		// because they must be written after the DDL.
+		var event *dumpedEvent
+		if len(ddls) > 0 && (len(rows) == 0 || ddls[0].DDL.CommitTs <= rows[0].Row.CommitTs) {
+			event = newDumpedDDL(redo.LogToDDL(ddls[0]))
+			ddls = ddls[1:]
+		} else {
+			event = newDumpedRow(redo.LogToRow(rows[0]))
+			rows = rows[1:]
+		}
+		if event.CommitTs <= startTs || event.CommitTs > endTs {
+			continue
+		}
+		if err := encoder.Encode(event); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/redo"
+	"github.com/pingcap/tiflow/cdc/redo/reader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDump(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dmls := []*model.RowChangedEvent{
+		{
+			StartTs:  1100,
+			CommitTs: 1200,
+			Table:    &model.TableName{Schema: "test", Table: "t1", TableID: 1},
+			Columns: []*model.Column{
+				{Name: "a", Value: 1, Flag: model.HandleKeyFlag},
+				{Name: "b", Value: []byte("2")},
+			},
+		},
+		{
+			StartTs:  1200,
+			CommitTs: 1300,
+			Table:    &model.TableName{Schema: "test", Table: "t1", TableID: 1},
+			PreColumns: []*model.Column{
+				{Name: "a", Value: 1, Flag: model.HandleKeyFlag},
+				{Name: "b", Value: []byte("2")},
+			},
+		},
+		{
+			StartTs:  1400,
+			CommitTs: 2100,
+			Table:    &model.TableName{Schema: "test", Table: "t1", TableID: 1},
+			Columns: []*model.Column{
+				{Name: "a", Value: 2, Flag: model.HandleKeyFlag},
+			},
+		},
+	}
+	ddls := []*model.DDLEvent{
+		{
+			StartTs:  1250,
+			CommitTs: 1300,
+			TableInfo: &model.SimpleTableInfo{
+				Schema: "test", Table: "t2", TableID: 2,
+			},
+			Query: "create table test.t2(a int primary key)",
+			Type:  3,
+		},
+	}
+
+	newReader := func() (reader.RedoLogReader, error) {
+		redoLogCh := make(chan *model.RedoRowChangedEvent, len(dmls))
+		for _, dml := range dmls {
+			redoLogCh <- redo.RowToRedo(dml)
+		}
+		close(redoLogCh)
+		ddlEventCh := make(chan *model.RedoDDLEvent, len(ddls))
+		for _, ddl := range ddls {
+			ddlEventCh <- redo.DDLToRedo(ddl)
+		}
+		close(ddlEventCh)
+		return NewMockReader(1000, 2000, redoLogCh, ddlEventCh), nil
+	}
+	createRedoReaderBak := createRedoReader
+	createRedoReader = func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return newReader()
+	}
+	defer func() {
+		createRedoReader = createRedoReaderBak
+	}()
+
+	dump := func(opts *RedoDumpOptions) []*dumpedEvent {
+		var buf bytes.Buffer
+		ap := NewRedoApplier(&RedoApplierConfig{})
+		require.Nil(t, ap.Dump(ctx, opts, &buf))
+		var events []*dumpedEvent
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			event := &dumpedEvent{}
+			require.Nil(t, json.Unmarshal([]byte(line), event))
+			events = append(events, event)
+		}
+		return events
+	}
+
+	// The event committed after resolved ts is not dumped, and the DDL
+	// is dumped before the row with the same commit ts.
+	events := dump(&RedoDumpOptions{})
+	require.Len(t, events, 3)
+	require.Equal(t, "insert", events[0].Type)
+	require.Equal(t, uint64(1200), events[0].CommitTs)
+	require.Equal(t, "test", events[0].Schema)
+	require.Equal(t, "t1", events[0].Table)
+	require.Equal(t, int64(1), events[0].TableID)
+	require.Len(t, events[0].Columns, 2)
+	require.Equal(t, "2", events[0].Columns[1].Value)
+	require.Equal(t, "ddl", events[1].Type)
+	require.Equal(t, "create table test.t2(a int primary key)", events[1].Query)
+	require.Equal(t, "t2", events[1].Table)
+	require.Equal(t, "delete", events[2].Type)
+	require.Equal(t, uint64(1300), events[2].CommitTs)
+	require.Len(t, events[2].PreColumns, 2)
+
+	events = dump(&RedoDumpOptions{StartTs: 1200, EndTs: 2000, EventType: DumpEventTypeRow})
+	require.Len(t, events, 1)
+	require.Equal(t, "delete", events[0].Type)
+
+	events = dump(&RedoDumpOptions{EventType: DumpEventTypeDDL})
+	require.Len(t, events, 1)
+	require.Equal(t, "ddl", events[0].Type)
+
+	ap := NewRedoApplier(&RedoApplierConfig{})
+	err := ap.Dump(ctx, &RedoDumpOptions{EventType: "unknown"}, &bytes.Buffer{})
+	require.Regexp(t, "unknown event type", err)
+}
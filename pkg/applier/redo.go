@@ -16,12 +16,14 @@ package applier
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/redo"
+	"github.com/pingcap/tiflow/cdc/redo/common"
 	"github.com/pingcap/tiflow/cdc/redo/reader"
 	"github.com/pingcap/tiflow/cdc/sink"
 	"github.com/pingcap/tiflow/cdc/sink/mysql"
@@ -36,6 +38,9 @@ const (
 	applierChangefeed = "redo-applier"
 	emitBatch         = mysql.DefaultMaxTxnRow
 	readBatch         = mysql.DefaultWorkerCount * emitBatch
+	// waitFlushInterval is the interval to check whether all events
+	// have been flushed to the sink.
+	waitFlushInterval = 100 * time.Millisecond
 )
 
 var errApplyFinished = errors.New("apply finished, can exit safely")
@@ -45,6 +50,12 @@ type RedoApplierConfig struct {
 	SinkURI string
 	Storage string
 	Dir     string
+	// EncryptionKeyFile is the path of the file which contains the key to
+	// decrypt encrypted redo logs.
+	EncryptionKeyFile string
+	// ReplicaConfig is used to create the sink, such as the protocol and
+	// dispatch rules of MQ sinks. The default one is used if it's nil.
+	ReplicaConfig *config.ReplicaConfig
 }
 
 // RedoApplier implements a redo log applier
@@ -73,6 +84,12 @@ func (rac *RedoApplierConfig) toLogReaderConfig() (string, *reader.LogReaderConf
 		Dir:       uri.Path,
 		S3Storage: redo.IsS3StorageEnabled(uri.Scheme),
 	}
+	if rac.EncryptionKeyFile != "" {
+		cfg.EncryptionKey, err = common.LoadEncryptionKey(rac.EncryptionKeyFile)
+		if err != nil {
+			return "", nil, err
+		}
+	}
 	if cfg.S3Storage {
 		cfg.S3URI = *uri
 		// If use s3 as backend, applier will download redo logs to local dir.
@@ -109,11 +126,15 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	}
 	log.Info("apply redo log starts", zap.Uint64("checkpointTs", checkpointTs), zap.Uint64("resolvedTs", resolvedTs))
 
-	// MySQL sink will use the following replication config
+	// If the replica config is not specified, the sink will use the
+	// following replication config
 	// - EnableOldValue: default true
 	// - ForceReplicate: default false
 	// - filter: default []string{"*.*"}
 	replicaConfig := config.GetDefaultReplicaConfig()
+	if ra.cfg.ReplicaConfig != nil {
+		replicaConfig = ra.cfg.ReplicaConfig.Clone()
+	}
 	ctx = contextutil.PutRoleInCtx(ctx, util.RoleRedoLogApplier)
 	s, err := sink.New(ctx,
 		model.DefaultChangeFeedID(applierChangefeed),
@@ -148,6 +169,9 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 		for _, redoLog := range redoLogs {
 			tableID := redoLog.Row.Table.TableID
 			if _, ok := tableResolvedTsMap[redoLog.Row.Table.TableID]; !ok {
+				if err := s.AddTable(tableID); err != nil {
+					return err
+				}
 				tableResolvedTsMap[tableID] = lastSafeResolvedTs
 			}
 			if len(cachedRows) >= emitBatch {
@@ -177,7 +201,7 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	}
 
 	for tableID := range tableResolvedTsMap {
-		_, err = s.FlushRowChangedEvents(ctx, tableID, model.NewResolvedTs(resolvedTs))
+		err = waitTableFlushed(ctx, s, tableID, resolvedTs)
 		if err != nil {
 			return err
 		}
@@ -189,6 +213,30 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	return errApplyFinished
 }
 
+// waitTableFlushed waits until all events of the table are flushed.
+// Some sinks, such as MQ sinks, flush events asynchronously, so events
+// could be lost if the sink is closed directly.
+func waitTableFlushed(
+	ctx context.Context, s sink.Sink, tableID model.TableID, resolvedTs model.Ts,
+) error {
+	ticker := time.NewTicker(waitFlushInterval)
+	defer ticker.Stop()
+	for {
+		checkpoint, err := s.FlushRowChangedEvents(ctx, tableID, model.NewResolvedTs(resolvedTs))
+		if err != nil {
+			return err
+		}
+		if checkpoint.Ts >= resolvedTs {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 var createRedoReader = createRedoReaderImpl
 
 func createRedoReaderImpl(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/redo"
 	"github.com/pingcap/tiflow/cdc/redo/reader"
 	"github.com/pingcap/tiflow/cdc/sink/mysql"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
 )
 
@@ -255,3 +256,38 @@ func TestApplyMeetSinkError(t *testing.T) {
 	err = ap.Apply(ctx)
 	require.Regexp(t, "CDC:ErrMySQLConnectionError", err)
 }
+
+func TestApplyToBlackholeSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	redoLogCh := make(chan *model.RedoRowChangedEvent, 1024)
+	ddlEventCh := make(chan *model.RedoDDLEvent, 1024)
+	createRedoReaderBak := createRedoReader
+	createRedoReader = func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(1000, 2000, redoLogCh, ddlEventCh), nil
+	}
+	defer func() {
+		createRedoReader = createRedoReaderBak
+	}()
+
+	for i := 0; i < 3; i++ {
+		redoLogCh <- redo.RowToRedo(&model.RowChangedEvent{
+			StartTs:  uint64(1100 + i*100),
+			CommitTs: uint64(1200 + i*100),
+			Table:    &model.TableName{Schema: "test", Table: "t1", TableID: int64(i)},
+			Columns: []*model.Column{
+				{Name: "a", Value: i, Flag: model.HandleKeyFlag},
+			},
+		})
+	}
+	close(redoLogCh)
+	close(ddlEventCh)
+
+	cfg := &RedoApplierConfig{
+		SinkURI:       "blackhole://",
+		ReplicaConfig: config.GetDefaultReplicaConfig(),
+	}
+	ap := NewRedoApplier(cfg)
+	require.Nil(t, ap.Apply(ctx))
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidirectional
+
+import (
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+)
+
+// Filter drops the transactions marked by the changefeeds whose writes must
+// not be replicated, and the mark rows.
+type Filter struct {
+	cfg *config.BidirectionalConfig
+}
+
+// NewFilter creates a Filter.
+func NewFilter(cfg *config.BidirectionalConfig) *Filter {
+	return &Filter{cfg: cfg}
+}
+
+// FilterEvents filters the row changed events committed at the same ts.
+func (f *Filter) FilterEvents(events []*model.PolymorphicEvent) []*model.PolymorphicEvent {
+	var dropped map[model.Ts]struct{}
+	for _, e := range events {
+		if e.Row == nil || !IsMarkTable(e.Row.Table.Schema, e.Row.Table.Table) {
+			continue
+		}
+		if id, ok := replicationIDOf(e.Row); ok && f.cfg.ShouldFilter(id) {
+			if dropped == nil {
+				dropped = make(map[model.Ts]struct{})
+			}
+			dropped[e.StartTs] = struct{}{}
+		}
+	}
+
+	res := events[:0]
+	for _, e := range events {
+		if e.Row != nil && IsMarkTable(e.Row.Table.Schema, e.Row.Table.Table) {
+			continue
+		}
+		if _, ok := dropped[e.StartTs]; ok {
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+func replicationIDOf(row *model.RowChangedEvent) (uint64, bool) {
+	cols := row.Columns
+	if len(cols) == 0 {
+		cols = row.PreColumns
+	}
+	for _, col := range cols {
+		if col == nil || col.Name != ReplicationIDColumn {
+			continue
+		}
+		switch v := col.Value.(type) {
+		case uint64:
+			return v, true
+		case int64:
+			return uint64(v), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidirectional
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterEvents(t *testing.T) {
+	t.Parallel()
+	f := NewFilter(&config.BidirectionalConfig{
+		ReplicationID:        1,
+		FilterReplicationIDs: []uint64{2},
+	})
+
+	table := &model.TableName{Schema: "test", Table: "t1"}
+	markTable := &model.TableName{Schema: SchemaName, Table: MarkTableName}
+	row := func(startTs model.Ts) *model.PolymorphicEvent {
+		return &model.PolymorphicEvent{
+			StartTs: startTs, CRTs: 10,
+			Row: &model.RowChangedEvent{StartTs: startTs, CommitTs: 10, Table: table},
+		}
+	}
+	mark := func(startTs model.Ts, replicationID interface{}) *model.PolymorphicEvent {
+		return &model.PolymorphicEvent{
+			StartTs: startTs, CRTs: 10,
+			Row: &model.RowChangedEvent{
+				StartTs: startTs, CommitTs: 10, Table: markTable,
+				Columns: []*model.Column{
+					{Name: "id", Value: int64(1)},
+					{Name: ReplicationIDColumn, Value: replicationID},
+				},
+			},
+		}
+	}
+
+	// Transaction 1 is written by the reverse direction, transaction 2 is
+	// written by another changefeed, and transaction 3 is written by users.
+	events := []*model.PolymorphicEvent{
+		row(1), row(2), mark(1, uint64(2)), row(3), mark(2, int64(3)), row(1),
+	}
+	res := f.FilterEvents(events)
+	require.Len(t, res, 2)
+	require.Equal(t, uint64(2), res[0].StartTs)
+	require.Equal(t, uint64(3), res[1].StartTs)
+
+	require.Len(t, f.FilterEvents(nil), 0)
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidirectional
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+)
+
+// A changefeed of a bidirectional replication marks every transaction it
+// writes to the downstream by updating a row of the mark table in the same
+// transaction, the row records the replication ID of the changefeed.
+//
+// There is only one mark table in a cluster. Its rows are identified by a
+// clustered integer handle, whose high bits are the hash of the table written
+// by the transaction, and low bits are the bucket of the sink worker. So the
+// reverse changefeed only pulls a small range of the mark table for each
+// table it replicates, and the mark table never needs to be created again for
+// tables created later.
+const (
+	// SchemaName is the schema of the mark table.
+	SchemaName = "tidb_cdc"
+	// MarkTableName is the name of the mark table.
+	MarkTableName = "repl_mark"
+
+	// ReplicationIDColumn is the column recording the replication ID.
+	ReplicationIDColumn = "replication_id"
+
+	bucketBits = 16
+	bucketMask = 1<<bucketBits - 1
+)
+
+// IsMarkTable returns true if the table is the mark table.
+func IsMarkTable(schema, table string) bool {
+	return strings.EqualFold(schema, SchemaName) &&
+		strings.EqualFold(table, MarkTableName)
+}
+
+// IsMarkTableOrSchema returns true if the table is the mark table, or the
+// table is empty and the schema is the schema of the mark table.
+func IsMarkTableOrSchema(schema, table string) bool {
+	if table == "" {
+		return strings.EqualFold(schema, SchemaName)
+	}
+	return IsMarkTable(schema, table)
+}
+
+// CreateMarkTableSQLs returns the SQLs to create the mark table.
+func CreateMarkTableSQLs() []string {
+	return []string{
+		"CREATE DATABASE IF NOT EXISTS " + quotes.QuoteName(SchemaName),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id BIGINT NOT NULL,
+	%s BIGINT UNSIGNED NOT NULL,
+	val BIGINT NOT NULL DEFAULT 0,
+	PRIMARY KEY (id) /*T![clustered_index] CLUSTERED */
+)`, quotes.QuoteSchema(SchemaName, MarkTableName), ReplicationIDColumn),
+	}
+}
+
+// UpdateMarkSQL returns the SQL to mark a transaction writing to the table,
+// and its arguments.
+func UpdateMarkSQL(schema, table string, bucket int, replicationID uint64) (string, []interface{}) {
+	query := fmt.Sprintf("INSERT INTO %s (id, %s, val) VALUES (?, ?, 1) "+
+		"ON DUPLICATE KEY UPDATE %s = VALUES(%s), val = val + 1",
+		quotes.QuoteSchema(SchemaName, MarkTableName),
+		ReplicationIDColumn, ReplicationIDColumn, ReplicationIDColumn)
+	return query, []interface{}{markRowID(schema, table, bucket), replicationID}
+}
+
+// MarkSpan returns the span of the mark table rows marking the transactions
+// writing to the table.
+func MarkSpan(markTableID int64, schema, table string) regionspan.Span {
+	start := markRowID(schema, table, 0)
+	return regionspan.Span{
+		Start: tablecodec.EncodeRowKeyWithHandle(markTableID, kv.IntHandle(start)),
+		End:   tablecodec.EncodeRowKeyWithHandle(markTableID, kv.IntHandle(start+bucketMask+1)),
+	}
+}
+
+func markRowID(schema, table string, bucket int) int64 {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(schema)))
+	h.Write([]byte{'.'})
+	h.Write([]byte(strings.ToLower(table)))
+	// Keep the handle positive.
+	return int64(h.Sum32()&0x7fffffff)<<bucketBits | int64(bucket&bucketMask)
+}
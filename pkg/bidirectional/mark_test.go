@@ -0,0 +1,66 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidirectional
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsMarkTable(t *testing.T) {
+	t.Parallel()
+	require.True(t, IsMarkTable("tidb_cdc", "repl_mark"))
+	require.True(t, IsMarkTable("TIDB_CDC", "Repl_Mark"))
+	require.False(t, IsMarkTable("tidb_cdc", "syncpoint_v1"))
+	require.False(t, IsMarkTable("test", "repl_mark"))
+
+	require.True(t, IsMarkTableOrSchema("tidb_cdc", ""))
+	require.True(t, IsMarkTableOrSchema("tidb_cdc", "repl_mark"))
+	require.False(t, IsMarkTableOrSchema("tidb_cdc", "syncpoint_v1"))
+	require.False(t, IsMarkTableOrSchema("test", ""))
+}
+
+func TestUpdateMarkSQL(t *testing.T) {
+	t.Parallel()
+	query, args := UpdateMarkSQL("test", "t1", 2, 10)
+	require.Equal(t, "INSERT INTO `tidb_cdc`.`repl_mark` (id, replication_id, val) "+
+		"VALUES (?, ?, 1) ON DUPLICATE KEY UPDATE replication_id = VALUES(replication_id), "+
+		"val = val + 1", query)
+	require.Len(t, args, 2)
+	require.Equal(t, markRowID("test", "t1", 2), args[0])
+	require.Equal(t, uint64(10), args[1])
+}
+
+func TestMarkSpan(t *testing.T) {
+	t.Parallel()
+	const markTableID = 100
+	inSpan := func(schema, table string, bucket int, start, end []byte) bool {
+		key := tablecodec.EncodeRowKeyWithHandle(markTableID,
+			kv.IntHandle(markRowID(schema, table, bucket)))
+		return bytes.Compare(key, start) >= 0 && bytes.Compare(key, end) < 0
+	}
+
+	span := MarkSpan(markTableID, "test", "t1")
+	for _, bucket := range []int{0, 1, bucketMask} {
+		require.True(t, inSpan("test", "t1", bucket, span.Start, span.End))
+		// The table name is case-insensitive.
+		require.True(t, inSpan("TEST", "T1", bucket, span.Start, span.End))
+		require.False(t, inSpan("test", "t2", bucket, span.Start, span.End))
+	}
+	require.Greater(t, markRowID("test", "t1", 0), int64(0))
+}
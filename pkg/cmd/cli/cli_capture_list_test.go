@@ -19,42 +19,30 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/errors"
-	"github.com/pingcap/tiflow/cdc/model"
-	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
-	"github.com/pingcap/tiflow/pkg/api/v1/mock"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
-	v2mock "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
 	"github.com/pingcap/tiflow/pkg/cmd/factory"
 	"github.com/stretchr/testify/require"
 )
 
-type mockAPIV1Client struct {
-	apiv1client.APIV1Interface
-	captures    apiv1client.CaptureInterface
-	changefeeds apiv1client.ChangefeedInterface
-	processor   apiv1client.ProcessorInterface
-	status      apiv1client.StatusInterface
+type mockAPIV2Client struct {
+	apiv2client.APIV2Interface
+	captures    apiv2client.CaptureInterface
+	processor   apiv2client.ProcessorInterface
+	tso         apiv2client.TsoInterface
+	changefeeds apiv2client.ChangefeedInterface
+	unsafes     apiv2client.UnsafeInterface
 }
 
-func (f *mockAPIV1Client) Captures() apiv1client.CaptureInterface {
+func (f *mockAPIV2Client) Captures() apiv2client.CaptureInterface {
 	return f.captures
 }
 
-func (f *mockAPIV1Client) Processors() apiv1client.ProcessorInterface {
+func (f *mockAPIV2Client) Processors() apiv2client.ProcessorInterface {
 	return f.processor
 }
 
-func (f *mockAPIV1Client) Changefeeds() apiv1client.ChangefeedInterface {
-	return f.changefeeds
-}
-
-type mockAPIV2Client struct {
-	apiv2client.APIV2Interface
-	tso         apiv2client.TsoInterface
-	changefeeds apiv2client.ChangefeedInterface
-	unsafes     apiv2client.UnsafeInterface
-}
-
 func (f *mockAPIV2Client) Changefeeds() apiv2client.ChangefeedInterface {
 	return f.changefeeds
 }
@@ -72,44 +60,30 @@ type mockFactory struct {
 	captures    *mock.MockCaptureInterface
 	changefeeds *mock.MockChangefeedInterface
 	processor   *mock.MockProcessorInterface
-	status      *mock.MockStatusInterface
-
-	changefeedsv2 *v2mock.MockChangefeedInterface
-	tso           *v2mock.MockTsoInterface
-	unsafes       *v2mock.MockUnsafeInterface
+	tso         *mock.MockTsoInterface
+	unsafes     *mock.MockUnsafeInterface
 }
 
 func newMockFactory(ctrl *gomock.Controller) *mockFactory {
 	cps := mock.NewMockCaptureInterface(ctrl)
 	processor := mock.NewMockProcessorInterface(ctrl)
 	cf := mock.NewMockChangefeedInterface(ctrl)
-	status := mock.NewMockStatusInterface(ctrl)
-	unsafes := v2mock.NewMockUnsafeInterface(ctrl)
-	tso := v2mock.NewMockTsoInterface(ctrl)
-	cfv2 := v2mock.NewMockChangefeedInterface(ctrl)
+	unsafes := mock.NewMockUnsafeInterface(ctrl)
+	tso := mock.NewMockTsoInterface(ctrl)
 	return &mockFactory{
-		captures:      cps,
-		changefeeds:   cf,
-		processor:     processor,
-		status:        status,
-		changefeedsv2: cfv2,
-		tso:           tso,
-		unsafes:       unsafes,
+		captures:    cps,
+		changefeeds: cf,
+		processor:   processor,
+		tso:         tso,
+		unsafes:     unsafes,
 	}
 }
 
-func (f *mockFactory) APIV1Client() (apiv1client.APIV1Interface, error) {
-	return &mockAPIV1Client{
+func (f *mockFactory) APIV2Client() (apiv2client.APIV2Interface, error) {
+	return &mockAPIV2Client{
 		captures:    f.captures,
 		changefeeds: f.changefeeds,
-		status:      f.status,
 		processor:   f.processor,
-	}, nil
-}
-
-func (f *mockFactory) APIV2Client() (apiv2client.APIV2Interface, error) {
-	return &mockAPIV2Client{
-		changefeeds: f.changefeedsv2,
 		tso:         f.tso,
 		unsafes:     f.unsafes,
 	}, nil
@@ -121,7 +95,7 @@ func TestCaptureListCli(t *testing.T) {
 	cf := mock.NewMockCaptureInterface(ctrl)
 	f := &mockFactory{captures: cf}
 	cmd := newCmdListCapture(f)
-	cf.EXPECT().List(gomock.Any()).Return(&[]model.Capture{
+	cf.EXPECT().List(gomock.Any()).Return([]v2.Capture{
 		{
 			ID:            "owner",
 			IsOwner:       true,
@@ -0,0 +1,94 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// handleDDLErrorOptions defines flags for the `cli changefeed handle-ddl-error` command.
+type handleDDLErrorOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	commitTs     uint64
+	op           string
+	sqls         []string
+}
+
+// newHandleDDLErrorOptions creates new options for the `cli changefeed handle-ddl-error` command.
+func newHandleDDLErrorOptions() *handleDDLErrorOptions {
+	return &handleDDLErrorOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *handleDDLErrorOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().Uint64Var(&o.commitTs, "commit-ts", 0, "Commit ts of the DDL to handle")
+	cmd.PersistentFlags().StringVar(&o.op, "op", "", "Operation to handle the DDL, one of skip, replace, inject and revert")
+	cmd.PersistentFlags().StringArrayVar(&o.sqls, "sql", nil, "SQL to replace or be injected before the DDL, can be specified multiple times")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	_ = cmd.MarkPersistentFlagRequired("commit-ts")
+	_ = cmd.MarkPersistentFlagRequired("op")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *handleDDLErrorOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed handle-ddl-error` command.
+func (o *handleDDLErrorOptions) run(cmd *cobra.Command) error {
+	ctx := context.Background()
+	handlers, err := o.apiClient.Changefeeds().HandleDDLError(ctx, &v2.DDLErrorHandler{
+		CommitTs: o.commitTs,
+		Op:       o.op,
+		SQLs:     o.sqls,
+	}, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	return util.JSONPrint(cmd, handlers)
+}
+
+// newCmdHandleDDLError creates the `cli changefeed handle-ddl-error` command.
+func newCmdHandleDDLError(f factory.Factory) *cobra.Command {
+	o := newHandleDDLErrorOptions()
+
+	command := &cobra.Command{
+		Use:   "handle-ddl-error",
+		Short: "Skip, replace or inject SQLs before a DDL failed to execute downstream, the changefeed must be stopped",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
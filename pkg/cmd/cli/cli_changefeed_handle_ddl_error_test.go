@@ -0,0 +1,52 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	mock_v2 "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedHandleDDLErrorCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock_v2.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdHandleDDLError(f)
+	o := newHandleDDLErrorOptions()
+	require.Nil(t, o.complete(f))
+
+	o.changefeedID = "abc"
+	o.commitTs = 10
+	o.op = "replace"
+	o.sqls = []string{"alter table t add column a int"}
+	handler := &v2.DDLErrorHandler{
+		CommitTs: 10,
+		Op:       "replace",
+		SQLs:     []string{"alter table t add column a int"},
+	}
+	cf.EXPECT().HandleDDLError(gomock.Any(), handler, "abc").
+		Return([]*v2.DDLErrorHandler{handler}, nil)
+	require.Nil(t, o.run(cmd))
+
+	cf.EXPECT().HandleDDLError(gomock.Any(), gomock.Any(), "abc").
+		Return(nil, errors.New("test"))
+	require.NotNil(t, o.run(cmd))
+}
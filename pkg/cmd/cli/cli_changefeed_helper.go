@@ -18,6 +18,8 @@ import (
 	"strings"
 	"time"
 
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/tikv/client-go/v2/oracle"
@@ -89,3 +91,16 @@ func confirmIgnoreIneligibleTables(cmd *cobra.Command) (bool, error) {
 
 	return true, nil
 }
+
+// toModelRunningError converts the running error returned by the v2 API into
+// *model.RunningError, so that the output of the commands is unchanged.
+func toModelRunningError(err *v2.RunningError) *model.RunningError {
+	if err == nil {
+		return nil
+	}
+	return &model.RunningError{
+		Addr:    err.Addr,
+		Code:    err.Code,
+		Message: err.Message,
+	}
+}
@@ -19,7 +19,6 @@ import (
 	"github.com/pingcap/errors"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	"github.com/pingcap/tiflow/cdc/model"
-	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
 	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
 
 	"github.com/pingcap/tiflow/pkg/cmd/factory"
@@ -51,8 +50,7 @@ type cfMeta struct {
 
 // queryChangefeedOptions defines flags for the `cli changefeed query` command.
 type queryChangefeedOptions struct {
-	apiClient    apiv1client.APIV1Interface
-	apiClientV2  apiv2client.APIV2Interface
+	apiClient    apiv2client.APIV2Interface
 	changefeedID string
 	simplified   bool
 }
@@ -72,16 +70,11 @@ func (o *queryChangefeedOptions) addFlags(cmd *cobra.Command) {
 
 // complete adapts from the command line args to the data and client required.
 func (o *queryChangefeedOptions) complete(f factory.Factory) error {
-	clientV1, err := f.APIV1Client()
+	apiClient, err := f.APIV2Client()
 	if err != nil {
 		return err
 	}
-	o.apiClient = clientV1
-	clientV2, err := f.APIV2Client()
-	if err != nil {
-		return err
-	}
-	o.apiClientV2 = clientV2
+	o.apiClient = apiClient
 	return nil
 }
 
@@ -93,9 +86,17 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		if err != nil {
 			return errors.Trace(err)
 		}
-		for _, info := range *infos {
+		for _, info := range infos {
 			if info.ID == o.changefeedID {
-				return util.JSONPrint(cmd, info)
+				return util.JSONPrint(cmd, model.ChangefeedCommonInfo{
+					UpstreamID:     info.UpstreamID,
+					Namespace:      info.Namespace,
+					ID:             info.ID,
+					FeedState:      info.FeedState,
+					CheckpointTSO:  info.CheckpointTSO,
+					CheckpointTime: info.CheckpointTime,
+					RunningError:   toModelRunningError(info.RunningError),
+				})
 			}
 		}
 		return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(o.changefeedID)
@@ -106,7 +107,7 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	info, err := o.apiClientV2.Changefeeds().GetInfo(ctx, o.changefeedID)
+	info, err := o.apiClient.Changefeeds().GetInfo(ctx, o.changefeedID)
 	if err != nil && cerror.ErrChangeFeedNotExists.NotEqual(err) {
 		return err
 	}
@@ -122,12 +123,17 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		TargetTs:       detail.TargetTs,
 		CheckpointTSO:  detail.CheckpointTSO,
 		CheckpointTime: detail.CheckpointTime,
-		Engine:         detail.Engine,
+		Engine:         model.SortEngine(detail.Engine),
 		FeedState:      detail.FeedState,
-		RunningError:   detail.RunningError,
+		RunningError:   toModelRunningError(detail.RunningError),
 		ErrorHis:       detail.ErrorHis,
 		CreatorVersion: detail.CreatorVersion,
-		TaskStatus:     detail.TaskStatus,
+	}
+	for _, status := range detail.TaskStatus {
+		meta.TaskStatus = append(meta.TaskStatus, model.CaptureTaskStatus{
+			CaptureID: status.CaptureID,
+			Tables:    status.Tables,
+		})
 	}
 	return util.JSONPrint(cmd, meta)
 }
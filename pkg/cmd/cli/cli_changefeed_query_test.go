@@ -23,24 +23,22 @@ import (
 	"github.com/pingcap/errors"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	"github.com/pingcap/tiflow/cdc/model"
-	mock_v1 "github.com/pingcap/tiflow/pkg/api/v1/mock"
-	mock_v2 "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestChangefeedQueryCli(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	cfV1 := mock_v1.NewMockChangefeedInterface(ctrl)
-	cfV2 := mock_v2.NewMockChangefeedInterface(ctrl)
+	cf := mock.NewMockChangefeedInterface(ctrl)
 
-	f := &mockFactory{changefeeds: cfV1, changefeedsv2: cfV2}
+	f := &mockFactory{changefeeds: cf}
 
 	o := newQueryChangefeedOptions()
 	o.complete(f)
 	cmd := newCmdQueryChangefeed(f)
 
-	cfV1.EXPECT().List(gomock.Any(), "all").Return(&[]model.ChangefeedCommonInfo{
+	cf.EXPECT().List(gomock.Any(), "all").Return([]v2.ChangefeedCommonInfo{
 		{
 			UpstreamID:     1,
 			Namespace:      "default",
@@ -53,7 +51,7 @@ func TestChangefeedQueryCli(t *testing.T) {
 	o.simplified = true
 	o.changefeedID = "abc"
 	require.Nil(t, o.run(cmd))
-	cfV1.EXPECT().List(gomock.Any(), "all").Return(&[]model.ChangefeedCommonInfo{
+	cf.EXPECT().List(gomock.Any(), "all").Return([]v2.ChangefeedCommonInfo{
 		{
 			UpstreamID:     1,
 			Namespace:      "default",
@@ -67,14 +65,16 @@ func TestChangefeedQueryCli(t *testing.T) {
 	o.changefeedID = "abcd"
 	require.NotNil(t, o.run(cmd))
 
-	cfV1.EXPECT().List(gomock.Any(), "all").Return(nil, errors.New("test"))
+	cf.EXPECT().List(gomock.Any(), "all").Return(nil, errors.New("test"))
 	o.simplified = true
 	o.changefeedID = "abcd"
 	require.NotNil(t, o.run(cmd))
 
 	// query success
-	cfV1.EXPECT().Get(gomock.Any(), "bcd").Return(&model.ChangefeedDetail{}, nil)
-	cfV2.EXPECT().GetInfo(gomock.Any(), gomock.Any()).Return(&v2.ChangeFeedInfo{
+	cf.EXPECT().Get(gomock.Any(), "bcd").Return(&v2.ChangefeedDetail{
+		ErrorHis: []int64{1000},
+	}, nil)
+	cf.EXPECT().GetInfo(gomock.Any(), gomock.Any()).Return(&v2.ChangeFeedInfo{
 		Config: v2.GetDefaultReplicaConfig(),
 	}, nil)
 
@@ -87,9 +87,10 @@ func TestChangefeedQueryCli(t *testing.T) {
 	require.Nil(t, err)
 	// make sure config is printed
 	require.Contains(t, string(out), "config")
+	require.Contains(t, string(out), "\"error_history\": [\n    1000\n  ]")
 
 	// query failed
-	cfV1.EXPECT().Get(gomock.Any(), "bcd").Return(nil, errors.New("test"))
+	cf.EXPECT().Get(gomock.Any(), "bcd").Return(nil, errors.New("test"))
 	os.Args = []string{"query", "--simple=false", "--changefeed-id=bcd"}
 	require.NotNil(t, o.run(cmd))
 }
@@ -19,8 +19,6 @@ import (
 	"strings"
 
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
-	"github.com/pingcap/tiflow/cdc/model"
-	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
 	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
 	"github.com/pingcap/tiflow/pkg/cmd/factory"
@@ -32,11 +30,10 @@ import (
 
 // resumeChangefeedOptions defines flags for the `cli changefeed resume` command.
 type resumeChangefeedOptions struct {
-	apiV1Client apiv1client.APIV1Interface
-	apiV2Client apiv2client.APIV2Interface
+	apiClient apiv2client.APIV2Interface
 
 	changefeedID          string
-	changefeedDetail      *model.ChangefeedDetail
+	changefeedDetail      *v2.ChangefeedDetail
 	noConfirm             bool
 	overwriteCheckpointTs string
 	currentTso            *v2.Tso
@@ -79,16 +76,11 @@ func (o *resumeChangefeedOptions) addFlags(cmd *cobra.Command) {
 
 // complete adapts from the command line args to the data and client required.
 func (o *resumeChangefeedOptions) complete(f factory.Factory) error {
-	apiClient, err := f.APIV1Client()
+	apiClient, err := f.APIV2Client()
 	if err != nil {
 		return err
 	}
-	o.apiV1Client = apiClient
-	apiClient2, err := f.APIV2Client()
-	if err != nil {
-		return err
-	}
-	o.apiV2Client = apiClient2
+	o.apiClient = apiClient
 	return nil
 }
 
@@ -127,7 +119,7 @@ func (o *resumeChangefeedOptions) getResumeChangefeedConfig(
 }
 
 func (o *resumeChangefeedOptions) getTSO(ctx context.Context) (*v2.Tso, error) {
-	tso, err := o.apiV2Client.Tso().Query(ctx,
+	tso, err := o.apiClient.Tso().Query(ctx,
 		&v2.UpstreamConfig{ID: o.changefeedDetail.UpstreamID})
 	if err != nil {
 		return nil, err
@@ -137,9 +129,9 @@ func (o *resumeChangefeedOptions) getTSO(ctx context.Context) (*v2.Tso, error) {
 }
 
 func (o *resumeChangefeedOptions) getChangefeedInfo(ctx context.Context) (
-	*model.ChangefeedDetail, error,
+	*v2.ChangefeedDetail, error,
 ) {
-	detail, err := o.apiV1Client.Changefeeds().Get(ctx, o.changefeedID)
+	detail, err := o.apiClient.Changefeeds().Get(ctx, o.changefeedID)
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +205,7 @@ func (o *resumeChangefeedOptions) run(cmd *cobra.Command) error {
 	if err := o.confirmResumeChangefeedCheck(ctx, cmd); err != nil {
 		return err
 	}
-	err := o.apiV2Client.Changefeeds().Resume(ctx, cfg, o.changefeedID)
+	err := o.apiClient.Changefeeds().Resume(ctx, cfg, o.changefeedID)
 
 	return err
 }
@@ -38,7 +38,7 @@ func TestChangefeedResumeCli(t *testing.T) {
 	cmd := newCmdResumeChangefeed(f)
 
 	// 1. test changefeed resume with non-nil changefeed get result, non-nil tso get result
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{
 		UpstreamID:     1,
 		Namespace:      "default",
 		ID:             "abc",
@@ -48,21 +48,21 @@ func TestChangefeedResumeCli(t *testing.T) {
 	f.tso.EXPECT().Query(gomock.Any(), gomock.Any()).Return(&v2.Tso{
 		Timestamp: time.Now().Unix() * 1000,
 	}, nil).AnyTimes()
-	f.changefeedsv2.EXPECT().Resume(gomock.Any(), &v2.ResumeChangefeedConfig{
+	f.changefeeds.EXPECT().Resume(gomock.Any(), &v2.ResumeChangefeedConfig{
 		OverwriteCheckpointTs: 0,
 	}, "abc").Return(nil)
 	os.Args = []string{"resume", "--no-confirm=true", "--changefeed-id=abc"}
 	require.Nil(t, cmd.Execute())
 
 	// 2. test changefeed resume with nil changfeed get result
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{}, nil)
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{}, nil)
 	os.Args = []string{"resume", "--no-confirm=false", "--changefeed-id=abc"}
 	o.noConfirm = false
 	o.changefeedID = "abc"
 	require.NotNil(t, o.run(cmd))
 
 	// 3. test changefeed resume with nil tso get result
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{
 		UpstreamID:     1,
 		Namespace:      "default",
 		ID:             "abc",
@@ -74,7 +74,7 @@ func TestChangefeedResumeCli(t *testing.T) {
 
 	// 4. test changefeed resume with non-nil changefeed result, non-nil tso get result,
 	// and confirmation checking
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{
 		UpstreamID:     1,
 		Namespace:      "default",
 		ID:             "abc",
@@ -109,7 +109,7 @@ func TestChangefeedResumeWithNewCheckpointTs(t *testing.T) {
 	cmd := newCmdResumeChangefeed(f)
 
 	// 1. test changefeed resume with valid overwritten checkpointTs
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{
 		UpstreamID:     1,
 		Namespace:      "default",
 		ID:             "abc",
@@ -120,7 +120,7 @@ func TestChangefeedResumeWithNewCheckpointTs(t *testing.T) {
 		Timestamp: time.Now().Unix() * 1000,
 	}
 	f.tso.EXPECT().Query(gomock.Any(), gomock.Any()).Return(tso, nil).AnyTimes()
-	f.changefeedsv2.EXPECT().Resume(gomock.Any(), &v2.ResumeChangefeedConfig{
+	f.changefeeds.EXPECT().Resume(gomock.Any(), &v2.ResumeChangefeedConfig{
 		OverwriteCheckpointTs: oracle.ComposeTS(tso.Timestamp, tso.LogicTime),
 	}, "abc").Return(nil)
 	os.Args = []string{
@@ -130,7 +130,7 @@ func TestChangefeedResumeWithNewCheckpointTs(t *testing.T) {
 	require.Nil(t, cmd.Execute())
 
 	// 2. test changefeed resume with invalid overwritten checkpointTs
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{
 		UpstreamID:     1,
 		Namespace:      "default",
 		ID:             "abc",
@@ -144,7 +144,7 @@ func TestChangefeedResumeWithNewCheckpointTs(t *testing.T) {
 	require.NotNil(t, o.run(cmd))
 
 	// 3. test changefeed resume with checkpointTs larger than current tso
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{
 		UpstreamID:     1,
 		Namespace:      "default",
 		ID:             "abc",
@@ -156,7 +156,7 @@ func TestChangefeedResumeWithNewCheckpointTs(t *testing.T) {
 	require.NotNil(t, o.run(cmd))
 
 	// 4. test changefeed resume with checkpointTs smaller than gcSafePoint
-	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&model.ChangefeedDetail{
+	f.changefeeds.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangefeedDetail{
 		UpstreamID:     1,
 		Namespace:      "default",
 		ID:             "abc",
@@ -167,7 +167,7 @@ func TestChangefeedResumeWithNewCheckpointTs(t *testing.T) {
 		Timestamp: 1,
 	}
 	f.tso.EXPECT().Query(gomock.Any(), gomock.Any()).Return(tso, nil).AnyTimes()
-	f.changefeedsv2.EXPECT().Resume(gomock.Any(), &v2.ResumeChangefeedConfig{
+	f.changefeeds.EXPECT().Resume(gomock.Any(), &v2.ResumeChangefeedConfig{
 		OverwriteCheckpointTs: 262144,
 	}, "abc").
 		Return(cerror.ErrStartTsBeforeGC)
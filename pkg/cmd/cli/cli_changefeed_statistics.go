@@ -19,7 +19,6 @@ import (
 	"time"
 
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
-	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
 	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
 	"github.com/pingcap/tiflow/pkg/cmd/factory"
@@ -38,8 +37,7 @@ type status struct {
 
 // statisticsChangefeedOptions defines flags for the `cli changefeed statistics` command.
 type statisticsChangefeedOptions struct {
-	apiV1Client apiv1client.APIV1Interface
-	apiV2Client apiv2client.APIV2Interface
+	apiClient apiv2client.APIV2Interface
 
 	changefeedID string
 	interval     uint
@@ -60,15 +58,11 @@ func (o *statisticsChangefeedOptions) addFlags(cmd *cobra.Command) {
 
 // complete adapts from the command line args to the data and client required.
 func (o *statisticsChangefeedOptions) complete(f factory.Factory) error {
-	var err error
-	o.apiV1Client, err = f.APIV1Client()
-	if err != nil {
-		return err
-	}
-	o.apiV2Client, err = f.APIV2Client()
+	apiClient, err := f.APIV2Client()
 	if err != nil {
 		return err
 	}
+	o.apiClient = apiClient
 	return nil
 }
 
@@ -76,24 +70,24 @@ func (o *statisticsChangefeedOptions) complete(f factory.Factory) error {
 func (o *statisticsChangefeedOptions) runCliWithAPIClient(ctx context.Context, cmd *cobra.Command, lastCount *uint64, lastTime *time.Time) error {
 	now := time.Now()
 	var count uint64
-	captures, err := o.apiV1Client.Captures().List(ctx)
+	captures, err := o.apiClient.Captures().List(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, capture := range *captures {
-		processor, err := o.apiV1Client.Processors().Get(ctx, o.changefeedID, capture.ID)
+	for _, capture := range captures {
+		processor, err := o.apiClient.Processors().Get(ctx, "", o.changefeedID, capture.ID)
 		if err != nil {
 			return err
 		}
 		count += processor.Count
 	}
 
-	changefeed, err := o.apiV1Client.Changefeeds().Get(ctx, o.changefeedID)
+	changefeed, err := o.apiClient.Changefeeds().Get(ctx, o.changefeedID)
 	if err != nil {
 		return err
 	}
-	ts, err := o.apiV2Client.Tso().Query(ctx,
+	ts, err := o.apiClient.Tso().Query(ctx,
 		&v2.UpstreamConfig{ID: changefeed.UpstreamID})
 	if err != nil {
 		return err
@@ -104,20 +104,20 @@ func TestChangefeedUpdateCli(t *testing.T) {
 	o := newUpdateChangefeedOptions(newChangefeedCommonOptions())
 	o.complete(f)
 	cmd := newCmdUpdateChangefeed(f)
-	f.changefeedsv2.EXPECT().GetInfo(gomock.Any(), "abc").Return(nil, errors.New("test"))
+	f.changefeeds.EXPECT().GetInfo(gomock.Any(), "abc").Return(nil, errors.New("test"))
 	os.Args = []string{"update", "--no-confirm=true", "--changefeed-id=abc"}
 	o.commonChangefeedOptions.noConfirm = true
 	o.changefeedID = "abc"
 	require.NotNil(t, o.run(cmd))
 
-	f.changefeedsv2.EXPECT().GetInfo(gomock.Any(), "abc").
+	f.changefeeds.EXPECT().GetInfo(gomock.Any(), "abc").
 		Return(&v2.ChangeFeedInfo{
 			ID: "abc",
 			Config: &v2.ReplicaConfig{
 				Sink: &v2.SinkConfig{},
 			},
 		}, nil)
-	f.changefeedsv2.EXPECT().Update(gomock.Any(), gomock.Any(), "abc").
+	f.changefeeds.EXPECT().Update(gomock.Any(), gomock.Any(), "abc").
 		Return(&v2.ChangeFeedInfo{}, nil)
 	dir := t.TempDir()
 	configPath := filepath.Join(dir, "cf.toml")
@@ -153,13 +153,13 @@ func TestChangefeedUpdateCli(t *testing.T) {
 
 	// no diff
 	cmd = newCmdUpdateChangefeed(f)
-	f.changefeedsv2.EXPECT().GetInfo(gomock.Any(), "abc").
+	f.changefeeds.EXPECT().GetInfo(gomock.Any(), "abc").
 		Return(&v2.ChangeFeedInfo{}, nil)
 	os.Args = []string{"update", "--no-confirm=true", "-c", "abc"}
 	require.Nil(t, cmd.Execute())
 
 	cmd = newCmdUpdateChangefeed(f)
-	f.changefeedsv2.EXPECT().GetInfo(gomock.Any(), "abcd").
+	f.changefeeds.EXPECT().GetInfo(gomock.Any(), "abcd").
 		Return(&v2.ChangeFeedInfo{ID: "abcd"}, errors.New("test"))
 	o.commonChangefeedOptions.noConfirm = true
 	o.commonChangefeedOptions.sortEngine = "unified"
@@ -0,0 +1,99 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// verifyChangefeedOptions defines flags for the `cli changefeed verify` command.
+type verifyChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	upstreamURI  string
+	chunkSize    int
+	last         bool
+}
+
+// newVerifyChangefeedOptions creates new options for the `cli changefeed verify` command.
+func newVerifyChangefeedOptions() *verifyChangefeedOptions {
+	return &verifyChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *verifyChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().StringVar(&o.upstreamURI, "upstream-uri", "", "Upstream TiDB URI used to compute checksums, overrides the one in changefeed config")
+	cmd.PersistentFlags().IntVar(&o.chunkSize, "chunk-size", 0, "Rows per chunk when locating mismatched key ranges")
+	cmd.PersistentFlags().BoolVar(&o.last, "last", false, "Only output the last recorded verification result")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *verifyChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed verify` command.
+func (o *verifyChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.Background()
+	var (
+		result *v2.ChangefeedVerification
+		err    error
+	)
+	if o.last {
+		result, err = o.apiClient.Changefeeds().GetVerification(ctx, o.changefeedID)
+	} else {
+		result, err = o.apiClient.Changefeeds().Verify(ctx, &v2.VerifyChangefeedConfig{
+			UpstreamURI: o.upstreamURI,
+			ChunkSize:   o.chunkSize,
+		}, o.changefeedID)
+	}
+	if err != nil {
+		return err
+	}
+	return util.JSONPrint(cmd, result)
+}
+
+// newCmdVerifyChangefeed creates the `cli changefeed verify` command.
+func newCmdVerifyChangefeed(f factory.Factory) *cobra.Command {
+	o := newVerifyChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify data consistency between upstream and downstream of a replication task (changefeed)",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
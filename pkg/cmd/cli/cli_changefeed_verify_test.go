@@ -0,0 +1,53 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	mock_v2 "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedVerifyCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock_v2.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdVerifyChangefeed(f)
+	o := newVerifyChangefeedOptions()
+	require.Nil(t, o.complete(f))
+
+	o.changefeedID = "abc"
+	o.upstreamURI = "mysql://root@127.0.0.1:4000/"
+	o.chunkSize = 100
+	cf.EXPECT().Verify(gomock.Any(), &v2.VerifyChangefeedConfig{
+		UpstreamURI: "mysql://root@127.0.0.1:4000/",
+		ChunkSize:   100,
+	}, "abc").Return(&v2.ChangefeedVerification{Consistent: true}, nil)
+	require.Nil(t, o.run(cmd))
+
+	cf.EXPECT().Verify(gomock.Any(), gomock.Any(), "abc").
+		Return(nil, errors.New("test"))
+	require.NotNil(t, o.run(cmd))
+
+	o.last = true
+	cf.EXPECT().GetVerification(gomock.Any(), "abc").
+		Return(&v2.ChangefeedVerification{Consistent: false}, nil)
+	require.Nil(t, o.run(cmd))
+}
@@ -17,7 +17,7 @@ import (
 	"context"
 
 	"github.com/pingcap/tiflow/cdc/model"
-	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
 	"github.com/pingcap/tiflow/pkg/cmd/factory"
 	"github.com/pingcap/tiflow/pkg/cmd/util"
@@ -33,7 +33,7 @@ type processorMeta struct {
 // queryProcessorOptions defines flags for the `cli processor query` command.
 type queryProcessorOptions struct {
 	etcdClient *etcd.CDCEtcdClientImpl
-	apiClient  apiv1client.APIV1Interface
+	apiClient  apiv2client.APIV2Interface
 
 	changefeedID     string
 	captureID        string
@@ -47,7 +47,7 @@ func newQueryProcessorOptions() *queryProcessorOptions {
 
 // complete adapts from the command line args to the data and client required.
 func (o *queryProcessorOptions) complete(f factory.Factory) error {
-	apiClient, err := f.APIV1Client()
+	apiClient, err := f.APIV2Client()
 	if err != nil {
 		return err
 	}
@@ -66,7 +66,7 @@ func (o *queryProcessorOptions) addFlags(cmd *cobra.Command) {
 
 // run cli cmd with api client
 func (o *queryProcessorOptions) runCliWithAPIClient(ctx context.Context, cmd *cobra.Command) error {
-	processor, err := o.apiClient.Processors().Get(ctx, o.changefeedID, o.captureID)
+	processor, err := o.apiClient.Processors().Get(ctx, "", o.changefeedID, o.captureID)
 	if err != nil {
 		return err
 	}
@@ -86,10 +86,10 @@ func (o *queryProcessorOptions) runCliWithAPIClient(ctx context.Context, cmd *co
 			// Operations, AdminJobType and ModRevision are vacant
 		},
 		Position: &model.TaskPosition{
-			CheckPointTs: processor.CheckPointTs,
+			CheckPointTs: processor.CheckpointTs,
 			ResolvedTs:   processor.ResolvedTs,
 			Count:        processor.Count,
-			Error:        processor.Error,
+			Error:        toModelRunningError(processor.Error),
 		},
 	}
 
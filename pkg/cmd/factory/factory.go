@@ -43,6 +43,8 @@ type ClientGetter interface {
 	GetServerAddr() string
 	GetLogLevel() string
 	GetCredential() *security.Credential
+	GetUser() string
+	GetPassword() string
 }
 
 // ClientFlags specifies the parameters needed to construct the client.
@@ -53,6 +55,8 @@ type ClientFlags struct {
 	caPath     string
 	certPath   string
 	keyPath    string
+	user       string
+	password   string
 }
 
 var _ ClientGetter = &ClientFlags{}
@@ -93,6 +97,16 @@ func (c *ClientFlags) GetServerAddr() string {
 	return c.serverAddr
 }
 
+// GetUser returns the user for the basic authentication of the CDC server.
+func (c *ClientFlags) GetUser() string {
+	return c.user
+}
+
+// GetPassword returns the password for the basic authentication of the CDC server.
+func (c *ClientFlags) GetPassword() string {
+	return c.password
+}
+
 // NewClientFlags creates new client flags.
 func NewClientFlags() *ClientFlags {
 	return &ClientFlags{}
@@ -112,6 +126,10 @@ func (c *ClientFlags) AddFlags(cmd *cobra.Command) {
 		"Certificate path for TLS connection to CDC server")
 	cmd.PersistentFlags().StringVar(&c.keyPath, "key", "",
 		"Private key path for TLS connection to CDC server")
+	cmd.PersistentFlags().StringVar(&c.user, "user", "",
+		"User for the basic authentication of CDC server")
+	cmd.PersistentFlags().StringVar(&c.password, "password", "",
+		"Password for the basic authentication of CDC server")
 	cmd.PersistentFlags().StringVar(&c.logLevel, "log-level", "warn",
 		"log level (etc: debug|info|warn|error)")
 }
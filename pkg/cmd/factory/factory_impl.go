@@ -88,6 +88,16 @@ func (f *factoryImpl) GetCredential() *security.Credential {
 	return f.clientGetter.GetCredential()
 }
 
+// GetUser returns the user for the basic authentication of the CDC server.
+func (f *factoryImpl) GetUser() string {
+	return f.clientGetter.GetUser()
+}
+
+// GetPassword returns the password for the basic authentication of the CDC server.
+func (f *factoryImpl) GetPassword() string {
+	return f.clientGetter.GetPassword()
+}
+
 // EtcdClient creates new cdc etcd client.
 func (f *factoryImpl) EtcdClient() (*etcd.CDCEtcdClientImpl, error) {
 	ctx := cmdconetxt.GetDefaultContext()
@@ -208,7 +218,8 @@ func (f *factoryImpl) APIV1Client() (apiv1client.APIV1Interface, error) {
 		return nil, errors.Trace(err)
 	}
 	log.Info(serverAddr)
-	client, err := apiv1client.NewAPIClient(serverAddr, f.clientGetter.GetCredential())
+	client, err := apiv1client.NewAPIClient(serverAddr, f.clientGetter.GetCredential(),
+		f.clientGetter.GetUser(), f.clientGetter.GetPassword())
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -222,14 +233,16 @@ func (f *factoryImpl) APIV2Client() (apiv2client.APIV2Interface, error) {
 		return nil, errors.Trace(err)
 	}
 	log.Info(serverAddr)
-	client, err := apiv1client.NewAPIClient(serverAddr, f.clientGetter.GetCredential())
+	client, err := apiv1client.NewAPIClient(serverAddr, f.clientGetter.GetCredential(),
+		f.clientGetter.GetUser(), f.clientGetter.GetPassword())
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	if err := checkCDCVersion(client); err != nil {
 		return nil, errors.Trace(err)
 	}
-	return apiv2client.NewAPIClient(serverAddr, f.clientGetter.GetCredential())
+	return apiv2client.NewAPIClient(serverAddr, f.clientGetter.GetCredential(),
+		f.clientGetter.GetUser(), f.clientGetter.GetPassword())
 }
 
 // findServerAddr find the cdc server address by the following logic
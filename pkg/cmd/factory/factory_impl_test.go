@@ -15,6 +15,9 @@ package factory
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -117,3 +120,43 @@ func TestCheckCDCVersion(t *testing.T) {
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "the cluster version is too old")
 }
+
+func TestFactoryImplAPIClientBasicAuth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmdcontext.SetDefaultContext(ctx)
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "root" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/status":
+			_ = json.NewEncoder(w).Encode(&model.ServerStatus{Version: "v6.3.0"})
+		default:
+			_, _ = w.Write([]byte("[]"))
+		}
+	}))
+	defer server.Close()
+
+	c := mock_factory.NewMockClientGetter(gomock.NewController(t))
+	c.EXPECT().GetPdAddr().Return("").AnyTimes()
+	c.EXPECT().GetServerAddr().Return(server.URL).AnyTimes()
+	c.EXPECT().GetCredential().Return(&security.Credential{}).AnyTimes()
+	c.EXPECT().GetUser().Return("root").AnyTimes()
+	c.EXPECT().GetPassword().Return("secret").AnyTimes()
+	f := NewFactory(c)
+
+	_, err := f.APIV1Client()
+	require.Nil(t, err)
+	client, err := f.APIV2Client()
+	require.Nil(t, err)
+	_, err = client.Captures().List(ctx)
+	require.Nil(t, err)
+	require.Equal(t, []string{"/api/v1/status", "/api/v1/status", "/api/v2/captures"}, paths)
+}
@@ -113,6 +113,20 @@ func (mr *MockFactoryMockRecorder) GetLogLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogLevel", reflect.TypeOf((*MockFactory)(nil).GetLogLevel))
 }
 
+// GetPassword mocks base method.
+func (m *MockFactory) GetPassword() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassword")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPassword indicates an expected call of GetPassword.
+func (mr *MockFactoryMockRecorder) GetPassword() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassword", reflect.TypeOf((*MockFactory)(nil).GetPassword))
+}
+
 // GetPdAddr mocks base method.
 func (m *MockFactory) GetPdAddr() string {
 	m.ctrl.T.Helper()
@@ -141,6 +155,20 @@ func (mr *MockFactoryMockRecorder) GetServerAddr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerAddr", reflect.TypeOf((*MockFactory)(nil).GetServerAddr))
 }
 
+// GetUser mocks base method.
+func (m *MockFactory) GetUser() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockFactoryMockRecorder) GetUser() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockFactory)(nil).GetUser))
+}
+
 // PdClient mocks base method.
 func (m *MockFactory) PdClient() (pd.Client, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +265,20 @@ func (mr *MockClientGetterMockRecorder) GetLogLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogLevel", reflect.TypeOf((*MockClientGetter)(nil).GetLogLevel))
 }
 
+// GetPassword mocks base method.
+func (m *MockClientGetter) GetPassword() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassword")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPassword indicates an expected call of GetPassword.
+func (mr *MockClientGetterMockRecorder) GetPassword() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassword", reflect.TypeOf((*MockClientGetter)(nil).GetPassword))
+}
+
 // GetPdAddr mocks base method.
 func (m *MockClientGetter) GetPdAddr() string {
 	m.ctrl.T.Helper()
@@ -265,6 +307,20 @@ func (mr *MockClientGetterMockRecorder) GetServerAddr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerAddr", reflect.TypeOf((*MockClientGetter)(nil).GetServerAddr))
 }
 
+// GetUser mocks base method.
+func (m *MockClientGetter) GetUser() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockClientGetterMockRecorder) GetUser() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockClientGetter)(nil).GetUser))
+}
+
 // ToGRPCDialOption mocks base method.
 func (m *MockClientGetter) ToGRPCDialOption() (grpc.DialOption, error) {
 	m.ctrl.T.Helper()
@@ -16,13 +16,16 @@ package redo
 import (
 	"github.com/pingcap/tiflow/pkg/applier"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/spf13/cobra"
 )
 
 // applyRedoOptions defines flags for the `redo apply` command.
 type applyRedoOptions struct {
 	options
-	sinkURI string
+	sinkURI    string
+	configFile string
 }
 
 // newapplyRedoOptions creates new applyRedoOptions for the `redo apply` command.
@@ -33,7 +36,8 @@ func newapplyRedoOptions() *applyRedoOptions {
 // addFlags receives a *cobra.Command reference and binds
 // flags related to template printing to it.
 func (o *applyRedoOptions) addFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "", "target database sink-uri")
+	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "", "target sink-uri, any sink supported by changefeeds can be used")
+	cmd.Flags().StringVar(&o.configFile, "config", "", "path of the changefeed configuration file, whose sink configurations such as dispatch rules are used")
 	// the possible error returned from MarkFlagRequired is `no such flag`
 	cmd.MarkFlagRequired("sink-uri") //nolint:errcheck
 }
@@ -46,6 +50,15 @@ func (o *applyRedoOptions) run(cmd *cobra.Command) error {
 		Storage: o.storage,
 		SinkURI: o.sinkURI,
 		Dir:     o.dir,
+
+		EncryptionKeyFile: o.encryptionKeyFile,
+	}
+	if o.configFile != "" {
+		replicaConfig := config.GetDefaultReplicaConfig()
+		if err := util.StrictDecodeFile(o.configFile, "cdc redo", replicaConfig); err != nil {
+			return err
+		}
+		cfg.ReplicaConfig = replicaConfig
 	}
 	ap := applier.NewRedoApplier(cfg)
 	err := ap.Apply(ctx)
@@ -21,9 +21,10 @@ import (
 
 // options defines flags for the `redo` command.
 type options struct {
-	storage  string
-	dir      string
-	logLevel string
+	storage           string
+	dir               string
+	logLevel          string
+	encryptionKeyFile string
 }
 
 // newOptions creates new options for the `server` command.
@@ -37,6 +38,7 @@ func (o *options) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVar(&o.storage, "storage", "", "storage of redo log, specify the url where backup redo logs will store, eg, \"s3://bucket/path/prefix\"")
 	cmd.PersistentFlags().StringVar(&o.dir, "tmp-dir", "", "temporary path used to download redo log with S3 backend")
 	cmd.PersistentFlags().StringVar(&o.logLevel, "log-level", "info", "log level (etc: debug|info|warn|error)")
+	cmd.PersistentFlags().StringVar(&o.encryptionKeyFile, "encryption-key-file", "", "path of the file which contains the key to decrypt encrypted redo logs")
 	// the possible error returned from MarkFlagRequired is `no such flag`
 	cmd.MarkFlagRequired("storage") //nolint:errcheck
 }
@@ -70,6 +72,7 @@ func NewCmdRedo() *cobra.Command {
 	// Add subcommands.
 	cmds.AddCommand(newCmdApply(o))
 	cmds.AddCommand(newCmdMeta(o))
+	cmds.AddCommand(newCmdDump(o))
 
 	return cmds
 }
@@ -168,6 +168,7 @@ func TestParseCfg(t *testing.T) {
 			KeyPath:       "cc",
 			CertAllowedCN: []string{"dd", "ee"},
 		},
+		Auth:                &config.AuthConfig{},
 		PerTableMemoryQuota: config.DefaultTableMemoryQuota,
 		KVClient: &config.KVClientConfig{
 			WorkerConcurrent:    8,
@@ -325,6 +326,7 @@ check-balance-interval = "10s"
 			SortDir:                config.DefaultSortDir,
 		},
 		Security:            &config.SecurityConfig{},
+		Auth:                &config.AuthConfig{},
 		PerTableMemoryQuota: config.DefaultTableMemoryQuota,
 		KVClient: &config.KVClientConfig{
 			WorkerConcurrent:    8,
@@ -472,6 +474,7 @@ cert-allowed-cn = ["dd","ee"]
 			KeyPath:       "cc",
 			CertAllowedCN: []string{"dd", "ee"},
 		},
+		Auth:                &config.AuthConfig{},
 		PerTableMemoryQuota: config.DefaultTableMemoryQuota,
 		KVClient: &config.KVClientConfig{
 			WorkerConcurrent:    8,
@@ -193,7 +193,13 @@ func TestAndWriteExampleReplicaTOML(t *testing.T) {
 			{Matcher: []string{"test1.*", "test2.*"}, Columns: []string{"column1", "column2"}},
 			{Matcher: []string{"test3.*", "test4.*"}, Columns: []string{"!a", "column3"}},
 		},
-		Protocol: "open-protocol",
+		Protocol:      "open-protocol",
+		DateSeparator: "day",
+		CSVConfig: &config.CSVConfig{
+			Delimiter:  ",",
+			Quote:      "\"",
+			NullString: "\\N",
+		},
 	}, cfg.Sink)
 }
 
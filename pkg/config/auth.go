@@ -0,0 +1,112 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// Roles of the clients of the HTTP APIs.
+const (
+	// AuthRoleViewer can only query the cluster.
+	AuthRoleViewer = "viewer"
+	// AuthRoleOperator can query the cluster and manage changefeeds and captures.
+	AuthRoleOperator = "operator"
+	// AuthRoleAdmin can do everything, including calling the unsafe APIs.
+	AuthRoleAdmin = "admin"
+)
+
+// Providers to check the user name and password of a basic auth request.
+const (
+	// BasicAuthProviderNone disables basic auth.
+	BasicAuthProviderNone = "none"
+	// BasicAuthProviderHtpasswd checks passwords against a local htpasswd file.
+	BasicAuthProviderHtpasswd = "htpasswd"
+	// BasicAuthProviderTiDB checks passwords by logging in to a TiDB server.
+	BasicAuthProviderTiDB = "tidb"
+)
+
+// AuthConfig represents the authentication and authorization config of the
+// HTTP APIs.
+type AuthConfig struct {
+	// Enable authentication and authorization. If it's disabled, every client
+	// is treated as an admin.
+	Enable bool `toml:"enable" json:"enable"`
+	// BasicAuthProvider is one of "none", "htpasswd" and "tidb".
+	BasicAuthProvider string `toml:"basic-auth-provider" json:"basic-auth-provider"`
+	// HtpasswdFile is the htpasswd file used by the htpasswd provider.
+	// Only bcrypt and SHA1 passwords are supported.
+	HtpasswdFile string `toml:"htpasswd-file" json:"htpasswd-file"`
+	// TiDBAddr is the address of the TiDB server used by the tidb provider.
+	TiDBAddr string `toml:"tidb-addr" json:"tidb-addr"`
+	// CertIdentities maps the common names of TLS client certificates to
+	// user names. A common name not in the map is used as the user name.
+	CertIdentities map[string]string `toml:"cert-identities" json:"cert-identities"`
+	// UserRoles maps user names to roles.
+	UserRoles map[string]string `toml:"user-roles" json:"user-roles"`
+	// DefaultRole is the role of authenticated users not in UserRoles.
+	// Empty means these users are denied.
+	DefaultRole string `toml:"default-role" json:"default-role"`
+	// ForwardSecretFile is a file holding a secret shared by all captures of
+	// the cluster. It's used to sign the user of a request forwarded to the
+	// owner. If it's empty, the owner doesn't trust forwarded users, and a
+	// forwarded request is authenticated as the capture that forwards it.
+	ForwardSecretFile string `toml:"forward-secret-file" json:"forward-secret-file"`
+}
+
+// IsValidAuthRole checks whether the role is a known role.
+func IsValidAuthRole(role string) bool {
+	switch role {
+	case AuthRoleViewer, AuthRoleOperator, AuthRoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateAndAdjust validates and adjusts the auth configuration
+func (c *AuthConfig) ValidateAndAdjust() error {
+	if !c.Enable {
+		return nil
+	}
+	switch c.BasicAuthProvider {
+	case "":
+		c.BasicAuthProvider = BasicAuthProviderNone
+	case BasicAuthProviderNone:
+	case BasicAuthProviderHtpasswd:
+		if c.HtpasswdFile == "" {
+			return errors.ErrInvalidServerOption.GenWithStack(
+				"htpasswd-file must be set if basic-auth-provider is htpasswd")
+		}
+	case BasicAuthProviderTiDB:
+		if c.TiDBAddr == "" {
+			return errors.ErrInvalidServerOption.GenWithStack(
+				"tidb-addr must be set if basic-auth-provider is tidb")
+		}
+	default:
+		return errors.ErrInvalidServerOption.GenWithStack(
+			"unknown basic-auth-provider %s", c.BasicAuthProvider)
+	}
+	for user, role := range c.UserRoles {
+		if !IsValidAuthRole(role) {
+			return errors.ErrInvalidServerOption.GenWithStack(
+				"unknown role %s of user %s", role, user)
+		}
+	}
+	if c.DefaultRole != "" && !IsValidAuthRole(c.DefaultRole) {
+		return errors.ErrInvalidServerOption.GenWithStack(
+			"unknown default-role %s", c.DefaultRole)
+	}
+	return nil
+}
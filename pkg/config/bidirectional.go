@@ -0,0 +1,78 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+)
+
+// BidirectionalConfig represents the config of a changefeed which is one
+// direction of a bidirectional replication between two TiDB clusters.
+//
+// The MySQL sink marks every transaction it writes to the downstream with the
+// ReplicationID, and the changefeed of the reverse direction drops the
+// transactions marked with any of its FilterReplicationIDs, so that changes
+// are not replicated back to where they come from.
+type BidirectionalConfig struct {
+	// ReplicationID identifies the direction of the changefeed.
+	ReplicationID uint64 `toml:"replication-id" json:"replication-id"`
+	// FilterReplicationIDs are the replication IDs of the changefeeds whose
+	// writes to the upstream must not be replicated, usually the ID of the
+	// reverse direction.
+	FilterReplicationIDs []uint64 `toml:"filter-replication-ids" json:"filter-replication-ids"`
+	// SyncDDL is whether DDLs are replicated by the changefeed. DDLs can only
+	// be replicated in one direction, so it must be true for one direction
+	// and false for the other.
+	SyncDDL bool `toml:"sync-ddl" json:"sync-ddl"`
+}
+
+// ShouldFilter returns true if the transactions marked with the replication
+// ID must not be replicated.
+func (c *BidirectionalConfig) ShouldFilter(replicationID uint64) bool {
+	for _, id := range c.FilterReplicationIDs {
+		if id == replicationID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *BidirectionalConfig) validateAndAdjust(sinkURI *url.URL, sinkConfig *SinkConfig) error {
+	if c.ReplicationID == 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"bidirectional.replication-id must be greater than 0")
+	}
+	if c.ShouldFilter(c.ReplicationID) {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("bidirectional.filter-replication-ids must not contain "+
+				"the replication-id %d of the changefeed itself", c.ReplicationID))
+	}
+	if sinkURI != nil && !sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("bidirectional replication is only supported by MySQL "+
+				"compatible sinks, but got %s", sinkURI.Scheme))
+	}
+	// The transaction and its mark are written to the downstream atomically,
+	// and filtered as a whole, so transactions must not be split.
+	if sinkURI != nil && sinkConfig != nil && sinkConfig.TxnAtomicity != tableTxnAtomicity {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("bidirectional replication requires the transaction-atomicity "+
+				"to be %s", tableTxnAtomicity))
+	}
+	return nil
+}
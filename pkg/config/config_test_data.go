@@ -55,7 +55,9 @@ const (
     "level": "none",
     "max-log-size": 64,
     "flush-interval": 2000,
-    "storage": ""
+    "storage": "",
+    "compression": "",
+    "encryption-key-file": ""
   }
 }`
 
@@ -92,6 +94,16 @@ const (
     "key-path": "",
     "cert-allowed-cn": null
   },
+  "auth": {
+    "enable": false,
+    "basic-auth-provider": "",
+    "htpasswd-file": "",
+    "tidb-addr": "",
+    "cert-identities": null,
+    "user-roles": null,
+    "default-role": "",
+    "forward-secret-file": ""
+  },
   "per-table-memory-quota": 10485760,
   "kv-client": {
     "worker-concurrent": 8,
@@ -179,8 +191,15 @@ const (
     "level": "none",
     "max-log-size": 64,
     "flush-interval": 2000,
-    "storage": ""
-  }
+    "storage": "",
+    "compression": "",
+    "encryption-key-file": ""
+  },
+  "verification": null,
+  "scheduler": null,
+  "throttle": null,
+  "bidirectional": null,
+  "emit-virtual-generated-columns": false
 }`
 
 	testCfgTestReplicaConfigMarshal2 = `{
@@ -219,7 +238,9 @@ const (
     "level": "none",
     "max-log-size": 64,
     "flush-interval": 2000,
-    "storage": ""
+    "storage": "",
+    "compression": "",
+    "encryption-key-file": ""
   }
 }`
 )
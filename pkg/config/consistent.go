@@ -13,10 +13,41 @@
 
 package config
 
+import (
+	"fmt"
+	"path/filepath"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
 // ConsistentConfig represents replication consistency config for a changefeed
 type ConsistentConfig struct {
 	Level             string `toml:"level" json:"level"`
 	MaxLogSize        int64  `toml:"max-log-size" json:"max-log-size"`
 	FlushIntervalInMs int64  `toml:"flush-interval" json:"flush-interval"`
 	Storage           string `toml:"storage" json:"storage"`
+	// Compression is the compression algorithm of redo log files, it can be
+	// none, lz4 or zstd.
+	Compression string `toml:"compression" json:"compression"`
+	// EncryptionKeyFile is the path of a local file which contains the AES key
+	// used to encrypt redo log files. Redo logs are not encrypted if it's empty.
+	EncryptionKeyFile string `toml:"encryption-key-file" json:"encryption-key-file"`
+}
+
+func (c *ConsistentConfig) validateAndAdjust() error {
+	switch c.Compression {
+	case "", "none", "lz4", "zstd":
+	default:
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("unsupported redo log compression %q, "+
+				"it can be none, lz4 or zstd", c.Compression))
+	}
+	// The key file is read by every capture, a relative path depends on
+	// the working directory of the capture.
+	if c.EncryptionKeyFile != "" && !filepath.IsAbs(c.EncryptionKeyFile) {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("redo log encryption-key-file %q must be an absolute path",
+				c.EncryptionKeyFile))
+	}
+	return nil
 }
@@ -87,6 +87,19 @@ type replicaConfig struct {
 	Mounter            *MounterConfig    `toml:"mounter" json:"mounter"`
 	Sink               *SinkConfig       `toml:"sink" json:"sink"`
 	Consistent         *ConsistentConfig `toml:"consistent" json:"consistent"`
+	// Verification is nil if the changefeed has never been configured to be verified.
+	Verification *VerificationConfig `toml:"verification" json:"verification"`
+	// Scheduler is nil if tables of the changefeed are never split.
+	Scheduler *ChangefeedSchedulerConfig `toml:"scheduler" json:"scheduler"`
+	// Throttle is nil if the throughput of the changefeed is never limited.
+	Throttle *ThrottleConfig `toml:"throttle" json:"throttle"`
+	// Bidirectional is nil if the changefeed is not a part of a bidirectional
+	// replication.
+	Bidirectional *BidirectionalConfig `toml:"bidirectional" json:"bidirectional"`
+	// EmitVirtualGeneratedColumns is whether the values of virtual generated
+	// columns are evaluated and emitted in row changed events. The MySQL sink
+	// never writes them to the downstream.
+	EmitVirtualGeneratedColumns bool `toml:"emit-virtual-generated-columns" json:"emit-virtual-generated-columns"`
 }
 
 // Marshal returns the json marshal format of a ReplicationConfig
@@ -170,6 +183,31 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
 						minSyncPointRetention.String()))
 		}
 	}
+	if c.Consistent != nil {
+		if err := c.Consistent.validateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	if c.Verification != nil {
+		if err := c.Verification.validateAndAdjust(c.EnableSyncPoint); err != nil {
+			return err
+		}
+	}
+	if c.Scheduler != nil {
+		if err := c.Scheduler.validateAndAdjust(sinkURI, c.Sink, c.Consistent); err != nil {
+			return err
+		}
+	}
+	if c.Throttle != nil {
+		if err := c.Throttle.validateAndAdjust(c.Sink); err != nil {
+			return err
+		}
+	}
+	if c.Bidirectional != nil {
+		if err := c.Bidirectional.validateAndAdjust(sinkURI, c.Sink); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -16,9 +16,11 @@ package config
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/BurntSushi/toml"
 	"github.com/stretchr/testify/require"
 )
 
@@ -135,3 +137,179 @@ func TestValidateAndAdjust(t *testing.T) {
 	cfg.SyncPointRetention = time.Minute * 10
 	require.Error(t, cfg.ValidateAndAdjust(nil))
 }
+
+func TestValidateAndAdjustVerification(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	cfg.Verification = &VerificationConfig{}
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.Verification.ChunkSize = -1
+	require.Regexp(t, ".*chunk-size.*must not be negative.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Verification.ChunkSize = 1000
+	cfg.Verification.Interval = time.Hour
+	require.Regexp(t, ".*requires the sync point to be enabled.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.EnableSyncPoint = true
+	require.Regexp(t, ".*requires the upstream-uri.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Verification.UpstreamURI = "mysql://root@127.0.0.1:4000/"
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.Verification.Interval = time.Minute
+	require.Regexp(t, ".*must be larger than.*", cfg.ValidateAndAdjust(nil))
+}
+
+func TestValidateAndAdjustScheduler(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	cfg.Scheduler = &ChangefeedSchedulerConfig{}
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.Scheduler.RegionThreshold = -1
+	require.Regexp(t, ".*must not be negative.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Scheduler.RegionThreshold = 0
+	cfg.Scheduler.EnableTableAcrossNodes = true
+	require.Regexp(t, ".*requires region-threshold or write-key-threshold.*",
+		cfg.ValidateAndAdjust(nil))
+
+	cfg.Scheduler.RegionThreshold = 100
+	sinkURI, err := url.Parse("mysql://127.0.0.1:3306/?transaction-atomicity=table")
+	require.NoError(t, err)
+	require.Regexp(t, ".*requires transaction-atomicity=none.*",
+		cfg.ValidateAndAdjust(sinkURI))
+
+	// The transactional producers of the captures have different
+	// transactional IDs, so they can send the spans of a table.
+	sinkURI, err = url.Parse("kafka://127.0.0.1:9092/topic?protocol=open-protocol" +
+		"&enable-transactional=true")
+	require.NoError(t, err)
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURI))
+
+	sinkURI, err = url.Parse("s3://bucket/prefix?protocol=csv&transaction-atomicity=none")
+	require.NoError(t, err)
+	require.Regexp(t, ".*can not be used with the cloud storage sink.*",
+		cfg.ValidateAndAdjust(sinkURI))
+
+	sinkURI, err = url.Parse("kafka://127.0.0.1:9092/topic?protocol=open-protocol")
+	require.NoError(t, err)
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURI))
+
+	cfg.Consistent.Level = "eventual"
+	require.Regexp(t, ".*can not be used with the redo log.*",
+		cfg.ValidateAndAdjust(sinkURI))
+}
+
+func TestValidateAndAdjustConsistent(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	cfg.Consistent.Compression = "lz4"
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.Consistent.Compression = "gzip"
+	require.Regexp(t, ".*unsupported redo log compression.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Consistent.Compression = "zstd"
+	cfg.Consistent.EncryptionKeyFile = "redo.key"
+	require.Regexp(t, ".*must be an absolute path.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Consistent.EncryptionKeyFile = "/etc/ticdc/redo.key"
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+}
+
+func TestValidateAndAdjustThrottle(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	cfg.Throttle = &ThrottleConfig{}
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.Throttle.RowsPerSecond = -1
+	require.Regexp(t, ".*must not be negative.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Throttle.RowsPerSecond = 1000
+	cfg.Throttle.Schedule = []*ThrottleWindow{{Start: "9:00", End: "25:00"}}
+	require.Regexp(t, ".*invalid throttle schedule time.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Throttle.Schedule[0].End = "18:00"
+	cfg.Throttle.Schedule[0].MaxConnections = -1
+	require.Regexp(t, ".*must not be negative.*", cfg.ValidateAndAdjust(nil))
+
+	cfg.Throttle.Schedule[0].MaxConnections = 4
+	require.NoError(t, cfg.ValidateAndAdjust(nil))
+
+	cfg.Sink.TxnAtomicity = globalTxnAtomicity
+	require.Regexp(t, ".*throttle can not be used with the transaction-atomicity.*",
+		cfg.ValidateAndAdjust(nil))
+}
+
+func TestThrottleLimitsAt(t *testing.T) {
+	t.Parallel()
+	cfg := &ThrottleConfig{
+		ThrottleLimits: ThrottleLimits{RowsPerSecond: 1000},
+		Schedule: []*ThrottleWindow{
+			{Start: "09:00", End: "18:00", ThrottleLimits: ThrottleLimits{RowsPerSecond: 10}},
+			{Start: "22:00", End: "02:00", ThrottleLimits: ThrottleLimits{BytesPerSecond: 20}},
+		},
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2022, 10, 1, hour, minute, 0, 0, time.Local)
+	}
+	require.Equal(t, ThrottleLimits{RowsPerSecond: 1000}, cfg.LimitsAt(at(8, 59)))
+	require.Equal(t, ThrottleLimits{RowsPerSecond: 10}, cfg.LimitsAt(at(9, 0)))
+	require.Equal(t, ThrottleLimits{RowsPerSecond: 10}, cfg.LimitsAt(at(17, 59)))
+	require.Equal(t, ThrottleLimits{RowsPerSecond: 1000}, cfg.LimitsAt(at(18, 0)))
+	require.Equal(t, ThrottleLimits{BytesPerSecond: 20}, cfg.LimitsAt(at(23, 0)))
+	require.Equal(t, ThrottleLimits{BytesPerSecond: 20}, cfg.LimitsAt(at(1, 59)))
+	require.Equal(t, ThrottleLimits{RowsPerSecond: 1000}, cfg.LimitsAt(at(2, 0)))
+
+	// The config can be decoded from toml.
+	cfg = &ThrottleConfig{}
+	_, err := toml.Decode(`
+rows-per-second = 100
+max-connections = 8
+[[schedule]]
+start = "09:00"
+end = "18:00"
+bytes-per-second = 1024
+`, cfg)
+	require.NoError(t, err)
+	require.Equal(t, &ThrottleConfig{
+		ThrottleLimits: ThrottleLimits{RowsPerSecond: 100, MaxConnections: 8},
+		Schedule: []*ThrottleWindow{{
+			Start: "09:00", End: "18:00",
+			ThrottleLimits: ThrottleLimits{BytesPerSecond: 1024},
+		}},
+	}, cfg)
+}
+
+func TestValidateAndAdjustBidirectional(t *testing.T) {
+	t.Parallel()
+	cfg := GetDefaultReplicaConfig()
+	cfg.Bidirectional = &BidirectionalConfig{}
+	sinkURI, err := url.Parse("mysql://127.0.0.1:3306")
+	require.NoError(t, err)
+	require.Regexp(t, ".*replication-id must be greater than 0.*",
+		cfg.ValidateAndAdjust(sinkURI))
+
+	cfg.Bidirectional.ReplicationID = 1
+	cfg.Bidirectional.FilterReplicationIDs = []uint64{1, 2}
+	require.Regexp(t, ".*must not contain the replication-id 1.*",
+		cfg.ValidateAndAdjust(sinkURI))
+
+	cfg.Bidirectional.FilterReplicationIDs = []uint64{2}
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURI))
+	require.True(t, cfg.Bidirectional.ShouldFilter(2))
+	require.False(t, cfg.Bidirectional.ShouldFilter(1))
+
+	sinkURI, err = url.Parse("mysql://127.0.0.1:3306/?transaction-atomicity=none")
+	require.NoError(t, err)
+	require.Regexp(t, ".*requires the transaction-atomicity to be table.*",
+		cfg.ValidateAndAdjust(sinkURI))
+
+	sinkURI, err = url.Parse("kafka://127.0.0.1:9092/test?protocol=canal-json")
+	require.NoError(t, err)
+	require.Regexp(t, ".*only supported by MySQL compatible sinks.*",
+		cfg.ValidateAndAdjust(sinkURI))
+}
@@ -14,9 +14,11 @@
 package config
 
 import (
+	"net/url"
 	"time"
 
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
 )
 
 // SchedulerConfig configs TiCDC scheduler.
@@ -70,3 +72,53 @@ func (c *SchedulerConfig) ValidateAndAdjust() error {
 
 	return nil
 }
+
+// ChangefeedSchedulerConfig is the scheduler config of a changefeed.
+// Tables can be split into spans and replicated by multiple captures,
+// which requires transactions to be split, i.e. transaction-atomicity=none,
+// and the redo log to be disabled. It can not be used with the cloud storage
+// sink either.
+type ChangefeedSchedulerConfig struct {
+	// EnableTableAcrossNodes enables splitting tables into spans.
+	EnableTableAcrossNodes bool `toml:"enable-table-across-nodes" json:"enable-table-across-nodes"`
+	// RegionThreshold is the number of regions above which a table is split,
+	// 0 means tables are not split by region count.
+	RegionThreshold int `toml:"region-threshold" json:"region-threshold"`
+	// WriteKeyThreshold is the number of keys written per minute above which
+	// a table is split, 0 means tables are not split by write rate.
+	WriteKeyThreshold int `toml:"write-key-threshold" json:"write-key-threshold"`
+}
+
+func (c *ChangefeedSchedulerConfig) validateAndAdjust(
+	sinkURI *url.URL, sinkConfig *SinkConfig, consistent *ConsistentConfig,
+) error {
+	if c.RegionThreshold < 0 || c.WriteKeyThreshold < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"scheduler region-threshold and write-key-threshold must not be negative")
+	}
+	if !c.EnableTableAcrossNodes {
+		return nil
+	}
+	if c.RegionThreshold == 0 && c.WriteKeyThreshold == 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"scheduler enable-table-across-nodes requires " +
+				"region-threshold or write-key-threshold to be set")
+	}
+	if sinkConfig == nil || !sinkConfig.TxnAtomicity.ShouldSplitTxn() {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"scheduler enable-table-across-nodes requires transaction-atomicity=none")
+	}
+	if consistent != nil && consistent.Level != "" && consistent.Level != "none" {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"scheduler enable-table-across-nodes can not be used with the redo log")
+	}
+	// The cloud storage sink numbers the data files of a table by itself, the
+	// sinks of the spans of a table on different captures would overwrite the
+	// files of each other.
+	if sinkURI != nil && sink.IsStorageScheme(sinkURI.Scheme) {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"scheduler enable-table-across-nodes can not be used with " +
+				"the cloud storage sink")
+	}
+	return nil
+}
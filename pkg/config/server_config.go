@@ -112,6 +112,7 @@ var defaultServerConfig = &ServerConfig{
 		SortDir:                DefaultSortDir,
 	},
 	Security:            &SecurityConfig{},
+	Auth:                &AuthConfig{},
 	PerTableMemoryQuota: DefaultTableMemoryQuota,
 	KVClient: &KVClientConfig{
 		WorkerConcurrent: 8,
@@ -174,6 +175,7 @@ type ServerConfig struct {
 
 	Sorter              *SorterConfig   `toml:"sorter" json:"sorter"`
 	Security            *SecurityConfig `toml:"security" json:"security"`
+	Auth                *AuthConfig     `toml:"auth" json:"auth"`
 	PerTableMemoryQuota uint64          `toml:"per-table-memory-quota" json:"per-table-memory-quota"`
 	KVClient            *KVClientConfig `toml:"kv-client" json:"kv-client"`
 	Debug               *DebugConfig    `toml:"debug" json:"debug"`
@@ -266,6 +268,19 @@ func (c *ServerConfig) ValidateAndAdjust() error {
 	}
 
 	defaultCfg := GetDefaultServerConfig()
+	if c.Auth == nil {
+		c.Auth = defaultCfg.Auth
+	}
+	if err := c.Auth.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
+	// Client certificates are only verified if cert-allowed-cn is set.
+	if c.Auth.Enable && c.Auth.BasicAuthProvider == BasicAuthProviderNone &&
+		(c.Security == nil || !c.Security.IsTLSEnabled() || len(c.Security.CertAllowedCN) == 0) {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"auth is enabled but neither client certificate nor basic auth is configured")
+	}
+
 	if c.Sorter == nil {
 		c.Sorter = defaultCfg.Sorter
 	}
@@ -108,6 +108,45 @@ func TestKVClientConfigValidateAndAdjust(t *testing.T) {
 	require.Error(t, conf.ValidateAndAdjust())
 }
 
+func TestAuthConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().Auth
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	conf.Enable = true
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.Equal(t, BasicAuthProviderNone, conf.BasicAuthProvider)
+	conf.BasicAuthProvider = BasicAuthProviderHtpasswd
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.HtpasswdFile = "/tmp/htpasswd"
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.BasicAuthProvider = BasicAuthProviderTiDB
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.TiDBAddr = "127.0.0.1:4000"
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.BasicAuthProvider = "ldap"
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.BasicAuthProvider = BasicAuthProviderTiDB
+
+	conf.UserRoles = map[string]string{"root": AuthRoleAdmin, "bob": "root"}
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.UserRoles["bob"] = AuthRoleOperator
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.DefaultRole = "guest"
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.DefaultRole = AuthRoleViewer
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	// Auth needs either client certificates or basic auth.
+	serverConf := GetDefaultServerConfig().Clone()
+	serverConf.Auth.Enable = true
+	require.Regexp(t, "neither client certificate nor basic auth",
+		serverConf.ValidateAndAdjust())
+	serverConf.Auth.BasicAuthProvider = BasicAuthProviderTiDB
+	serverConf.Auth.TiDBAddr = "127.0.0.1:4000"
+	require.Nil(t, serverConf.ValidateAndAdjust())
+}
+
 func TestSchedulerConfigValidateAndAdjust(t *testing.T) {
 	t.Parallel()
 	conf := GetDefaultServerConfig().Clone().Debug.Scheduler
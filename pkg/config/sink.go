@@ -16,6 +16,7 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -24,8 +25,19 @@ import (
 	"go.uber.org/zap"
 )
 
-// DefaultMaxMessageBytes sets the default value for max-message-bytes.
-const DefaultMaxMessageBytes = 10 * 1024 * 1024 // 10M
+const (
+	// DefaultMaxMessageBytes sets the default value for max-message-bytes.
+	DefaultMaxMessageBytes = 10 * 1024 * 1024 // 10M
+
+	// DefaultGlobalTxnMemoryQuota is the default memory quota of the global
+	// transaction coordinator.
+	DefaultGlobalTxnMemoryQuota = 1024 * 1024 * 1024 // 1G
+
+	// CR is carriage return.
+	CR = '\r'
+	// LF is line feed.
+	LF = '\n'
+)
 
 // AtomicityLevel represents the atomicity level of a changefeed.
 type AtomicityLevel string
@@ -41,24 +53,85 @@ const (
 	// tableTxnAtomicity means atomicity of single table transactions is guaranteed.
 	tableTxnAtomicity AtomicityLevel = "table"
 
-	// globalTxnAtomicity means atomicity of cross table transactions is guaranteed, which
-	// is currently not supported by TiCDC.
-	// globalTxnAtomicity AtomicityLevel = "global"
+	// globalTxnAtomicity means atomicity of cross table transactions is guaranteed.
+	// It's only supported by MySQL compatible sinks.
+	globalTxnAtomicity AtomicityLevel = "global"
 
 	defaultMqTxnAtomicity    = noneTxnAtomicity
 	defaultMysqlTxnAtomicity = tableTxnAtomicity
 )
 
+// GetGlobalTxnMemoryQuota returns the memory quota of the global transaction
+// coordinator.
+func (s *SinkConfig) GetGlobalTxnMemoryQuota() uint64 {
+	if s.GlobalTxnMemoryQuota == 0 {
+		return DefaultGlobalTxnMemoryQuota
+	}
+	return s.GlobalTxnMemoryQuota
+}
+
 // ShouldSplitTxn returns whether the sink should split txn.
 func (l AtomicityLevel) ShouldSplitTxn() bool {
 	return l == noneTxnAtomicity
 }
 
+// IsGlobal returns whether transactions across tables should be written
+// to the downstream atomically.
+func (l AtomicityLevel) IsGlobal() bool {
+	return l == globalTxnAtomicity
+}
+
 // ForceEnableOldValueProtocols specifies which protocols need to be forced to enable old value.
 var ForceEnableOldValueProtocols = []string{
 	ProtocolCanal.String(),
 	ProtocolCanalJSON.String(),
 	ProtocolMaxwell.String(),
+	ProtocolDebezium.String(),
+}
+
+// DateSeparator specifies the date separator in storage destination path.
+type DateSeparator int
+
+// Enum types of DateSeparator
+const (
+	DateSeparatorNone DateSeparator = iota
+	DateSeparatorYear
+	DateSeparatorMonth
+	DateSeparatorDay
+)
+
+// FromString converts the separator from string to DateSeparator enum type.
+func (d *DateSeparator) FromString(separator string) error {
+	switch strings.ToLower(separator) {
+	case "none":
+		*d = DateSeparatorNone
+	case "year":
+		*d = DateSeparatorYear
+	case "month":
+		*d = DateSeparatorMonth
+	case "day":
+		*d = DateSeparatorDay
+	default:
+		return cerror.ErrStorageSinkInvalidDateSeparator.GenWithStackByArgs(separator)
+	}
+
+	return nil
+}
+
+// String converts the DateSeparator enum type to string.
+func (d DateSeparator) String() string {
+	switch d {
+	case DateSeparatorNone:
+		return "none"
+	case DateSeparatorYear:
+		return "year"
+	case DateSeparatorMonth:
+		return "month"
+	case DateSeparatorDay:
+		return "day"
+	default:
+		return "unknown"
+	}
 }
 
 // SinkConfig represents sink config for a changefeed
@@ -68,6 +141,73 @@ type SinkConfig struct {
 	ColumnSelectors []*ColumnSelector `toml:"column-selectors" json:"column-selectors"`
 	SchemaRegistry  string            `toml:"schema-registry" json:"schema-registry"`
 	TxnAtomicity    AtomicityLevel    `toml:"transaction-atomicity" json:"transaction-atomicity"`
+	// ClaimCheckStorageURI is the external storage, e.g. s3://bucket/prefix or
+	// file:///path, to offload the messages larger than max-message-bytes to.
+	// Only the MQ downstreams support it, a reference to the offloaded message
+	// is sent to the MQ instead.
+	ClaimCheckStorageURI string `toml:"claim-check-storage-uri" json:"claim-check-storage-uri,omitempty"`
+	// SchemaChangeTopic is the topic which the DDL events are sent to, like
+	// the schema change topic of Debezium. Only the MQ downstreams with the
+	// debezium protocol support it, the DDL events are sent to the topics of
+	// the tables if it's empty.
+	SchemaChangeTopic string `toml:"schema-change-topic" json:"schema-change-topic,omitempty"`
+	// GlobalTxnMemoryQuota limits the memory used by the owner to buffer rows
+	// when the transaction atomicity is global. The tables ahead of others are
+	// throttled once it's exceeded. Zero means DefaultGlobalTxnMemoryQuota.
+	GlobalTxnMemoryQuota uint64 `toml:"global-txn-memory-quota" json:"global-txn-memory-quota,omitempty"`
+	// CSVConfig and DateSeparator are only available when the downstream is storage.
+	CSVConfig     *CSVConfig `toml:"csv" json:"csv,omitempty"`
+	DateSeparator string     `toml:"date-separator" json:"date-separator,omitempty"`
+}
+
+// CSVConfig defines a series of configuration items for csv codec.
+type CSVConfig struct {
+	// delimiter between fields
+	Delimiter string `toml:"delimiter" json:"delimiter"`
+	// quoting character
+	Quote string `toml:"quote" json:"quote"`
+	// representation of null values
+	NullString string `toml:"null" json:"null"`
+	// whether to include commit ts
+	IncludeCommitTs bool `toml:"include-commit-ts" json:"include-commit-ts"`
+}
+
+const (
+	defaultCSVDelimiter  = ","
+	defaultCSVQuote      = "\""
+	defaultCSVNullString = "\\N"
+)
+
+func (c *CSVConfig) validate() error {
+	switch len(c.Delimiter) {
+	case 0:
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+			errors.New("csv config delimiter cannot be empty"))
+	case 1:
+		if strings.ContainsRune(c.Delimiter, CR) || strings.ContainsRune(c.Delimiter, LF) {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("csv config delimiter contains line break characters"))
+		}
+	default:
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+			errors.New("csv config delimiter contains more than one character"))
+	}
+
+	if len(c.Quote) > 1 {
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+			errors.New("csv config quote contains more than one character"))
+	}
+	if len(c.Quote) == 1 {
+		if strings.ContainsRune(c.Quote, CR) || strings.ContainsRune(c.Quote, LF) {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("csv config quote contains line break characters"))
+		}
+		if strings.Contains(c.Delimiter, c.Quote) {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("csv config quote and delimiter cannot be the same"))
+		}
+	}
+	return nil
 }
 
 // DispatchRule represents partition rule for a table.
@@ -79,6 +219,8 @@ type DispatchRule struct {
 	// In the future release, the DispatcherRule is expected to be removed .
 	PartitionRule string `toml:"partition" json:"partition"`
 	TopicRule     string `toml:"topic" json:"topic"`
+	// Columns are the columns used by the `columns` partition rule.
+	Columns []string `toml:"columns" json:"columns,omitempty"`
 }
 
 // ColumnSelector represents a column selector for a table.
@@ -118,9 +260,55 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) er
 		}
 	}
 
+	if s.ClaimCheckStorageURI != "" {
+		if sinkURI != nil && !sink.IsMQScheme(sinkURI.Scheme) {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("claim-check-storage-uri is only supported by the MQ sink"))
+		}
+		if _, err := url.Parse(s.ClaimCheckStorageURI); err != nil {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+		}
+	}
+
+	if s.SchemaChangeTopic != "" {
+		if sinkURI != nil && !sink.IsMQScheme(sinkURI.Scheme) {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("schema-change-topic is only supported by the MQ sink"))
+		}
+		if s.Protocol != ProtocolDebezium.String() {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("schema-change-topic is only supported by the debezium protocol"))
+		}
+	}
+
+	if sinkURI != nil && sink.IsStorageScheme(sinkURI.Scheme) {
+		return s.validateAndAdjustStorageConfig()
+	}
+
 	return nil
 }
 
+// validateAndAdjustStorageConfig fills the default values of the
+// storage sink related configurations and validates them.
+func (s *SinkConfig) validateAndAdjustStorageConfig() error {
+	if s.CSVConfig == nil {
+		s.CSVConfig = &CSVConfig{
+			Delimiter:  defaultCSVDelimiter,
+			Quote:      defaultCSVQuote,
+			NullString: defaultCSVNullString,
+		}
+	}
+	if err := s.CSVConfig.validate(); err != nil {
+		return err
+	}
+
+	if s.DateSeparator == "" {
+		s.DateSeparator = DateSeparatorDay.String()
+	}
+	var separator DateSeparator
+	return separator.FromString(s.DateSeparator)
+}
+
 // applyParameter fill the `ReplicaConfig` and `TxnAtomicity` by sinkURI.
 func (s *SinkConfig) applyParameter(sinkURI *url.URL) error {
 	if sinkURI == nil {
@@ -150,6 +338,13 @@ func (s *SinkConfig) applyParameter(sinkURI *url.URL) error {
 		} else {
 			s.TxnAtomicity = tableTxnAtomicity
 		}
+	case globalTxnAtomicity:
+		if !sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+			errMsg := fmt.Sprintf("%s level atomicity is not supported by %s scheme",
+				txnAtomicity, sinkURI.Scheme)
+			return cerror.ErrSinkURIInvalid.GenWithStackByArgs(errMsg)
+		}
+		s.TxnAtomicity = globalTxnAtomicity
 	default:
 		errMsg := fmt.Sprintf("%s level atomicity is not supported by %s scheme",
 			txnAtomicity, sinkURI.Scheme)
@@ -175,6 +370,20 @@ func (s *SinkConfig) applyParameter(sinkURI *url.URL) error {
 		if err != nil {
 			return err
 		}
+		if protocol == ProtocolCSV {
+			return cerror.ErrSinkURIInvalid.GenWithStackByArgs(fmt.Sprintf("protocol %s "+
+				"is incompatible with %s scheme", s.Protocol, sinkURI.Scheme))
+		}
+	} else if sink.IsStorageScheme(sinkURI.Scheme) {
+		var protocol Protocol
+		err := protocol.FromString(s.Protocol)
+		if err != nil {
+			return err
+		}
+		if protocol != ProtocolCSV && protocol != ProtocolCanalJSON {
+			return cerror.ErrSinkURIInvalid.GenWithStackByArgs(fmt.Sprintf("protocol %s "+
+				"is incompatible with %s scheme", s.Protocol, sinkURI.Scheme))
+		}
 	} else if s.Protocol != "" {
 		return cerror.ErrSinkURIInvalid.GenWithStackByArgs(fmt.Sprintf("protocol %s "+
 			"is incompatible with %s scheme", s.Protocol, sinkURI.Scheme))
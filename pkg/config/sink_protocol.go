@@ -36,6 +36,9 @@ const (
 	ProtocolCanalJSON
 	ProtocolCraft
 	ProtocolOpen
+	ProtocolCSV
+	ProtocolDebezium
+	ProtocolProtobuf
 )
 
 // FromString converts the protocol from string to Protocol enum type.
@@ -57,6 +60,12 @@ func (p *Protocol) FromString(protocol string) error {
 		*p = ProtocolCraft
 	case "open-protocol":
 		*p = ProtocolOpen
+	case "csv":
+		*p = ProtocolCSV
+	case "debezium":
+		*p = ProtocolDebezium
+	case "protobuf":
+		*p = ProtocolProtobuf
 	default:
 		return cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(protocol)
 	}
@@ -81,6 +90,12 @@ func (p Protocol) String() string {
 		return "craft"
 	case ProtocolOpen:
 		return "open-protocol"
+	case ProtocolCSV:
+		return "csv"
+	case ProtocolDebezium:
+		return "debezium"
+	case ProtocolProtobuf:
+		return "protobuf"
 	default:
 		panic("unreachable")
 	}
@@ -62,6 +62,18 @@ func TestFromString(t *testing.T) {
 			protocol:             "open-protocol",
 			expectedProtocolEnum: ProtocolOpen,
 		},
+		{
+			protocol:             "csv",
+			expectedProtocolEnum: ProtocolCSV,
+		},
+		{
+			protocol:             "debezium",
+			expectedProtocolEnum: ProtocolDebezium,
+		},
+		{
+			protocol:             "protobuf",
+			expectedProtocolEnum: ProtocolProtobuf,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -109,6 +121,18 @@ func TestString(t *testing.T) {
 			protocolEnum:     ProtocolOpen,
 			expectedProtocol: "open-protocol",
 		},
+		{
+			protocolEnum:     ProtocolCSV,
+			expectedProtocol: "csv",
+		},
+		{
+			protocolEnum:     ProtocolDebezium,
+			expectedProtocol: "debezium",
+		},
+		{
+			protocolEnum:     ProtocolProtobuf,
+			expectedProtocol: "protobuf",
+		},
 	}
 
 	for _, tc := range testCases {
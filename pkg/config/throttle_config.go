@@ -0,0 +1,135 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ThrottleLimits are the throughput limits of a changefeed, 0 means unlimited.
+// The limits are split evenly among the captures which replicate the
+// changefeed, and each capture enforces its own share.
+type ThrottleLimits struct {
+	// RowsPerSecond is the max number of rows written to the downstream per second.
+	RowsPerSecond int64 `toml:"rows-per-second" json:"rows-per-second"`
+	// BytesPerSecond is the max number of bytes written to the downstream per second.
+	BytesPerSecond int64 `toml:"bytes-per-second" json:"bytes-per-second"`
+	// MaxConnections is the max number of connections to the downstream,
+	// it only takes effect for MySQL and TiDB sinks.
+	MaxConnections int `toml:"max-connections" json:"max-connections"`
+}
+
+// ThrottleWindow overrides the default throttle limits of a changefeed during
+// a time of day window. The window is [Start, End) in the time zone of the
+// TiCDC server, and it spans midnight if End is not after Start.
+type ThrottleWindow struct {
+	// Start is the start time of the window in the HH:MM format.
+	Start string `toml:"start" json:"start"`
+	// End is the end time of the window in the HH:MM format.
+	End string `toml:"end" json:"end"`
+
+	ThrottleLimits
+}
+
+// ThrottleConfig represents the throughput throttle config for a changefeed.
+// It can be updated when the changefeed is running. It only takes effect with
+// the new sink, and it can not be used with the global transaction atomicity.
+type ThrottleConfig struct {
+	// ThrottleLimits are the default limits.
+	ThrottleLimits
+	// Schedule is a list of windows whose limits override the default ones,
+	// the first window matched takes effect.
+	Schedule []*ThrottleWindow `toml:"schedule" json:"schedule"`
+}
+
+// LimitsAt returns the limits in effect at the given time.
+func (c *ThrottleConfig) LimitsAt(t time.Time) ThrottleLimits {
+	now := t.Hour()*60 + t.Minute()
+	for _, w := range c.Schedule {
+		start, err := parseClock(w.Start)
+		if err != nil {
+			continue
+		}
+		end, err := parseClock(w.End)
+		if err != nil {
+			continue
+		}
+		if start < end && now >= start && now < end {
+			return w.ThrottleLimits
+		}
+		if start >= end && (now >= start || now < end) {
+			return w.ThrottleLimits
+		}
+	}
+	return c.ThrottleLimits
+}
+
+// Split returns the share of the limits for one of n captures. A share is
+// rounded up so that a limit never becomes unlimited after the split.
+func (l ThrottleLimits) Split(n int) ThrottleLimits {
+	if n <= 1 {
+		return l
+	}
+	return ThrottleLimits{
+		RowsPerSecond:  (l.RowsPerSecond + int64(n) - 1) / int64(n),
+		BytesPerSecond: (l.BytesPerSecond + int64(n) - 1) / int64(n),
+		MaxConnections: (l.MaxConnections + n - 1) / n,
+	}
+}
+
+func (c *ThrottleConfig) validateAndAdjust(sinkConfig *SinkConfig) error {
+	if sinkConfig != nil && sinkConfig.TxnAtomicity.IsGlobal() {
+		// Rows are written by the global transaction coordinator instead of
+		// the table sinks, which are where the throttle takes effect.
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("throttle can not be used with the transaction-atomicity %s",
+				globalTxnAtomicity))
+	}
+	if err := c.ThrottleLimits.validate(); err != nil {
+		return err
+	}
+	for _, w := range c.Schedule {
+		if _, err := parseClock(w.Start); err != nil {
+			return err
+		}
+		if _, err := parseClock(w.End); err != nil {
+			return err
+		}
+		if err := w.ThrottleLimits.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l ThrottleLimits) validate() error {
+	if l.RowsPerSecond < 0 || l.BytesPerSecond < 0 || l.MaxConnections < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"throttle rows-per-second, bytes-per-second and max-connections must not be negative")
+	}
+	return nil
+}
+
+// parseClock parses a time of day in the HH:MM format into minutes.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("invalid throttle schedule time %q, the format is HH:MM", s))
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// minVerificationInterval is the minimum of Verification.Interval can be set.
+const minVerificationInterval = time.Minute * 10
+
+// VerificationConfig represents the data verification config for a changefeed.
+// The upstream and the downstream are compared at the latest syncpoint, so the
+// sync point must be enabled to verify a changefeed.
+type VerificationConfig struct {
+	// UpstreamURI is the MySQL protocol address of the upstream TiDB,
+	// e.g. mysql://root@127.0.0.1:4000/
+	// The password in it is masked in the API responses and the logs.
+	UpstreamURI string `toml:"upstream-uri" json:"upstream-uri"`
+	// Interval is the interval of the periodic verification,
+	// 0 means the changefeed is only verified on demand.
+	Interval time.Duration `toml:"interval" json:"interval"`
+	// ChunkSize is the max number of rows in a chunk whose checksums are compared.
+	ChunkSize int `toml:"chunk-size" json:"chunk-size"`
+}
+
+func (c *VerificationConfig) validateAndAdjust(enableSyncPoint bool) error {
+	if c.UpstreamURI != "" {
+		if _, err := url.Parse(c.UpstreamURI); err != nil {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs(fmt.Sprintf("invalid verification upstream-uri: %s", err))
+		}
+	}
+	if c.ChunkSize < 0 {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(fmt.Sprintf("The verification chunk-size:%d must not be negative",
+				c.ChunkSize))
+	}
+	if c.Interval == 0 {
+		return nil
+	}
+	if !enableSyncPoint {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("the periodic verification requires the sync point to be enabled")
+	}
+	if c.UpstreamURI == "" {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("the periodic verification requires the upstream-uri")
+	}
+	if c.Interval < minVerificationInterval {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(
+				fmt.Sprintf("The verification interval:%s must be larger than %s",
+					c.Interval.String(),
+					minVerificationInterval.String()))
+	}
+	return nil
+}
@@ -187,6 +187,14 @@ var (
 		"filter rule is invalid %v",
 		errors.RFCCodeText("CDC:ErrFilterRuleInvalid"),
 	)
+	ErrDispatchRuleInvalid = errors.Normalize(
+		"dispatch rule is invalid: %s",
+		errors.RFCCodeText("CDC:ErrDispatchRuleInvalid"),
+	)
+	ErrColumnSelectorFailed = errors.Normalize(
+		"column selector failed: %s",
+		errors.RFCCodeText("CDC:ErrColumnSelectorFailed"),
+	)
 
 	// internal errors
 	ErrAdminStopProcessor = errors.Normalize(
@@ -210,6 +218,14 @@ var (
 		"create mark table failed",
 		errors.RFCCodeText("CDC:ErrCreateMarkTableFailed"),
 	)
+	ErrMarkTableNotFound = errors.Normalize(
+		"mark table %s not found, the changefeed of the reverse direction may be not created yet",
+		errors.RFCCodeText("CDC:ErrMarkTableNotFound"),
+	)
+	ErrMarkTableChanged = errors.Normalize(
+		"mark table %d is changed by a DDL, the tables are replicated again",
+		errors.RFCCodeText("CDC:ErrMarkTableChanged"),
+	)
 
 	// sink related errors
 	ErrExecDDLFailed = errors.Normalize(
@@ -236,6 +252,14 @@ var (
 		"flush not finished before producer close",
 		errors.RFCCodeText("CDC:ErrKafkaFlushUnfinished"),
 	)
+	ErrKafkaCommitTransaction = errors.Normalize(
+		"kafka commit transaction failed",
+		errors.RFCCodeText("CDC:ErrKafkaCommitTransaction"),
+	)
+	ErrKafkaTransactionalNotSupported = errors.Normalize(
+		"transactional kafka producer is not supported by %s",
+		errors.RFCCodeText("CDC:ErrKafkaTransactionalNotSupported"),
+	)
 	ErrKafkaInvalidPartitionNum = errors.Normalize(
 		"invalid partition num %d",
 		errors.RFCCodeText("CDC:ErrKafkaInvalidPartitionNum"),
@@ -271,6 +295,30 @@ var (
 	ErrKafkaTopicNotExists = errors.Normalize("kafka topic not exists after creation",
 		errors.RFCCodeText("CDC:ErrKafkaTopicNotExists"),
 	)
+	ErrPulsarInvalidConfig = errors.Normalize(
+		"pulsar config invalid",
+		errors.RFCCodeText("CDC:ErrPulsarInvalidConfig"),
+	)
+	ErrPulsarNewProducer = errors.Normalize(
+		"new pulsar producer",
+		errors.RFCCodeText("CDC:ErrPulsarNewProducer"),
+	)
+	ErrPulsarGetTopicPartitions = errors.Normalize(
+		"get the partitions of pulsar topic %s failed",
+		errors.RFCCodeText("CDC:ErrPulsarGetTopicPartitions"),
+	)
+	ErrPulsarSendMessage = errors.Normalize(
+		"pulsar send message failed",
+		errors.RFCCodeText("CDC:ErrPulsarSendMessage"),
+	)
+	ErrPulsarAsyncSendMessage = errors.Normalize(
+		"pulsar async send message failed",
+		errors.RFCCodeText("CDC:ErrPulsarAsyncSendMessage"),
+	)
+	ErrPulsarProducerClosed = errors.Normalize(
+		"pulsar producer client closed",
+		errors.RFCCodeText("CDC:ErrPulsarProducerClosed"),
+	)
 	ErrRedoConfigInvalid = errors.Normalize(
 		"redo log config invalid",
 		errors.RFCCodeText("CDC:ErrRedoConfigInvalid"),
@@ -295,6 +343,14 @@ var (
 		"initialize meta for redo log",
 		errors.RFCCodeText("CDC:ErrRedoMetaInitialize"),
 	)
+	ErrRedoLogCorrupted = errors.Normalize(
+		"redo log file %s is corrupted: %s",
+		errors.RFCCodeText("CDC:ErrRedoLogCorrupted"),
+	)
+	ErrRedoEncryptionKeyInvalid = errors.Normalize(
+		"redo log encryption key is invalid: %s",
+		errors.RFCCodeText("CDC:ErrRedoEncryptionKeyInvalid"),
+	)
 	ErrFileSizeExceed = errors.Normalize(
 		"rawData size %d exceeds maximum file size %d",
 		errors.RFCCodeText("CDC:ErrFileSizeExceed"),
@@ -311,6 +367,38 @@ var (
 		"Codec invalid config",
 		errors.RFCCodeText("CDC:ErrCodecInvalidConfig"),
 	)
+	ErrCSVEncodeFailed = errors.Normalize(
+		"csv encode failed",
+		errors.RFCCodeText("CDC:ErrCSVEncodeFailed"),
+	)
+	ErrStorageSinkInvalidConfig = errors.Normalize(
+		"storage sink config invalid",
+		errors.RFCCodeText("CDC:ErrStorageSinkInvalidConfig"),
+	)
+	ErrStorageSinkInvalidDateSeparator = errors.Normalize(
+		"date separator in storage sink is invalid: %s",
+		errors.RFCCodeText("CDC:ErrStorageSinkInvalidDateSeparator"),
+	)
+	ErrStorageSinkInvalidFileName = errors.Normalize(
+		"filename in storage sink is invalid: %s",
+		errors.RFCCodeText("CDC:ErrStorageSinkInvalidFileName"),
+	)
+	ErrStorageInitialize = errors.Normalize(
+		"new external storage for sink",
+		errors.RFCCodeText("CDC:ErrStorageInitialize"),
+	)
+	ErrClaimCheckStorageAPI = errors.Normalize(
+		"claim-check external storage api",
+		errors.RFCCodeText("CDC:ErrClaimCheckStorageAPI"),
+	)
+	ErrClaimCheckInvalidReference = errors.Normalize(
+		"invalid claim-check reference: %s",
+		errors.RFCCodeText("CDC:ErrClaimCheckInvalidReference"),
+	)
+	ErrClaimCheckChecksumMismatch = errors.Normalize(
+		"checksum mismatch of claim-check payload %s, expected %d, actual %d",
+		errors.RFCCodeText("CDC:ErrClaimCheckChecksumMismatch"),
+	)
 	ErrAsyncBroadcastNotSupport = errors.Normalize(
 		"Async broadcasts not supported",
 		errors.RFCCodeText("CDC:ErrAsyncBroadcastNotSupport"),
@@ -359,6 +447,10 @@ var (
 		"encode to avro native data",
 		errors.RFCCodeText("CDC:ErrAvroEncodeFailed"),
 	)
+	ErrAvroDecodeFailed = errors.Normalize(
+		"decode avro data failed",
+		errors.RFCCodeText("CDC:ErrAvroDecodeFailed"),
+	)
 	ErrAvroEncodeToBinary = errors.Normalize(
 		"encode to binray from native",
 		errors.RFCCodeText("CDC:ErrAvroEncodeToBinary"),
@@ -379,6 +471,26 @@ var (
 		"maxwell invalid data",
 		errors.RFCCodeText("CDC:ErrMaxwellInvalidData"),
 	)
+	ErrDebeziumEncodeFailed = errors.Normalize(
+		"debezium encode failed",
+		errors.RFCCodeText("CDC:ErrDebeziumEncodeFailed"),
+	)
+	ErrDebeziumDecodeFailed = errors.Normalize(
+		"debezium decode failed",
+		errors.RFCCodeText("CDC:ErrDebeziumDecodeFailed"),
+	)
+	ErrDebeziumInvalidData = errors.Normalize(
+		"debezium invalid data",
+		errors.RFCCodeText("CDC:ErrDebeziumInvalidData"),
+	)
+	ErrProtobufEncodeFailed = errors.Normalize(
+		"protobuf encode failed",
+		errors.RFCCodeText("CDC:ErrProtobufEncodeFailed"),
+	)
+	ErrProtobufCodecInvalidData = errors.Normalize(
+		"protobuf codec invalid data",
+		errors.RFCCodeText("CDC:ErrProtobufCodecInvalidData"),
+	)
 	ErrOpenProtocolCodecInvalidData = errors.Normalize(
 		"open-protocol codec invalid data",
 		errors.RFCCodeText("CDC:ErrOpenProtocolCodecInvalidData"),
@@ -403,6 +515,14 @@ var (
 		"sink config invalid",
 		errors.RFCCodeText("CDC:ErrSinkInvalidConfig"),
 	)
+	ErrGlobalTxnUnexpectedMessage = errors.Normalize(
+		"unexpected global transaction message: %s",
+		errors.RFCCodeText("CDC:ErrGlobalTxnUnexpectedMessage"),
+	)
+	ErrGlobalTxnCoordinatorChanged = errors.Normalize(
+		"global transaction coordinator changed from %s to %s",
+		errors.RFCCodeText("CDC:ErrGlobalTxnCoordinatorChanged"),
+	)
 	ErrCraftCodecInvalidData = errors.Normalize(
 		"craft codec invalid data",
 		errors.RFCCodeText("CDC:ErrCraftCodecInvalidData"),
@@ -652,6 +772,14 @@ var (
 		"this api supports GET method only",
 		errors.RFCCodeText("CDC:ErrSupportGetOnly"),
 	)
+	ErrUnauthenticated = errors.Normalize(
+		"request is not authenticated: %s",
+		errors.RFCCodeText("CDC:ErrUnauthenticated"),
+	)
+	ErrPermissionDenied = errors.Normalize(
+		"permission denied, %s",
+		errors.RFCCodeText("CDC:ErrPermissionDenied"),
+	)
 	ErrAPIInvalidParam = errors.Normalize(
 		"invalid api parameter",
 		errors.RFCCodeText("CDC:ErrAPIInvalidParam"),
@@ -680,10 +808,26 @@ var (
 		"changefeed update error: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateRefused"),
 	)
+	ErrInvalidDDLErrorHandler = errors.Normalize(
+		"invalid ddl error handler: %s",
+		errors.RFCCodeText("CDC:ErrInvalidDDLErrorHandler"),
+	)
 	ErrChangefeedUpdateFailedTransaction = errors.Normalize(
 		"changefeed update failed due to unexpected etcd transaction failure: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateFailed"),
 	)
+	ErrChangefeedVerificationNotFound = errors.Normalize(
+		"verification result of changefeed %s is not found",
+		errors.RFCCodeText("CDC:ErrChangefeedVerificationNotFound"),
+	)
+	ErrChangefeedVerificationUnavailable = errors.Normalize(
+		"changefeed %s can not be verified: %s",
+		errors.RFCCodeText("CDC:ErrChangefeedVerificationUnavailable"),
+	)
+	ErrSyncPointNotFound = errors.Normalize(
+		"no syncpoint of changefeed %s is found in the downstream",
+		errors.RFCCodeText("CDC:ErrSyncPointNotFound"),
+	)
 	ErrChangefeedAbnormalState = errors.Normalize(
 		"changefeed in abnormal state: %s, replication status: %+v",
 		errors.RFCCodeText("CDC:ErrChangefeedAbnormalState"),
@@ -1100,6 +1244,10 @@ var (
 		"invalid filter expression(s). Cannot find column '%s' from table '%s' in: %s",
 		errors.RFCCodeText("CDC:ErrExpressionColumnNotFound"),
 	)
+	ErrEvalVirtualColumnFailed = errors.Normalize(
+		"failed to evaluate the virtual generated column '%s' of table '%s'",
+		errors.RFCCodeText("CDC:ErrEvalVirtualColumnFailed"),
+	)
 	ErrInvalidIgnoreEventType = errors.Normalize(
 		"invalid ignore event type: '%s'",
 		errors.RFCCodeText("CDC:ErrInvalidIgnoreEventType"),
@@ -497,6 +497,10 @@ var (
 		"local resource directory not writable",
 		errors.RFCCodeText("DFLOW:ErrLocalFileDirNotWritable"),
 	)
+	ErrS3ResourceNotConfigured = errors.Normalize(
+		"s3 resource is not configured for executor %s",
+		errors.RFCCodeText("DFLOW:ErrS3ResourceNotConfigured"),
+	)
 
 	// JobOps related error
 	ErrJobAlreadyCanceled = errors.Normalize(
@@ -504,6 +508,16 @@ var (
 		errors.RFCCodeText("DFLOW:ErrJobAlreadyCanceled"),
 	)
 
+	// CDC job related errors
+	ErrCDCJobInvalidConfig = errors.Normalize(
+		"invalid cdc job config: %s",
+		errors.RFCCodeText("DFLOW:ErrCDCJobInvalidConfig"),
+	)
+	ErrCDCTableNotFound = errors.Normalize(
+		"table %s is not found in upstream at ts %d",
+		errors.RFCCodeText("DFLOW:ErrCDCTableNotFound"),
+	)
+
 	// cli related errors
 	ErrInvalidCliParameter = errors.Normalize(
 		"invalid cli parameters",
@@ -40,6 +40,10 @@ import (
 // DefaultCDCClusterID is the default value of cdc cluster id
 const DefaultCDCClusterID = "default"
 
+// maxUpdateChangeFeedInfoRetry is the max times to retry updating the
+// changefeed info when it's modified concurrently.
+const maxUpdateChangeFeedInfoRetry = 3
+
 // CaptureOwnerKey is the capture owner path that is saved to etcd
 func CaptureOwnerKey(clusterID string) string {
 	return BaseKey(clusterID) + metaPrefix + "/owner"
@@ -90,6 +94,12 @@ func GetEtcdKeyJob(clusterID string, changeFeedID model.ChangeFeedID) string {
 	return ChangefeedStatusKeyPrefix(clusterID, changeFeedID.Namespace) + "/" + changeFeedID.ID
 }
 
+// GetEtcdKeyChangefeedVerification returns the key of a changefeed verification result
+func GetEtcdKeyChangefeedVerification(clusterID string, changefeedID model.ChangeFeedID) string {
+	return NamespacedPrefix(clusterID, changefeedID.Namespace) +
+		ChangefeedVerificationKey + "/" + changefeedID.ID
+}
+
 // MigrateBackupKey is the key of backup data during a migration.
 func MigrateBackupKey(version int, backupKey string) string {
 	if strings.HasPrefix(backupKey, "/") {
@@ -129,6 +139,15 @@ type CDCEtcdClient interface {
 		namespace string,
 	) (*model.UpstreamInfo, error)
 
+	GetChangefeedVerification(ctx context.Context,
+		id model.ChangeFeedID,
+	) (*model.ChangefeedVerification, error)
+
+	PutChangefeedVerification(ctx context.Context,
+		id model.ChangeFeedID,
+		verification *model.ChangefeedVerification,
+	) error
+
 	GetGCServiceID() string
 
 	GetEnsureGCServiceID(tag string) string
@@ -138,6 +157,11 @@ type CDCEtcdClient interface {
 		changeFeedID model.ChangeFeedID,
 	) error
 
+	UpdateChangeFeedInfo(ctx context.Context,
+		changeFeedID model.ChangeFeedID,
+		update func(*model.ChangeFeedInfo) (*model.ChangeFeedInfo, error),
+	) (*model.ChangeFeedInfo, error)
+
 	CreateChangefeedInfo(context.Context,
 		*model.UpstreamInfo,
 		*model.ChangeFeedInfo,
@@ -338,6 +362,38 @@ func (c *CDCEtcdClientImpl) GetChangeFeedStatus(ctx context.Context,
 	return info, resp.Kvs[0].ModRevision, errors.Trace(err)
 }
 
+// GetChangefeedVerification queries the latest verification result of a given changefeed
+func (c *CDCEtcdClientImpl) GetChangefeedVerification(ctx context.Context,
+	id model.ChangeFeedID,
+) (*model.ChangefeedVerification, error) {
+	key := GetEtcdKeyChangefeedVerification(c.ClusterID, id)
+	resp, err := c.Client.Get(ctx, key)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if resp.Count == 0 {
+		return nil, cerror.ErrChangefeedVerificationNotFound.GenWithStackByArgs(id.ID)
+	}
+	verification := &model.ChangefeedVerification{}
+	err = verification.Unmarshal(resp.Kvs[0].Value)
+	return verification, errors.Trace(err)
+}
+
+// PutChangefeedVerification stores the verification result of a given changefeed,
+// only the latest result is kept.
+func (c *CDCEtcdClientImpl) PutChangefeedVerification(ctx context.Context,
+	id model.ChangeFeedID,
+	verification *model.ChangefeedVerification,
+) error {
+	key := GetEtcdKeyChangefeedVerification(c.ClusterID, id)
+	value, err := verification.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = c.Client.Put(ctx, key, value)
+	return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+}
+
 // GetCaptures returns kv revision and CaptureInfo list
 func (c *CDCEtcdClientImpl) GetCaptures(ctx context.Context) (int64, []*model.CaptureInfo, error) {
 	key := CaptureInfoKeyPrefix(c.ClusterID)
@@ -532,6 +588,53 @@ func (c *CDCEtcdClientImpl) SaveChangeFeedInfo(ctx context.Context,
 	return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
 }
 
+// UpdateChangeFeedInfo updates the changefeed info by the update function,
+// which is called with the latest info. The new info is only stored if the
+// info is not modified since it's read, e.g. by the owner, otherwise the
+// update function is called again with the modified info.
+func (c *CDCEtcdClientImpl) UpdateChangeFeedInfo(ctx context.Context,
+	changeFeedID model.ChangeFeedID,
+	update func(*model.ChangeFeedInfo) (*model.ChangeFeedInfo, error),
+) (*model.ChangeFeedInfo, error) {
+	key := GetEtcdKeyChangeFeedInfo(c.ClusterID, changeFeedID)
+	for i := 0; i < maxUpdateChangeFeedInfoRetry; i++ {
+		resp, err := c.Client.Get(ctx, key)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+		}
+		if resp.Count == 0 {
+			return nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(key)
+		}
+		info := &model.ChangeFeedInfo{}
+		if err := info.Unmarshal(resp.Kvs[0].Value); err != nil {
+			return nil, errors.Trace(err)
+		}
+		newInfo, err := update(info)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		value, err := newInfo.Marshal()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		cmps := []clientv3.Cmp{
+			clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision),
+		}
+		opsThen := []clientv3.Op{clientv3.OpPut(key, value)}
+		txnResp, err := c.Client.Txn(ctx, cmps, opsThen, TxnEmptyOpsElse)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+		}
+		if txnResp.Succeeded {
+			return newInfo, nil
+		}
+		log.Info("changefeed info is modified concurrently, retry updating it",
+			zap.String("namespace", changeFeedID.Namespace),
+			zap.String("changefeed", changeFeedID.ID))
+	}
+	return nil, cerror.ErrChangefeedUpdateFailedTransaction.GenWithStackByArgs(changeFeedID)
+}
+
 // PutCaptureInfo put capture info into etcd,
 // this happens when the capture starts.
 func (c *CDCEtcdClientImpl) PutCaptureInfo(
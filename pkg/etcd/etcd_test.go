@@ -222,6 +222,28 @@ func TestCreateChangefeed(t *testing.T) {
 	require.True(t, cerror.ErrChangeFeedAlreadyExists.Equal(err))
 }
 
+func TestOpChangefeedVerification(t *testing.T) {
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	id := model.DefaultChangeFeedID("test-verification")
+	_, err := s.client.GetChangefeedVerification(ctx, id)
+	require.True(t, cerror.ErrChangefeedVerificationNotFound.Equal(err))
+
+	verification := &model.ChangefeedVerification{
+		PrimaryTs:   1,
+		SecondaryTs: 2,
+		Consistent:  true,
+	}
+	err = s.client.PutChangefeedVerification(ctx, id, verification)
+	require.NoError(t, err)
+	v, err := s.client.GetChangefeedVerification(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, verification, v)
+}
+
 func TestUpdateChangefeedAndUpstream(t *testing.T) {
 	s := &Tester{}
 	s.SetUpTest(t)
@@ -254,6 +276,55 @@ func TestUpdateChangefeedAndUpstream(t *testing.T) {
 	require.Equal(t, changeFeedInfo.SinkURI, changefeedResult.SinkURI)
 }
 
+func TestUpdateChangeFeedInfo(t *testing.T) {
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	changeFeedID := model.DefaultChangeFeedID("test-update-cf-info")
+	_, err := s.client.UpdateChangeFeedInfo(ctx, changeFeedID,
+		func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, error) {
+			return info, nil
+		})
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
+
+	err = s.client.SaveChangeFeedInfo(ctx, &model.ChangeFeedInfo{
+		SinkURI: "blackhole://",
+		State:   model.StateNormal,
+	}, changeFeedID)
+	require.NoError(t, err)
+
+	// The info is modified concurrently when it's updated at the first time,
+	// so the update is retried on the modified info.
+	calls := 0
+	info, err := s.client.UpdateChangeFeedInfo(ctx, changeFeedID,
+		func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, error) {
+			calls++
+			if calls == 1 {
+				modified := *info
+				modified.State = model.StateError
+				require.NoError(t, s.client.SaveChangeFeedInfo(ctx, &modified, changeFeedID))
+			}
+			info.SinkURI = "blackhole://?a=b"
+			return info, nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Equal(t, "blackhole://?a=b", info.SinkURI)
+	info, err = s.client.GetChangeFeedInfo(ctx, changeFeedID)
+	require.NoError(t, err)
+	require.Equal(t, "blackhole://?a=b", info.SinkURI)
+	require.Equal(t, model.StateError, info.State)
+
+	// The error returned by the update function is returned.
+	_, err = s.client.UpdateChangeFeedInfo(ctx, changeFeedID,
+		func(info *model.ChangeFeedInfo) (*model.ChangeFeedInfo, error) {
+			return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs("refused")
+		})
+	require.True(t, cerror.ErrChangefeedUpdateRefused.Equal(err))
+}
+
 func TestGetAllCaptureLeases(t *testing.T) {
 	s := &Tester{}
 	s.SetUpTest(t)
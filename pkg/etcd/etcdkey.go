@@ -34,6 +34,8 @@ const (
 	ChangefeedInfoKey = "/changefeed/info"
 	// ChangefeedStatusKey is the key path for changefeed status
 	ChangefeedStatusKey = "/changefeed/status"
+	// ChangefeedVerificationKey is the key path for the changefeed verification result
+	ChangefeedVerificationKey = "/changefeed/verification"
 	// metaVersionKey is the key path for metadata version
 	metaVersionKey = "/meta/meta-version"
 	upstreamKey    = "/upstream"
@@ -63,6 +65,7 @@ const (
 	CDCKeyTypeTaskPosition
 	CDCKeyTypeMetaVersion
 	CDCKeyTypeUpStream
+	CDCKeyTypeChangefeedVerification
 )
 
 // CDCKey represents an etcd key which is defined by TiCDC
@@ -167,6 +170,14 @@ func (k *CDCKey) Parse(clusterID, key string) error {
 				ID:        key[len(ChangefeedStatusKey)+1:],
 			}
 			k.OwnerLeaseID = ""
+		case strings.HasPrefix(key, ChangefeedVerificationKey):
+			k.Tp = CDCKeyTypeChangefeedVerification
+			k.CaptureID = ""
+			k.ChangefeedID = model.ChangeFeedID{
+				Namespace: namespace,
+				ID:        key[len(ChangefeedVerificationKey)+1:],
+			}
+			k.OwnerLeaseID = ""
 		case strings.HasPrefix(key, taskPositionKey):
 			splitKey := strings.SplitN(key[len(taskPositionKey)+1:], "/", 2)
 			if len(splitKey) != 2 {
@@ -201,6 +212,9 @@ func (k *CDCKey) String() string {
 	case CDCKeyTypeChangeFeedStatus:
 		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + ChangefeedStatusKey +
 			"/" + k.ChangefeedID.ID
+	case CDCKeyTypeChangefeedVerification:
+		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + ChangefeedVerificationKey +
+			"/" + k.ChangefeedID.ID
 	case CDCKeyTypeTaskPosition:
 		return NamespacedPrefix(k.ClusterID, k.ChangefeedID.Namespace) + taskPositionKey +
 			"/" + k.CaptureID + "/" + k.ChangefeedID.ID
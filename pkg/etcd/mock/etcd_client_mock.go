@@ -157,6 +157,21 @@ func (mr *MockCDCEtcdClientMockRecorder) GetChangeFeedStatus(ctx, id interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedStatus", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangeFeedStatus), ctx, id)
 }
 
+// GetChangefeedVerification mocks base method.
+func (m *MockCDCEtcdClient) GetChangefeedVerification(ctx context.Context, id model.ChangeFeedID) (*model.ChangefeedVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedVerification", ctx, id)
+	ret0, _ := ret[0].(*model.ChangefeedVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedVerification indicates an expected call of GetChangefeedVerification.
+func (mr *MockCDCEtcdClientMockRecorder) GetChangefeedVerification(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedVerification", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangefeedVerification), ctx, id)
+}
+
 // GetClusterID mocks base method.
 func (m *MockCDCEtcdClient) GetClusterID() string {
 	m.ctrl.T.Helper()
@@ -272,6 +287,20 @@ func (mr *MockCDCEtcdClientMockRecorder) PutCaptureInfo(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCaptureInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).PutCaptureInfo), arg0, arg1, arg2)
 }
 
+// PutChangefeedVerification mocks base method.
+func (m *MockCDCEtcdClient) PutChangefeedVerification(ctx context.Context, id model.ChangeFeedID, verification *model.ChangefeedVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutChangefeedVerification", ctx, id, verification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutChangefeedVerification indicates an expected call of PutChangefeedVerification.
+func (mr *MockCDCEtcdClientMockRecorder) PutChangefeedVerification(ctx, id, verification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutChangefeedVerification", reflect.TypeOf((*MockCDCEtcdClient)(nil).PutChangefeedVerification), ctx, id, verification)
+}
+
 // SaveChangeFeedInfo mocks base method.
 func (m *MockCDCEtcdClient) SaveChangeFeedInfo(ctx context.Context, info *model.ChangeFeedInfo, changeFeedID model.ChangeFeedID) error {
 	m.ctrl.T.Helper()
@@ -286,6 +315,21 @@ func (mr *MockCDCEtcdClientMockRecorder) SaveChangeFeedInfo(ctx, info, changeFee
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveChangeFeedInfo), ctx, info, changeFeedID)
 }
 
+// UpdateChangeFeedInfo mocks base method.
+func (m *MockCDCEtcdClient) UpdateChangeFeedInfo(ctx context.Context, changeFeedID model.ChangeFeedID, update func(*model.ChangeFeedInfo) (*model.ChangeFeedInfo, error)) (*model.ChangeFeedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChangeFeedInfo", ctx, changeFeedID, update)
+	ret0, _ := ret[0].(*model.ChangeFeedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChangeFeedInfo indicates an expected call of UpdateChangeFeedInfo.
+func (mr *MockCDCEtcdClientMockRecorder) UpdateChangeFeedInfo(ctx, changeFeedID, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).UpdateChangeFeedInfo), ctx, changeFeedID, update)
+}
+
 // UpdateChangefeedAndUpstream mocks base method.
 func (m *MockCDCEtcdClient) UpdateChangefeedAndUpstream(ctx context.Context, upstreamInfo *model.UpstreamInfo, changeFeedInfo *model.ChangeFeedInfo, changeFeedID model.ChangeFeedID) error {
 	m.ctrl.T.Helper()
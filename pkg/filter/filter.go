@@ -17,6 +17,7 @@ import (
 	timodel "github.com/pingcap/tidb/parser/model"
 	tfilter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/bidirectional"
 	"github.com/pingcap/tiflow/pkg/config"
 )
 
@@ -187,6 +188,14 @@ func (f *filter) ShouldDiscardDDL(ddlType timodel.ActionType, schema, table stri
 		return
 	}
 
+	// The DDLs of the mark table of bidirectional replication are always
+	// applied to the schema storage, so that the rows of the mark table can be
+	// mounted even if it's created after the changefeed starts. They are not
+	// sent to downstream as the mark table is ignored by ShouldIgnoreDDLEvent.
+	if bidirectional.IsMarkTableOrSchema(schema, table) {
+		return false
+	}
+
 	switch ddlType {
 	case timodel.ActionCreateSchema, timodel.ActionDropSchema,
 		timodel.ActionModifySchemaCharsetAndCollate:
@@ -200,7 +209,8 @@ func (f *filter) ShouldDiscardDDL(ddlType timodel.ActionType, schema, table stri
 // ShouldIgnoreTable returns true if the specified table should be ignored by this change feed.
 // NOTICE: Set `tbl` to an empty string to test against the whole database.
 func (f *filter) ShouldIgnoreTable(db, tbl string) bool {
-	if isSysSchema(db) {
+	// The mark table of bidirectional replication is never replicated.
+	if isSysSchema(db) || bidirectional.IsMarkTable(db, tbl) {
 		return true
 	}
 	return !f.tableFilter.MatchTable(db, tbl)
@@ -35,6 +35,7 @@ func TestShouldUseDefaultRules(t *testing.T) {
 	require.False(t, filter.ShouldIgnoreTable("metric_schema", "query_duration"))
 	require.False(t, filter.ShouldIgnoreTable("sns", "user"))
 	require.False(t, filter.ShouldIgnoreTable("tidb_cdc", "repl_mark_a_a"))
+	require.True(t, filter.ShouldIgnoreTable("tidb_cdc", "repl_mark"))
 }
 
 func TestShouldUseCustomRules(t *testing.T) {
@@ -184,6 +185,17 @@ func TestShouldDiscardDDL(t *testing.T) {
 	require.True(t, filter.ShouldDiscardDDL(timodel.ActionCreateSequence, "", ""))
 	require.True(t, filter.ShouldDiscardDDL(timodel.ActionAlterSequence, "", ""))
 	require.True(t, filter.ShouldDiscardDDL(timodel.ActionDropSequence, "", ""))
+
+	// The DDLs of the mark table of bidirectional replication are never
+	// discarded, even if they don't match the filter rules.
+	cfg.Filter.Rules = []string{"test.*"}
+	filter, err = NewFilter(cfg, "")
+	require.Nil(t, err)
+	require.True(t, filter.ShouldDiscardDDL(timodel.ActionCreateSchema, "other", ""))
+	require.True(t, filter.ShouldDiscardDDL(timodel.ActionCreateTable, "tidb_cdc", "syncpoint_v1"))
+	require.False(t, filter.ShouldDiscardDDL(timodel.ActionCreateSchema, "tidb_cdc", ""))
+	require.False(t, filter.ShouldDiscardDDL(timodel.ActionCreateTable, "tidb_cdc", "repl_mark"))
+	require.True(t, filter.ShouldIgnoreTable("tidb_cdc", "repl_mark"))
 }
 
 func TestShouldIgnoreDDL(t *testing.T) {
@@ -42,5 +42,8 @@ func VerifyNone(t *testing.T, options ...goleak.Option) {
 // options can be used to implement other ignore items
 func SetUpLeakTest(m *testing.M, options ...goleak.Option) {
 	options = append(options, defaultOpts...)
+	// Ignore the goroutines started during package initialization, such as
+	// the dbus connection created by the keyring library of pulsar client.
+	options = append(options, goleak.IgnoreCurrent())
 	goleak.VerifyTestMain(m, options...)
 }
@@ -16,9 +16,12 @@ package pdutil
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -34,6 +37,7 @@ const (
 	regionLabelPrefix     = "/pd/api/v1/config/region-label/rules"
 	gcServiceSafePointURL = "/pd/api/v1/gc/safepoint"
 	healthyAPI            = "/pd/api/v1/health"
+	scanRegionAPI         = "/pd/api/v1/regions/key"
 
 	// Split the default rule by following keys to keep metadata region isolated
 	// from the normal data area.
@@ -81,8 +85,9 @@ const (
 )
 
 const (
-	defaultMaxRetry       = 3
-	defaultRequestTimeout = 5 * time.Second
+	defaultMaxRetry        = 3
+	defaultRequestTimeout  = 5 * time.Second
+	defaultScanRegionLimit = 1024
 )
 
 // pdAPIClient is the api client of Placement Driver, include grpc client and http client.
@@ -172,6 +177,90 @@ func (pc *pdAPIClient) ListGcServiceSafePoint(
 	return resp, err
 }
 
+// RegionInfo records detail region info for api usage.
+// NOTE: This type is a copy of github.com/tikv/pd/server/api/region.go.
+type RegionInfo struct {
+	ID          uint64 `json:"id"`
+	StartKey    string `json:"start_key"`
+	EndKey      string `json:"end_key"`
+	WrittenKeys uint64 `json:"written_keys"`
+}
+
+// RegionsInfo contains some regions with the detailed region info.
+// NOTE: This type is a copy of github.com/tikv/pd/server/api/region.go.
+type RegionsInfo struct {
+	Count   int64        `json:"count"`
+	Regions []RegionInfo `json:"regions"`
+}
+
+// ScanRegions scans regions in the key range [startKey, endKey) from PD.
+// Keys are in the memcomparable format that PD stores regions with.
+func (pc *pdAPIClient) ScanRegions(
+	ctx context.Context, startKey, endKey []byte,
+) ([]RegionInfo, error) {
+	var regions []RegionInfo
+	for {
+		var (
+			resp *RegionsInfo
+			err  error
+		)
+		err = retry.Do(ctx, func() error {
+			ctx, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+			defer cancel()
+
+			resp, err = pc.scanRegions(ctx, startKey, endKey)
+			return err
+		}, retry.WithMaxTries(defaultMaxRetry), retry.WithIsRetryableErr(func(err error) bool {
+			switch errors.Cause(err) {
+			case context.Canceled:
+				return false
+			}
+			return true
+		}))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		regions = append(regions, resp.Regions...)
+		if len(resp.Regions) < defaultScanRegionLimit {
+			return regions, nil
+		}
+		last := resp.Regions[len(resp.Regions)-1]
+		if last.EndKey == "" {
+			return regions, nil
+		}
+		next, err := hex.DecodeString(last.EndKey)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(endKey) != 0 && bytes.Compare(next, endKey) >= 0 {
+			return regions, nil
+		}
+		startKey = next
+	}
+}
+
+func (pc *pdAPIClient) scanRegions(
+	ctx context.Context, startKey, endKey []byte,
+) (*RegionsInfo, error) {
+	query := url.Values{}
+	query.Set("key", string(startKey))
+	query.Set("end_key", string(endKey))
+	query.Set("limit", strconv.Itoa(defaultScanRegionLimit))
+	url := pc.grpcClient.GetLeaderAddr() + scanRegionAPI + "?" + query.Encode()
+
+	respData, err := pc.httpClient.DoRequest(ctx, url, http.MethodGet,
+		nil, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	resp := RegionsInfo{}
+	err = json.Unmarshal(respData, &resp)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &resp, nil
+}
+
 func (pc *pdAPIClient) patchMetaLabel(ctx context.Context) error {
 	url := pc.grpcClient.GetLeaderAddr() + regionLabelPrefix
 	header := http.Header{"Content-Type": {"application/json"}}
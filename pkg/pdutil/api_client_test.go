@@ -113,6 +113,40 @@ func TestListGcServiceSafePoint(t *testing.T) {
 	mockClient.testServer.Close()
 }
 
+func TestScanRegions(t *testing.T) {
+	t.Parallel()
+
+	mockClient := &mockPDClient{}
+	mockClient.testServer = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, scanRegionAPI, r.URL.Path)
+			require.Equal(t, "a", r.URL.Query().Get("key"))
+			require.Equal(t, "z", r.URL.Query().Get("end_key"))
+			resp := RegionsInfo{
+				Count: 2,
+				Regions: []RegionInfo{
+					{ID: 1, StartKey: "61", EndKey: "6D", WrittenKeys: 10},
+					{ID: 2, StartKey: "6D", EndKey: "7A", WrittenKeys: 20},
+				},
+			}
+			data, err := json.Marshal(resp)
+			require.NoError(t, err)
+			_, _ = w.Write(data)
+		},
+	))
+	defer mockClient.testServer.Close()
+	mockClient.url = mockClient.testServer.URL
+
+	pc, err := NewPDAPIClient(mockClient, nil)
+	require.NoError(t, err)
+	defer pc.Close()
+
+	regions, err := pc.ScanRegions(context.Background(), []byte("a"), []byte("z"))
+	require.NoError(t, err)
+	require.Len(t, regions, 2)
+	require.Equal(t, uint64(20), regions[1].WrittenKeys)
+}
+
 // LabelRulePatch is the patch to update the label rules.
 // NOTE: This type is exported by HTTP API. Please pay more attention when modifying it.
 // Copied from github.com/tikv/pd/server/schedule/labeler
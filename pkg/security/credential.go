@@ -75,7 +75,8 @@ func (s *Credential) ToTLSConfigWithVerify() (*tls.Config, error) {
 	return cfg, cerror.WrapError(cerror.ErrToTLSConfigFailed, err)
 }
 
-func (s *Credential) getSelfCommonName() (string, error) {
+// SelfCommonName returns the Common Name in certificate that specified by s.CertPath
+func (s *Credential) SelfCommonName() (string, error) {
 	if s.CertPath == "" {
 		return "", nil
 	}
@@ -97,7 +98,7 @@ func (s *Credential) getSelfCommonName() (string, error) {
 // AddSelfCommonName add Common Name in certificate that specified by s.CertPath
 // to s.CertAllowedCN
 func (s *Credential) AddSelfCommonName() error {
-	cn, err := s.getSelfCommonName()
+	cn, err := s.SelfCommonName()
 	if err != nil {
 		return err
 	}
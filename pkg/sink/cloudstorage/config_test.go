@@ -0,0 +1,83 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigApply(t *testing.T) {
+	t.Parallel()
+
+	expected := NewConfig()
+	expected.WorkerCount = 32
+	expected.FlushInterval = 10 * time.Second
+	expected.FileSize = 16 * 1024 * 1024
+	expected.DateSeparator = config.DateSeparatorMonth.String()
+
+	uri := "s3://bucket/prefix?worker-count=32&flush-interval=10s&file-size=16777216"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.DateSeparator = "month"
+	cfg := NewConfig()
+	err = cfg.Apply(sinkURI, replicaConfig)
+	require.Nil(t, err)
+	require.Equal(t, expected, cfg)
+}
+
+func TestConfigApplyAdjust(t *testing.T) {
+	t.Parallel()
+
+	uri := "file:///tmp/test?worker-count=1024&flush-interval=1s&file-size=1"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	cfg := NewConfig()
+	err = cfg.Apply(sinkURI, config.GetDefaultReplicaConfig())
+	require.Nil(t, err)
+	require.Equal(t, maxWorkerCount, cfg.WorkerCount)
+	require.Equal(t, minFlushInterval, cfg.FlushInterval)
+	require.Equal(t, minFileSize, cfg.FileSize)
+}
+
+func TestConfigApplyInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []string{
+		"kafka://127.0.0.1:9092/test",
+		"s3://bucket/prefix?worker-count=a",
+		"s3://bucket/prefix?worker-count=-1",
+		"s3://bucket/prefix?flush-interval=a",
+		"s3://bucket/prefix?file-size=a",
+	}
+	for _, uri := range testCases {
+		sinkURI, err := url.Parse(uri)
+		require.Nil(t, err)
+		cfg := NewConfig()
+		err = cfg.Apply(sinkURI, config.GetDefaultReplicaConfig())
+		require.Regexp(t, ".*CDC:ErrStorageSinkInvalidConfig.*", err, uri)
+	}
+
+	sinkURI, err := url.Parse("gcs://bucket/prefix")
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.DateSeparator = "week"
+	err = NewConfig().Apply(sinkURI, replicaConfig)
+	require.Regexp(t, ".*CDC:ErrStorageSinkInvalidDateSeparator.*", err)
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	// MetadataFileName is the name of the file which records the
+	// checkpoint-ts of the changefeed, it's in the root of the storage.
+	MetadataFileName = "metadata"
+	// SchemaFileName is the name of the table schema file.
+	SchemaFileName = "schema.json"
+
+	// dataFileNameFormat is the format of the data file name,
+	// layout: CDC{index}.{extension}
+	dataFileNameFormat = "CDC%06d%s"
+
+	csvFileExtension  = ".csv"
+	jsonFileExtension = ".json"
+)
+
+var dataFileNameRegexp = regexp.MustCompile(`^CDC(\d{6,})\.\w+$`)
+
+// VersionedTable is used to wrap TableName with a version.
+type VersionedTable struct {
+	model.TableName
+	// Version is the version of the table schema, which is the
+	// commit-ts of the DDL that created the schema.
+	Version uint64
+}
+
+// String implements fmt.Stringer.
+func (t VersionedTable) String() string {
+	return fmt.Sprintf("%s.%d", t.TableName.String(), t.Version)
+}
+
+// GetFileExtension returns the extension of the data files
+// encoded by the given protocol.
+func GetFileExtension(protocol config.Protocol) string {
+	switch protocol {
+	case config.ProtocolCSV:
+		return csvFileExtension
+	case config.ProtocolCanalJSON:
+		return jsonFileExtension
+	default:
+		return ".unknown"
+	}
+}
+
+// GenerateDateStr returns the date directory of the data files
+// for the given time, it's empty if no separator is configured.
+func GenerateDateStr(separator string, t time.Time) string {
+	switch separator {
+	case config.DateSeparatorYear.String():
+		return t.Format("2006")
+	case config.DateSeparatorMonth.String():
+		return t.Format("2006-01")
+	case config.DateSeparatorDay.String():
+		return t.Format("2006-01-02")
+	default:
+		return ""
+	}
+}
+
+// GenerateDataDirPath returns the directory of the data files of a table,
+// layout: {schema}/{table}/{table-version}/{date}.
+func GenerateDataDirPath(tbl VersionedTable, date string) string {
+	return path.Join(tbl.Schema, tbl.Table, strconv.FormatUint(tbl.Version, 10), date)
+}
+
+// GenerateDataFileName returns the name of the index-th data file.
+func GenerateDataFileName(index uint64, extension string) string {
+	return fmt.Sprintf(dataFileNameFormat, index, extension)
+}
+
+// FetchIndexFromFileName extracts the index from a data file name.
+func FetchIndexFromFileName(fileName string) (uint64, error) {
+	matches := dataFileNameRegexp.FindStringSubmatch(path.Base(fileName))
+	if len(matches) != 2 {
+		return 0, cerror.ErrStorageSinkInvalidFileName.GenWithStackByArgs(fileName)
+	}
+	index, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, cerror.WrapError(cerror.ErrStorageSinkInvalidFileName, err, fileName)
+	}
+	return index, nil
+}
+
+// GenerateSchemaFilePath returns the path of the schema file of a table,
+// layout: {schema}/{table}/{table-version}/schema.json.
+func GenerateSchemaFilePath(tbl VersionedTable) string {
+	return path.Join(tbl.Schema, tbl.Table, strconv.FormatUint(tbl.Version, 10), SchemaFileName)
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDataFilePath(t *testing.T) {
+	t.Parallel()
+
+	tbl := VersionedTable{
+		TableName: model.TableName{Schema: "test", Table: "t1"},
+		Version:   5,
+	}
+	now := time.Date(2022, 9, 23, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		separator string
+		expected  string
+	}{
+		{config.DateSeparatorNone.String(), "test/t1/5"},
+		{config.DateSeparatorYear.String(), "test/t1/5/2022"},
+		{config.DateSeparatorMonth.String(), "test/t1/5/2022-09"},
+		{config.DateSeparatorDay.String(), "test/t1/5/2022-09-23"},
+	}
+	for _, tc := range testCases {
+		dir := GenerateDataDirPath(tbl, GenerateDateStr(tc.separator, now))
+		require.Equal(t, tc.expected, dir)
+	}
+
+	name := GenerateDataFileName(12, GetFileExtension(config.ProtocolCSV))
+	require.Equal(t, "CDC000012.csv", name)
+	index, err := FetchIndexFromFileName("test/t1/5/" + name)
+	require.Nil(t, err)
+	require.Equal(t, uint64(12), index)
+	index, err = FetchIndexFromFileName("CDC1234567.json")
+	require.Nil(t, err)
+	require.Equal(t, uint64(1234567), index)
+	_, err = FetchIndexFromFileName("schema.json")
+	require.Regexp(t, ".*CDC:ErrStorageSinkInvalidFileName.*", err)
+
+	require.Equal(t, "test/t1/5/schema.json", GenerateSchemaFilePath(tbl))
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"encoding/json"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// TableCol denotes the column info for a table definition.
+type TableCol struct {
+	Name string `json:"ColumnName"`
+	Tp   string `json:"ColumnType"`
+}
+
+// TableDefinition is the detailed table definition used for cloud storage sink,
+// it's written into the schema file of each table version.
+type TableDefinition struct {
+	Table        string     `json:"Table"`
+	Schema       string     `json:"Schema"`
+	Version      uint64     `json:"Version"`
+	Query        string     `json:"Query"`
+	Columns      []TableCol `json:"TableColumns"`
+	TotalColumns int        `json:"TableColumnsTotal"`
+}
+
+// FromDDLEvent builds the table definition from the table info after a DDL.
+func (t *TableDefinition) FromDDLEvent(event *model.DDLEvent) {
+	t.Table = event.TableInfo.Table
+	t.Schema = event.TableInfo.Schema
+	t.Version = event.CommitTs
+	t.Query = event.Query
+	t.Columns = make([]TableCol, 0, len(event.TableInfo.ColumnInfo))
+	for _, col := range event.TableInfo.ColumnInfo {
+		t.Columns = append(t.Columns, TableCol{
+			Name: col.Name,
+			Tp:   types.TypeToStr(col.Type, ""),
+		})
+	}
+	t.TotalColumns = len(t.Columns)
+}
+
+// FromRowChangedEvent builds the table definition of the given version from
+// the columns of a row, it's used when the schema file of the version is
+// missing, so the query which creates the version is unknown.
+func (t *TableDefinition) FromRowChangedEvent(event *model.RowChangedEvent, version uint64) {
+	t.Table = event.Table.Table
+	t.Schema = event.Table.Schema
+	t.Version = version
+	t.Query = ""
+	cols := event.Columns
+	if len(cols) == 0 {
+		cols = event.PreColumns
+	}
+	t.Columns = make([]TableCol, 0, len(cols))
+	for _, col := range cols {
+		if col == nil {
+			continue
+		}
+		t.Columns = append(t.Columns, TableCol{
+			Name: col.Name,
+			Tp:   types.TypeToStr(col.Type, ""),
+		})
+	}
+	t.TotalColumns = len(t.Columns)
+}
+
+// VersionedTable returns the versioned table name of the definition.
+func (t *TableDefinition) VersionedTable() VersionedTable {
+	return VersionedTable{
+		TableName: model.TableName{Schema: t.Schema, Table: t.Table},
+		Version:   t.Version,
+	}
+}
+
+// Marshal returns the json encoded table definition.
+func (t *TableDefinition) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(t, "", "    ")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return data, nil
+}
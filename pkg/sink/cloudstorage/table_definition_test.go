@@ -0,0 +1,67 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableDefinition(t *testing.T) {
+	t.Parallel()
+
+	var def TableDefinition
+	def.FromDDLEvent(&model.DDLEvent{
+		CommitTs: 100,
+		Query:    "create table test.t1(id int primary key, name varchar(32))",
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "test",
+			Table:  "t1",
+			ColumnInfo: []*model.ColumnInfo{
+				{Name: "id", Type: mysql.TypeLong},
+				{Name: "name", Type: mysql.TypeVarchar},
+			},
+		},
+	})
+	require.Equal(t, []TableCol{{Name: "id", Tp: "int"}, {Name: "name", Tp: "varchar"}},
+		def.Columns)
+	require.Equal(t, 2, def.TotalColumns)
+	require.Equal(t, "test/t1/100/schema.json", GenerateSchemaFilePath(def.VersionedTable()))
+
+	data, err := def.Marshal()
+	require.Nil(t, err)
+	require.Contains(t, string(data), `"TableColumnsTotal": 2`)
+}
+
+func TestTableDefinitionFromRowChangedEvent(t *testing.T) {
+	t.Parallel()
+
+	var def TableDefinition
+	def.FromRowChangedEvent(&model.RowChangedEvent{
+		CommitTs: 200,
+		Table:    &model.TableName{Schema: "test", Table: "t1"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("a")},
+		},
+	}, 100)
+	require.Equal(t, []TableCol{{Name: "id", Tp: "int"}, {Name: "name", Tp: "varchar"}},
+		def.Columns)
+	require.Equal(t, 2, def.TotalColumns)
+	require.Empty(t, def.Query)
+	require.Equal(t, "test/t1/100/schema.json", GenerateSchemaFilePath(def.VersionedTable()))
+}
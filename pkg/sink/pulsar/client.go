@@ -0,0 +1,35 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ClientCreator defines the type of client creator.
+type ClientCreator func(config *Config) (pulsar.Client, error)
+
+// NewClient creates a pulsar client with the config.
+func NewClient(config *Config) (pulsar.Client, error) {
+	options, err := config.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	client, err := pulsar.NewClient(options)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	return client, nil
+}
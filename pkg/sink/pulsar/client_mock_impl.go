@@ -0,0 +1,179 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+)
+
+// MockClient is an in-process pulsar client used in tests, it acts as a
+// pulsar broker which keeps the messages of the topics in memory.
+type MockClient struct {
+	mu sync.Mutex
+	// partitions records the partition number of the topics,
+	// zero means the topic is a non-partitioned topic.
+	partitions map[string]int
+	// messages records the messages sent to each partition.
+	messages map[string][]*pulsar.ProducerMessage
+	sendErr  error
+	closed   bool
+}
+
+// NewMockClient creates a mock client with the partitioned topics.
+// The topics not in the map are created as non-partitioned topics
+// automatically, the same as the default behavior of pulsar brokers.
+func NewMockClient(partitions map[string]int) *MockClient {
+	if partitions == nil {
+		partitions = make(map[string]int)
+	}
+	return &MockClient{
+		partitions: partitions,
+		messages:   make(map[string][]*pulsar.ProducerMessage),
+	}
+}
+
+// NewMockClientCreator returns a ClientCreator which always returns the client.
+func NewMockClientCreator(client *MockClient) ClientCreator {
+	return func(_ *Config) (pulsar.Client, error) {
+		return client, nil
+	}
+}
+
+// SetSendError makes all the following messages fail to be sent with the error.
+func (c *MockClient) SetSendError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sendErr = err
+}
+
+// Messages returns the messages sent to the partition of the topic.
+func (c *MockClient) Messages(topic string, partition int32) []*pulsar.ProducerMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.messages[c.partitionTopic(topic, partition)]
+}
+
+// IsClosed returns true if the client is closed.
+func (c *MockClient) IsClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+func (c *MockClient) partitionTopic(topic string, partition int32) string {
+	if c.partitions[topic] == 0 {
+		return topic
+	}
+	return fmt.Sprintf("%s-partition-%d", topic, partition)
+}
+
+// CreateProducer implements pulsar.Client.
+func (c *MockClient) CreateProducer(options pulsar.ProducerOptions) (pulsar.Producer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errors.New("pulsar client is closed")
+	}
+	return &mockProducer{client: c, topic: options.Topic}, nil
+}
+
+// Subscribe implements pulsar.Client.
+func (c *MockClient) Subscribe(pulsar.ConsumerOptions) (pulsar.Consumer, error) {
+	return nil, errors.New("consumer is not supported by the mock client")
+}
+
+// CreateReader implements pulsar.Client.
+func (c *MockClient) CreateReader(pulsar.ReaderOptions) (pulsar.Reader, error) {
+	return nil, errors.New("reader is not supported by the mock client")
+}
+
+// CreateTableView implements pulsar.Client.
+func (c *MockClient) CreateTableView(pulsar.TableViewOptions) (pulsar.TableView, error) {
+	return nil, errors.New("table view is not supported by the mock client")
+}
+
+// TopicPartitions implements pulsar.Client.
+func (c *MockClient) TopicPartitions(topic string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errors.New("pulsar client is closed")
+	}
+	n := c.partitions[topic]
+	if n == 0 {
+		return []string{topic}, nil
+	}
+	partitions := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		partitions = append(partitions, c.partitionTopic(topic, int32(i)))
+	}
+	return partitions, nil
+}
+
+// Close implements pulsar.Client.
+func (c *MockClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+}
+
+type mockProducer struct {
+	client *MockClient
+	topic  string
+	seqID  int64
+}
+
+func (p *mockProducer) Topic() string {
+	return p.topic
+}
+
+func (p *mockProducer) Name() string {
+	return "mock-producer-" + p.topic
+}
+
+func (p *mockProducer) Send(
+	_ context.Context, msg *pulsar.ProducerMessage,
+) (pulsar.MessageID, error) {
+	p.client.mu.Lock()
+	defer p.client.mu.Unlock()
+	if p.client.sendErr != nil {
+		return nil, p.client.sendErr
+	}
+	p.client.messages[p.topic] = append(p.client.messages[p.topic], msg)
+	p.seqID++
+	return pulsar.LatestMessageID(), nil
+}
+
+func (p *mockProducer) SendAsync(
+	ctx context.Context, msg *pulsar.ProducerMessage,
+	callback func(pulsar.MessageID, *pulsar.ProducerMessage, error),
+) {
+	id, err := p.Send(ctx, msg)
+	callback(id, msg, err)
+}
+
+func (p *mockProducer) LastSequenceID() int64 {
+	return p.seqID
+}
+
+func (p *mockProducer) Flush() error {
+	return nil
+}
+
+func (p *mockProducer) Close() {}
@@ -0,0 +1,295 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+)
+
+const (
+	// the authentication types supported by the pulsar sink.
+	authTypeToken  = "token"
+	authTypeOAuth2 = "oauth2"
+
+	defaultConnectionTimeout       = 5 * time.Second
+	defaultOperationTimeout        = 30 * time.Second
+	defaultSendTimeout             = 30 * time.Second
+	defaultBatchingMaxMessages     = 1000
+	defaultBatchingMaxPublishDelay = 10 * time.Millisecond
+	// defaultMaxMessageBytes is the default max message size of pulsar brokers,
+	// which is `maxMessageSize` in broker.conf.
+	defaultMaxMessageBytes = 5 * 1024 * 1024
+)
+
+// OAuth2 stores the configurations of the OAuth2 client credentials flow.
+type OAuth2 struct {
+	IssuerURL string
+	Audience  string
+	// PrivateKey is the path of the credentials file of the client.
+	PrivateKey string
+	ClientID   string
+	Scope      string
+}
+
+// Config stores the user specified Pulsar producer configuration.
+type Config struct {
+	// URL is the service URL of the pulsar cluster,
+	// such as pulsar://127.0.0.1:6650.
+	URL string
+
+	ConnectionTimeout time.Duration
+	OperationTimeout  time.Duration
+	SendTimeout       time.Duration
+
+	BatchingMaxMessages     uint
+	BatchingMaxPublishDelay time.Duration
+	MaxMessageBytes         int
+	Compression             string
+
+	// AuthType is the authentication type, which is empty, token or oauth2.
+	AuthType string
+	// Token and TokenFile are used by the token authentication,
+	// only one of them can be specified.
+	Token     string
+	TokenFile string
+	OAuth2    *OAuth2
+
+	TLSTrustCertsFilePath      string
+	TLSAllowInsecureConnection bool
+	TLSValidateHostname        bool
+}
+
+// NewConfig returns a default Pulsar configuration.
+func NewConfig() *Config {
+	return &Config{
+		ConnectionTimeout:       defaultConnectionTimeout,
+		OperationTimeout:        defaultOperationTimeout,
+		SendTimeout:             defaultSendTimeout,
+		BatchingMaxMessages:     defaultBatchingMaxMessages,
+		BatchingMaxPublishDelay: defaultBatchingMaxPublishDelay,
+		MaxMessageBytes:         defaultMaxMessageBytes,
+		Compression:             "none",
+		OAuth2:                  &OAuth2{},
+	}
+}
+
+// Apply the sinkURI to update Config.
+func (c *Config) Apply(sinkURI *url.URL) error {
+	scheme := strings.ToLower(sinkURI.Scheme)
+	if !sink.IsPulsarScheme(scheme) {
+		return cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"can't create pulsar sink with unsupported scheme: %s", scheme)
+	}
+	if sinkURI.Host == "" {
+		return cerror.ErrPulsarInvalidConfig.GenWithStack("no broker is specified in sink-uri")
+	}
+	c.URL = fmt.Sprintf("%s://%s", scheme, sinkURI.Host)
+
+	params := sinkURI.Query()
+	var err error
+	if err = getDuration(params, "connection-timeout", &c.ConnectionTimeout); err != nil {
+		return err
+	}
+	if err = getDuration(params, "operation-timeout", &c.OperationTimeout); err != nil {
+		return err
+	}
+	if err = getDuration(params, "send-timeout", &c.SendTimeout); err != nil {
+		return err
+	}
+	if err = getDuration(params, "batching-max-publish-delay",
+		&c.BatchingMaxPublishDelay); err != nil {
+		return err
+	}
+
+	s := params.Get("batching-max-messages")
+	if s != "" {
+		a, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+		}
+		c.BatchingMaxMessages = uint(a)
+	}
+
+	s = params.Get("max-message-bytes")
+	if s != "" {
+		a, err := strconv.Atoi(s)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+		}
+		if a <= 0 {
+			return cerror.ErrPulsarInvalidConfig.GenWithStack(
+				"invalid max-message-bytes %d, which must be greater than 0", a)
+		}
+		c.MaxMessageBytes = a
+	}
+
+	s = params.Get("compression")
+	if s != "" {
+		if _, err := c.compressionType(s); err != nil {
+			return err
+		}
+		c.Compression = s
+	}
+
+	if err = c.applyAuthentication(params); err != nil {
+		return err
+	}
+	return c.applyTLS(params)
+}
+
+func (c *Config) applyAuthentication(params url.Values) error {
+	c.Token = params.Get("token")
+	c.TokenFile = params.Get("token-from-file")
+	c.OAuth2.IssuerURL = params.Get("oauth2-issuer-url")
+	c.OAuth2.Audience = params.Get("oauth2-audience")
+	c.OAuth2.PrivateKey = params.Get("oauth2-private-key")
+	c.OAuth2.ClientID = params.Get("oauth2-client-id")
+	c.OAuth2.Scope = params.Get("oauth2-scope")
+
+	useToken := c.Token != "" || c.TokenFile != ""
+	useOAuth2 := c.OAuth2.IssuerURL != "" || c.OAuth2.PrivateKey != ""
+	switch {
+	case useToken && useOAuth2:
+		return cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"token and oauth2 authentication can't be used at the same time")
+	case useToken:
+		if c.Token != "" && c.TokenFile != "" {
+			return cerror.ErrPulsarInvalidConfig.GenWithStack(
+				"token and token-from-file can't be specified at the same time")
+		}
+		c.AuthType = authTypeToken
+	case useOAuth2:
+		if c.OAuth2.IssuerURL == "" || c.OAuth2.PrivateKey == "" || c.OAuth2.Audience == "" {
+			return cerror.ErrPulsarInvalidConfig.GenWithStack(
+				"oauth2-issuer-url, oauth2-private-key and oauth2-audience should all be supplied")
+		}
+		c.AuthType = authTypeOAuth2
+	}
+	return nil
+}
+
+func (c *Config) applyTLS(params url.Values) error {
+	c.TLSTrustCertsFilePath = params.Get("tls-trust-certs-file-path")
+
+	s := params.Get("tls-allow-insecure-connection")
+	if s != "" {
+		allow, err := strconv.ParseBool(s)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+		}
+		c.TLSAllowInsecureConnection = allow
+	}
+
+	s = params.Get("tls-validate-hostname")
+	if s != "" {
+		validate, err := strconv.ParseBool(s)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrPulsarInvalidConfig, err)
+		}
+		c.TLSValidateHostname = validate
+	}
+	return nil
+}
+
+// ClientOptions returns the options to create a pulsar client.
+func (c *Config) ClientOptions() (pulsar.ClientOptions, error) {
+	option := pulsar.ClientOptions{
+		URL:                        c.URL,
+		ConnectionTimeout:          c.ConnectionTimeout,
+		OperationTimeout:           c.OperationTimeout,
+		TLSTrustCertsFilePath:      c.TLSTrustCertsFilePath,
+		TLSAllowInsecureConnection: c.TLSAllowInsecureConnection,
+		TLSValidateHostname:        c.TLSValidateHostname,
+	}
+
+	switch c.AuthType {
+	case authTypeToken:
+		if c.TokenFile != "" {
+			option.Authentication = pulsar.NewAuthenticationTokenFromFile(c.TokenFile)
+		} else {
+			option.Authentication = pulsar.NewAuthenticationToken(c.Token)
+		}
+	case authTypeOAuth2:
+		params := map[string]string{
+			"type":       "client_credentials",
+			"issuerUrl":  c.OAuth2.IssuerURL,
+			"audience":   c.OAuth2.Audience,
+			"privateKey": c.OAuth2.PrivateKey,
+			"clientId":   c.OAuth2.ClientID,
+		}
+		if c.OAuth2.Scope != "" {
+			params["scope"] = c.OAuth2.Scope
+		}
+		// The OAuth2 provider fetches the access token when it's created,
+		// and it returns nil if the authorization fails.
+		option.Authentication = pulsar.NewAuthenticationOAuth2(params)
+		if option.Authentication == nil {
+			return option, cerror.ErrPulsarInvalidConfig.GenWithStack(
+				"failed to authorize with oauth2 issuer %s", c.OAuth2.IssuerURL)
+		}
+	}
+	return option, nil
+}
+
+// ProducerOptions returns the options to create a pulsar producer of the topic.
+func (c *Config) ProducerOptions(topic string) pulsar.ProducerOptions {
+	// The compression type has been checked in Apply.
+	compression, _ := c.compressionType(c.Compression)
+	return pulsar.ProducerOptions{
+		Topic:                   topic,
+		SendTimeout:             c.SendTimeout,
+		BatchingMaxMessages:     c.BatchingMaxMessages,
+		BatchingMaxPublishDelay: c.BatchingMaxPublishDelay,
+		BatchingMaxSize:         uint(c.MaxMessageBytes),
+		CompressionType:         compression,
+	}
+}
+
+func (c *Config) compressionType(compression string) (pulsar.CompressionType, error) {
+	switch strings.ToLower(compression) {
+	case "none", "":
+		return pulsar.NoCompression, nil
+	case "lz4":
+		return pulsar.LZ4, nil
+	case "zlib":
+		return pulsar.ZLib, nil
+	case "zstd":
+		return pulsar.ZSTD, nil
+	default:
+		return pulsar.NoCompression, cerror.ErrPulsarInvalidConfig.GenWithStack(
+			"unsupported compression algorithm %s", compression)
+	}
+}
+
+func getDuration(params url.Values, key string, target *time.Duration) error {
+	s := params.Get(key)
+	if s == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrPulsarInvalidConfig, errors.Annotate(err, key))
+	}
+	*target = d
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigApply(t *testing.T) {
+	t.Parallel()
+
+	uri := "pulsar://127.0.0.1:6650/test?connection-timeout=10s&operation-timeout=20s" +
+		"&send-timeout=5s&batching-max-messages=100&batching-max-publish-delay=50ms" +
+		"&max-message-bytes=1048576&compression=lz4&token=abc"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	c := NewConfig()
+	require.Nil(t, c.Apply(sinkURI))
+	require.Equal(t, "pulsar://127.0.0.1:6650", c.URL)
+	require.Equal(t, 10*time.Second, c.ConnectionTimeout)
+	require.Equal(t, 20*time.Second, c.OperationTimeout)
+	require.Equal(t, 5*time.Second, c.SendTimeout)
+	require.Equal(t, uint(100), c.BatchingMaxMessages)
+	require.Equal(t, 50*time.Millisecond, c.BatchingMaxPublishDelay)
+	require.Equal(t, 1048576, c.MaxMessageBytes)
+	require.Equal(t, authTypeToken, c.AuthType)
+
+	options, err := c.ClientOptions()
+	require.Nil(t, err)
+	require.Equal(t, "pulsar://127.0.0.1:6650", options.URL)
+	require.NotNil(t, options.Authentication)
+
+	producerOptions := c.ProducerOptions("test")
+	require.Equal(t, "test", producerOptions.Topic)
+	require.Equal(t, pulsar.LZ4, producerOptions.CompressionType)
+	require.Equal(t, uint(1048576), producerOptions.BatchingMaxSize)
+}
+
+func TestConfigApplyDefault(t *testing.T) {
+	t.Parallel()
+
+	sinkURI, err := url.Parse("pulsar+ssl://127.0.0.1:6651/test")
+	require.Nil(t, err)
+	c := NewConfig()
+	require.Nil(t, c.Apply(sinkURI))
+	require.Equal(t, "pulsar+ssl://127.0.0.1:6651", c.URL)
+	require.Equal(t, defaultMaxMessageBytes, c.MaxMessageBytes)
+	require.Equal(t, "", c.AuthType)
+	options, err := c.ClientOptions()
+	require.Nil(t, err)
+	require.Nil(t, options.Authentication)
+	require.Equal(t, pulsar.NoCompression, c.ProducerOptions("test").CompressionType)
+}
+
+func TestConfigApplyOAuth2(t *testing.T) {
+	t.Parallel()
+
+	uri := "pulsar://127.0.0.1:6650/test?oauth2-issuer-url=https://issuer" +
+		"&oauth2-audience=audience&oauth2-private-key=/tmp/key.json&oauth2-client-id=id"
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	c := NewConfig()
+	require.Nil(t, c.Apply(sinkURI))
+	require.Equal(t, authTypeOAuth2, c.AuthType)
+	require.Equal(t, "https://issuer", c.OAuth2.IssuerURL)
+	require.Equal(t, "audience", c.OAuth2.Audience)
+	require.Equal(t, "/tmp/key.json", c.OAuth2.PrivateKey)
+	require.Equal(t, "id", c.OAuth2.ClientID)
+}
+
+func TestConfigApplyInvalid(t *testing.T) {
+	t.Parallel()
+
+	uris := []string{
+		"kafka://127.0.0.1:9092/test",
+		"pulsar:///test",
+		"pulsar://127.0.0.1:6650/test?connection-timeout=abc",
+		"pulsar://127.0.0.1:6650/test?batching-max-messages=-1",
+		"pulsar://127.0.0.1:6650/test?max-message-bytes=0",
+		"pulsar://127.0.0.1:6650/test?compression=snappy",
+		"pulsar://127.0.0.1:6650/test?token=abc&token-from-file=/tmp/token",
+		"pulsar://127.0.0.1:6650/test?token=abc&oauth2-issuer-url=https://issuer",
+		"pulsar://127.0.0.1:6650/test?oauth2-issuer-url=https://issuer",
+		"pulsar://127.0.0.1:6650/test?tls-allow-insecure-connection=abc",
+	}
+	for _, uri := range uris {
+		sinkURI, err := url.Parse(uri)
+		require.Nil(t, err)
+		err = NewConfig().Apply(sinkURI)
+		require.Regexp(t, ".*CDC:ErrPulsarInvalidConfig.*", err, uri)
+	}
+}
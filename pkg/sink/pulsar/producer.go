@@ -0,0 +1,207 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Producer sends messages to the partitions of pulsar topics.
+// A pulsar producer is created lazily for each partition of a topic,
+// so a message can be sent to the partition chosen by the partition dispatcher.
+type Producer struct {
+	id     model.ChangeFeedID
+	client pulsar.Client
+	config *Config
+
+	mu     sync.Mutex
+	closed bool
+	// partitions caches the partition topics of each topic.
+	partitions map[string][]string
+	// producers holds the producer of each partition topic.
+	producers map[string]pulsar.Producer
+}
+
+// NewProducer creates a new Producer.
+func NewProducer(id model.ChangeFeedID, client pulsar.Client, config *Config) *Producer {
+	return &Producer{
+		id:         id,
+		client:     client,
+		config:     config,
+		partitions: make(map[string][]string),
+		producers:  make(map[string]pulsar.Producer),
+	}
+}
+
+// GetPartitionNum returns the number of partitions of the topic,
+// a non-partitioned topic is treated as a topic with only one partition.
+func (p *Producer) GetPartitionNum(topic string) (int32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	partitions, err := p.getPartitions(topic)
+	if err != nil {
+		return 0, err
+	}
+	return int32(len(partitions)), nil
+}
+
+func (p *Producer) getPartitions(topic string) ([]string, error) {
+	if partitions, ok := p.partitions[topic]; ok {
+		return partitions, nil
+	}
+	partitions, err := p.client.TopicPartitions(topic)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarGetTopicPartitions, err, topic)
+	}
+	if len(partitions) == 0 {
+		return nil, cerror.ErrPulsarGetTopicPartitions.GenWithStackByArgs(topic)
+	}
+	p.partitions[topic] = partitions
+	return partitions, nil
+}
+
+func (p *Producer) getProducer(topic string, partition int32) (pulsar.Producer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+	partitions, err := p.getPartitions(topic)
+	if err != nil {
+		return nil, err
+	}
+	if partition < 0 || int(partition) >= len(partitions) {
+		return nil, cerror.ErrPulsarSendMessage.GenWithStack(
+			"partition %d is out of range of topic %s", partition, topic)
+	}
+	partitionTopic := partitions[partition]
+	if producer, ok := p.producers[partitionTopic]; ok {
+		return producer, nil
+	}
+	producer, err := p.client.CreateProducer(p.config.ProducerOptions(partitionTopic))
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	log.Info("pulsar producer created",
+		zap.String("namespace", p.id.Namespace),
+		zap.String("changefeed", p.id.ID),
+		zap.String("topic", partitionTopic))
+	p.producers[partitionTopic] = producer
+	return producer, nil
+}
+
+func toPulsarMessage(message *common.Message) *pulsar.ProducerMessage {
+	msg := &pulsar.ProducerMessage{
+		Payload: message.Value,
+		Key:     string(message.Key),
+	}
+	if message.ClaimCheck {
+		msg.Properties = map[string]string{common.ClaimCheckHeaderKey: ""}
+	}
+	return msg
+}
+
+// AsyncSend sends the message to the partition of the topic asynchronously,
+// the callback is called with the result once the message is acknowledged
+// by the broker or failed to be sent.
+func (p *Producer) AsyncSend(
+	ctx context.Context, topic string, partition int32,
+	message *common.Message, callback func(err error),
+) error {
+	producer, err := p.getProducer(topic, partition)
+	if err != nil {
+		return err
+	}
+	producer.SendAsync(ctx, toPulsarMessage(message),
+		func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+			if err != nil {
+				err = cerror.WrapError(cerror.ErrPulsarAsyncSendMessage, err)
+			}
+			callback(err)
+		})
+	return nil
+}
+
+// SyncSend sends the message to the partition of the topic and waits for the result.
+func (p *Producer) SyncSend(
+	ctx context.Context, topic string, partition int32, message *common.Message,
+) error {
+	producer, err := p.getProducer(topic, partition)
+	if err != nil {
+		return err
+	}
+	if _, err := producer.Send(ctx, toPulsarMessage(message)); err != nil {
+		return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+	}
+	return nil
+}
+
+// SyncBroadcast sends the message to all the partitions of the topic.
+func (p *Producer) SyncBroadcast(
+	ctx context.Context, topic string, message *common.Message,
+) error {
+	partitionNum, err := p.GetPartitionNum(topic)
+	if err != nil {
+		return err
+	}
+	for i := int32(0); i < partitionNum; i++ {
+		if err := p.SyncSend(ctx, topic, i, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush flushes the pending messages of all the producers.
+func (p *Producer) Flush() error {
+	p.mu.Lock()
+	producers := make([]pulsar.Producer, 0, len(p.producers))
+	for _, producer := range p.producers {
+		producers = append(producers, producer)
+	}
+	p.mu.Unlock()
+
+	for _, producer := range producers {
+		if err := producer.Flush(); err != nil {
+			return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+		}
+	}
+	return nil
+}
+
+// Close closes all the producers and the client.
+func (p *Producer) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	for topic, producer := range p.producers {
+		producer.Close()
+		log.Info("pulsar producer closed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID),
+			zap.String("topic", topic))
+	}
+	p.client.Close()
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProducerSend(t *testing.T) {
+	t.Parallel()
+
+	client := NewMockClient(map[string]int{"partitioned": 3})
+	p := NewProducer(model.DefaultChangeFeedID("test"), client, NewConfig())
+	ctx := context.Background()
+
+	num, err := p.GetPartitionNum("partitioned")
+	require.Nil(t, err)
+	require.Equal(t, int32(3), num)
+	num, err = p.GetPartitionNum("non-partitioned")
+	require.Nil(t, err)
+	require.Equal(t, int32(1), num)
+
+	message := &common.Message{Key: []byte("key"), Value: []byte("value")}
+	var callbackErr error
+	called := false
+	err = p.AsyncSend(ctx, "partitioned", 2, message, func(err error) {
+		called = true
+		callbackErr = err
+	})
+	require.Nil(t, err)
+	require.True(t, called)
+	require.Nil(t, callbackErr)
+	messages := client.Messages("partitioned", 2)
+	require.Len(t, messages, 1)
+	require.Equal(t, "key", messages[0].Key)
+	require.Equal(t, []byte("value"), messages[0].Payload)
+
+	require.Nil(t, p.SyncSend(ctx, "non-partitioned", 0, message))
+	require.Len(t, client.Messages("non-partitioned", 0), 1)
+
+	require.Nil(t, p.SyncBroadcast(ctx, "partitioned", message))
+	require.Len(t, client.Messages("partitioned", 0), 1)
+	require.Len(t, client.Messages("partitioned", 1), 1)
+	require.Len(t, client.Messages("partitioned", 2), 2)
+
+	err = p.SyncSend(ctx, "partitioned", 3, message)
+	require.Regexp(t, ".*CDC:ErrPulsarSendMessage.*", err)
+
+	client.SetSendError(errors.New("fake"))
+	err = p.AsyncSend(ctx, "partitioned", 0, message, func(err error) {
+		callbackErr = err
+	})
+	require.Nil(t, err)
+	require.Regexp(t, ".*CDC:ErrPulsarAsyncSendMessage.*", callbackErr)
+	err = p.SyncSend(ctx, "partitioned", 0, message)
+	require.Regexp(t, ".*CDC:ErrPulsarSendMessage.*", err)
+
+	require.Nil(t, p.Flush())
+	p.Close()
+	require.True(t, client.IsClosed())
+	err = p.SyncSend(ctx, "partitioned", 0, message)
+	require.Regexp(t, ".*CDC:ErrPulsarProducerClosed.*", err)
+}
@@ -49,11 +49,27 @@ const (
 	TiDBSchema = "tidb"
 	// TiDBSSLSchema indicates the schema is TiDB+ssl.
 	TiDBSSLSchema = "tidb+ssl"
+	// PulsarSchema indicates the schema is pulsar.
+	PulsarSchema = "pulsar"
+	// PulsarSSLSchema indicates the schema is pulsar+ssl.
+	PulsarSSLSchema = "pulsar+ssl"
+	// S3Schema indicates the schema is s3.
+	S3Schema = "s3"
+	// FileSchema indicates the schema is local fs or NFS.
+	FileSchema = "file"
+	// GCSSchema indicates the schema is gcs.
+	GCSSchema = "gcs"
 )
 
 // IsMQScheme returns true if the scheme belong to mq schema.
 func IsMQScheme(scheme string) bool {
-	return scheme == KafkaSchema || scheme == KafkaSSLSchema
+	return scheme == KafkaSchema || scheme == KafkaSSLSchema ||
+		scheme == PulsarSchema || scheme == PulsarSSLSchema
+}
+
+// IsPulsarScheme returns true if the scheme belong to pulsar schema.
+func IsPulsarScheme(scheme string) bool {
+	return scheme == PulsarSchema || scheme == PulsarSSLSchema
 }
 
 // IsMySQLCompatibleScheme returns true if the scheme is compatible with MySQL.
@@ -61,3 +77,8 @@ func IsMySQLCompatibleScheme(scheme string) bool {
 	return scheme == MySQLSchema || scheme == MySQLSSLSchema ||
 		scheme == TiDBSchema || scheme == TiDBSSLSchema
 }
+
+// IsStorageScheme returns true if the scheme belong to storage scheme.
+func IsStorageScheme(scheme string) bool {
+	return scheme == S3Schema || scheme == FileSchema || scheme == GCSSchema
+}
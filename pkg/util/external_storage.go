@@ -0,0 +1,87 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// GetExternalStorageFromURI creates a new storage.ExternalStorage from a uri,
+// the uri can be in the form of s3://bucket/prefix, gcs://bucket/prefix
+// or file:///path.
+func GetExternalStorageFromURI(
+	ctx context.Context, uri string,
+) (storage.ExternalStorage, error) {
+	return GetExternalStorage(ctx, uri, nil)
+}
+
+// GetExternalStorage creates a new storage.ExternalStorage based on the uri
+// and options. The options can be nil, the default options are used then.
+func GetExternalStorage(
+	ctx context.Context, uri string, opts *storage.BackendOptions,
+) (storage.ExternalStorage, error) {
+	backend, err := storage.ParseBackend(uri, opts)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageInitialize, err)
+	}
+
+	ret, err := storage.New(ctx, backend, &storage.ExternalStorageOptions{
+		SendCredentials: false,
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrStorageInitialize, err)
+	}
+
+	if backend.GetLocal() != nil {
+		return &localStorage{ExternalStorage: ret, base: backend.GetLocal().Path}, nil
+	}
+	return ret, nil
+}
+
+// localStorage wraps the local storage of br, which doesn't create the
+// parent directories of a file, so that it can be used the same way as
+// the object storages, where a directory is just a prefix of keys.
+type localStorage struct {
+	storage.ExternalStorage
+	base string
+}
+
+// WriteFile implements storage.ExternalStorage.
+func (s *localStorage) WriteFile(ctx context.Context, name string, data []byte) error {
+	if err := s.mkdirAll(name); err != nil {
+		return err
+	}
+	return s.ExternalStorage.WriteFile(ctx, name, data)
+}
+
+// Create implements storage.ExternalStorage.
+func (s *localStorage) Create(
+	ctx context.Context, name string,
+) (storage.ExternalFileWriter, error) {
+	if err := s.mkdirAll(name); err != nil {
+		return nil, err
+	}
+	return s.ExternalStorage.Create(ctx, name)
+}
+
+func (s *localStorage) mkdirAll(name string) error {
+	dir := filepath.Dir(filepath.Join(s.base, name))
+	return errors.Trace(os.MkdirAll(dir, 0o755))
+}
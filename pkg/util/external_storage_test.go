@@ -0,0 +1,42 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExternalStorageFromURI(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := GetExternalStorageFromURI(ctx, "file://"+dir+"?worker-count=4")
+	require.Nil(t, err)
+
+	// the parent directories should be created automatically.
+	err = s.WriteFile(ctx, "a/b/c.txt", []byte("test"))
+	require.Nil(t, err)
+	data, err := s.ReadFile(ctx, "a/b/c.txt")
+	require.Nil(t, err)
+	require.Equal(t, []byte("test"), data)
+
+	_, err = GetExternalStorageFromURI(ctx, "s3:///prefix")
+	require.Regexp(t, ".*CDC:ErrStorageInitialize.*", err)
+	_, err = GetExternalStorageFromURI(ctx, "unknown://bucket/prefix")
+	require.Regexp(t, ".*CDC:ErrStorageInitialize.*", err)
+}